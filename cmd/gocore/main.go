@@ -2,8 +2,11 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"log"
+	"strings"
 
+	"github.com/dev-hyunsang/ticketly-backend/config"
 	"github.com/dev-hyunsang/ticketly-backend/internal/db"
 	"github.com/dev-hyunsang/ticketly-backend/internal/handler"
 	"github.com/dev-hyunsang/ticketly-backend/internal/middleware"
@@ -14,6 +17,7 @@ import (
 	"github.com/gofiber/fiber/v2"
 	fiberMiddleware "github.com/gofiber/fiber/v2/middleware/cors"
 	"github.com/gofiber/fiber/v2/middleware/logger"
+	"github.com/google/uuid"
 )
 
 func main() {
@@ -26,10 +30,19 @@ func main() {
 	}
 	defer client.Close()
 
-	if err = client.Schema.Create(context.Background()); err != nil {
+	if err = client.Schema.Create(context.Background(), mysql.NgramFullTextIndexes()); err != nil {
 		log.Fatalf("failed create schema resources : %v", err)
 	}
 
+	// Keep search suggestion columns in sync, filling them in for rows created before they existed
+	if err = mysql.BackfillSearchInitials(context.Background(), client); err != nil {
+		log.Fatalf("failed to backfill search initials : %v", err)
+	}
+	mysql.RegisterSearchHooks(client)
+
+	// Record changes to organizations, events and payments in the audit log
+	mysql.RegisterAuditHooks(client)
+
 	// Redis connection
 	redisClient, err := db.ConnectRedis()
 	if err != nil {
@@ -43,32 +56,89 @@ func main() {
 	orgRepo := mysql.NewOrganizationRepository(client)
 	eventRepo := mysql.NewEventRepository(client)
 	paymentRepo := mysql.NewPaymentRepository(client)
+	registrationRepo := mysql.NewRegistrationRepository(client)
+	invitationRepo := mysql.NewInvitationRepository(client)
+	transferRepo := mysql.NewOwnershipTransferRepository(client)
+	auditRepo := mysql.NewAuditRepository(client)
+	apiKeyRepo := mysql.NewAPIKeyRepository(client)
+	webhookRepo := mysql.NewWebhookRepository(client)
+	webhookQueue := redis.NewWebhookQueue(redisClient)
+	cancellationRepo := mysql.NewEventCancellationRepository(client)
+	eventChangeRepo := mysql.NewEventChangeRepository(client)
+	seriesRepo := mysql.NewEventSeriesRepository(client)
+	searchTrends := redis.NewSearchTrends(redisClient)
+	venueRepo := mysql.NewVenueRepository(client)
+	favoriteRepo := mysql.NewEventFavoriteRepository(client)
+	popularityRanking := redis.NewPopularityRanking(redisClient)
 
 	// Initialize utilities
 	jwtUtil := util.NewJWTUtil()
+	mailer := util.NewMailer()
+	paymentGateway := util.NewPaymentGateway()
+
+	// Initialize authorization policy
+	policy := usecase.NewAuthorizationPolicy(orgRepo, eventRepo, apiKeyRepo)
+
+	// Platform admins manage the platform-wide venues
+	platformAdmins, err := parseUserIDs(config.Getenv("PLATFORM_ADMIN_USER_IDS"))
+	if err != nil {
+		log.Fatalf("invalid PLATFORM_ADMIN_USER_IDS : %v", err)
+	}
 
 	// Initialize use cases
+	popularityUseCase := usecase.NewPopularityUseCase(popularityRanking, eventRepo)
+	// Rank the events already on sale when the ranking starts out empty
+	if err = popularityUseCase.BackfillPopularity(); err != nil {
+		log.Fatalf("failed to backfill event popularity : %v", err)
+	}
+	webhookUseCase := usecase.NewWebhookUseCase(webhookRepo, webhookQueue, eventRepo, policy)
 	userUseCase := usecase.NewUserUseCase(userRepo)
 	authUseCase := usecase.NewAuthUseCase(userRepo, tokenRepo, jwtUtil)
-	orgUseCase := usecase.NewOrganizationUseCase(orgRepo)
-	eventUseCase := usecase.NewEventUseCase(eventRepo, orgRepo)
-	paymentUseCase := usecase.NewPaymentUseCase(paymentRepo, eventRepo)
+	cancellationUseCase := usecase.NewCancellationUseCase(cancellationRepo, eventRepo, paymentRepo, policy, webhookUseCase)
+	orgUseCase := usecase.NewOrganizationUseCase(orgRepo, transferRepo, eventRepo, paymentRepo, policy, webhookUseCase, cancellationUseCase)
+	eventUseCase := usecase.NewEventUseCase(eventRepo, eventChangeRepo, paymentRepo, venueRepo, policy, mailer, searchTrends, popularityUseCase)
+	seriesUseCase := usecase.NewSeriesUseCase(seriesRepo, eventRepo, venueRepo, eventUseCase, policy)
+	paymentUseCase := usecase.NewPaymentUseCase(paymentRepo, eventRepo, registrationRepo, eventChangeRepo, policy, paymentGateway, webhookUseCase, popularityUseCase)
+	boxOfficeUseCase := usecase.NewBoxOfficeUseCase(paymentRepo, eventRepo, policy, webhookUseCase, popularityUseCase)
+	rsvpUseCase := usecase.NewRsvpUseCase(paymentRepo, eventRepo, policy, userRepo, registrationRepo, webhookUseCase, popularityUseCase)
+	registrationUseCase := usecase.NewRegistrationUseCase(registrationRepo, eventRepo, policy)
+	invitationUseCase := usecase.NewInvitationUseCase(invitationRepo, orgRepo, userRepo, policy, jwtUtil, mailer, config.Getenv("INVITATION_ACCEPT_URL"))
+	attendeeUseCase := usecase.NewAttendeeUseCase(paymentRepo, eventRepo, policy, registrationRepo, webhookUseCase)
+	auditUseCase := usecase.NewAuditUseCase(auditRepo, policy)
+	apiKeyUseCase := usecase.NewAPIKeyUseCase(apiKeyRepo, policy)
+	searchUseCase := usecase.NewSearchUseCase(eventRepo, orgRepo, searchTrends)
+	venueUseCase := usecase.NewVenueUseCase(venueRepo, policy, platformAdmins)
+	favoriteUseCase := usecase.NewFavoriteUseCase(favoriteRepo, eventRepo, policy, popularityUseCase)
 
 	// Initialize handlers
 	authHandler := handler.NewAuthHandler(authUseCase)
 	userHandler := handler.NewUserHandler(userUseCase)
 	orgHandler := handler.NewOrganizationHandler(orgUseCase)
 	eventHandler := handler.NewEventHandler(eventUseCase)
+	seriesHandler := handler.NewSeriesHandler(seriesUseCase)
 	paymentHandler := handler.NewPaymentHandler(paymentUseCase)
+	boxOfficeHandler := handler.NewBoxOfficeHandler(boxOfficeUseCase)
+	rsvpHandler := handler.NewRsvpHandler(rsvpUseCase)
+	registrationHandler := handler.NewRegistrationHandler(registrationUseCase)
+	attendeeHandler := handler.NewAttendeeHandler(attendeeUseCase)
+	invitationHandler := handler.NewInvitationHandler(invitationUseCase)
+	auditHandler := handler.NewAuditHandler(auditUseCase)
+	apiKeyHandler := handler.NewAPIKeyHandler(apiKeyUseCase)
+	webhookHandler := handler.NewWebhookHandler(webhookUseCase)
+	cancellationHandler := handler.NewCancellationHandler(cancellationUseCase)
+	searchHandler := handler.NewSearchHandler(searchUseCase)
+	venueHandler := handler.NewVenueHandler(venueUseCase)
+	favoriteHandler := handler.NewFavoriteHandler(favoriteUseCase)
 
 	// Initialize middleware
 	authMiddleware := middleware.NewAuthMiddleware(authUseCase)
+	apiKeyMiddleware := middleware.NewAPIKeyMiddleware(apiKeyUseCase, authMiddleware)
 
 	// Apply global middleware
 	app.Use(logger.New())
 	app.Use(fiberMiddleware.New(fiberMiddleware.Config{
 		AllowOrigins: "*",
-		AllowHeaders: "Origin, Content-Type, Accept, Authorization",
+		AllowHeaders: "Origin, Content-Type, Accept, Authorization, X-API-Key",
 		AllowMethods: "GET, POST, PUT, DELETE, OPTIONS",
 	}))
 
@@ -90,6 +160,7 @@ func main() {
 	users := auth.Group("/users", authMiddleware.Authenticate)
 	users.Get("/me", authHandler.Me)
 	users.Put("/me", userHandler.UpdateProfile)
+	users.Get("/me/favorites", favoriteHandler.GetMyFavorites)
 	users.Get("/:id", userHandler.GetUserByID)
 	users.Post("/logout", authHandler.Logout)
 
@@ -102,6 +173,8 @@ func main() {
 	orgs.Get("/:id", orgHandler.GetOrganization)
 	orgs.Put("/:id", orgHandler.UpdateOrganization)
 	orgs.Delete("/:id", orgHandler.DeleteOrganization)
+	orgs.Post("/:id/deactivate", orgHandler.DeactivateOrganization)
+	orgs.Post("/:id/reactivate", orgHandler.ReactivateOrganization)
 
 	// Organization member routes
 	orgs.Get("/:id/members", orgHandler.GetMembers)
@@ -109,17 +182,123 @@ func main() {
 	orgs.Delete("/:id/members/:userId", orgHandler.RemoveMember)
 	orgs.Put("/:id/members/:userId", orgHandler.UpdateMemberRole)
 
+	// Organization role routes
+	orgs.Get("/:id/roles", orgHandler.GetRoles)
+	orgs.Post("/:id/roles", orgHandler.CreateRole)
+	orgs.Put("/:id/roles/:name", orgHandler.UpdateRole)
+	orgs.Delete("/:id/roles/:name", orgHandler.DeleteRole)
+	orgs.Get("/:id/permissions", orgHandler.GetMyPermissions)
+
+	// Organization ownership transfer routes
+	orgs.Post("/:id/ownership-transfer", orgHandler.ProposeOwnershipTransfer)
+	orgs.Get("/:id/ownership-transfer", orgHandler.GetOwnershipTransfer)
+	orgs.Delete("/:id/ownership-transfer", orgHandler.CancelOwnershipTransfer)
+	orgs.Post("/:id/ownership-transfer/accept", orgHandler.AcceptOwnershipTransfer)
+	orgs.Post("/:id/ownership-transfer/decline", orgHandler.DeclineOwnershipTransfer)
+
+	// Organization audit log routes
+	orgs.Get("/:id/audit-logs", auditHandler.GetAuditLogs)
+
+	// Organization API key routes
+	orgs.Post("/:id/api-keys", apiKeyHandler.CreateAPIKey)
+	orgs.Get("/:id/api-keys", apiKeyHandler.GetAPIKeys)
+	orgs.Delete("/:id/api-keys/:keyId", apiKeyHandler.RevokeAPIKey)
+
+	// Organization webhook routes
+	orgs.Post("/:id/webhooks", webhookHandler.CreateEndpoint)
+	orgs.Get("/:id/webhooks", webhookHandler.GetEndpoints)
+	orgs.Put("/:id/webhooks/:webhookId", webhookHandler.UpdateEndpoint)
+	orgs.Delete("/:id/webhooks/:webhookId", webhookHandler.DeleteEndpoint)
+	orgs.Get("/:id/webhooks/:webhookId/deliveries", webhookHandler.GetDeliveries)
+	orgs.Post("/:id/webhook-deliveries/:deliveryId/replay", webhookHandler.ReplayDelivery)
+
+	// Organization invitation routes
+	orgs.Post("/:id/invitations", invitationHandler.Invite)
+	orgs.Get("/:id/invitations", invitationHandler.GetPendingInvitations)
+	orgs.Delete("/:id/invitations/:invitationId", invitationHandler.RevokeInvitation)
+
 	// Organization events routes
 	orgs.Post("/:orgId/events", eventHandler.CreateEvent)
 	orgs.Get("/:orgId/events", eventHandler.GetOrganizationEvents)
+	orgs.Get("/:orgId/events/review-queue", eventHandler.GetReviewQueue)
+
+	// Organization event series routes
+	orgs.Post("/:orgId/series", seriesHandler.CreateSeries)
+	orgs.Get("/:orgId/series", seriesHandler.GetOrganizationSeries)
+
+	// Organization venue routes
+	orgs.Post("/:orgId/venues", venueHandler.CreateVenue)
+	orgs.Get("/:orgId/venues", venueHandler.GetOrganizationVenues)
+
+	// Venue routes; creating and listing at the root is for platform-wide venues
+	venues := api.Group("/venues")
+	venues.Post("/", venueHandler.CreatePlatformVenue)
+	venues.Get("/", venueHandler.GetPlatformVenues)
+	venues.Get("/:id", venueHandler.GetVenue)
+	venues.Put("/:id", venueHandler.UpdateVenue)
+	venues.Delete("/:id", venueHandler.DeleteVenue)
+
+	// Event series routes
+	series := api.Group("/series")
+	series.Get("/:id", seriesHandler.GetSeries)
+	series.Post("/:id/submit", seriesHandler.SubmitSeriesForReview)
+	series.Post("/:id/approve", seriesHandler.ApproveSeries)
+	series.Post("/:id/request-changes", seriesHandler.RequestSeriesChanges)
+	series.Post("/:id/reject", seriesHandler.RejectSeries)
+
+	// Invitation routes (invitee side)
+	invitations := api.Group("/invitations")
+	invitations.Get("/my", invitationHandler.GetMyInvitations)
+	invitations.Post("/accept", invitationHandler.AcceptByToken)
+	invitations.Post("/decline", invitationHandler.DeclineByToken)
+	invitations.Post("/:id/decline", invitationHandler.Decline)
 
 	// Event routes
 	events := api.Group("/events")
 	events.Get("/:id", eventHandler.GetEvent)
 	events.Put("/:id", eventHandler.UpdateEvent)
 	events.Delete("/:id", eventHandler.DeleteEvent)
+	events.Get("/:id/history", eventHandler.GetEventHistory)
+	events.Get("/:id/preview", eventHandler.PreviewEvent)
+	events.Put("/:id/future", seriesHandler.UpdateFutureOccurrences)
+
 	events.Get("/:eventId/payments", paymentHandler.GetEventPayments)
 	events.Get("/:eventId/attendees", paymentHandler.GetEventAttendees)
+	events.Get("/:eventId/attendees/export", attendeeHandler.ExportAttendees)
+	events.Post("/:eventId/attendees/:paymentId/check-in", attendeeHandler.CheckIn)
+	events.Delete("/:eventId/attendees/:paymentId/check-in", attendeeHandler.UndoCheckIn)
+
+	// Event cancellation routes
+	events.Post("/:id/cancel", cancellationHandler.CancelEvent)
+	events.Get("/:id/cancellation", cancellationHandler.GetCancellation)
+	events.Get("/:id/cancellation/refunds", cancellationHandler.GetCancellationRefunds)
+	events.Post("/:id/cancellation/retry", cancellationHandler.RetryFailedRefunds)
+	events.Post("/:id/cancellation/refunds/:refundId/confirm", cancellationHandler.ConfirmManualRefund)
+
+	// Event approval workflow routes
+	events.Post("/:id/submit", eventHandler.SubmitEventForReview)
+	events.Post("/:id/approve", eventHandler.ApproveEvent)
+	events.Post("/:id/request-changes", eventHandler.RequestEventChanges)
+	events.Post("/:id/reject", eventHandler.RejectEvent)
+
+	// Box-office routes (on-site sales by organization staff)
+	events.Post("/:eventId/box-office/sales", boxOfficeHandler.RecordSale)
+	events.Get("/:eventId/box-office/report", boxOfficeHandler.GetCashUpReport)
+
+	// Favorite routes (any signed-in user, public events only)
+	events.Post("/:eventId/favorite", favoriteHandler.FavoriteEvent)
+	events.Delete("/:eventId/favorite", favoriteHandler.UnfavoriteEvent)
+
+	// RSVP routes (free events)
+	events.Put("/:eventId/rsvp", rsvpHandler.Respond)
+	events.Get("/:eventId/rsvp", rsvpHandler.GetMyRsvp)
+	events.Get("/:eventId/rsvps", rsvpHandler.GetEventRsvps)
+	events.Post("/:eventId/rsvps/:rsvpId/approve", rsvpHandler.ApproveRsvp)
+	events.Post("/:eventId/rsvps/:rsvpId/decline", rsvpHandler.DeclineRsvp)
+
+	// Registration form routes
+	events.Get("/:eventId/questions", registrationHandler.GetForm)
+	events.Put("/:eventId/questions", registrationHandler.UpdateForm)
 
 	// Payment routes
 	payments := api.Group("/payments")
@@ -129,17 +308,69 @@ func main() {
 	payments.Get("/order/:orderId", paymentHandler.GetPaymentByOrderID)
 	payments.Post("/complete", paymentHandler.CompletePayment)
 	payments.Delete("/:id", paymentHandler.CancelPayment)
+	payments.Post("/:id/date-change-refund", paymentHandler.RefundForDateChange)
+
+	// Integration routes (organization API key or user token)
+	integrations := app.Group("/integrations", apiKeyMiddleware.Authenticate)
+	integrations.Get("/organizations/:orgId/events", eventHandler.GetOrganizationEvents)
+	integrations.Get("/events/:id", eventHandler.GetEvent)
+	integrations.Get("/events/:eventId/attendees", paymentHandler.GetEventAttendees)
+	integrations.Get("/events/:eventId/attendees/export", attendeeHandler.ExportAttendees)
+	integrations.Post("/events/:eventId/attendees/:paymentId/check-in", attendeeHandler.CheckIn)
+	integrations.Delete("/events/:eventId/attendees/:paymentId/check-in", attendeeHandler.UndoCheckIn)
 
 	// Public event routes (no authentication)
 	publicEvents := app.Group("/public/events")
 	publicEvents.Get("/", eventHandler.GetPublicEvents)
 	publicEvents.Get("/upcoming", eventHandler.GetUpcomingEvents)
 	publicEvents.Get("/popular", eventHandler.GetPopularEvents)
+	publicEvents.Get("/nearby", eventHandler.GetNearbyEvents)
 	publicEvents.Get("/search", eventHandler.SearchEvents)
+	publicEvents.Get("/suggest", searchHandler.Suggest)
+	publicEvents.Get("/trending-keywords", searchHandler.GetTrendingKeywords)
 	publicEvents.Get("/:id", eventHandler.GetPublicEvent)
+	publicEvents.Get("/:eventId/questions", registrationHandler.GetForm)
+
+	// Public event series routes (no authentication)
+	app.Get("/public/series/:id", seriesHandler.GetPublicSeries)
+
+	// Public venue routes (no authentication)
+	app.Get("/public/venues/:id", venueHandler.GetPublicVenue)
+
+	// Public organization routes (no authentication)
+	publicOrgs := app.Group("/public/organizations")
+	publicOrgs.Get("/", orgHandler.GetPublicOrganizations)
+	publicOrgs.Get("/:slug", orgHandler.GetPublicOrganization)
+
+	// Background workers
+	webhookWorker := usecase.NewWebhookWorker(webhookRepo, webhookQueue)
+	go webhookWorker.Run(context.Background())
+
+	eventScheduler := usecase.NewEventScheduler(eventRepo, popularityUseCase)
+	go eventScheduler.Run(context.Background())
+
+	cancellationWorker := usecase.NewCancellationWorker(cancellationRepo, paymentRepo, eventRepo, userRepo, paymentGateway, mailer, webhookUseCase)
+	go cancellationWorker.Run(context.Background())
 
 	log.Println("Server starting on :3000")
 	if err = app.Listen(":3000"); err != nil {
 		log.Fatalf("failed starting server %v", err)
 	}
 }
+
+// parseUserIDs parses a comma-separated list of user IDs
+func parseUserIDs(value string) ([]uuid.UUID, error) {
+	var ids []uuid.UUID
+	for _, part := range strings.Split(value, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		id, err := uuid.Parse(part)
+		if err != nil {
+			return nil, fmt.Errorf("invalid user ID %q: %w", part, err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
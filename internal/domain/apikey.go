@@ -0,0 +1,69 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// APIKeyPrefix starts every organization API key, so keys can be told apart from user tokens
+const APIKeyPrefix = "tkly"
+
+// API key scopes
+const (
+	ScopeEventsRead    = "events:read"
+	ScopeAttendeesRead = "attendees:read"
+	ScopeCheckInsWrite = "checkins:write"
+)
+
+// APIKeyScopes maps each scope to the organization permissions it grants
+var APIKeyScopes = map[string][]Permission{
+	ScopeEventsRead:    {PermissionEventView},
+	ScopeAttendeesRead: {PermissionAttendeeView, PermissionAttendeeExport},
+	ScopeCheckInsWrite: {PermissionAttendeeCheckIn},
+}
+
+// ScopePermissions returns the permissions granted by a set of scopes
+func ScopePermissions(scopes []string) []Permission {
+	var permissions []Permission
+	for _, scope := range scopes {
+		permissions = append(permissions, APIKeyScopes[scope]...)
+	}
+	return permissions
+}
+
+// APIKey lets an organization's own servers call the API without a user session
+// Only the prefix and a hash of the secret are stored; the full key is shown once at creation
+type APIKey struct {
+	ID             uuid.UUID  `json:"id"`
+	OrganizationID uuid.UUID  `json:"organization_id"`
+	Name           string     `json:"name"`
+	Prefix         string     `json:"prefix"`
+	SecretHash     string     `json:"-"`
+	Scopes         []string   `json:"scopes"`
+	CreatedBy      uuid.UUID  `json:"created_by"`
+	ExpiresAt      *time.Time `json:"expires_at,omitempty"`
+	LastUsedAt     *time.Time `json:"last_used_at,omitempty"`
+	RevokedAt      *time.Time `json:"revoked_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// IsActive checks if the key has neither been revoked nor expired
+func (k *APIKey) IsActive() bool {
+	if k.RevokedAt != nil {
+		return false
+	}
+	return k.ExpiresAt == nil || time.Now().Before(*k.ExpiresAt)
+}
+
+// APIKeyRepository defines the interface for API key data access
+type APIKeyRepository interface {
+	Create(ctx context.Context, key *APIKey) (*APIKey, error)
+	GetByID(keyID uuid.UUID) (*APIKey, error)
+	GetByPrefix(prefix string) (*APIKey, error)
+	GetByOrganizationID(orgID uuid.UUID) ([]*APIKey, error)
+	UpdateLastUsed(keyID uuid.UUID, usedAt time.Time) error
+	Revoke(ctx context.Context, keyID uuid.UUID) error
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// AuditEntry records an action taken on a resource
+type AuditEntry struct {
+	ID             uuid.UUID              `json:"id"`
+	OrganizationID *uuid.UUID             `json:"organization_id,omitempty"`
+	ActorID        *uuid.UUID             `json:"actor_id,omitempty"`
+	Action         string                 `json:"action"` // <target_type>.<created|updated|deleted>
+	TargetType     string                 `json:"target_type"`
+	TargetID       string                 `json:"target_id,omitempty"`
+	Before         map[string]interface{} `json:"before,omitempty"` // Changed fields before the action
+	After          map[string]interface{} `json:"after,omitempty"`  // Changed fields after the action
+	Metadata       map[string]interface{} `json:"metadata,omitempty"`
+	IP             string                 `json:"ip,omitempty"`
+	UserAgent      string                 `json:"user_agent,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
+// AuditActor identifies who is making a request
+// It travels in the request context down to the repositories, which is why
+// mutating repository methods take a context: the audit hook reads it there
+type AuditActor struct {
+	UserID    uuid.UUID
+	APIKeyID  *uuid.UUID // Set when the request was made with an API key created by UserID
+	IP        string
+	UserAgent string
+}
+
+type auditActorKey struct{}
+
+// WithAuditActor returns a copy of ctx carrying the actor
+func WithAuditActor(ctx context.Context, actor AuditActor) context.Context {
+	return context.WithValue(ctx, auditActorKey{}, actor)
+}
+
+// AuditActorFromContext returns the actor carried by ctx, if any
+// Changes made without an actor (background jobs) are recorded as system actions
+func AuditActorFromContext(ctx context.Context) (AuditActor, bool) {
+	actor, ok := ctx.Value(auditActorKey{}).(AuditActor)
+	return actor, ok
+}
+
+// AuditFilter narrows an organization's audit log; zero values match everything
+type AuditFilter struct {
+	OrganizationID uuid.UUID
+	ActorID        *uuid.UUID
+	Action         string
+	TargetType     string
+	TargetID       string
+	From           *time.Time
+	To             *time.Time
+}
+
+// AuditRepository defines the interface for audit log data access
+// The log is append-only: entries are written by the audit hook and never updated or deleted
+type AuditRepository interface {
+	List(filter AuditFilter, offset, limit int) ([]*AuditEntry, int, error)
+}
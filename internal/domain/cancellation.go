@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Event cancellation statuses
+const (
+	CancellationRunning   = "running"
+	CancellationCompleted = "completed"
+)
+
+// Refund methods
+const (
+	RefundGateway = "gateway" // Online payment refunded through the payment gateway
+	RefundManual  = "manual"  // Box-office sale the organizer refunds in person
+	RefundNone    = "none"    // Pending payment or free RSVP; nothing was charged
+)
+
+// Refund statuses
+const (
+	RefundPending   = "pending"
+	RefundSucceeded = "succeeded"
+	RefundFailed    = "failed"
+
+	// Manual refund the buyer was told about, until the organizer confirms the money was paid back
+	RefundAwaitingConfirmation = "awaiting_confirmation"
+)
+
+// EventCancellation is the background job that settles every order of a cancelled event
+type EventCancellation struct {
+	ID             uuid.UUID             `json:"id"`
+	EventID        uuid.UUID             `json:"event_id"`
+	OrganizationID uuid.UUID             `json:"organization_id"`
+	RequestedBy    uuid.UUID             `json:"requested_by"`
+	Reason         string                `json:"reason,omitempty"`
+	Status         string                `json:"status"` // running, completed
+	Progress       *CancellationProgress `json:"progress,omitempty"`
+	CompletedAt    *time.Time            `json:"completed_at,omitempty"`
+	CreatedAt      time.Time             `json:"created_at"`
+	UpdatedAt      time.Time             `json:"updated_at"`
+}
+
+// CancellationProgress counts the refunds of a cancellation by status
+type CancellationProgress struct {
+	Total                int `json:"total"`
+	Pending              int `json:"pending"`
+	AwaitingConfirmation int `json:"awaiting_confirmation"`
+	Succeeded            int `json:"succeeded"`
+	Failed               int `json:"failed"`
+}
+
+// CancellationRefund settles one payment of a cancelled event
+type CancellationRefund struct {
+	ID             uuid.UUID  `json:"id"`
+	CancellationID uuid.UUID  `json:"cancellation_id"`
+	PaymentID      uuid.UUID  `json:"payment_id"`
+	Method         string     `json:"method"` // gateway, manual, none
+	Status         string     `json:"status"` // pending, awaiting_confirmation, succeeded, failed
+	Attempts       int        `json:"attempts"`
+	LastError      string     `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	RefundedAt     *time.Time `json:"refunded_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// EventCancellationRepository defines the interface for event cancellation data access
+type EventCancellationRepository interface {
+	// Create stores a cancellation together with its refunds
+	Create(ctx context.Context, cancellation *EventCancellation, refunds []*CancellationRefund) (*EventCancellation, error)
+	GetByEventID(eventID uuid.UUID) (*EventCancellation, error)
+	GetRunning() ([]*EventCancellation, error)
+	GetProgress(cancellationID uuid.UUID) (*CancellationProgress, error)
+	Complete(ctx context.Context, cancellationID uuid.UUID, completedAt time.Time) error
+
+	// Refunds; status "" matches every refund
+	GetRefunds(cancellationID uuid.UUID, status string) ([]*CancellationRefund, error)
+	GetRefundByID(refundID uuid.UUID) (*CancellationRefund, error)
+	GetDueRefunds(cancellationID uuid.UUID, now time.Time) ([]*CancellationRefund, error)
+	UpdateRefund(ctx context.Context, refund *CancellationRefund) error
+
+	// RetryFailed moves failed refunds back to pending and reopens the cancellation
+	// It returns the number of refunds that will be retried
+	RetryFailed(ctx context.Context, cancellationID uuid.UUID) (int, error)
+}
@@ -17,4 +17,6 @@ var (
 	ErrTooManyRequests    = errors.New("너무 많은 요청입니다. 잠시 후 다시 시도해주세요.")
 	ErrTokenExpired       = errors.New("토큰이 만료되었습니다.")
 	ErrInvalidToken       = errors.New("유효하지 않은 토큰입니다.")
+	ErrConflict           = errors.New("현재 상태에서는 요청을 처리할 수 없습니다.")
+	ErrOrgInactive        = errors.New("비활성화된 조직입니다.")
 )
@@ -1,53 +1,250 @@
 package domain
 
 import (
+	"context"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 )
 
+// Event lifecycle statuses
+const (
+	EventDraft     = "draft"
+	EventPublished = "published"
+	EventOngoing   = "ongoing"
+	EventCompleted = "completed"
+	EventCancelled = "cancelled"
+)
+
+// eventTransitions lists the statuses each status may move to
+// Completed and cancelled events are final
+var eventTransitions = map[string][]string{
+	EventDraft:     {EventPublished, EventCancelled},
+	EventPublished: {EventOngoing, EventCancelled},
+	EventOngoing:   {EventCompleted, EventCancelled},
+}
+
+// CanTransitionEvent reports whether an event may move from one lifecycle status to another
+func CanTransitionEvent(from, to string) bool {
+	for _, next := range eventTransitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// IsFinalEventStatus reports whether an event's lifecycle has ended
+func IsFinalEventStatus(status string) bool {
+	return status == EventCompleted || status == EventCancelled
+}
+
+// Event review statuses
+const (
+	ReviewNotSubmitted     = "not_submitted"
+	ReviewPending          = "pending"
+	ReviewChangesRequested = "changes_requested"
+	ReviewApproved         = "approved"
+	ReviewRejected         = "rejected"
+)
+
+// EventReview is an event's state in its organization's approval workflow
+// Only approved events can be published
+type EventReview struct {
+	Status      string     `json:"status"` // not_submitted, pending, changes_requested, approved, rejected
+	Comment     string     `json:"comment,omitempty"`
+	SubmittedBy *uuid.UUID `json:"submitted_by,omitempty"`
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	ReviewedBy  *uuid.UUID `json:"reviewed_by,omitempty"`
+	ReviewedAt  *time.Time `json:"reviewed_at,omitempty"`
+}
+
+// Address is a structured postal address
+type Address struct {
+	Street     string `json:"street,omitempty"`
+	City       string `json:"city,omitempty"`
+	Region     string `json:"region,omitempty"` // State, province or 시/도
+	PostalCode string `json:"postal_code,omitempty"`
+	Country    string `json:"country,omitempty"` // ISO 3166-1 alpha-2 code such as KR
+}
+
+// String joins the parts of the address that are set
+func (a *Address) String() string {
+	if a == nil {
+		return ""
+	}
+	parts := make([]string, 0, 5)
+	for _, part := range []string{a.Street, a.City, a.Region, a.PostalCode, a.Country} {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// GeoPoint is a WGS 84 coordinate
+type GeoPoint struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
 type Event struct {
-	ID               uuid.UUID `json:"id"`
-	OrganizationID   uuid.UUID `json:"organization_id"`
-	Title            string    `json:"title"`
-	Description      string    `json:"description,omitempty"`
-	Location         string    `json:"location,omitempty"`
-	Venue            string    `json:"venue,omitempty"`
-	StartTime        time.Time `json:"start_time"`
-	EndTime          time.Time `json:"end_time"`
-	TotalTickets     int       `json:"total_tickets"`
-	AvailableTickets int       `json:"available_tickets"`
-	ParticipantCount int       `json:"participant_count"` // Real-time count based on completed payments
-	TicketPrice      float64   `json:"ticket_price"`
-	Currency         string    `json:"currency"`
-	ThumbnailURL     string    `json:"thumbnail_url,omitempty"`
-	Status           string    `json:"status"` // draft, published, ongoing, completed, cancelled
-	IsPublic         bool      `json:"is_public"`
-	CreatedBy        uuid.UUID `json:"created_by"`
-	CreatedAt        time.Time `json:"created_at"`
-	UpdatedAt        time.Time `json:"updated_at"`
+	ID               uuid.UUID    `json:"id"`
+	OrganizationID   uuid.UUID    `json:"organization_id"`
+	SeriesID         *uuid.UUID   `json:"series_id,omitempty"` // Set on occurrences of an event series
+	Title            string       `json:"title"`
+	Description      string       `json:"description,omitempty"`
+	Location         string       `json:"location,omitempty"`
+	Venue            string       `json:"venue,omitempty"`
+	VenueID          *uuid.UUID   `json:"venue_id,omitempty"`       // Saved venue; Venue, Address and Coordinates are copied from it
+	SeatingLayout    string       `json:"seating_layout,omitempty"` // Layout of the saved venue; empty for its full capacity
+	Address          *Address     `json:"address,omitempty"`
+	Coordinates      *GeoPoint    `json:"coordinates,omitempty"` // Events without coordinates are left out of nearby searches
+	Category         string       `json:"category,omitempty"`
+	StartTime        time.Time    `json:"start_time"`
+	EndTime          time.Time    `json:"end_time"`
+	TotalTickets     int          `json:"total_tickets"`
+	AvailableTickets int          `json:"available_tickets"`
+	ParticipantCount int          `json:"participant_count"` // Real-time count based on completed payments
+	TicketPrice      float64      `json:"ticket_price"`
+	Currency         string       `json:"currency"`
+	ThumbnailURL     string       `json:"thumbnail_url,omitempty"`
+	Status           string       `json:"status"` // draft, published, ongoing, completed, cancelled
+	IsPublic         bool         `json:"is_public"`
+	RequiresApproval bool         `json:"requires_approval"` // Free events only: RSVPs wait for organizer approval
+	Review           *EventReview `json:"review,omitempty"`  // Only shown to members with events:view
+
+	// Draft events are published by the scheduler at this time once approved
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// Set when the start time moves; until then ticket holders may get a full refund
+	DateChangeRefundUntil *time.Time `json:"date_change_refund_until,omitempty"`
+
+	CreatedBy uuid.UUID `json:"created_by"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// HasUnlimitedSeats reports whether the event is free without a ticket limit
+// Such events never sell out, so RSVPs do not reserve seats from the available tickets
+func (e *Event) HasUnlimitedSeats() bool {
+	return e.TicketPrice == 0 && e.TotalTickets == 0
+}
+
+// Event fields whose changes ticket holders are notified about
+const (
+	EventFieldStartTime = "start_time"
+	EventFieldLocation  = "location"
+	EventFieldVenue     = "venue"
+	EventFieldAddress   = "address"
+)
+
+// EventChange records a material change to a published event and the notification it caused
+type EventChange struct {
+	ID            uuid.UUID         `json:"id"`
+	EventID       uuid.UUID         `json:"event_id"`
+	ChangedBy     uuid.UUID         `json:"changed_by"`
+	Before        map[string]string `json:"before"`
+	After         map[string]string `json:"after"`
+	NotifiedCount int               `json:"notified_count"`
+	RefundUntil   *time.Time        `json:"refund_until,omitempty"` // Refund window opened by a date change
+	CreatedAt     time.Time         `json:"created_at"`
+}
+
+// EventChangeRepository defines the interface for event change history access
+type EventChangeRepository interface {
+	Create(ctx context.Context, change *EventChange) (*EventChange, error)
+	// GetByEventID returns an event's changes, newest first
+	GetByEventID(eventID uuid.UUID) ([]*EventChange, error)
 }
 
 type EventWithOrganization struct {
 	Event
 	OrganizationName string `json:"organization_name"`
+
+	// Set on search results: matched fields, HTML-escaped, with each search term wrapped in <em>
+	Highlights map[string]string `json:"highlights,omitempty"`
+	// Set on nearby results: kilometres from the searched point
+	DistanceKm *float64 `json:"distance_km,omitempty"`
+}
+
+// Public event listing sort orders
+const (
+	EventSortStartTime  = "start_time" // Soonest first
+	EventSortNewest     = "newest"     // Most recently created first
+	EventSortPriceAsc   = "price_asc"
+	EventSortPriceDesc  = "price_desc"
+	EventSortPopularity = "popularity" // Most participants first
+	EventSortRelevance  = "relevance"  // Best keyword match first; needs a keyword
+	EventSortDistance   = "distance"   // Closest first; needs a point
+)
+
+// EventListQuery filters, sorts and pages the public event listings; zero values match everything
+// Listings only ever include public, non-draft events of active organizations
+type EventListQuery struct {
+	Keyword        string // Full-text match on title, description, location, venue or organization name
+	Category       string
+	OrganizationID *uuid.UUID
+	StartFrom      *time.Time // Events starting at or after this time
+	StartTo        *time.Time // Events starting at or before this time
+	MinPrice       *float64
+	MaxPrice       *float64
+	Free           *bool  // true for free events only, false for paid events only
+	Available      bool   // Only events with tickets left
+	Location       string // Matches location or venue
+	Upcoming       bool   // Only published or ongoing events that have not started yet
+	Near           *GeoPoint
+	RadiusKm       float64 // Only events within this distance of Near; set together with Near
+
+	Sort   string // One of the EventSort orders
+	Cursor string // Returned with the previous page; empty for the first page
+	Limit  int
 }
 
 // EventRepository defines the interface for event data access
 type EventRepository interface {
 	// Event CRUD
-	Create(event *Event) (*Event, error)
+	Create(ctx context.Context, event *Event) (*Event, error)
 	GetByID(eventID uuid.UUID) (*Event, error)
 	GetByOrganizationID(orgID uuid.UUID) ([]*Event, error)
-	Update(event *Event) error
-	Delete(eventID uuid.UUID) error
+	// GetBySeriesID returns a series' occurrences in start time order
+	GetBySeriesID(seriesID uuid.UUID) ([]*Event, error)
+	// Update saves the event's fields and status if its status is still fromStatus, or returns ErrConflict
+	Update(ctx context.Context, event *Event, fromStatus string) error
+	Delete(ctx context.Context, eventID uuid.UUID) error
+
+	// UpdateStatus moves an event to another lifecycle status if its status is still fromStatus
+	// and returns ErrConflict otherwise; Update leaves the status untouched
+	UpdateStatus(ctx context.Context, eventID uuid.UUID, fromStatus, toStatus string) error
+
+	// PublishDueEvents publishes approved draft events whose publish time has passed and returns their IDs
+	PublishDueEvents(ctx context.Context, now time.Time) ([]uuid.UUID, error)
+	// StartDueEvents moves published events whose start time has passed to ongoing
+	// CompleteDueEvents moves published and ongoing events whose end time has passed to completed
+	// Both return the number of events moved
+	StartDueEvents(ctx context.Context, now time.Time) (int, error)
+	CompleteDueEvents(ctx context.Context, now time.Time) (int, error)
+
+	// UpdateReview moves an event in the approval workflow if its review status is still fromStatus
+	// and returns ErrConflict otherwise
+	UpdateReview(ctx context.Context, eventID uuid.UUID, fromStatus string, review *EventReview) error
 
 	// Queries
-	GetPublicEvents() ([]*EventWithOrganization, error)
+	// ListPublicEvents returns one page of public events and the cursor of the next page,
+	// which is empty on the last page; a malformed cursor returns ErrInvalidInput
+	ListPublicEvents(query EventListQuery) ([]*EventWithOrganization, string, error)
+	GetPublicEventsByOrganizationID(orgID uuid.UUID) ([]*Event, error)
+	// GetPublicEventsByIDs returns the events among eventIDs that a listing with query's filters would include,
+	// in the order of eventIDs
+	GetPublicEventsByIDs(eventIDs []uuid.UUID, query EventListQuery) ([]*EventWithOrganization, error)
+	// GetUpcomingPublicEventIDs returns the IDs among eventIDs of public events that are drafts or on sale and have not started yet
+	GetUpcomingPublicEventIDs(eventIDs []uuid.UUID) ([]uuid.UUID, error)
 	GetEventsByStatus(status string) ([]*EventWithOrganization, error)
-	GetUpcomingEvents() ([]*EventWithOrganization, error)
-	GetPopularEvents(threshold float64) ([]*EventWithOrganization, error)
-	SearchEvents(keyword string) ([]*EventWithOrganization, error)
+
+	// Search suggestions among public events that are on sale or running
+	// Both match words starting with prefix; a prefix containing standalone consonants such as ㅅㅇ matches initial consonants
+	SuggestTitles(prefix string, limit int) ([]*SearchSuggestion, error)
+	SuggestVenues(prefix string, limit int) ([]*SearchSuggestion, error)
 
 	// Ticket management
 	UpdateAvailableTickets(eventID uuid.UUID, tickets int) error
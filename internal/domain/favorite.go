@@ -0,0 +1,26 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// EventFavorite records that a user saved an event to come back to
+type EventFavorite struct {
+	ID        uuid.UUID `json:"id"`
+	UserID    uuid.UUID `json:"user_id"`
+	EventID   uuid.UUID `json:"event_id"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// EventFavoriteRepository defines the interface for event favorite data access
+type EventFavoriteRepository interface {
+	// Create returns ErrAlreadyExists if the user already favorited the event
+	Create(ctx context.Context, favorite *EventFavorite) (*EventFavorite, error)
+	Get(userID, eventID uuid.UUID) (*EventFavorite, error)
+	// GetByUserID returns a user's favorites, newest first
+	GetByUserID(userID uuid.UUID) ([]*EventFavorite, error)
+	Delete(ctx context.Context, favoriteID uuid.UUID) error
+}
@@ -0,0 +1,49 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Invitation statuses
+const (
+	InvitationPending  = "pending"
+	InvitationAccepted = "accepted"
+	InvitationDeclined = "declined"
+	InvitationRevoked  = "revoked"
+)
+
+// OrganizationInvitation invites an email address to join an organization with a role
+type OrganizationInvitation struct {
+	ID               uuid.UUID  `json:"id"`
+	OrganizationID   uuid.UUID  `json:"organization_id"`
+	OrganizationName string     `json:"organization_name,omitempty"`
+	Email            string     `json:"email"`
+	Role             string     `json:"role"`
+	InvitedBy        uuid.UUID  `json:"invited_by"`
+	Status           string     `json:"status"` // pending, accepted, declined, revoked
+	ExpiresAt        time.Time  `json:"expires_at"`
+	RespondedBy      *uuid.UUID `json:"responded_by,omitempty"`
+	RespondedAt      *time.Time `json:"responded_at,omitempty"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+}
+
+// IsExpired checks if the invitation can no longer be accepted
+func (i *OrganizationInvitation) IsExpired() bool {
+	return time.Now().After(i.ExpiresAt)
+}
+
+// InvitationRepository defines the interface for organization invitation data access
+type InvitationRepository interface {
+	Create(ctx context.Context, invitation *OrganizationInvitation) (*OrganizationInvitation, error)
+	GetByID(invitationID uuid.UUID) (*OrganizationInvitation, error)
+	GetPendingByOrgID(orgID uuid.UUID) ([]*OrganizationInvitation, error)
+	GetPendingByEmail(email string) ([]*OrganizationInvitation, error)
+	GetPendingByOrgIDAndEmail(orgID uuid.UUID, email string) (*OrganizationInvitation, error)
+	UpdateStatus(ctx context.Context, invitationID uuid.UUID, status string, respondedBy *uuid.UUID) error
+	// Accept marks a pending invitation accepted and adds the member in one transaction
+	Accept(ctx context.Context, invitationID uuid.UUID, member *OrganizationMember) error
+}
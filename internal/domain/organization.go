@@ -1,33 +1,56 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
 )
 
 type Organization struct {
-	ID          uuid.UUID `json:"id"`
-	Name        string    `json:"name"`
-	Description string    `json:"description,omitempty"`
-	LogoURL     string    `json:"logo_url,omitempty"`
-	Category    string    `json:"category,omitempty"`
-	OwnerID     uuid.UUID `json:"owner_id"`
-	IsActive    bool      `json:"is_active"`
-	CreatedAt   time.Time `json:"created_at"`
-	UpdatedAt   time.Time `json:"updated_at"`
+	ID          uuid.UUID         `json:"id"`
+	Name        string            `json:"name"`
+	Slug        string            `json:"slug,omitempty"` // URL slug of the public profile
+	Description string            `json:"description,omitempty"`
+	LogoURL     string            `json:"logo_url,omitempty"`
+	Category    string            `json:"category,omitempty"`
+	SocialLinks map[string]string `json:"social_links,omitempty"` // Keyed by network (website, instagram, x, ...)
+	OwnerID     uuid.UUID         `json:"owner_id"`
+	IsActive    bool              `json:"is_active"`
+	CreatedAt   time.Time         `json:"created_at"`
+	UpdatedAt   time.Time         `json:"updated_at"`
+}
+
+// OrganizationProfile is the public profile of an organization with its public events
+type OrganizationProfile struct {
+	Organization   *Organization `json:"organization"`
+	UpcomingEvents []*Event      `json:"upcoming_events"`
+	PastEvents     []*Event      `json:"past_events"`
 }
 
 type OrganizationMember struct {
 	ID             uuid.UUID `json:"id"`
 	OrganizationID uuid.UUID `json:"organization_id"`
 	UserID         uuid.UUID `json:"user_id"`
-	Role           string    `json:"role"` // "admin" or "member"
+	Role           string    `json:"role"` // Built-in role or custom role name
 	JoinedAt       time.Time `json:"joined_at"`
 	CreatedAt      time.Time `json:"created_at"`
 	UpdatedAt      time.Time `json:"updated_at"`
 }
 
+// OrganizationRole is a role with a custom permission set defined by an organization
+// Built-in roles are listed alongside custom roles with BuiltIn set and a nil ID
+type OrganizationRole struct {
+	ID             uuid.UUID    `json:"id"`
+	OrganizationID uuid.UUID    `json:"organization_id"`
+	Name           string       `json:"name"`
+	Description    string       `json:"description,omitempty"`
+	Permissions    []Permission `json:"permissions"`
+	BuiltIn        bool         `json:"built_in"`
+	CreatedAt      time.Time    `json:"created_at"`
+	UpdatedAt      time.Time    `json:"updated_at"`
+}
+
 type OrganizationWithRole struct {
 	Organization
 	UserRole string `json:"user_role"`
@@ -36,18 +59,30 @@ type OrganizationWithRole struct {
 // OrganizationRepository defines the interface for organization data access
 type OrganizationRepository interface {
 	// Organization CRUD
-	Create(org *Organization) (*Organization, error)
+	Create(ctx context.Context, org *Organization) (*Organization, error)
 	GetByID(orgID uuid.UUID) (*Organization, error)
 	GetByOwnerID(ownerID uuid.UUID) ([]*Organization, error)
-	Update(org *Organization) error
-	Delete(orgID uuid.UUID) error
+	GetBySlug(slug string) (*Organization, error)
+	GetPublicOrganizations(category string) ([]*Organization, error)
+	// SuggestPublic matches public organizations like EventRepository.SuggestTitles
+	SuggestPublic(prefix string, limit int) ([]*SearchSuggestion, error)
+	Update(ctx context.Context, org *Organization) error
+	Delete(ctx context.Context, orgID uuid.UUID) error
 
 	// Member management
-	AddMember(member *OrganizationMember) error
-	RemoveMember(orgID, userID uuid.UUID) error
+	AddMember(ctx context.Context, member *OrganizationMember) error
+	RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error
 	GetMember(orgID, userID uuid.UUID) (*OrganizationMember, error)
 	GetMembersByOrgID(orgID uuid.UUID) ([]*OrganizationMember, error)
-	UpdateMemberRole(orgID, userID uuid.UUID, role string) error
+	UpdateMemberRole(ctx context.Context, orgID, userID uuid.UUID, role string) error
+	CountMembersWithRole(orgID uuid.UUID, role string) (int, error)
+
+	// Custom roles
+	CreateRole(ctx context.Context, role *OrganizationRole) (*OrganizationRole, error)
+	GetRoles(orgID uuid.UUID) ([]*OrganizationRole, error)
+	GetRoleByName(orgID uuid.UUID, name string) (*OrganizationRole, error)
+	UpdateRole(ctx context.Context, role *OrganizationRole) error
+	DeleteRole(ctx context.Context, roleID uuid.UUID) error
 
 	// Role checking
 	IsUserAdmin(orgID, userID uuid.UUID) (bool, error)
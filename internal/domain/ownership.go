@@ -0,0 +1,45 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Ownership transfer statuses
+const (
+	TransferPending   = "pending"
+	TransferAccepted  = "accepted"
+	TransferDeclined  = "declined"
+	TransferCancelled = "cancelled"
+)
+
+// OwnershipTransfer is an owner's proposal to hand an organization over to one of its admins
+type OwnershipTransfer struct {
+	ID             uuid.UUID  `json:"id"`
+	OrganizationID uuid.UUID  `json:"organization_id"`
+	FromUserID     uuid.UUID  `json:"from_user_id"`
+	ToUserID       uuid.UUID  `json:"to_user_id"`
+	Status         string     `json:"status"` // pending, accepted, declined, cancelled
+	ExpiresAt      time.Time  `json:"expires_at"`
+	RespondedAt    *time.Time `json:"responded_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// IsExpired checks if the transfer can no longer be accepted
+func (t *OwnershipTransfer) IsExpired() bool {
+	return time.Now().After(t.ExpiresAt)
+}
+
+// OwnershipTransferRepository defines the interface for ownership transfer data access
+type OwnershipTransferRepository interface {
+	Create(ctx context.Context, transfer *OwnershipTransfer) (*OwnershipTransfer, error)
+	GetPendingByOrgID(orgID uuid.UUID) (*OwnershipTransfer, error)
+	UpdateStatus(ctx context.Context, transferID uuid.UUID, status string) error
+
+	// Complete accepts a pending transfer, moves owner_id and swaps the owner and admin roles in a single transaction
+	// The audit hook records each of these changes, within the same transaction
+	Complete(ctx context.Context, transfer *OwnershipTransfer) error
+}
@@ -1,6 +1,7 @@
 package domain
 
 import (
+	"context"
 	"time"
 
 	"github.com/google/uuid"
@@ -19,7 +20,12 @@ type Payment struct {
 	BuyerPhone     string     `json:"buyer_phone"`
 	PaymentKey     string     `json:"payment_key,omitempty"`
 	OrderID        string     `json:"order_id,omitempty"`
-	Status         string     `json:"status"` // pending, completed, failed, cancelled, refunded
+	Status         string     `json:"status"`                   // pending, completed, failed, cancelled, refunded
+	Channel        string     `json:"channel"`                  // online, box_office
+	PaymentMethod  string     `json:"payment_method"`           // gateway, cash, card_terminal, transfer
+	OperatorID     *uuid.UUID `json:"operator_id,omitempty"`    // Staff user who recorded a box-office sale
+	ReceiptNumber  string     `json:"receipt_number,omitempty"` // Receipt number for a box-office sale
+	CheckedInAt    *time.Time `json:"checked_in_at,omitempty"`
 	CreatedAt      time.Time  `json:"created_at"`
 	UpdatedAt      time.Time  `json:"updated_at"`
 }
@@ -31,26 +37,95 @@ type PaymentWithEvent struct {
 
 // Attendee represents a participant who completed payment
 type Attendee struct {
-	PaymentID      uuid.UUID  `json:"payment_id"`
-	UserID         *uuid.UUID `json:"user_id,omitempty"`
-	BuyerName      string     `json:"buyer_name"`
-	BuyerEmail     string     `json:"buyer_email"`
-	BuyerPhone     string     `json:"buyer_phone"`
-	TicketQuantity int        `json:"ticket_quantity"`
-	TotalPrice     float64    `json:"total_price"`
-	Currency       string     `json:"currency"`
-	OrderID        string     `json:"order_id"`
-	PurchasedAt    time.Time  `json:"purchased_at"`
+	PaymentID      uuid.UUID             `json:"payment_id"`
+	UserID         *uuid.UUID            `json:"user_id,omitempty"`
+	BuyerName      string                `json:"buyer_name"`
+	BuyerEmail     string                `json:"buyer_email"`
+	BuyerPhone     string                `json:"buyer_phone"`
+	TicketQuantity int                   `json:"ticket_quantity"`
+	TotalPrice     float64               `json:"total_price"`
+	Currency       string                `json:"currency"`
+	OrderID        string                `json:"order_id"`
+	Channel        string                `json:"channel"` // online, box_office, rsvp
+	CheckedInAt    *time.Time            `json:"checked_in_at,omitempty"`
+	Answers        []*RegistrationAnswer `json:"answers,omitempty"`
+	PurchasedAt    time.Time             `json:"purchased_at"`
+}
+
+// PaymentFilter narrows payment listings; zero values match everything
+type PaymentFilter struct {
+	Statuses  []string
+	CheckedIn *bool
+}
+
+// RSVP statuses for free events, stored on the backing payment
+// going = completed, pending = pending, not_going = cancelled, declined = failed
+const (
+	RsvpStatusGoing    = "going"
+	RsvpStatusPending  = "pending"
+	RsvpStatusNotGoing = "not_going"
+	RsvpStatusDeclined = "declined"
+)
+
+// Rsvp is a reservation for a free event, backed by a zero-priced payment
+type Rsvp struct {
+	Payment
+	RsvpStatus string `json:"rsvp_status"`
+}
+
+// NewRsvp wraps an RSVP payment with its derived RSVP status
+func NewRsvp(p *Payment) *Rsvp {
+	status := RsvpStatusNotGoing
+	switch p.Status {
+	case "completed":
+		status = RsvpStatusGoing
+	case "pending":
+		status = RsvpStatusPending
+	case "failed":
+		status = RsvpStatusDeclined
+	}
+
+	return &Rsvp{
+		Payment:    *p,
+		RsvpStatus: status,
+	}
+}
+
+// OperatorCashUp summarizes the box-office sales recorded by a single operator
+type OperatorCashUp struct {
+	OperatorID     uuid.UUID          `json:"operator_id"`
+	SaleCount      int                `json:"sale_count"`
+	TicketQuantity int                `json:"ticket_quantity"`
+	TotalAmount    float64            `json:"total_amount"`
+	AmountByMethod map[string]float64 `json:"amount_by_method"` // cash, card_terminal, transfer
+}
+
+// CashUpReport is the end-of-night box-office report for an event
+type CashUpReport struct {
+	EventID        uuid.UUID          `json:"event_id"`
+	From           time.Time          `json:"from"`
+	To             time.Time          `json:"to"`
+	Currency       string             `json:"currency"`
+	Operators      []*OperatorCashUp  `json:"operators"`
+	SaleCount      int                `json:"sale_count"`
+	TicketQuantity int                `json:"ticket_quantity"`
+	TotalAmount    float64            `json:"total_amount"`
+	AmountByMethod map[string]float64 `json:"amount_by_method"`
 }
 
 // PaymentRepository defines the interface for payment data access
 type PaymentRepository interface {
-	Create(payment *Payment) (*Payment, error)
+	Create(ctx context.Context, payment *Payment) (*Payment, error)
 	GetByID(paymentID uuid.UUID) (*Payment, error)
 	GetByOrderID(orderID string) (*Payment, error)
 	GetByUserID(userID uuid.UUID) ([]*Payment, error)
 	GetByEventID(eventID uuid.UUID) ([]*Payment, error)
+	GetByEventIDAndChannel(eventID uuid.UUID, channel string) ([]*Payment, error)
+	GetLatestByEventIDAndUserID(eventID, userID uuid.UUID, channel string) (*Payment, error)
 	GetCompletedPaymentsByEventID(eventID uuid.UUID) ([]*Payment, error)
-	UpdateStatus(paymentID uuid.UUID, status string, paymentKey string) error
+	GetByEventIDFiltered(eventID uuid.UUID, filter PaymentFilter, offset, limit int) ([]*Payment, error)
+	UpdateStatus(ctx context.Context, paymentID uuid.UUID, status string, paymentKey string) error
+	UpdateCheckIn(ctx context.Context, paymentID uuid.UUID, checkedInAt *time.Time) error
 	GetParticipantCountByEventID(eventID uuid.UUID) (int, error)
+	GetBoxOfficeSalesByEventID(eventID uuid.UUID, from, to time.Time) ([]*Payment, error)
 }
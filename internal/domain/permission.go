@@ -0,0 +1,155 @@
+package domain
+
+// Built-in organization roles
+const (
+	RoleOwner        = "owner"
+	RoleAdmin        = "admin"
+	RoleEventManager = "event_manager"
+	RoleFinance      = "finance"
+	RoleCheckinStaff = "checkin_staff"
+	RoleViewer       = "viewer"
+
+	// RoleLegacyMember is the member role used before fine-grained roles and grants viewer permissions
+	RoleLegacyMember = "member"
+)
+
+// Permission is an action a member may perform within an organization
+type Permission string
+
+const (
+	// Organization
+	PermissionOrgUpdate     Permission = "organization:update"
+	PermissionOrgDelete     Permission = "organization:delete"
+	PermissionMemberView    Permission = "members:view"
+	PermissionMemberManage  Permission = "members:manage"
+	PermissionRoleManage    Permission = "roles:manage"
+	PermissionAuditView     Permission = "audit:view"
+	PermissionAPIKeyManage  Permission = "api_keys:manage"
+	PermissionWebhookManage Permission = "webhooks:manage"
+
+	// Events
+	PermissionEventView          Permission = "events:view" // Private and draft events
+	PermissionEventCreate        Permission = "events:create"
+	PermissionEventUpdate        Permission = "events:update"
+	PermissionEventDelete        Permission = "events:delete"
+	PermissionEventApprove       Permission = "events:approve"
+	PermissionVenueManage        Permission = "venues:manage"
+	PermissionRegistrationManage Permission = "registration:manage"
+	PermissionRsvpManage         Permission = "rsvp:manage"
+
+	// Payments
+	PermissionPaymentView     Permission = "payments:view"
+	PermissionPaymentRefund   Permission = "payments:refund"
+	PermissionBoxOfficeSell   Permission = "box_office:sell"
+	PermissionBoxOfficeReport Permission = "box_office:report"
+
+	// Attendees
+	PermissionAttendeeView    Permission = "attendees:view"
+	PermissionAttendeeExport  Permission = "attendees:export"
+	PermissionAttendeeCheckIn Permission = "attendees:check_in"
+)
+
+// AllPermissions lists every permission that can be granted to a role
+var AllPermissions = []Permission{
+	PermissionOrgUpdate,
+	PermissionOrgDelete,
+	PermissionMemberView,
+	PermissionMemberManage,
+	PermissionRoleManage,
+	PermissionAuditView,
+	PermissionAPIKeyManage,
+	PermissionWebhookManage,
+	PermissionEventView,
+	PermissionEventCreate,
+	PermissionEventUpdate,
+	PermissionEventDelete,
+	PermissionEventApprove,
+	PermissionVenueManage,
+	PermissionRegistrationManage,
+	PermissionRsvpManage,
+	PermissionPaymentView,
+	PermissionPaymentRefund,
+	PermissionBoxOfficeSell,
+	PermissionBoxOfficeReport,
+	PermissionAttendeeView,
+	PermissionAttendeeExport,
+	PermissionAttendeeCheckIn,
+}
+
+// BuiltInRoles maps each built-in role to the permissions it grants
+var BuiltInRoles = map[string][]Permission{
+	RoleOwner: AllPermissions,
+	RoleAdmin: {
+		PermissionOrgUpdate,
+		PermissionMemberView,
+		PermissionMemberManage,
+		PermissionRoleManage,
+		PermissionAuditView,
+		PermissionAPIKeyManage,
+		PermissionWebhookManage,
+		PermissionEventView,
+		PermissionEventCreate,
+		PermissionEventUpdate,
+		PermissionEventDelete,
+		PermissionEventApprove,
+		PermissionVenueManage,
+		PermissionRegistrationManage,
+		PermissionRsvpManage,
+		PermissionPaymentView,
+		PermissionPaymentRefund,
+		PermissionBoxOfficeSell,
+		PermissionBoxOfficeReport,
+		PermissionAttendeeView,
+		PermissionAttendeeExport,
+		PermissionAttendeeCheckIn,
+	},
+	RoleEventManager: {
+		PermissionMemberView,
+		PermissionEventView,
+		PermissionEventCreate,
+		PermissionEventUpdate,
+		PermissionEventDelete,
+		PermissionVenueManage,
+		PermissionRegistrationManage,
+		PermissionRsvpManage,
+		PermissionAttendeeView,
+		PermissionAttendeeCheckIn,
+	},
+	RoleFinance: {
+		PermissionMemberView,
+		PermissionEventView,
+		PermissionPaymentView,
+		PermissionPaymentRefund,
+		PermissionBoxOfficeReport,
+		PermissionAttendeeView,
+	},
+	RoleCheckinStaff: {
+		PermissionEventView,
+		PermissionBoxOfficeSell,
+		PermissionAttendeeView,
+		PermissionAttendeeCheckIn,
+	},
+	RoleViewer: {
+		PermissionMemberView,
+		PermissionEventView,
+	},
+}
+
+// BuiltInRolePermissions returns the permissions of a built-in role
+func BuiltInRolePermissions(role string) ([]Permission, bool) {
+	if role == RoleLegacyMember {
+		role = RoleViewer
+	}
+	permissions, ok := BuiltInRoles[role]
+	return permissions, ok
+}
+
+// IsValidPermission checks if a permission exists
+func IsValidPermission(permission Permission) bool {
+	for _, p := range AllPermissions {
+		if p == permission {
+			return true
+		}
+	}
+	return false
+}
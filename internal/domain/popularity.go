@@ -0,0 +1,22 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// PopularityRanking keeps a running popularity score for every upcoming event
+// Scores decay over time, so weight added now outranks the same weight added days ago
+type PopularityRanking interface {
+	// Add adds weight to an event's score as of at; a negative weight takes back weight added at the same time
+	Add(eventID uuid.UUID, weight float64, at time.Time) error
+	// Page returns ranked event IDs starting at offset, most popular first
+	Page(offset, limit int) ([]uuid.UUID, error)
+	// Remove drops events that can no longer be popular, such as started or deleted ones
+	Remove(eventIDs []uuid.UUID) error
+	// Size returns the number of ranked events
+	Size() (int64, error)
+	// FirstView reports whether viewer is viewing the event for the first time within window, and marks it viewed
+	FirstView(eventID uuid.UUID, viewer string, window time.Duration) (bool, error)
+}
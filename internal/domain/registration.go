@@ -0,0 +1,42 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// RegistrationQuestion is a custom question on an event's registration form
+type RegistrationQuestion struct {
+	ID        uuid.UUID `json:"id"`
+	EventID   uuid.UUID `json:"event_id"`
+	Label     string    `json:"label"`
+	Type      string    `json:"type"` // text, select, multi_select, checkbox, date
+	Options   []string  `json:"options,omitempty"`
+	Required  bool      `json:"required"`
+	Position  int       `json:"position"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// RegistrationAnswer is an attendee's answer to a registration question for a single ticket
+type RegistrationAnswer struct {
+	ID            uuid.UUID `json:"id"`
+	PaymentID     uuid.UUID `json:"payment_id"`
+	TicketIndex   int       `json:"ticket_index"`
+	QuestionID    uuid.UUID `json:"question_id"`
+	QuestionLabel string    `json:"question_label"`
+	Values        []string  `json:"values"`
+	CreatedAt     time.Time `json:"created_at"`
+}
+
+// RegistrationRepository defines the interface for registration form data access
+type RegistrationRepository interface {
+	// Questions
+	ReplaceQuestions(eventID uuid.UUID, questions []*RegistrationQuestion) ([]*RegistrationQuestion, error)
+	GetQuestionsByEventID(eventID uuid.UUID) ([]*RegistrationQuestion, error)
+
+	// Answers
+	SaveAnswers(answers []*RegistrationAnswer) error
+	GetAnswersByPaymentIDs(paymentIDs []uuid.UUID) ([]*RegistrationAnswer, error)
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Search suggestion types
+const (
+	SuggestionEvent        = "event"
+	SuggestionVenue        = "venue"
+	SuggestionOrganization = "organization"
+)
+
+// SearchSuggestion is one autocomplete entry for the search box
+type SearchSuggestion struct {
+	Type             string     `json:"type"`
+	Text             string     `json:"text"`
+	EventID          *uuid.UUID `json:"event_id,omitempty"`          // Set for event suggestions
+	OrganizationSlug string     `json:"organization_slug,omitempty"` // Set for organization suggestions
+}
+
+// TrendingKeyword is a search keyword with its decayed search count
+type TrendingKeyword struct {
+	Keyword string  `json:"keyword"`
+	Score   float64 `json:"score"`
+}
+
+// SearchTrends counts search keywords over time
+type SearchTrends interface {
+	Record(keyword string, at time.Time) error
+	// Top returns the most searched keywords as of at, recent searches weighing more than older ones
+	Top(limit int, at time.Time) ([]*TrendingKeyword, error)
+}
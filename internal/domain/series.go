@@ -0,0 +1,226 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Recurrence frequencies
+const (
+	RecurrenceWeekly  = "weekly"
+	RecurrenceMonthly = "monthly"
+	RecurrenceCustom  = "custom"
+)
+
+// MaxSeriesOccurrences limits how many events a series may generate
+const MaxSeriesOccurrences = 366
+
+var recurrenceWeekdays = map[string]time.Weekday{
+	"MO": time.Monday,
+	"TU": time.Tuesday,
+	"WE": time.Wednesday,
+	"TH": time.Thursday,
+	"FR": time.Friday,
+	"SA": time.Saturday,
+	"SU": time.Sunday,
+}
+
+// Recurrence describes when the occurrences of a series take place, modelled on iCalendar RRULE
+// Weekly and monthly rules need count or until; custom rules list every extra start time in dates
+type Recurrence struct {
+	Frequency  string      `json:"frequency"`            // weekly, monthly, custom
+	Interval   int         `json:"interval,omitempty"`   // Every n weeks or months; defaults to 1
+	Weekdays   []string    `json:"weekdays,omitempty"`   // Weekly only: MO, TU, WE, TH, FR, SA, SU; defaults to the first start's weekday
+	Count      int         `json:"count,omitempty"`      // Number of occurrences, counted before exclusions as in RRULE
+	Until      *time.Time  `json:"until,omitempty"`      // No occurrence starts after this time
+	Dates      []time.Time `json:"dates,omitempty"`      // Custom only: start times besides the first
+	Exclusions []string    `json:"exclusions,omitempty"` // Dates (YYYY-MM-DD) without an occurrence
+}
+
+// Occurrences expands the rule into the start times of every occurrence, beginning with first
+// Dates are computed in first's time zone so occurrences keep their local time of day
+func (r *Recurrence) Occurrences(first time.Time) ([]time.Time, error) {
+	interval := r.Interval
+	if interval == 0 {
+		interval = 1
+	}
+	if interval < 0 {
+		return nil, errors.New("recurrence interval must be positive")
+	}
+	if r.Count < 0 {
+		return nil, errors.New("recurrence count must be positive")
+	}
+	if r.Frequency != RecurrenceCustom && r.Count == 0 && r.Until == nil {
+		return nil, errors.New("recurrence needs a count or an until time")
+	}
+	if r.Until != nil && r.Until.Before(first) {
+		return nil, errors.New("recurrence until must be after the first start")
+	}
+
+	excluded := make(map[string]bool, len(r.Exclusions))
+	for _, date := range r.Exclusions {
+		if _, err := time.Parse("2006-01-02", date); err != nil {
+			return nil, errors.New("exclusions must be dates in YYYY-MM-DD format")
+		}
+		excluded[date] = true
+	}
+
+	var candidates []time.Time
+	var err error
+	switch r.Frequency {
+	case RecurrenceWeekly:
+		candidates, err = r.weekly(first, interval)
+	case RecurrenceMonthly:
+		candidates, err = r.monthly(first, interval)
+	case RecurrenceCustom:
+		candidates, err = r.custom(first)
+	default:
+		return nil, errors.New("recurrence frequency must be weekly, monthly or custom")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	occurrences := make([]time.Time, 0, len(candidates))
+	for _, start := range candidates {
+		if !excluded[start.In(first.Location()).Format("2006-01-02")] {
+			occurrences = append(occurrences, start)
+		}
+	}
+	if len(occurrences) == 0 {
+		return nil, errors.New("recurrence has no occurrences")
+	}
+
+	return occurrences, nil
+}
+
+// done reports whether a rule has produced all its occurrences once start is next
+func (r *Recurrence) done(start time.Time, produced int) bool {
+	return (r.Count > 0 && produced >= r.Count) || (r.Until != nil && start.After(*r.Until))
+}
+
+func (r *Recurrence) weekly(first time.Time, interval int) ([]time.Time, error) {
+	days := make([]int, 0, len(r.Weekdays))
+	seen := make(map[time.Weekday]bool)
+	for _, name := range r.Weekdays {
+		day, ok := recurrenceWeekdays[name]
+		if !ok {
+			return nil, errors.New("weekdays must be MO, TU, WE, TH, FR, SA or SU")
+		}
+		if !seen[day] {
+			seen[day] = true
+			// Weeks start on Monday
+			days = append(days, (int(day)+6)%7)
+		}
+	}
+	if len(days) == 0 {
+		days = append(days, (int(first.Weekday())+6)%7)
+	}
+	sort.Ints(days)
+
+	monday := first.AddDate(0, 0, -((int(first.Weekday()) + 6) % 7))
+	var starts []time.Time
+	for week := 0; ; week += interval {
+		for _, offset := range days {
+			day := monday.AddDate(0, 0, week*7+offset)
+			start := time.Date(day.Year(), day.Month(), day.Day(), first.Hour(), first.Minute(), first.Second(), 0, first.Location())
+			if start.Before(first) {
+				continue
+			}
+			if r.done(start, len(starts)) {
+				return starts, nil
+			}
+			if len(starts) == MaxSeriesOccurrences {
+				return nil, errors.New("recurrence generates too many occurrences")
+			}
+			starts = append(starts, start)
+		}
+	}
+}
+
+// monthly repeats on first's day of the month, skipping months without that day as RRULE does
+func (r *Recurrence) monthly(first time.Time, interval int) ([]time.Time, error) {
+	var starts []time.Time
+	for month := 0; ; month += interval {
+		start := time.Date(first.Year(), first.Month()+time.Month(month), first.Day(), first.Hour(), first.Minute(), first.Second(), 0, first.Location())
+		if start.Day() != first.Day() {
+			// Give up on rules that can never produce another occurrence
+			if month > 12*MaxSeriesOccurrences {
+				return nil, errors.New("recurrence generates too many occurrences")
+			}
+			continue
+		}
+		if r.done(start, len(starts)) {
+			return starts, nil
+		}
+		if len(starts) == MaxSeriesOccurrences {
+			return nil, errors.New("recurrence generates too many occurrences")
+		}
+		starts = append(starts, start)
+	}
+}
+
+func (r *Recurrence) custom(first time.Time) ([]time.Time, error) {
+	starts := append([]time.Time{first}, r.Dates...)
+	sort.Slice(starts, func(i, j int) bool { return starts[i].Before(starts[j]) })
+
+	unique := make([]time.Time, 0, len(starts))
+	for _, start := range starts {
+		if start.Before(first) {
+			return nil, errors.New("custom dates must be after the first start")
+		}
+		if len(unique) > 0 && unique[len(unique)-1].Equal(start) {
+			continue
+		}
+		if r.done(start, len(unique)) {
+			break
+		}
+		unique = append(unique, start)
+	}
+	if len(unique) > MaxSeriesOccurrences {
+		return nil, errors.New("recurrence generates too many occurrences")
+	}
+	return unique, nil
+}
+
+// EventSeries is a template for recurring events; each occurrence is an Event with its own inventory
+type EventSeries struct {
+	ID               uuid.UUID  `json:"id"`
+	OrganizationID   uuid.UUID  `json:"organization_id"`
+	Title            string     `json:"title"`
+	Description      string     `json:"description,omitempty"`
+	Location         string     `json:"location,omitempty"`
+	Venue            string     `json:"venue,omitempty"`
+	VenueID          *uuid.UUID `json:"venue_id,omitempty"`
+	SeatingLayout    string     `json:"seating_layout,omitempty"`
+	Address          *Address   `json:"address,omitempty"`
+	Coordinates      *GeoPoint  `json:"coordinates,omitempty"`
+	Category         string     `json:"category,omitempty"`
+	StartTime        time.Time  `json:"start_time"` // First occurrence
+	EndTime          time.Time  `json:"end_time"`
+	Recurrence       Recurrence `json:"recurrence"`
+	TotalTickets     int        `json:"total_tickets"` // Per occurrence
+	TicketPrice      float64    `json:"ticket_price"`
+	Currency         string     `json:"currency"`
+	ThumbnailURL     string     `json:"thumbnail_url,omitempty"`
+	IsPublic         bool       `json:"is_public"`
+	RequiresApproval bool       `json:"requires_approval"`
+	CreatedBy        uuid.UUID  `json:"created_by"`
+	CreatedAt        time.Time  `json:"created_at"`
+	UpdatedAt        time.Time  `json:"updated_at"`
+
+	Occurrences []*Event `json:"occurrences,omitempty"`
+}
+
+// EventSeriesRepository defines the interface for event series data access
+type EventSeriesRepository interface {
+	// Create stores a series together with its occurrences
+	Create(ctx context.Context, series *EventSeries, occurrences []*Event) (*EventSeries, error)
+	GetByID(seriesID uuid.UUID) (*EventSeries, error)
+	GetByOrganizationID(orgID uuid.UUID) ([]*EventSeries, error)
+	Update(ctx context.Context, series *EventSeries) error
+}
@@ -0,0 +1,231 @@
+package domain
+
+import (
+	"slices"
+	"testing"
+	"time"
+	_ "time/tzdata"
+)
+
+func TestRecurrenceOccurrences(t *testing.T) {
+	seoul := mustLoadLocation(t, "Asia/Seoul")
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	at := func(loc *time.Location, value string) time.Time {
+		parsed, err := time.ParseInLocation("2006-01-02 15:04", value, loc)
+		if err != nil {
+			t.Fatalf("parse %q: %v", value, err)
+		}
+		return parsed
+	}
+	until := func(loc *time.Location, value string) *time.Time {
+		parsed := at(loc, value)
+		return &parsed
+	}
+
+	cases := []struct {
+		name       string
+		recurrence Recurrence
+		first      time.Time
+		want       []string // Local start times as "2006-01-02 15:04 MST"
+		wantErr    bool
+	}{
+		{
+			name:       "weekly on several weekdays",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Weekdays: []string{"FR", "MO", "WE"}, Count: 5},
+			first:      at(seoul, "2026-01-07 19:00"), // Wednesday
+			want: []string{
+				"2026-01-07 19:00 KST", "2026-01-09 19:00 KST", "2026-01-12 19:00 KST",
+				"2026-01-14 19:00 KST", "2026-01-16 19:00 KST",
+			},
+		},
+		{
+			name:       "weekly repeated weekdays count once",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Weekdays: []string{"TU", "TU", "TH"}, Count: 3},
+			first:      at(seoul, "2026-01-06 19:00"), // Tuesday
+			want:       []string{"2026-01-06 19:00 KST", "2026-01-08 19:00 KST", "2026-01-13 19:00 KST"},
+		},
+		{
+			name:       "every other week until an inclusive end",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Interval: 2, Weekdays: []string{"TU", "TH"}, Until: until(seoul, "2026-01-22 19:00")},
+			first:      at(seoul, "2026-01-06 19:00"),
+			want: []string{
+				"2026-01-06 19:00 KST", "2026-01-08 19:00 KST", "2026-01-20 19:00 KST", "2026-01-22 19:00 KST",
+			},
+		},
+		{
+			name:       "weekly defaults to the first start's weekday",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Count: 3},
+			first:      at(seoul, "2026-01-03 14:30"), // Saturday
+			want:       []string{"2026-01-03 14:30 KST", "2026-01-10 14:30 KST", "2026-01-17 14:30 KST"},
+		},
+		{
+			name:       "monthly on the 31st skips shorter months",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Count: 4},
+			first:      at(seoul, "2026-01-31 19:00"),
+			want: []string{
+				"2026-01-31 19:00 KST", "2026-03-31 19:00 KST", "2026-05-31 19:00 KST", "2026-07-31 19:00 KST",
+			},
+		},
+		{
+			name:       "monthly on the 30th skips February",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Until: until(seoul, "2026-04-30 19:00")},
+			first:      at(seoul, "2026-01-30 19:00"),
+			want:       []string{"2026-01-30 19:00 KST", "2026-03-30 19:00 KST", "2026-04-30 19:00 KST"},
+		},
+		{
+			name:       "monthly on the 29th skips February outside leap years",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Count: 3},
+			first:      at(seoul, "2026-01-29 19:00"),
+			want:       []string{"2026-01-29 19:00 KST", "2026-03-29 19:00 KST", "2026-04-29 19:00 KST"},
+		},
+		{
+			name:       "monthly on the 29th keeps February in leap years",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Count: 3},
+			first:      at(seoul, "2027-12-29 19:00"),
+			want:       []string{"2027-12-29 19:00 KST", "2028-01-29 19:00 KST", "2028-02-29 19:00 KST"},
+		},
+		{
+			name:       "every third month",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Interval: 3, Count: 3},
+			first:      at(seoul, "2026-01-15 10:00"),
+			want:       []string{"2026-01-15 10:00 KST", "2026-04-15 10:00 KST", "2026-07-15 10:00 KST"},
+		},
+		{
+			name:       "exclusions are taken out after counting",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Count: 4, Exclusions: []string{"2026-01-10", "2026-02-01"}},
+			first:      at(seoul, "2026-01-03 14:30"),
+			want:       []string{"2026-01-03 14:30 KST", "2026-01-17 14:30 KST", "2026-01-24 14:30 KST"},
+		},
+		{
+			name:       "until ends the rule before the count",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Count: 10, Until: until(seoul, "2026-01-20 00:00")},
+			first:      at(seoul, "2026-01-03 14:30"),
+			want:       []string{"2026-01-03 14:30 KST", "2026-01-10 14:30 KST", "2026-01-17 14:30 KST"},
+		},
+		{
+			name:       "count ends the rule before until",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Count: 2, Until: until(seoul, "2027-01-01 00:00")},
+			first:      at(seoul, "2026-01-15 10:00"),
+			want:       []string{"2026-01-15 10:00 KST", "2026-02-15 10:00 KST"},
+		},
+		{
+			name:       "weekly keeps the local time across the start of daylight saving time",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Count: 3},
+			first:      at(newYork, "2026-03-01 10:00"),
+			want:       []string{"2026-03-01 10:00 EST", "2026-03-08 10:00 EDT", "2026-03-15 10:00 EDT"},
+		},
+		{
+			name:       "monthly keeps the local time across the end of daylight saving time",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Count: 2},
+			first:      at(newYork, "2026-10-05 09:00"),
+			want:       []string{"2026-10-05 09:00 EDT", "2026-11-05 09:00 EST"},
+		},
+		{
+			name: "custom dates are sorted and deduplicated",
+			recurrence: Recurrence{Frequency: RecurrenceCustom, Dates: []time.Time{
+				at(seoul, "2026-03-01 19:00"), at(seoul, "2026-02-01 19:00"), at(seoul, "2026-03-01 19:00"),
+			}},
+			first: at(seoul, "2026-01-10 19:00"),
+			want:  []string{"2026-01-10 19:00 KST", "2026-02-01 19:00 KST", "2026-03-01 19:00 KST"},
+		},
+		{
+			name: "exclusions use the first start's time zone",
+			recurrence: Recurrence{
+				Frequency:  RecurrenceCustom,
+				Dates:      []time.Time{at(time.UTC, "2026-01-10 16:00"), at(time.UTC, "2026-01-12 03:00")}, // 11th 01:00 and 12th 12:00 in Seoul
+				Exclusions: []string{"2026-01-11"},
+			},
+			first: at(seoul, "2026-01-10 01:00"),
+			want:  []string{"2026-01-10 01:00 KST", "2026-01-12 12:00 KST"},
+		},
+
+		{
+			name:       "weekly without count or until",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly},
+			first:      at(seoul, "2026-01-03 14:30"),
+			wantErr:    true,
+		},
+		{
+			name:       "until before the first start",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Until: until(seoul, "2026-01-01 00:00")},
+			first:      at(seoul, "2026-01-03 14:30"),
+			wantErr:    true,
+		},
+		{
+			name:       "negative interval",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Interval: -1, Count: 3},
+			first:      at(seoul, "2026-01-03 14:30"),
+			wantErr:    true,
+		},
+		{
+			name:       "unknown weekday",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Weekdays: []string{"XX"}, Count: 3},
+			first:      at(seoul, "2026-01-03 14:30"),
+			wantErr:    true,
+		},
+		{
+			name:       "malformed exclusion",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Count: 3, Exclusions: []string{"01/10/2026"}},
+			first:      at(seoul, "2026-01-03 14:30"),
+			wantErr:    true,
+		},
+		{
+			name:       "every occurrence excluded",
+			recurrence: Recurrence{Frequency: RecurrenceMonthly, Count: 1, Exclusions: []string{"2026-01-15"}},
+			first:      at(seoul, "2026-01-15 10:00"),
+			wantErr:    true,
+		},
+		{
+			name:       "too many occurrences",
+			recurrence: Recurrence{Frequency: RecurrenceWeekly, Count: MaxSeriesOccurrences + 1},
+			first:      at(seoul, "2026-01-03 14:30"),
+			wantErr:    true,
+		},
+		{
+			name:       "custom date before the first start",
+			recurrence: Recurrence{Frequency: RecurrenceCustom, Dates: []time.Time{at(seoul, "2026-01-01 19:00")}},
+			first:      at(seoul, "2026-01-10 19:00"),
+			wantErr:    true,
+		},
+		{
+			name:       "unknown frequency",
+			recurrence: Recurrence{Frequency: "daily", Count: 3},
+			first:      at(seoul, "2026-01-03 14:30"),
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			occurrences, err := tc.recurrence.Occurrences(tc.first)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("got %v, want an error", occurrences)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			got := make([]string, len(occurrences))
+			for i, start := range occurrences {
+				got[i] = start.In(tc.first.Location()).Format("2006-01-02 15:04 MST")
+			}
+			if !slices.Equal(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("load %s: %v", name, err)
+	}
+	return loc
+}
@@ -0,0 +1,78 @@
+package domain
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SeatingLayout is one way of arranging a venue, such as seated or standing
+type SeatingLayout struct {
+	Name        string `json:"name"`
+	Capacity    int    `json:"capacity"`
+	Description string `json:"description,omitempty"`
+}
+
+// Venue is a place events can take place at, saved so its details need not be retyped for every event
+// Venues belong to an organization, or are shared platform-wide and managed by platform admins
+type Venue struct {
+	ID             uuid.UUID       `json:"id"`
+	OrganizationID *uuid.UUID      `json:"organization_id,omitempty"` // Nil for platform-wide venues
+	Name           string          `json:"name"`
+	Address        *Address        `json:"address,omitempty"`
+	Coordinates    *GeoPoint       `json:"coordinates,omitempty"`
+	Capacity       int             `json:"capacity"`
+	SeatingLayouts []SeatingLayout `json:"seating_layouts"`
+	Directions     string          `json:"directions,omitempty"`
+	Parking        string          `json:"parking,omitempty"`
+	Accessibility  string          `json:"accessibility,omitempty"`
+	CreatedBy      uuid.UUID       `json:"created_by"`
+	CreatedAt      time.Time       `json:"created_at"`
+	UpdatedAt      time.Time       `json:"updated_at"`
+}
+
+// IsPlatformWide reports whether the venue is shared by every organization
+func (v *Venue) IsPlatformWide() bool {
+	return v.OrganizationID == nil
+}
+
+// LayoutCapacity returns the capacity of a seating layout, or of the whole venue for an empty layout name
+// Layout names match regardless of case
+func (v *Venue) LayoutCapacity(layout string) (int, bool) {
+	if layout == "" {
+		return v.Capacity, true
+	}
+	for _, l := range v.SeatingLayouts {
+		if strings.EqualFold(l.Name, layout) {
+			return l.Capacity, true
+		}
+	}
+	return 0, false
+}
+
+// VenueBooking is an event, or a series still holding occurrences, that takes place at a venue
+// Exactly one of EventID and SeriesID is set
+type VenueBooking struct {
+	EventID       *uuid.UUID
+	SeriesID      *uuid.UUID
+	Title         string
+	SeatingLayout string
+	TotalTickets  int
+}
+
+// VenueRepository defines the interface for venue data access
+type VenueRepository interface {
+	Create(ctx context.Context, venue *Venue) (*Venue, error)
+	GetByID(venueID uuid.UUID) (*Venue, error)
+	// GetAvailable returns an organization's own venues and the platform-wide ones, by name
+	GetAvailable(orgID uuid.UUID) ([]*Venue, error)
+	GetPlatformWide() ([]*Venue, error)
+	Update(ctx context.Context, venue *Venue) error
+	// GetActiveBookings returns the events at a venue that are not completed or cancelled,
+	// and the series at it with such occurrences
+	GetActiveBookings(venueID uuid.UUID) ([]*VenueBooking, error)
+	// Delete removes a venue; events and series at it keep their copied details
+	Delete(ctx context.Context, venueID uuid.UUID) error
+}
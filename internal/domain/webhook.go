@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// Webhook event types
+const (
+	WebhookOrderCompleted  = "order.completed"
+	WebhookOrderCancelled  = "order.cancelled"
+	WebhookOrderRefunded   = "order.refunded"
+	WebhookTicketCheckedIn = "ticket.checked_in"
+)
+
+// WebhookEventTypes lists every event type an endpoint can subscribe to
+var WebhookEventTypes = []string{
+	WebhookOrderCompleted,
+	WebhookOrderCancelled,
+	WebhookOrderRefunded,
+	WebhookTicketCheckedIn,
+}
+
+// IsValidWebhookEventType checks if an event type exists
+func IsValidWebhookEventType(eventType string) bool {
+	for _, t := range WebhookEventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// Webhook delivery statuses
+const (
+	DeliveryPending   = "pending"
+	DeliverySucceeded = "succeeded"
+	DeliveryFailed    = "failed"
+)
+
+// WebhookEndpoint is a URL an organization receives event notifications at
+type WebhookEndpoint struct {
+	ID             uuid.UUID `json:"id"`
+	OrganizationID uuid.UUID `json:"organization_id"`
+	URL            string    `json:"url"`
+	Secret         string    `json:"-"`
+	EventTypes     []string  `json:"event_types"`
+	IsActive       bool      `json:"is_active"`
+	CreatedBy      uuid.UUID `json:"created_by"`
+	CreatedAt      time.Time `json:"created_at"`
+	UpdatedAt      time.Time `json:"updated_at"`
+}
+
+// Subscribes checks if the endpoint receives an event type
+func (e *WebhookEndpoint) Subscribes(eventType string) bool {
+	for _, t := range e.EventTypes {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
+
+// WebhookEvent is the body posted to endpoints
+type WebhookEvent struct {
+	ID             uuid.UUID   `json:"id"`
+	Type           string      `json:"type"`
+	OrganizationID uuid.UUID   `json:"organization_id"`
+	CreatedAt      time.Time   `json:"created_at"`
+	Data           interface{} `json:"data"`
+}
+
+// WebhookDelivery is one event sent, or to be sent, to one endpoint
+type WebhookDelivery struct {
+	ID             uuid.UUID  `json:"id"`
+	EndpointID     uuid.UUID  `json:"endpoint_id"`
+	OrganizationID uuid.UUID  `json:"organization_id"`
+	EventID        uuid.UUID  `json:"event_id"`
+	EventType      string     `json:"event_type"`
+	Payload        string     `json:"payload"`
+	Status         string     `json:"status"` // pending, succeeded, failed
+	Attempts       int        `json:"attempts"`
+	LastStatusCode *int       `json:"last_status_code,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	NextAttemptAt  *time.Time `json:"next_attempt_at,omitempty"`
+	DeliveredAt    *time.Time `json:"delivered_at,omitempty"`
+	ReplayOf       *uuid.UUID `json:"replay_of,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// WebhookRepository defines the interface for webhook endpoint and delivery data access
+type WebhookRepository interface {
+	// Endpoints
+	CreateEndpoint(ctx context.Context, endpoint *WebhookEndpoint) (*WebhookEndpoint, error)
+	GetEndpointByID(endpointID uuid.UUID) (*WebhookEndpoint, error)
+	GetEndpointsByOrganizationID(orgID uuid.UUID) ([]*WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, endpoint *WebhookEndpoint) error
+	DeleteEndpoint(ctx context.Context, endpointID uuid.UUID) error
+
+	// Deliveries
+	CreateDelivery(delivery *WebhookDelivery) (*WebhookDelivery, error)
+	GetDeliveryByID(deliveryID uuid.UUID) (*WebhookDelivery, error)
+	GetDeliveriesByEndpointID(endpointID uuid.UUID, offset, limit int) ([]*WebhookDelivery, int, error)
+	GetPendingDeliveries() ([]*WebhookDelivery, error)
+	UpdateDelivery(delivery *WebhookDelivery) error
+}
+
+// WebhookQueue schedules deliveries by the time their next attempt is due
+type WebhookQueue interface {
+	Enqueue(deliveryID uuid.UUID, dueAt time.Time) error
+	// Claim removes and returns up to limit deliveries due at or before now
+	Claim(now time.Time, limit int) ([]uuid.UUID, error)
+}
@@ -0,0 +1,460 @@
+package handler
+
+import (
+	"context"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/middleware"
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+// The fakes embed the repository interfaces, so calling a method they do not override panics
+
+type fakeOrganizationRepository struct {
+	domain.OrganizationRepository
+	orgs    map[uuid.UUID]*domain.Organization
+	members map[uuid.UUID]*domain.OrganizationMember
+}
+
+func (r *fakeOrganizationRepository) GetByID(orgID uuid.UUID) (*domain.Organization, error) {
+	org, ok := r.orgs[orgID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return org, nil
+}
+
+func (r *fakeOrganizationRepository) GetMember(orgID, userID uuid.UUID) (*domain.OrganizationMember, error) {
+	member, ok := r.members[userID]
+	if !ok || member.OrganizationID != orgID {
+		return nil, domain.ErrNotFound
+	}
+	return member, nil
+}
+
+type fakeEventRepository struct {
+	domain.EventRepository
+	events map[uuid.UUID]*domain.Event
+}
+
+func (r *fakeEventRepository) GetByID(eventID uuid.UUID) (*domain.Event, error) {
+	event, ok := r.events[eventID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	copied := *event
+	return &copied, nil
+}
+
+func (r *fakeEventRepository) UpdateAvailableTickets(eventID uuid.UUID, delta int) error {
+	event, ok := r.events[eventID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	event.AvailableTickets += delta
+	return nil
+}
+
+func (r *fakeEventRepository) UpdateParticipantCount(eventID uuid.UUID, count int) error {
+	event, ok := r.events[eventID]
+	if !ok {
+		return domain.ErrNotFound
+	}
+	event.ParticipantCount = count
+	return nil
+}
+
+type fakePaymentRepository struct {
+	domain.PaymentRepository
+	payments []*domain.Payment
+}
+
+func (r *fakePaymentRepository) find(match func(p *domain.Payment) bool) []*domain.Payment {
+	result := make([]*domain.Payment, 0)
+	for _, p := range r.payments {
+		if match(p) {
+			copied := *p
+			result = append(result, &copied)
+		}
+	}
+	return result
+}
+
+func (r *fakePaymentRepository) Create(ctx context.Context, payment *domain.Payment) (*domain.Payment, error) {
+	created := *payment
+	r.payments = append(r.payments, &created)
+	copied := created
+	return &copied, nil
+}
+
+func (r *fakePaymentRepository) GetByID(paymentID uuid.UUID) (*domain.Payment, error) {
+	found := r.find(func(p *domain.Payment) bool { return p.ID == paymentID })
+	if len(found) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return found[0], nil
+}
+
+func (r *fakePaymentRepository) GetByOrderID(orderID string) (*domain.Payment, error) {
+	found := r.find(func(p *domain.Payment) bool { return p.OrderID == orderID })
+	if len(found) == 0 {
+		return nil, domain.ErrNotFound
+	}
+	return found[0], nil
+}
+
+func (r *fakePaymentRepository) GetByEventID(eventID uuid.UUID) ([]*domain.Payment, error) {
+	return r.find(func(p *domain.Payment) bool { return p.EventID == eventID }), nil
+}
+
+func (r *fakePaymentRepository) GetByEventIDAndChannel(eventID uuid.UUID, channel string) ([]*domain.Payment, error) {
+	return r.find(func(p *domain.Payment) bool { return p.EventID == eventID && p.Channel == channel }), nil
+}
+
+func (r *fakePaymentRepository) GetCompletedPaymentsByEventID(eventID uuid.UUID) ([]*domain.Payment, error) {
+	return r.find(func(p *domain.Payment) bool { return p.EventID == eventID && p.Status == "completed" }), nil
+}
+
+func (r *fakePaymentRepository) GetByEventIDFiltered(eventID uuid.UUID, filter domain.PaymentFilter, offset, limit int) ([]*domain.Payment, error) {
+	found := r.find(func(p *domain.Payment) bool {
+		if p.EventID != eventID {
+			return false
+		}
+		for _, status := range filter.Statuses {
+			if p.Status == status {
+				return true
+			}
+		}
+		return false
+	})
+	if offset >= len(found) {
+		return nil, nil
+	}
+	return found[offset:min(offset+limit, len(found))], nil
+}
+
+func (r *fakePaymentRepository) GetBoxOfficeSalesByEventID(eventID uuid.UUID, from, to time.Time) ([]*domain.Payment, error) {
+	return r.find(func(p *domain.Payment) bool {
+		return p.EventID == eventID && p.Channel == "box_office" && !p.CreatedAt.Before(from) && p.CreatedAt.Before(to)
+	}), nil
+}
+
+func (r *fakePaymentRepository) GetParticipantCountByEventID(eventID uuid.UUID) (int, error) {
+	count := 0
+	for _, p := range r.find(func(p *domain.Payment) bool { return p.EventID == eventID && p.Status == "completed" }) {
+		count += p.TicketQuantity
+	}
+	return count, nil
+}
+
+func (r *fakePaymentRepository) UpdateStatus(ctx context.Context, paymentID uuid.UUID, status string, paymentKey string) error {
+	for _, p := range r.payments {
+		if p.ID == paymentID {
+			p.Status = status
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+func (r *fakePaymentRepository) UpdateCheckIn(ctx context.Context, paymentID uuid.UUID, checkedInAt *time.Time) error {
+	for _, p := range r.payments {
+		if p.ID == paymentID {
+			p.CheckedInAt = checkedInAt
+			return nil
+		}
+	}
+	return domain.ErrNotFound
+}
+
+type fakeAPIKeyRepository struct {
+	domain.APIKeyRepository
+	keys map[uuid.UUID]*domain.APIKey
+}
+
+func (r *fakeAPIKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	created := *key
+	r.keys[created.ID] = &created
+	return &created, nil
+}
+
+func (r *fakeAPIKeyRepository) GetByID(keyID uuid.UUID) (*domain.APIKey, error) {
+	key, ok := r.keys[keyID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return key, nil
+}
+
+func (r *fakeAPIKeyRepository) GetByPrefix(prefix string) (*domain.APIKey, error) {
+	for _, key := range r.keys {
+		if key.Prefix == prefix {
+			return key, nil
+		}
+	}
+	return nil, domain.ErrNotFound
+}
+
+func (r *fakeAPIKeyRepository) UpdateLastUsed(keyID uuid.UUID, usedAt time.Time) error {
+	return nil
+}
+
+func (r *fakeAPIKeyRepository) Revoke(ctx context.Context, keyID uuid.UUID) error {
+	now := time.Now()
+	r.keys[keyID].RevokedAt = &now
+	return nil
+}
+
+type fakeRegistrationRepository struct {
+	domain.RegistrationRepository
+}
+
+func (r *fakeRegistrationRepository) GetQuestionsByEventID(eventID uuid.UUID) ([]*domain.RegistrationQuestion, error) {
+	return nil, nil
+}
+
+func (r *fakeRegistrationRepository) GetAnswersByPaymentIDs(paymentIDs []uuid.UUID) ([]*domain.RegistrationAnswer, error) {
+	return nil, nil
+}
+
+type fakeWebhookDispatcher struct{}
+
+func (d *fakeWebhookDispatcher) Dispatch(orgID uuid.UUID, eventType string, data interface{}) {}
+
+func (d *fakeWebhookDispatcher) DispatchPayment(eventType string, payment *domain.Payment) {}
+
+type fakePopularity struct {
+	usecase.PopularityUseCase
+}
+
+func (p *fakePopularity) RecordTicketSale(eventID uuid.UUID, tickets int) {}
+
+// fakeGateway records the refunds it was asked for
+type fakeGateway struct {
+	refunds []string
+}
+
+func (g *fakeGateway) CancelPayment(paymentKey, reason, idempotencyKey string) error {
+	g.refunds = append(g.refunds, idempotencyKey)
+	return nil
+}
+
+// accessFixture serves the payment, attendee, box-office and RSVP routes for one published event
+// Members hold one built-in role each; the buyer bought a paid online ticket and is not a member
+type accessFixture struct {
+	app *fiber.App
+
+	orgRepo    *fakeOrganizationRepository
+	eventRepo  *fakeEventRepository
+	payments   *fakePaymentRepository
+	apiKeyRepo *fakeAPIKeyRepository
+	apiKeys    usecase.APIKeyUseCase
+	gateway    *fakeGateway
+
+	orgID, otherOrgID uuid.UUID
+	eventID           uuid.UUID
+	paymentID         uuid.UUID // Paid online order of the buyer
+	orderID           string
+	checkedInID       uuid.UUID // Box-office sale already checked in
+	rsvpID            uuid.UUID // RSVP awaiting approval
+
+	owner, admin, eventManager, finance, checkinStaff, viewer uuid.UUID
+	buyer, outsider, otherOwner                               uuid.UUID
+}
+
+func newAccessFixture() *accessFixture {
+	f := &accessFixture{
+		orgID:        uuid.New(),
+		otherOrgID:   uuid.New(),
+		eventID:      uuid.New(),
+		paymentID:    uuid.New(),
+		orderID:      "order-1",
+		checkedInID:  uuid.New(),
+		rsvpID:       uuid.New(),
+		owner:        uuid.New(),
+		admin:        uuid.New(),
+		eventManager: uuid.New(),
+		finance:      uuid.New(),
+		checkinStaff: uuid.New(),
+		viewer:       uuid.New(),
+		buyer:        uuid.New(),
+		outsider:     uuid.New(),
+		otherOwner:   uuid.New(),
+		gateway:      &fakeGateway{},
+	}
+
+	f.orgRepo = &fakeOrganizationRepository{
+		orgs: map[uuid.UUID]*domain.Organization{
+			f.orgID:      {ID: f.orgID, OwnerID: f.owner, IsActive: true},
+			f.otherOrgID: {ID: f.otherOrgID, OwnerID: f.otherOwner, IsActive: true},
+		},
+		members: make(map[uuid.UUID]*domain.OrganizationMember),
+	}
+	for userID, role := range map[uuid.UUID]string{
+		f.admin:        domain.RoleAdmin,
+		f.eventManager: domain.RoleEventManager,
+		f.finance:      domain.RoleFinance,
+		f.checkinStaff: domain.RoleCheckinStaff,
+		f.viewer:       domain.RoleViewer,
+	} {
+		f.orgRepo.members[userID] = &domain.OrganizationMember{OrganizationID: f.orgID, UserID: userID, Role: role}
+	}
+
+	f.eventRepo = &fakeEventRepository{
+		events: map[uuid.UUID]*domain.Event{
+			f.eventID: {
+				ID: f.eventID, OrganizationID: f.orgID, IsPublic: true, Status: domain.EventPublished,
+				TicketPrice: 10000, Currency: "KRW", TotalTickets: 100, AvailableTickets: 98,
+			},
+		},
+	}
+
+	checkedInAt := time.Now().Add(-time.Hour)
+	f.payments = &fakePaymentRepository{
+		payments: []*domain.Payment{
+			{
+				ID: f.paymentID, EventID: f.eventID, UserID: &f.buyer, OrderID: f.orderID, PaymentKey: "pay-key-1",
+				Status: "completed", Channel: "online", PaymentMethod: "gateway", TicketQuantity: 1, TotalPrice: 10000,
+			},
+			{
+				ID: f.checkedInID, EventID: f.eventID, OrderID: "BOX-1", Status: "completed", Channel: "box_office",
+				PaymentMethod: "cash", TicketQuantity: 1, TotalPrice: 10000, OperatorID: &f.checkinStaff,
+				CheckedInAt: &checkedInAt, CreatedAt: time.Now(),
+			},
+			{
+				ID: f.rsvpID, EventID: f.eventID, UserID: &f.outsider, OrderID: "RSVP-1", Status: "pending",
+				Channel: "rsvp", PaymentMethod: "free", TicketQuantity: 1,
+			},
+		},
+	}
+
+	f.apiKeyRepo = &fakeAPIKeyRepository{keys: make(map[uuid.UUID]*domain.APIKey)}
+	registrationRepo := &fakeRegistrationRepository{}
+	webhooks := &fakeWebhookDispatcher{}
+	popularity := &fakePopularity{}
+
+	policy := usecase.NewAuthorizationPolicy(f.orgRepo, f.eventRepo, f.apiKeyRepo)
+	f.apiKeys = usecase.NewAPIKeyUseCase(f.apiKeyRepo, policy)
+	paymentHandler := NewPaymentHandler(usecase.NewPaymentUseCase(f.payments, f.eventRepo, registrationRepo, nil, policy, f.gateway, webhooks, popularity))
+	attendeeHandler := NewAttendeeHandler(usecase.NewAttendeeUseCase(f.payments, f.eventRepo, policy, registrationRepo, webhooks))
+	boxOfficeHandler := NewBoxOfficeHandler(usecase.NewBoxOfficeUseCase(f.payments, f.eventRepo, policy, webhooks, popularity))
+	rsvpHandler := NewRsvpHandler(usecase.NewRsvpUseCase(f.payments, f.eventRepo, policy, nil, registrationRepo, webhooks, popularity))
+
+	f.app = fiber.New()
+
+	// Stands in for the JWT middleware: the caller is named by a header
+	api := f.app.Group("/api", func(c *fiber.Ctx) error {
+		userID, err := uuid.Parse(c.Get("X-User-ID"))
+		if err != nil {
+			return c.SendStatus(fiber.StatusUnauthorized)
+		}
+		c.Locals("userID", userID)
+		return c.Next()
+	})
+	api.Get("/events/:eventId/payments", paymentHandler.GetEventPayments)
+	api.Get("/events/:eventId/attendees", paymentHandler.GetEventAttendees)
+	api.Get("/events/:eventId/attendees/export", attendeeHandler.ExportAttendees)
+	api.Post("/events/:eventId/attendees/:paymentId/check-in", attendeeHandler.CheckIn)
+	api.Delete("/events/:eventId/attendees/:paymentId/check-in", attendeeHandler.UndoCheckIn)
+	api.Post("/events/:eventId/box-office/sales", boxOfficeHandler.RecordSale)
+	api.Get("/events/:eventId/box-office/report", boxOfficeHandler.GetCashUpReport)
+	api.Get("/events/:eventId/rsvps", rsvpHandler.GetEventRsvps)
+	api.Post("/events/:eventId/rsvps/:rsvpId/approve", rsvpHandler.ApproveRsvp)
+	api.Post("/events/:eventId/rsvps/:rsvpId/decline", rsvpHandler.DeclineRsvp)
+	api.Get("/payments/:id", paymentHandler.GetPayment)
+	api.Get("/payments/order/:orderId", paymentHandler.GetPaymentByOrderID)
+	api.Delete("/payments/:id", paymentHandler.CancelPayment)
+
+	// Integration routes take organization API keys the way the server does
+	integrations := f.app.Group("/integrations", middleware.NewAPIKeyMiddleware(f.apiKeys, nil).Authenticate)
+	integrations.Get("/events/:eventId/attendees", paymentHandler.GetEventAttendees)
+	integrations.Get("/events/:eventId/attendees/export", attendeeHandler.ExportAttendees)
+	integrations.Post("/events/:eventId/attendees/:paymentId/check-in", attendeeHandler.CheckIn)
+	integrations.Delete("/events/:eventId/attendees/:paymentId/check-in", attendeeHandler.UndoCheckIn)
+
+	return f
+}
+
+// createAPIKey issues a key with the given scopes for an organization, as creator, and returns the full key
+func (f *accessFixture) createAPIKey(t *testing.T, orgID, creator uuid.UUID, scopes ...string) string {
+	t.Helper()
+
+	created, err := f.apiKeys.CreateAPIKey(context.Background(), orgID, creator, usecase.CreateAPIKeyRequest{
+		Name:   "crm",
+		Scopes: scopes,
+	})
+	if err != nil {
+		t.Fatalf("create api key: %v", err)
+	}
+	return created.Key
+}
+
+// status sends a request as a user and returns the response status
+func (f *accessFixture) status(t *testing.T, method, path string, userID uuid.UUID, body string) int {
+	t.Helper()
+	return f.send(t, method, path, body, "X-User-ID", userID.String())
+}
+
+// keyStatus sends a request authenticated with an API key and returns the response status
+func (f *accessFixture) keyStatus(t *testing.T, method, path, key string) int {
+	t.Helper()
+	return f.send(t, method, path, "", middleware.APIKeyHeader, key)
+}
+
+func (f *accessFixture) send(t *testing.T, method, path, body, header, value string) int {
+	t.Helper()
+
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	req.Header.Set(header, value)
+	if body != "" {
+		req.Header.Set(fiber.HeaderContentType, fiber.MIMEApplicationJSON)
+	}
+	resp, err := f.app.Test(req)
+	if err != nil {
+		t.Fatalf("%s %s: %v", method, path, err)
+	}
+	resp.Body.Close()
+
+	return resp.StatusCode
+}
+
+// accessCase is a caller and the status a route should answer them with
+type accessCase struct {
+	name   string
+	userID func(f *accessFixture) uuid.UUID
+	want   int
+}
+
+func caller(name string, want int, userID func(f *accessFixture) uuid.UUID) accessCase {
+	return accessCase{name: name, userID: userID, want: want}
+}
+
+var (
+	asOwner        = func(f *accessFixture) uuid.UUID { return f.owner }
+	asAdmin        = func(f *accessFixture) uuid.UUID { return f.admin }
+	asEventManager = func(f *accessFixture) uuid.UUID { return f.eventManager }
+	asFinance      = func(f *accessFixture) uuid.UUID { return f.finance }
+	asCheckinStaff = func(f *accessFixture) uuid.UUID { return f.checkinStaff }
+	asViewer       = func(f *accessFixture) uuid.UUID { return f.viewer }
+	asBuyer        = func(f *accessFixture) uuid.UUID { return f.buyer }
+	asOutsider     = func(f *accessFixture) uuid.UUID { return f.outsider }
+)
+
+// checkAccess sends one request per case, each to a fresh fixture since the routes change state
+func checkAccess(t *testing.T, method string, path func(f *accessFixture) string, body string, cases []accessCase) {
+	t.Helper()
+
+	for _, tc := range cases {
+		f := newAccessFixture()
+		p := path(f)
+		if got := f.status(t, method, p, tc.userID(f), body); got != tc.want {
+			t.Errorf("%s %s as %s: got %d, want %d", method, p, tc.name, got, tc.want)
+		}
+	}
+}
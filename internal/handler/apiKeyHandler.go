@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type APIKeyHandler struct {
+	apiKeyUseCase usecase.APIKeyUseCase
+}
+
+func NewAPIKeyHandler(apiKeyUseCase usecase.APIKeyUseCase) *APIKeyHandler {
+	return &APIKeyHandler{
+		apiKeyUseCase: apiKeyUseCase,
+	}
+}
+
+// CreateAPIKey creates an API key for an organization; the full key is only returned in this response
+func (h *APIKeyHandler) CreateAPIKey(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req usecase.CreateAPIKeyRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	key, err := h.apiKeyUseCase.CreateAPIKey(c.UserContext(), orgID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "API key created successfully",
+		"api_key": key,
+	})
+}
+
+// GetAPIKeys lists an organization's API keys
+func (h *APIKeyHandler) GetAPIKeys(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	keys, err := h.apiKeyUseCase.GetAPIKeys(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"api_keys": keys,
+		"count":    len(keys),
+	})
+}
+
+// RevokeAPIKey revokes an organization's API key
+func (h *APIKeyHandler) RevokeAPIKey(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	keyID, err := uuid.Parse(c.Params("keyId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid API key ID",
+		})
+	}
+
+	if err := h.apiKeyUseCase.RevokeAPIKey(c.UserContext(), orgID, keyID, userID); err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "API key revoked successfully",
+	})
+}
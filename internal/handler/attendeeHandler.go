@@ -0,0 +1,123 @@
+package handler
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type AttendeeHandler struct {
+	attendeeUseCase usecase.AttendeeUseCase
+}
+
+func NewAttendeeHandler(attendeeUseCase usecase.AttendeeUseCase) *AttendeeHandler {
+	return &AttendeeHandler{
+		attendeeUseCase: attendeeUseCase,
+	}
+}
+
+// ExportAttendees streams the attendee list as CSV or XLSX (admin only)
+// Query: format=csv|xlsx, columns=a,b,c, status=completed,cancelled, checked_in=true|false
+func (h *AttendeeHandler) ExportAttendees(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	opts := usecase.ExportAttendeesOptions{
+		Format:   c.Query("format", "csv"),
+		Columns:  splitQueryList(c.Query("columns")),
+		Statuses: splitQueryList(c.Query("status")),
+	}
+	if checkedIn := c.Query("checked_in"); checkedIn != "" {
+		value, err := strconv.ParseBool(checkedIn)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid checked_in value",
+			})
+		}
+		opts.CheckedIn = &value
+	}
+
+	export, err := h.attendeeUseCase.ExportAttendees(eventID, userID, opts)
+	if err != nil {
+		return attendeeErrorResponse(c, err)
+	}
+
+	c.Set(fiber.HeaderContentType, export.ContentType)
+	c.Set(fiber.HeaderContentDisposition, fmt.Sprintf(`attachment; filename="%s"`, export.FileName))
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		if err := export.Write(w); err != nil {
+			log.Printf("failed to stream attendee export for event %s: %v", eventID, err)
+		}
+	})
+
+	return nil
+}
+
+// CheckIn marks an attendee as checked in (organization members only)
+func (h *AttendeeHandler) CheckIn(c *fiber.Ctx) error {
+	return h.checkIn(c, h.attendeeUseCase.CheckIn, "Attendee checked in successfully")
+}
+
+// UndoCheckIn clears an attendee's check-in (organization members only)
+func (h *AttendeeHandler) UndoCheckIn(c *fiber.Ctx) error {
+	return h.checkIn(c, h.attendeeUseCase.UndoCheckIn, "Check-in cancelled successfully")
+}
+
+func (h *AttendeeHandler) checkIn(c *fiber.Ctx, action func(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Payment, error), message string) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	paymentID, err := uuid.Parse(c.Params("paymentId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid payment ID",
+		})
+	}
+
+	payment, err := action(c.UserContext(), eventID, paymentID, userID)
+	if err != nil {
+		return attendeeErrorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": message,
+		"payment": payment,
+	})
+}
+
+func attendeeErrorResponse(c *fiber.Ctx, err error) error {
+	return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
+
+// splitQueryList splits a comma-separated query value, dropping empty entries
+func splitQueryList(value string) []string {
+	var result []string
+	for _, part := range strings.Split(value, ",") {
+		if part = strings.TrimSpace(part); part != "" {
+			result = append(result, part)
+		}
+	}
+	return result
+}
@@ -0,0 +1,150 @@
+package handler
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestExportAttendeesRequiresPermission(t *testing.T) {
+	path := func(f *accessFixture) string { return "/api/events/" + f.eventID.String() + "/attendees/export" }
+	checkAccess(t, http.MethodGet, path, "", []accessCase{
+		caller("owner", fiber.StatusOK, asOwner),
+		caller("admin", fiber.StatusOK, asAdmin),
+		// Exports hold every attendee's contact details, so only owners and admins get them by default
+		caller("event manager", fiber.StatusForbidden, asEventManager),
+		caller("finance", fiber.StatusForbidden, asFinance),
+		caller("checkin staff", fiber.StatusForbidden, asCheckinStaff),
+		caller("viewer", fiber.StatusForbidden, asViewer),
+		caller("buyer", fiber.StatusForbidden, asBuyer),
+		caller("outsider", fiber.StatusForbidden, asOutsider),
+	})
+}
+
+func TestCheckInRequiresPermission(t *testing.T) {
+	checkIn := func(f *accessFixture) string {
+		return "/api/events/" + f.eventID.String() + "/attendees/" + f.paymentID.String() + "/check-in"
+	}
+	undo := func(f *accessFixture) string {
+		return "/api/events/" + f.eventID.String() + "/attendees/" + f.checkedInID.String() + "/check-in"
+	}
+	for method, path := range map[string]func(f *accessFixture) string{
+		http.MethodPost:   checkIn,
+		http.MethodDelete: undo,
+	} {
+		checkAccess(t, method, path, "", []accessCase{
+			caller("checkin staff", fiber.StatusOK, asCheckinStaff),
+			caller("event manager", fiber.StatusOK, asEventManager),
+			caller("admin", fiber.StatusOK, asAdmin),
+			caller("finance", fiber.StatusForbidden, asFinance),
+			caller("viewer", fiber.StatusForbidden, asViewer),
+			// Ticket holders cannot check themselves in
+			caller("buyer", fiber.StatusForbidden, asBuyer),
+			caller("outsider", fiber.StatusForbidden, asOutsider),
+		})
+	}
+}
+
+func TestIntegrationRoutesFollowKeyScopes(t *testing.T) {
+	f := newAccessFixture()
+
+	attendeesKey := f.createAPIKey(t, f.orgID, f.owner, domain.ScopeAttendeesRead)
+	checkInsKey := f.createAPIKey(t, f.orgID, f.owner, domain.ScopeCheckInsWrite)
+	eventsKey := f.createAPIKey(t, f.orgID, f.owner, domain.ScopeEventsRead)
+	otherOrgKey := f.createAPIKey(t, f.otherOrgID, f.otherOwner, domain.ScopeAttendeesRead, domain.ScopeCheckInsWrite)
+
+	events := "/integrations/events/" + f.eventID.String()
+	attendees := events + "/attendees"
+	export := events + "/attendees/export"
+	checkIn := events + "/attendees/" + f.paymentID.String() + "/check-in"
+
+	cases := []struct {
+		name   string
+		method string
+		path   string
+		key    string
+		want   int
+	}{
+		{"attendees with attendees:read", http.MethodGet, attendees, attendeesKey, fiber.StatusOK},
+		{"export with attendees:read", http.MethodGet, export, attendeesKey, fiber.StatusOK},
+		{"check-in with attendees:read", http.MethodPost, checkIn, attendeesKey, fiber.StatusForbidden},
+
+		{"attendees with checkins:write", http.MethodGet, attendees, checkInsKey, fiber.StatusForbidden},
+		{"export with checkins:write", http.MethodGet, export, checkInsKey, fiber.StatusForbidden},
+		{"check-in with checkins:write", http.MethodPost, checkIn, checkInsKey, fiber.StatusOK},
+		{"undo check-in with checkins:write", http.MethodDelete, checkIn, checkInsKey, fiber.StatusOK},
+
+		{"attendees with events:read", http.MethodGet, attendees, eventsKey, fiber.StatusForbidden},
+		{"export with events:read", http.MethodGet, export, eventsKey, fiber.StatusForbidden},
+
+		// Keys only reach the events of their own organization
+		{"attendees with another organization's key", http.MethodGet, attendees, otherOrgKey, fiber.StatusForbidden},
+		{"check-in with another organization's key", http.MethodPost, checkIn, otherOrgKey, fiber.StatusForbidden},
+
+		{"unknown key", http.MethodGet, attendees, domain.APIKeyPrefix + "_000000000000_secret", fiber.StatusUnauthorized},
+		{"wrong secret", http.MethodGet, attendees, attendeesKey + "x", fiber.StatusUnauthorized},
+	}
+	for _, tc := range cases {
+		if got := f.keyStatus(t, tc.method, tc.path, tc.key); got != tc.want {
+			t.Errorf("%s: got %d, want %d", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRevokedKeyIsRejected(t *testing.T) {
+	f := newAccessFixture()
+
+	key := f.createAPIKey(t, f.orgID, f.owner, domain.ScopeAttendeesRead)
+	path := "/integrations/events/" + f.eventID.String() + "/attendees"
+	if got := f.keyStatus(t, http.MethodGet, path, key); got != fiber.StatusOK {
+		t.Fatalf("before revoking: got %d, want %d", got, fiber.StatusOK)
+	}
+
+	for id := range f.apiKeyRepo.keys {
+		if err := f.apiKeys.RevokeAPIKey(t.Context(), f.orgID, id, f.owner); err != nil {
+			t.Fatalf("revoke: %v", err)
+		}
+	}
+	if got := f.keyStatus(t, http.MethodGet, path, key); got != fiber.StatusUnauthorized {
+		t.Errorf("after revoking: got %d, want %d", got, fiber.StatusUnauthorized)
+	}
+}
+
+func TestKeyPermissionsFollowCreatorRole(t *testing.T) {
+	f := newAccessFixture()
+
+	key := f.createAPIKey(t, f.orgID, f.admin, domain.ScopeAttendeesRead)
+	attendees := "/integrations/events/" + f.eventID.String() + "/attendees"
+	export := attendees + "/export"
+	if got := f.keyStatus(t, http.MethodGet, export, key); got != fiber.StatusOK {
+		t.Fatalf("export while the creator is an admin: got %d, want %d", got, fiber.StatusOK)
+	}
+
+	// Check-in staff may view attendees but not export them, and neither may the key from then on
+	f.orgRepo.members[f.admin].Role = domain.RoleCheckinStaff
+	if got := f.keyStatus(t, http.MethodGet, attendees, key); got != fiber.StatusOK {
+		t.Errorf("attendees after demoting the creator: got %d, want %d", got, fiber.StatusOK)
+	}
+	if got := f.keyStatus(t, http.MethodGet, export, key); got != fiber.StatusForbidden {
+		t.Errorf("export after demoting the creator: got %d, want %d", got, fiber.StatusForbidden)
+	}
+
+	delete(f.orgRepo.members, f.admin)
+	if got := f.keyStatus(t, http.MethodGet, attendees, key); got != fiber.StatusForbidden {
+		t.Errorf("attendees after the creator left: got %d, want %d", got, fiber.StatusForbidden)
+	}
+}
+
+func TestKeyOfInactiveOrganizationIsRejected(t *testing.T) {
+	f := newAccessFixture()
+
+	key := f.createAPIKey(t, f.orgID, f.owner, domain.ScopeAttendeesRead)
+	f.orgRepo.orgs[f.orgID].IsActive = false
+
+	path := "/integrations/events/" + f.eventID.String() + "/attendees"
+	if got := f.keyStatus(t, http.MethodGet, path, key); got != fiber.StatusForbidden {
+		t.Errorf("got %d, want %d", got, fiber.StatusForbidden)
+	}
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type AuditHandler struct {
+	auditUseCase usecase.AuditUseCase
+}
+
+func NewAuditHandler(auditUseCase usecase.AuditUseCase) *AuditHandler {
+	return &AuditHandler{
+		auditUseCase: auditUseCase,
+	}
+}
+
+// GetAuditLogs lists an organization's audit log, filtered by actor, action, target and time range
+func (h *AuditHandler) GetAuditLogs(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	filter := domain.AuditFilter{
+		Action:     c.Query("action"),
+		TargetType: c.Query("target_type"),
+		TargetID:   c.Query("target_id"),
+	}
+	if actor := c.Query("actor_id"); actor != "" {
+		actorID, err := uuid.Parse(actor)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid actor ID",
+			})
+		}
+		filter.ActorID = &actorID
+	}
+	if from := c.Query("from"); from != "" {
+		value, err := time.Parse(time.RFC3339, from)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid from format, expected RFC3339",
+			})
+		}
+		filter.From = &value
+	}
+	if to := c.Query("to"); to != "" {
+		value, err := time.Parse(time.RFC3339, to)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid to format, expected RFC3339",
+			})
+		}
+		filter.To = &value
+	}
+
+	result, err := h.auditUseCase.GetAuditLogs(orgID, userID, filter, c.QueryInt("page", 1), c.QueryInt("limit", 0))
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type BoxOfficeHandler struct {
+	boxOfficeUseCase usecase.BoxOfficeUseCase
+}
+
+func NewBoxOfficeHandler(boxOfficeUseCase usecase.BoxOfficeUseCase) *BoxOfficeHandler {
+	return &BoxOfficeHandler{
+		boxOfficeUseCase: boxOfficeUseCase,
+	}
+}
+
+// RecordSale records an on-site sale for an event (organization members only)
+func (h *BoxOfficeHandler) RecordSale(c *fiber.Ctx) error {
+	operatorID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	var req usecase.RecordSaleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	payment, err := h.boxOfficeUseCase.RecordSale(c.UserContext(), eventID, operatorID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Sale recorded successfully",
+		"payment": payment,
+	})
+}
+
+// GetCashUpReport returns the per-operator cash-up report for a business day
+// The day defaults to today and can be selected with ?date=YYYY-MM-DD
+func (h *BoxOfficeHandler) GetCashUpReport(c *fiber.Ctx) error {
+	requesterID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	now := time.Now()
+	from := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	if date := c.Query("date"); date != "" {
+		from, err = time.ParseInLocation("2006-01-02", date, time.Local)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid date format, expected YYYY-MM-DD",
+			})
+		}
+	}
+	to := from.AddDate(0, 0, 1)
+
+	report, err := h.boxOfficeUseCase.GetCashUpReport(eventID, requesterID, from, to)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"report": report,
+	})
+}
@@ -0,0 +1,35 @@
+package handler
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestRecordSaleRequiresPermission(t *testing.T) {
+	path := func(f *accessFixture) string { return "/api/events/" + f.eventID.String() + "/box-office/sales" }
+	body := `{"ticket_quantity": 1, "total_price": 10000, "payment_method": "cash"}`
+	checkAccess(t, http.MethodPost, path, body, []accessCase{
+		caller("checkin staff", fiber.StatusCreated, asCheckinStaff),
+		caller("admin", fiber.StatusCreated, asAdmin),
+		caller("owner", fiber.StatusCreated, asOwner),
+		// Reporting on sales does not allow making them
+		caller("finance", fiber.StatusForbidden, asFinance),
+		caller("viewer", fiber.StatusForbidden, asViewer),
+		caller("buyer", fiber.StatusForbidden, asBuyer),
+		caller("outsider", fiber.StatusForbidden, asOutsider),
+	})
+}
+
+func TestCashUpReportRequiresPermission(t *testing.T) {
+	path := func(f *accessFixture) string { return "/api/events/" + f.eventID.String() + "/box-office/report" }
+	checkAccess(t, http.MethodGet, path, "", []accessCase{
+		caller("finance", fiber.StatusOK, asFinance),
+		caller("admin", fiber.StatusOK, asAdmin),
+		// Staff at the door sell tickets but do not see the day's takings
+		caller("checkin staff", fiber.StatusForbidden, asCheckinStaff),
+		caller("viewer", fiber.StatusForbidden, asViewer),
+		caller("outsider", fiber.StatusForbidden, asOutsider),
+	})
+}
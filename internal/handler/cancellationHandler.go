@@ -0,0 +1,154 @@
+package handler
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type CancellationHandler struct {
+	cancellationUseCase usecase.CancellationUseCase
+}
+
+func NewCancellationHandler(cancellationUseCase usecase.CancellationUseCase) *CancellationHandler {
+	return &CancellationHandler{
+		cancellationUseCase: cancellationUseCase,
+	}
+}
+
+// CancelEvent cancels an event; its orders are refunded in the background
+func (h *CancellationHandler) CancelEvent(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	var req struct {
+		Reason string `json:"reason"`
+	}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
+	}
+
+	cancellation, err := h.cancellationUseCase.CancelEvent(c.UserContext(), eventID, userID, req.Reason)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message":      "Event cancelled; refunds are being processed",
+		"cancellation": cancellation,
+	})
+}
+
+// GetCancellation retrieves an event's cancellation and its refund progress
+func (h *CancellationHandler) GetCancellation(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	cancellation, err := h.cancellationUseCase.GetCancellation(eventID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"cancellation": cancellation,
+	})
+}
+
+// GetCancellationRefunds lists the refunds of an event's cancellation, optionally filtered by ?status=
+func (h *CancellationHandler) GetCancellationRefunds(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	refunds, err := h.cancellationUseCase.GetCancellationRefunds(eventID, userID, c.Query("status"))
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"refunds": refunds,
+		"count":   len(refunds),
+	})
+}
+
+// RetryFailedRefunds queues an event's failed refunds for another round of attempts
+func (h *CancellationHandler) RetryFailedRefunds(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	cancellation, err := h.cancellationUseCase.RetryFailedRefunds(c.UserContext(), eventID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message":      "Failed refunds queued for retry",
+		"cancellation": cancellation,
+	})
+}
+
+// ConfirmManualRefund records that a box-office order of a cancelled event was paid back
+func (h *CancellationHandler) ConfirmManualRefund(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	refundID, err := uuid.Parse(c.Params("refundId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid refund ID",
+		})
+	}
+
+	refund, err := h.cancellationUseCase.ConfirmManualRefund(c.UserContext(), eventID, refundID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Refund confirmed",
+		"refund":  refund,
+	})
+}
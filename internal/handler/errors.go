@@ -0,0 +1,25 @@
+package handler
+
+import (
+	"errors"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/gofiber/fiber/v2"
+)
+
+// errorStatus maps domain errors to HTTP status codes, using fallback for everything else
+// Usecase error types that carry details, such as usecase.PermissionError, implement Is to match their domain error
+func errorStatus(err error, fallback int) int {
+	switch {
+	case errors.Is(err, domain.ErrPermissionDenied):
+		return fiber.StatusForbidden
+	case errors.Is(err, domain.ErrInvalidInput):
+		return fiber.StatusBadRequest
+	case errors.Is(err, domain.ErrNotFound):
+		return fiber.StatusNotFound
+	case errors.Is(err, domain.ErrConflict), errors.Is(err, domain.ErrOrgInactive), errors.Is(err, domain.ErrAlreadyExists):
+		return fiber.StatusConflict
+	default:
+		return fallback
+	}
+}
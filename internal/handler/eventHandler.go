@@ -2,14 +2,19 @@ package handler
 
 import (
 	"bytes"
+	"context"
 	"encoding/base64"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"log"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/dev-hyunsang/ticketly-backend/config"
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
 	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -26,7 +31,7 @@ type EventHandler struct {
 }
 
 type BuyEventTicket struct {
-	EventID uuid.UUID `json:event_id`
+	EventID uuid.UUID `json:"event_id"`
 }
 
 type ConfirmReqBody struct {
@@ -59,13 +64,9 @@ func (h *EventHandler) CreateEvent(c *fiber.Ctx) error {
 		})
 	}
 
-	event, err := h.eventUseCase.CreateEvent(orgID, userID, req)
+	event, err := h.eventUseCase.CreateEvent(c.UserContext(), orgID, userID, req)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "permission denied: only admins can create events" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -76,8 +77,10 @@ func (h *EventHandler) CreateEvent(c *fiber.Ctx) error {
 	})
 }
 
-// GetEvent retrieves an event by ID
+// GetEvent retrieves an event by ID (private and draft events are visible to organization members only)
 func (h *EventHandler) GetEvent(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
 	eventID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -85,8 +88,13 @@ func (h *EventHandler) GetEvent(c *fiber.Ctx) error {
 		})
 	}
 
-	event, err := h.eventUseCase.GetEvent(eventID)
+	event, err := h.eventUseCase.GetEventForUser(eventID, userID)
 	if err != nil {
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": err.Error(),
+			})
+		}
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"error": "Event not found",
 		})
@@ -106,27 +114,29 @@ func (h *EventHandler) GetPublicEvent(c *fiber.Ctx) error {
 		})
 	}
 
-	event, err := h.eventUseCase.GetEvent(eventID)
+	// Anonymous viewers only see public, non-draft events of active organizations
+	event, err := h.eventUseCase.GetPublicEvent(eventID, c.IP())
 	if err != nil {
+		if errors.Is(err, domain.ErrPermissionDenied) {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "This event is not publicly accessible",
+			})
+		}
 		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
 			"error": "Event not found",
 		})
 	}
 
-	// Check if event is public
-	if !event.IsPublic {
-		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
-			"error": "This event is not publicly accessible",
-		})
-	}
-
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"event": event,
 	})
 }
 
 // GetOrganizationEvents retrieves all events for an organization
+// Non-members only see the organization's public events
 func (h *EventHandler) GetOrganizationEvents(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
 	orgID, err := uuid.Parse(c.Params("orgId"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -134,7 +144,7 @@ func (h *EventHandler) GetOrganizationEvents(c *fiber.Ctx) error {
 		})
 	}
 
-	events, err := h.eventUseCase.GetOrganizationEvents(orgID)
+	events, err := h.eventUseCase.GetOrganizationEvents(orgID, userID)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
 			"error": err.Error(),
@@ -164,13 +174,9 @@ func (h *EventHandler) UpdateEvent(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.eventUseCase.UpdateEvent(eventID, userID, req)
+	err = h.eventUseCase.UpdateEvent(c.UserContext(), eventID, userID, req)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "permission denied: only admins can update events" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -191,13 +197,9 @@ func (h *EventHandler) DeleteEvent(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.eventUseCase.DeleteEvent(eventID, userID)
+	err = h.eventUseCase.DeleteEvent(c.UserContext(), eventID, userID)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "permission denied: only admins can delete events" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -207,50 +209,152 @@ func (h *EventHandler) DeleteEvent(c *fiber.Ctx) error {
 	})
 }
 
-// GetPublicEvents retrieves all public events
-func (h *EventHandler) GetPublicEvents(c *fiber.Ctx) error {
-	events, err := h.eventUseCase.GetPublicEvents()
+// SubmitEventForReview submits a draft event to the organization's approvers
+func (h *EventHandler) SubmitEventForReview(c *fiber.Ctx) error {
+	return h.review(c, h.eventUseCase.SubmitEventForReview, "Event submitted for review")
+}
+
+// ApproveEvent approves an event waiting for review
+func (h *EventHandler) ApproveEvent(c *fiber.Ctx) error {
+	return h.review(c, h.eventUseCase.ApproveEvent, "Event approved successfully")
+}
+
+// RequestEventChanges sends an event waiting for review back with a comment
+func (h *EventHandler) RequestEventChanges(c *fiber.Ctx) error {
+	return h.review(c, h.eventUseCase.RequestEventChanges, "Changes requested successfully")
+}
+
+// RejectEvent rejects an event waiting for review
+func (h *EventHandler) RejectEvent(c *fiber.Ctx) error {
+	return h.review(c, h.eventUseCase.RejectEvent, "Event rejected successfully")
+}
+
+func (h *EventHandler) review(c *fiber.Ctx, action func(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error), message string) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	var req struct {
+		Comment string `json:"comment"`
+	}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
+	}
+
+	event, err := action(c.UserContext(), eventID, userID, req.Comment)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"events": events,
+		"message": message,
+		"event":   event,
 	})
 }
 
-// GetUpcomingEvents retrieves upcoming public events
-func (h *EventHandler) GetUpcomingEvents(c *fiber.Ctx) error {
-	events, err := h.eventUseCase.GetUpcomingEvents()
+// GetReviewQueue lists an organization's events waiting for review
+func (h *EventHandler) GetReviewQueue(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("orgId"))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
-			"error": err.Error(),
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
 		})
 	}
 
-	log.Println(events)
+	events, err := h.eventUseCase.GetReviewQueue(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
 		"events": events,
+		"count":  len(events),
 	})
 }
 
-// GetPopularEvents retrieves popular upcoming events
-func (h *EventHandler) GetPopularEvents(c *fiber.Ctx) error {
-	events, err := h.eventUseCase.GetPopularEvents()
+// PreviewEvent shows organization members an event as it will appear once published
+func (h *EventHandler) PreviewEvent(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	event, err := h.eventUseCase.PreviewEvent(eventID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"events": events,
+		"event":   event,
+		"preview": event.Status == "draft",
+	})
+}
+
+// GetEventHistory lists the material changes made to an event after it went on sale
+func (h *EventHandler) GetEventHistory(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	changes, err := h.eventUseCase.GetEventHistory(eventID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"changes": changes,
+		"count":   len(changes),
 	})
 }
 
+// GetPublicEvents lists public events; see parseEventListQuery for the filters
+func (h *EventHandler) GetPublicEvents(c *fiber.Ctx) error {
+	return h.listEvents(c, h.eventUseCase.GetPublicEvents)
+}
+
+// GetUpcomingEvents lists upcoming public events
+func (h *EventHandler) GetUpcomingEvents(c *fiber.Ctx) error {
+	return h.listEvents(c, h.eventUseCase.GetUpcomingEvents)
+}
+
+// GetPopularEvents lists popular upcoming events
+func (h *EventHandler) GetPopularEvents(c *fiber.Ctx) error {
+	return h.listEvents(c, h.eventUseCase.GetPopularEvents)
+}
+
+// GetNearbyEvents lists public events around a point given by lat and lng
+func (h *EventHandler) GetNearbyEvents(c *fiber.Ctx) error {
+	return h.listEvents(c, h.eventUseCase.GetNearbyEvents)
+}
+
 // SearchEvents searches events by keyword
 func (h *EventHandler) SearchEvents(c *fiber.Ctx) error {
 	keyword := c.Query("q")
@@ -260,16 +364,120 @@ func (h *EventHandler) SearchEvents(c *fiber.Ctx) error {
 		})
 	}
 
-	events, err := h.eventUseCase.SearchEvents(keyword)
+	return h.listEvents(c, func(query domain.EventListQuery) (*usecase.EventPage, error) {
+		return h.eventUseCase.SearchEvents(keyword, query)
+	})
+}
+
+func (h *EventHandler) listEvents(c *fiber.Ctx, list func(query domain.EventListQuery) (*usecase.EventPage, error)) error {
+	query, err := parseEventListQuery(c)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
 
-	return c.Status(fiber.StatusOK).JSON(fiber.Map{
-		"events": events,
-	})
+	page, err := list(query)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(page)
+}
+
+// parseEventListQuery reads the filters shared by the public listings:
+// category, organization_id, start_from, start_to, min_price, max_price, free, available, upcoming, location,
+// lat, lng, radius, sort, cursor and limit
+func parseEventListQuery(c *fiber.Ctx) (domain.EventListQuery, error) {
+	query := domain.EventListQuery{
+		Category: c.Query("category"),
+		Location: c.Query("location"),
+		Sort:     c.Query("sort"),
+		Cursor:   c.Query("cursor"),
+		Limit:    c.QueryInt("limit", 0),
+	}
+
+	if value := c.Query("organization_id"); value != "" {
+		orgID, err := uuid.Parse(value)
+		if err != nil {
+			return query, errors.New("Invalid organization ID")
+		}
+		query.OrganizationID = &orgID
+	}
+	times := []struct {
+		name   string
+		target **time.Time
+	}{{"start_from", &query.StartFrom}, {"start_to", &query.StartTo}}
+	for _, param := range times {
+		if value := c.Query(param.name); value != "" {
+			parsed, err := time.Parse(time.RFC3339, value)
+			if err != nil {
+				return query, fmt.Errorf("Invalid %s format, expected RFC3339", param.name)
+			}
+			*param.target = &parsed
+		}
+	}
+	prices := []struct {
+		name   string
+		target **float64
+	}{{"min_price", &query.MinPrice}, {"max_price", &query.MaxPrice}}
+	for _, param := range prices {
+		if value := c.Query(param.name); value != "" {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return query, fmt.Errorf("Invalid %s, expected a number", param.name)
+			}
+			*param.target = &parsed
+		}
+	}
+	if value := c.Query("free"); value != "" {
+		free, err := strconv.ParseBool(value)
+		if err != nil {
+			return query, errors.New("Invalid free, expected true or false")
+		}
+		query.Free = &free
+	}
+	if value := c.Query("available"); value != "" {
+		available, err := strconv.ParseBool(value)
+		if err != nil {
+			return query, errors.New("Invalid available, expected true or false")
+		}
+		query.Available = available
+	}
+	if value := c.Query("upcoming"); value != "" {
+		upcoming, err := strconv.ParseBool(value)
+		if err != nil {
+			return query, errors.New("Invalid upcoming, expected true or false")
+		}
+		query.Upcoming = upcoming
+	}
+
+	lat, lng := c.Query("lat"), c.Query("lng")
+	if lat != "" || lng != "" {
+		if lat == "" || lng == "" {
+			return query, errors.New("lat and lng must be given together")
+		}
+		var point domain.GeoPoint
+		var err error
+		if point.Latitude, err = strconv.ParseFloat(lat, 64); err != nil {
+			return query, errors.New("Invalid lat, expected a number")
+		}
+		if point.Longitude, err = strconv.ParseFloat(lng, 64); err != nil {
+			return query, errors.New("Invalid lng, expected a number")
+		}
+		query.Near = &point
+	}
+	if value := c.Query("radius"); value != "" {
+		radius, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return query, errors.New("Invalid radius, expected a number of kilometres")
+		}
+		query.RadiusKm = radius
+	}
+
+	return query, nil
 }
 
 func ConfirmPayment(paymentKey, orderId string, amount int) (string, error) {
@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type FavoriteHandler struct {
+	favoriteUseCase usecase.FavoriteUseCase
+}
+
+func NewFavoriteHandler(favoriteUseCase usecase.FavoriteUseCase) *FavoriteHandler {
+	return &FavoriteHandler{
+		favoriteUseCase: favoriteUseCase,
+	}
+}
+
+// FavoriteEvent saves an event to the current user's favorites
+func (h *FavoriteHandler) FavoriteEvent(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	favorite, err := h.favoriteUseCase.FavoriteEvent(c.UserContext(), eventID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"favorite": favorite,
+	})
+}
+
+// UnfavoriteEvent removes an event from the current user's favorites
+func (h *FavoriteHandler) UnfavoriteEvent(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	if err := h.favoriteUseCase.UnfavoriteEvent(c.UserContext(), eventID, userID); err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Event removed from favorites",
+	})
+}
+
+// GetMyFavorites lists the current user's favorite events
+func (h *FavoriteHandler) GetMyFavorites(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	events, err := h.favoriteUseCase.GetMyFavorites(userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"events": events,
+	})
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type InvitationHandler struct {
+	invitationUseCase usecase.InvitationUseCase
+}
+
+func NewInvitationHandler(invitationUseCase usecase.InvitationUseCase) *InvitationHandler {
+	return &InvitationHandler{
+		invitationUseCase: invitationUseCase,
+	}
+}
+
+type CreateInvitationRequest struct {
+	Email string `json:"email"`
+	Role  string `json:"role"`
+}
+
+type InvitationTokenRequest struct {
+	Token string `json:"token"`
+}
+
+// Invite sends an email invitation to join an organization (members:manage)
+func (h *InvitationHandler) Invite(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req CreateInvitationRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	invitation, err := h.invitationUseCase.Invite(c.UserContext(), orgID, userID, req.Email, req.Role)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":    "Invitation sent successfully",
+		"invitation": invitation,
+	})
+}
+
+// GetPendingInvitations lists an organization's pending invitations (members:manage)
+func (h *InvitationHandler) GetPendingInvitations(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	invitations, err := h.invitationUseCase.GetPendingInvitations(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"invitations": invitations,
+	})
+}
+
+// RevokeInvitation cancels a pending invitation (members:manage)
+func (h *InvitationHandler) RevokeInvitation(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	invitationID, err := uuid.Parse(c.Params("invitationId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid invitation ID",
+		})
+	}
+
+	if err := h.invitationUseCase.RevokeInvitation(c.UserContext(), orgID, invitationID, userID); err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Invitation revoked successfully",
+	})
+}
+
+// GetMyInvitations lists the pending invitations sent to the current user's email
+func (h *InvitationHandler) GetMyInvitations(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	invitations, err := h.invitationUseCase.GetMyInvitations(userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"invitations": invitations,
+	})
+}
+
+// AcceptByToken accepts an invitation with the token from the invitation email
+func (h *InvitationHandler) AcceptByToken(c *fiber.Ctx) error {
+	return h.respondByToken(c, h.invitationUseCase.AcceptByToken, "Invitation accepted successfully")
+}
+
+// DeclineByToken declines an invitation with the token from the invitation email
+func (h *InvitationHandler) DeclineByToken(c *fiber.Ctx) error {
+	return h.respondByToken(c, h.invitationUseCase.DeclineByToken, "Invitation declined successfully")
+}
+
+// Decline declines one of the current user's invitations
+func (h *InvitationHandler) Decline(c *fiber.Ctx) error {
+	return h.respondByID(c, h.invitationUseCase.Decline, "Invitation declined successfully")
+}
+
+func (h *InvitationHandler) respondByToken(c *fiber.Ctx, respond func(context.Context, string, uuid.UUID) (*domain.OrganizationInvitation, error), message string) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	var req InvitationTokenRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	invitation, err := respond(c.UserContext(), req.Token, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":    message,
+		"invitation": invitation,
+	})
+}
+
+func (h *InvitationHandler) respondByID(c *fiber.Ctx, respond func(context.Context, uuid.UUID, uuid.UUID) (*domain.OrganizationInvitation, error), message string) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	invitationID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid invitation ID",
+		})
+	}
+
+	invitation, err := respond(c.UserContext(), invitationID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":    message,
+		"invitation": invitation,
+	})
+}
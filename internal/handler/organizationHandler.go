@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"errors"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
 	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -17,26 +20,45 @@ func NewOrganizationHandler(orgUseCase usecase.OrganizationUseCase) *Organizatio
 }
 
 type CreateOrganizationRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	LogoURL     string `json:"logo_url"`
-	Category    string `json:"category"`
+	Name        string            `json:"name"`
+	Slug        string            `json:"slug"` // Derived from the name when omitted
+	Description string            `json:"description"`
+	LogoURL     string            `json:"logo_url"`
+	Category    string            `json:"category"`
+	SocialLinks map[string]string `json:"social_links"`
 }
 
 type UpdateOrganizationRequest struct {
-	Name        string `json:"name"`
-	Description string `json:"description"`
-	LogoURL     string `json:"logo_url"`
-	Category    string `json:"category"`
+	Name        string            `json:"name"`
+	Slug        string            `json:"slug"` // Keeps the current slug when omitted
+	Description string            `json:"description"`
+	LogoURL     string            `json:"logo_url"`
+	Category    string            `json:"category"`
+	SocialLinks map[string]string `json:"social_links"`
 }
 
 type AddMemberRequest struct {
 	UserID uuid.UUID `json:"user_id"`
-	Role   string    `json:"role"` // "admin" or "member"
+	Role   string    `json:"role"` // Built-in role (admin, event_manager, finance, checkin_staff, viewer) or custom role name
 }
 
 type UpdateMemberRoleRequest struct {
-	Role string `json:"role"` // "admin" or "member"
+	Role string `json:"role"`
+}
+
+type CreateRoleRequest struct {
+	Name        string              `json:"name"`
+	Description string              `json:"description"`
+	Permissions []domain.Permission `json:"permissions"`
+}
+
+type UpdateRoleRequest struct {
+	Description string              `json:"description"`
+	Permissions []domain.Permission `json:"permissions"`
+}
+
+type ProposeOwnershipTransferRequest struct {
+	UserID uuid.UUID `json:"user_id"` // Must be an admin of the organization
 }
 
 // CreateOrganization creates a new organization
@@ -50,9 +72,9 @@ func (h *OrganizationHandler) CreateOrganization(c *fiber.Ctx) error {
 		})
 	}
 
-	org, err := h.orgUseCase.CreateOrganization(req.Name, req.Description, req.LogoURL, req.Category, userID)
+	org, err := h.orgUseCase.CreateOrganization(c.UserContext(), req.Name, req.Description, req.LogoURL, req.Category, req.Slug, req.SocialLinks, userID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -100,7 +122,7 @@ func (h *OrganizationHandler) GetMyOrganizations(c *fiber.Ctx) error {
 	})
 }
 
-// UpdateOrganization updates an organization (admin only)
+// UpdateOrganization updates an organization (organization:update)
 func (h *OrganizationHandler) UpdateOrganization(c *fiber.Ctx) error {
 	userID := c.Locals("userID").(uuid.UUID)
 
@@ -118,13 +140,9 @@ func (h *OrganizationHandler) UpdateOrganization(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.orgUseCase.UpdateOrganization(orgID, req.Name, req.Description, req.LogoURL, req.Category, userID)
+	err = h.orgUseCase.UpdateOrganization(c.UserContext(), orgID, req.Name, req.Description, req.LogoURL, req.Category, req.Slug, req.SocialLinks, userID)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "permission denied: admin role required" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -146,13 +164,17 @@ func (h *OrganizationHandler) DeleteOrganization(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.orgUseCase.DeleteOrganization(orgID, userID)
+	// refund=true cancels live events and deletes once their attendees are refunded
+	err = h.orgUseCase.DeleteOrganization(c.UserContext(), orgID, userID, c.QueryBool("refund"))
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "only organization owner can delete the organization" {
-			status = fiber.StatusForbidden
+		var deletionErr *usecase.OrganizationDeletionError
+		if errors.As(err, &deletionErr) && deletionErr.Refunds != nil {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error":   err.Error(),
+				"refunds": deletionErr.Refunds,
+			})
 		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -162,8 +184,84 @@ func (h *OrganizationHandler) DeleteOrganization(c *fiber.Ctx) error {
 	})
 }
 
-// GetMembers retrieves all members of an organization
+// GetPublicOrganizations lists organizations in the public directory (no authentication required)
+func (h *OrganizationHandler) GetPublicOrganizations(c *fiber.Ctx) error {
+	orgs, err := h.orgUseCase.GetPublicOrganizations(c.Query("category"))
+	if err != nil {
+		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"organizations": orgs,
+	})
+}
+
+// GetPublicOrganization retrieves an organization's public profile by slug (no authentication required)
+func (h *OrganizationHandler) GetPublicOrganization(c *fiber.Ctx) error {
+	profile, err := h.orgUseCase.GetPublicProfile(c.Params("slug"))
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(profile)
+}
+
+// DeactivateOrganization hides an organization and stops its ticket sales
+func (h *OrganizationHandler) DeactivateOrganization(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	org, err := h.orgUseCase.DeactivateOrganization(c.UserContext(), orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":      "Organization deactivated successfully",
+		"organization": org,
+	})
+}
+
+// ReactivateOrganization makes a deactivated organization public again
+func (h *OrganizationHandler) ReactivateOrganization(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	org, err := h.orgUseCase.ReactivateOrganization(c.UserContext(), orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":      "Organization reactivated successfully",
+		"organization": org,
+	})
+}
+
+// GetMembers retrieves all members of an organization (members:view)
 func (h *OrganizationHandler) GetMembers(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
 	orgID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -171,9 +269,9 @@ func (h *OrganizationHandler) GetMembers(c *fiber.Ctx) error {
 		})
 	}
 
-	members, err := h.orgUseCase.GetMembers(orgID)
+	members, err := h.orgUseCase.GetMembers(orgID, userID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -183,7 +281,7 @@ func (h *OrganizationHandler) GetMembers(c *fiber.Ctx) error {
 	})
 }
 
-// AddMember adds a member to an organization (admin only)
+// AddMember adds a member to an organization (members:manage)
 func (h *OrganizationHandler) AddMember(c *fiber.Ctx) error {
 	userID := c.Locals("userID").(uuid.UUID)
 
@@ -201,13 +299,9 @@ func (h *OrganizationHandler) AddMember(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.orgUseCase.AddMember(orgID, req.UserID, userID, req.Role)
+	err = h.orgUseCase.AddMember(c.UserContext(), orgID, req.UserID, userID, req.Role)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "permission denied: admin role required" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -217,7 +311,7 @@ func (h *OrganizationHandler) AddMember(c *fiber.Ctx) error {
 	})
 }
 
-// RemoveMember removes a member from an organization (admin only)
+// RemoveMember removes a member from an organization (members:manage)
 func (h *OrganizationHandler) RemoveMember(c *fiber.Ctx) error {
 	requesterID := c.Locals("userID").(uuid.UUID)
 
@@ -235,13 +329,9 @@ func (h *OrganizationHandler) RemoveMember(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.orgUseCase.RemoveMember(orgID, userID, requesterID)
+	err = h.orgUseCase.RemoveMember(c.UserContext(), orgID, userID, requesterID)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "permission denied: admin role required" || err.Error() == "cannot remove organization owner" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -251,7 +341,7 @@ func (h *OrganizationHandler) RemoveMember(c *fiber.Ctx) error {
 	})
 }
 
-// UpdateMemberRole updates a member's role (admin only)
+// UpdateMemberRole updates a member's role (members:manage)
 func (h *OrganizationHandler) UpdateMemberRole(c *fiber.Ctx) error {
 	requesterID := c.Locals("userID").(uuid.UUID)
 
@@ -276,13 +366,9 @@ func (h *OrganizationHandler) UpdateMemberRole(c *fiber.Ctx) error {
 		})
 	}
 
-	err = h.orgUseCase.UpdateMemberRole(orgID, userID, requesterID, req.Role)
+	err = h.orgUseCase.UpdateMemberRole(c.UserContext(), orgID, userID, requesterID, req.Role)
 	if err != nil {
-		status := fiber.StatusInternalServerError
-		if err.Error() == "permission denied: admin role required" || err.Error() == "cannot change organization owner's role" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -291,3 +377,259 @@ func (h *OrganizationHandler) UpdateMemberRole(c *fiber.Ctx) error {
 		"message": "Member role updated successfully",
 	})
 }
+
+// GetRoles lists the built-in and custom roles of an organization (members:view)
+func (h *OrganizationHandler) GetRoles(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	roles, err := h.orgUseCase.GetRoles(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"roles":       roles,
+		"permissions": domain.AllPermissions,
+	})
+}
+
+// CreateRole defines a custom role (roles:manage)
+func (h *OrganizationHandler) CreateRole(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req CreateRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	role, err := h.orgUseCase.CreateRole(c.UserContext(), orgID, userID, req.Name, req.Description, req.Permissions)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Role created successfully",
+		"role":    role,
+	})
+}
+
+// UpdateRole replaces a custom role's permissions (roles:manage)
+func (h *OrganizationHandler) UpdateRole(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req UpdateRoleRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	role, err := h.orgUseCase.UpdateRole(c.UserContext(), orgID, userID, c.Params("name"), req.Description, req.Permissions)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Role updated successfully",
+		"role":    role,
+	})
+}
+
+// DeleteRole deletes an unused custom role (roles:manage)
+func (h *OrganizationHandler) DeleteRole(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	err = h.orgUseCase.DeleteRole(c.UserContext(), orgID, userID, c.Params("name"))
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Role deleted successfully",
+	})
+}
+
+// GetMyPermissions lists the current user's permissions in an organization
+func (h *OrganizationHandler) GetMyPermissions(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	permissions, err := h.orgUseCase.GetMyPermissions(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"permissions": permissions,
+	})
+}
+
+// ProposeOwnershipTransfer proposes an admin as the new organization owner
+func (h *OrganizationHandler) ProposeOwnershipTransfer(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req ProposeOwnershipTransferRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	transfer, err := h.orgUseCase.ProposeOwnershipTransfer(c.UserContext(), orgID, userID, req.UserID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message":  "Ownership transfer proposed successfully",
+		"transfer": transfer,
+	})
+}
+
+// GetOwnershipTransfer retrieves the pending ownership transfer of an organization
+func (h *OrganizationHandler) GetOwnershipTransfer(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	transfer, err := h.orgUseCase.GetOwnershipTransfer(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"transfer": transfer,
+	})
+}
+
+// CancelOwnershipTransfer withdraws the pending ownership transfer
+func (h *OrganizationHandler) CancelOwnershipTransfer(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	err = h.orgUseCase.CancelOwnershipTransfer(c.UserContext(), orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Ownership transfer cancelled successfully",
+	})
+}
+
+// AcceptOwnershipTransfer accepts the ownership transfer proposed to the current user
+func (h *OrganizationHandler) AcceptOwnershipTransfer(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	org, err := h.orgUseCase.AcceptOwnershipTransfer(c.UserContext(), orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":      "Ownership transferred successfully",
+		"organization": org,
+	})
+}
+
+// DeclineOwnershipTransfer declines the ownership transfer proposed to the current user
+func (h *OrganizationHandler) DeclineOwnershipTransfer(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	err = h.orgUseCase.DeclineOwnershipTransfer(c.UserContext(), orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Ownership transfer declined successfully",
+	})
+}
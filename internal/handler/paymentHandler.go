@@ -1,6 +1,9 @@
 package handler
 
 import (
+	"errors"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
 	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
 	"github.com/gofiber/fiber/v2"
 	"github.com/google/uuid"
@@ -31,9 +34,9 @@ func (h *PaymentHandler) CreatePayment(c *fiber.Ctx) error {
 		})
 	}
 
-	payment, err := h.paymentUseCase.CreatePayment(req, userID)
+	payment, err := h.paymentUseCase.CreatePayment(c.UserContext(), req, userID)
 	if err != nil {
-		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -44,8 +47,10 @@ func (h *PaymentHandler) CreatePayment(c *fiber.Ctx) error {
 	})
 }
 
-// GetPayment retrieves a payment by ID
+// GetPayment retrieves a payment by ID (buyer or organization admin only)
 func (h *PaymentHandler) GetPayment(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
 	paymentID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -53,11 +58,9 @@ func (h *PaymentHandler) GetPayment(c *fiber.Ctx) error {
 		})
 	}
 
-	payment, err := h.paymentUseCase.GetPaymentByID(paymentID)
+	payment, err := h.paymentUseCase.GetPaymentByID(paymentID, userID)
 	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"error": "Payment not found",
-		})
+		return paymentLookupError(c, err)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
@@ -65,8 +68,10 @@ func (h *PaymentHandler) GetPayment(c *fiber.Ctx) error {
 	})
 }
 
-// GetPaymentByOrderID retrieves a payment by order ID
+// GetPaymentByOrderID retrieves a payment by order ID (buyer or organization admin only)
 func (h *PaymentHandler) GetPaymentByOrderID(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
 	orderID := c.Params("orderId")
 	if orderID == "" {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -74,11 +79,9 @@ func (h *PaymentHandler) GetPaymentByOrderID(c *fiber.Ctx) error {
 		})
 	}
 
-	payment, err := h.paymentUseCase.GetPaymentByOrderID(orderID)
+	payment, err := h.paymentUseCase.GetPaymentByOrderID(orderID, userID)
 	if err != nil {
-		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
-			"error": "Payment not found",
-		})
+		return paymentLookupError(c, err)
 	}
 
 	return c.Status(fiber.StatusOK).JSON(fiber.Map{
@@ -102,8 +105,10 @@ func (h *PaymentHandler) GetMyPayments(c *fiber.Ctx) error {
 	})
 }
 
-// GetEventPayments retrieves all payments for an event
+// GetEventPayments retrieves all payments for an event (admin only)
 func (h *PaymentHandler) GetEventPayments(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
 	eventID, err := uuid.Parse(c.Params("eventId"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -111,9 +116,9 @@ func (h *PaymentHandler) GetEventPayments(c *fiber.Ctx) error {
 		})
 	}
 
-	payments, err := h.paymentUseCase.GetEventPayments(eventID)
+	payments, err := h.paymentUseCase.GetEventPayments(eventID, userID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -123,8 +128,10 @@ func (h *PaymentHandler) GetEventPayments(c *fiber.Ctx) error {
 	})
 }
 
-// GetEventAttendees retrieves the attendee list for an event (completed payments only)
+// GetEventAttendees retrieves the attendee list for an event (completed payments only, members only)
 func (h *PaymentHandler) GetEventAttendees(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
 	eventID, err := uuid.Parse(c.Params("eventId"))
 	if err != nil {
 		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
@@ -132,9 +139,9 @@ func (h *PaymentHandler) GetEventAttendees(c *fiber.Ctx) error {
 		})
 	}
 
-	attendees, err := h.paymentUseCase.GetEventAttendees(eventID)
+	attendees, err := h.paymentUseCase.GetEventAttendees(eventID, userID)
 	if err != nil {
-		return c.Status(fiber.StatusInternalServerError).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -165,8 +172,14 @@ func (h *PaymentHandler) CompletePayment(c *fiber.Ctx) error {
 		})
 	}
 
-	payment, err := h.paymentUseCase.CompletePayment(req.OrderID, req.PaymentKey)
+	payment, err := h.paymentUseCase.CompletePayment(c.UserContext(), req.OrderID, req.PaymentKey)
 	if err != nil {
+		if errors.Is(err, domain.ErrConflict) {
+			return c.Status(fiber.StatusConflict).JSON(fiber.Map{
+				"error": "취소된 행사의 결제는 완료할 수 없습니다.",
+			})
+		}
+
 		// Check error type and return appropriate Korean message
 		errMsg := err.Error()
 		var statusCode int
@@ -197,11 +210,7 @@ func (h *PaymentHandler) CompletePayment(c *fiber.Ctx) error {
 
 // CancelPayment cancels a payment and restores tickets
 func (h *PaymentHandler) CancelPayment(c *fiber.Ctx) error {
-	// Get user ID from context (optional for guest checkout)
-	var userID *uuid.UUID
-	if id, ok := c.Locals("userID").(uuid.UUID); ok {
-		userID = &id
-	}
+	userID := c.Locals("userID").(uuid.UUID)
 
 	paymentID, err := uuid.Parse(c.Params("id"))
 	if err != nil {
@@ -210,13 +219,9 @@ func (h *PaymentHandler) CancelPayment(c *fiber.Ctx) error {
 		})
 	}
 
-	payment, err := h.paymentUseCase.CancelPayment(paymentID, userID)
+	payment, err := h.paymentUseCase.CancelPayment(c.UserContext(), paymentID, userID)
 	if err != nil {
-		status := fiber.StatusBadRequest
-		if err.Error() == "permission denied: you can only cancel your own payments" {
-			status = fiber.StatusForbidden
-		}
-		return c.Status(status).JSON(fiber.Map{
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
 			"error": err.Error(),
 		})
 	}
@@ -226,3 +231,39 @@ func (h *PaymentHandler) CancelPayment(c *fiber.Ctx) error {
 		"payment": payment,
 	})
 }
+
+// RefundForDateChange fully refunds the buyer's order because the event date moved
+func (h *PaymentHandler) RefundForDateChange(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	paymentID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid payment ID",
+		})
+	}
+
+	payment, err := h.paymentUseCase.RefundForDateChange(c.UserContext(), paymentID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Payment refunded successfully",
+		"payment": payment,
+	})
+}
+
+// paymentLookupError responds 403 when access to the payment was denied and 404 otherwise
+func paymentLookupError(c *fiber.Ctx, err error) error {
+	if errors.Is(err, domain.ErrPermissionDenied) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+		"error": "Payment not found",
+	})
+}
@@ -0,0 +1,102 @@
+package handler
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+func TestEventPaymentRoutesRequirePermission(t *testing.T) {
+	for _, route := range []string{"payments", "attendees"} {
+		path := func(f *accessFixture) string { return "/api/events/" + f.eventID.String() + "/" + route }
+		checkAccess(t, http.MethodGet, path, "", []accessCase{
+			// Buying a ticket does not let the buyer list everyone else's
+			caller("buyer", fiber.StatusForbidden, asBuyer),
+			caller("viewer", fiber.StatusForbidden, asViewer),
+			caller("finance", fiber.StatusOK, asFinance),
+			caller("owner", fiber.StatusOK, asOwner),
+			caller("outsider", fiber.StatusForbidden, asOutsider),
+		})
+
+		f := newAccessFixture()
+		missing := "/api/events/" + uuid.NewString() + "/" + route
+		if got := f.status(t, http.MethodGet, missing, f.finance, ""); got != fiber.StatusNotFound {
+			t.Errorf("%s: got %d, want %d", missing, got, fiber.StatusNotFound)
+		}
+	}
+}
+
+func TestPaymentRoutesAllowBuyerOrPermission(t *testing.T) {
+	paths := map[string]func(f *accessFixture) string{
+		"by id":    func(f *accessFixture) string { return "/api/payments/" + f.paymentID.String() },
+		"by order": func(f *accessFixture) string { return "/api/payments/order/" + f.orderID },
+	}
+	for name, path := range paths {
+		checkAccess(t, http.MethodGet, path, "", []accessCase{
+			caller("buyer", fiber.StatusOK, asBuyer),
+			caller("viewer", fiber.StatusForbidden, asViewer),
+			caller("finance", fiber.StatusOK, asFinance),
+			caller("outsider", fiber.StatusForbidden, asOutsider),
+		})
+
+		f := newAccessFixture()
+		missing := map[string]string{
+			"by id":    "/api/payments/" + uuid.NewString(),
+			"by order": "/api/payments/order/missing-order",
+		}[name]
+		if got := f.status(t, http.MethodGet, missing, f.buyer, ""); got != fiber.StatusNotFound {
+			t.Errorf("%s: got %d, want %d", missing, got, fiber.StatusNotFound)
+		}
+	}
+}
+
+func TestCancelPaymentRequiresBuyerOrRefundPermission(t *testing.T) {
+	path := func(f *accessFixture) string { return "/api/payments/" + f.paymentID.String() }
+	checkAccess(t, http.MethodDelete, path, "", []accessCase{
+		caller("buyer", fiber.StatusOK, asBuyer),
+		caller("finance", fiber.StatusOK, asFinance),
+		caller("admin", fiber.StatusOK, asAdmin),
+		// Selling and checking in tickets does not extend to refunding them
+		caller("checkin staff", fiber.StatusForbidden, asCheckinStaff),
+		caller("event manager", fiber.StatusForbidden, asEventManager),
+		caller("viewer", fiber.StatusForbidden, asViewer),
+		caller("outsider", fiber.StatusForbidden, asOutsider),
+	})
+
+	f := newAccessFixture()
+	missing := "/api/payments/" + uuid.NewString()
+	if got := f.status(t, http.MethodDelete, missing, f.buyer, ""); got != fiber.StatusNotFound {
+		t.Errorf("%s: got %d, want %d", missing, got, fiber.StatusNotFound)
+	}
+}
+
+func TestCancelPaymentRefundsThroughGateway(t *testing.T) {
+	f := newAccessFixture()
+
+	path := "/api/payments/" + f.paymentID.String()
+	if got := f.status(t, http.MethodDelete, path, f.buyer, ""); got != fiber.StatusOK {
+		t.Fatalf("cancel: got %d, want %d", got, fiber.StatusOK)
+	}
+
+	// The payment ID is the idempotency key, so a retried cancellation cannot refund twice
+	if len(f.gateway.refunds) != 1 || f.gateway.refunds[0] != f.paymentID.String() {
+		t.Errorf("gateway refunds: got %v, want [%s]", f.gateway.refunds, f.paymentID)
+	}
+	payment, _ := f.payments.GetByID(f.paymentID)
+	if payment.Status != "refunded" {
+		t.Errorf("payment status: got %q, want %q", payment.Status, "refunded")
+	}
+	if available := f.eventRepo.events[f.eventID].AvailableTickets; available != 99 {
+		t.Errorf("available tickets: got %d, want 99", available)
+	}
+
+	// A refunded payment cannot be cancelled again
+	if got := f.status(t, http.MethodDelete, path, f.buyer, ""); got != fiber.StatusBadRequest {
+		t.Errorf("second cancel: got %d, want %d", got, fiber.StatusBadRequest)
+	}
+	if len(f.gateway.refunds) != 1 {
+		t.Errorf("gateway refunds after second cancel: got %d, want 1", len(f.gateway.refunds))
+	}
+}
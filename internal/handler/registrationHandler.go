@@ -0,0 +1,72 @@
+package handler
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RegistrationHandler struct {
+	registrationUseCase usecase.RegistrationUseCase
+}
+
+func NewRegistrationHandler(registrationUseCase usecase.RegistrationUseCase) *RegistrationHandler {
+	return &RegistrationHandler{
+		registrationUseCase: registrationUseCase,
+	}
+}
+
+// GetForm retrieves an event's registration questions
+// Served on both the public and the authenticated routes; anonymous callers only see forms of public events
+func (h *RegistrationHandler) GetForm(c *fiber.Ctx) error {
+	userID, _ := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	questions, err := h.registrationUseCase.GetForm(eventID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusNotFound)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"questions": questions,
+	})
+}
+
+// UpdateForm replaces an event's registration questions (admin only)
+func (h *RegistrationHandler) UpdateForm(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	var req usecase.UpdateRegistrationFormRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	questions, err := h.registrationUseCase.UpdateForm(eventID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message":   "Registration form updated successfully",
+		"questions": questions,
+	})
+}
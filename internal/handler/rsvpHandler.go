@@ -0,0 +1,139 @@
+package handler
+
+import (
+	"context"
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type RsvpHandler struct {
+	rsvpUseCase usecase.RsvpUseCase
+}
+
+func NewRsvpHandler(rsvpUseCase usecase.RsvpUseCase) *RsvpHandler {
+	return &RsvpHandler{
+		rsvpUseCase: rsvpUseCase,
+	}
+}
+
+// Respond records the current user's "going" / "not going" response to a free event
+func (h *RsvpHandler) Respond(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	var req usecase.RsvpRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	rsvp, err := h.rsvpUseCase.Respond(c.UserContext(), eventID, userID, req)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "RSVP updated successfully",
+		"rsvp":    rsvp,
+	})
+}
+
+// GetMyRsvp retrieves the current user's RSVP for an event
+func (h *RsvpHandler) GetMyRsvp(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	rsvp, err := h.rsvpUseCase.GetMyRsvp(eventID, userID)
+	if err != nil {
+		return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+			"error": "RSVP not found",
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"rsvp": rsvp,
+	})
+}
+
+// GetEventRsvps retrieves RSVPs for an event, filtered by ?status= (admin only)
+func (h *RsvpHandler) GetEventRsvps(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	rsvps, err := h.rsvpUseCase.GetEventRsvps(eventID, userID, c.Query("status"))
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"rsvps": rsvps,
+		"count": len(rsvps),
+	})
+}
+
+// ApproveRsvp approves a pending RSVP (admin only)
+func (h *RsvpHandler) ApproveRsvp(c *fiber.Ctx) error {
+	return h.review(c, h.rsvpUseCase.ApproveRsvp, "RSVP approved successfully")
+}
+
+// DeclineRsvp declines a pending RSVP (admin only)
+func (h *RsvpHandler) DeclineRsvp(c *fiber.Ctx) error {
+	return h.review(c, h.rsvpUseCase.DeclineRsvp, "RSVP declined successfully")
+}
+
+func (h *RsvpHandler) review(c *fiber.Ctx, action func(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Rsvp, error), message string) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("eventId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	paymentID, err := uuid.Parse(c.Params("rsvpId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid RSVP ID",
+		})
+	}
+
+	rsvp, err := action(c.UserContext(), eventID, paymentID, userID)
+	if err != nil {
+		return h.errorResponse(c, err)
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": message,
+		"rsvp":    rsvp,
+	})
+}
+
+func (h *RsvpHandler) errorResponse(c *fiber.Ctx, err error) error {
+	return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,36 @@
+package handler
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+func TestRsvpManagementRequiresPermission(t *testing.T) {
+	routes := []struct {
+		method string
+		path   func(f *accessFixture) string
+	}{
+		{http.MethodGet, func(f *accessFixture) string { return "/api/events/" + f.eventID.String() + "/rsvps" }},
+		{http.MethodPost, func(f *accessFixture) string {
+			return "/api/events/" + f.eventID.String() + "/rsvps/" + f.rsvpID.String() + "/approve"
+		}},
+		{http.MethodPost, func(f *accessFixture) string {
+			return "/api/events/" + f.eventID.String() + "/rsvps/" + f.rsvpID.String() + "/decline"
+		}},
+	}
+
+	for _, route := range routes {
+		checkAccess(t, route.method, route.path, "", []accessCase{
+			caller("event manager", fiber.StatusOK, asEventManager),
+			caller("admin", fiber.StatusOK, asAdmin),
+			caller("owner", fiber.StatusOK, asOwner),
+			caller("finance", fiber.StatusForbidden, asFinance),
+			caller("checkin staff", fiber.StatusForbidden, asCheckinStaff),
+			caller("viewer", fiber.StatusForbidden, asViewer),
+			// The guest who RSVPed cannot approve their own RSVP
+			caller("rsvp holder", fiber.StatusForbidden, asOutsider),
+		})
+	}
+}
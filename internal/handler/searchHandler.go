@@ -0,0 +1,53 @@
+package handler
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+type SearchHandler struct {
+	searchUseCase usecase.SearchUseCase
+}
+
+func NewSearchHandler(searchUseCase usecase.SearchUseCase) *SearchHandler {
+	return &SearchHandler{
+		searchUseCase: searchUseCase,
+	}
+}
+
+// Suggest returns autocomplete suggestions for the search box
+func (h *SearchHandler) Suggest(c *fiber.Ctx) error {
+	q := c.Query("q")
+	if q == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Query is required",
+		})
+	}
+
+	suggestions, err := h.searchUseCase.Suggest(q)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"suggestions": suggestions,
+		"count":       len(suggestions),
+	})
+}
+
+// GetTrendingKeywords returns the most searched keywords of the last day
+func (h *SearchHandler) GetTrendingKeywords(c *fiber.Ctx) error {
+	keywords, err := h.searchUseCase.GetTrendingKeywords(c.QueryInt("limit", 0))
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"keywords": keywords,
+		"count":    len(keywords),
+	})
+}
@@ -0,0 +1,205 @@
+package handler
+
+import (
+	"context"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type SeriesHandler struct {
+	seriesUseCase usecase.SeriesUseCase
+}
+
+func NewSeriesHandler(seriesUseCase usecase.SeriesUseCase) *SeriesHandler {
+	return &SeriesHandler{
+		seriesUseCase: seriesUseCase,
+	}
+}
+
+// CreateSeries creates a recurring event series and its occurrences
+func (h *SeriesHandler) CreateSeries(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req usecase.CreateSeriesRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	series, err := h.seriesUseCase.CreateSeries(c.UserContext(), orgID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Event series created successfully",
+		"series":  series,
+	})
+}
+
+// GetSeries retrieves a series with all its occurrences
+func (h *SeriesHandler) GetSeries(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	seriesID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid series ID",
+		})
+	}
+
+	series, err := h.seriesUseCase.GetSeries(seriesID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"series": series,
+	})
+}
+
+// GetOrganizationSeries lists an organization's series
+func (h *SeriesHandler) GetOrganizationSeries(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	series, err := h.seriesUseCase.GetOrganizationSeries(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"series": series,
+		"count":  len(series),
+	})
+}
+
+// UpdateFutureOccurrences applies an edit to an occurrence and all later occurrences of its series
+func (h *SeriesHandler) UpdateFutureOccurrences(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	eventID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid event ID",
+		})
+	}
+
+	var req usecase.UpdateEventRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	result, err := h.seriesUseCase.UpdateFutureOccurrences(c.UserContext(), eventID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":  err.Error(),
+			"result": result,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Occurrences updated successfully",
+		"result":  result,
+	})
+}
+
+// SubmitSeriesForReview submits a series' draft occurrences for review
+func (h *SeriesHandler) SubmitSeriesForReview(c *fiber.Ctx) error {
+	return h.review(c, h.seriesUseCase.SubmitSeriesForReview, "Series submitted for review")
+}
+
+// ApproveSeries approves a series' occurrences waiting for review
+func (h *SeriesHandler) ApproveSeries(c *fiber.Ctx) error {
+	return h.review(c, h.seriesUseCase.ApproveSeries, "Series approved successfully")
+}
+
+// RequestSeriesChanges sends a series' occurrences waiting for review back with a comment
+func (h *SeriesHandler) RequestSeriesChanges(c *fiber.Ctx) error {
+	return h.review(c, h.seriesUseCase.RequestSeriesChanges, "Changes requested successfully")
+}
+
+// RejectSeries rejects a series' occurrences waiting for review
+func (h *SeriesHandler) RejectSeries(c *fiber.Ctx) error {
+	return h.review(c, h.seriesUseCase.RejectSeries, "Series rejected successfully")
+}
+
+func (h *SeriesHandler) review(c *fiber.Ctx, action func(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*usecase.SeriesUpdateResult, error), message string) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	seriesID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid series ID",
+		})
+	}
+
+	var req struct {
+		Comment string `json:"comment"`
+	}
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+				"error": "Invalid request body",
+			})
+		}
+	}
+
+	result, err := action(c.UserContext(), seriesID, userID, req.Comment)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error":  err.Error(),
+			"result": result,
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": message,
+		"result":  result,
+	})
+}
+
+// GetPublicSeries retrieves a public series with its upcoming dates
+func (h *SeriesHandler) GetPublicSeries(c *fiber.Ctx) error {
+	seriesID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid series ID",
+		})
+	}
+
+	series, err := h.seriesUseCase.GetPublicSeries(seriesID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"series": series,
+	})
+}
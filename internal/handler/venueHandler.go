@@ -0,0 +1,206 @@
+package handler
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type VenueHandler struct {
+	venueUseCase usecase.VenueUseCase
+}
+
+func NewVenueHandler(venueUseCase usecase.VenueUseCase) *VenueHandler {
+	return &VenueHandler{
+		venueUseCase: venueUseCase,
+	}
+}
+
+// CreateVenue saves a venue for an organization
+func (h *VenueHandler) CreateVenue(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req usecase.VenueRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	venue, err := h.venueUseCase.CreateVenue(c.UserContext(), orgID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Venue created successfully",
+		"venue":   venue,
+	})
+}
+
+// GetOrganizationVenues lists the organization's venues together with the platform-wide ones
+func (h *VenueHandler) GetOrganizationVenues(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("orgId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	venues, err := h.venueUseCase.GetOrganizationVenues(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"venues": venues,
+	})
+}
+
+// CreatePlatformVenue saves a venue every organization can use
+func (h *VenueHandler) CreatePlatformVenue(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	var req usecase.VenueRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	venue, err := h.venueUseCase.CreatePlatformVenue(c.UserContext(), userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Venue created successfully",
+		"venue":   venue,
+	})
+}
+
+// GetPlatformVenues lists the platform-wide venues
+func (h *VenueHandler) GetPlatformVenues(c *fiber.Ctx) error {
+	venues, err := h.venueUseCase.GetPlatformVenues()
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"venues": venues,
+	})
+}
+
+// GetVenue retrieves a venue
+func (h *VenueHandler) GetVenue(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	venueID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid venue ID",
+		})
+	}
+
+	venue, err := h.venueUseCase.GetVenue(venueID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"venue": venue,
+	})
+}
+
+// UpdateVenue replaces a venue's details
+func (h *VenueHandler) UpdateVenue(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	venueID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid venue ID",
+		})
+	}
+
+	var req usecase.VenueRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	venue, err := h.venueUseCase.UpdateVenue(c.UserContext(), venueID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Venue updated successfully",
+		"venue":   venue,
+	})
+}
+
+// DeleteVenue deletes a venue
+func (h *VenueHandler) DeleteVenue(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	venueID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid venue ID",
+		})
+	}
+
+	if err := h.venueUseCase.DeleteVenue(c.UserContext(), venueID, userID); err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Venue deleted successfully",
+	})
+}
+
+// GetPublicVenue retrieves a venue's details for attendees (no authentication)
+func (h *VenueHandler) GetPublicVenue(c *fiber.Ctx) error {
+	venueID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid venue ID",
+		})
+	}
+
+	venue, err := h.venueUseCase.GetPublicVenue(venueID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"venue": venue,
+	})
+}
@@ -0,0 +1,191 @@
+package handler
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+)
+
+type WebhookHandler struct {
+	webhookUseCase usecase.WebhookUseCase
+}
+
+func NewWebhookHandler(webhookUseCase usecase.WebhookUseCase) *WebhookHandler {
+	return &WebhookHandler{
+		webhookUseCase: webhookUseCase,
+	}
+}
+
+// CreateEndpoint registers a webhook endpoint; the signing secret is only returned in this response
+func (h *WebhookHandler) CreateEndpoint(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	var req usecase.WebhookEndpointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	endpoint, err := h.webhookUseCase.CreateEndpoint(c.UserContext(), orgID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(fiber.Map{
+		"message": "Webhook endpoint created successfully",
+		"webhook": endpoint,
+	})
+}
+
+// GetEndpoints lists an organization's webhook endpoints
+func (h *WebhookHandler) GetEndpoints(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	endpoints, err := h.webhookUseCase.GetEndpoints(orgID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"webhooks": endpoints,
+		"count":    len(endpoints),
+	})
+}
+
+// UpdateEndpoint updates a webhook endpoint or rotates its secret
+func (h *WebhookHandler) UpdateEndpoint(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, endpointID, err := parseWebhookParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	var req usecase.UpdateWebhookEndpointRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid request body",
+		})
+	}
+
+	endpoint, err := h.webhookUseCase.UpdateEndpoint(c.UserContext(), orgID, endpointID, userID, req)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Webhook endpoint updated successfully",
+		"webhook": endpoint,
+	})
+}
+
+// DeleteEndpoint deletes a webhook endpoint and its delivery log
+func (h *WebhookHandler) DeleteEndpoint(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, endpointID, err := parseWebhookParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	if err := h.webhookUseCase.DeleteEndpoint(c.UserContext(), orgID, endpointID, userID); err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(fiber.Map{
+		"message": "Webhook endpoint deleted successfully",
+	})
+}
+
+// GetDeliveries lists a webhook endpoint's deliveries, newest first
+func (h *WebhookHandler) GetDeliveries(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, endpointID, err := parseWebhookParams(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	result, err := h.webhookUseCase.GetDeliveries(orgID, endpointID, userID, c.QueryInt("page", 1), c.QueryInt("limit", 0))
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusInternalServerError)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusOK).JSON(result)
+}
+
+// ReplayDelivery sends a past delivery again
+func (h *WebhookHandler) ReplayDelivery(c *fiber.Ctx) error {
+	userID := c.Locals("userID").(uuid.UUID)
+
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid organization ID",
+		})
+	}
+
+	deliveryID, err := uuid.Parse(c.Params("deliveryId"))
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(fiber.Map{
+			"error": "Invalid delivery ID",
+		})
+	}
+
+	delivery, err := h.webhookUseCase.ReplayDelivery(orgID, deliveryID, userID)
+	if err != nil {
+		return c.Status(errorStatus(err, fiber.StatusBadRequest)).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+
+	return c.Status(fiber.StatusAccepted).JSON(fiber.Map{
+		"message":  "Webhook delivery queued",
+		"delivery": delivery,
+	})
+}
+
+func parseWebhookParams(c *fiber.Ctx) (uuid.UUID, uuid.UUID, error) {
+	orgID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fiber.NewError(fiber.StatusBadRequest, "Invalid organization ID")
+	}
+
+	endpointID, err := uuid.Parse(c.Params("webhookId"))
+	if err != nil {
+		return uuid.Nil, uuid.Nil, fiber.NewError(fiber.StatusBadRequest, "Invalid webhook ID")
+	}
+
+	return orgID, endpointID, nil
+}
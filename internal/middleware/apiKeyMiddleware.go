@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
+	"github.com/gofiber/fiber/v2"
+)
+
+// APIKeyHeader carries an organization API key
+const APIKeyHeader = "X-API-Key"
+
+type APIKeyMiddleware struct {
+	apiKeyUseCase  usecase.APIKeyUseCase
+	authMiddleware *AuthMiddleware
+}
+
+func NewAPIKeyMiddleware(apiKeyUseCase usecase.APIKeyUseCase, authMiddleware *AuthMiddleware) *APIKeyMiddleware {
+	return &APIKeyMiddleware{
+		apiKeyUseCase:  apiKeyUseCase,
+		authMiddleware: authMiddleware,
+	}
+}
+
+// Authenticate accepts an organization API key, from the X-API-Key header or as a bearer token,
+// and falls back to user JWT authentication otherwise
+// Requests made with a key act as the key: userID is the key ID, which the authorization policy
+// resolves to the permissions of the key's scopes within its organization
+func (m *APIKeyMiddleware) Authenticate(c *fiber.Ctx) error {
+	raw := c.Get(APIKeyHeader)
+	if raw == "" {
+		if token := strings.TrimPrefix(c.Get("Authorization"), "Bearer "); m.apiKeyUseCase.IsAPIKey(token) {
+			raw = token
+		}
+	}
+	if raw == "" {
+		return m.authMiddleware.Authenticate(c)
+	}
+
+	key, err := m.apiKeyUseCase.Authenticate(raw)
+	if errors.Is(err, domain.ErrOrgInactive) {
+		return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+			"error": err.Error(),
+		})
+	}
+	if err != nil {
+		return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+			"error": "invalid, expired or revoked api key",
+		})
+	}
+
+	// Set key info in context
+	c.Locals("userID", key.ID)
+	c.Locals("apiKeyID", key.ID)
+	c.Locals("organizationID", key.OrganizationID)
+
+	// Attribute changes made during this request to the key and the member who created it
+	c.SetUserContext(domain.WithAuditActor(c.UserContext(), domain.AuditActor{
+		UserID:    key.CreatedBy,
+		APIKeyID:  &key.ID,
+		IP:        c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+	}))
+
+	return c.Next()
+}
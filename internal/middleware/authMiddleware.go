@@ -3,6 +3,7 @@ package middleware
 import (
 	"strings"
 
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
 	"github.com/dev-hyunsang/ticketly-backend/internal/usecase"
 	"github.com/gofiber/fiber/v2"
 )
@@ -49,5 +50,12 @@ func (m *AuthMiddleware) Authenticate(c *fiber.Ctx) error {
 	c.Locals("userID", claims.UserID)
 	c.Locals("email", claims.Email)
 
+	// Attribute changes made during this request in the audit log
+	c.SetUserContext(domain.WithAuditActor(c.UserContext(), domain.AuditActor{
+		UserID:    claims.UserID,
+		IP:        c.IP(),
+		UserAgent: c.Get(fiber.HeaderUserAgent),
+	}))
+
 	return c.Next()
 }
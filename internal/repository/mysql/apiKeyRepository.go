@@ -0,0 +1,156 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/apikey"
+	"github.com/google/uuid"
+)
+
+type apiKeyRepository struct {
+	client *ent.Client
+}
+
+func NewAPIKeyRepository(client *ent.Client) domain.APIKeyRepository {
+	return &apiKeyRepository{
+		client: client,
+	}
+}
+
+// Create creates a new API key
+func (r *apiKeyRepository) Create(ctx context.Context, key *domain.APIKey) (*domain.APIKey, error) {
+	created, err := r.client.APIKey.
+		Create().
+		SetID(key.ID).
+		SetOrganizationID(key.OrganizationID).
+		SetName(key.Name).
+		SetPrefix(key.Prefix).
+		SetSecretHash(key.SecretHash).
+		SetScopes(key.Scopes).
+		SetCreatedBy(key.CreatedBy).
+		SetNillableExpiresAt(key.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create api key: %w", err)
+	}
+
+	return mapAPIKeyToDomain(created), nil
+}
+
+// GetByID retrieves an API key by ID
+func (r *apiKeyRepository) GetByID(keyID uuid.UUID) (*domain.APIKey, error) {
+	ctx := context.Background()
+
+	key, err := r.client.APIKey.
+		Query().
+		Where(apikey.ID(keyID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	return mapAPIKeyToDomain(key), nil
+}
+
+// GetByPrefix retrieves an API key by its public prefix
+func (r *apiKeyRepository) GetByPrefix(prefix string) (*domain.APIKey, error) {
+	ctx := context.Background()
+
+	key, err := r.client.APIKey.
+		Query().
+		Where(apikey.Prefix(prefix)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get api key: %w", err)
+	}
+
+	return mapAPIKeyToDomain(key), nil
+}
+
+// GetByOrganizationID retrieves every API key of an organization, including revoked ones
+func (r *apiKeyRepository) GetByOrganizationID(orgID uuid.UUID) ([]*domain.APIKey, error) {
+	ctx := context.Background()
+
+	keys, err := r.client.APIKey.
+		Query().
+		Where(apikey.OrganizationID(orgID)).
+		Order(ent.Desc(apikey.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get api keys: %w", err)
+	}
+
+	result := make([]*domain.APIKey, len(keys))
+	for i, key := range keys {
+		result[i] = mapAPIKeyToDomain(key)
+	}
+	return result, nil
+}
+
+// UpdateLastUsed records when a key last authenticated a request
+func (r *apiKeyRepository) UpdateLastUsed(keyID uuid.UUID, usedAt time.Time) error {
+	ctx := context.Background()
+
+	err := r.client.APIKey.
+		UpdateOneID(keyID).
+		SetLastUsedAt(usedAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update api key last used: %w", err)
+	}
+
+	return nil
+}
+
+// Revoke revokes an API key that has not been revoked yet
+func (r *apiKeyRepository) Revoke(ctx context.Context, keyID uuid.UUID) error {
+	affected, err := r.client.APIKey.
+		Update().
+		Where(
+			apikey.ID(keyID),
+			apikey.RevokedAtIsNil(),
+		).
+		SetRevokedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to revoke api key: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+func mapAPIKeyToDomain(key *ent.APIKey) *domain.APIKey {
+	return &domain.APIKey{
+		ID:             key.ID,
+		OrganizationID: key.OrganizationID,
+		Name:           key.Name,
+		Prefix:         key.Prefix,
+		SecretHash:     key.SecretHash,
+		Scopes:         key.Scopes,
+		CreatedBy:      key.CreatedBy,
+		ExpiresAt:      key.ExpiresAt,
+		LastUsedAt:     key.LastUsedAt,
+		RevokedAt:      key.RevokedAt,
+		CreatedAt:      key.CreatedAt,
+		UpdatedAt:      key.UpdatedAt,
+	}
+}
@@ -0,0 +1,372 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/apikey"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/hook"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationmember"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationrole"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookendpoint"
+	"github.com/google/uuid"
+)
+
+// auditedTypes maps the audited ent types to the target type stored in the log
+var auditedTypes = map[string]string{
+	ent.TypeOrganization:           "organization",
+	ent.TypeOrganizationMember:     "organization_member",
+	ent.TypeOrganizationRole:       "organization_role",
+	ent.TypeOrganizationInvitation: "organization_invitation",
+	ent.TypeOwnershipTransfer:      "ownership_transfer",
+	ent.TypeEvent:                  "event",
+	ent.TypeEventSeries:            "event_series",
+	ent.TypePayment:                "payment",
+	ent.TypeAPIKey:                 "api_key",
+	ent.TypeWebhookEndpoint:        "webhook_endpoint",
+	ent.TypeVenue:                  "venue",
+}
+
+// auditIgnoredFields change as a side effect of other actions and are left out of diffs
+var auditIgnoredFields = map[string]bool{
+	"created_at":        true,
+	"updated_at":        true,
+	"available_tickets": true,
+	"participant_count": true,
+	"last_used_at":      true,
+	"title_initials":    true,
+	"venue_initials":    true,
+	"name_initials":     true,
+	"geohash":           true,
+}
+
+// auditRedactedFields are recorded as changed without their values
+var auditRedactedFields = map[string]bool{
+	"payment_key": true,
+	"secret_hash": true,
+	"secret":      true,
+}
+
+// auditMutation is implemented by every generated mutation
+type auditMutation interface {
+	ent.Mutation
+	Client() *ent.Client
+	ID() (uuid.UUID, bool)
+	IDs(ctx context.Context) ([]uuid.UUID, error)
+}
+
+// RegisterAuditHooks records every change to organizations, members, roles, invitations,
+// ownership transfers, API keys, webhook endpoints, venues, events, event series and payments in the audit log, and makes the log append-only
+func RegisterAuditHooks(client *ent.Client) {
+	client.Use(auditHook)
+	client.AuditLog.Use(hook.Reject(ent.OpUpdate | ent.OpUpdateOne | ent.OpDelete | ent.OpDeleteOne))
+}
+
+func auditHook(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		targetType, ok := auditedTypes[m.Type()]
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+		am, ok := m.(auditMutation)
+		if !ok {
+			return next.Mutate(ctx, m)
+		}
+
+		// Rows must be read before the change: bulk updates may no longer match their predicates afterwards
+		var ids []uuid.UUID
+		var snapshots map[uuid.UUID]map[string]interface{}
+		var oldValues map[string]interface{}
+		if !m.Op().Is(ent.OpCreate) {
+			var err error
+			ids, err = am.IDs(ctx)
+			if err != nil {
+				return nil, fmt.Errorf("failed to read audited rows: %w", err)
+			}
+			snapshots, err = loadAuditSnapshots(ctx, am.Client(), m.Type(), ids)
+			if err != nil {
+				return nil, err
+			}
+			if m.Op().Is(ent.OpUpdateOne) {
+				oldValues = make(map[string]interface{})
+				for _, name := range append(m.Fields(), m.ClearedFields()...) {
+					if old, err := m.OldField(ctx, name); err == nil {
+						oldValues[name] = old
+					}
+				}
+			}
+		}
+
+		value, err := next.Mutate(ctx, m)
+		if err != nil {
+			return value, err
+		}
+
+		if m.Op().Is(ent.OpCreate) {
+			if id, ok := am.ID(); ok {
+				ids = []uuid.UUID{id}
+			}
+		}
+
+		entries := make([]*domain.AuditEntry, 0, len(ids))
+		for _, id := range ids {
+			entry := buildAuditEntry(am, targetType, id, snapshots[id], oldValues)
+			if entry != nil {
+				entries = append(entries, entry)
+			}
+		}
+		writeAuditEntries(ctx, am.Client(), entries)
+
+		return value, nil
+	})
+}
+
+// buildAuditEntry describes the change to one row, or returns nil if nothing worth recording changed
+func buildAuditEntry(m auditMutation, targetType string, id uuid.UUID, snapshot, oldValues map[string]interface{}) *domain.AuditEntry {
+	var action string
+	var before, after map[string]interface{}
+
+	switch {
+	case m.Op().Is(ent.OpCreate):
+		action = targetType + ".created"
+		after = make(map[string]interface{})
+		for _, name := range m.Fields() {
+			value, _ := m.Field(name)
+			after[name] = normalizeAuditValue(value)
+		}
+
+	case m.Op().Is(ent.OpDelete | ent.OpDeleteOne):
+		action = targetType + ".deleted"
+		before = snapshot
+
+	default:
+		action = targetType + ".updated"
+		before = make(map[string]interface{})
+		after = make(map[string]interface{})
+		changed := func(name string, newValue interface{}) {
+			var oldValue interface{}
+			if old, ok := oldValues[name]; ok {
+				oldValue = normalizeAuditValue(old)
+			} else {
+				oldValue = snapshot[name]
+			}
+			newValue = normalizeAuditValue(newValue)
+			if reflect.DeepEqual(oldValue, newValue) {
+				return
+			}
+			before[name] = oldValue
+			after[name] = newValue
+		}
+		for _, name := range m.Fields() {
+			value, _ := m.Field(name)
+			changed(name, value)
+		}
+		for _, name := range m.ClearedFields() {
+			changed(name, nil)
+		}
+	}
+
+	for name := range auditIgnoredFields {
+		delete(before, name)
+		delete(after, name)
+	}
+	if len(before) == 0 && len(after) == 0 {
+		return nil
+	}
+	for name := range auditRedactedFields {
+		if _, ok := before[name]; ok {
+			before[name] = "[redacted]"
+		}
+		if _, ok := after[name]; ok {
+			after[name] = "[redacted]"
+		}
+	}
+
+	entry := &domain.AuditEntry{
+		ID:         uuid.New(),
+		Action:     action,
+		TargetType: targetType,
+		TargetID:   id.String(),
+		Before:     before,
+		After:      after,
+	}
+
+	// Organization the row belongs to; payments belong to their event's organization
+	switch m.Type() {
+	case ent.TypeOrganization:
+		entry.OrganizationID = &id
+	case ent.TypePayment:
+		entry.Metadata = map[string]interface{}{"event_id": auditFieldValue(m, "event_id", snapshot)}
+	default:
+		if orgID, err := uuid.Parse(auditFieldValue(m, "organization_id", snapshot)); err == nil {
+			entry.OrganizationID = &orgID
+		}
+	}
+
+	return entry
+}
+
+// auditFieldValue reads a field from the mutation, falling back to the row as it was before the change
+func auditFieldValue(m ent.Mutation, name string, snapshot map[string]interface{}) string {
+	if value, ok := m.Field(name); ok {
+		return fmt.Sprint(value)
+	}
+	if value, ok := snapshot[name]; ok {
+		return fmt.Sprint(value)
+	}
+	return ""
+}
+
+// writeAuditEntries stores the entries with the actor of the request
+// Failures are logged rather than returned because the change itself has already been applied
+func writeAuditEntries(ctx context.Context, client *ent.Client, entries []*domain.AuditEntry) {
+	if len(entries) == 0 {
+		return
+	}
+
+	actor, hasActor := domain.AuditActorFromContext(ctx)
+
+	// Resolve payment organizations through their events
+	eventOrgs := make(map[string]*uuid.UUID)
+	for _, entry := range entries {
+		if entry.TargetType != auditedTypes[ent.TypePayment] {
+			continue
+		}
+		eventID, _ := entry.Metadata["event_id"].(string)
+		orgID, ok := eventOrgs[eventID]
+		if !ok {
+			if id, err := uuid.Parse(eventID); err == nil {
+				if evt, err := client.Event.Query().Where(event.ID(id)).Only(ctx); err == nil {
+					orgID = &evt.OrganizationID
+				}
+			}
+			eventOrgs[eventID] = orgID
+		}
+		entry.OrganizationID = orgID
+	}
+
+	for _, entry := range entries {
+		builder := client.AuditLog.
+			Create().
+			SetID(entry.ID).
+			SetNillableOrganizationID(entry.OrganizationID).
+			SetAction(entry.Action).
+			SetTargetType(entry.TargetType).
+			SetTargetID(entry.TargetID)
+		if entry.Before != nil {
+			builder.SetBefore(entry.Before)
+		}
+		if entry.After != nil {
+			builder.SetAfter(entry.After)
+		}
+		if hasActor && actor.APIKeyID != nil {
+			if entry.Metadata == nil {
+				entry.Metadata = make(map[string]interface{})
+			}
+			entry.Metadata["api_key_id"] = actor.APIKeyID.String()
+		}
+		if entry.Metadata != nil {
+			builder.SetMetadata(entry.Metadata)
+		}
+		if hasActor {
+			builder.
+				SetActorID(actor.UserID).
+				SetIP(actor.IP).
+				SetUserAgent(actor.UserAgent)
+		}
+
+		if err := builder.Exec(ctx); err != nil {
+			fmt.Printf("Warning: failed to write audit entry for %s %s: %v\n", entry.TargetType, entry.TargetID, err)
+		}
+	}
+}
+
+// loadAuditSnapshots reads the rows a mutation is about to change, keyed by ID
+func loadAuditSnapshots(ctx context.Context, client *ent.Client, typ string, ids []uuid.UUID) (map[uuid.UUID]map[string]interface{}, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	var rows interface{}
+	var err error
+	switch typ {
+	case ent.TypeOrganization:
+		rows, err = client.Organization.Query().Where(organization.IDIn(ids...)).All(ctx)
+	case ent.TypeOrganizationMember:
+		rows, err = client.OrganizationMember.Query().Where(organizationmember.IDIn(ids...)).All(ctx)
+	case ent.TypeOrganizationRole:
+		rows, err = client.OrganizationRole.Query().Where(organizationrole.IDIn(ids...)).All(ctx)
+	case ent.TypeOrganizationInvitation:
+		rows, err = client.OrganizationInvitation.Query().Where(organizationinvitation.IDIn(ids...)).All(ctx)
+	case ent.TypeOwnershipTransfer:
+		rows, err = client.OwnershipTransfer.Query().Where(ownershiptransfer.IDIn(ids...)).All(ctx)
+	case ent.TypeEvent:
+		rows, err = client.Event.Query().Where(event.IDIn(ids...)).All(ctx)
+	case ent.TypeEventSeries:
+		rows, err = client.EventSeries.Query().Where(eventseries.IDIn(ids...)).All(ctx)
+	case ent.TypePayment:
+		rows, err = client.Payment.Query().Where(payment.IDIn(ids...)).All(ctx)
+	case ent.TypeAPIKey:
+		rows, err = client.APIKey.Query().Where(apikey.IDIn(ids...)).All(ctx)
+	case ent.TypeWebhookEndpoint:
+		rows, err = client.WebhookEndpoint.Query().Where(webhookendpoint.IDIn(ids...)).All(ctx)
+	case ent.TypeVenue:
+		rows, err = client.Venue.Query().Where(venue.IDIn(ids...)).All(ctx)
+	default:
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read audited rows: %w", err)
+	}
+
+	// Entities marshal with their field names as keys; empty values are omitted
+	data, err := json.Marshal(rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode audited rows: %w", err)
+	}
+	var decoded []map[string]interface{}
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return nil, fmt.Errorf("failed to decode audited rows: %w", err)
+	}
+
+	snapshots := make(map[uuid.UUID]map[string]interface{}, len(decoded))
+	for _, row := range decoded {
+		id, err := uuid.Parse(fmt.Sprint(row["id"]))
+		if err != nil {
+			continue
+		}
+		delete(row, "id")
+		delete(row, "edges")
+		snapshots[id] = row
+	}
+
+	return snapshots, nil
+}
+
+// normalizeAuditValue converts a field value to its JSON form so values read from
+// mutations compare equal to values read from snapshots
+func normalizeAuditValue(value interface{}) interface{} {
+	if value == nil {
+		return nil
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprint(value)
+	}
+	var normalized interface{}
+	if err := json.Unmarshal(data, &normalized); err != nil {
+		return fmt.Sprint(value)
+	}
+	return normalized
+}
@@ -0,0 +1,86 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/auditlog"
+)
+
+type auditRepository struct {
+	client *ent.Client
+}
+
+func NewAuditRepository(client *ent.Client) domain.AuditRepository {
+	return &auditRepository{
+		client: client,
+	}
+}
+
+// List retrieves an organization's audit entries, newest first, with the total number of matches
+func (r *auditRepository) List(filter domain.AuditFilter, offset, limit int) ([]*domain.AuditEntry, int, error) {
+	ctx := context.Background()
+
+	query := r.client.AuditLog.
+		Query().
+		Where(auditlog.OrganizationID(filter.OrganizationID))
+
+	if filter.ActorID != nil {
+		query = query.Where(auditlog.ActorID(*filter.ActorID))
+	}
+	if filter.Action != "" {
+		query = query.Where(auditlog.Action(filter.Action))
+	}
+	if filter.TargetType != "" {
+		query = query.Where(auditlog.TargetType(filter.TargetType))
+	}
+	if filter.TargetID != "" {
+		query = query.Where(auditlog.TargetID(filter.TargetID))
+	}
+	if filter.From != nil {
+		query = query.Where(auditlog.CreatedAtGTE(*filter.From))
+	}
+	if filter.To != nil {
+		query = query.Where(auditlog.CreatedAtLT(*filter.To))
+	}
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count audit entries: %w", err)
+	}
+
+	entries, err := query.
+		Order(ent.Desc(auditlog.FieldCreatedAt), ent.Desc(auditlog.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get audit entries: %w", err)
+	}
+
+	result := make([]*domain.AuditEntry, len(entries))
+	for i, entry := range entries {
+		result[i] = mapAuditEntryToDomain(entry)
+	}
+
+	return result, total, nil
+}
+
+func mapAuditEntryToDomain(entry *ent.AuditLog) *domain.AuditEntry {
+	return &domain.AuditEntry{
+		ID:             entry.ID,
+		OrganizationID: entry.OrganizationID,
+		ActorID:        entry.ActorID,
+		Action:         entry.Action,
+		TargetType:     entry.TargetType,
+		TargetID:       entry.TargetID,
+		Before:         entry.Before,
+		After:          entry.After,
+		Metadata:       entry.Metadata,
+		IP:             entry.IP,
+		UserAgent:      entry.UserAgent,
+		CreatedAt:      entry.CreatedAt,
+	}
+}
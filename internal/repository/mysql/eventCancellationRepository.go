@@ -0,0 +1,322 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/google/uuid"
+)
+
+type eventCancellationRepository struct {
+	client *ent.Client
+}
+
+func NewEventCancellationRepository(client *ent.Client) domain.EventCancellationRepository {
+	return &eventCancellationRepository{
+		client: client,
+	}
+}
+
+// Create stores a cancellation and its refunds in one transaction
+func (r *eventCancellationRepository) Create(ctx context.Context, cancellation *domain.EventCancellation, refunds []*domain.CancellationRefund) (*domain.EventCancellation, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	created, err := tx.EventCancellation.
+		Create().
+		SetID(cancellation.ID).
+		SetEventID(cancellation.EventID).
+		SetOrganizationID(cancellation.OrganizationID).
+		SetRequestedBy(cancellation.RequestedBy).
+		SetReason(cancellation.Reason).
+		SetStatus(eventcancellation.Status(cancellation.Status)).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create event cancellation: %w", err)
+	}
+
+	if len(refunds) > 0 {
+		builders := make([]*ent.CancellationRefundCreate, len(refunds))
+		for i, refund := range refunds {
+			builders[i] = tx.CancellationRefund.
+				Create().
+				SetCancellationID(created.ID).
+				SetPaymentID(refund.PaymentID).
+				SetMethod(cancellationrefund.Method(refund.Method)).
+				SetStatus(cancellationrefund.Status(refund.Status))
+		}
+		if _, err := tx.CancellationRefund.CreateBulk(builders...).Save(ctx); err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to create cancellation refunds: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return mapEventCancellationToDomain(created), nil
+}
+
+// GetByEventID retrieves the cancellation of an event
+func (r *eventCancellationRepository) GetByEventID(eventID uuid.UUID) (*domain.EventCancellation, error) {
+	ctx := context.Background()
+
+	cancellation, err := r.client.EventCancellation.
+		Query().
+		Where(eventcancellation.EventID(eventID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get event cancellation: %w", err)
+	}
+
+	return mapEventCancellationToDomain(cancellation), nil
+}
+
+// GetRunning retrieves every cancellation that still has refunds to attempt
+func (r *eventCancellationRepository) GetRunning() ([]*domain.EventCancellation, error) {
+	ctx := context.Background()
+
+	cancellations, err := r.client.EventCancellation.
+		Query().
+		Where(eventcancellation.StatusEQ(eventcancellation.StatusRunning)).
+		Order(ent.Asc(eventcancellation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get running event cancellations: %w", err)
+	}
+
+	result := make([]*domain.EventCancellation, len(cancellations))
+	for i, cancellation := range cancellations {
+		result[i] = mapEventCancellationToDomain(cancellation)
+	}
+	return result, nil
+}
+
+// GetProgress counts a cancellation's refunds by status
+func (r *eventCancellationRepository) GetProgress(cancellationID uuid.UUID) (*domain.CancellationProgress, error) {
+	ctx := context.Background()
+
+	var rows []struct {
+		Status string `json:"status"`
+		Count  int    `json:"count"`
+	}
+	err := r.client.CancellationRefund.
+		Query().
+		Where(cancellationrefund.CancellationID(cancellationID)).
+		GroupBy(cancellationrefund.FieldStatus).
+		Aggregate(ent.Count()).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count cancellation refunds: %w", err)
+	}
+
+	progress := &domain.CancellationProgress{}
+	for _, row := range rows {
+		progress.Total += row.Count
+		switch row.Status {
+		case domain.RefundPending:
+			progress.Pending = row.Count
+		case domain.RefundAwaitingConfirmation:
+			progress.AwaitingConfirmation = row.Count
+		case domain.RefundSucceeded:
+			progress.Succeeded = row.Count
+		case domain.RefundFailed:
+			progress.Failed = row.Count
+		}
+	}
+	return progress, nil
+}
+
+// Complete marks a cancellation as completed
+func (r *eventCancellationRepository) Complete(ctx context.Context, cancellationID uuid.UUID, completedAt time.Time) error {
+	err := r.client.EventCancellation.
+		UpdateOneID(cancellationID).
+		SetStatus(eventcancellation.StatusCompleted).
+		SetCompletedAt(completedAt).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to complete event cancellation: %w", err)
+	}
+
+	return nil
+}
+
+// GetRefunds retrieves a cancellation's refunds, optionally only those with the given status
+func (r *eventCancellationRepository) GetRefunds(cancellationID uuid.UUID, status string) ([]*domain.CancellationRefund, error) {
+	ctx := context.Background()
+
+	query := r.client.CancellationRefund.
+		Query().
+		Where(cancellationrefund.CancellationID(cancellationID))
+	if status != "" {
+		query = query.Where(cancellationrefund.StatusEQ(cancellationrefund.Status(status)))
+	}
+
+	refunds, err := query.
+		Order(ent.Asc(cancellationrefund.FieldCreatedAt), ent.Asc(cancellationrefund.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get cancellation refunds: %w", err)
+	}
+
+	return mapCancellationRefundsToDomain(refunds), nil
+}
+
+// GetRefundByID retrieves a cancellation refund
+func (r *eventCancellationRepository) GetRefundByID(refundID uuid.UUID) (*domain.CancellationRefund, error) {
+	ctx := context.Background()
+
+	refund, err := r.client.CancellationRefund.Get(ctx, refundID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get cancellation refund: %w", err)
+	}
+
+	return mapCancellationRefundsToDomain([]*ent.CancellationRefund{refund})[0], nil
+}
+
+// GetDueRefunds retrieves a cancellation's pending refunds whose next attempt is due
+func (r *eventCancellationRepository) GetDueRefunds(cancellationID uuid.UUID, now time.Time) ([]*domain.CancellationRefund, error) {
+	ctx := context.Background()
+
+	refunds, err := r.client.CancellationRefund.
+		Query().
+		Where(
+			cancellationrefund.CancellationID(cancellationID),
+			cancellationrefund.StatusEQ(cancellationrefund.StatusPending),
+			cancellationrefund.Or(
+				cancellationrefund.NextAttemptAtIsNil(),
+				cancellationrefund.NextAttemptAtLTE(now),
+			),
+		).
+		Order(ent.Asc(cancellationrefund.FieldCreatedAt), ent.Asc(cancellationrefund.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get due cancellation refunds: %w", err)
+	}
+
+	return mapCancellationRefundsToDomain(refunds), nil
+}
+
+// UpdateRefund records the outcome of a refund attempt
+func (r *eventCancellationRepository) UpdateRefund(ctx context.Context, refund *domain.CancellationRefund) error {
+	update := r.client.CancellationRefund.
+		UpdateOneID(refund.ID).
+		SetMethod(cancellationrefund.Method(refund.Method)).
+		SetStatus(cancellationrefund.Status(refund.Status)).
+		SetAttempts(refund.Attempts).
+		SetLastError(refund.LastError).
+		SetNillableRefundedAt(refund.RefundedAt)
+	if refund.NextAttemptAt != nil {
+		update.SetNextAttemptAt(*refund.NextAttemptAt)
+	} else {
+		update.ClearNextAttemptAt()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update cancellation refund: %w", err)
+	}
+
+	return nil
+}
+
+// RetryFailed moves failed refunds back to pending and reopens the cancellation in one transaction
+func (r *eventCancellationRepository) RetryFailed(ctx context.Context, cancellationID uuid.UUID) (int, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	retried, err := tx.CancellationRefund.
+		Update().
+		Where(
+			cancellationrefund.CancellationID(cancellationID),
+			cancellationrefund.StatusEQ(cancellationrefund.StatusFailed),
+		).
+		SetStatus(cancellationrefund.StatusPending).
+		SetAttempts(0).
+		ClearNextAttemptAt().
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return 0, fmt.Errorf("failed to retry cancellation refunds: %w", err)
+	}
+
+	if retried > 0 {
+		err = tx.EventCancellation.
+			UpdateOneID(cancellationID).
+			SetStatus(eventcancellation.StatusRunning).
+			ClearCompletedAt().
+			Exec(ctx)
+		if err != nil {
+			tx.Rollback()
+			if ent.IsNotFound(err) {
+				return 0, domain.ErrNotFound
+			}
+			return 0, fmt.Errorf("failed to reopen event cancellation: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return retried, nil
+}
+
+func mapEventCancellationToDomain(cancellation *ent.EventCancellation) *domain.EventCancellation {
+	return &domain.EventCancellation{
+		ID:             cancellation.ID,
+		EventID:        cancellation.EventID,
+		OrganizationID: cancellation.OrganizationID,
+		RequestedBy:    cancellation.RequestedBy,
+		Reason:         cancellation.Reason,
+		Status:         string(cancellation.Status),
+		CompletedAt:    cancellation.CompletedAt,
+		CreatedAt:      cancellation.CreatedAt,
+		UpdatedAt:      cancellation.UpdatedAt,
+	}
+}
+
+func mapCancellationRefundsToDomain(refunds []*ent.CancellationRefund) []*domain.CancellationRefund {
+	result := make([]*domain.CancellationRefund, len(refunds))
+	for i, refund := range refunds {
+		result[i] = &domain.CancellationRefund{
+			ID:             refund.ID,
+			CancellationID: refund.CancellationID,
+			PaymentID:      refund.PaymentID,
+			Method:         string(refund.Method),
+			Status:         string(refund.Status),
+			Attempts:       refund.Attempts,
+			LastError:      refund.LastError,
+			NextAttemptAt:  refund.NextAttemptAt,
+			RefundedAt:     refund.RefundedAt,
+			CreatedAt:      refund.CreatedAt,
+			UpdatedAt:      refund.UpdatedAt,
+		}
+	}
+	return result
+}
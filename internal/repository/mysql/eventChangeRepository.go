@@ -0,0 +1,73 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventchange"
+	"github.com/google/uuid"
+)
+
+type eventChangeRepository struct {
+	client *ent.Client
+}
+
+func NewEventChangeRepository(client *ent.Client) domain.EventChangeRepository {
+	return &eventChangeRepository{
+		client: client,
+	}
+}
+
+// Create records a material change to an event
+func (r *eventChangeRepository) Create(ctx context.Context, change *domain.EventChange) (*domain.EventChange, error) {
+	created, err := r.client.EventChange.
+		Create().
+		SetID(change.ID).
+		SetEventID(change.EventID).
+		SetChangedBy(change.ChangedBy).
+		SetBefore(change.Before).
+		SetAfter(change.After).
+		SetNotifiedCount(change.NotifiedCount).
+		SetNillableRefundUntil(change.RefundUntil).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create event change: %w", err)
+	}
+
+	return mapEventChangeToDomain(created), nil
+}
+
+// GetByEventID retrieves an event's change history, newest first
+func (r *eventChangeRepository) GetByEventID(eventID uuid.UUID) ([]*domain.EventChange, error) {
+	ctx := context.Background()
+
+	changes, err := r.client.EventChange.
+		Query().
+		Where(eventchange.EventID(eventID)).
+		Order(ent.Desc(eventchange.FieldCreatedAt), ent.Desc(eventchange.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event changes: %w", err)
+	}
+
+	result := make([]*domain.EventChange, len(changes))
+	for i, change := range changes {
+		result[i] = mapEventChangeToDomain(change)
+	}
+	return result, nil
+}
+
+func mapEventChangeToDomain(change *ent.EventChange) *domain.EventChange {
+	return &domain.EventChange{
+		ID:            change.ID,
+		EventID:       change.EventID,
+		ChangedBy:     change.ChangedBy,
+		Before:        change.Before,
+		After:         change.After,
+		NotifiedCount: change.NotifiedCount,
+		RefundUntil:   change.RefundUntil,
+		CreatedAt:     change.CreatedAt,
+	}
+}
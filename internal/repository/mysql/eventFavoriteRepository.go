@@ -0,0 +1,105 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventfavorite"
+	"github.com/google/uuid"
+)
+
+type eventFavoriteRepository struct {
+	client *ent.Client
+}
+
+func NewEventFavoriteRepository(client *ent.Client) domain.EventFavoriteRepository {
+	return &eventFavoriteRepository{
+		client: client,
+	}
+}
+
+// Create records a user's favorite event
+func (r *eventFavoriteRepository) Create(ctx context.Context, favorite *domain.EventFavorite) (*domain.EventFavorite, error) {
+	created, err := r.client.EventFavorite.
+		Create().
+		SetID(favorite.ID).
+		SetUserID(favorite.UserID).
+		SetEventID(favorite.EventID).
+		SetCreatedAt(favorite.CreatedAt).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create event favorite: %w", err)
+	}
+
+	return mapEventFavoriteToDomain(created), nil
+}
+
+// Get retrieves a user's favorite of an event
+func (r *eventFavoriteRepository) Get(userID, eventID uuid.UUID) (*domain.EventFavorite, error) {
+	ctx := context.Background()
+
+	favorite, err := r.client.EventFavorite.
+		Query().
+		Where(
+			eventfavorite.UserID(userID),
+			eventfavorite.EventID(eventID),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get event favorite: %w", err)
+	}
+
+	return mapEventFavoriteToDomain(favorite), nil
+}
+
+// GetByUserID retrieves a user's favorites, newest first
+func (r *eventFavoriteRepository) GetByUserID(userID uuid.UUID) ([]*domain.EventFavorite, error) {
+	ctx := context.Background()
+
+	favorites, err := r.client.EventFavorite.
+		Query().
+		Where(eventfavorite.UserID(userID)).
+		Order(ent.Desc(eventfavorite.FieldCreatedAt), ent.Desc(eventfavorite.FieldID)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event favorites: %w", err)
+	}
+
+	result := make([]*domain.EventFavorite, len(favorites))
+	for i, favorite := range favorites {
+		result[i] = mapEventFavoriteToDomain(favorite)
+	}
+	return result, nil
+}
+
+// Delete deletes a favorite
+func (r *eventFavoriteRepository) Delete(ctx context.Context, favoriteID uuid.UUID) error {
+	err := r.client.EventFavorite.
+		DeleteOneID(favoriteID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete event favorite: %w", err)
+	}
+
+	return nil
+}
+
+func mapEventFavoriteToDomain(favorite *ent.EventFavorite) *domain.EventFavorite {
+	return &domain.EventFavorite{
+		ID:        favorite.ID,
+		UserID:    favorite.UserID,
+		EventID:   favorite.EventID,
+		CreatedAt: favorite.CreatedAt,
+	}
+}
@@ -2,13 +2,20 @@ package mysql
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
-	"log"
+	"slices"
 	"time"
 
+	"entgo.io/ent/dialect/sql"
+
 	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
 	"github.com/google/uuid"
 )
 
@@ -23,17 +30,26 @@ func NewEventRepository(client *ent.Client) domain.EventRepository {
 }
 
 // Create creates a new event
-func (r *eventRepository) Create(evt *domain.Event) (*domain.Event, error) {
-	ctx := context.Background()
-
-	createdEvent, err := r.client.Event.
+func (r *eventRepository) Create(ctx context.Context, evt *domain.Event) (*domain.Event, error) {
+	address := addressOrEmpty(evt.Address)
+	builder := r.client.Event.
 		Create().
 		SetID(evt.ID).
 		SetOrganizationID(evt.OrganizationID).
+		SetNillableSeriesID(evt.SeriesID).
 		SetTitle(evt.Title).
 		SetNillableDescription(&evt.Description).
 		SetNillableLocation(&evt.Location).
 		SetNillableVenue(&evt.Venue).
+		SetNillableVenueID(evt.VenueID).
+		SetSeatingLayout(evt.SeatingLayout).
+		SetAddressStreet(address.Street).
+		SetAddressCity(address.City).
+		SetAddressRegion(address.Region).
+		SetAddressPostalCode(address.PostalCode).
+		SetAddressCountry(address.Country).
+		SetGeohash(eventGeohash(evt.Coordinates)).
+		SetCategory(evt.Category).
 		SetStartTime(evt.StartTime).
 		SetEndTime(evt.EndTime).
 		SetTotalTickets(evt.TotalTickets).
@@ -41,35 +57,23 @@ func (r *eventRepository) Create(evt *domain.Event) (*domain.Event, error) {
 		SetTicketPrice(evt.TicketPrice).
 		SetCurrency(evt.Currency).
 		SetNillableThumbnailURL(&evt.ThumbnailURL).
-		SetStatus(event.Status(evt.Status)).
 		SetIsPublic(evt.IsPublic).
-		SetCreatedBy(evt.CreatedBy).
-		Save(ctx)
+		SetRequiresApproval(evt.RequiresApproval).
+		SetNillablePublishAt(evt.PublishAt).
+		SetCreatedBy(evt.CreatedBy)
+	if evt.Coordinates != nil {
+		builder.SetLatitude(evt.Coordinates.Latitude).SetLongitude(evt.Coordinates.Longitude)
+	}
+	if evt.Review != nil {
+		builder.SetReviewStatus(event.ReviewStatus(evt.Review.Status))
+	}
+
+	createdEvent, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create event: %w", err)
 	}
 
-	return &domain.Event{
-		ID:               createdEvent.ID,
-		OrganizationID:   createdEvent.OrganizationID,
-		Title:            createdEvent.Title,
-		Description:      createdEvent.Description,
-		Location:         createdEvent.Location,
-		Venue:            createdEvent.Venue,
-		StartTime:        createdEvent.StartTime,
-		EndTime:          createdEvent.EndTime,
-		TotalTickets:     createdEvent.TotalTickets,
-		AvailableTickets: createdEvent.AvailableTickets,
-		ParticipantCount: createdEvent.ParticipantCount,
-		TicketPrice:      createdEvent.TicketPrice,
-		Currency:         createdEvent.Currency,
-		ThumbnailURL:     createdEvent.ThumbnailURL,
-		Status:           string(createdEvent.Status),
-		IsPublic:         createdEvent.IsPublic,
-		CreatedBy:        createdEvent.CreatedBy,
-		CreatedAt:        createdEvent.CreatedAt,
-		UpdatedAt:        createdEvent.UpdatedAt,
-	}, nil
+	return r.mapToDomain(createdEvent), nil
 }
 
 // GetByID retrieves an event by ID
@@ -87,27 +91,7 @@ func (r *eventRepository) GetByID(eventID uuid.UUID) (*domain.Event, error) {
 		return nil, fmt.Errorf("failed to get event: %w", err)
 	}
 
-	return &domain.Event{
-		ID:               evt.ID,
-		OrganizationID:   evt.OrganizationID,
-		Title:            evt.Title,
-		Description:      evt.Description,
-		Location:         evt.Location,
-		Venue:            evt.Venue,
-		StartTime:        evt.StartTime,
-		EndTime:          evt.EndTime,
-		TotalTickets:     evt.TotalTickets,
-		AvailableTickets: evt.AvailableTickets,
-		ParticipantCount: evt.ParticipantCount,
-		TicketPrice:      evt.TicketPrice,
-		Currency:         evt.Currency,
-		ThumbnailURL:     evt.ThumbnailURL,
-		Status:           string(evt.Status),
-		IsPublic:         evt.IsPublic,
-		CreatedBy:        evt.CreatedBy,
-		CreatedAt:        evt.CreatedAt,
-		UpdatedAt:        evt.UpdatedAt,
-	}, nil
+	return r.mapToDomain(evt), nil
 }
 
 // GetByOrganizationID retrieves all events for an organization
@@ -125,42 +109,56 @@ func (r *eventRepository) GetByOrganizationID(orgID uuid.UUID) ([]*domain.Event,
 
 	result := make([]*domain.Event, len(events))
 	for i, evt := range events {
-		result[i] = &domain.Event{
-			ID:               evt.ID,
-			OrganizationID:   evt.OrganizationID,
-			Title:            evt.Title,
-			Description:      evt.Description,
-			Location:         evt.Location,
-			Venue:            evt.Venue,
-			StartTime:        evt.StartTime,
-			EndTime:          evt.EndTime,
-			TotalTickets:     evt.TotalTickets,
-			AvailableTickets: evt.AvailableTickets,
-			ParticipantCount: evt.ParticipantCount,
-			TicketPrice:      evt.TicketPrice,
-			Currency:         evt.Currency,
-			ThumbnailURL:     evt.ThumbnailURL,
-			Status:           string(evt.Status),
-			IsPublic:         evt.IsPublic,
-			CreatedBy:        evt.CreatedBy,
-			CreatedAt:        evt.CreatedAt,
-			UpdatedAt:        evt.UpdatedAt,
-		}
+		result[i] = r.mapToDomain(evt)
 	}
 
 	return result, nil
 }
 
-// Update updates an event
-func (r *eventRepository) Update(evt *domain.Event) error {
+// GetBySeriesID retrieves the occurrences of an event series in start time order
+func (r *eventRepository) GetBySeriesID(seriesID uuid.UUID) ([]*domain.Event, error) {
 	ctx := context.Background()
 
-	err := r.client.Event.
-		UpdateOneID(evt.ID).
+	events, err := r.client.Event.
+		Query().
+		Where(event.SeriesID(seriesID)).
+		Order(ent.Asc(event.FieldStartTime)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get series events: %w", err)
+	}
+
+	result := make([]*domain.Event, len(events))
+	for i, evt := range events {
+		result[i] = r.mapToDomain(evt)
+	}
+	return result, nil
+}
+
+// Update saves an event's fields and status if its status is still fromStatus
+// Both are written in one statement so a conflicting status change leaves the event untouched
+func (r *eventRepository) Update(ctx context.Context, evt *domain.Event, fromStatus string) error {
+	address := addressOrEmpty(evt.Address)
+	update := r.client.Event.
+		Update().
+		Where(
+			event.ID(evt.ID),
+			event.StatusEQ(event.Status(fromStatus)),
+		).
+		SetStatus(event.Status(evt.Status)).
 		SetTitle(evt.Title).
 		SetDescription(evt.Description).
 		SetLocation(evt.Location).
 		SetVenue(evt.Venue).
+		SetNillableVenueID(evt.VenueID).
+		SetSeatingLayout(evt.SeatingLayout).
+		SetAddressStreet(address.Street).
+		SetAddressCity(address.City).
+		SetAddressRegion(address.Region).
+		SetAddressPostalCode(address.PostalCode).
+		SetAddressCountry(address.Country).
+		SetGeohash(eventGeohash(evt.Coordinates)).
+		SetCategory(evt.Category).
 		SetStartTime(evt.StartTime).
 		SetEndTime(evt.EndTime).
 		SetTotalTickets(evt.TotalTickets).
@@ -168,23 +166,163 @@ func (r *eventRepository) Update(evt *domain.Event) error {
 		SetTicketPrice(evt.TicketPrice).
 		SetCurrency(evt.Currency).
 		SetThumbnailURL(evt.ThumbnailURL).
-		SetStatus(event.Status(evt.Status)).
 		SetIsPublic(evt.IsPublic).
-		Exec(ctx)
+		SetRequiresApproval(evt.RequiresApproval).
+		SetNillableDateChangeRefundUntil(evt.DateChangeRefundUntil)
+	if evt.VenueID == nil {
+		update.ClearVenueID()
+	}
+	if evt.Coordinates != nil {
+		update.SetLatitude(evt.Coordinates.Latitude).SetLongitude(evt.Coordinates.Longitude)
+	} else {
+		update.ClearLatitude().ClearLongitude()
+	}
+	if evt.PublishAt != nil {
+		update.SetPublishAt(*evt.PublishAt)
+	} else {
+		update.ClearPublishAt()
+	}
+
+	affected, err := update.Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
+		return fmt.Errorf("failed to update event: %w", err)
+	}
+	if affected == 0 {
+		exists, err := r.client.Event.Query().Where(event.ID(evt.ID)).Exist(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to update event: %w", err)
+		}
+		if !exists {
 			return domain.ErrNotFound
 		}
-		return fmt.Errorf("failed to update event: %w", err)
+		return domain.ErrConflict
 	}
 
 	return nil
 }
 
-// Delete deletes an event
-func (r *eventRepository) Delete(eventID uuid.UUID) error {
-	ctx := context.Background()
+// UpdateStatus moves an event to another lifecycle status
+// The current status is part of the condition so the scheduler and organizers cannot overwrite each other
+func (r *eventRepository) UpdateStatus(ctx context.Context, eventID uuid.UUID, fromStatus, toStatus string) error {
+	affected, err := r.client.Event.
+		Update().
+		Where(
+			event.ID(eventID),
+			event.StatusEQ(event.Status(fromStatus)),
+		).
+		SetStatus(event.Status(toStatus)).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update event status: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrConflict
+	}
+
+	return nil
+}
+
+// PublishDueEvents publishes scheduled draft events once their publish time has passed and returns their IDs
+// Events that are not approved or no longer publishable stay drafts until they are
+func (r *eventRepository) PublishDueEvents(ctx context.Context, now time.Time) ([]uuid.UUID, error) {
+	due := []predicate.Event{
+		event.StatusEQ(event.StatusDraft),
+		event.ReviewStatusEQ(event.ReviewStatusApproved),
+		event.PublishAtLTE(now),
+		event.StartTimeGT(now),
+		event.Or(
+			event.TotalTicketsGT(0),
+			event.TicketPriceEQ(0),
+		),
+		event.HasOrganizationWith(organization.IsActive(true)),
+	}
+
+	ids, err := r.client.Event.
+		Query().
+		Where(due...).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events due for publishing: %w", err)
+	}
+	if len(ids) == 0 {
+		return ids, nil
+	}
+
+	// The conditions are checked again so events changed in the meantime stay drafts
+	_, err = r.client.Event.
+		Update().
+		Where(append(due, event.IDIn(ids...))...).
+		SetStatus(event.StatusPublished).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to publish events: %w", err)
+	}
+
+	return ids, nil
+}
+
+// StartDueEvents moves published events that have started to ongoing
+func (r *eventRepository) StartDueEvents(ctx context.Context, now time.Time) (int, error) {
+	affected, err := r.client.Event.
+		Update().
+		Where(
+			event.StatusEQ(event.StatusPublished),
+			event.StartTimeLTE(now),
+		).
+		SetStatus(event.StatusOngoing).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start events: %w", err)
+	}
+
+	return affected, nil
+}
+
+// CompleteDueEvents moves published and ongoing events that have ended to completed
+func (r *eventRepository) CompleteDueEvents(ctx context.Context, now time.Time) (int, error) {
+	affected, err := r.client.Event.
+		Update().
+		Where(
+			event.StatusIn(event.StatusPublished, event.StatusOngoing),
+			event.EndTimeLTE(now),
+		).
+		SetStatus(event.StatusCompleted).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to complete events: %w", err)
+	}
+
+	return affected, nil
+}
 
+// UpdateReview records a step of the approval workflow
+// The current review status is part of the condition so concurrent reviews cannot both succeed
+func (r *eventRepository) UpdateReview(ctx context.Context, eventID uuid.UUID, fromStatus string, review *domain.EventReview) error {
+	affected, err := r.client.Event.
+		Update().
+		Where(
+			event.ID(eventID),
+			event.ReviewStatusEQ(event.ReviewStatus(fromStatus)),
+		).
+		SetReviewStatus(event.ReviewStatus(review.Status)).
+		SetReviewComment(review.Comment).
+		SetNillableSubmittedBy(review.SubmittedBy).
+		SetNillableSubmittedAt(review.SubmittedAt).
+		SetNillableReviewedBy(review.ReviewedBy).
+		SetNillableReviewedAt(review.ReviewedAt).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update event review: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrConflict
+	}
+
+	return nil
+}
+
+// Delete deletes an event
+func (r *eventRepository) Delete(ctx context.Context, eventID uuid.UUID) error {
 	err := r.client.Event.
 		DeleteOneID(eventID).
 		Exec(ctx)
@@ -198,122 +336,419 @@ func (r *eventRepository) Delete(eventID uuid.UUID) error {
 	return nil
 }
 
-// GetPublicEvents retrieves all public events
-func (r *eventRepository) GetPublicEvents() ([]*domain.EventWithOrganization, error) {
+// eventSorts maps each listing order to its column and direction; ties are broken by ID in the same direction
+var eventSorts = map[string]struct {
+	field string
+	desc  bool
+}{
+	domain.EventSortStartTime:  {event.FieldStartTime, false},
+	domain.EventSortNewest:     {event.FieldCreatedAt, true},
+	domain.EventSortPriceAsc:   {event.FieldTicketPrice, false},
+	domain.EventSortPriceDesc:  {event.FieldTicketPrice, true},
+	domain.EventSortPopularity: {event.FieldParticipantCount, true},
+}
+
+// eventCursor is the sort key and ID of the last event on a page
+// Time is set for time columns and Number for numeric ones; relevance and distance pages only use Offset
+// Sort ties the cursor to the listing it came from, since its key means nothing to another sort
+type eventCursor struct {
+	Sort   string     `json:"s"`
+	Time   *time.Time `json:"t,omitempty"`
+	Number *float64   `json:"n,omitempty"`
+	ID     uuid.UUID  `json:"id"`
+	Offset int        `json:"o,omitempty"`
+}
+
+// ListPublicEvents retrieves one page of public, non-draft events of active organizations
+// Pages are keyset-paginated, so events created between requests never shift or repeat results
+func (r *eventRepository) ListPublicEvents(query domain.EventListQuery) ([]*domain.EventWithOrganization, string, error) {
 	ctx := context.Background()
 
+	relevance := query.Sort == domain.EventSortRelevance
+	distance := query.Sort == domain.EventSortDistance
+	sort, ok := eventSorts[query.Sort]
+	if !ok && !relevance && !distance {
+		return nil, "", fmt.Errorf("%w: unknown sort %q", domain.ErrInvalidInput, query.Sort)
+	}
+	if relevance && query.Keyword == "" {
+		return nil, "", fmt.Errorf("%w: relevance sort needs a keyword", domain.ErrInvalidInput)
+	}
+	if distance && query.Near == nil {
+		return nil, "", fmt.Errorf("%w: distance sort needs a point", domain.ErrInvalidInput)
+	}
+
+	predicates := publicEventFilters(query)
+	// Relevance scores and distances are not stored, so those pages are counted by offset instead
+	byOffset := relevance || distance
+	offset := 0
+	if query.Cursor != "" {
+		after, err := decodeEventCursor(query.Cursor)
+		if err != nil {
+			return nil, "", err
+		}
+		if after.Sort != query.Sort {
+			return nil, "", fmt.Errorf("%w: cursor was issued for a different sort", domain.ErrInvalidInput)
+		}
+		if byOffset {
+			if after.Offset <= 0 {
+				return nil, "", fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+			}
+			offset = after.Offset
+		} else {
+			if after.Time == nil && after.Number == nil {
+				return nil, "", fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+			}
+			predicates = append(predicates, afterEventCursor(sort.field, sort.desc, after))
+		}
+	}
+
+	var order event.OrderOption
+	switch {
+	case relevance:
+		order = orderByRelevance(query.Keyword)
+	case distance:
+		order = orderByDistance(*query.Near)
+	case sort.desc:
+		order = ent.Desc(sort.field, event.FieldID)
+	default:
+		order = ent.Asc(sort.field, event.FieldID)
+	}
+
+	// Fetch one extra row to learn whether another page follows
 	events, err := r.client.Event.
 		Query().
-		Where(event.IsPublic(true)).
+		Where(predicates...).
 		WithOrganization().
-		Order(ent.Desc(event.FieldStartTime)).
+		Order(order).
+		Offset(offset).
+		Limit(query.Limit + 1).
 		All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get public events: %w", err)
+		return nil, "", fmt.Errorf("failed to list public events: %w", err)
 	}
 
-	return r.mapEventsWithOrganization(events), nil
+	next := ""
+	if len(events) > query.Limit {
+		events = events[:query.Limit]
+		cursor := eventCursor{Offset: offset + query.Limit}
+		if !byOffset {
+			cursor = eventCursorAt(sort.field, events[len(events)-1])
+		}
+		cursor.Sort = query.Sort
+		if next, err = encodeEventCursor(cursor); err != nil {
+			return nil, "", err
+		}
+	}
+
+	result := r.mapEventsWithOrganization(events)
+	setDistances(result, query.Near)
+
+	return result, next, nil
 }
 
-// GetEventsByStatus retrieves events by status
-func (r *eventRepository) GetEventsByStatus(status string) ([]*domain.EventWithOrganization, error) {
+// setDistances fills in how far events are from point, if one was searched for
+func setDistances(events []*domain.EventWithOrganization, point *domain.GeoPoint) {
+	if point == nil {
+		return
+	}
+	for _, evt := range events {
+		if evt.Coordinates != nil {
+			km := util.DistanceKm(point.Latitude, point.Longitude, evt.Coordinates.Latitude, evt.Coordinates.Longitude)
+			evt.DistanceKm = &km
+		}
+	}
+}
+
+// publicEventFilters matches the public, non-draft events of active organizations that pass query's filters
+func publicEventFilters(query domain.EventListQuery) []predicate.Event {
+	predicates := []predicate.Event{
+		event.IsPublic(true),
+		event.StatusNEQ(event.StatusDraft),
+		event.HasOrganizationWith(organization.IsActive(true)),
+	}
+	if query.Keyword != "" {
+		predicates = append(predicates, matchEventKeyword(query.Keyword))
+	}
+	if query.Category != "" {
+		predicates = append(predicates, event.Category(query.Category))
+	}
+	if query.OrganizationID != nil {
+		predicates = append(predicates, event.OrganizationID(*query.OrganizationID))
+	}
+	if query.StartFrom != nil {
+		predicates = append(predicates, event.StartTimeGTE(*query.StartFrom))
+	}
+	if query.StartTo != nil {
+		predicates = append(predicates, event.StartTimeLTE(*query.StartTo))
+	}
+	if query.MinPrice != nil {
+		predicates = append(predicates, event.TicketPriceGTE(*query.MinPrice))
+	}
+	if query.MaxPrice != nil {
+		predicates = append(predicates, event.TicketPriceLTE(*query.MaxPrice))
+	}
+	if query.Free != nil {
+		if *query.Free {
+			predicates = append(predicates, event.TicketPrice(0))
+		} else {
+			predicates = append(predicates, event.TicketPriceGT(0))
+		}
+	}
+	if query.Available {
+		// Free events without a ticket limit never sell out
+		predicates = append(predicates, event.Or(
+			event.AvailableTicketsGT(0),
+			event.And(event.TotalTickets(0), event.TicketPrice(0)),
+		))
+	}
+	if query.Location != "" {
+		predicates = append(predicates, event.Or(
+			event.LocationContains(query.Location),
+			event.VenueContains(query.Location),
+		))
+	}
+	if query.Upcoming {
+		predicates = append(predicates,
+			event.StartTimeGT(time.Now()),
+			event.StatusIn(event.StatusPublished, event.StatusOngoing),
+		)
+	}
+	if query.Near != nil {
+		predicates = append(predicates, matchNearby(*query.Near, query.RadiusKm))
+	}
+
+	return predicates
+}
+
+// afterEventCursor matches the events that follow the cursor in the given order
+func afterEventCursor(field string, desc bool, cursor *eventCursor) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		var value any = cursor.ID
+		if cursor.Time != nil {
+			value = *cursor.Time
+		} else if cursor.Number != nil {
+			value = *cursor.Number
+		}
+
+		column, id := s.C(field), s.C(event.FieldID)
+		if desc {
+			s.Where(sql.Or(sql.LT(column, value), sql.And(sql.EQ(column, value), sql.LT(id, cursor.ID))))
+		} else {
+			s.Where(sql.Or(sql.GT(column, value), sql.And(sql.EQ(column, value), sql.GT(id, cursor.ID))))
+		}
+	})
+}
+
+// eventCursorAt returns the cursor that resumes a listing sorted by field after evt
+func eventCursorAt(field string, evt *ent.Event) eventCursor {
+	cursor := eventCursor{ID: evt.ID}
+	switch field {
+	case event.FieldStartTime:
+		cursor.Time = &evt.StartTime
+	case event.FieldCreatedAt:
+		cursor.Time = &evt.CreatedAt
+	case event.FieldTicketPrice:
+		cursor.Number = &evt.TicketPrice
+	case event.FieldParticipantCount:
+		count := float64(evt.ParticipantCount)
+		cursor.Number = &count
+	}
+	return cursor
+}
+
+func encodeEventCursor(cursor eventCursor) (string, error) {
+	data, err := json.Marshal(cursor)
+	if err != nil {
+		return "", fmt.Errorf("failed to encode cursor: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeEventCursor(value string) (*eventCursor, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+	}
+
+	var cursor eventCursor
+	if err := json.Unmarshal(data, &cursor); err != nil {
+		return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+	}
+	return &cursor, nil
+}
+
+// GetPublicEventsByIDs retrieves the events among eventIDs that are listed publicly and pass query's filters,
+// in the order of eventIDs; the sort, cursor and limit of query are ignored
+func (r *eventRepository) GetPublicEventsByIDs(eventIDs []uuid.UUID, query domain.EventListQuery) ([]*domain.EventWithOrganization, error) {
 	ctx := context.Background()
 
+	if len(eventIDs) == 0 {
+		return []*domain.EventWithOrganization{}, nil
+	}
+
 	events, err := r.client.Event.
 		Query().
-		Where(event.StatusEQ(event.Status(status))).
+		Where(append(publicEventFilters(query), event.IDIn(eventIDs...))...).
 		WithOrganization().
-		Order(ent.Desc(event.FieldStartTime)).
 		All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get events by status: %w", err)
+		return nil, fmt.Errorf("failed to get public events: %w", err)
 	}
 
-	return r.mapEventsWithOrganization(events), nil
+	position := make(map[uuid.UUID]int, len(eventIDs))
+	for i, id := range eventIDs {
+		position[id] = i
+	}
+	slices.SortFunc(events, func(a, b *ent.Event) int {
+		return position[a.ID] - position[b.ID]
+	})
+
+	result := r.mapEventsWithOrganization(events)
+	setDistances(result, query.Near)
+
+	return result, nil
 }
 
-// GetUpcomingEvents retrieves upcoming events
-func (r *eventRepository) GetUpcomingEvents() ([]*domain.EventWithOrganization, error) {
+// GetUpcomingPublicEventIDs returns the IDs among eventIDs of public events that are drafts or on sale and have not started yet
+// Drafts are included since they may be scheduled to publish
+func (r *eventRepository) GetUpcomingPublicEventIDs(eventIDs []uuid.UUID) ([]uuid.UUID, error) {
 	ctx := context.Background()
-	now := time.Now()
 
-	events, err := r.client.Event.
+	if len(eventIDs) == 0 {
+		return []uuid.UUID{}, nil
+	}
+
+	ids, err := r.client.Event.
 		Query().
 		Where(
+			event.IDIn(eventIDs...),
 			event.IsPublic(true),
-			event.StartTimeGT(now),
-			event.StatusIn(event.StatusPublished, event.StatusOngoing),
+			event.StatusIn(event.StatusDraft, event.StatusPublished),
+			event.StartTimeGT(time.Now()),
 		).
-		WithOrganization().
-		Order(ent.Asc(event.FieldStartTime)).
-		All(ctx)
+		IDs(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get upcoming events: %w", err)
 	}
 
-	log.Println(events)
-
-	return r.mapEventsWithOrganization(events), nil
+	return ids, nil
 }
 
-// GetPopularEvents retrieves popular upcoming events based on ticket sales
-// threshold is the minimum percentage of tickets sold (0.0 to 1.0)
-// For example, 0.7 means events with 70% or more tickets sold
-func (r *eventRepository) GetPopularEvents(threshold float64) ([]*domain.EventWithOrganization, error) {
+// GetPublicEventsByOrganizationID retrieves an organization's public events that are on sale, running or completed
+func (r *eventRepository) GetPublicEventsByOrganizationID(orgID uuid.UUID) ([]*domain.Event, error) {
 	ctx := context.Background()
-	now := time.Now()
 
-	// Get all upcoming public events
 	events, err := r.client.Event.
 		Query().
 		Where(
+			event.OrganizationID(orgID),
 			event.IsPublic(true),
-			event.StartTimeGT(now),
-			event.StatusIn(event.StatusPublished, event.StatusOngoing),
-			event.TotalTicketsGT(0), // Only events with tickets
+			event.StatusIn(event.StatusPublished, event.StatusOngoing, event.StatusCompleted),
 		).
-		WithOrganization().
+		Order(ent.Asc(event.FieldStartTime)).
 		All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get popular events: %w", err)
+		return nil, fmt.Errorf("failed to get public events by organization: %w", err)
 	}
 
-	// Filter events based on ticket sales percentage
-	popularEvents := make([]*ent.Event, 0)
-	for _, evt := range events {
-		soldTickets := evt.TotalTickets - evt.AvailableTickets
-		salesPercentage := float64(soldTickets) / float64(evt.TotalTickets)
+	// Public listings leave out review details
+	result := make([]*domain.Event, len(events))
+	for i, evt := range events {
+		result[i] = r.mapToDomain(evt)
+		result[i].Review = nil
+	}
+
+	return result, nil
+}
+
+// suggestableEvents are the events search suggestions may point to
+func suggestableEvents() []predicate.Event {
+	return []predicate.Event{
+		event.IsPublic(true),
+		event.StatusIn(event.StatusPublished, event.StatusOngoing),
+		event.HasOrganizationWith(organization.IsActive(true)),
+	}
+}
+
+// SuggestTitles suggests event titles, soonest event first
+// Occurrences of a series share a title, so each title is suggested once with its soonest event
+func (r *eventRepository) SuggestTitles(prefix string, limit int) ([]*domain.SearchSuggestion, error) {
+	ctx := context.Background()
+
+	var events []struct {
+		ID    uuid.UUID `json:"id"`
+		Title string    `json:"title"`
+	}
+	err := r.client.Event.
+		Query().
+		Where(suggestableEvents()...).
+		Where(predicate.Event(matchWordPrefix(event.FieldTitle, event.FieldTitleInitials, prefix))).
+		Order(ent.Asc(event.FieldStartTime, event.FieldID)).
+		Limit(limit*4).
+		Select(event.FieldID, event.FieldTitle).
+		Scan(ctx, &events)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest event titles: %w", err)
+	}
 
-		if salesPercentage >= threshold {
-			popularEvents = append(popularEvents, evt)
+	seen := make(map[string]bool, len(events))
+	result := make([]*domain.SearchSuggestion, 0, limit)
+	for _, evt := range events {
+		if seen[evt.Title] || len(result) == limit {
+			continue
 		}
+		seen[evt.Title] = true
+		id := evt.ID
+		result = append(result, &domain.SearchSuggestion{
+			Type:    domain.SuggestionEvent,
+			Text:    evt.Title,
+			EventID: &id,
+		})
+	}
+
+	return result, nil
+}
+
+// SuggestVenues suggests distinct venues in alphabetical order
+func (r *eventRepository) SuggestVenues(prefix string, limit int) ([]*domain.SearchSuggestion, error) {
+	ctx := context.Background()
+
+	venues, err := r.client.Event.
+		Query().
+		Where(suggestableEvents()...).
+		Where(predicate.Event(matchWordPrefix(event.FieldVenue, event.FieldVenueInitials, prefix))).
+		Unique(true).
+		Order(ent.Asc(event.FieldVenue)).
+		Limit(limit).
+		Select(event.FieldVenue).
+		Strings(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest venues: %w", err)
 	}
 
-	// Sort by sales percentage (highest first), then by start time
-	// We'll sort by sold tickets count as a proxy since we can't add calculated fields
-	// You can implement custom sorting if needed
+	result := make([]*domain.SearchSuggestion, len(venues))
+	for i, venue := range venues {
+		result[i] = &domain.SearchSuggestion{
+			Type: domain.SuggestionVenue,
+			Text: venue,
+		}
+	}
 
-	return r.mapEventsWithOrganization(popularEvents), nil
+	return result, nil
 }
 
-// SearchEvents searches events by keyword in title or description
-func (r *eventRepository) SearchEvents(keyword string) ([]*domain.EventWithOrganization, error) {
+// GetEventsByStatus retrieves events by status
+func (r *eventRepository) GetEventsByStatus(status string) ([]*domain.EventWithOrganization, error) {
 	ctx := context.Background()
 
 	events, err := r.client.Event.
 		Query().
-		Where(
-			event.Or(
-				event.TitleContains(keyword),
-				event.DescriptionContains(keyword),
-			),
-			event.IsPublic(true),
-		).
+		Where(event.StatusEQ(event.Status(status))).
 		WithOrganization().
 		Order(ent.Desc(event.FieldStartTime)).
 		All(ctx)
 	if err != nil {
-		return nil, fmt.Errorf("failed to search events: %w", err)
+		return nil, fmt.Errorf("failed to get events by status: %w", err)
 	}
 
 	return r.mapEventsWithOrganization(events), nil
@@ -321,6 +756,7 @@ func (r *eventRepository) SearchEvents(keyword string) ([]*domain.EventWithOrgan
 
 // UpdateAvailableTickets updates the available tickets count by adding the given delta
 // Use negative value to decrease, positive value to increase
+// Free events without a ticket limit have no seats to count, so they are left unchanged
 func (r *eventRepository) UpdateAvailableTickets(eventID uuid.UUID, delta int) error {
 	ctx := context.Background()
 
@@ -332,6 +768,9 @@ func (r *eventRepository) UpdateAvailableTickets(eventID uuid.UUID, delta int) e
 		}
 		return fmt.Errorf("failed to get event: %w", err)
 	}
+	if event.TotalTickets == 0 && event.TicketPrice == 0 {
+		return nil
+	}
 
 	// Calculate new available tickets
 	newAvailable := event.AvailableTickets + delta
@@ -374,7 +813,7 @@ func (r *eventRepository) UpdateParticipantCount(eventID uuid.UUID, count int) e
 	return nil
 }
 
-// Helper function to map events with organization
+// Helper function to map events with organization for public listings, which leave out review details
 func (r *eventRepository) mapEventsWithOrganization(events []*ent.Event) []*domain.EventWithOrganization {
 	result := make([]*domain.EventWithOrganization, len(events))
 	for i, evt := range events {
@@ -384,29 +823,52 @@ func (r *eventRepository) mapEventsWithOrganization(events []*ent.Event) []*doma
 		}
 
 		result[i] = &domain.EventWithOrganization{
-			Event: domain.Event{
-				ID:               evt.ID,
-				OrganizationID:   evt.OrganizationID,
-				Title:            evt.Title,
-				Description:      evt.Description,
-				Location:         evt.Location,
-				Venue:            evt.Venue,
-				StartTime:        evt.StartTime,
-				EndTime:          evt.EndTime,
-				TotalTickets:     evt.TotalTickets,
-				AvailableTickets: evt.AvailableTickets,
-				ParticipantCount: evt.ParticipantCount,
-				TicketPrice:      evt.TicketPrice,
-				Currency:         evt.Currency,
-				ThumbnailURL:     evt.ThumbnailURL,
-				Status:           string(evt.Status),
-				IsPublic:         evt.IsPublic,
-				CreatedBy:        evt.CreatedBy,
-				CreatedAt:        evt.CreatedAt,
-				UpdatedAt:        evt.UpdatedAt,
-			},
+			Event:            *r.mapToDomain(evt),
 			OrganizationName: orgName,
 		}
+		result[i].Review = nil
 	}
 	return result
 }
+
+// Helper function to map an ent event to the domain model
+func (r *eventRepository) mapToDomain(evt *ent.Event) *domain.Event {
+	return &domain.Event{
+		ID:               evt.ID,
+		OrganizationID:   evt.OrganizationID,
+		SeriesID:         evt.SeriesID,
+		Title:            evt.Title,
+		Description:      evt.Description,
+		Location:         evt.Location,
+		Venue:            evt.Venue,
+		VenueID:          evt.VenueID,
+		SeatingLayout:    evt.SeatingLayout,
+		Address:          mapAddress(evt.AddressStreet, evt.AddressCity, evt.AddressRegion, evt.AddressPostalCode, evt.AddressCountry),
+		Coordinates:      mapGeoPoint(evt.Latitude, evt.Longitude),
+		Category:         evt.Category,
+		StartTime:        evt.StartTime,
+		EndTime:          evt.EndTime,
+		TotalTickets:     evt.TotalTickets,
+		AvailableTickets: evt.AvailableTickets,
+		ParticipantCount: evt.ParticipantCount,
+		TicketPrice:      evt.TicketPrice,
+		Currency:         evt.Currency,
+		ThumbnailURL:     evt.ThumbnailURL,
+		Status:           string(evt.Status),
+		IsPublic:         evt.IsPublic,
+		RequiresApproval: evt.RequiresApproval,
+		Review: &domain.EventReview{
+			Status:      string(evt.ReviewStatus),
+			Comment:     evt.ReviewComment,
+			SubmittedBy: evt.SubmittedBy,
+			SubmittedAt: evt.SubmittedAt,
+			ReviewedBy:  evt.ReviewedBy,
+			ReviewedAt:  evt.ReviewedAt,
+		},
+		PublishAt:             evt.PublishAt,
+		DateChangeRefundUntil: evt.DateChangeRefundUntil,
+		CreatedBy:             evt.CreatedBy,
+		CreatedAt:             evt.CreatedAt,
+		UpdatedAt:             evt.UpdatedAt,
+	}
+}
@@ -0,0 +1,237 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/google/uuid"
+)
+
+type eventSeriesRepository struct {
+	client *ent.Client
+}
+
+func NewEventSeriesRepository(client *ent.Client) domain.EventSeriesRepository {
+	return &eventSeriesRepository{
+		client: client,
+	}
+}
+
+// Create stores a series and its occurrences in one transaction
+func (r *eventSeriesRepository) Create(ctx context.Context, series *domain.EventSeries, occurrences []*domain.Event) (*domain.EventSeries, error) {
+	recurrence, err := json.Marshal(series.Recurrence)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode recurrence: %w", err)
+	}
+
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	address := addressOrEmpty(series.Address)
+	seriesBuilder := tx.EventSeries.
+		Create().
+		SetID(series.ID).
+		SetOrganizationID(series.OrganizationID).
+		SetTitle(series.Title).
+		SetDescription(series.Description).
+		SetLocation(series.Location).
+		SetVenue(series.Venue).
+		SetNillableVenueID(series.VenueID).
+		SetSeatingLayout(series.SeatingLayout).
+		SetAddressStreet(address.Street).
+		SetAddressCity(address.City).
+		SetAddressRegion(address.Region).
+		SetAddressPostalCode(address.PostalCode).
+		SetAddressCountry(address.Country).
+		SetCategory(series.Category).
+		SetStartTime(series.StartTime).
+		SetEndTime(series.EndTime).
+		SetRecurrence(string(recurrence)).
+		SetTotalTickets(series.TotalTickets).
+		SetTicketPrice(series.TicketPrice).
+		SetCurrency(series.Currency).
+		SetThumbnailURL(series.ThumbnailURL).
+		SetIsPublic(series.IsPublic).
+		SetRequiresApproval(series.RequiresApproval).
+		SetCreatedBy(series.CreatedBy)
+	if series.Coordinates != nil {
+		seriesBuilder.SetLatitude(series.Coordinates.Latitude).SetLongitude(series.Coordinates.Longitude)
+	}
+	created, err := seriesBuilder.Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to create event series: %w", err)
+	}
+
+	builders := make([]*ent.EventCreate, len(occurrences))
+	for i, evt := range occurrences {
+		address := addressOrEmpty(evt.Address)
+		builder := tx.Event.
+			Create().
+			SetID(evt.ID).
+			SetOrganizationID(evt.OrganizationID).
+			SetSeriesID(created.ID).
+			SetTitle(evt.Title).
+			SetDescription(evt.Description).
+			SetLocation(evt.Location).
+			SetVenue(evt.Venue).
+			SetNillableVenueID(evt.VenueID).
+			SetSeatingLayout(evt.SeatingLayout).
+			SetAddressStreet(address.Street).
+			SetAddressCity(address.City).
+			SetAddressRegion(address.Region).
+			SetAddressPostalCode(address.PostalCode).
+			SetAddressCountry(address.Country).
+			SetGeohash(eventGeohash(evt.Coordinates)).
+			SetCategory(evt.Category).
+			SetStartTime(evt.StartTime).
+			SetEndTime(evt.EndTime).
+			SetTotalTickets(evt.TotalTickets).
+			SetAvailableTickets(evt.AvailableTickets).
+			SetTicketPrice(evt.TicketPrice).
+			SetCurrency(evt.Currency).
+			SetThumbnailURL(evt.ThumbnailURL).
+			SetIsPublic(evt.IsPublic).
+			SetRequiresApproval(evt.RequiresApproval).
+			SetCreatedBy(evt.CreatedBy)
+		if evt.Coordinates != nil {
+			builder.SetLatitude(evt.Coordinates.Latitude).SetLongitude(evt.Coordinates.Longitude)
+		}
+		if evt.Review != nil {
+			builder.SetReviewStatus(event.ReviewStatus(evt.Review.Status))
+		}
+		builders[i] = builder
+	}
+	if _, err := tx.Event.CreateBulk(builders...).Save(ctx); err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to create series occurrences: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return mapEventSeriesToDomain(created)
+}
+
+// GetByID retrieves an event series by ID
+func (r *eventSeriesRepository) GetByID(seriesID uuid.UUID) (*domain.EventSeries, error) {
+	ctx := context.Background()
+
+	series, err := r.client.EventSeries.
+		Query().
+		Where(eventseries.ID(seriesID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get event series: %w", err)
+	}
+
+	return mapEventSeriesToDomain(series)
+}
+
+// GetByOrganizationID retrieves every series of an organization, newest first
+func (r *eventSeriesRepository) GetByOrganizationID(orgID uuid.UUID) ([]*domain.EventSeries, error) {
+	ctx := context.Background()
+
+	series, err := r.client.EventSeries.
+		Query().
+		Where(eventseries.OrganizationID(orgID)).
+		Order(ent.Desc(eventseries.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event series: %w", err)
+	}
+
+	result := make([]*domain.EventSeries, len(series))
+	for i, s := range series {
+		if result[i], err = mapEventSeriesToDomain(s); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+// Update updates the template fields of a series; its schedule cannot change
+func (r *eventSeriesRepository) Update(ctx context.Context, series *domain.EventSeries) error {
+	address := addressOrEmpty(series.Address)
+	update := r.client.EventSeries.
+		UpdateOneID(series.ID).
+		SetTitle(series.Title).
+		SetDescription(series.Description).
+		SetLocation(series.Location).
+		SetVenue(series.Venue).
+		SetNillableVenueID(series.VenueID).
+		SetSeatingLayout(series.SeatingLayout).
+		SetAddressStreet(address.Street).
+		SetAddressCity(address.City).
+		SetAddressRegion(address.Region).
+		SetAddressPostalCode(address.PostalCode).
+		SetAddressCountry(address.Country).
+		SetCategory(series.Category).
+		SetTotalTickets(series.TotalTickets).
+		SetTicketPrice(series.TicketPrice).
+		SetThumbnailURL(series.ThumbnailURL).
+		SetIsPublic(series.IsPublic).
+		SetRequiresApproval(series.RequiresApproval)
+	if series.VenueID == nil {
+		update.ClearVenueID()
+	}
+	if series.Coordinates != nil {
+		update.SetLatitude(series.Coordinates.Latitude).SetLongitude(series.Coordinates.Longitude)
+	} else {
+		update.ClearLatitude().ClearLongitude()
+	}
+
+	err := update.Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update event series: %w", err)
+	}
+
+	return nil
+}
+
+func mapEventSeriesToDomain(series *ent.EventSeries) (*domain.EventSeries, error) {
+	var recurrence domain.Recurrence
+	if err := json.Unmarshal([]byte(series.Recurrence), &recurrence); err != nil {
+		return nil, fmt.Errorf("failed to decode recurrence: %w", err)
+	}
+
+	return &domain.EventSeries{
+		ID:               series.ID,
+		OrganizationID:   series.OrganizationID,
+		Title:            series.Title,
+		Description:      series.Description,
+		Location:         series.Location,
+		Venue:            series.Venue,
+		VenueID:          series.VenueID,
+		SeatingLayout:    series.SeatingLayout,
+		Address:          mapAddress(series.AddressStreet, series.AddressCity, series.AddressRegion, series.AddressPostalCode, series.AddressCountry),
+		Coordinates:      mapGeoPoint(series.Latitude, series.Longitude),
+		Category:         series.Category,
+		StartTime:        series.StartTime,
+		EndTime:          series.EndTime,
+		Recurrence:       recurrence,
+		TotalTickets:     series.TotalTickets,
+		TicketPrice:      series.TicketPrice,
+		Currency:         series.Currency,
+		ThumbnailURL:     series.ThumbnailURL,
+		IsPublic:         series.IsPublic,
+		RequiresApproval: series.RequiresApproval,
+		CreatedBy:        series.CreatedBy,
+		CreatedAt:        series.CreatedAt,
+		UpdatedAt:        series.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,82 @@
+package mysql
+
+import (
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+)
+
+// matchNearby matches events within radiusKm of point
+// Candidates are narrowed to the geohash cells around the point through the geohash index
+// before their exact distance is checked
+func matchNearby(point domain.GeoPoint, radiusKm float64) predicate.Event {
+	cells := util.GeohashCover(point.Latitude, point.Longitude, radiusKm)
+	return predicate.Event(func(s *sql.Selector) {
+		if len(cells) > 0 {
+			inCells := make([]*sql.Predicate, len(cells))
+			for i, cell := range cells {
+				inCells[i] = sql.HasPrefix(s.C(event.FieldGeohash), cell)
+			}
+			s.Where(sql.Or(inCells...))
+		} else {
+			s.Where(sql.NotNull(s.C(event.FieldLatitude)))
+		}
+		s.Where(sql.ExprP(distanceFrom(s)+" <= ?", point.Longitude, point.Latitude, radiusKm*1000))
+	})
+}
+
+// orderByDistance sorts events by their distance from point, closest first
+func orderByDistance(point domain.GeoPoint) event.OrderOption {
+	return func(s *sql.Selector) {
+		s.OrderExpr(sql.ExprP(distanceFrom(s), point.Longitude, point.Latitude))
+		s.OrderBy(sql.Asc(s.C(event.FieldID)))
+	}
+}
+
+// distanceFrom returns an expression for the distance in metres between an event and a point given as longitude, latitude arguments
+func distanceFrom(s *sql.Selector) string {
+	return fmt.Sprintf("ST_Distance_Sphere(POINT(%s, %s), POINT(?, ?))", s.C(event.FieldLongitude), s.C(event.FieldLatitude))
+}
+
+// eventGeohash returns the geohash stored with an event's coordinates, or "" without coordinates
+func eventGeohash(point *domain.GeoPoint) string {
+	if point == nil {
+		return ""
+	}
+	return util.EncodeGeohash(point.Latitude, point.Longitude, util.GeohashPrecision)
+}
+
+// mapGeoPoint returns nil unless both coordinates are set
+func mapGeoPoint(lat, lng *float64) *domain.GeoPoint {
+	if lat == nil || lng == nil {
+		return nil
+	}
+	return &domain.GeoPoint{Latitude: *lat, Longitude: *lng}
+}
+
+// mapAddress returns nil for an address with no parts set
+func mapAddress(street, city, region, postalCode, country string) *domain.Address {
+	address := domain.Address{
+		Street:     street,
+		City:       city,
+		Region:     region,
+		PostalCode: postalCode,
+		Country:    country,
+	}
+	if address == (domain.Address{}) {
+		return nil
+	}
+	return &address
+}
+
+// addressOrEmpty lets a missing address be stored as empty columns
+func addressOrEmpty(address *domain.Address) domain.Address {
+	if address == nil {
+		return domain.Address{}
+	}
+	return *address
+}
@@ -0,0 +1,220 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
+	"github.com/google/uuid"
+)
+
+type invitationRepository struct {
+	client *ent.Client
+}
+
+func NewInvitationRepository(client *ent.Client) domain.InvitationRepository {
+	return &invitationRepository{
+		client: client,
+	}
+}
+
+// Create creates a new invitation
+func (r *invitationRepository) Create(ctx context.Context, invitation *domain.OrganizationInvitation) (*domain.OrganizationInvitation, error) {
+	created, err := r.client.OrganizationInvitation.
+		Create().
+		SetID(invitation.ID).
+		SetOrganizationID(invitation.OrganizationID).
+		SetEmail(invitation.Email).
+		SetRole(invitation.Role).
+		SetInvitedBy(invitation.InvitedBy).
+		SetStatus(organizationinvitation.Status(invitation.Status)).
+		SetExpiresAt(invitation.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create invitation: %w", err)
+	}
+
+	return mapInvitationToDomain(created), nil
+}
+
+// GetByID retrieves an invitation by ID with its organization
+func (r *invitationRepository) GetByID(invitationID uuid.UUID) (*domain.OrganizationInvitation, error) {
+	ctx := context.Background()
+
+	invitation, err := r.client.OrganizationInvitation.
+		Query().
+		Where(organizationinvitation.ID(invitationID)).
+		WithOrganization().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	return mapInvitationToDomain(invitation), nil
+}
+
+// GetPendingByOrgID retrieves the pending invitations of an organization
+func (r *invitationRepository) GetPendingByOrgID(orgID uuid.UUID) ([]*domain.OrganizationInvitation, error) {
+	ctx := context.Background()
+
+	invitations, err := r.client.OrganizationInvitation.
+		Query().
+		Where(
+			organizationinvitation.OrganizationID(orgID),
+			organizationinvitation.StatusEQ(organizationinvitation.StatusPending),
+		).
+		Order(ent.Desc(organizationinvitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations: %w", err)
+	}
+
+	return mapInvitationsToDomain(invitations), nil
+}
+
+// GetPendingByEmail retrieves the pending invitations sent to an email with their organizations
+func (r *invitationRepository) GetPendingByEmail(email string) ([]*domain.OrganizationInvitation, error) {
+	ctx := context.Background()
+
+	invitations, err := r.client.OrganizationInvitation.
+		Query().
+		Where(
+			organizationinvitation.Email(email),
+			organizationinvitation.StatusEQ(organizationinvitation.StatusPending),
+		).
+		WithOrganization().
+		Order(ent.Desc(organizationinvitation.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get invitations by email: %w", err)
+	}
+
+	return mapInvitationsToDomain(invitations), nil
+}
+
+// GetPendingByOrgIDAndEmail retrieves the pending invitation of an email to an organization
+func (r *invitationRepository) GetPendingByOrgIDAndEmail(orgID uuid.UUID, email string) (*domain.OrganizationInvitation, error) {
+	ctx := context.Background()
+
+	invitation, err := r.client.OrganizationInvitation.
+		Query().
+		Where(
+			organizationinvitation.OrganizationID(orgID),
+			organizationinvitation.Email(email),
+			organizationinvitation.StatusEQ(organizationinvitation.StatusPending),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get invitation: %w", err)
+	}
+
+	return mapInvitationToDomain(invitation), nil
+}
+
+// UpdateStatus records a response to a pending invitation
+func (r *invitationRepository) UpdateStatus(ctx context.Context, invitationID uuid.UUID, status string, respondedBy *uuid.UUID) error {
+	// Only pending invitations can change status, so concurrent responses cannot both succeed
+	affected, err := r.client.OrganizationInvitation.
+		Update().
+		Where(
+			organizationinvitation.ID(invitationID),
+			organizationinvitation.StatusEQ(organizationinvitation.StatusPending),
+		).
+		SetStatus(organizationinvitation.Status(status)).
+		SetNillableRespondedBy(respondedBy).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update invitation status: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// Accept marks a pending invitation accepted and adds the member in one transaction
+func (r *invitationRepository) Accept(ctx context.Context, invitationID uuid.UUID, member *domain.OrganizationMember) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Close the invitation first so a concurrent response cannot also succeed
+	affected, err := tx.OrganizationInvitation.
+		Update().
+		Where(
+			organizationinvitation.ID(invitationID),
+			organizationinvitation.StatusEQ(organizationinvitation.StatusPending),
+		).
+		SetStatus(organizationinvitation.StatusAccepted).
+		SetRespondedBy(member.UserID).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to accept invitation: %w", err)
+	}
+	if affected == 0 {
+		tx.Rollback()
+		return domain.ErrNotFound
+	}
+
+	_, err = tx.OrganizationMember.
+		Create().
+		SetOrganizationID(member.OrganizationID).
+		SetUserID(member.UserID).
+		SetRole(member.Role).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return domain.ErrAlreadyExists
+		}
+		return fmt.Errorf("failed to add member: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func mapInvitationsToDomain(invitations []*ent.OrganizationInvitation) []*domain.OrganizationInvitation {
+	result := make([]*domain.OrganizationInvitation, len(invitations))
+	for i, invitation := range invitations {
+		result[i] = mapInvitationToDomain(invitation)
+	}
+	return result
+}
+
+func mapInvitationToDomain(invitation *ent.OrganizationInvitation) *domain.OrganizationInvitation {
+	result := &domain.OrganizationInvitation{
+		ID:             invitation.ID,
+		OrganizationID: invitation.OrganizationID,
+		Email:          invitation.Email,
+		Role:           invitation.Role,
+		InvitedBy:      invitation.InvitedBy,
+		Status:         string(invitation.Status),
+		ExpiresAt:      invitation.ExpiresAt,
+		RespondedBy:    invitation.RespondedBy,
+		RespondedAt:    invitation.RespondedAt,
+		CreatedAt:      invitation.CreatedAt,
+		UpdatedAt:      invitation.UpdatedAt,
+	}
+	if invitation.Edges.Organization != nil {
+		result.OrganizationName = invitation.Edges.Organization.Name
+	}
+	return result
+}
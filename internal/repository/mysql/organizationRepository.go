@@ -3,11 +3,14 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationmember"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationrole"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
 	"github.com/google/uuid"
 )
 
@@ -21,10 +24,8 @@ func NewOrganizationRepository(client *ent.Client) domain.OrganizationRepository
 	}
 }
 
-// Create creates a new organization and adds the owner as an admin member
-func (r *organizationRepository) Create(org *domain.Organization) (*domain.Organization, error) {
-	ctx := context.Background()
-
+// Create creates a new organization and adds the owner as an owner member
+func (r *organizationRepository) Create(ctx context.Context, org *domain.Organization) (*domain.Organization, error) {
 	// Start transaction
 	tx, err := r.client.Tx(ctx)
 	if err != nil {
@@ -44,23 +45,32 @@ func (r *organizationRepository) Create(org *domain.Organization) (*domain.Organ
 	if org.Category != "" {
 		builder.SetCategory(org.Category)
 	}
+	if org.Slug != "" {
+		builder.SetSlug(org.Slug)
+	}
+	if org.SocialLinks != nil {
+		builder.SetSocialLinks(org.SocialLinks)
+	}
 
 	createdOrg, err := builder.Save(ctx)
 	if err != nil {
 		tx.Rollback()
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrAlreadyExists
+		}
 		return nil, fmt.Errorf("failed to create organization: %w", err)
 	}
 
-	// Add owner as admin member
+	// Add owner as member
 	_, err = tx.OrganizationMember.
 		Create().
 		SetOrganizationID(createdOrg.ID).
 		SetUserID(org.OwnerID).
-		SetRole(organizationmember.RoleAdmin).
+		SetRole(domain.RoleOwner).
 		Save(ctx)
 	if err != nil {
 		tx.Rollback()
-		return nil, fmt.Errorf("failed to add owner as admin: %w", err)
+		return nil, fmt.Errorf("failed to add owner as member: %w", err)
 	}
 
 	// Commit transaction
@@ -68,17 +78,7 @@ func (r *organizationRepository) Create(org *domain.Organization) (*domain.Organ
 		return nil, fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
-	return &domain.Organization{
-		ID:          createdOrg.ID,
-		Name:        createdOrg.Name,
-		Description: createdOrg.Description,
-		LogoURL:     createdOrg.LogoURL,
-		Category:    createdOrg.Category,
-		OwnerID:     createdOrg.OwnerID,
-		IsActive:    createdOrg.IsActive,
-		CreatedAt:   createdOrg.CreatedAt,
-		UpdatedAt:   createdOrg.UpdatedAt,
-	}, nil
+	return mapOrganizationToDomain(createdOrg), nil
 }
 
 // GetByID retrieves an organization by ID
@@ -87,7 +87,10 @@ func (r *organizationRepository) GetByID(orgID uuid.UUID) (*domain.Organization,
 
 	org, err := r.client.Organization.
 		Query().
-		Where(organization.ID(orgID)).
+		Where(
+			organization.ID(orgID),
+			organization.DeletedAtIsNil(),
+		).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -96,17 +99,7 @@ func (r *organizationRepository) GetByID(orgID uuid.UUID) (*domain.Organization,
 		return nil, fmt.Errorf("failed to get organization: %w", err)
 	}
 
-	return &domain.Organization{
-		ID:          org.ID,
-		Name:        org.Name,
-		Description: org.Description,
-		LogoURL:     org.LogoURL,
-		Category:    org.Category,
-		OwnerID:     org.OwnerID,
-		IsActive:    org.IsActive,
-		CreatedAt:   org.CreatedAt,
-		UpdatedAt:   org.UpdatedAt,
-	}, nil
+	return mapOrganizationToDomain(org), nil
 }
 
 // GetByOwnerID retrieves all organizations owned by a user
@@ -115,7 +108,10 @@ func (r *organizationRepository) GetByOwnerID(ownerID uuid.UUID) ([]*domain.Orga
 
 	orgs, err := r.client.Organization.
 		Query().
-		Where(organization.OwnerID(ownerID)).
+		Where(
+			organization.OwnerID(ownerID),
+			organization.DeletedAtIsNil(),
+		).
 		All(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get organizations by owner: %w", err)
@@ -123,70 +119,157 @@ func (r *organizationRepository) GetByOwnerID(ownerID uuid.UUID) ([]*domain.Orga
 
 	result := make([]*domain.Organization, len(orgs))
 	for i, org := range orgs {
-		result[i] = &domain.Organization{
-			ID:          org.ID,
-			Name:        org.Name,
-			Description: org.Description,
-			LogoURL:     org.LogoURL,
-			Category:    org.Category,
-			OwnerID:     org.OwnerID,
-			IsActive:    org.IsActive,
-			CreatedAt:   org.CreatedAt,
-			UpdatedAt:   org.UpdatedAt,
+		result[i] = mapOrganizationToDomain(org)
+	}
+
+	return result, nil
+}
+
+// GetBySlug retrieves an organization by its URL slug
+func (r *organizationRepository) GetBySlug(slug string) (*domain.Organization, error) {
+	ctx := context.Background()
+
+	org, err := r.client.Organization.
+		Query().
+		Where(
+			organization.Slug(slug),
+			organization.DeletedAtIsNil(),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
 		}
+		return nil, fmt.Errorf("failed to get organization by slug: %w", err)
+	}
+
+	return mapOrganizationToDomain(org), nil
+}
+
+// GetPublicOrganizations retrieves active organizations with a public profile, optionally filtered by category
+func (r *organizationRepository) GetPublicOrganizations(category string) ([]*domain.Organization, error) {
+	ctx := context.Background()
+
+	query := r.client.Organization.
+		Query().
+		Where(
+			organization.IsActive(true),
+			organization.DeletedAtIsNil(),
+			organization.SlugNotNil(),
+		)
+	if category != "" {
+		query = query.Where(organization.CategoryEqualFold(category))
+	}
+
+	orgs, err := query.
+		Order(ent.Asc(organization.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public organizations: %w", err)
+	}
+
+	result := make([]*domain.Organization, len(orgs))
+	for i, org := range orgs {
+		result[i] = mapOrganizationToDomain(org)
 	}
 
 	return result, nil
 }
 
-// Update updates an organization
-func (r *organizationRepository) Update(org *domain.Organization) error {
+// SuggestPublic suggests active organizations with a public profile in alphabetical order
+func (r *organizationRepository) SuggestPublic(prefix string, limit int) ([]*domain.SearchSuggestion, error) {
 	ctx := context.Background()
 
-	err := r.client.Organization.
+	orgs, err := r.client.Organization.
+		Query().
+		Where(
+			organization.IsActive(true),
+			organization.DeletedAtIsNil(),
+			organization.SlugNotNil(),
+			predicate.Organization(matchWordPrefix(organization.FieldName, organization.FieldNameInitials, prefix)),
+		).
+		Order(ent.Asc(organization.FieldName)).
+		Limit(limit).
+		Select(organization.FieldName, organization.FieldSlug).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to suggest organizations: %w", err)
+	}
+
+	result := make([]*domain.SearchSuggestion, len(orgs))
+	for i, org := range orgs {
+		result[i] = &domain.SearchSuggestion{
+			Type:             domain.SuggestionOrganization,
+			Text:             org.Name,
+			OrganizationSlug: *org.Slug,
+		}
+	}
+
+	return result, nil
+}
+
+// Update updates an organization
+func (r *organizationRepository) Update(ctx context.Context, org *domain.Organization) error {
+	builder := r.client.Organization.
 		UpdateOneID(org.ID).
 		SetName(org.Name).
 		SetDescription(org.Description).
 		SetLogoURL(org.LogoURL).
 		SetCategory(org.Category).
-		SetIsActive(org.IsActive).
-		Exec(ctx)
+		SetIsActive(org.IsActive)
+
+	if org.Slug != "" {
+		builder.SetSlug(org.Slug)
+	}
+	if org.SocialLinks != nil {
+		builder.SetSocialLinks(org.SocialLinks)
+	} else {
+		builder.ClearSocialLinks()
+	}
+
+	err := builder.Exec(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
 			return domain.ErrNotFound
 		}
+		if ent.IsConstraintError(err) {
+			return domain.ErrAlreadyExists
+		}
 		return fmt.Errorf("failed to update organization: %w", err)
 	}
 
 	return nil
 }
 
-// Delete deletes an organization
-func (r *organizationRepository) Delete(orgID uuid.UUID) error {
-	ctx := context.Background()
-
-	err := r.client.Organization.
-		DeleteOneID(orgID).
-		Exec(ctx)
+// Delete soft-deletes an organization so its events and payments stay on record
+func (r *organizationRepository) Delete(ctx context.Context, orgID uuid.UUID) error {
+	affected, err := r.client.Organization.
+		Update().
+		Where(
+			organization.ID(orgID),
+			organization.DeletedAtIsNil(),
+		).
+		SetIsActive(false).
+		SetDeletedAt(time.Now()).
+		ClearSlug(). // Free the slug for other organizations
+		Save(ctx)
 	if err != nil {
-		if ent.IsNotFound(err) {
-			return domain.ErrNotFound
-		}
 		return fmt.Errorf("failed to delete organization: %w", err)
 	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
 
 	return nil
 }
 
 // AddMember adds a member to an organization
-func (r *organizationRepository) AddMember(member *domain.OrganizationMember) error {
-	ctx := context.Background()
-
+func (r *organizationRepository) AddMember(ctx context.Context, member *domain.OrganizationMember) error {
 	_, err := r.client.OrganizationMember.
 		Create().
 		SetOrganizationID(member.OrganizationID).
 		SetUserID(member.UserID).
-		SetRole(organizationmember.Role(member.Role)).
+		SetRole(member.Role).
 		Save(ctx)
 	if err != nil {
 		return fmt.Errorf("failed to add member: %w", err)
@@ -196,9 +279,7 @@ func (r *organizationRepository) AddMember(member *domain.OrganizationMember) er
 }
 
 // RemoveMember removes a member from an organization
-func (r *organizationRepository) RemoveMember(orgID, userID uuid.UUID) error {
-	ctx := context.Background()
-
+func (r *organizationRepository) RemoveMember(ctx context.Context, orgID, userID uuid.UUID) error {
 	_, err := r.client.OrganizationMember.
 		Delete().
 		Where(
@@ -235,7 +316,7 @@ func (r *organizationRepository) GetMember(orgID, userID uuid.UUID) (*domain.Org
 		ID:             member.ID,
 		OrganizationID: member.OrganizationID,
 		UserID:         member.UserID,
-		Role:           string(member.Role),
+		Role:           member.Role,
 		JoinedAt:       member.JoinedAt,
 		CreatedAt:      member.CreatedAt,
 		UpdatedAt:      member.UpdatedAt,
@@ -260,7 +341,7 @@ func (r *organizationRepository) GetMembersByOrgID(orgID uuid.UUID) ([]*domain.O
 			ID:             member.ID,
 			OrganizationID: member.OrganizationID,
 			UserID:         member.UserID,
-			Role:           string(member.Role),
+			Role:           member.Role,
 			JoinedAt:       member.JoinedAt,
 			CreatedAt:      member.CreatedAt,
 			UpdatedAt:      member.UpdatedAt,
@@ -271,16 +352,14 @@ func (r *organizationRepository) GetMembersByOrgID(orgID uuid.UUID) ([]*domain.O
 }
 
 // UpdateMemberRole updates a member's role
-func (r *organizationRepository) UpdateMemberRole(orgID, userID uuid.UUID, role string) error {
-	ctx := context.Background()
-
+func (r *organizationRepository) UpdateMemberRole(ctx context.Context, orgID, userID uuid.UUID, role string) error {
 	_, err := r.client.OrganizationMember.
 		Update().
 		Where(
 			organizationmember.OrganizationID(orgID),
 			organizationmember.UserID(userID),
 		).
-		SetRole(organizationmember.Role(role)).
+		SetRole(role).
 		Save(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -292,7 +371,25 @@ func (r *organizationRepository) UpdateMemberRole(orgID, userID uuid.UUID, role
 	return nil
 }
 
-// IsUserAdmin checks if a user is an admin of an organization
+// CountMembersWithRole counts the members of an organization assigned to a role
+func (r *organizationRepository) CountMembersWithRole(orgID uuid.UUID, role string) (int, error) {
+	ctx := context.Background()
+
+	count, err := r.client.OrganizationMember.
+		Query().
+		Where(
+			organizationmember.OrganizationID(orgID),
+			organizationmember.Role(role),
+		).
+		Count(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to count members with role: %w", err)
+	}
+
+	return count, nil
+}
+
+// IsUserAdmin checks if a user is an owner or admin of an organization
 func (r *organizationRepository) IsUserAdmin(orgID, userID uuid.UUID) (bool, error) {
 	ctx := context.Background()
 
@@ -301,7 +398,7 @@ func (r *organizationRepository) IsUserAdmin(orgID, userID uuid.UUID) (bool, err
 		Where(
 			organizationmember.OrganizationID(orgID),
 			organizationmember.UserID(userID),
-			organizationmember.RoleEQ(organizationmember.RoleAdmin),
+			organizationmember.RoleIn(domain.RoleOwner, domain.RoleAdmin),
 		).
 		Count(ctx)
 	if err != nil {
@@ -335,7 +432,10 @@ func (r *organizationRepository) GetUserOrganizations(userID uuid.UUID) ([]*doma
 
 	members, err := r.client.OrganizationMember.
 		Query().
-		Where(organizationmember.UserID(userID)).
+		Where(
+			organizationmember.UserID(userID),
+			organizationmember.HasOrganizationWith(organization.DeletedAtIsNil()),
+		).
 		WithOrganization().
 		All(ctx)
 	if err != nil {
@@ -346,20 +446,150 @@ func (r *organizationRepository) GetUserOrganizations(userID uuid.UUID) ([]*doma
 	for i, member := range members {
 		org := member.Edges.Organization
 		result[i] = &domain.OrganizationWithRole{
-			Organization: domain.Organization{
-				ID:          org.ID,
-				Name:        org.Name,
-				Description: org.Description,
-				LogoURL:     org.LogoURL,
-				Category:    org.Category,
-				OwnerID:     org.OwnerID,
-				IsActive:    org.IsActive,
-				CreatedAt:   org.CreatedAt,
-				UpdatedAt:   org.UpdatedAt,
-			},
-			UserRole: string(member.Role),
+			Organization: *mapOrganizationToDomain(org),
+			UserRole:     member.Role,
 		}
 	}
 
 	return result, nil
 }
+
+// CreateRole creates a custom role for an organization
+func (r *organizationRepository) CreateRole(ctx context.Context, role *domain.OrganizationRole) (*domain.OrganizationRole, error) {
+	created, err := r.client.OrganizationRole.
+		Create().
+		SetID(role.ID).
+		SetOrganizationID(role.OrganizationID).
+		SetName(role.Name).
+		SetDescription(role.Description).
+		SetPermissions(permissionsToStrings(role.Permissions)).
+		Save(ctx)
+	if err != nil {
+		if ent.IsConstraintError(err) {
+			return nil, domain.ErrAlreadyExists
+		}
+		return nil, fmt.Errorf("failed to create role: %w", err)
+	}
+
+	return mapRoleToDomain(created), nil
+}
+
+// GetRoles retrieves all custom roles of an organization
+func (r *organizationRepository) GetRoles(orgID uuid.UUID) ([]*domain.OrganizationRole, error) {
+	ctx := context.Background()
+
+	roles, err := r.client.OrganizationRole.
+		Query().
+		Where(organizationrole.OrganizationID(orgID)).
+		Order(ent.Asc(organizationrole.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get roles: %w", err)
+	}
+
+	result := make([]*domain.OrganizationRole, len(roles))
+	for i, role := range roles {
+		result[i] = mapRoleToDomain(role)
+	}
+
+	return result, nil
+}
+
+// GetRoleByName retrieves a custom role of an organization by name
+func (r *organizationRepository) GetRoleByName(orgID uuid.UUID, name string) (*domain.OrganizationRole, error) {
+	ctx := context.Background()
+
+	role, err := r.client.OrganizationRole.
+		Query().
+		Where(
+			organizationrole.OrganizationID(orgID),
+			organizationrole.Name(name),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get role: %w", err)
+	}
+
+	return mapRoleToDomain(role), nil
+}
+
+// UpdateRole updates a custom role's description and permissions
+func (r *organizationRepository) UpdateRole(ctx context.Context, role *domain.OrganizationRole) error {
+	err := r.client.OrganizationRole.
+		UpdateOneID(role.ID).
+		SetDescription(role.Description).
+		SetPermissions(permissionsToStrings(role.Permissions)).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update role: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteRole deletes a custom role
+func (r *organizationRepository) DeleteRole(ctx context.Context, roleID uuid.UUID) error {
+	err := r.client.OrganizationRole.
+		DeleteOneID(roleID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete role: %w", err)
+	}
+
+	return nil
+}
+
+func mapOrganizationToDomain(org *ent.Organization) *domain.Organization {
+	slug := ""
+	if org.Slug != nil {
+		slug = *org.Slug
+	}
+
+	return &domain.Organization{
+		ID:          org.ID,
+		Name:        org.Name,
+		Slug:        slug,
+		Description: org.Description,
+		LogoURL:     org.LogoURL,
+		Category:    org.Category,
+		SocialLinks: org.SocialLinks,
+		OwnerID:     org.OwnerID,
+		IsActive:    org.IsActive,
+		CreatedAt:   org.CreatedAt,
+		UpdatedAt:   org.UpdatedAt,
+	}
+}
+
+func mapRoleToDomain(role *ent.OrganizationRole) *domain.OrganizationRole {
+	permissions := make([]domain.Permission, len(role.Permissions))
+	for i, p := range role.Permissions {
+		permissions[i] = domain.Permission(p)
+	}
+
+	return &domain.OrganizationRole{
+		ID:             role.ID,
+		OrganizationID: role.OrganizationID,
+		Name:           role.Name,
+		Description:    role.Description,
+		Permissions:    permissions,
+		CreatedAt:      role.CreatedAt,
+		UpdatedAt:      role.UpdatedAt,
+	}
+}
+
+func permissionsToStrings(permissions []domain.Permission) []string {
+	result := make([]string, len(permissions))
+	for i, p := range permissions {
+		result[i] = string(p)
+	}
+	return result
+}
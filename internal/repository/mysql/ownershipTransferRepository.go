@@ -0,0 +1,166 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationmember"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
+	"github.com/google/uuid"
+)
+
+type ownershipTransferRepository struct {
+	client *ent.Client
+}
+
+func NewOwnershipTransferRepository(client *ent.Client) domain.OwnershipTransferRepository {
+	return &ownershipTransferRepository{
+		client: client,
+	}
+}
+
+// Create creates a new ownership transfer proposal
+func (r *ownershipTransferRepository) Create(ctx context.Context, transfer *domain.OwnershipTransfer) (*domain.OwnershipTransfer, error) {
+	created, err := r.client.OwnershipTransfer.
+		Create().
+		SetID(transfer.ID).
+		SetOrganizationID(transfer.OrganizationID).
+		SetFromUserID(transfer.FromUserID).
+		SetToUserID(transfer.ToUserID).
+		SetStatus(ownershiptransfer.Status(transfer.Status)).
+		SetExpiresAt(transfer.ExpiresAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ownership transfer: %w", err)
+	}
+
+	return mapTransferToDomain(created), nil
+}
+
+// GetPendingByOrgID retrieves the pending ownership transfer of an organization
+func (r *ownershipTransferRepository) GetPendingByOrgID(orgID uuid.UUID) (*domain.OwnershipTransfer, error) {
+	ctx := context.Background()
+
+	transfer, err := r.client.OwnershipTransfer.
+		Query().
+		Where(
+			ownershiptransfer.OrganizationID(orgID),
+			ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending),
+		).
+		Order(ent.Desc(ownershiptransfer.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get ownership transfer: %w", err)
+	}
+
+	return mapTransferToDomain(transfer), nil
+}
+
+// UpdateStatus closes a pending ownership transfer
+func (r *ownershipTransferRepository) UpdateStatus(ctx context.Context, transferID uuid.UUID, status string) error {
+	affected, err := r.client.OwnershipTransfer.
+		Update().
+		Where(
+			ownershiptransfer.ID(transferID),
+			ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending),
+		).
+		SetStatus(ownershiptransfer.Status(status)).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update ownership transfer: %w", err)
+	}
+	if affected == 0 {
+		return domain.ErrNotFound
+	}
+
+	return nil
+}
+
+// Complete accepts a pending transfer and hands the organization over in one transaction
+func (r *ownershipTransferRepository) Complete(ctx context.Context, transfer *domain.OwnershipTransfer) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// Close the proposal first so a concurrent accept or cancel cannot also succeed
+	affected, err := tx.OwnershipTransfer.
+		Update().
+		Where(
+			ownershiptransfer.ID(transfer.ID),
+			ownershiptransfer.StatusEQ(ownershiptransfer.StatusPending),
+		).
+		SetStatus(ownershiptransfer.StatusAccepted).
+		SetRespondedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to accept ownership transfer: %w", err)
+	}
+	if affected == 0 {
+		tx.Rollback()
+		return domain.ErrNotFound
+	}
+
+	err = tx.Organization.
+		UpdateOneID(transfer.OrganizationID).
+		SetOwnerID(transfer.ToUserID).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to update organization owner: %w", err)
+	}
+
+	roles := []struct {
+		userID uuid.UUID
+		role   string
+	}{
+		{transfer.ToUserID, domain.RoleOwner},
+		{transfer.FromUserID, domain.RoleAdmin},
+	}
+	for _, member := range roles {
+		affected, err := tx.OrganizationMember.
+			Update().
+			Where(
+				organizationmember.OrganizationID(transfer.OrganizationID),
+				organizationmember.UserID(member.userID),
+			).
+			SetRole(member.role).
+			Save(ctx)
+		if err != nil {
+			tx.Rollback()
+			return fmt.Errorf("failed to update member role: %w", err)
+		}
+		if affected == 0 {
+			tx.Rollback()
+			return domain.ErrNotFound
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func mapTransferToDomain(transfer *ent.OwnershipTransfer) *domain.OwnershipTransfer {
+	return &domain.OwnershipTransfer{
+		ID:             transfer.ID,
+		OrganizationID: transfer.OrganizationID,
+		FromUserID:     transfer.FromUserID,
+		ToUserID:       transfer.ToUserID,
+		Status:         string(transfer.Status),
+		ExpiresAt:      transfer.ExpiresAt,
+		RespondedAt:    transfer.RespondedAt,
+		CreatedAt:      transfer.CreatedAt,
+		UpdatedAt:      transfer.UpdatedAt,
+	}
+}
@@ -3,6 +3,7 @@ package mysql
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
@@ -20,9 +21,7 @@ func NewPaymentRepository(client *ent.Client) *PaymentRepository {
 	}
 }
 
-func (r *PaymentRepository) Create(p *domain.Payment) (*domain.Payment, error) {
-	ctx := context.Background()
-
+func (r *PaymentRepository) Create(ctx context.Context, p *domain.Payment) (*domain.Payment, error) {
 	builder := r.client.Payment.
 		Create().
 		SetID(p.ID).
@@ -48,6 +47,22 @@ func (r *PaymentRepository) Create(p *domain.Payment) (*domain.Payment, error) {
 		builder.SetOrderID(p.OrderID)
 	}
 
+	if p.Channel != "" {
+		builder.SetChannel(payment.Channel(p.Channel))
+	}
+
+	if p.PaymentMethod != "" {
+		builder.SetPaymentMethod(payment.PaymentMethod(p.PaymentMethod))
+	}
+
+	if p.OperatorID != nil {
+		builder.SetOperatorID(*p.OperatorID)
+	}
+
+	if p.ReceiptNumber != "" {
+		builder.SetReceiptNumber(p.ReceiptNumber)
+	}
+
 	createdPayment, err := builder.Save(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create payment: %w", err)
@@ -130,6 +145,53 @@ func (r *PaymentRepository) GetByEventID(eventID uuid.UUID) ([]*domain.Payment,
 	return result, nil
 }
 
+// GetByEventIDAndChannel retrieves all payments for an event made through the given channel
+func (r *PaymentRepository) GetByEventIDAndChannel(eventID uuid.UUID, channel string) ([]*domain.Payment, error) {
+	ctx := context.Background()
+
+	payments, err := r.client.Payment.
+		Query().
+		Where(
+			payment.EventID(eventID),
+			payment.ChannelEQ(payment.Channel(channel)),
+		).
+		Order(ent.Asc(payment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get payments by event ID and channel: %w", err)
+	}
+
+	result := make([]*domain.Payment, len(payments))
+	for i, p := range payments {
+		result[i] = r.mapToDomain(p)
+	}
+
+	return result, nil
+}
+
+// GetLatestByEventIDAndUserID retrieves the most recent payment a user made for an event through the given channel
+func (r *PaymentRepository) GetLatestByEventIDAndUserID(eventID, userID uuid.UUID, channel string) (*domain.Payment, error) {
+	ctx := context.Background()
+
+	p, err := r.client.Payment.
+		Query().
+		Where(
+			payment.EventID(eventID),
+			payment.UserID(userID),
+			payment.ChannelEQ(payment.Channel(channel)),
+		).
+		Order(ent.Desc(payment.FieldCreatedAt)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get payment by event ID and user ID: %w", err)
+	}
+
+	return r.mapToDomain(p), nil
+}
+
 func (r *PaymentRepository) GetCompletedPaymentsByEventID(eventID uuid.UUID) ([]*domain.Payment, error) {
 	ctx := context.Background()
 
@@ -153,9 +215,68 @@ func (r *PaymentRepository) GetCompletedPaymentsByEventID(eventID uuid.UUID) ([]
 	return result, nil
 }
 
-func (r *PaymentRepository) UpdateStatus(paymentID uuid.UUID, status string, paymentKey string) error {
+// GetByEventIDFiltered retrieves a page of an event's payments matching the filter, oldest first
+func (r *PaymentRepository) GetByEventIDFiltered(eventID uuid.UUID, filter domain.PaymentFilter, offset, limit int) ([]*domain.Payment, error) {
 	ctx := context.Background()
 
+	query := r.client.Payment.
+		Query().
+		Where(payment.EventID(eventID))
+
+	if len(filter.Statuses) > 0 {
+		statuses := make([]payment.Status, len(filter.Statuses))
+		for i, s := range filter.Statuses {
+			statuses[i] = payment.Status(s)
+		}
+		query = query.Where(payment.StatusIn(statuses...))
+	}
+
+	if filter.CheckedIn != nil {
+		if *filter.CheckedIn {
+			query = query.Where(payment.CheckedInAtNotNil())
+		} else {
+			query = query.Where(payment.CheckedInAtIsNil())
+		}
+	}
+
+	payments, err := query.
+		Order(ent.Asc(payment.FieldCreatedAt), ent.Asc(payment.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get filtered payments by event ID: %w", err)
+	}
+
+	result := make([]*domain.Payment, len(payments))
+	for i, p := range payments {
+		result[i] = r.mapToDomain(p)
+	}
+
+	return result, nil
+}
+
+// UpdateCheckIn sets or clears a payment's check-in time
+func (r *PaymentRepository) UpdateCheckIn(ctx context.Context, paymentID uuid.UUID, checkedInAt *time.Time) error {
+	builder := r.client.Payment.UpdateOneID(paymentID)
+	if checkedInAt != nil {
+		builder.SetCheckedInAt(*checkedInAt)
+	} else {
+		builder.ClearCheckedInAt()
+	}
+
+	err := builder.Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update check-in: %w", err)
+	}
+
+	return nil
+}
+
+func (r *PaymentRepository) UpdateStatus(ctx context.Context, paymentID uuid.UUID, status string, paymentKey string) error {
 	builder := r.client.Payment.
 		UpdateOneID(paymentID).
 		SetStatus(payment.Status(status))
@@ -199,12 +320,44 @@ func (r *PaymentRepository) GetParticipantCountByEventID(eventID uuid.UUID) (int
 	return totalParticipants, nil
 }
 
+// GetBoxOfficeSalesByEventID retrieves completed box-office sales recorded within [from, to)
+func (r *PaymentRepository) GetBoxOfficeSalesByEventID(eventID uuid.UUID, from, to time.Time) ([]*domain.Payment, error) {
+	ctx := context.Background()
+
+	payments, err := r.client.Payment.
+		Query().
+		Where(
+			payment.EventID(eventID),
+			payment.ChannelEQ(payment.ChannelBoxOffice),
+			payment.StatusEQ(payment.StatusCompleted),
+			payment.CreatedAtGTE(from),
+			payment.CreatedAtLT(to),
+		).
+		Order(ent.Asc(payment.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get box-office sales by event ID: %w", err)
+	}
+
+	result := make([]*domain.Payment, len(payments))
+	for i, p := range payments {
+		result[i] = r.mapToDomain(p)
+	}
+
+	return result, nil
+}
+
 func (r *PaymentRepository) mapToDomain(p *ent.Payment) *domain.Payment {
 	var userID *uuid.UUID
 	if p.UserID != uuid.Nil {
 		userID = &p.UserID
 	}
 
+	var operatorID *uuid.UUID
+	if p.OperatorID != uuid.Nil {
+		operatorID = &p.OperatorID
+	}
+
 	return &domain.Payment{
 		ID:             p.ID,
 		EventID:        p.EventID,
@@ -219,6 +372,11 @@ func (r *PaymentRepository) mapToDomain(p *ent.Payment) *domain.Payment {
 		PaymentKey:     p.PaymentKey,
 		OrderID:        p.OrderID,
 		Status:         string(p.Status),
+		Channel:        string(p.Channel),
+		PaymentMethod:  string(p.PaymentMethod),
+		OperatorID:     operatorID,
+		ReceiptNumber:  p.ReceiptNumber,
+		CheckedInAt:    p.CheckedInAt,
 		CreatedAt:      p.CreatedAt,
 		UpdatedAt:      p.UpdatedAt,
 	}
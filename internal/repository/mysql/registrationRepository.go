@@ -0,0 +1,199 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
+	"github.com/google/uuid"
+)
+
+type registrationRepository struct {
+	client *ent.Client
+}
+
+func NewRegistrationRepository(client *ent.Client) domain.RegistrationRepository {
+	return &registrationRepository{
+		client: client,
+	}
+}
+
+// ReplaceQuestions replaces an event's registration form with the given questions
+// Questions with an existing ID are updated in place so stored answers keep pointing at them
+func (r *registrationRepository) ReplaceQuestions(eventID uuid.UUID, questions []*domain.RegistrationQuestion) ([]*domain.RegistrationQuestion, error) {
+	ctx := context.Background()
+
+	// Start transaction
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	keep := make([]uuid.UUID, 0, len(questions))
+	for _, q := range questions {
+		keep = append(keep, q.ID)
+	}
+
+	// Remove questions that are no longer part of the form
+	_, err = tx.RegistrationQuestion.
+		Delete().
+		Where(
+			registrationquestion.EventID(eventID),
+			registrationquestion.IDNotIn(keep...),
+		).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return nil, fmt.Errorf("failed to remove registration questions: %w", err)
+	}
+
+	result := make([]*domain.RegistrationQuestion, 0, len(questions))
+	for _, q := range questions {
+		exists, err := tx.RegistrationQuestion.
+			Query().
+			Where(
+				registrationquestion.ID(q.ID),
+				registrationquestion.EventID(eventID),
+			).
+			Exist(ctx)
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to check registration question: %w", err)
+		}
+
+		var saved *ent.RegistrationQuestion
+		if exists {
+			saved, err = tx.RegistrationQuestion.
+				UpdateOneID(q.ID).
+				SetLabel(q.Label).
+				SetType(registrationquestion.Type(q.Type)).
+				SetOptions(q.Options).
+				SetRequired(q.Required).
+				SetPosition(q.Position).
+				Save(ctx)
+		} else {
+			saved, err = tx.RegistrationQuestion.
+				Create().
+				SetID(q.ID).
+				SetEventID(eventID).
+				SetLabel(q.Label).
+				SetType(registrationquestion.Type(q.Type)).
+				SetOptions(q.Options).
+				SetRequired(q.Required).
+				SetPosition(q.Position).
+				Save(ctx)
+		}
+		if err != nil {
+			tx.Rollback()
+			return nil, fmt.Errorf("failed to save registration question: %w", err)
+		}
+
+		result = append(result, r.mapQuestionToDomain(saved))
+	}
+
+	// Commit transaction
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return result, nil
+}
+
+// GetQuestionsByEventID retrieves an event's registration questions in display order
+func (r *registrationRepository) GetQuestionsByEventID(eventID uuid.UUID) ([]*domain.RegistrationQuestion, error) {
+	ctx := context.Background()
+
+	questions, err := r.client.RegistrationQuestion.
+		Query().
+		Where(registrationquestion.EventID(eventID)).
+		Order(ent.Asc(registrationquestion.FieldPosition), ent.Asc(registrationquestion.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registration questions: %w", err)
+	}
+
+	result := make([]*domain.RegistrationQuestion, len(questions))
+	for i, q := range questions {
+		result[i] = r.mapQuestionToDomain(q)
+	}
+
+	return result, nil
+}
+
+// SaveAnswers stores registration answers
+func (r *registrationRepository) SaveAnswers(answers []*domain.RegistrationAnswer) error {
+	if len(answers) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+
+	builders := make([]*ent.RegistrationAnswerCreate, len(answers))
+	for i, a := range answers {
+		builders[i] = r.client.RegistrationAnswer.
+			Create().
+			SetID(a.ID).
+			SetPaymentID(a.PaymentID).
+			SetTicketIndex(a.TicketIndex).
+			SetQuestionID(a.QuestionID).
+			SetQuestionLabel(a.QuestionLabel).
+			SetValues(a.Values)
+	}
+
+	_, err := r.client.RegistrationAnswer.CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to save registration answers: %w", err)
+	}
+
+	return nil
+}
+
+// GetAnswersByPaymentIDs retrieves registration answers for the given payments
+func (r *registrationRepository) GetAnswersByPaymentIDs(paymentIDs []uuid.UUID) ([]*domain.RegistrationAnswer, error) {
+	if len(paymentIDs) == 0 {
+		return []*domain.RegistrationAnswer{}, nil
+	}
+
+	ctx := context.Background()
+
+	answers, err := r.client.RegistrationAnswer.
+		Query().
+		Where(registrationanswer.PaymentIDIn(paymentIDs...)).
+		Order(ent.Asc(registrationanswer.FieldTicketIndex)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get registration answers: %w", err)
+	}
+
+	result := make([]*domain.RegistrationAnswer, len(answers))
+	for i, a := range answers {
+		result[i] = &domain.RegistrationAnswer{
+			ID:            a.ID,
+			PaymentID:     a.PaymentID,
+			TicketIndex:   a.TicketIndex,
+			QuestionID:    a.QuestionID,
+			QuestionLabel: a.QuestionLabel,
+			Values:        a.Values,
+			CreatedAt:     a.CreatedAt,
+		}
+	}
+
+	return result, nil
+}
+
+func (r *registrationRepository) mapQuestionToDomain(q *ent.RegistrationQuestion) *domain.RegistrationQuestion {
+	return &domain.RegistrationQuestion{
+		ID:        q.ID,
+		EventID:   q.EventID,
+		Label:     q.Label,
+		Type:      string(q.Type),
+		Options:   q.Options,
+		Required:  q.Required,
+		Position:  q.Position,
+		CreatedAt: q.CreatedAt,
+		UpdatedAt: q.UpdatedAt,
+	}
+}
@@ -0,0 +1,183 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	atlasmysql "ariga.io/atlas/sql/mysql"
+	atlas "ariga.io/atlas/sql/schema"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/hook"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+)
+
+// NgramFullTextIndexes gives every FULLTEXT index the ngram parser during migration
+// MySQL's default parser splits words on spaces, which misses most Korean words inside longer phrases;
+// ent cannot declare index parsers itself, so the desired schema is adjusted before it is diffed
+func NgramFullTextIndexes() schema.MigrateOption {
+	return schema.WithDiffHook(func(next schema.Differ) schema.Differ {
+		return schema.DiffFunc(func(current, desired *atlas.Schema) ([]atlas.Change, error) {
+			for _, table := range desired.Tables {
+				for _, idx := range table.Indexes {
+					if isFullTextIndex(idx) {
+						idx.AddAttrs(&atlasmysql.IndexParser{P: atlasmysql.IndexParserNGram})
+					}
+				}
+			}
+			return next.Diff(current, desired)
+		})
+	})
+}
+
+func isFullTextIndex(idx *atlas.Index) bool {
+	for _, attr := range idx.Attrs {
+		if t, ok := attr.(*atlasmysql.IndexType); ok && t.T == atlasmysql.IndexTypeFullText {
+			return true
+		}
+	}
+	return false
+}
+
+// RegisterSearchHooks keeps the initial-consonant columns used by search suggestions in sync with the text they mirror
+func RegisterSearchHooks(client *ent.Client) {
+	client.Event.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.EventFunc(func(ctx context.Context, m *ent.EventMutation) (ent.Value, error) {
+			if title, ok := m.Title(); ok {
+				m.SetTitleInitials(util.KoreanInitials(title))
+			}
+			if venue, ok := m.Venue(); ok {
+				m.SetVenueInitials(util.KoreanInitials(venue))
+			} else if m.VenueCleared() {
+				m.SetVenueInitials("")
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+	client.Organization.Use(func(next ent.Mutator) ent.Mutator {
+		return hook.OrganizationFunc(func(ctx context.Context, m *ent.OrganizationMutation) (ent.Value, error) {
+			if name, ok := m.Name(); ok {
+				m.SetNameInitials(util.KoreanInitials(name))
+			}
+			return next.Mutate(ctx, m)
+		})
+	})
+}
+
+// BackfillSearchInitials fills the initial-consonant columns of rows created before they existed
+// Titles and names are never empty, so rows with empty initials are exactly the ones still missing them;
+// run it before the audit hooks are registered, since it only derives columns from existing text
+func BackfillSearchInitials(ctx context.Context, client *ent.Client) error {
+	events, err := client.Event.
+		Query().
+		Where(event.TitleInitials("")).
+		Select(event.FieldID, event.FieldTitle, event.FieldVenue).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get events without search initials: %w", err)
+	}
+	for _, evt := range events {
+		err := client.Event.
+			UpdateOneID(evt.ID).
+			SetTitleInitials(util.KoreanInitials(evt.Title)).
+			SetVenueInitials(util.KoreanInitials(evt.Venue)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to backfill search initials of event %s: %w", evt.ID, err)
+		}
+	}
+
+	orgs, err := client.Organization.
+		Query().
+		Where(organization.NameInitials("")).
+		Select(organization.FieldID, organization.FieldName).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get organizations without search initials: %w", err)
+	}
+	for _, org := range orgs {
+		err := client.Organization.
+			UpdateOneID(org.ID).
+			SetNameInitials(util.KoreanInitials(org.Name)).
+			Exec(ctx)
+		if err != nil {
+			return fmt.Errorf("failed to backfill search initials of organization %s: %w", org.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// matchWordPrefix matches rows where a word of field starts with prefix
+// If prefix contains standalone consonants it is reduced to initials and matched against initialsField instead
+func matchWordPrefix(field, initialsField, prefix string) func(*sql.Selector) {
+	if util.HasKoreanInitials(prefix) {
+		field, prefix = initialsField, util.KoreanInitials(prefix)
+	}
+	return func(s *sql.Selector) {
+		s.Where(sql.Or(
+			sql.HasPrefix(s.C(field), prefix),
+			sql.Contains(s.C(field), " "+prefix),
+		))
+	}
+}
+
+// ngramTokenSize is MySQL's default ngram_token_size; shorter keywords cannot match a full-text index
+const ngramTokenSize = 2
+
+// matchEventKeyword matches events whose title, description, location, venue or organization name contain keyword
+// With the ngram parser a keyword also matches text sharing only some of its two-character tokens,
+// so misspelled words still find results, ranked below exact matches
+func matchEventKeyword(keyword string) predicate.Event {
+	if utf8.RuneCountInString(keyword) < ngramTokenSize {
+		return event.Or(
+			event.TitleContains(keyword),
+			event.DescriptionContains(keyword),
+			event.LocationContains(keyword),
+			event.VenueContains(keyword),
+		)
+	}
+
+	return predicate.Event(func(s *sql.Selector) {
+		orgs := sql.Table(organization.Table)
+		s.Where(sql.Or(
+			sql.ExprP(fullTextMatch(s.C(event.FieldTitle), s.C(event.FieldDescription), s.C(event.FieldLocation), s.C(event.FieldVenue)), keyword),
+			sql.In(
+				s.C(event.FieldOrganizationID),
+				sql.Select(orgs.C(organization.FieldID)).
+					From(orgs).
+					Where(sql.ExprP(fullTextMatch(orgs.C(organization.FieldName)), keyword)),
+			),
+		))
+	})
+}
+
+// orderByRelevance sorts events by how well they match keyword, newest first among equals
+// Title matches count double; organization name matches count once
+func orderByRelevance(keyword string) event.OrderOption {
+	return func(s *sql.Selector) {
+		org := sql.Table(organization.Table).As("search_org")
+		score := fmt.Sprintf(
+			"%s * 2 + %s + COALESCE((SELECT %s FROM `%s` AS `search_org` WHERE %s = %s), 0) DESC",
+			fullTextMatch(s.C(event.FieldTitle)),
+			fullTextMatch(s.C(event.FieldTitle), s.C(event.FieldDescription), s.C(event.FieldLocation), s.C(event.FieldVenue)),
+			fullTextMatch(org.C(organization.FieldName)),
+			organization.Table,
+			org.C(organization.FieldID),
+			s.C(event.FieldOrganizationID),
+		)
+		s.OrderExpr(sql.ExprP(score, keyword, keyword, keyword))
+		s.OrderBy(sql.Desc(s.C(event.FieldCreatedAt)), sql.Desc(s.C(event.FieldID)))
+	}
+}
+
+// fullTextMatch returns a MATCH expression over columns that together form a full-text index
+func fullTextMatch(columns ...string) string {
+	return fmt.Sprintf("MATCH(%s) AGAINST (? IN NATURAL LANGUAGE MODE)", strings.Join(columns, ", "))
+}
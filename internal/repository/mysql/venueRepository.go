@@ -0,0 +1,253 @@
+package mysql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/google/uuid"
+)
+
+type venueRepository struct {
+	client *ent.Client
+}
+
+func NewVenueRepository(client *ent.Client) domain.VenueRepository {
+	return &venueRepository{
+		client: client,
+	}
+}
+
+// Create creates a new venue
+func (r *venueRepository) Create(ctx context.Context, v *domain.Venue) (*domain.Venue, error) {
+	layouts, err := json.Marshal(v.SeatingLayouts)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode seating layouts: %w", err)
+	}
+
+	address := addressOrEmpty(v.Address)
+	builder := r.client.Venue.
+		Create().
+		SetID(v.ID).
+		SetNillableOrganizationID(v.OrganizationID).
+		SetName(v.Name).
+		SetAddressStreet(address.Street).
+		SetAddressCity(address.City).
+		SetAddressRegion(address.Region).
+		SetAddressPostalCode(address.PostalCode).
+		SetAddressCountry(address.Country).
+		SetCapacity(v.Capacity).
+		SetSeatingLayouts(string(layouts)).
+		SetDirections(v.Directions).
+		SetParking(v.Parking).
+		SetAccessibility(v.Accessibility).
+		SetCreatedBy(v.CreatedBy)
+	if v.Coordinates != nil {
+		builder.SetLatitude(v.Coordinates.Latitude).SetLongitude(v.Coordinates.Longitude)
+	}
+
+	created, err := builder.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create venue: %w", err)
+	}
+
+	return mapVenueToDomain(created)
+}
+
+// GetByID retrieves a venue by ID
+func (r *venueRepository) GetByID(venueID uuid.UUID) (*domain.Venue, error) {
+	ctx := context.Background()
+
+	v, err := r.client.Venue.
+		Query().
+		Where(venue.ID(venueID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get venue: %w", err)
+	}
+
+	return mapVenueToDomain(v)
+}
+
+// GetAvailable retrieves the venues an organization can hold events at
+func (r *venueRepository) GetAvailable(orgID uuid.UUID) ([]*domain.Venue, error) {
+	ctx := context.Background()
+
+	venues, err := r.client.Venue.
+		Query().
+		Where(venue.Or(
+			venue.OrganizationID(orgID),
+			venue.OrganizationIDIsNil(),
+		)).
+		Order(ent.Asc(venue.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get venues: %w", err)
+	}
+
+	return mapVenuesToDomain(venues)
+}
+
+// GetPlatformWide retrieves the venues shared by every organization
+func (r *venueRepository) GetPlatformWide() ([]*domain.Venue, error) {
+	ctx := context.Background()
+
+	venues, err := r.client.Venue.
+		Query().
+		Where(venue.OrganizationIDIsNil()).
+		Order(ent.Asc(venue.FieldName)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get platform venues: %w", err)
+	}
+
+	return mapVenuesToDomain(venues)
+}
+
+// Update updates a venue; its owner cannot change
+func (r *venueRepository) Update(ctx context.Context, v *domain.Venue) error {
+	layouts, err := json.Marshal(v.SeatingLayouts)
+	if err != nil {
+		return fmt.Errorf("failed to encode seating layouts: %w", err)
+	}
+
+	address := addressOrEmpty(v.Address)
+	update := r.client.Venue.
+		UpdateOneID(v.ID).
+		SetName(v.Name).
+		SetAddressStreet(address.Street).
+		SetAddressCity(address.City).
+		SetAddressRegion(address.Region).
+		SetAddressPostalCode(address.PostalCode).
+		SetAddressCountry(address.Country).
+		SetCapacity(v.Capacity).
+		SetSeatingLayouts(string(layouts)).
+		SetDirections(v.Directions).
+		SetParking(v.Parking).
+		SetAccessibility(v.Accessibility)
+	if v.Coordinates != nil {
+		update.SetLatitude(v.Coordinates.Latitude).SetLongitude(v.Coordinates.Longitude)
+	} else {
+		update.ClearLatitude().ClearLongitude()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update venue: %w", err)
+	}
+
+	return nil
+}
+
+// Delete deletes a venue; the foreign keys of its events and series are cleared by the database
+func (r *venueRepository) Delete(ctx context.Context, venueID uuid.UUID) error {
+	err := r.client.Venue.
+		DeleteOneID(venueID).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete venue: %w", err)
+	}
+
+	return nil
+}
+
+// GetActiveBookings returns the events at a venue that are not completed or cancelled,
+// and the series at it with such occurrences
+func (r *venueRepository) GetActiveBookings(venueID uuid.UUID) ([]*domain.VenueBooking, error) {
+	ctx := context.Background()
+
+	active := event.StatusNotIn(event.StatusCompleted, event.StatusCancelled)
+	events, err := r.client.Event.
+		Query().
+		Where(
+			event.VenueID(venueID),
+			active,
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get events at venue: %w", err)
+	}
+
+	series, err := r.client.EventSeries.
+		Query().
+		Where(
+			eventseries.VenueID(venueID),
+			eventseries.HasOccurrencesWith(active),
+		).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get event series at venue: %w", err)
+	}
+
+	bookings := make([]*domain.VenueBooking, 0, len(events)+len(series))
+	for _, e := range events {
+		bookings = append(bookings, &domain.VenueBooking{
+			EventID:       &e.ID,
+			Title:         e.Title,
+			SeatingLayout: e.SeatingLayout,
+			TotalTickets:  e.TotalTickets,
+		})
+	}
+	for _, s := range series {
+		bookings = append(bookings, &domain.VenueBooking{
+			SeriesID:      &s.ID,
+			Title:         s.Title,
+			SeatingLayout: s.SeatingLayout,
+			TotalTickets:  s.TotalTickets,
+		})
+	}
+
+	return bookings, nil
+}
+
+func mapVenuesToDomain(venues []*ent.Venue) ([]*domain.Venue, error) {
+	result := make([]*domain.Venue, len(venues))
+	for i, v := range venues {
+		var err error
+		if result[i], err = mapVenueToDomain(v); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func mapVenueToDomain(v *ent.Venue) (*domain.Venue, error) {
+	layouts := []domain.SeatingLayout{}
+	if v.SeatingLayouts != "" {
+		if err := json.Unmarshal([]byte(v.SeatingLayouts), &layouts); err != nil {
+			return nil, fmt.Errorf("failed to decode seating layouts: %w", err)
+		}
+	}
+	if layouts == nil {
+		layouts = []domain.SeatingLayout{}
+	}
+
+	return &domain.Venue{
+		ID:             v.ID,
+		OrganizationID: v.OrganizationID,
+		Name:           v.Name,
+		Address:        mapAddress(v.AddressStreet, v.AddressCity, v.AddressRegion, v.AddressPostalCode, v.AddressCountry),
+		Coordinates:    mapGeoPoint(v.Latitude, v.Longitude),
+		Capacity:       v.Capacity,
+		SeatingLayouts: layouts,
+		Directions:     v.Directions,
+		Parking:        v.Parking,
+		Accessibility:  v.Accessibility,
+		CreatedBy:      v.CreatedBy,
+		CreatedAt:      v.CreatedAt,
+		UpdatedAt:      v.UpdatedAt,
+	}, nil
+}
@@ -0,0 +1,284 @@
+package mysql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookdelivery"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookendpoint"
+	"github.com/google/uuid"
+)
+
+type webhookRepository struct {
+	client *ent.Client
+}
+
+func NewWebhookRepository(client *ent.Client) domain.WebhookRepository {
+	return &webhookRepository{
+		client: client,
+	}
+}
+
+// CreateEndpoint creates a new webhook endpoint
+func (r *webhookRepository) CreateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) (*domain.WebhookEndpoint, error) {
+	created, err := r.client.WebhookEndpoint.
+		Create().
+		SetID(endpoint.ID).
+		SetOrganizationID(endpoint.OrganizationID).
+		SetURL(endpoint.URL).
+		SetSecret(endpoint.Secret).
+		SetEventTypes(endpoint.EventTypes).
+		SetIsActive(endpoint.IsActive).
+		SetCreatedBy(endpoint.CreatedBy).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook endpoint: %w", err)
+	}
+
+	return mapWebhookEndpointToDomain(created), nil
+}
+
+// GetEndpointByID retrieves a webhook endpoint by ID
+func (r *webhookRepository) GetEndpointByID(endpointID uuid.UUID) (*domain.WebhookEndpoint, error) {
+	ctx := context.Background()
+
+	endpoint, err := r.client.WebhookEndpoint.
+		Query().
+		Where(webhookendpoint.ID(endpointID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook endpoint: %w", err)
+	}
+
+	return mapWebhookEndpointToDomain(endpoint), nil
+}
+
+// GetEndpointsByOrganizationID retrieves every webhook endpoint of an organization
+func (r *webhookRepository) GetEndpointsByOrganizationID(orgID uuid.UUID) ([]*domain.WebhookEndpoint, error) {
+	ctx := context.Background()
+
+	endpoints, err := r.client.WebhookEndpoint.
+		Query().
+		Where(webhookendpoint.OrganizationID(orgID)).
+		Order(ent.Asc(webhookendpoint.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get webhook endpoints: %w", err)
+	}
+
+	result := make([]*domain.WebhookEndpoint, len(endpoints))
+	for i, endpoint := range endpoints {
+		result[i] = mapWebhookEndpointToDomain(endpoint)
+	}
+	return result, nil
+}
+
+// UpdateEndpoint updates a webhook endpoint's URL, secret, event types and state
+func (r *webhookRepository) UpdateEndpoint(ctx context.Context, endpoint *domain.WebhookEndpoint) error {
+	err := r.client.WebhookEndpoint.
+		UpdateOneID(endpoint.ID).
+		SetURL(endpoint.URL).
+		SetSecret(endpoint.Secret).
+		SetEventTypes(endpoint.EventTypes).
+		SetIsActive(endpoint.IsActive).
+		Exec(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update webhook endpoint: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteEndpoint deletes a webhook endpoint together with its delivery log
+func (r *webhookRepository) DeleteEndpoint(ctx context.Context, endpointID uuid.UUID) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	_, err = tx.WebhookDelivery.
+		Delete().
+		Where(webhookdelivery.EndpointID(endpointID)).
+		Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		return fmt.Errorf("failed to delete webhook deliveries: %w", err)
+	}
+
+	err = tx.WebhookEndpoint.DeleteOneID(endpointID).Exec(ctx)
+	if err != nil {
+		tx.Rollback()
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to delete webhook endpoint: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// CreateDelivery creates a new webhook delivery
+func (r *webhookRepository) CreateDelivery(delivery *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	ctx := context.Background()
+
+	created, err := r.client.WebhookDelivery.
+		Create().
+		SetID(delivery.ID).
+		SetEndpointID(delivery.EndpointID).
+		SetOrganizationID(delivery.OrganizationID).
+		SetEventID(delivery.EventID).
+		SetEventType(delivery.EventType).
+		SetPayload(delivery.Payload).
+		SetStatus(webhookdelivery.Status(delivery.Status)).
+		SetNillableNextAttemptAt(delivery.NextAttemptAt).
+		SetNillableReplayOf(delivery.ReplayOf).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create webhook delivery: %w", err)
+	}
+
+	return mapWebhookDeliveryToDomain(created), nil
+}
+
+// GetDeliveryByID retrieves a webhook delivery by ID
+func (r *webhookRepository) GetDeliveryByID(deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	ctx := context.Background()
+
+	delivery, err := r.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.ID(deliveryID)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, fmt.Errorf("failed to get webhook delivery: %w", err)
+	}
+
+	return mapWebhookDeliveryToDomain(delivery), nil
+}
+
+// GetDeliveriesByEndpointID retrieves an endpoint's deliveries, newest first, with the total count
+func (r *webhookRepository) GetDeliveriesByEndpointID(endpointID uuid.UUID, offset, limit int) ([]*domain.WebhookDelivery, int, error) {
+	ctx := context.Background()
+
+	query := r.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.EndpointID(endpointID))
+
+	total, err := query.Clone().Count(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count webhook deliveries: %w", err)
+	}
+
+	deliveries, err := query.
+		Order(ent.Desc(webhookdelivery.FieldCreatedAt), ent.Desc(webhookdelivery.FieldID)).
+		Offset(offset).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to get webhook deliveries: %w", err)
+	}
+
+	return mapWebhookDeliveriesToDomain(deliveries), total, nil
+}
+
+// GetPendingDeliveries retrieves every delivery still waiting for an attempt
+func (r *webhookRepository) GetPendingDeliveries() ([]*domain.WebhookDelivery, error) {
+	ctx := context.Background()
+
+	deliveries, err := r.client.WebhookDelivery.
+		Query().
+		Where(webhookdelivery.StatusEQ(webhookdelivery.StatusPending)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending webhook deliveries: %w", err)
+	}
+
+	return mapWebhookDeliveriesToDomain(deliveries), nil
+}
+
+// UpdateDelivery records the outcome of a delivery attempt
+func (r *webhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	ctx := context.Background()
+
+	update := r.client.WebhookDelivery.
+		UpdateOneID(delivery.ID).
+		SetStatus(webhookdelivery.Status(delivery.Status)).
+		SetAttempts(delivery.Attempts).
+		SetLastError(delivery.LastError).
+		SetNillableDeliveredAt(delivery.DeliveredAt)
+	if delivery.LastStatusCode != nil {
+		update.SetLastStatusCode(*delivery.LastStatusCode)
+	} else {
+		update.ClearLastStatusCode()
+	}
+	if delivery.NextAttemptAt != nil {
+		update.SetNextAttemptAt(*delivery.NextAttemptAt)
+	} else {
+		update.ClearNextAttemptAt()
+	}
+
+	if err := update.Exec(ctx); err != nil {
+		if ent.IsNotFound(err) {
+			return domain.ErrNotFound
+		}
+		return fmt.Errorf("failed to update webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+func mapWebhookEndpointToDomain(endpoint *ent.WebhookEndpoint) *domain.WebhookEndpoint {
+	return &domain.WebhookEndpoint{
+		ID:             endpoint.ID,
+		OrganizationID: endpoint.OrganizationID,
+		URL:            endpoint.URL,
+		Secret:         endpoint.Secret,
+		EventTypes:     endpoint.EventTypes,
+		IsActive:       endpoint.IsActive,
+		CreatedBy:      endpoint.CreatedBy,
+		CreatedAt:      endpoint.CreatedAt,
+		UpdatedAt:      endpoint.UpdatedAt,
+	}
+}
+
+func mapWebhookDeliveriesToDomain(deliveries []*ent.WebhookDelivery) []*domain.WebhookDelivery {
+	result := make([]*domain.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		result[i] = mapWebhookDeliveryToDomain(delivery)
+	}
+	return result
+}
+
+func mapWebhookDeliveryToDomain(delivery *ent.WebhookDelivery) *domain.WebhookDelivery {
+	return &domain.WebhookDelivery{
+		ID:             delivery.ID,
+		EndpointID:     delivery.EndpointID,
+		OrganizationID: delivery.OrganizationID,
+		EventID:        delivery.EventID,
+		EventType:      delivery.EventType,
+		Payload:        delivery.Payload,
+		Status:         string(delivery.Status),
+		Attempts:       delivery.Attempts,
+		LastStatusCode: delivery.LastStatusCode,
+		LastError:      delivery.LastError,
+		NextAttemptAt:  delivery.NextAttemptAt,
+		DeliveredAt:    delivery.DeliveredAt,
+		ReplayOf:       delivery.ReplayOf,
+		CreatedAt:      delivery.CreatedAt,
+		UpdatedAt:      delivery.UpdatedAt,
+	}
+}
@@ -0,0 +1,137 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	popularityKey = "events:popularity"
+	// Weight added to an event counts half as much after every half-life
+	popularityHalfLife = 48 * time.Hour
+
+	// Marks that a viewer has been counted, keyed by popularityViewedKeyPrefix, the event ID and the viewer
+	popularityViewedKeyPrefix = "events:popularity:viewed:"
+)
+
+// addPopularityScript adds weight to a score kept as the natural log of the event's weights,
+// each grown by how much later than the epoch it was added
+// Decaying every score at once would mean rewriting the whole set; growing new weight instead ranks events the same
+// without touching the others, and logs keep the growing scores within float range
+// KEYS[1] is the sorted set; ARGV is the event ID, the weight and the log growth of the time it was added
+var addPopularityScript = redis.NewScript(`
+local current = redis.call('ZSCORE', KEYS[1], ARGV[1])
+local weight = tonumber(ARGV[2])
+local added = math.log(math.abs(weight)) + tonumber(ARGV[3])
+
+if weight > 0 then
+	if current then
+		local score = tonumber(current)
+		local high, low = math.max(score, added), math.min(score, added)
+		added = high + math.log(1 + math.exp(low - high))
+	end
+	redis.call('ZADD', KEYS[1], added, ARGV[1])
+elseif current then
+	local score = tonumber(current)
+	if added >= score - 1e-9 then
+		redis.call('ZREM', KEYS[1], ARGV[1])
+	else
+		redis.call('ZADD', KEYS[1], score + math.log(1 - math.exp(added - score)), ARGV[1])
+	end
+end
+return 1
+`)
+
+type PopularityRanking struct {
+	client *redis.Client
+}
+
+func NewPopularityRanking(client *redis.Client) *PopularityRanking {
+	return &PopularityRanking{
+		client: client,
+	}
+}
+
+// Add adds weight to an event's score as of at
+// Weight is taken back by adding its negative with the time it was first added
+func (r *PopularityRanking) Add(eventID uuid.UUID, weight float64, at time.Time) error {
+	if weight == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	growth := float64(at.UnixNano()) / float64(popularityHalfLife) * math.Ln2
+	if err := addPopularityScript.Run(ctx, r.client, []string{popularityKey}, eventID.String(), weight, growth).Err(); err != nil {
+		return fmt.Errorf("failed to update event popularity: %w", err)
+	}
+
+	return nil
+}
+
+// Page returns ranked event IDs starting at offset, most popular first
+func (r *PopularityRanking) Page(offset, limit int) ([]uuid.UUID, error) {
+	ctx := context.Background()
+
+	members, err := r.client.ZRevRange(ctx, popularityKey, int64(offset), int64(offset+limit-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read popular events: %w", err)
+	}
+
+	ids := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		id, err := uuid.Parse(member)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read popular events: malformed event ID %q", member)
+		}
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
+// Remove drops events from the ranking
+func (r *PopularityRanking) Remove(eventIDs []uuid.UUID) error {
+	if len(eventIDs) == 0 {
+		return nil
+	}
+
+	ctx := context.Background()
+	members := make([]interface{}, len(eventIDs))
+	for i, id := range eventIDs {
+		members[i] = id.String()
+	}
+	if err := r.client.ZRem(ctx, popularityKey, members...).Err(); err != nil {
+		return fmt.Errorf("failed to remove events from popularity ranking: %w", err)
+	}
+
+	return nil
+}
+
+// Size returns the number of ranked events
+func (r *PopularityRanking) Size() (int64, error) {
+	ctx := context.Background()
+
+	size, err := r.client.ZCard(ctx, popularityKey).Result()
+	if err != nil {
+		return 0, fmt.Errorf("failed to count popular events: %w", err)
+	}
+
+	return size, nil
+}
+
+// FirstView reports whether viewer has not been counted as viewing the event within window, and counts them
+func (r *PopularityRanking) FirstView(eventID uuid.UUID, viewer string, window time.Duration) (bool, error) {
+	ctx := context.Background()
+
+	first, err := r.client.SetNX(ctx, popularityViewedKeyPrefix+eventID.String()+":"+viewer, 1, window).Result()
+	if err != nil {
+		return false, fmt.Errorf("failed to record event view: %w", err)
+	}
+
+	return first, nil
+}
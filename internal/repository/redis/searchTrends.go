@@ -0,0 +1,97 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// Searches are counted in one sorted set per hour, keyed by searchTrendsKeyPrefix and the hour
+	searchTrendsKeyPrefix = "search:trending:"
+	searchTrendsWindow    = 24
+	// Each hour a search counts this much less than one made an hour later
+	searchTrendsDecay = 0.8
+
+	// The decayed ranking is cached briefly, since it merges every hourly set
+	searchTrendsTopKey = "search:trending:top"
+	searchTrendsTopTTL = time.Minute
+)
+
+type SearchTrends struct {
+	client *redis.Client
+}
+
+func NewSearchTrends(client *redis.Client) *SearchTrends {
+	return &SearchTrends{
+		client: client,
+	}
+}
+
+// Record counts one search for keyword in the hour of at
+func (t *SearchTrends) Record(keyword string, at time.Time) error {
+	ctx := context.Background()
+	key := searchTrendsKey(at)
+
+	pipe := t.client.TxPipeline()
+	pipe.ZIncrBy(ctx, key, 1, keyword)
+	pipe.Expire(ctx, key, (searchTrendsWindow+1)*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to record search keyword: %w", err)
+	}
+
+	return nil
+}
+
+// Top returns the most searched keywords of the last day
+// Every hourly count is weighted by how long ago it was, so a keyword searched a lot right now
+// outranks one that was searched more often in the morning
+func (t *SearchTrends) Top(limit int, at time.Time) ([]*domain.TrendingKeyword, error) {
+	ctx := context.Background()
+
+	exists, err := t.client.Exists(ctx, searchTrendsTopKey).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trending keywords: %w", err)
+	}
+	if exists == 0 {
+		keys := make([]string, searchTrendsWindow)
+		weights := make([]float64, searchTrendsWindow)
+		for age := range searchTrendsWindow {
+			keys[age] = searchTrendsKey(at.Add(-time.Duration(age) * time.Hour))
+			weights[age] = math.Pow(searchTrendsDecay, float64(age))
+		}
+
+		pipe := t.client.TxPipeline()
+		pipe.ZUnionStore(ctx, searchTrendsTopKey, &redis.ZStore{
+			Keys:    keys,
+			Weights: weights,
+		})
+		pipe.Expire(ctx, searchTrendsTopKey, searchTrendsTopTTL)
+		if _, err := pipe.Exec(ctx); err != nil {
+			return nil, fmt.Errorf("failed to rank trending keywords: %w", err)
+		}
+	}
+
+	top, err := t.client.ZRevRangeWithScores(ctx, searchTrendsTopKey, 0, int64(limit-1)).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read trending keywords: %w", err)
+	}
+
+	result := make([]*domain.TrendingKeyword, len(top))
+	for i, z := range top {
+		result[i] = &domain.TrendingKeyword{
+			Keyword: z.Member.(string),
+			Score:   z.Score,
+		}
+	}
+
+	return result, nil
+}
+
+func searchTrendsKey(at time.Time) string {
+	return searchTrendsKeyPrefix + at.UTC().Format("2006010215")
+}
@@ -0,0 +1,72 @@
+package redis
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+)
+
+// webhookQueueKey is a sorted set of delivery IDs scored by when they are due
+const webhookQueueKey = "webhook:deliveries"
+
+type WebhookQueue struct {
+	client *redis.Client
+}
+
+func NewWebhookQueue(client *redis.Client) *WebhookQueue {
+	return &WebhookQueue{
+		client: client,
+	}
+}
+
+// Enqueue schedules a delivery attempt, replacing any earlier schedule for it
+func (q *WebhookQueue) Enqueue(deliveryID uuid.UUID, dueAt time.Time) error {
+	ctx := context.Background()
+
+	err := q.client.ZAdd(ctx, webhookQueueKey, redis.Z{
+		Score:  float64(dueAt.UnixMilli()),
+		Member: deliveryID.String(),
+	}).Err()
+	if err != nil {
+		return fmt.Errorf("failed to enqueue webhook delivery: %w", err)
+	}
+
+	return nil
+}
+
+// Claim removes and returns up to limit deliveries that are due
+// A delivery is only returned to the worker that removed it, so several workers can share the queue
+func (q *WebhookQueue) Claim(now time.Time, limit int) ([]uuid.UUID, error) {
+	ctx := context.Background()
+
+	members, err := q.client.ZRangeByScore(ctx, webhookQueueKey, &redis.ZRangeBy{
+		Min:   "-inf",
+		Max:   strconv.FormatInt(now.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read webhook queue: %w", err)
+	}
+
+	claimed := make([]uuid.UUID, 0, len(members))
+	for _, member := range members {
+		removed, err := q.client.ZRem(ctx, webhookQueueKey, member).Result()
+		if err != nil {
+			return claimed, fmt.Errorf("failed to claim webhook delivery: %w", err)
+		}
+		if removed == 0 {
+			continue
+		}
+		id, err := uuid.Parse(member)
+		if err != nil {
+			continue
+		}
+		claimed = append(claimed, id)
+	}
+
+	return claimed, nil
+}
@@ -0,0 +1,207 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// apiKeyLastUsedInterval limits how often last_used_at is written for a busy key
+const apiKeyLastUsedInterval = time.Minute
+
+type APIKeyUseCase interface {
+	// Organization side (api_keys:manage)
+	CreateAPIKey(ctx context.Context, orgID, requesterID uuid.UUID, req CreateAPIKeyRequest) (*CreatedAPIKey, error)
+	GetAPIKeys(orgID, requesterID uuid.UUID) ([]*domain.APIKey, error)
+	RevokeAPIKey(ctx context.Context, orgID, keyID, requesterID uuid.UUID) error
+
+	// Authentication
+	IsAPIKey(raw string) bool
+	Authenticate(raw string) (*domain.APIKey, error)
+}
+
+type CreateAPIKeyRequest struct {
+	Name      string     `json:"name"`
+	Scopes    []string   `json:"scopes"`
+	ExpiresAt *time.Time `json:"expires_at"`
+}
+
+// CreatedAPIKey is a new key with its full value, which is only ever returned here
+type CreatedAPIKey struct {
+	*domain.APIKey
+	Key string `json:"key"`
+}
+
+type apiKeyUseCase struct {
+	apiKeyRepo domain.APIKeyRepository
+	policy     AuthorizationPolicy
+}
+
+func NewAPIKeyUseCase(apiKeyRepo domain.APIKeyRepository, policy AuthorizationPolicy) APIKeyUseCase {
+	return &apiKeyUseCase{
+		apiKeyRepo: apiKeyRepo,
+		policy:     policy,
+	}
+}
+
+// CreateAPIKey creates an API key for an organization
+// Members can only create keys with scopes whose permissions they hold themselves
+func (uc *apiKeyUseCase) CreateAPIKey(ctx context.Context, orgID, requesterID uuid.UUID, req CreateAPIKeyRequest) (*CreatedAPIKey, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" {
+		return nil, errors.New("name is required")
+	}
+	if len(req.Scopes) == 0 {
+		return nil, errors.New("at least one scope is required")
+	}
+	scopes := make([]string, 0, len(req.Scopes))
+	seen := make(map[string]bool, len(req.Scopes))
+	for _, scope := range req.Scopes {
+		if _, ok := domain.APIKeyScopes[scope]; !ok {
+			return nil, fmt.Errorf("invalid scope: %s", scope)
+		}
+		if !seen[scope] {
+			seen[scope] = true
+			scopes = append(scopes, scope)
+		}
+	}
+	if req.ExpiresAt != nil && !req.ExpiresAt.After(time.Now()) {
+		return nil, errors.New("expires_at must be in the future")
+	}
+
+	if err := uc.policy.RequireGrantable(requesterID, orgID, domain.ScopePermissions(scopes)); err != nil {
+		return nil, err
+	}
+	if err := uc.policy.RequireActiveOrganization(orgID); err != nil {
+		return nil, err
+	}
+
+	prefix, secret, err := generateAPIKey()
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := uc.apiKeyRepo.Create(ctx, &domain.APIKey{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Name:           req.Name,
+		Prefix:         prefix,
+		SecretHash:     hashAPIKeySecret(secret),
+		Scopes:         scopes,
+		CreatedBy:      requesterID,
+		ExpiresAt:      req.ExpiresAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreatedAPIKey{
+		APIKey: key,
+		Key:    prefix + "_" + secret,
+	}, nil
+}
+
+// GetAPIKeys lists an organization's API keys, including revoked and expired ones
+func (uc *apiKeyUseCase) GetAPIKeys(orgID, requesterID uuid.UUID) ([]*domain.APIKey, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionAPIKeyManage); err != nil {
+		return nil, err
+	}
+
+	return uc.apiKeyRepo.GetByOrganizationID(orgID)
+}
+
+// RevokeAPIKey revokes an API key; requests made with it are rejected from then on
+func (uc *apiKeyUseCase) RevokeAPIKey(ctx context.Context, orgID, keyID, requesterID uuid.UUID) error {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionAPIKeyManage); err != nil {
+		return err
+	}
+
+	key, err := uc.apiKeyRepo.GetByID(keyID)
+	if err != nil {
+		return err
+	}
+	if key.OrganizationID != orgID {
+		return domain.ErrNotFound
+	}
+
+	return uc.apiKeyRepo.Revoke(ctx, keyID)
+}
+
+// IsAPIKey checks if a credential looks like an API key rather than a user token
+func (uc *apiKeyUseCase) IsAPIKey(raw string) bool {
+	return strings.HasPrefix(raw, domain.APIKeyPrefix+"_")
+}
+
+// Authenticate resolves the active API key matching a full key value and records its use
+// Keys of deactivated organizations return domain.ErrOrgInactive
+func (uc *apiKeyUseCase) Authenticate(raw string) (*domain.APIKey, error) {
+	// Keys look like tkly_<id>_<secret>; the secret may itself contain underscores
+	parts := strings.SplitN(raw, "_", 3)
+	if len(parts) != 3 || parts[0] != domain.APIKeyPrefix {
+		return nil, domain.ErrInvalidToken
+	}
+	prefix := parts[0] + "_" + parts[1]
+
+	key, err := uc.apiKeyRepo.GetByPrefix(prefix)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, domain.ErrInvalidToken
+		}
+		return nil, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hashAPIKeySecret(parts[2])), []byte(key.SecretHash)) != 1 {
+		return nil, domain.ErrInvalidToken
+	}
+	if !key.IsActive() {
+		return nil, domain.ErrInvalidToken
+	}
+	if err := uc.policy.RequireActiveOrganization(key.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if key.LastUsedAt == nil || now.Sub(*key.LastUsedAt) >= apiKeyLastUsedInterval {
+		if err := uc.apiKeyRepo.UpdateLastUsed(key.ID, now); err != nil {
+			fmt.Printf("Warning: failed to record api key use for %s: %v\n", key.ID, err)
+		} else {
+			key.LastUsedAt = &now
+		}
+	}
+
+	return key, nil
+}
+
+// generateAPIKey returns a random public prefix and secret
+func generateAPIKey() (string, string, error) {
+	id := make([]byte, 6)
+	if _, err := rand.Read(id); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", "", fmt.Errorf("failed to generate api key: %w", err)
+	}
+
+	return domain.APIKeyPrefix + "_" + hex.EncodeToString(id), base64.RawURLEncoding.EncodeToString(secret), nil
+}
+
+// hashAPIKeySecret hashes a key secret for storage
+// Secrets are random and long, so a fast hash is enough to keep them unrecoverable
+func hashAPIKeySecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,378 @@
+package usecase
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+	"github.com/google/uuid"
+)
+
+const attendeeExportBatchSize = 500
+
+type AttendeeUseCase interface {
+	// Export
+	ExportAttendees(eventID, requesterID uuid.UUID, opts ExportAttendeesOptions) (*AttendeeExport, error)
+
+	// Check-in
+	CheckIn(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Payment, error)
+	UndoCheckIn(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Payment, error)
+}
+
+type ExportAttendeesOptions struct {
+	Format    string   // csv, xlsx
+	Columns   []string // Defaults to every column
+	Statuses  []string // Defaults to completed
+	CheckedIn *bool
+}
+
+// AttendeeExport is a prepared export that writes its rows on demand
+type AttendeeExport struct {
+	Format      string
+	ContentType string
+	FileName    string
+
+	write func(w io.Writer) error
+}
+
+// Write streams the export to w
+func (e *AttendeeExport) Write(w io.Writer) error {
+	return e.write(w)
+}
+
+type attendeeColumn struct {
+	key   string
+	value func(p *domain.Payment) string
+}
+
+var attendeeColumns = []attendeeColumn{
+	{"payment_id", func(p *domain.Payment) string { return p.ID.String() }},
+	{"order_id", func(p *domain.Payment) string { return p.OrderID }},
+	{"buyer_name", func(p *domain.Payment) string { return p.BuyerName }},
+	{"buyer_email", func(p *domain.Payment) string { return p.BuyerEmail }},
+	{"buyer_phone", func(p *domain.Payment) string { return p.BuyerPhone }},
+	{"ticket_quantity", func(p *domain.Payment) string { return strconv.Itoa(p.TicketQuantity) }},
+	{"total_price", func(p *domain.Payment) string { return strconv.FormatFloat(p.TotalPrice, 'f', -1, 64) }},
+	{"currency", func(p *domain.Payment) string { return p.Currency }},
+	{"channel", func(p *domain.Payment) string { return p.Channel }},
+	{"status", func(p *domain.Payment) string { return p.Status }},
+	{"checked_in_at", func(p *domain.Payment) string {
+		if p.CheckedInAt == nil {
+			return ""
+		}
+		return p.CheckedInAt.Format(time.RFC3339)
+	}},
+	{"purchased_at", func(p *domain.Payment) string { return p.CreatedAt.Format(time.RFC3339) }},
+}
+
+// answersColumn expands to one column per registration question
+const answersColumn = "answers"
+
+type attendeeUseCase struct {
+	paymentRepo      domain.PaymentRepository
+	eventRepo        domain.EventRepository
+	policy           AuthorizationPolicy
+	registrationRepo domain.RegistrationRepository
+	webhooks         WebhookDispatcher
+}
+
+func NewAttendeeUseCase(paymentRepo domain.PaymentRepository, eventRepo domain.EventRepository, policy AuthorizationPolicy, registrationRepo domain.RegistrationRepository, webhooks WebhookDispatcher) AttendeeUseCase {
+	return &attendeeUseCase{
+		paymentRepo:      paymentRepo,
+		eventRepo:        eventRepo,
+		policy:           policy,
+		registrationRepo: registrationRepo,
+		webhooks:         webhooks,
+	}
+}
+
+// ExportAttendees validates the export options and prepares a streaming export (attendees:export)
+func (uc *attendeeUseCase) ExportAttendees(eventID, requesterID uuid.UUID, opts ExportAttendeesOptions) (*AttendeeExport, error) {
+	if _, err := uc.policy.RequireForEvent(requesterID, eventID, domain.PermissionAttendeeExport); err != nil {
+		return nil, err
+	}
+
+	// Resolve columns
+	keys := opts.Columns
+	if len(keys) == 0 {
+		for _, col := range attendeeColumns {
+			keys = append(keys, col.key)
+		}
+		keys = append(keys, answersColumn)
+	}
+
+	columns := make([]attendeeColumn, 0, len(keys))
+	includeAnswers := false
+	for _, key := range keys {
+		if key == answersColumn {
+			includeAnswers = true
+			continue
+		}
+		found := false
+		for _, col := range attendeeColumns {
+			if col.key == key {
+				columns = append(columns, col)
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, fmt.Errorf("unknown column: %s", key)
+		}
+	}
+
+	// Resolve filters
+	statuses := opts.Statuses
+	if len(statuses) == 0 {
+		statuses = []string{"completed"}
+	}
+	validStatuses := map[string]bool{
+		"pending": true, "completed": true, "failed": true, "cancelled": true, "refunded": true,
+	}
+	for _, s := range statuses {
+		if !validStatuses[s] {
+			return nil, fmt.Errorf("invalid payment status: %s", s)
+		}
+	}
+	filter := domain.PaymentFilter{
+		Statuses:  statuses,
+		CheckedIn: opts.CheckedIn,
+	}
+
+	var questions []*domain.RegistrationQuestion
+	if includeAnswers {
+		var err error
+		questions, err = uc.registrationRepo.GetQuestionsByEventID(eventID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	header := make([]string, 0, len(columns)+len(questions))
+	for _, col := range columns {
+		header = append(header, col.key)
+	}
+	for _, q := range questions {
+		header = append(header, q.Label)
+	}
+
+	export := &AttendeeExport{Format: opts.Format}
+	fileName := fmt.Sprintf("attendees-%s-%s", eventID.String()[:8], time.Now().Format("20060102"))
+
+	var newRowWriter func(w io.Writer) (writeRow func([]string) error, finish func() error, err error)
+	switch opts.Format {
+	case "csv":
+		export.ContentType = "text/csv; charset=utf-8"
+		export.FileName = fileName + ".csv"
+		newRowWriter = func(w io.Writer) (func([]string) error, func() error, error) {
+			// UTF-8 BOM so Excel detects the encoding of Korean text
+			if _, err := io.WriteString(w, "\uFEFF"); err != nil {
+				return nil, nil, err
+			}
+			cw := csv.NewWriter(w)
+			writeRow := func(row []string) error {
+				cells := make([]string, len(row))
+				for i, cell := range row {
+					cells[i] = neutralizeFormula(cell)
+				}
+				return cw.Write(cells)
+			}
+			finish := func() error {
+				cw.Flush()
+				return cw.Error()
+			}
+			return writeRow, finish, nil
+		}
+	case "xlsx":
+		export.ContentType = "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+		export.FileName = fileName + ".xlsx"
+		// Cells are written as inline strings, which spreadsheets never evaluate as formulas
+		newRowWriter = func(w io.Writer) (func([]string) error, func() error, error) {
+			xw, err := util.NewXLSXWriter(w, "Attendees")
+			if err != nil {
+				return nil, nil, err
+			}
+			return xw.WriteRow, xw.Close, nil
+		}
+	default:
+		return nil, errors.New("invalid format: must be 'csv' or 'xlsx'")
+	}
+
+	export.write = func(w io.Writer) error {
+		bw := bufio.NewWriter(w)
+
+		writeRow, finish, err := newRowWriter(bw)
+		if err != nil {
+			return err
+		}
+		if err := writeRow(header); err != nil {
+			return err
+		}
+
+		// Page through payments so large events are never loaded at once
+		for offset := 0; ; offset += attendeeExportBatchSize {
+			payments, err := uc.paymentRepo.GetByEventIDFiltered(eventID, filter, offset, attendeeExportBatchSize)
+			if err != nil {
+				return err
+			}
+
+			answersByPayment, err := uc.getAnswersByPayment(payments, len(questions) > 0)
+			if err != nil {
+				return err
+			}
+
+			for _, p := range payments {
+				row := make([]string, 0, len(header))
+				for _, col := range columns {
+					row = append(row, col.value(p))
+				}
+				for _, q := range questions {
+					row = append(row, formatAnswers(answersByPayment[p.ID], q.ID, p.TicketQuantity))
+				}
+				if err := writeRow(row); err != nil {
+					return err
+				}
+			}
+
+			if len(payments) < attendeeExportBatchSize {
+				break
+			}
+		}
+
+		if err := finish(); err != nil {
+			return err
+		}
+
+		return bw.Flush()
+	}
+
+	return export, nil
+}
+
+// numericCellPattern matches numbers and phone numbers such as -1500 or +82 (10) 1234-5678,
+// which spreadsheets read as values rather than formulas
+var numericCellPattern = regexp.MustCompile(`^[+-]?[\d\s().-]+$`)
+
+// neutralizeFormula prefixes a cell that a spreadsheet would read as a formula with a quote,
+// so buyer-entered text like "=HYPERLINK(...)" opens as plain text
+// Numbers and phone numbers are left as they are
+func neutralizeFormula(cell string) string {
+	if numericCellPattern.MatchString(cell) {
+		return cell
+	}
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
+
+func (uc *attendeeUseCase) getAnswersByPayment(payments []*domain.Payment, include bool) (map[uuid.UUID][]*domain.RegistrationAnswer, error) {
+	result := make(map[uuid.UUID][]*domain.RegistrationAnswer)
+	if !include || len(payments) == 0 {
+		return result, nil
+	}
+
+	paymentIDs := make([]uuid.UUID, len(payments))
+	for i, p := range payments {
+		paymentIDs[i] = p.ID
+	}
+
+	answers, err := uc.registrationRepo.GetAnswersByPaymentIDs(paymentIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range answers {
+		result[a.PaymentID] = append(result[a.PaymentID], a)
+	}
+
+	return result, nil
+}
+
+// formatAnswers renders a question's answers for every ticket of a payment in a single cell
+func formatAnswers(answers []*domain.RegistrationAnswer, questionID uuid.UUID, ticketQuantity int) string {
+	parts := make([]string, 0, ticketQuantity)
+	for _, a := range answers {
+		if a.QuestionID != questionID {
+			continue
+		}
+		value := strings.Join(a.Values, ", ")
+		if ticketQuantity > 1 {
+			value = fmt.Sprintf("#%d: %s", a.TicketIndex+1, value)
+		}
+		parts = append(parts, value)
+	}
+	return strings.Join(parts, " | ")
+}
+
+// CheckIn marks a completed payment's attendee as checked in (attendees:check_in)
+func (uc *attendeeUseCase) CheckIn(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Payment, error) {
+	payment, err := uc.getEventPayment(eventID, paymentID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.Status != "completed" {
+		return nil, fmt.Errorf("cannot check in payment with status: %s", payment.Status)
+	}
+	if payment.CheckedInAt != nil {
+		return nil, errors.New("attendee is already checked in")
+	}
+
+	now := time.Now()
+	if err := uc.paymentRepo.UpdateCheckIn(ctx, payment.ID, &now); err != nil {
+		return nil, err
+	}
+
+	updated, err := uc.paymentRepo.GetByID(payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.webhooks.DispatchPayment(domain.WebhookTicketCheckedIn, updated)
+
+	return updated, nil
+}
+
+// UndoCheckIn clears an attendee's check-in (attendees:check_in)
+func (uc *attendeeUseCase) UndoCheckIn(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Payment, error) {
+	payment, err := uc.getEventPayment(eventID, paymentID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.CheckedInAt == nil {
+		return nil, errors.New("attendee is not checked in")
+	}
+
+	if err := uc.paymentRepo.UpdateCheckIn(ctx, payment.ID, nil); err != nil {
+		return nil, err
+	}
+
+	return uc.paymentRepo.GetByID(payment.ID)
+}
+
+// getEventPayment loads a payment of the event after checking the check-in permission
+func (uc *attendeeUseCase) getEventPayment(eventID, paymentID, requesterID uuid.UUID) (*domain.Payment, error) {
+	if _, err := uc.policy.RequireForEvent(requesterID, eventID, domain.PermissionAttendeeCheckIn); err != nil {
+		return nil, err
+	}
+
+	payment, err := uc.paymentRepo.GetByID(paymentID)
+	if err != nil {
+		return nil, err
+	}
+	if payment.EventID != eventID {
+		return nil, domain.ErrNotFound
+	}
+
+	return payment, nil
+}
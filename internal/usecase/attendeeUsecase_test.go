@@ -0,0 +1,35 @@
+package usecase
+
+import "testing"
+
+func TestNeutralizeFormula(t *testing.T) {
+	cases := []struct {
+		cell string
+		want string
+	}{
+		{"=HYPERLINK(\"http://example.com\")", "'=HYPERLINK(\"http://example.com\")"},
+		{"+SUM(A1:A2)", "'+SUM(A1:A2)"},
+		{"-2+3*cmd|' /C calc'!A0", "'-2+3*cmd|' /C calc'!A0"},
+		{"@SUM(A1)", "'@SUM(A1)"},
+		{"\t=1+1", "'\t=1+1"},
+		// Arithmetic is still a formula even though it only uses digits
+		{"=1+1", "'=1+1"},
+		{"+1+1", "'+1+1"},
+
+		// Numbers and phone numbers are kept as they are
+		{"-1500", "-1500"},
+		{"+82 10-1234-5678", "+82 10-1234-5678"},
+		{"+1 (555) 123-4567", "+1 (555) 123-4567"},
+		{"010-1234-5678", "010-1234-5678"},
+		{"-3.5", "-3.5"},
+
+		{"홍길동", "홍길동"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		if got := neutralizeFormula(tc.cell); got != tc.want {
+			t.Errorf("neutralizeFormula(%q) = %q, want %q", tc.cell, got, tc.want)
+		}
+	}
+}
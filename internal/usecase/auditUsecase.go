@@ -0,0 +1,65 @@
+package usecase
+
+import (
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAuditPageSize = 50
+	maxAuditPageSize     = 100
+)
+
+type AuditUseCase interface {
+	GetAuditLogs(orgID, requesterID uuid.UUID, filter domain.AuditFilter, page, limit int) (*AuditLogPage, error)
+}
+
+// AuditLogPage is one page of an organization's audit log, newest first
+type AuditLogPage struct {
+	Entries []*domain.AuditEntry `json:"audit_logs"`
+	Total   int                  `json:"total"`
+	Page    int                  `json:"page"`
+	Limit   int                  `json:"limit"`
+}
+
+type auditUseCase struct {
+	auditRepo domain.AuditRepository
+	policy    AuthorizationPolicy
+}
+
+func NewAuditUseCase(auditRepo domain.AuditRepository, policy AuthorizationPolicy) AuditUseCase {
+	return &auditUseCase{
+		auditRepo: auditRepo,
+		policy:    policy,
+	}
+}
+
+// GetAuditLogs retrieves an organization's audit log (audit:view)
+func (uc *auditUseCase) GetAuditLogs(orgID, requesterID uuid.UUID, filter domain.AuditFilter, page, limit int) (*AuditLogPage, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionAuditView); err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultAuditPageSize
+	}
+	if limit > maxAuditPageSize {
+		limit = maxAuditPageSize
+	}
+
+	filter.OrganizationID = orgID
+	entries, total, err := uc.auditRepo.List(filter, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AuditLogPage{
+		Entries: entries,
+		Total:   total,
+		Page:    page,
+		Limit:   limit,
+	}, nil
+}
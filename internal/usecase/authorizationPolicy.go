@@ -0,0 +1,277 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+// PermissionError is returned when a user may not act on a resource
+type PermissionError struct {
+	Reason string
+}
+
+func (e *PermissionError) Error() string {
+	return "permission denied: " + e.Reason
+}
+
+func (e *PermissionError) Is(target error) bool {
+	return target == domain.ErrPermissionDenied
+}
+
+func permissionDenied(reason string) error {
+	return &PermissionError{Reason: reason}
+}
+
+// AuthorizationPolicy centralizes resource ownership and organization permission checks
+type AuthorizationPolicy interface {
+	// Organization permissions granted by the user's role
+	Can(userID, orgID uuid.UUID, permission domain.Permission) (bool, error)
+	Require(userID, orgID uuid.UUID, permission domain.Permission) error
+	Permissions(userID, orgID uuid.UUID) ([]domain.Permission, error)
+
+	// Role assignment, limited to permissions the granting user holds
+	RequireGrantable(userID, orgID uuid.UUID, permissions []domain.Permission) error
+	RequireAssignableRole(userID, orgID uuid.UUID, role string) error
+
+	// Organization state; deactivated organizations cannot sell tickets or create events
+	RequireActiveOrganization(orgID uuid.UUID) error
+
+	// Events, checked against the hosting organization
+	RequireForEvent(userID, eventID uuid.UUID, permission domain.Permission) (*domain.Event, error)
+	CanViewEvent(event *domain.Event, userID uuid.UUID) error
+
+	// Payments, allowed for the buyer or a member of the hosting organization with the permission
+	RequirePaymentAccess(payment *domain.Payment, userID uuid.UUID, permission domain.Permission) error
+}
+
+type authorizationPolicy struct {
+	orgRepo    domain.OrganizationRepository
+	eventRepo  domain.EventRepository
+	apiKeyRepo domain.APIKeyRepository
+}
+
+func NewAuthorizationPolicy(orgRepo domain.OrganizationRepository, eventRepo domain.EventRepository, apiKeyRepo domain.APIKeyRepository) AuthorizationPolicy {
+	return &authorizationPolicy{
+		orgRepo:    orgRepo,
+		eventRepo:  eventRepo,
+		apiKeyRepo: apiKeyRepo,
+	}
+}
+
+// Can checks if a user's role in an organization grants a permission
+func (p *authorizationPolicy) Can(userID, orgID uuid.UUID, permission domain.Permission) (bool, error) {
+	permissions, err := p.Permissions(userID, orgID)
+	if err != nil {
+		return false, err
+	}
+
+	for _, granted := range permissions {
+		if granted == permission {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// Require returns a permission error unless the user has the permission in the organization
+func (p *authorizationPolicy) Require(userID, orgID uuid.UUID, permission domain.Permission) error {
+	allowed, err := p.Can(userID, orgID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return permissionDenied(string(permission) + " permission required")
+	}
+
+	return nil
+}
+
+// Permissions resolves the permissions a user holds in an organization
+// The organization owner always holds every permission; non-members hold none
+// Requests made with an API key act as the key, which holds the permissions of its scopes
+// that its creator still holds
+func (p *authorizationPolicy) Permissions(userID, orgID uuid.UUID) ([]domain.Permission, error) {
+	org, err := p.orgRepo.GetByID(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err := p.memberPermissions(org, userID)
+	if errors.Is(err, domain.ErrNotFound) {
+		return p.apiKeyPermissions(org, userID)
+	}
+	return permissions, err
+}
+
+// memberPermissions resolves the permissions of the owner or a member, and returns ErrNotFound for anyone else
+func (p *authorizationPolicy) memberPermissions(org *domain.Organization, userID uuid.UUID) ([]domain.Permission, error) {
+	if org.OwnerID == userID {
+		return domain.AllPermissions, nil
+	}
+
+	member, err := p.orgRepo.GetMember(org.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if permissions, ok := domain.BuiltInRolePermissions(member.Role); ok {
+		return permissions, nil
+	}
+
+	role, err := p.orgRepo.GetRoleByName(org.ID, member.Role)
+	if err != nil {
+		// A member whose custom role was removed keeps no permissions
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return role.Permissions, nil
+}
+
+// apiKeyPermissions resolves the permissions of an active API key of the organization
+// A key never holds more than its creator does now, so demoting or removing the creator also limits their keys
+func (p *authorizationPolicy) apiKeyPermissions(org *domain.Organization, keyID uuid.UUID) ([]domain.Permission, error) {
+	key, err := p.apiKeyRepo.GetByID(keyID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if key.OrganizationID != org.ID || !key.IsActive() {
+		return nil, nil
+	}
+
+	held, err := p.memberPermissions(org, key.CreatedBy)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	heldSet := make(map[domain.Permission]bool, len(held))
+	for _, permission := range held {
+		heldSet[permission] = true
+	}
+
+	permissions := make([]domain.Permission, 0, len(key.Scopes))
+	for _, permission := range domain.ScopePermissions(key.Scopes) {
+		if heldSet[permission] {
+			permissions = append(permissions, permission)
+		}
+	}
+	return permissions, nil
+}
+
+// RequireGrantable ensures the user holds every permission being granted
+func (p *authorizationPolicy) RequireGrantable(userID, orgID uuid.UUID, permissions []domain.Permission) error {
+	held, err := p.Permissions(userID, orgID)
+	if err != nil {
+		return err
+	}
+
+	heldSet := make(map[domain.Permission]bool, len(held))
+	for _, permission := range held {
+		heldSet[permission] = true
+	}
+	for _, permission := range permissions {
+		if !heldSet[permission] {
+			return permissionDenied("cannot grant permission " + string(permission))
+		}
+	}
+
+	return nil
+}
+
+// RequireAssignableRole validates a role the user wants to assign to someone
+// The owner role cannot be assigned, and no one can grant permissions they do not hold themselves
+func (p *authorizationPolicy) RequireAssignableRole(userID, orgID uuid.UUID, role string) error {
+	if role == domain.RoleOwner {
+		return errors.New("invalid role: the owner role cannot be assigned")
+	}
+
+	permissions, ok := domain.BuiltInRoles[role]
+	if !ok {
+		custom, err := p.orgRepo.GetRoleByName(orgID, role)
+		if err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return fmt.Errorf("invalid role: %s", role)
+			}
+			return err
+		}
+		permissions = custom.Permissions
+	}
+
+	return p.RequireGrantable(userID, orgID, permissions)
+}
+
+// RequireActiveOrganization returns domain.ErrOrgInactive if the organization is deactivated
+func (p *authorizationPolicy) RequireActiveOrganization(orgID uuid.UUID) error {
+	org, err := p.orgRepo.GetByID(orgID)
+	if err != nil {
+		return err
+	}
+	if !org.IsActive {
+		return domain.ErrOrgInactive
+	}
+
+	return nil
+}
+
+// RequireForEvent loads an event and checks the user's permission in its organization
+func (p *authorizationPolicy) RequireForEvent(userID, eventID uuid.UUID, permission domain.Permission) (*domain.Event, error) {
+	event, err := p.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.Require(userID, event.OrganizationID, permission); err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
+
+// CanViewEvent allows anyone to view public, non-draft events of active organizations
+// and members with events:view to view the rest
+func (p *authorizationPolicy) CanViewEvent(event *domain.Event, userID uuid.UUID) error {
+	if event.IsPublic && event.Status != "draft" {
+		err := p.RequireActiveOrganization(event.OrganizationID)
+		if err == nil {
+			return nil
+		}
+		if !errors.Is(err, domain.ErrOrgInactive) {
+			return err
+		}
+	}
+
+	return p.Require(userID, event.OrganizationID, domain.PermissionEventView)
+}
+
+// RequirePaymentAccess allows the buyer or a member of the event's organization with the permission
+func (p *authorizationPolicy) RequirePaymentAccess(payment *domain.Payment, userID uuid.UUID, permission domain.Permission) error {
+	if payment.UserID != nil && *payment.UserID == userID {
+		return nil
+	}
+
+	event, err := p.eventRepo.GetByID(payment.EventID)
+	if err != nil {
+		return err
+	}
+
+	allowed, err := p.Can(userID, event.OrganizationID, permission)
+	if err != nil {
+		return err
+	}
+	if !allowed {
+		return permissionDenied("you can only access your own payments")
+	}
+
+	return nil
+}
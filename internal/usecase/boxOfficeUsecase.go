@@ -0,0 +1,202 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type BoxOfficeUseCase interface {
+	RecordSale(ctx context.Context, eventID, operatorID uuid.UUID, req RecordSaleRequest) (*domain.Payment, error)
+	GetCashUpReport(eventID, requesterID uuid.UUID, from, to time.Time) (*domain.CashUpReport, error)
+}
+
+type RecordSaleRequest struct {
+	TicketQuantity int     `json:"ticket_quantity"`
+	TotalPrice     float64 `json:"total_price"`
+	PaymentMethod  string  `json:"payment_method"` // cash, card_terminal (also accepted as card-terminal), transfer
+	ReceiptNumber  string  `json:"receipt_number"`
+	BuyerName      string  `json:"buyer_name"`
+	BuyerEmail     string  `json:"buyer_email"`
+	BuyerPhone     string  `json:"buyer_phone"`
+}
+
+type boxOfficeUseCase struct {
+	paymentRepo domain.PaymentRepository
+	eventRepo   domain.EventRepository
+	policy      AuthorizationPolicy
+	webhooks    WebhookDispatcher
+	popularity  PopularityUseCase
+}
+
+func NewBoxOfficeUseCase(paymentRepo domain.PaymentRepository, eventRepo domain.EventRepository, policy AuthorizationPolicy, webhooks WebhookDispatcher, popularity PopularityUseCase) BoxOfficeUseCase {
+	return &boxOfficeUseCase{
+		paymentRepo: paymentRepo,
+		eventRepo:   eventRepo,
+		policy:      policy,
+		webhooks:    webhooks,
+		popularity:  popularity,
+	}
+}
+
+// RecordSale records an on-site sale made at the door (box_office:sell)
+func (uc *boxOfficeUseCase) RecordSale(ctx context.Context, eventID, operatorID uuid.UUID, req RecordSaleRequest) (*domain.Payment, error) {
+	// Validate required fields
+	if req.TicketQuantity <= 0 {
+		return nil, errors.New("ticket quantity must be positive")
+	}
+	if req.TotalPrice < 0 {
+		return nil, errors.New("total price must be non-negative")
+	}
+
+	// Terminals and staff apps send the method spelled either way; it is stored as card_terminal
+	if req.PaymentMethod == "card-terminal" {
+		req.PaymentMethod = "card_terminal"
+	}
+	validMethods := map[string]bool{
+		"cash": true, "card_terminal": true, "transfer": true,
+	}
+	if !validMethods[req.PaymentMethod] {
+		return nil, errors.New("invalid payment method: must be 'cash', 'card_terminal' (or 'card-terminal') or 'transfer'")
+	}
+
+	// Check if operator may sell tickets for the hosting organization
+	event, err := uc.policy.RequireForEvent(operatorID, eventID, domain.PermissionBoxOfficeSell)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.RequireActiveOrganization(event.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	if event.Status == "cancelled" || event.Status == "completed" {
+		return nil, fmt.Errorf("cannot sell tickets for event with status: %s", event.Status)
+	}
+
+	if event.AvailableTickets < req.TicketQuantity {
+		return nil, errors.New("not enough tickets available")
+	}
+
+	// Generate order and receipt numbers
+	orderID := fmt.Sprintf("BOX-%s", uuid.New().String()[:8])
+	receiptNumber := req.ReceiptNumber
+	if receiptNumber == "" {
+		receiptNumber = orderID
+	}
+
+	buyerName := req.BuyerName
+	if buyerName == "" {
+		buyerName = "현장 구매"
+	}
+
+	payment := &domain.Payment{
+		ID:             uuid.New(),
+		EventID:        eventID,
+		EventTitle:     event.Title,
+		TicketQuantity: req.TicketQuantity,
+		TotalPrice:     req.TotalPrice,
+		Currency:       event.Currency,
+		BuyerName:      buyerName,
+		BuyerEmail:     req.BuyerEmail,
+		BuyerPhone:     req.BuyerPhone,
+		OrderID:        orderID,
+		Status:         "completed",
+		Channel:        "box_office",
+		PaymentMethod:  req.PaymentMethod,
+		OperatorID:     &operatorID,
+		ReceiptNumber:  receiptNumber,
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	// Reserve tickets before recording the sale
+	err = uc.eventRepo.UpdateAvailableTickets(eventID, -req.TicketQuantity)
+	if err != nil {
+		return nil, fmt.Errorf("failed to reserve tickets: %w", err)
+	}
+
+	created, err := uc.paymentRepo.Create(ctx, payment)
+	if err != nil {
+		// Rollback ticket reservation if the sale could not be recorded
+		_ = uc.eventRepo.UpdateAvailableTickets(eventID, req.TicketQuantity)
+		return nil, err
+	}
+
+	// Update participant count
+	participantCount, err := uc.paymentRepo.GetParticipantCountByEventID(eventID)
+	if err != nil {
+		// Log error but don't fail the sale
+		fmt.Printf("Warning: failed to update participant count: %v\n", err)
+	} else {
+		err = uc.eventRepo.UpdateParticipantCount(eventID, participantCount)
+		if err != nil {
+			// Log error but don't fail the sale
+			fmt.Printf("Warning: failed to update participant count in DB: %v\n", err)
+		}
+	}
+
+	uc.popularity.RecordTicketSale(eventID, req.TicketQuantity)
+	uc.webhooks.DispatchPayment(domain.WebhookOrderCompleted, created)
+
+	return created, nil
+}
+
+// GetCashUpReport summarizes box-office sales per operator within [from, to) (box_office:report)
+func (uc *boxOfficeUseCase) GetCashUpReport(eventID, requesterID uuid.UUID, from, to time.Time) (*domain.CashUpReport, error) {
+	if !from.Before(to) {
+		return nil, errors.New("report start must be before report end")
+	}
+
+	event, err := uc.policy.RequireForEvent(requesterID, eventID, domain.PermissionBoxOfficeReport)
+	if err != nil {
+		return nil, err
+	}
+
+	sales, err := uc.paymentRepo.GetBoxOfficeSalesByEventID(eventID, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get box-office sales: %w", err)
+	}
+
+	report := &domain.CashUpReport{
+		EventID:        eventID,
+		From:           from,
+		To:             to,
+		Currency:       event.Currency,
+		Operators:      make([]*domain.OperatorCashUp, 0),
+		AmountByMethod: make(map[string]float64),
+	}
+
+	byOperator := make(map[uuid.UUID]*domain.OperatorCashUp)
+	for _, sale := range sales {
+		if sale.OperatorID == nil {
+			continue
+		}
+
+		summary, ok := byOperator[*sale.OperatorID]
+		if !ok {
+			summary = &domain.OperatorCashUp{
+				OperatorID:     *sale.OperatorID,
+				AmountByMethod: make(map[string]float64),
+			}
+			byOperator[*sale.OperatorID] = summary
+			report.Operators = append(report.Operators, summary)
+		}
+
+		summary.SaleCount++
+		summary.TicketQuantity += sale.TicketQuantity
+		summary.TotalAmount += sale.TotalPrice
+		summary.AmountByMethod[sale.PaymentMethod] += sale.TotalPrice
+
+		report.SaleCount++
+		report.TicketQuantity += sale.TicketQuantity
+		report.TotalAmount += sale.TotalPrice
+		report.AmountByMethod[sale.PaymentMethod] += sale.TotalPrice
+	}
+
+	return report, nil
+}
@@ -0,0 +1,240 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+const cancellationReasonMaxLength = 200
+
+type CancellationUseCase interface {
+	CancelEvent(ctx context.Context, eventID, userID uuid.UUID, reason string) (*domain.EventCancellation, error)
+	GetCancellation(eventID, userID uuid.UUID) (*domain.EventCancellation, error)
+	GetCancellationRefunds(eventID, userID uuid.UUID, status string) ([]*domain.CancellationRefund, error)
+	RetryFailedRefunds(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventCancellation, error)
+	ConfirmManualRefund(ctx context.Context, eventID, refundID, userID uuid.UUID) (*domain.CancellationRefund, error)
+}
+
+type cancellationUseCase struct {
+	cancellationRepo domain.EventCancellationRepository
+	eventRepo        domain.EventRepository
+	paymentRepo      domain.PaymentRepository
+	policy           AuthorizationPolicy
+	webhooks         WebhookDispatcher
+}
+
+func NewCancellationUseCase(cancellationRepo domain.EventCancellationRepository, eventRepo domain.EventRepository, paymentRepo domain.PaymentRepository, policy AuthorizationPolicy, webhooks WebhookDispatcher) CancellationUseCase {
+	return &cancellationUseCase{
+		cancellationRepo: cancellationRepo,
+		eventRepo:        eventRepo,
+		paymentRepo:      paymentRepo,
+		policy:           policy,
+		webhooks:         webhooks,
+	}
+}
+
+// CancelEvent cancels an event and starts refunding its orders in the background (events:update and payments:refund)
+// Events cancelled before cancellations existed can be cancelled again to refund their orders
+func (uc *cancellationUseCase) CancelEvent(ctx context.Context, eventID, userID uuid.UUID, reason string) (*domain.EventCancellation, error) {
+	event, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.policy.Require(userID, event.OrganizationID, domain.PermissionPaymentRefund); err != nil {
+		return nil, err
+	}
+
+	reason = strings.TrimSpace(reason)
+	if len([]rune(reason)) > cancellationReasonMaxLength {
+		return nil, errors.New("reason must be at most 200 characters")
+	}
+
+	if event.Status == domain.EventCancelled {
+		if _, err := uc.cancellationRepo.GetByEventID(eventID); err == nil {
+			return nil, errors.New("event is already cancelled")
+		} else if !errors.Is(err, domain.ErrNotFound) {
+			return nil, err
+		}
+	} else if !domain.CanTransitionEvent(event.Status, domain.EventCancelled) {
+		return nil, errors.New("completed events cannot be cancelled")
+	}
+
+	// Stop sales before the orders are collected so no new order slips past the refunds
+	if event.Status != domain.EventCancelled {
+		if err := uc.eventRepo.UpdateStatus(ctx, eventID, event.Status, domain.EventCancelled); err != nil {
+			return nil, err
+		}
+	}
+
+	payments, err := uc.paymentRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	refunds := make([]*domain.CancellationRefund, 0, len(payments))
+	for _, p := range payments {
+		if p.Status != "pending" && p.Status != "completed" {
+			continue
+		}
+		refunds = append(refunds, &domain.CancellationRefund{
+			PaymentID: p.ID,
+			Method:    refundMethod(p),
+			Status:    domain.RefundPending,
+		})
+	}
+
+	cancellation, err := uc.cancellationRepo.Create(ctx, &domain.EventCancellation{
+		ID:             uuid.New(),
+		EventID:        eventID,
+		OrganizationID: event.OrganizationID,
+		RequestedBy:    userID,
+		Reason:         reason,
+		Status:         domain.CancellationRunning,
+	}, refunds)
+	if err != nil {
+		if errors.Is(err, domain.ErrAlreadyExists) {
+			return nil, errors.New("event is already cancelled")
+		}
+		return nil, err
+	}
+
+	return uc.withProgress(cancellation)
+}
+
+// GetCancellation retrieves an event's cancellation and its refund progress (payments:view)
+func (uc *cancellationUseCase) GetCancellation(eventID, userID uuid.UUID) (*domain.EventCancellation, error) {
+	if _, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionPaymentView); err != nil {
+		return nil, err
+	}
+
+	cancellation, err := uc.cancellationRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.withProgress(cancellation)
+}
+
+// GetCancellationRefunds lists the refunds of an event's cancellation, optionally by status (payments:view)
+func (uc *cancellationUseCase) GetCancellationRefunds(eventID, userID uuid.UUID, status string) ([]*domain.CancellationRefund, error) {
+	if _, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionPaymentView); err != nil {
+		return nil, err
+	}
+
+	validStatuses := map[string]bool{
+		"": true, domain.RefundPending: true, domain.RefundAwaitingConfirmation: true,
+		domain.RefundSucceeded: true, domain.RefundFailed: true,
+	}
+	if !validStatuses[status] {
+		return nil, errors.New("invalid refund status")
+	}
+
+	cancellation, err := uc.cancellationRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.cancellationRepo.GetRefunds(cancellation.ID, status)
+}
+
+// RetryFailedRefunds queues the refunds that used every attempt for another round (payments:refund)
+func (uc *cancellationUseCase) RetryFailedRefunds(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventCancellation, error) {
+	if _, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionPaymentRefund); err != nil {
+		return nil, err
+	}
+
+	cancellation, err := uc.cancellationRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	retried, err := uc.cancellationRepo.RetryFailed(ctx, cancellation.ID)
+	if err != nil {
+		return nil, err
+	}
+	if retried == 0 {
+		return nil, errors.New("no failed refunds to retry")
+	}
+
+	cancellation, err = uc.cancellationRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	return uc.withProgress(cancellation)
+}
+
+// ConfirmManualRefund records that the organizer paid back a box-office order of a cancelled event (payments:refund)
+func (uc *cancellationUseCase) ConfirmManualRefund(ctx context.Context, eventID, refundID, userID uuid.UUID) (*domain.CancellationRefund, error) {
+	if _, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionPaymentRefund); err != nil {
+		return nil, err
+	}
+
+	cancellation, err := uc.cancellationRepo.GetByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := uc.cancellationRepo.GetRefundByID(refundID)
+	if err != nil {
+		return nil, err
+	}
+	if refund.CancellationID != cancellation.ID {
+		return nil, domain.ErrNotFound
+	}
+	if refund.Status != domain.RefundAwaitingConfirmation {
+		return nil, fmt.Errorf("%w: only refunds awaiting confirmation can be confirmed", domain.ErrConflict)
+	}
+
+	payment, err := uc.paymentRepo.GetByID(refund.PaymentID)
+	if err != nil {
+		return nil, err
+	}
+	// The order may have been settled by hand in the meantime
+	if payment.Status == "completed" {
+		if err := uc.paymentRepo.UpdateStatus(ctx, payment.ID, "refunded", payment.PaymentKey); err != nil {
+			return nil, fmt.Errorf("failed to refund payment: %w", err)
+		}
+		payment.Status = "refunded"
+		uc.webhooks.DispatchPayment(domain.WebhookOrderRefunded, payment)
+
+		if participantCount, err := uc.paymentRepo.GetParticipantCountByEventID(eventID); err == nil {
+			_ = uc.eventRepo.UpdateParticipantCount(eventID, participantCount)
+		}
+	}
+
+	now := time.Now()
+	refund.Status = domain.RefundSucceeded
+	refund.RefundedAt = &now
+	if err := uc.cancellationRepo.UpdateRefund(ctx, refund); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+func (uc *cancellationUseCase) withProgress(cancellation *domain.EventCancellation) (*domain.EventCancellation, error) {
+	progress, err := uc.cancellationRepo.GetProgress(cancellation.ID)
+	if err != nil {
+		return nil, err
+	}
+	cancellation.Progress = progress
+	return cancellation, nil
+}
+
+// refundMethod decides how a payment of a cancelled event is settled
+func refundMethod(p *domain.Payment) string {
+	switch {
+	case p.Status != "completed" || p.TotalPrice == 0:
+		return domain.RefundNone
+	case p.Channel == "online":
+		return domain.RefundGateway
+	default:
+		return domain.RefundManual
+	}
+}
@@ -0,0 +1,272 @@
+package usecase
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+)
+
+const (
+	cancellationPollInterval = 10 * time.Second
+
+	// Failed refunds are retried after 1, 2, 4, ... minutes, up to refundMaxAttempts in total
+	refundMaxAttempts   = 5
+	refundRetryBaseWait = time.Minute
+	refundRetryMaxWait  = time.Hour
+
+	refundErrorExcerptLength = 1000
+)
+
+// CancellationWorker settles the orders of cancelled events, notifying buyers and the organizer
+// Progress lives in the database, so a restarted worker resumes where it stopped
+type CancellationWorker struct {
+	cancellationRepo domain.EventCancellationRepository
+	paymentRepo      domain.PaymentRepository
+	eventRepo        domain.EventRepository
+	userRepo         domain.UserRepository
+	gateway          util.PaymentGateway
+	mailer           util.Mailer
+	webhooks         WebhookDispatcher
+}
+
+func NewCancellationWorker(cancellationRepo domain.EventCancellationRepository, paymentRepo domain.PaymentRepository, eventRepo domain.EventRepository, userRepo domain.UserRepository, gateway util.PaymentGateway, mailer util.Mailer, webhooks WebhookDispatcher) *CancellationWorker {
+	return &CancellationWorker{
+		cancellationRepo: cancellationRepo,
+		paymentRepo:      paymentRepo,
+		eventRepo:        eventRepo,
+		userRepo:         userRepo,
+		gateway:          gateway,
+		mailer:           mailer,
+		webhooks:         webhooks,
+	}
+}
+
+// Run processes running cancellations until ctx is cancelled
+func (w *CancellationWorker) Run(ctx context.Context) {
+	ticker := time.NewTicker(cancellationPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			cancellations, err := w.cancellationRepo.GetRunning()
+			if err != nil {
+				log.Printf("failed to get running event cancellations: %v", err)
+				continue
+			}
+			for _, cancellation := range cancellations {
+				if ctx.Err() != nil {
+					return
+				}
+				w.process(ctx, cancellation)
+			}
+		}
+	}
+}
+
+// process attempts every due refund of a cancellation and completes it once none are pending
+func (w *CancellationWorker) process(ctx context.Context, cancellation *domain.EventCancellation) {
+	refunds, err := w.cancellationRepo.GetDueRefunds(cancellation.ID, time.Now())
+	if err != nil {
+		log.Printf("failed to get refunds of cancellation %s: %v", cancellation.ID, err)
+		return
+	}
+
+	event, err := w.eventRepo.GetByID(cancellation.EventID)
+	if err != nil {
+		log.Printf("failed to get event of cancellation %s: %v", cancellation.ID, err)
+		return
+	}
+
+	for _, refund := range refunds {
+		if ctx.Err() != nil {
+			return
+		}
+		w.settle(cancellation, event, refund)
+	}
+
+	progress, err := w.cancellationRepo.GetProgress(cancellation.ID)
+	if err != nil {
+		log.Printf("failed to get progress of cancellation %s: %v", cancellation.ID, err)
+		return
+	}
+	if progress.Pending > 0 {
+		return
+	}
+
+	if err := w.cancellationRepo.Complete(context.Background(), cancellation.ID, time.Now()); err != nil {
+		log.Printf("failed to complete cancellation %s: %v", cancellation.ID, err)
+		return
+	}
+
+	if participantCount, err := w.paymentRepo.GetParticipantCountByEventID(event.ID); err == nil {
+		if err := w.eventRepo.UpdateParticipantCount(event.ID, participantCount); err != nil {
+			log.Printf("failed to update participant count of event %s: %v", event.ID, err)
+		}
+	}
+
+	w.notifyOrganizer(cancellation, event, progress)
+}
+
+// settle makes one attempt at a refund and records the outcome
+func (w *CancellationWorker) settle(cancellation *domain.EventCancellation, event *domain.Event, refund *domain.CancellationRefund) {
+	ctx := context.Background()
+
+	payment, err := w.paymentRepo.GetByID(refund.PaymentID)
+	if err != nil {
+		log.Printf("failed to get payment %s: %v", refund.PaymentID, err)
+		return
+	}
+
+	// A payment already settled was handled before the worker stopped, or by hand
+	alreadySettled := payment.Status != "pending" && payment.Status != "completed"
+
+	// The method is decided from the payment as it is now, since a pending order may have completed since the job started
+	if !alreadySettled {
+		refund.Method = refundMethod(payment)
+	}
+
+	if !alreadySettled && refund.Method == domain.RefundGateway {
+		reason := cancellation.Reason
+		if reason == "" {
+			reason = "행사 취소"
+		}
+		if err := w.gateway.CancelPayment(payment.PaymentKey, reason, refund.ID.String()); err != nil {
+			refund.Attempts++
+			refund.LastError = truncateText(err.Error(), refundErrorExcerptLength)
+			refund.NextAttemptAt = nil
+			if refund.Attempts >= refundMaxAttempts {
+				refund.Status = domain.RefundFailed
+			} else {
+				next := time.Now().Add(refundRetryWait(refund.Attempts))
+				refund.NextAttemptAt = &next
+			}
+			if err := w.cancellationRepo.UpdateRefund(ctx, refund); err != nil {
+				log.Printf("failed to record refund %s: %v", refund.ID, err)
+			}
+			return
+		}
+	}
+
+	// Box-office money is paid back in person, so the organizer confirms each refund once it is done
+	if !alreadySettled && refund.Method == domain.RefundManual {
+		refund.Attempts++
+		refund.Status = domain.RefundAwaitingConfirmation
+		refund.LastError = ""
+		refund.NextAttemptAt = nil
+		if err := w.cancellationRepo.UpdateRefund(ctx, refund); err != nil {
+			log.Printf("failed to record refund %s: %v", refund.ID, err)
+			return
+		}
+		w.notifyBuyer(cancellation, event, payment, refund.Method)
+		return
+	}
+
+	if !alreadySettled {
+		status := "cancelled"
+		if payment.Status == "completed" && payment.TotalPrice > 0 {
+			status = "refunded"
+		}
+		if err := w.paymentRepo.UpdateStatus(ctx, payment.ID, status, payment.PaymentKey); err != nil {
+			// The gateway refund is idempotent, so the next attempt can safely repeat it
+			log.Printf("failed to settle payment %s: %v", payment.ID, err)
+			return
+		}
+
+		wasCompleted := payment.Status == "completed"
+		payment.Status = status
+		w.webhooks.DispatchPayment(paymentWebhookEvents[status], payment)
+		if wasCompleted {
+			w.notifyBuyer(cancellation, event, payment, refund.Method)
+		}
+	}
+
+	now := time.Now()
+	refund.Attempts++
+	refund.Status = domain.RefundSucceeded
+	refund.LastError = ""
+	refund.NextAttemptAt = nil
+	refund.RefundedAt = &now
+	if err := w.cancellationRepo.UpdateRefund(ctx, refund); err != nil {
+		log.Printf("failed to record refund %s: %v", refund.ID, err)
+	}
+}
+
+// notifyBuyer tells a ticket holder that the event was cancelled and how their order is refunded
+func (w *CancellationWorker) notifyBuyer(cancellation *domain.EventCancellation, event *domain.Event, payment *domain.Payment, method string) {
+	if payment.BuyerEmail == "" {
+		return
+	}
+
+	var refundNote string
+	switch method {
+	case domain.RefundGateway:
+		refundNote = fmt.Sprintf("결제하신 %.0f %s는 결제 수단으로 전액 환불됩니다. 카드사에 따라 환불 반영까지 며칠이 걸릴 수 있습니다.", payment.TotalPrice, payment.Currency)
+	case domain.RefundManual:
+		refundNote = fmt.Sprintf("현장에서 결제하신 %.0f %s의 환불 방법은 주최자가 별도로 안내해 드립니다.", payment.TotalPrice, payment.Currency)
+	default:
+		refundNote = "예약이 취소되었습니다."
+	}
+
+	reason := ""
+	if cancellation.Reason != "" {
+		reason = fmt.Sprintf("\n취소 사유: %s\n", cancellation.Reason)
+	}
+
+	subject := fmt.Sprintf("[Ticketly] %s 행사가 취소되었습니다", event.Title)
+	body := fmt.Sprintf(
+		"%s님, 예매하신 %s 행사(%s)가 주최자 사정으로 취소되었습니다.\n%s\n%s\n\n주문 번호: %s",
+		payment.BuyerName,
+		event.Title,
+		event.StartTime.Format("2006-01-02 15:04"),
+		reason,
+		refundNote,
+		payment.OrderID,
+	)
+
+	if err := w.mailer.Send(payment.BuyerEmail, subject, body); err != nil {
+		log.Printf("failed to notify buyer of payment %s: %v", payment.ID, err)
+	}
+}
+
+// notifyOrganizer sends the member who cancelled the event a summary once every refund was attempted
+func (w *CancellationWorker) notifyOrganizer(cancellation *domain.EventCancellation, event *domain.Event, progress *domain.CancellationProgress) {
+	user, err := w.userRepo.GetUserByID(cancellation.RequestedBy)
+	if err != nil {
+		log.Printf("failed to get organizer of cancellation %s: %v", cancellation.ID, err)
+		return
+	}
+
+	subject := fmt.Sprintf("[Ticketly] %s 행사 취소 처리가 완료되었습니다", event.Title)
+	body := fmt.Sprintf(
+		"%s 행사의 주문 %d건 중 %d건의 환불 및 취소가 완료되었습니다.",
+		event.Title,
+		progress.Total,
+		progress.Succeeded,
+	)
+	if progress.AwaitingConfirmation > 0 {
+		body += fmt.Sprintf("\n\n현장 결제 %d건은 직접 환불해 주셔야 합니다. 환불한 뒤 이벤트의 취소 내역에서 각 건의 환불 완료를 확인해 주세요.", progress.AwaitingConfirmation)
+	}
+	if progress.Failed > 0 {
+		body += fmt.Sprintf("\n\n%d건은 환불에 실패했습니다. 이벤트의 취소 내역에서 실패한 환불을 확인하고 다시 시도해 주세요.", progress.Failed)
+	}
+
+	if err := w.mailer.Send(user.Email, subject, body); err != nil {
+		log.Printf("failed to notify organizer of cancellation %s: %v", cancellation.ID, err)
+	}
+}
+
+// refundRetryWait returns how long to wait before retrying a refund that failed attempts times
+func refundRetryWait(attempts int) time.Duration {
+	wait := refundRetryBaseWait << (attempts - 1)
+	if wait > refundRetryMaxWait || wait <= 0 {
+		return refundRetryMaxWait
+	}
+	return wait
+}
@@ -0,0 +1,64 @@
+package usecase
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+)
+
+// Short enough that scheduled events go live within seconds of their publish time
+const eventSchedulerInterval = 10 * time.Second
+
+// EventScheduler publishes scheduled drafts and moves published events to ongoing and completed
+// as their publish, start and end times pass
+type EventScheduler struct {
+	eventRepo  domain.EventRepository
+	popularity PopularityUseCase
+}
+
+func NewEventScheduler(eventRepo domain.EventRepository, popularity PopularityUseCase) *EventScheduler {
+	return &EventScheduler{
+		eventRepo:  eventRepo,
+		popularity: popularity,
+	}
+}
+
+// Run advances event lifecycles until ctx is cancelled
+// Events that became due while the scheduler was down are caught up on the first pass
+func (s *EventScheduler) Run(ctx context.Context) {
+	s.advance(ctx)
+
+	ticker := time.NewTicker(eventSchedulerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.advance(ctx)
+		}
+	}
+}
+
+// advance publishes due drafts, then completes ended events before starting others,
+// so an event whose whole time window was missed goes straight to completed
+func (s *EventScheduler) advance(ctx context.Context) {
+	now := time.Now()
+
+	published, err := s.eventRepo.PublishDueEvents(ctx, now)
+	if err != nil {
+		log.Printf("failed to publish scheduled events: %v", err)
+	}
+	for _, eventID := range published {
+		s.popularity.RecordPublish(eventID)
+	}
+	if _, err := s.eventRepo.CompleteDueEvents(ctx, now); err != nil {
+		log.Printf("failed to complete events: %v", err)
+	}
+	if _, err := s.eventRepo.StartDueEvents(ctx, now); err != nil {
+		log.Printf("failed to start events: %v", err)
+	}
+}
@@ -0,0 +1,100 @@
+package usecase
+
+import (
+	"html"
+	"strings"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+)
+
+// Long descriptions are cut to a snippet of about this many characters around the first match
+const highlightSnippetLength = 120
+
+// highlightEvent returns the searchable fields of an event that contain a search term,
+// HTML-escaped with every match wrapped in <em>; it returns nil if no field matches exactly,
+// which happens when the search index matched a misspelled term
+func highlightEvent(event *domain.EventWithOrganization, terms []string) map[string]string {
+	fields := []struct {
+		name    string
+		text    string
+		snippet bool
+	}{
+		{"title", event.Title, false},
+		{"description", event.Description, true},
+		{"location", event.Location, false},
+		{"venue", event.Venue, false},
+		{"organization_name", event.OrganizationName, false},
+	}
+
+	var highlights map[string]string
+	for _, field := range fields {
+		highlighted, ok := highlightText(field.text, terms, field.snippet)
+		if !ok {
+			continue
+		}
+		if highlights == nil {
+			highlights = make(map[string]string)
+		}
+		highlights[field.name] = highlighted
+	}
+	return highlights
+}
+
+// highlightText wraps every case-insensitive occurrence of the terms in text with <em>
+func highlightText(text string, terms []string, snippet bool) (string, bool) {
+	runes := []rune(text)
+	folded := []rune(strings.ToLower(text))
+	if len(folded) != len(runes) {
+		// Lowercasing changed the length, so positions would not line up; match case-sensitively
+		folded = runes
+	}
+
+	marked := make([]bool, len(runes))
+	first := -1
+	for _, term := range terms {
+		needle := []rune(strings.ToLower(term))
+		if len(needle) == 0 {
+			continue
+		}
+		for i := 0; i+len(needle) <= len(folded); i++ {
+			if string(folded[i:i+len(needle)]) != string(needle) {
+				continue
+			}
+			for j := i; j < i+len(needle); j++ {
+				marked[j] = true
+			}
+			if first == -1 || i < first {
+				first = i
+			}
+			i += len(needle) - 1
+		}
+	}
+	if first == -1 {
+		return "", false
+	}
+
+	start, end := 0, len(runes)
+	if snippet && len(runes) > highlightSnippetLength {
+		start = max(0, first-highlightSnippetLength/4)
+		end = min(len(runes), start+highlightSnippetLength)
+	}
+
+	var b strings.Builder
+	if start > 0 {
+		b.WriteString("…")
+	}
+	for i := start; i < end; i++ {
+		if marked[i] && (i == start || !marked[i-1]) {
+			b.WriteString("<em>")
+		}
+		b.WriteString(html.EscapeString(string(runes[i])))
+		if marked[i] && (i == end-1 || !marked[i+1]) {
+			b.WriteString("</em>")
+		}
+	}
+	if end < len(runes) {
+		b.WriteString("…")
+	}
+
+	return b.String(), true
+}
@@ -1,26 +1,46 @@
 package usecase
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
 	"github.com/google/uuid"
 )
 
 type EventUseCase interface {
 	// Event management
-	CreateEvent(orgID, userID uuid.UUID, req CreateEventRequest) (*domain.Event, error)
+	CreateEvent(ctx context.Context, orgID, userID uuid.UUID, req CreateEventRequest) (*domain.Event, error)
 	GetEvent(eventID uuid.UUID) (*domain.Event, error)
-	GetOrganizationEvents(orgID uuid.UUID) ([]*domain.Event, error)
-	UpdateEvent(eventID, userID uuid.UUID, req UpdateEventRequest) error
-	DeleteEvent(eventID, userID uuid.UUID) error
-
-	// Public queries
-	GetPublicEvents() ([]*domain.EventWithOrganization, error)
-	GetUpcomingEvents() ([]*domain.EventWithOrganization, error)
-	GetPopularEvents() ([]*domain.EventWithOrganization, error)
-	SearchEvents(keyword string) ([]*domain.EventWithOrganization, error)
+	GetEventForUser(eventID, userID uuid.UUID) (*domain.Event, error)
+	// GetPublicEvent shows an event to an anonymous visitor and counts the view; viewer identifies the visitor
+	GetPublicEvent(eventID uuid.UUID, viewer string) (*domain.Event, error)
+	PreviewEvent(eventID, userID uuid.UUID) (*domain.Event, error)
+	GetOrganizationEvents(orgID, userID uuid.UUID) ([]*domain.Event, error)
+	UpdateEvent(ctx context.Context, eventID, userID uuid.UUID, req UpdateEventRequest) error
+	DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error
+
+	// Approval workflow
+	SubmitEventForReview(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error)
+	ApproveEvent(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error)
+	RequestEventChanges(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error)
+	RejectEvent(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error)
+	GetReviewQueue(orgID, userID uuid.UUID) ([]*domain.Event, error)
+
+	// Change history
+	GetEventHistory(eventID, userID uuid.UUID) ([]*domain.EventChange, error)
+
+	// Public queries, all filtered, sorted and paginated by the same query
+	GetPublicEvents(query domain.EventListQuery) (*EventPage, error)
+	GetUpcomingEvents(query domain.EventListQuery) (*EventPage, error)
+	GetPopularEvents(query domain.EventListQuery) (*EventPage, error)
+	SearchEvents(keyword string, query domain.EventListQuery) (*EventPage, error)
+	GetNearbyEvents(query domain.EventListQuery) (*EventPage, error)
 
 	// Ticket management
 	ReserveTickets(eventID uuid.UUID, quantity int) error
@@ -28,55 +48,104 @@ type EventUseCase interface {
 }
 
 type CreateEventRequest struct {
-	Title            string    `json:"title"`
-	Description      string    `json:"description"`
-	Location         string    `json:"location"`
-	Venue            string    `json:"venue"`
-	StartTime        time.Time `json:"start_time"`
-	EndTime          time.Time `json:"end_time"`
-	TotalTickets     int       `json:"total_tickets"`
-	TicketPrice      float64   `json:"ticket_price"`
-	Currency         string    `json:"currency"`
-	ThumbnailURL     string    `json:"thumbnail_url"`
-	IsPublic         bool      `json:"is_public"`
+	Title            string           `json:"title"`
+	Description      string           `json:"description"`
+	Location         string           `json:"location"`
+	Venue            string           `json:"venue"`
+	VenueID          *uuid.UUID       `json:"venue_id"`       // Saved venue; overrides venue, address and coordinates
+	SeatingLayout    string           `json:"seating_layout"` // Layout of the saved venue; empty for its full capacity
+	Address          *domain.Address  `json:"address"`
+	Coordinates      *domain.GeoPoint `json:"coordinates"` // Needed to show up in nearby searches
+	Category         string           `json:"category"`
+	StartTime        time.Time        `json:"start_time"`
+	EndTime          time.Time        `json:"end_time"`
+	TotalTickets     int              `json:"total_tickets"`
+	TicketPrice      float64          `json:"ticket_price"`
+	Currency         string           `json:"currency"`
+	ThumbnailURL     string           `json:"thumbnail_url"`
+	IsPublic         bool             `json:"is_public"`
+	RequiresApproval bool             `json:"requires_approval"`
+	PublishAt        *time.Time       `json:"publish_at"` // Optional scheduled publish time
 }
 
 type UpdateEventRequest struct {
-	Title            string    `json:"title"`
-	Description      string    `json:"description"`
-	Location         string    `json:"location"`
-	Venue            string    `json:"venue"`
-	StartTime        time.Time `json:"start_time"`
-	EndTime          time.Time `json:"end_time"`
-	TotalTickets     int       `json:"total_tickets"`
-	TicketPrice      float64   `json:"ticket_price"`
-	Currency         string    `json:"currency"`
-	ThumbnailURL     string    `json:"thumbnail_url"`
-	Status           string    `json:"status"`
-	IsPublic         bool      `json:"is_public"`
+	Title            string           `json:"title"`
+	Description      string           `json:"description"`
+	Location         string           `json:"location"`
+	Venue            string           `json:"venue"`
+	VenueID          *uuid.UUID       `json:"venue_id"` // Omit to detach the event from its saved venue
+	SeatingLayout    string           `json:"seating_layout"`
+	Address          *domain.Address  `json:"address"`     // Omit to clear
+	Coordinates      *domain.GeoPoint `json:"coordinates"` // Omit to clear
+	Category         string           `json:"category"`
+	StartTime        time.Time        `json:"start_time"`
+	EndTime          time.Time        `json:"end_time"`
+	TotalTickets     int              `json:"total_tickets"`
+	TicketPrice      float64          `json:"ticket_price"`
+	Currency         string           `json:"currency"`
+	ThumbnailURL     string           `json:"thumbnail_url"`
+	Status           string           `json:"status"`
+	IsPublic         bool             `json:"is_public"`
+	RequiresApproval bool             `json:"requires_approval"`
+	PublishAt        *time.Time       `json:"publish_at"` // Scheduled publish time of a draft; omit to unschedule
+}
+
+// EventPage is one page of a public event listing
+type EventPage struct {
+	Events     []*domain.EventWithOrganization `json:"events"`
+	NextCursor string                          `json:"next_cursor,omitempty"` // Pass as ?cursor= for the next page; empty on the last page
 }
 
+const (
+	defaultEventPageSize = 20
+	maxEventPageSize     = 100
+	maxCategoryLength    = 50
+
+	maxStreetLength     = 255
+	maxCityLength       = 100 // Also the limit for regions
+	maxPostalCodeLength = 20
+
+	maxSearchKeywordLength = 100
+
+	defaultNearbyRadiusKm = 10
+	maxNearbyRadiusKm     = 100
+)
+
+// Ticket holders may get a full refund for this long after the event date moves, but not past the new start
+const dateChangeRefundWindow = 7 * 24 * time.Hour
+
 type eventUseCase struct {
-	eventRepo domain.EventRepository
-	orgRepo   domain.OrganizationRepository
+	eventRepo   domain.EventRepository
+	changeRepo  domain.EventChangeRepository
+	paymentRepo domain.PaymentRepository
+	venueRepo   domain.VenueRepository
+	policy      AuthorizationPolicy
+	mailer      util.Mailer
+	trends      domain.SearchTrends
+	popularity  PopularityUseCase
 }
 
-func NewEventUseCase(eventRepo domain.EventRepository, orgRepo domain.OrganizationRepository) EventUseCase {
+func NewEventUseCase(eventRepo domain.EventRepository, changeRepo domain.EventChangeRepository, paymentRepo domain.PaymentRepository, venueRepo domain.VenueRepository, policy AuthorizationPolicy, mailer util.Mailer, trends domain.SearchTrends, popularity PopularityUseCase) EventUseCase {
 	return &eventUseCase{
-		eventRepo: eventRepo,
-		orgRepo:   orgRepo,
+		eventRepo:   eventRepo,
+		changeRepo:  changeRepo,
+		paymentRepo: paymentRepo,
+		venueRepo:   venueRepo,
+		policy:      policy,
+		mailer:      mailer,
+		trends:      trends,
+		popularity:  popularity,
 	}
 }
 
-// CreateEvent creates a new event (admin only)
-func (uc *eventUseCase) CreateEvent(orgID, userID uuid.UUID, req CreateEventRequest) (*domain.Event, error) {
-	// Check if user is admin of the organization
-	isAdmin, err := uc.orgRepo.IsUserAdmin(orgID, userID)
-	if err != nil {
+// CreateEvent creates a new event (events:create)
+func (uc *eventUseCase) CreateEvent(ctx context.Context, orgID, userID uuid.UUID, req CreateEventRequest) (*domain.Event, error) {
+	// Check if user may create events for the organization
+	if err := uc.policy.Require(userID, orgID, domain.PermissionEventCreate); err != nil {
 		return nil, err
 	}
-	if !isAdmin {
-		return nil, errors.New("permission denied: only admins can create events")
+	if err := uc.policy.RequireActiveOrganization(orgID); err != nil {
+		return nil, err
 	}
 
 	// Validate required fields
@@ -98,6 +167,25 @@ func (uc *eventUseCase) CreateEvent(orgID, userID uuid.UUID, req CreateEventRequ
 	if req.TicketPrice < 0 {
 		return nil, errors.New("ticket price must be non-negative")
 	}
+	if err := validatePublishAt(req.PublishAt, req.StartTime, time.Now()); err != nil {
+		return nil, err
+	}
+	category, err := normalizeCategory(req.Category)
+	if err != nil {
+		return nil, err
+	}
+	address, err := normalizeAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCoordinates(req.Coordinates); err != nil {
+		return nil, err
+	}
+	layout := strings.TrimSpace(req.SeatingLayout)
+	venue, err := venueForEvent(uc.venueRepo, orgID, req.VenueID, layout, req.TotalTickets)
+	if err != nil {
+		return nil, err
+	}
 
 	// Set default currency
 	if req.Currency == "" {
@@ -111,6 +199,9 @@ func (uc *eventUseCase) CreateEvent(orgID, userID uuid.UUID, req CreateEventRequ
 		Description:      req.Description,
 		Location:         req.Location,
 		Venue:            req.Venue,
+		Address:          address,
+		Coordinates:      req.Coordinates,
+		Category:         category,
 		StartTime:        req.StartTime,
 		EndTime:          req.EndTime,
 		TotalTickets:     req.TotalTickets,
@@ -118,14 +209,33 @@ func (uc *eventUseCase) CreateEvent(orgID, userID uuid.UUID, req CreateEventRequ
 		TicketPrice:      req.TicketPrice,
 		Currency:         req.Currency,
 		ThumbnailURL:     req.ThumbnailURL,
-		Status:           "draft",
+		Status:           domain.EventDraft,
 		IsPublic:         req.IsPublic,
+		RequiresApproval: req.RequiresApproval,
+		Review:           &domain.EventReview{Status: domain.ReviewNotSubmitted},
+		PublishAt:        req.PublishAt,
 		CreatedBy:        userID,
 		CreatedAt:        time.Now(),
 		UpdatedAt:        time.Now(),
 	}
+	holdEventAt(event, venue, layout)
+
+	return uc.eventRepo.Create(ctx, event)
+}
+
+// holdEventAt links an event to a saved venue and copies its details, or unlinks it for a nil venue
+func holdEventAt(event *domain.Event, venue *domain.Venue, layout string) {
+	if venue == nil {
+		event.VenueID = nil
+		event.SeatingLayout = ""
+		return
+	}
 
-	return uc.eventRepo.Create(event)
+	event.VenueID = &venue.ID
+	event.SeatingLayout = layout
+	event.Venue = venue.Name
+	event.Address = venue.Address
+	event.Coordinates = venue.Coordinates
 }
 
 // GetEvent retrieves an event by ID
@@ -133,26 +243,84 @@ func (uc *eventUseCase) GetEvent(eventID uuid.UUID) (*domain.Event, error) {
 	return uc.eventRepo.GetByID(eventID)
 }
 
-// GetOrganizationEvents retrieves all events for an organization
-func (uc *eventUseCase) GetOrganizationEvents(orgID uuid.UUID) ([]*domain.Event, error) {
-	return uc.eventRepo.GetByOrganizationID(orgID)
+// GetEventForUser retrieves an event if the user is allowed to view it
+func (uc *eventUseCase) GetEventForUser(eventID, userID uuid.UUID) (*domain.Event, error) {
+	event, err := uc.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.CanViewEvent(event, userID); err != nil {
+		return nil, err
+	}
+
+	// Review details are internal to the organization
+	canViewAll, err := uc.policy.Can(userID, event.OrganizationID, domain.PermissionEventView)
+	if err != nil {
+		return nil, err
+	}
+	if !canViewAll {
+		event.Review = nil
+	}
+
+	return event, nil
 }
 
-// UpdateEvent updates an event (admin only)
-func (uc *eventUseCase) UpdateEvent(eventID, userID uuid.UUID, req UpdateEventRequest) error {
-	// Get event
-	event, err := uc.eventRepo.GetByID(eventID)
+// GetPublicEvent retrieves an event as anonymous visitors see it: public, non-draft events of active organizations
+func (uc *eventUseCase) GetPublicEvent(eventID uuid.UUID, viewer string) (*domain.Event, error) {
+	event, err := uc.GetEventForUser(eventID, uuid.Nil)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// Check if user is admin of the organization
-	isAdmin, err := uc.orgRepo.IsUserAdmin(event.OrganizationID, userID)
+	uc.popularity.RecordView(event, viewer)
+	return event, nil
+}
+
+// PreviewEvent shows organization members an event as the public will see it, including unpublished drafts (events:view)
+func (uc *eventUseCase) PreviewEvent(eventID, userID uuid.UUID) (*domain.Event, error) {
+	event, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventView)
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	event.Review = nil
+	return event, nil
+}
+
+// GetOrganizationEvents retrieves an organization's events
+// Members with events:view see every event, everyone else only public published ones
+func (uc *eventUseCase) GetOrganizationEvents(orgID, userID uuid.UUID) ([]*domain.Event, error) {
+	events, err := uc.eventRepo.GetByOrganizationID(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	canViewAll, err := uc.policy.Can(userID, orgID, domain.PermissionEventView)
+	if err != nil {
+		return nil, err
+	}
+	if canViewAll {
+		return events, nil
+	}
+
+	visible := make([]*domain.Event, 0, len(events))
+	for _, event := range events {
+		if event.IsPublic && event.Status != "draft" {
+			event.Review = nil
+			visible = append(visible, event)
+		}
 	}
-	if !isAdmin {
-		return errors.New("permission denied: only admins can update events")
+
+	return visible, nil
+}
+
+// UpdateEvent updates an event (events:update)
+func (uc *eventUseCase) UpdateEvent(ctx context.Context, eventID, userID uuid.UUID, req UpdateEventRequest) error {
+	// Get event and check if user may update it
+	event, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventUpdate)
+	if err != nil {
+		return err
 	}
 
 	// Validate updates
@@ -165,14 +333,39 @@ func (uc *eventUseCase) UpdateEvent(eventID, userID uuid.UUID, req UpdateEventRe
 	if req.TicketPrice < 0 {
 		return errors.New("ticket price must be non-negative")
 	}
+	category, err := normalizeCategory(req.Category)
+	if err != nil {
+		return err
+	}
+	address, err := normalizeAddress(req.Address)
+	if err != nil {
+		return err
+	}
+	if err := validateCoordinates(req.Coordinates); err != nil {
+		return err
+	}
+
+	if domain.IsFinalEventStatus(event.Status) {
+		return fmt.Errorf("%s events cannot be edited", event.Status)
+	}
 
 	// Validate status
 	validStatuses := map[string]bool{
-		"draft": true, "published": true, "ongoing": true, "completed": true, "cancelled": true,
+		domain.EventDraft: true, domain.EventPublished: true, domain.EventOngoing: true,
+		domain.EventCompleted: true, domain.EventCancelled: true,
 	}
 	if req.Status != "" && !validStatuses[req.Status] {
 		return errors.New("invalid status")
 	}
+	fromStatus := event.Status
+	if req.Status == domain.EventCancelled {
+		return errors.New("events must be cancelled through the cancel operation so their orders are refunded")
+	}
+	if req.Status != "" && req.Status != fromStatus && !domain.CanTransitionEvent(fromStatus, req.Status) {
+		return fmt.Errorf("event cannot move from %s to %s", fromStatus, req.Status)
+	}
+
+	previous := *event
 
 	// Update fields
 	if req.Title != "" {
@@ -181,6 +374,9 @@ func (uc *eventUseCase) UpdateEvent(eventID, userID uuid.UUID, req UpdateEventRe
 	event.Description = req.Description
 	event.Location = req.Location
 	event.Venue = req.Venue
+	event.Address = address
+	event.Coordinates = req.Coordinates
+	event.Category = category
 	if !req.StartTime.IsZero() {
 		event.StartTime = req.StartTime
 	}
@@ -188,15 +384,23 @@ func (uc *eventUseCase) UpdateEvent(eventID, userID uuid.UUID, req UpdateEventRe
 		event.EndTime = req.EndTime
 	}
 	if req.TotalTickets > 0 {
-		// Adjust available tickets proportionally
-		diff := req.TotalTickets - event.TotalTickets
-		event.AvailableTickets += diff
+		if previous.HasUnlimitedSeats() {
+			// RSVPs to an unlimited event did not reserve seats, so count them against the new limit
+			event.AvailableTickets = req.TotalTickets - event.ParticipantCount
+		} else {
+			// Adjust available tickets proportionally
+			diff := req.TotalTickets - event.TotalTickets
+			event.AvailableTickets += diff
+		}
 		if event.AvailableTickets < 0 {
 			event.AvailableTickets = 0
 		}
 		event.TotalTickets = req.TotalTickets
 	}
 	event.TicketPrice = req.TicketPrice
+	if event.Status != domain.EventDraft && event.TotalTickets <= 0 && event.TicketPrice > 0 {
+		return errors.New("paid events need tickets while they are on sale")
+	}
 	if req.Currency != "" {
 		event.Currency = req.Currency
 	}
@@ -205,54 +409,563 @@ func (uc *eventUseCase) UpdateEvent(eventID, userID uuid.UUID, req UpdateEventRe
 		event.Status = req.Status
 	}
 	event.IsPublic = req.IsPublic
+	event.RequiresApproval = req.RequiresApproval
 	event.UpdatedAt = time.Now()
 
-	return uc.eventRepo.Update(event)
+	layout := strings.TrimSpace(req.SeatingLayout)
+	venue, err := venueForEvent(uc.venueRepo, event.OrganizationID, req.VenueID, layout, event.TotalTickets)
+	if err != nil {
+		return err
+	}
+	holdEventAt(event, venue, layout)
+
+	// Only drafts are scheduled; the publish time of a published event is kept as a record
+	if event.Status == domain.EventDraft {
+		if !samePublishAt(req.PublishAt, previous.PublishAt) {
+			if err := validatePublishAt(req.PublishAt, event.StartTime, time.Now()); err != nil {
+				return err
+			}
+		}
+		event.PublishAt = req.PublishAt
+	}
+
+	if event.Status != fromStatus {
+		if err := validateEventTransition(event, time.Now()); err != nil {
+			return err
+		}
+	}
+
+	// Ticket holders only exist once an event is on sale
+	var change *domain.EventChange
+	if fromStatus == domain.EventPublished || fromStatus == domain.EventOngoing {
+		change = materialChange(&previous, event, userID)
+	}
+	if change != nil && !previous.StartTime.Equal(event.StartTime) {
+		until := time.Now().Add(dateChangeRefundWindow)
+		if event.StartTime.Before(until) {
+			until = event.StartTime
+		}
+		if until.After(time.Now()) {
+			change.RefundUntil = &until
+			event.DateChangeRefundUntil = &until
+		}
+	}
+
+	if err := uc.eventRepo.Update(ctx, event, fromStatus); err != nil {
+		return err
+	}
+	if change != nil {
+		uc.recordMaterialChange(ctx, event, change)
+	}
+	if event.Status != fromStatus && event.Status == domain.EventPublished {
+		uc.popularity.RecordPublish(event.ID)
+	}
+
+	// Edits to an approved draft need another review unless the editor may approve events
+	if event.Status == domain.EventDraft && event.Review.Status == domain.ReviewApproved {
+		canApprove, err := uc.policy.Can(userID, event.OrganizationID, domain.PermissionEventApprove)
+		if err != nil {
+			return err
+		}
+		if !canApprove {
+			err := uc.eventRepo.UpdateReview(ctx, event.ID, domain.ReviewApproved, &domain.EventReview{
+				Status: domain.ReviewNotSubmitted,
+			})
+			if err != nil && !errors.Is(err, domain.ErrConflict) {
+				return err
+			}
+		}
+	}
+
+	return nil
 }
 
-// DeleteEvent deletes an event (admin only)
-func (uc *eventUseCase) DeleteEvent(eventID, userID uuid.UUID) error {
-	// Get event
-	event, err := uc.eventRepo.GetByID(eventID)
+// materialChange compares the fields ticket holders are told about, returning nil if none changed
+func materialChange(previous, event *domain.Event, userID uuid.UUID) *domain.EventChange {
+	before := make(map[string]string)
+	after := make(map[string]string)
+	if !previous.StartTime.Equal(event.StartTime) {
+		before[domain.EventFieldStartTime] = previous.StartTime.Format(time.RFC3339)
+		after[domain.EventFieldStartTime] = event.StartTime.Format(time.RFC3339)
+	}
+	if previous.Location != event.Location {
+		before[domain.EventFieldLocation] = previous.Location
+		after[domain.EventFieldLocation] = event.Location
+	}
+	if previous.Venue != event.Venue {
+		before[domain.EventFieldVenue] = previous.Venue
+		after[domain.EventFieldVenue] = event.Venue
+	}
+	if previous.Address.String() != event.Address.String() {
+		before[domain.EventFieldAddress] = previous.Address.String()
+		after[domain.EventFieldAddress] = event.Address.String()
+	}
+	if len(after) == 0 {
+		return nil
+	}
+
+	return &domain.EventChange{
+		ID:        uuid.New(),
+		EventID:   event.ID,
+		ChangedBy: userID,
+		Before:    before,
+		After:     after,
+	}
+}
+
+// recordMaterialChange adds a change to the event's history and notifies its ticket holders in the background
+// The event update has already been saved, so failures here are logged instead of returned
+func (uc *eventUseCase) recordMaterialChange(ctx context.Context, event *domain.Event, change *domain.EventChange) {
+	payments, err := uc.paymentRepo.GetCompletedPaymentsByEventID(event.ID)
 	if err != nil {
-		return err
+		fmt.Printf("Warning: failed to get ticket holders of event %s: %v\n", event.ID, err)
+		return
+	}
+
+	recipients := make([]*domain.Payment, 0, len(payments))
+	for _, p := range payments {
+		if p.BuyerEmail != "" {
+			recipients = append(recipients, p)
+		}
+	}
+	change.NotifiedCount = len(recipients)
+
+	if _, err := uc.changeRepo.Create(ctx, change); err != nil {
+		fmt.Printf("Warning: failed to record change of event %s: %v\n", event.ID, err)
+	}
+
+	go func() {
+		for _, p := range recipients {
+			subject, body := materialChangeEmail(event, change, p)
+			if err := uc.mailer.Send(p.BuyerEmail, subject, body); err != nil {
+				fmt.Printf("Warning: failed to notify buyer of payment %s about event change: %v\n", p.ID, err)
+			}
+		}
+	}()
+}
+
+// materialChangeEmail renders the notice a ticket holder receives when an event changes
+func materialChangeEmail(event *domain.Event, change *domain.EventChange, payment *domain.Payment) (string, string) {
+	labels := []struct {
+		field string
+		label string
+	}{
+		{domain.EventFieldStartTime, "시작 시간"},
+		{domain.EventFieldLocation, "주소"},
+		{domain.EventFieldVenue, "장소"},
+		{domain.EventFieldAddress, "상세 주소"},
+	}
+
+	var lines strings.Builder
+	for _, l := range labels {
+		after, ok := change.After[l.field]
+		if !ok {
+			continue
+		}
+		before := change.Before[l.field]
+		if l.field == domain.EventFieldStartTime {
+			before = formatChangeTime(before)
+			after = formatChangeTime(after)
+		}
+		if before == "" {
+			before = "(없음)"
+		}
+		if after == "" {
+			after = "(없음)"
+		}
+		fmt.Fprintf(&lines, "- %s: %s → %s\n", l.label, before, after)
+	}
+
+	body := fmt.Sprintf(
+		"%s님, 예매하신 %s 행사의 정보가 다음과 같이 변경되었습니다.\n\n%s",
+		payment.BuyerName,
+		event.Title,
+		lines.String(),
+	)
+	if change.RefundUntil != nil && payment.Channel == "online" && payment.TotalPrice > 0 {
+		body += fmt.Sprintf(
+			"\n일정 변경으로 인해 %s까지 수수료 없이 전액 환불을 요청할 수 있습니다.\n",
+			change.RefundUntil.Format("2006-01-02 15:04"),
+		)
 	}
+	body += fmt.Sprintf("\n주문 번호: %s", payment.OrderID)
+
+	return fmt.Sprintf("[Ticketly] %s 행사 정보가 변경되었습니다", event.Title), body
+}
 
-	// Check if user is admin of the organization
-	isAdmin, err := uc.orgRepo.IsUserAdmin(event.OrganizationID, userID)
+// formatChangeTime formats a recorded RFC 3339 time for display in emails
+func formatChangeTime(value string) string {
+	t, err := time.Parse(time.RFC3339, value)
 	if err != nil {
+		return value
+	}
+	return t.Format("2006-01-02 15:04")
+}
+
+// GetEventHistory retrieves the material changes of an event, newest first (events:view)
+func (uc *eventUseCase) GetEventHistory(eventID, userID uuid.UUID) ([]*domain.EventChange, error) {
+	if _, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventView); err != nil {
+		return nil, err
+	}
+
+	return uc.changeRepo.GetByEventID(eventID)
+}
+
+// validatePublishAt checks a scheduled publish time; nil means the event is not scheduled
+func validatePublishAt(publishAt *time.Time, startTime, now time.Time) error {
+	if publishAt == nil {
+		return nil
+	}
+	if !publishAt.After(now) {
+		return errors.New("publish time must be in the future")
+	}
+	if !publishAt.Before(startTime) {
+		return errors.New("publish time must be before the start time")
+	}
+	return nil
+}
+
+func samePublishAt(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	return a.Equal(*b)
+}
+
+// validateEventTransition checks the conditions an event must meet to enter its new status
+func validateEventTransition(event *domain.Event, now time.Time) error {
+	switch event.Status {
+	case domain.EventPublished:
+		if event.Review.Status != domain.ReviewApproved {
+			return errors.New("event must be approved before it is published")
+		}
+		if event.TotalTickets <= 0 && event.TicketPrice > 0 {
+			return errors.New("paid events need tickets before they are published")
+		}
+		if !event.StartTime.After(now) {
+			return errors.New("events can only be published before they start")
+		}
+	case domain.EventOngoing:
+		if event.StartTime.After(now) {
+			return errors.New("event has not started yet")
+		}
+	}
+	return nil
+}
+
+// DeleteEvent deletes an event (events:delete)
+func (uc *eventUseCase) DeleteEvent(ctx context.Context, eventID, userID uuid.UUID) error {
+	// Check if user may delete the event
+	if _, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventDelete); err != nil {
 		return err
 	}
-	if !isAdmin {
-		return errors.New("permission denied: only admins can delete events")
+
+	return uc.eventRepo.Delete(ctx, eventID)
+}
+
+// SubmitEventForReview asks the organization's approvers to review a draft event (events:update)
+func (uc *eventUseCase) SubmitEventForReview(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error) {
+	event, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventUpdate)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Status != domain.EventDraft {
+		return nil, errors.New("only draft events can be submitted for review")
+	}
+	switch event.Review.Status {
+	case domain.ReviewNotSubmitted, domain.ReviewChangesRequested:
+	case domain.ReviewPending:
+		return nil, errors.New("event is already waiting for review")
+	case domain.ReviewApproved:
+		return nil, errors.New("event is already approved")
+	default:
+		return nil, errors.New("rejected events cannot be submitted again")
+	}
+
+	now := time.Now()
+	return uc.review(ctx, event, &domain.EventReview{
+		Status:      domain.ReviewPending,
+		Comment:     strings.TrimSpace(comment),
+		SubmittedBy: &userID,
+		SubmittedAt: &now,
+	})
+}
+
+// ApproveEvent approves an event waiting for review so it can be published (events:approve)
+func (uc *eventUseCase) ApproveEvent(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error) {
+	return uc.decide(ctx, eventID, userID, domain.ReviewApproved, comment)
+}
+
+// RequestEventChanges sends an event waiting for review back to its authors with a comment (events:approve)
+func (uc *eventUseCase) RequestEventChanges(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, errors.New("a comment is required when requesting changes")
+	}
+	return uc.decide(ctx, eventID, userID, domain.ReviewChangesRequested, comment)
+}
+
+// RejectEvent rejects an event waiting for review; rejected events cannot be submitted again (events:approve)
+func (uc *eventUseCase) RejectEvent(ctx context.Context, eventID, userID uuid.UUID, comment string) (*domain.Event, error) {
+	if strings.TrimSpace(comment) == "" {
+		return nil, errors.New("a comment is required when rejecting an event")
+	}
+	return uc.decide(ctx, eventID, userID, domain.ReviewRejected, comment)
+}
+
+// GetReviewQueue retrieves an organization's events waiting for review (events:approve)
+func (uc *eventUseCase) GetReviewQueue(orgID, userID uuid.UUID) ([]*domain.Event, error) {
+	if err := uc.policy.Require(userID, orgID, domain.PermissionEventApprove); err != nil {
+		return nil, err
+	}
+
+	events, err := uc.eventRepo.GetByOrganizationID(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := make([]*domain.Event, 0)
+	for _, event := range events {
+		if event.Review.Status == domain.ReviewPending {
+			pending = append(pending, event)
+		}
+	}
+
+	return pending, nil
+}
+
+// decide records a reviewer's decision on an event waiting for review
+func (uc *eventUseCase) decide(ctx context.Context, eventID, userID uuid.UUID, status, comment string) (*domain.Event, error) {
+	event, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventApprove)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Review.Status != domain.ReviewPending {
+		return nil, errors.New("event is not waiting for review")
+	}
+
+	now := time.Now()
+	return uc.review(ctx, event, &domain.EventReview{
+		Status:     status,
+		Comment:    strings.TrimSpace(comment),
+		ReviewedBy: &userID,
+		ReviewedAt: &now,
+	})
+}
+
+// review moves an event to the next review status and returns the updated event
+func (uc *eventUseCase) review(ctx context.Context, event *domain.Event, review *domain.EventReview) (*domain.Event, error) {
+	if err := uc.eventRepo.UpdateReview(ctx, event.ID, event.Review.Status, review); err != nil {
+		return nil, err
 	}
 
-	return uc.eventRepo.Delete(eventID)
+	return uc.eventRepo.GetByID(event.ID)
 }
 
-// GetPublicEvents retrieves all public events
-func (uc *eventUseCase) GetPublicEvents() ([]*domain.EventWithOrganization, error) {
-	return uc.eventRepo.GetPublicEvents()
+// GetPublicEvents lists public events, newest first unless sorted otherwise
+func (uc *eventUseCase) GetPublicEvents(query domain.EventListQuery) (*EventPage, error) {
+	return uc.listPublicEvents(query, domain.EventSortNewest)
 }
 
-// GetUpcomingEvents retrieves upcoming public events
-func (uc *eventUseCase) GetUpcomingEvents() ([]*domain.EventWithOrganization, error) {
-	return uc.eventRepo.GetUpcomingEvents()
+// GetUpcomingEvents lists public events that have not started yet, soonest first unless sorted otherwise
+func (uc *eventUseCase) GetUpcomingEvents(query domain.EventListQuery) (*EventPage, error) {
+	query.Upcoming = true
+	return uc.listPublicEvents(query, domain.EventSortStartTime)
 }
 
-// GetPopularEvents retrieves popular upcoming events
-// Events are considered popular if 70% or more of their tickets are sold
-func (uc *eventUseCase) GetPopularEvents() ([]*domain.EventWithOrganization, error) {
-	// 0.7 means 70% threshold - events with 70% or more tickets sold
-	return uc.eventRepo.GetPopularEvents(0.7)
+// GetPopularEvents lists upcoming events by their popularity ranking, which blends recent ticket sales, views,
+// favorites and how recently they were published
+func (uc *eventUseCase) GetPopularEvents(query domain.EventListQuery) (*EventPage, error) {
+	if query.Sort != "" && query.Sort != domain.EventSortPopularity {
+		return nil, fmt.Errorf("%w: popular events are always sorted by popularity", domain.ErrInvalidInput)
+	}
+	query.Upcoming = true
+
+	query, err := normalizeEventListQuery(query, domain.EventSortPopularity)
+	if err != nil {
+		return nil, err
+	}
+	return uc.popularity.GetPopularEvents(query)
 }
 
-// SearchEvents searches events by keyword
-func (uc *eventUseCase) SearchEvents(keyword string) ([]*domain.EventWithOrganization, error) {
-	if keyword == "" {
+// GetNearbyEvents lists public events within a radius of a point, closest first unless sorted otherwise
+// Events without coordinates are never included
+func (uc *eventUseCase) GetNearbyEvents(query domain.EventListQuery) (*EventPage, error) {
+	if query.Near == nil {
+		return nil, fmt.Errorf("%w: lat and lng are required", domain.ErrInvalidInput)
+	}
+	return uc.listPublicEvents(query, domain.EventSortDistance)
+}
+
+// SearchEvents lists public events matching a keyword, best match first unless sorted otherwise
+// Matched fields are returned highlighted
+func (uc *eventUseCase) SearchEvents(keyword string, query domain.EventListQuery) (*EventPage, error) {
+	query.Keyword = strings.TrimSpace(keyword)
+	if query.Keyword == "" {
 		return nil, errors.New("search keyword is required")
 	}
-	return uc.eventRepo.SearchEvents(keyword)
+	if utf8.RuneCountInString(query.Keyword) > maxSearchKeywordLength {
+		return nil, fmt.Errorf("%w: search keyword must be at most %d characters", domain.ErrInvalidInput, maxSearchKeywordLength)
+	}
+
+	page, err := uc.listPublicEvents(query, domain.EventSortRelevance)
+	if err != nil {
+		return nil, err
+	}
+
+	terms := strings.Fields(query.Keyword)
+	for _, event := range page.Events {
+		event.Highlights = highlightEvent(event, terms)
+	}
+
+	// Count each search once, on its first page, and only if it found something
+	if query.Cursor == "" && len(page.Events) > 0 {
+		if keyword, ok := trendingKeyword(query.Keyword); ok {
+			if err := uc.trends.Record(keyword, time.Now()); err != nil {
+				fmt.Printf("Warning: failed to record search keyword: %v\n", err)
+			}
+		}
+	}
+
+	return page, nil
+}
+
+// listPublicEvents validates a listing query, fills in its defaults and fetches one page
+func (uc *eventUseCase) listPublicEvents(query domain.EventListQuery, defaultSort string) (*EventPage, error) {
+	query, err := normalizeEventListQuery(query, defaultSort)
+	if err != nil {
+		return nil, err
+	}
+
+	events, next, err := uc.eventRepo.ListPublicEvents(query)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EventPage{
+		Events:     events,
+		NextCursor: next,
+	}, nil
+}
+
+// normalizeEventListQuery validates a listing query and fills in its defaults
+func normalizeEventListQuery(query domain.EventListQuery, defaultSort string) (domain.EventListQuery, error) {
+	if query.Sort == "" {
+		query.Sort = defaultSort
+	}
+	switch query.Sort {
+	case domain.EventSortStartTime, domain.EventSortNewest, domain.EventSortPriceAsc, domain.EventSortPriceDesc, domain.EventSortPopularity:
+	case domain.EventSortRelevance:
+		if query.Keyword == "" {
+			return query, fmt.Errorf("%w: relevance sort is only available when searching", domain.ErrInvalidInput)
+		}
+	case domain.EventSortDistance:
+		if query.Near == nil {
+			return query, fmt.Errorf("%w: distance sort needs lat and lng", domain.ErrInvalidInput)
+		}
+	default:
+		return query, fmt.Errorf("%w: sort must be start_time, newest, price_asc, price_desc, popularity, relevance or distance", domain.ErrInvalidInput)
+	}
+	if query.Near != nil {
+		if err := validateCoordinates(query.Near); err != nil {
+			return query, err
+		}
+		if query.RadiusKm == 0 {
+			query.RadiusKm = defaultNearbyRadiusKm
+		}
+		if !(query.RadiusKm > 0 && query.RadiusKm <= maxNearbyRadiusKm) {
+			return query, fmt.Errorf("%w: radius must be more than 0 and at most %d km", domain.ErrInvalidInput, maxNearbyRadiusKm)
+		}
+	} else if query.RadiusKm != 0 {
+		return query, fmt.Errorf("%w: radius needs lat and lng", domain.ErrInvalidInput)
+	}
+	if query.StartFrom != nil && query.StartTo != nil && query.StartFrom.After(*query.StartTo) {
+		return query, fmt.Errorf("%w: start_from must be before start_to", domain.ErrInvalidInput)
+	}
+	if (query.MinPrice != nil && *query.MinPrice < 0) || (query.MaxPrice != nil && *query.MaxPrice < 0) {
+		return query, fmt.Errorf("%w: prices must be non-negative", domain.ErrInvalidInput)
+	}
+	if query.MinPrice != nil && query.MaxPrice != nil && *query.MinPrice > *query.MaxPrice {
+		return query, fmt.Errorf("%w: min_price must not exceed max_price", domain.ErrInvalidInput)
+	}
+	query.Category = strings.ToLower(strings.TrimSpace(query.Category))
+	query.Location = strings.TrimSpace(query.Location)
+	if query.Limit < 1 {
+		query.Limit = defaultEventPageSize
+	}
+	if query.Limit > maxEventPageSize {
+		query.Limit = maxEventPageSize
+	}
+
+	return query, nil
+}
+
+// normalizeAddress trims the parts of an address and uppercases its country code
+// It returns nil for an address with no parts set
+func normalizeAddress(address *domain.Address) (*domain.Address, error) {
+	if address == nil {
+		return nil, nil
+	}
+
+	normalized := domain.Address{
+		Street:     strings.TrimSpace(address.Street),
+		City:       strings.TrimSpace(address.City),
+		Region:     strings.TrimSpace(address.Region),
+		PostalCode: strings.TrimSpace(address.PostalCode),
+		Country:    strings.ToUpper(strings.TrimSpace(address.Country)),
+	}
+	if normalized == (domain.Address{}) {
+		return nil, nil
+	}
+
+	limits := []struct {
+		name  string
+		value string
+		max   int
+	}{
+		{"street", normalized.Street, maxStreetLength},
+		{"city", normalized.City, maxCityLength},
+		{"region", normalized.Region, maxCityLength},
+		{"postal code", normalized.PostalCode, maxPostalCodeLength},
+	}
+	for _, l := range limits {
+		if utf8.RuneCountInString(l.value) > l.max {
+			return nil, fmt.Errorf("%w: %s must be at most %d characters", domain.ErrInvalidInput, l.name, l.max)
+		}
+	}
+	if normalized.Country != "" && !isCountryCode(normalized.Country) {
+		return nil, fmt.Errorf("%w: country must be a two-letter ISO 3166-1 code such as KR", domain.ErrInvalidInput)
+	}
+
+	return &normalized, nil
+}
+
+func isCountryCode(code string) bool {
+	return len(code) == 2 && code[0] >= 'A' && code[0] <= 'Z' && code[1] >= 'A' && code[1] <= 'Z'
+}
+
+// validateCoordinates checks that a point, if given, lies within latitude and longitude bounds
+func validateCoordinates(point *domain.GeoPoint) error {
+	if point == nil {
+		return nil
+	}
+	if !(point.Latitude >= -90 && point.Latitude <= 90) {
+		return fmt.Errorf("%w: latitude must be between -90 and 90", domain.ErrInvalidInput)
+	}
+	if !(point.Longitude >= -180 && point.Longitude <= 180) {
+		return fmt.Errorf("%w: longitude must be between -180 and 180", domain.ErrInvalidInput)
+	}
+	return nil
+}
+
+// normalizeCategory lowercases a category so filters match regardless of how it was typed
+func normalizeCategory(category string) (string, error) {
+	category = strings.ToLower(strings.TrimSpace(category))
+	if len([]rune(category)) > maxCategoryLength {
+		return "", fmt.Errorf("category must be at most %d characters", maxCategoryLength)
+	}
+	return category, nil
 }
 
 // ReserveTickets reserves tickets for an event (decreases available tickets)
@@ -0,0 +1,91 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type FavoriteUseCase interface {
+	FavoriteEvent(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventFavorite, error)
+	UnfavoriteEvent(ctx context.Context, eventID, userID uuid.UUID) error
+	// GetMyFavorites lists the events a user favorited that are still listed publicly, most recently favorited first
+	GetMyFavorites(userID uuid.UUID) ([]*domain.EventWithOrganization, error)
+}
+
+type favoriteUseCase struct {
+	favoriteRepo domain.EventFavoriteRepository
+	eventRepo    domain.EventRepository
+	policy       AuthorizationPolicy
+	popularity   PopularityUseCase
+}
+
+func NewFavoriteUseCase(favoriteRepo domain.EventFavoriteRepository, eventRepo domain.EventRepository, policy AuthorizationPolicy, popularity PopularityUseCase) FavoriteUseCase {
+	return &favoriteUseCase{
+		favoriteRepo: favoriteRepo,
+		eventRepo:    eventRepo,
+		policy:       policy,
+		popularity:   popularity,
+	}
+}
+
+// FavoriteEvent saves a public event to the user's favorites; favoriting it again returns the existing favorite
+func (uc *favoriteUseCase) FavoriteEvent(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventFavorite, error) {
+	event, err := uc.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	// Only events anyone can see, so favorites never reveal private or draft events
+	if err := uc.policy.CanViewEvent(event, uuid.Nil); err != nil {
+		return nil, err
+	}
+
+	created, err := uc.favoriteRepo.Create(ctx, &domain.EventFavorite{
+		ID:        uuid.New(),
+		UserID:    userID,
+		EventID:   eventID,
+		CreatedAt: time.Now(),
+	})
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return uc.favoriteRepo.Get(userID, eventID)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	uc.popularity.RecordFavorite(eventID, created.CreatedAt)
+	return created, nil
+}
+
+// UnfavoriteEvent removes an event from the user's favorites
+func (uc *favoriteUseCase) UnfavoriteEvent(ctx context.Context, eventID, userID uuid.UUID) error {
+	favorite, err := uc.favoriteRepo.Get(userID, eventID)
+	if err != nil {
+		return err
+	}
+
+	if err := uc.favoriteRepo.Delete(ctx, favorite.ID); err != nil {
+		return err
+	}
+
+	uc.popularity.RemoveFavorite(eventID, favorite.CreatedAt)
+	return nil
+}
+
+// GetMyFavorites lists the user's favorite events; events that were deleted or made private are left out
+func (uc *favoriteUseCase) GetMyFavorites(userID uuid.UUID) ([]*domain.EventWithOrganization, error) {
+	favorites, err := uc.favoriteRepo.GetByUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	eventIDs := make([]uuid.UUID, len(favorites))
+	for i, favorite := range favorites {
+		eventIDs[i] = favorite.EventID
+	}
+
+	return uc.eventRepo.GetPublicEventsByIDs(eventIDs, domain.EventListQuery{})
+}
@@ -0,0 +1,313 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+	"github.com/google/uuid"
+)
+
+const invitationTTL = 7 * 24 * time.Hour
+
+type InvitationUseCase interface {
+	// Organization side (members:manage)
+	Invite(ctx context.Context, orgID, requesterID uuid.UUID, email, role string) (*domain.OrganizationInvitation, error)
+	GetPendingInvitations(orgID, requesterID uuid.UUID) ([]*domain.OrganizationInvitation, error)
+	RevokeInvitation(ctx context.Context, orgID, invitationID, requesterID uuid.UUID) error
+
+	// Invitee side
+	GetMyInvitations(userID uuid.UUID) ([]*domain.OrganizationInvitation, error)
+	AcceptByToken(ctx context.Context, token string, userID uuid.UUID) (*domain.OrganizationInvitation, error)
+	DeclineByToken(ctx context.Context, token string, userID uuid.UUID) (*domain.OrganizationInvitation, error)
+	Decline(ctx context.Context, invitationID, userID uuid.UUID) (*domain.OrganizationInvitation, error)
+}
+
+type invitationUseCase struct {
+	invitationRepo domain.InvitationRepository
+	orgRepo        domain.OrganizationRepository
+	userRepo       domain.UserRepository
+	policy         AuthorizationPolicy
+	jwtUtil        *util.JWTUtil
+	mailer         util.Mailer
+	acceptURL      string
+}
+
+// NewInvitationUseCase creates the invitation usecase
+// acceptURL is the page invitees open from the email; the token is appended as ?token=
+func NewInvitationUseCase(invitationRepo domain.InvitationRepository, orgRepo domain.OrganizationRepository, userRepo domain.UserRepository, policy AuthorizationPolicy, jwtUtil *util.JWTUtil, mailer util.Mailer, acceptURL string) InvitationUseCase {
+	if acceptURL == "" {
+		acceptURL = "http://localhost:3000/invitations/accept"
+	}
+
+	return &invitationUseCase{
+		invitationRepo: invitationRepo,
+		orgRepo:        orgRepo,
+		userRepo:       userRepo,
+		policy:         policy,
+		jwtUtil:        jwtUtil,
+		mailer:         mailer,
+		acceptURL:      acceptURL,
+	}
+}
+
+// Invite invites an email address to join an organization with a role and emails the invitation link
+func (uc *invitationUseCase) Invite(ctx context.Context, orgID, requesterID uuid.UUID, email, role string) (*domain.OrganizationInvitation, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionMemberManage); err != nil {
+		return nil, err
+	}
+	if err := uc.policy.RequireAssignableRole(requesterID, orgID, role); err != nil {
+		return nil, err
+	}
+
+	email = strings.ToLower(strings.TrimSpace(email))
+	if _, err := mail.ParseAddress(email); err != nil {
+		return nil, errors.New("invalid email address")
+	}
+
+	org, err := uc.orgRepo.GetByID(orgID)
+	if err != nil {
+		return nil, err
+	}
+	if !org.IsActive {
+		return nil, errors.New("cannot invite members to an inactive organization")
+	}
+
+	// Registered users who are already members cannot be invited again
+	user, err := uc.userRepo.GetUserByEmail(email)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+	if user != nil {
+		isMember, err := uc.orgRepo.IsUserMember(orgID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		if isMember {
+			return nil, errors.New("user is already a member of this organization")
+		}
+	}
+
+	if _, err := uc.invitationRepo.GetPendingByOrgIDAndEmail(orgID, email); err == nil {
+		return nil, errors.New("an invitation is already pending for this email")
+	} else if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	invitation, err := uc.invitationRepo.Create(ctx, &domain.OrganizationInvitation{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Email:          email,
+		Role:           role,
+		InvitedBy:      requesterID,
+		Status:         domain.InvitationPending,
+		ExpiresAt:      time.Now().Add(invitationTTL),
+	})
+	if err != nil {
+		return nil, err
+	}
+	invitation.OrganizationName = org.Name
+
+	if err := uc.sendInvitationEmail(invitation); err != nil {
+		// Revoke the invitation so it can be sent again
+		_ = uc.invitationRepo.UpdateStatus(ctx, invitation.ID, domain.InvitationRevoked, nil)
+		return nil, err
+	}
+
+	return invitation, nil
+}
+
+func (uc *invitationUseCase) sendInvitationEmail(invitation *domain.OrganizationInvitation) error {
+	token, err := uc.jwtUtil.GenerateInvitationToken(invitation.ID, invitation.Email, invitation.ExpiresAt)
+	if err != nil {
+		return err
+	}
+
+	link := uc.acceptURL + "?token=" + url.QueryEscape(token)
+	subject := fmt.Sprintf("[Ticketly] %s 조직에 초대되었습니다", invitation.OrganizationName)
+	body := fmt.Sprintf(
+		"%s 조직에서 %s 역할로 초대했습니다.\n\n아래 링크에서 초대를 수락하거나 거절할 수 있습니다.\n%s\n\n아직 Ticketly 계정이 없다면 이 이메일 주소(%s)로 가입한 후 링크를 다시 열어주세요.\n이 초대는 %s에 만료됩니다.",
+		invitation.OrganizationName,
+		invitation.Role,
+		link,
+		invitation.Email,
+		invitation.ExpiresAt.Format("2006-01-02 15:04"),
+	)
+
+	return uc.mailer.Send(invitation.Email, subject, body)
+}
+
+// GetPendingInvitations lists an organization's pending invitations
+func (uc *invitationUseCase) GetPendingInvitations(orgID, requesterID uuid.UUID) ([]*domain.OrganizationInvitation, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionMemberManage); err != nil {
+		return nil, err
+	}
+
+	return uc.invitationRepo.GetPendingByOrgID(orgID)
+}
+
+// RevokeInvitation cancels a pending invitation so its token can no longer be used
+func (uc *invitationUseCase) RevokeInvitation(ctx context.Context, orgID, invitationID, requesterID uuid.UUID) error {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionMemberManage); err != nil {
+		return err
+	}
+
+	invitation, err := uc.invitationRepo.GetByID(invitationID)
+	if err != nil {
+		return err
+	}
+	if invitation.OrganizationID != orgID {
+		return domain.ErrNotFound
+	}
+	if invitation.Status != domain.InvitationPending {
+		return errors.New("invitation is no longer pending")
+	}
+
+	return uc.invitationRepo.UpdateStatus(ctx, invitation.ID, domain.InvitationRevoked, nil)
+}
+
+// GetMyInvitations lists the pending, unexpired invitations sent to the user's email
+// Accepting one still takes the token from the invitation email, since signing up does not prove the user owns the address
+func (uc *invitationUseCase) GetMyInvitations(userID uuid.UUID) ([]*domain.OrganizationInvitation, error) {
+	user, err := uc.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := uc.invitationRepo.GetPendingByEmail(strings.ToLower(user.Email))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.OrganizationInvitation, 0, len(invitations))
+	for _, invitation := range invitations {
+		if !invitation.IsExpired() {
+			result = append(result, invitation)
+		}
+	}
+
+	return result, nil
+}
+
+// AcceptByToken accepts the invitation identified by a signed invitation token
+// The token is the only proof that the user received the email, so invitations cannot be accepted by ID
+func (uc *invitationUseCase) AcceptByToken(ctx context.Context, token string, userID uuid.UUID) (*domain.OrganizationInvitation, error) {
+	invitationID, err := uc.parseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.accept(ctx, invitationID, userID)
+}
+
+// DeclineByToken declines the invitation identified by a signed invitation token
+func (uc *invitationUseCase) DeclineByToken(ctx context.Context, token string, userID uuid.UUID) (*domain.OrganizationInvitation, error) {
+	invitationID, err := uc.parseToken(token)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.Decline(ctx, invitationID, userID)
+}
+
+// accept joins the organization with the invited role
+func (uc *invitationUseCase) accept(ctx context.Context, invitationID, userID uuid.UUID) (*domain.OrganizationInvitation, error) {
+	invitation, err := uc.getRespondableInvitation(invitationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	isMember, err := uc.orgRepo.IsUserMember(invitation.OrganizationID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if isMember {
+		return nil, errors.New("user is already a member of this organization")
+	}
+
+	// A custom role may have been deleted since the invitation was sent
+	if _, ok := domain.BuiltInRoles[invitation.Role]; !ok {
+		if _, err := uc.orgRepo.GetRoleByName(invitation.OrganizationID, invitation.Role); err != nil {
+			if errors.Is(err, domain.ErrNotFound) {
+				return nil, errors.New("the invited role no longer exists")
+			}
+			return nil, err
+		}
+	}
+
+	err = uc.invitationRepo.Accept(ctx, invitation.ID, &domain.OrganizationMember{
+		ID:             uuid.New(),
+		OrganizationID: invitation.OrganizationID,
+		UserID:         userID,
+		Role:           invitation.Role,
+		JoinedAt:       time.Now(),
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	})
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return nil, errors.New("user is already a member of this organization")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.invitationRepo.GetByID(invitation.ID)
+}
+
+// Decline rejects the invitation
+func (uc *invitationUseCase) Decline(ctx context.Context, invitationID, userID uuid.UUID) (*domain.OrganizationInvitation, error) {
+	invitation, err := uc.getRespondableInvitation(invitationID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.invitationRepo.UpdateStatus(ctx, invitation.ID, domain.InvitationDeclined, &userID); err != nil {
+		return nil, err
+	}
+
+	return uc.invitationRepo.GetByID(invitation.ID)
+}
+
+func (uc *invitationUseCase) parseToken(token string) (uuid.UUID, error) {
+	if token == "" {
+		return uuid.Nil, errors.New("invitation token is required")
+	}
+
+	claims, err := uc.jwtUtil.ValidateInvitationToken(token)
+	if err != nil {
+		return uuid.Nil, errors.New("invalid or expired invitation token")
+	}
+
+	return claims.InvitationID, nil
+}
+
+// getRespondableInvitation loads a pending, unexpired invitation addressed to the user's email
+func (uc *invitationUseCase) getRespondableInvitation(invitationID, userID uuid.UUID) (*domain.OrganizationInvitation, error) {
+	invitation, err := uc.invitationRepo.GetByID(invitationID)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := uc.userRepo.GetUserByID(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(user.Email, invitation.Email) {
+		return nil, permissionDenied("this invitation was sent to a different email address")
+	}
+
+	if invitation.Status != domain.InvitationPending {
+		return nil, fmt.Errorf("invitation has already been %s", invitation.Status)
+	}
+	if invitation.IsExpired() {
+		return nil, errors.New("invitation has expired")
+	}
+
+	return invitation, nil
+}
@@ -1,61 +1,139 @@
 package usecase
 
 import (
+	"context"
 	"errors"
+	"fmt"
+	"net/url"
+	"strings"
 	"time"
 
 	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
 	"github.com/google/uuid"
 )
 
 type OrganizationUseCase interface {
 	// Organization management
-	CreateOrganization(name, description, logoURL, category string, ownerID uuid.UUID) (*domain.Organization, error)
+	CreateOrganization(ctx context.Context, name, description, logoURL, category, slug string, socialLinks map[string]string, ownerID uuid.UUID) (*domain.Organization, error)
 	GetOrganization(orgID uuid.UUID) (*domain.Organization, error)
 	GetUserOrganizations(userID uuid.UUID) ([]*domain.OrganizationWithRole, error)
-	UpdateOrganization(orgID uuid.UUID, name, description, logoURL, category string, userID uuid.UUID) error
-	DeleteOrganization(orgID uuid.UUID, userID uuid.UUID) error
+	UpdateOrganization(ctx context.Context, orgID uuid.UUID, name, description, logoURL, category, slug string, socialLinks map[string]string, userID uuid.UUID) error
+	DeleteOrganization(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, refund bool) error
+	DeactivateOrganization(ctx context.Context, orgID, userID uuid.UUID) (*domain.Organization, error)
+	ReactivateOrganization(ctx context.Context, orgID, userID uuid.UUID) (*domain.Organization, error)
+
+	// Public profiles
+	GetPublicProfile(slug string) (*domain.OrganizationProfile, error)
+	GetPublicOrganizations(category string) ([]*domain.Organization, error)
 
 	// Member management
-	AddMember(orgID, userID, requesterID uuid.UUID, role string) error
-	RemoveMember(orgID, userID, requesterID uuid.UUID) error
-	GetMembers(orgID uuid.UUID) ([]*domain.OrganizationMember, error)
-	UpdateMemberRole(orgID, userID, requesterID uuid.UUID, newRole string) error
+	AddMember(ctx context.Context, orgID, userID, requesterID uuid.UUID, role string) error
+	RemoveMember(ctx context.Context, orgID, userID, requesterID uuid.UUID) error
+	GetMembers(orgID, requesterID uuid.UUID) ([]*domain.OrganizationMember, error)
+	UpdateMemberRole(ctx context.Context, orgID, userID, requesterID uuid.UUID, newRole string) error
+
+	// Role management
+	GetRoles(orgID, requesterID uuid.UUID) ([]*domain.OrganizationRole, error)
+	CreateRole(ctx context.Context, orgID, requesterID uuid.UUID, name, description string, permissions []domain.Permission) (*domain.OrganizationRole, error)
+	UpdateRole(ctx context.Context, orgID, requesterID uuid.UUID, name, description string, permissions []domain.Permission) (*domain.OrganizationRole, error)
+	DeleteRole(ctx context.Context, orgID, requesterID uuid.UUID, name string) error
+
+	// Ownership transfer
+	ProposeOwnershipTransfer(ctx context.Context, orgID, requesterID, newOwnerID uuid.UUID) (*domain.OwnershipTransfer, error)
+	GetOwnershipTransfer(orgID, requesterID uuid.UUID) (*domain.OwnershipTransfer, error)
+	CancelOwnershipTransfer(ctx context.Context, orgID, requesterID uuid.UUID) error
+	AcceptOwnershipTransfer(ctx context.Context, orgID, requesterID uuid.UUID) (*domain.Organization, error)
+	DeclineOwnershipTransfer(ctx context.Context, orgID, requesterID uuid.UUID) error
 
 	// Permission checks
-	CheckAdminPermission(orgID, userID uuid.UUID) error
-	CheckMemberPermission(orgID, userID uuid.UUID) error
+	CheckPermission(orgID, userID uuid.UUID, permission domain.Permission) error
+	GetMyPermissions(orgID, userID uuid.UUID) ([]domain.Permission, error)
+}
+
+const ownershipTransferTTL = 7 * 24 * time.Hour
+
+// OrganizationDeletionError explains why an organization cannot be deleted yet
+type OrganizationDeletionError struct {
+	LiveEvents        int
+	UnsettledPayments int
+	// Refunds is set once deletion with refund has cancelled the events and their refunds are not done yet
+	Refunds *domain.CancellationProgress
+}
+
+func (e *OrganizationDeletionError) Error() string {
+	if e.Refunds != nil {
+		return fmt.Sprintf("refunds of the cancelled events are not done yet (%d of %d succeeded, %d awaiting confirmation, %d failed): delete again once they succeed, confirming box-office refunds and retrying failed ones from each event's cancellation", e.Refunds.Succeeded, e.Refunds.Total, e.Refunds.AwaitingConfirmation, e.Refunds.Failed)
+	}
+	return fmt.Sprintf("organization has %d live events and %d unsettled payments: deactivate it instead, or delete with refund to cancel the events and refund attendees", e.LiveEvents, e.UnsettledPayments)
 }
 
+func (e *OrganizationDeletionError) Is(target error) bool {
+	return target == domain.ErrConflict
+}
+
+// socialNetworks lists the keys accepted in an organization's social links
+var socialNetworks = map[string]bool{
+	"website": true, "instagram": true, "x": true, "facebook": true,
+	"youtube": true, "linkedin": true, "github": true, "blog": true,
+}
+
+const maxSlugAttempts = 5
+
 type organizationUseCase struct {
-	orgRepo domain.OrganizationRepository
+	orgRepo      domain.OrganizationRepository
+	transferRepo domain.OwnershipTransferRepository
+	eventRepo    domain.EventRepository
+	paymentRepo  domain.PaymentRepository
+	policy       AuthorizationPolicy
+	webhooks     WebhookDispatcher
+	cancellation CancellationUseCase
 }
 
-func NewOrganizationUseCase(orgRepo domain.OrganizationRepository) OrganizationUseCase {
+func NewOrganizationUseCase(orgRepo domain.OrganizationRepository, transferRepo domain.OwnershipTransferRepository, eventRepo domain.EventRepository, paymentRepo domain.PaymentRepository, policy AuthorizationPolicy, webhooks WebhookDispatcher, cancellation CancellationUseCase) OrganizationUseCase {
 	return &organizationUseCase{
-		orgRepo: orgRepo,
+		orgRepo:      orgRepo,
+		transferRepo: transferRepo,
+		eventRepo:    eventRepo,
+		paymentRepo:  paymentRepo,
+		policy:       policy,
+		webhooks:     webhooks,
+		cancellation: cancellation,
 	}
 }
 
 // CreateOrganization creates a new organization
-func (uc *organizationUseCase) CreateOrganization(name, description, logoURL, category string, ownerID uuid.UUID) (*domain.Organization, error) {
+func (uc *organizationUseCase) CreateOrganization(ctx context.Context, name, description, logoURL, category, slug string, socialLinks map[string]string, ownerID uuid.UUID) (*domain.Organization, error) {
 	if name == "" {
 		return nil, errors.New("organization name is required")
 	}
 
+	links, err := validateSocialLinks(socialLinks)
+	if err != nil {
+		return nil, err
+	}
+
+	orgID := uuid.New()
+	slug, err = uc.resolveSlug(orgID, slug, name)
+	if err != nil {
+		return nil, err
+	}
+
 	org := &domain.Organization{
-		ID:          uuid.New(),
+		ID:          orgID,
 		Name:        name,
+		Slug:        slug,
 		Description: description,
 		LogoURL:     logoURL,
 		Category:    category,
+		SocialLinks: links,
 		OwnerID:     ownerID,
 		IsActive:    true,
 		CreatedAt:   time.Now(),
 		UpdatedAt:   time.Now(),
 	}
 
-	return uc.orgRepo.Create(org)
+	return uc.orgRepo.Create(ctx, org)
 }
 
 // GetOrganization retrieves an organization by ID
@@ -68,10 +146,11 @@ func (uc *organizationUseCase) GetUserOrganizations(userID uuid.UUID) ([]*domain
 	return uc.orgRepo.GetUserOrganizations(userID)
 }
 
-// UpdateOrganization updates an organization (admin only)
-func (uc *organizationUseCase) UpdateOrganization(orgID uuid.UUID, name, description, logoURL, category string, userID uuid.UUID) error {
-	// Check if user is admin
-	if err := uc.CheckAdminPermission(orgID, userID); err != nil {
+// UpdateOrganization updates an organization (organization:update)
+// An empty slug keeps the current one
+func (uc *organizationUseCase) UpdateOrganization(ctx context.Context, orgID uuid.UUID, name, description, logoURL, category, slug string, socialLinks map[string]string, userID uuid.UUID) error {
+	// Check if user may update the organization
+	if err := uc.CheckPermission(orgID, userID, domain.PermissionOrgUpdate); err != nil {
 		return err
 	}
 
@@ -80,42 +159,297 @@ func (uc *organizationUseCase) UpdateOrganization(orgID uuid.UUID, name, descrip
 		return err
 	}
 
+	links, err := validateSocialLinks(socialLinks)
+	if err != nil {
+		return err
+	}
+
 	if name != "" {
 		org.Name = name
 	}
+	if slug != "" || org.Slug == "" {
+		if slug == "" {
+			slug = org.Slug
+		}
+		org.Slug, err = uc.resolveSlug(orgID, slug, org.Name)
+		if err != nil {
+			return err
+		}
+	}
 	org.Description = description
 	org.LogoURL = logoURL
 	org.Category = category
+	org.SocialLinks = links
 	org.UpdatedAt = time.Now()
 
-	return uc.orgRepo.Update(org)
+	return uc.orgRepo.Update(ctx, org)
 }
 
-// DeleteOrganization deletes an organization (owner only)
-func (uc *organizationUseCase) DeleteOrganization(orgID uuid.UUID, userID uuid.UUID) error {
-	org, err := uc.orgRepo.GetByID(orgID)
+// resolveSlug validates a requested slug, or derives an available one from the name when none is requested
+func (uc *organizationUseCase) resolveSlug(orgID uuid.UUID, requested, name string) (string, error) {
+	if requested != "" {
+		slug := strings.ToLower(strings.TrimSpace(requested))
+		if !util.IsValidSlug(slug) {
+			return "", fmt.Errorf("invalid slug: must be %d-%d lowercase letters, digits or hyphens", util.MinSlugLength, util.MaxSlugLength)
+		}
+		available, err := uc.isSlugAvailable(orgID, slug)
+		if err != nil {
+			return "", err
+		}
+		if !available {
+			return "", fmt.Errorf("slug %q is already taken: %w", slug, domain.ErrAlreadyExists)
+		}
+		return slug, nil
+	}
+
+	// Names without ASCII letters or digits (e.g. Korean names) fall back to an ID-based slug
+	base := util.Slugify(name)
+	if len(base) < util.MinSlugLength {
+		base = "org-" + orgID.String()[:8]
+	}
+	if len(base) > util.MaxSlugLength-7 {
+		base = strings.TrimRight(base[:util.MaxSlugLength-7], "-")
+	}
+
+	slug := base
+	for i := 0; i < maxSlugAttempts; i++ {
+		available, err := uc.isSlugAvailable(orgID, slug)
+		if err != nil {
+			return "", err
+		}
+		if available {
+			return slug, nil
+		}
+		slug = base + "-" + uuid.New().String()[:6]
+	}
+
+	return "", errors.New("failed to generate a unique slug; please choose one")
+}
+
+func (uc *organizationUseCase) isSlugAvailable(orgID uuid.UUID, slug string) (bool, error) {
+	existing, err := uc.orgRepo.GetBySlug(slug)
 	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	return existing.ID == orgID, nil
+}
+
+// validateSocialLinks checks network keys and requires absolute http(s) URLs, dropping empty entries
+func validateSocialLinks(links map[string]string) (map[string]string, error) {
+	result := make(map[string]string, len(links))
+	for network, link := range links {
+		network = strings.ToLower(strings.TrimSpace(network))
+		link = strings.TrimSpace(link)
+		if link == "" {
+			continue
+		}
+		if !socialNetworks[network] {
+			return nil, fmt.Errorf("invalid social link: unsupported network %q", network)
+		}
+		parsed, err := url.Parse(link)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, fmt.Errorf("invalid social link for %s: must be an http or https URL", network)
+		}
+		result[network] = link
+	}
+
+	if len(result) == 0 {
+		return nil, nil
+	}
+	return result, nil
+}
+
+// GetPublicProfile retrieves an active organization's public profile by slug with its upcoming and past public events
+func (uc *organizationUseCase) GetPublicProfile(slug string) (*domain.OrganizationProfile, error) {
+	org, err := uc.orgRepo.GetBySlug(strings.ToLower(slug))
+	if err != nil {
+		return nil, err
+	}
+	if !org.IsActive {
+		return nil, domain.ErrNotFound
+	}
+
+	events, err := uc.eventRepo.GetPublicEventsByOrganizationID(org.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	profile := &domain.OrganizationProfile{
+		Organization:   org,
+		UpcomingEvents: make([]*domain.Event, 0),
+		PastEvents:     make([]*domain.Event, 0),
+	}
+
+	// Events arrive in start time order; past events are listed most recent first
+	now := time.Now()
+	for _, event := range events {
+		if event.Status != "completed" && event.EndTime.After(now) {
+			profile.UpcomingEvents = append(profile.UpcomingEvents, event)
+		} else {
+			profile.PastEvents = append([]*domain.Event{event}, profile.PastEvents...)
+		}
+	}
+
+	return profile, nil
+}
+
+// GetPublicOrganizations lists active organizations with a public profile, optionally filtered by category
+func (uc *organizationUseCase) GetPublicOrganizations(category string) ([]*domain.Organization, error) {
+	return uc.orgRepo.GetPublicOrganizations(strings.TrimSpace(category))
+}
+
+// DeleteOrganization deletes an organization (organization:delete, held only by the owner)
+// Deletion is refused while live events or unsettled payments exist unless refund is set,
+// in which case those events are cancelled and the organization is deleted once every refund has succeeded
+// Until then it returns the refund progress, and calling it again picks up where it left off
+func (uc *organizationUseCase) DeleteOrganization(ctx context.Context, orgID uuid.UUID, userID uuid.UUID, refund bool) error {
+	if err := uc.CheckPermission(orgID, userID, domain.PermissionOrgDelete); err != nil {
 		return err
 	}
 
-	// Only owner can delete organization
-	if org.OwnerID != userID {
-		return errors.New("only organization owner can delete the organization")
+	events, err := uc.eventRepo.GetByOrganizationID(orgID)
+	if err != nil {
+		return err
+	}
+
+	var liveEvents []*domain.Event
+	unsettled := make(map[uuid.UUID][]*domain.Payment)
+	unsettledCount := 0
+	for _, event := range events {
+		if event.Status == domain.EventPublished || event.Status == domain.EventOngoing {
+			liveEvents = append(liveEvents, event)
+		}
+
+		payments, err := uc.paymentRepo.GetByEventID(event.ID)
+		if err != nil {
+			return err
+		}
+		for _, p := range payments {
+			// Paid attendees of events that have not taken place are still owed their ticket
+			if p.Status == "pending" || (p.Status == "completed" && event.Status != "completed") {
+				unsettled[event.ID] = append(unsettled[event.ID], p)
+				unsettledCount++
+			}
+		}
+	}
+
+	if len(liveEvents) > 0 || unsettledCount > 0 {
+		if !refund {
+			return &OrganizationDeletionError{
+				LiveEvents:        len(liveEvents),
+				UnsettledPayments: unsettledCount,
+			}
+		}
+
+		refunds := &domain.CancellationProgress{}
+		for _, event := range events {
+			if event.Status == domain.EventCompleted {
+				// Completed events cannot be cancelled; only their pending payments are left to settle
+				if err := uc.cancelPendingPayments(ctx, unsettled[event.ID]); err != nil {
+					return err
+				}
+				continue
+			}
+			if len(unsettled[event.ID]) == 0 && event.Status != domain.EventPublished && event.Status != domain.EventOngoing {
+				continue
+			}
+
+			cancellation, err := uc.cancelEvent(ctx, event.ID, userID)
+			if err != nil {
+				return fmt.Errorf("failed to cancel event %s: %w", event.ID, err)
+			}
+			refunds.Total += cancellation.Progress.Total
+			refunds.Pending += cancellation.Progress.Pending
+			refunds.AwaitingConfirmation += cancellation.Progress.AwaitingConfirmation
+			refunds.Succeeded += cancellation.Progress.Succeeded
+			refunds.Failed += cancellation.Progress.Failed
+		}
+
+		if refunds.Pending > 0 || refunds.AwaitingConfirmation > 0 || refunds.Failed > 0 {
+			return &OrganizationDeletionError{Refunds: refunds}
+		}
+	}
+
+	return uc.orgRepo.Delete(ctx, orgID)
+}
+
+// cancelEvent starts the cancellation job of an event, or returns the one already started
+func (uc *organizationUseCase) cancelEvent(ctx context.Context, eventID, userID uuid.UUID) (*domain.EventCancellation, error) {
+	cancellation, err := uc.cancellation.GetCancellation(eventID, userID)
+	if err == nil {
+		return cancellation, nil
+	}
+	if !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	return uc.cancellation.CancelEvent(ctx, eventID, userID, "The organization was deleted")
+}
+
+// cancelPendingPayments cancels the pending payments of an event; no money was taken for them
+func (uc *organizationUseCase) cancelPendingPayments(ctx context.Context, payments []*domain.Payment) error {
+	for _, p := range payments {
+		if p.Status != "pending" {
+			continue
+		}
+		if err := uc.paymentRepo.UpdateStatus(ctx, p.ID, "cancelled", p.PaymentKey); err != nil {
+			return fmt.Errorf("failed to cancel payment %s: %w", p.ID, err)
+		}
+
+		cancelled := *p
+		cancelled.Status = "cancelled"
+		uc.webhooks.DispatchPayment(paymentWebhookEvents["cancelled"], &cancelled)
+	}
+
+	return nil
+}
+
+// DeactivateOrganization hides the organization and its events from public queries
+// and stops new sales (organization:delete, held only by the owner)
+func (uc *organizationUseCase) DeactivateOrganization(ctx context.Context, orgID, userID uuid.UUID) (*domain.Organization, error) {
+	return uc.setActive(ctx, orgID, userID, false)
+}
+
+// ReactivateOrganization reverses DeactivateOrganization (organization:delete, held only by the owner)
+func (uc *organizationUseCase) ReactivateOrganization(ctx context.Context, orgID, userID uuid.UUID) (*domain.Organization, error) {
+	return uc.setActive(ctx, orgID, userID, true)
+}
+
+func (uc *organizationUseCase) setActive(ctx context.Context, orgID, userID uuid.UUID, active bool) (*domain.Organization, error) {
+	if err := uc.CheckPermission(orgID, userID, domain.PermissionOrgDelete); err != nil {
+		return nil, err
+	}
+
+	org, err := uc.orgRepo.GetByID(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	if org.IsActive != active {
+		org.IsActive = active
+		org.UpdatedAt = time.Now()
+		if err := uc.orgRepo.Update(ctx, org); err != nil {
+			return nil, err
+		}
 	}
 
-	return uc.orgRepo.Delete(orgID)
+	return org, nil
 }
 
-// AddMember adds a member to an organization (admin only)
-func (uc *organizationUseCase) AddMember(orgID, userID, requesterID uuid.UUID, role string) error {
-	// Check if requester is admin
-	if err := uc.CheckAdminPermission(orgID, requesterID); err != nil {
+// AddMember adds a member to an organization (members:manage)
+func (uc *organizationUseCase) AddMember(ctx context.Context, orgID, userID, requesterID uuid.UUID, role string) error {
+	// Check if requester may manage members
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionMemberManage); err != nil {
 		return err
 	}
 
 	// Validate role
-	if role != "admin" && role != "member" {
-		return errors.New("invalid role: must be 'admin' or 'member'")
+	if err := uc.policy.RequireAssignableRole(requesterID, orgID, role); err != nil {
+		return err
 	}
 
 	// Check if user is already a member
@@ -137,13 +471,13 @@ func (uc *organizationUseCase) AddMember(orgID, userID, requesterID uuid.UUID, r
 		UpdatedAt:      time.Now(),
 	}
 
-	return uc.orgRepo.AddMember(member)
+	return uc.orgRepo.AddMember(ctx, member)
 }
 
-// RemoveMember removes a member from an organization (admin only)
-func (uc *organizationUseCase) RemoveMember(orgID, userID, requesterID uuid.UUID) error {
-	// Check if requester is admin
-	if err := uc.CheckAdminPermission(orgID, requesterID); err != nil {
+// RemoveMember removes a member from an organization (members:manage)
+func (uc *organizationUseCase) RemoveMember(ctx context.Context, orgID, userID, requesterID uuid.UUID) error {
+	// Check if requester may manage members
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionMemberManage); err != nil {
 		return err
 	}
 
@@ -155,27 +489,31 @@ func (uc *organizationUseCase) RemoveMember(orgID, userID, requesterID uuid.UUID
 
 	// Cannot remove owner
 	if org.OwnerID == userID {
-		return errors.New("cannot remove organization owner")
+		return permissionDenied("cannot remove organization owner")
 	}
 
-	return uc.orgRepo.RemoveMember(orgID, userID)
+	return uc.orgRepo.RemoveMember(ctx, orgID, userID)
 }
 
-// GetMembers retrieves all members of an organization (member only)
-func (uc *organizationUseCase) GetMembers(orgID uuid.UUID) ([]*domain.OrganizationMember, error) {
+// GetMembers retrieves all members of an organization (members:view)
+func (uc *organizationUseCase) GetMembers(orgID, requesterID uuid.UUID) ([]*domain.OrganizationMember, error) {
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionMemberView); err != nil {
+		return nil, err
+	}
+
 	return uc.orgRepo.GetMembersByOrgID(orgID)
 }
 
-// UpdateMemberRole updates a member's role (admin only)
-func (uc *organizationUseCase) UpdateMemberRole(orgID, userID, requesterID uuid.UUID, newRole string) error {
-	// Check if requester is admin
-	if err := uc.CheckAdminPermission(orgID, requesterID); err != nil {
+// UpdateMemberRole updates a member's role (members:manage)
+func (uc *organizationUseCase) UpdateMemberRole(ctx context.Context, orgID, userID, requesterID uuid.UUID, newRole string) error {
+	// Check if requester may manage members
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionMemberManage); err != nil {
 		return err
 	}
 
 	// Validate role
-	if newRole != "admin" && newRole != "member" {
-		return errors.New("invalid role: must be 'admin' or 'member'")
+	if err := uc.policy.RequireAssignableRole(requesterID, orgID, newRole); err != nil {
+		return err
 	}
 
 	// Get organization to check owner
@@ -186,36 +524,285 @@ func (uc *organizationUseCase) UpdateMemberRole(orgID, userID, requesterID uuid.
 
 	// Cannot change owner's role
 	if org.OwnerID == userID {
-		return errors.New("cannot change organization owner's role")
+		return permissionDenied("cannot change organization owner's role")
+	}
+
+	return uc.orgRepo.UpdateMemberRole(ctx, orgID, userID, newRole)
+}
+
+// GetRoles lists the built-in roles followed by the organization's custom roles (members:view)
+func (uc *organizationUseCase) GetRoles(orgID, requesterID uuid.UUID) ([]*domain.OrganizationRole, error) {
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionMemberView); err != nil {
+		return nil, err
+	}
+
+	custom, err := uc.orgRepo.GetRoles(orgID)
+	if err != nil {
+		return nil, err
+	}
+
+	builtInNames := []string{
+		domain.RoleOwner, domain.RoleAdmin, domain.RoleEventManager,
+		domain.RoleFinance, domain.RoleCheckinStaff, domain.RoleViewer,
+	}
+	roles := make([]*domain.OrganizationRole, 0, len(builtInNames)+len(custom))
+	for _, name := range builtInNames {
+		roles = append(roles, &domain.OrganizationRole{
+			Name:        name,
+			Permissions: domain.BuiltInRoles[name],
+			BuiltIn:     true,
+		})
 	}
 
-	return uc.orgRepo.UpdateMemberRole(orgID, userID, newRole)
+	return append(roles, custom...), nil
 }
 
-// CheckAdminPermission checks if a user is an admin of an organization
-func (uc *organizationUseCase) CheckAdminPermission(orgID, userID uuid.UUID) error {
-	isAdmin, err := uc.orgRepo.IsUserAdmin(orgID, userID)
+// CreateRole defines a custom role for an organization (roles:manage)
+func (uc *organizationUseCase) CreateRole(ctx context.Context, orgID, requesterID uuid.UUID, name, description string, permissions []domain.Permission) (*domain.OrganizationRole, error) {
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionRoleManage); err != nil {
+		return nil, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return nil, errors.New("role name is required")
+	}
+	if _, ok := domain.BuiltInRolePermissions(name); ok {
+		return nil, errors.New("role name is reserved by a built-in role")
+	}
+
+	permissions, err := uc.validatePermissions(orgID, requesterID, permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	role, err := uc.orgRepo.CreateRole(ctx, &domain.OrganizationRole{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		Name:           name,
+		Description:    description,
+		Permissions:    permissions,
+	})
+	if errors.Is(err, domain.ErrAlreadyExists) {
+		return nil, errors.New("role already exists")
+	}
+
+	return role, err
+}
+
+// UpdateRole replaces a custom role's description and permissions (roles:manage)
+func (uc *organizationUseCase) UpdateRole(ctx context.Context, orgID, requesterID uuid.UUID, name, description string, permissions []domain.Permission) (*domain.OrganizationRole, error) {
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionRoleManage); err != nil {
+		return nil, err
+	}
+
+	role, err := uc.orgRepo.GetRoleByName(orgID, name)
+	if err != nil {
+		return nil, err
+	}
+
+	permissions, err = uc.validatePermissions(orgID, requesterID, permissions)
 	if err != nil {
+		return nil, err
+	}
+
+	role.Description = description
+	role.Permissions = permissions
+	if err := uc.orgRepo.UpdateRole(ctx, role); err != nil {
+		return nil, err
+	}
+
+	return uc.orgRepo.GetRoleByName(orgID, name)
+}
+
+// DeleteRole deletes a custom role that is no longer assigned to any member (roles:manage)
+func (uc *organizationUseCase) DeleteRole(ctx context.Context, orgID, requesterID uuid.UUID, name string) error {
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionRoleManage); err != nil {
 		return err
 	}
 
-	if !isAdmin {
-		return errors.New("permission denied: admin role required")
+	role, err := uc.orgRepo.GetRoleByName(orgID, name)
+	if err != nil {
+		return err
 	}
 
-	return nil
+	count, err := uc.orgRepo.CountMembersWithRole(orgID, role.Name)
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return fmt.Errorf("role is assigned to %d member(s)", count)
+	}
+
+	return uc.orgRepo.DeleteRole(ctx, role.ID)
 }
 
-// CheckMemberPermission checks if a user is a member of an organization
-func (uc *organizationUseCase) CheckMemberPermission(orgID, userID uuid.UUID) error {
-	isMember, err := uc.orgRepo.IsUserMember(orgID, userID)
+// validatePermissions checks and de-duplicates the permissions of a custom role
+func (uc *organizationUseCase) validatePermissions(orgID, requesterID uuid.UUID, permissions []domain.Permission) ([]domain.Permission, error) {
+	if len(permissions) == 0 {
+		return nil, errors.New("at least one permission is required")
+	}
+
+	seen := make(map[domain.Permission]bool, len(permissions))
+	result := make([]domain.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		if !domain.IsValidPermission(p) {
+			return nil, fmt.Errorf("invalid permission: %s", p)
+		}
+		if p == domain.PermissionOrgDelete {
+			return nil, errors.New("organization:delete is reserved for the owner")
+		}
+		if seen[p] {
+			continue
+		}
+		seen[p] = true
+		result = append(result, p)
+	}
+
+	if err := uc.policy.RequireGrantable(requesterID, orgID, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// ProposeOwnershipTransfer proposes an admin as the new owner (owner only)
+// A new proposal replaces any pending one
+func (uc *organizationUseCase) ProposeOwnershipTransfer(ctx context.Context, orgID, requesterID, newOwnerID uuid.UUID) (*domain.OwnershipTransfer, error) {
+	org, err := uc.orgRepo.GetByID(orgID)
+	if err != nil {
+		return nil, err
+	}
+	if org.OwnerID != requesterID {
+		return nil, permissionDenied("only the organization owner can transfer ownership")
+	}
+	if newOwnerID == requesterID {
+		return nil, errors.New("you already own this organization")
+	}
+
+	member, err := uc.orgRepo.GetMember(orgID, newOwnerID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, errors.New("new owner must be a member of the organization")
+		}
+		return nil, err
+	}
+	if member.Role != domain.RoleAdmin {
+		return nil, errors.New("ownership can only be transferred to an admin")
+	}
+
+	pending, err := uc.transferRepo.GetPendingByOrgID(orgID)
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+	if pending != nil {
+		if err := uc.transferRepo.UpdateStatus(ctx, pending.ID, domain.TransferCancelled); err != nil && !errors.Is(err, domain.ErrNotFound) {
+			return nil, err
+		}
+	}
+
+	return uc.transferRepo.Create(ctx, &domain.OwnershipTransfer{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		FromUserID:     requesterID,
+		ToUserID:       newOwnerID,
+		Status:         domain.TransferPending,
+		ExpiresAt:      time.Now().Add(ownershipTransferTTL),
+	})
+}
+
+// GetOwnershipTransfer retrieves the pending ownership transfer (members:view)
+func (uc *organizationUseCase) GetOwnershipTransfer(orgID, requesterID uuid.UUID) (*domain.OwnershipTransfer, error) {
+	if err := uc.CheckPermission(orgID, requesterID, domain.PermissionMemberView); err != nil {
+		return nil, err
+	}
+
+	return uc.transferRepo.GetPendingByOrgID(orgID)
+}
+
+// CancelOwnershipTransfer withdraws the pending ownership transfer (owner only)
+func (uc *organizationUseCase) CancelOwnershipTransfer(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	transfer, err := uc.transferRepo.GetPendingByOrgID(orgID)
 	if err != nil {
 		return err
 	}
+	if transfer.FromUserID != requesterID {
+		return permissionDenied("only the owner who proposed the transfer can cancel it")
+	}
+
+	return uc.transferRepo.UpdateStatus(ctx, transfer.ID, domain.TransferCancelled)
+}
 
-	if !isMember {
-		return errors.New("permission denied: organization membership required")
+// AcceptOwnershipTransfer makes the proposed admin the owner and demotes the previous owner to admin
+func (uc *organizationUseCase) AcceptOwnershipTransfer(ctx context.Context, orgID, requesterID uuid.UUID) (*domain.Organization, error) {
+	transfer, err := uc.getTransferForRecipient(orgID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.IsExpired() {
+		return nil, errors.New("ownership transfer has expired")
 	}
 
-	return nil
+	// The proposal is only valid while both sides still hold the roles it was made for
+	org, err := uc.orgRepo.GetByID(orgID)
+	if err != nil {
+		return nil, err
+	}
+	if org.OwnerID != transfer.FromUserID {
+		return nil, errors.New("organization owner has changed since the transfer was proposed")
+	}
+	member, err := uc.orgRepo.GetMember(orgID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+	if member.Role != domain.RoleAdmin {
+		return nil, errors.New("only an admin can accept ownership")
+	}
+
+	if err := uc.transferRepo.Complete(ctx, transfer); err != nil {
+		return nil, err
+	}
+
+	return uc.orgRepo.GetByID(orgID)
+}
+
+// DeclineOwnershipTransfer rejects the pending ownership transfer (proposed owner only)
+func (uc *organizationUseCase) DeclineOwnershipTransfer(ctx context.Context, orgID, requesterID uuid.UUID) error {
+	transfer, err := uc.getTransferForRecipient(orgID, requesterID)
+	if err != nil {
+		return err
+	}
+
+	return uc.transferRepo.UpdateStatus(ctx, transfer.ID, domain.TransferDeclined)
+}
+
+// getTransferForRecipient loads the pending transfer proposed to the requester
+func (uc *organizationUseCase) getTransferForRecipient(orgID, requesterID uuid.UUID) (*domain.OwnershipTransfer, error) {
+	transfer, err := uc.transferRepo.GetPendingByOrgID(orgID)
+	if err != nil {
+		return nil, err
+	}
+	if transfer.ToUserID != requesterID {
+		return nil, permissionDenied("ownership transfer was proposed to another member")
+	}
+
+	return transfer, nil
+}
+
+// CheckPermission checks if a user holds a permission in an organization
+func (uc *organizationUseCase) CheckPermission(orgID, userID uuid.UUID, permission domain.Permission) error {
+	return uc.policy.Require(userID, orgID, permission)
+}
+
+// GetMyPermissions lists the permissions a user holds in an organization
+func (uc *organizationUseCase) GetMyPermissions(orgID, userID uuid.UUID) ([]domain.Permission, error) {
+	permissions, err := uc.policy.Permissions(userID, orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(permissions) == 0 {
+		return nil, permissionDenied("organization membership required")
+	}
+
+	return permissions, nil
 }
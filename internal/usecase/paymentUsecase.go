@@ -1,25 +1,27 @@
 package usecase
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"time"
 
 	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
-	"github.com/dev-hyunsang/ticketly-backend/internal/repository/mysql"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
 	"github.com/google/uuid"
 )
 
 type PaymentUseCase interface {
-	CreatePayment(req CreatePaymentRequest, userID *uuid.UUID) (*domain.Payment, error)
-	GetPaymentByID(paymentID uuid.UUID) (*domain.Payment, error)
-	GetPaymentByOrderID(orderID string) (*domain.Payment, error)
+	CreatePayment(ctx context.Context, req CreatePaymentRequest, userID *uuid.UUID) (*domain.Payment, error)
+	GetPaymentByID(paymentID, requesterID uuid.UUID) (*domain.Payment, error)
+	GetPaymentByOrderID(orderID string, requesterID uuid.UUID) (*domain.Payment, error)
 	GetUserPayments(userID uuid.UUID) ([]*domain.Payment, error)
-	GetEventPayments(eventID uuid.UUID) ([]*domain.Payment, error)
-	GetEventAttendees(eventID uuid.UUID) ([]*domain.Attendee, error)
-	UpdatePaymentStatus(paymentID uuid.UUID, status string, paymentKey string) error
-	CompletePayment(orderID string, paymentKey string) (*domain.Payment, error)
-	CancelPayment(paymentID uuid.UUID, userID *uuid.UUID) (*domain.Payment, error)
+	GetEventPayments(eventID, requesterID uuid.UUID) ([]*domain.Payment, error)
+	GetEventAttendees(eventID, requesterID uuid.UUID) ([]*domain.Attendee, error)
+	UpdatePaymentStatus(ctx context.Context, paymentID uuid.UUID, status string, paymentKey string) error
+	CompletePayment(ctx context.Context, orderID string, paymentKey string) (*domain.Payment, error)
+	CancelPayment(ctx context.Context, paymentID, userID uuid.UUID) (*domain.Payment, error)
+	RefundForDateChange(ctx context.Context, paymentID, userID uuid.UUID) (*domain.Payment, error)
 }
 
 type CreatePaymentRequest struct {
@@ -30,21 +32,35 @@ type CreatePaymentRequest struct {
 	BuyerName      string    `json:"buyer_name"`
 	BuyerEmail     string    `json:"buyer_email"`
 	BuyerPhone     string    `json:"buyer_phone"`
+
+	Answers []RegistrationAnswerInput `json:"answers"`
 }
 
 type paymentUseCase struct {
-	paymentRepo *mysql.PaymentRepository
-	eventRepo   domain.EventRepository
+	paymentRepo      domain.PaymentRepository
+	eventRepo        domain.EventRepository
+	registrationRepo domain.RegistrationRepository
+	changeRepo       domain.EventChangeRepository
+	policy           AuthorizationPolicy
+	gateway          util.PaymentGateway
+	webhooks         WebhookDispatcher
+	popularity       PopularityUseCase
 }
 
-func NewPaymentUseCase(paymentRepo *mysql.PaymentRepository, eventRepo domain.EventRepository) PaymentUseCase {
+func NewPaymentUseCase(paymentRepo domain.PaymentRepository, eventRepo domain.EventRepository, registrationRepo domain.RegistrationRepository, changeRepo domain.EventChangeRepository, policy AuthorizationPolicy, gateway util.PaymentGateway, webhooks WebhookDispatcher, popularity PopularityUseCase) PaymentUseCase {
 	return &paymentUseCase{
-		paymentRepo: paymentRepo,
-		eventRepo:   eventRepo,
+		paymentRepo:      paymentRepo,
+		eventRepo:        eventRepo,
+		registrationRepo: registrationRepo,
+		changeRepo:       changeRepo,
+		policy:           policy,
+		gateway:          gateway,
+		webhooks:         webhooks,
+		popularity:       popularity,
 	}
 }
 
-func (uc *paymentUseCase) CreatePayment(req CreatePaymentRequest, userID *uuid.UUID) (*domain.Payment, error) {
+func (uc *paymentUseCase) CreatePayment(ctx context.Context, req CreatePaymentRequest, userID *uuid.UUID) (*domain.Payment, error) {
 	// Validate required fields
 	if req.EventID == uuid.Nil {
 		return nil, errors.New("event ID is required")
@@ -74,16 +90,52 @@ func (uc *paymentUseCase) CreatePayment(req CreatePaymentRequest, userID *uuid.U
 		return nil, fmt.Errorf("event not found: %w", err)
 	}
 
+	// Private events are only open to those allowed to see them; guests only see public ones
+	viewerID := uuid.Nil
+	if userID != nil {
+		viewerID = *userID
+	}
+	if err := uc.policy.CanViewEvent(event, viewerID); err != nil {
+		return nil, err
+	}
+
+	// Deactivated organizations cannot sell tickets
+	if err := uc.policy.RequireActiveOrganization(event.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	// Tickets are only sold once an event is published and until it ends
+	if event.Status != domain.EventPublished && event.Status != domain.EventOngoing {
+		return nil, fmt.Errorf("cannot buy tickets for a %s event", event.Status)
+	}
+
+	// Free events are reserved through RSVP instead of the payment gateway
+	if event.TicketPrice == 0 {
+		return nil, errors.New("free events must be reserved through RSVP")
+	}
+
 	// Check if enough tickets are available
 	if event.AvailableTickets < req.TicketQuantity {
 		return nil, errors.New("not enough tickets available")
 	}
 
+	// Validate answers to the event's registration questions
+	questions, err := uc.registrationRepo.GetQuestionsByEventID(req.EventID)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentID := uuid.New()
+	answers, err := buildRegistrationAnswers(questions, req.TicketQuantity, req.Answers, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
 	// Generate order ID
 	orderID := fmt.Sprintf("ORDER-%s", uuid.New().String()[:8])
 
 	payment := &domain.Payment{
-		ID:             uuid.New(),
+		ID:             paymentID,
 		EventID:        req.EventID,
 		UserID:         userID,
 		EventTitle:     req.EventTitle,
@@ -95,36 +147,93 @@ func (uc *paymentUseCase) CreatePayment(req CreatePaymentRequest, userID *uuid.U
 		BuyerPhone:     req.BuyerPhone,
 		OrderID:        orderID,
 		Status:         "pending",
+		Channel:        "online",
+		PaymentMethod:  "gateway",
 		CreatedAt:      time.Now(),
 		UpdatedAt:      time.Now(),
 	}
 
-	return uc.paymentRepo.Create(payment)
+	created, err := uc.paymentRepo.Create(ctx, payment)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.registrationRepo.SaveAnswers(answers); err != nil {
+		// Mark payment as failed so it cannot be completed without its answers
+		_ = uc.paymentRepo.UpdateStatus(ctx, created.ID, "failed", "")
+		return nil, err
+	}
+
+	return created, nil
 }
 
-func (uc *paymentUseCase) GetPaymentByID(paymentID uuid.UUID) (*domain.Payment, error) {
-	return uc.paymentRepo.GetByID(paymentID)
+// GetPaymentByID retrieves a payment (buyer or payments:view)
+func (uc *paymentUseCase) GetPaymentByID(paymentID, requesterID uuid.UUID) (*domain.Payment, error) {
+	payment, err := uc.paymentRepo.GetByID(paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.RequirePaymentAccess(payment, requesterID, domain.PermissionPaymentView); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
 }
 
-func (uc *paymentUseCase) GetPaymentByOrderID(orderID string) (*domain.Payment, error) {
-	return uc.paymentRepo.GetByOrderID(orderID)
+// GetPaymentByOrderID retrieves a payment by order ID (buyer or payments:view)
+func (uc *paymentUseCase) GetPaymentByOrderID(orderID string, requesterID uuid.UUID) (*domain.Payment, error) {
+	payment, err := uc.paymentRepo.GetByOrderID(orderID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.RequirePaymentAccess(payment, requesterID, domain.PermissionPaymentView); err != nil {
+		return nil, err
+	}
+
+	return payment, nil
 }
 
 func (uc *paymentUseCase) GetUserPayments(userID uuid.UUID) ([]*domain.Payment, error) {
 	return uc.paymentRepo.GetByUserID(userID)
 }
 
-func (uc *paymentUseCase) GetEventPayments(eventID uuid.UUID) ([]*domain.Payment, error) {
+// GetEventPayments retrieves all payments for an event (payments:view)
+func (uc *paymentUseCase) GetEventPayments(eventID, requesterID uuid.UUID) ([]*domain.Payment, error) {
+	if _, err := uc.policy.RequireForEvent(requesterID, eventID, domain.PermissionPaymentView); err != nil {
+		return nil, err
+	}
+
 	return uc.paymentRepo.GetByEventID(eventID)
 }
 
-func (uc *paymentUseCase) GetEventAttendees(eventID uuid.UUID) ([]*domain.Attendee, error) {
+// GetEventAttendees retrieves the attendee list for an event (attendees:view)
+func (uc *paymentUseCase) GetEventAttendees(eventID, requesterID uuid.UUID) ([]*domain.Attendee, error) {
+	if _, err := uc.policy.RequireForEvent(requesterID, eventID, domain.PermissionAttendeeView); err != nil {
+		return nil, err
+	}
+
 	// Get completed payments only
 	payments, err := uc.paymentRepo.GetCompletedPaymentsByEventID(eventID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get attendees: %w", err)
 	}
 
+	// Group registration answers by payment
+	paymentIDs := make([]uuid.UUID, len(payments))
+	for i, p := range payments {
+		paymentIDs[i] = p.ID
+	}
+	answers, err := uc.registrationRepo.GetAnswersByPaymentIDs(paymentIDs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get attendee answers: %w", err)
+	}
+	answersByPayment := make(map[uuid.UUID][]*domain.RegistrationAnswer)
+	for _, a := range answers {
+		answersByPayment[a.PaymentID] = append(answersByPayment[a.PaymentID], a)
+	}
+
 	// Convert payments to attendees
 	attendees := make([]*domain.Attendee, len(payments))
 	for i, p := range payments {
@@ -138,6 +247,9 @@ func (uc *paymentUseCase) GetEventAttendees(eventID uuid.UUID) ([]*domain.Attend
 			TotalPrice:     p.TotalPrice,
 			Currency:       p.Currency,
 			OrderID:        p.OrderID,
+			Channel:        p.Channel,
+			CheckedInAt:    p.CheckedInAt,
+			Answers:        answersByPayment[p.ID],
 			PurchasedAt:    p.CreatedAt,
 		}
 	}
@@ -145,7 +257,7 @@ func (uc *paymentUseCase) GetEventAttendees(eventID uuid.UUID) ([]*domain.Attend
 	return attendees, nil
 }
 
-func (uc *paymentUseCase) UpdatePaymentStatus(paymentID uuid.UUID, status string, paymentKey string) error {
+func (uc *paymentUseCase) UpdatePaymentStatus(ctx context.Context, paymentID uuid.UUID, status string, paymentKey string) error {
 	validStatuses := map[string]bool{
 		"pending": true, "completed": true, "failed": true, "cancelled": true, "refunded": true,
 	}
@@ -153,10 +265,23 @@ func (uc *paymentUseCase) UpdatePaymentStatus(paymentID uuid.UUID, status string
 		return errors.New("invalid payment status")
 	}
 
-	return uc.paymentRepo.UpdateStatus(paymentID, status, paymentKey)
+	if err := uc.paymentRepo.UpdateStatus(ctx, paymentID, status, paymentKey); err != nil {
+		return err
+	}
+
+	if eventType, ok := paymentWebhookEvents[status]; ok {
+		payment, err := uc.paymentRepo.GetByID(paymentID)
+		if err != nil {
+			fmt.Printf("Warning: failed to get payment for webhook: %v\n", err)
+			return nil
+		}
+		uc.webhooks.DispatchPayment(eventType, payment)
+	}
+
+	return nil
 }
 
-func (uc *paymentUseCase) CompletePayment(orderID string, paymentKey string) (*domain.Payment, error) {
+func (uc *paymentUseCase) CompletePayment(ctx context.Context, orderID string, paymentKey string) (*domain.Payment, error) {
 	// Get payment by order ID
 	payment, err := uc.paymentRepo.GetByOrderID(orderID)
 	if err != nil {
@@ -168,8 +293,22 @@ func (uc *paymentUseCase) CompletePayment(orderID string, paymentKey string) (*d
 		return nil, errors.New("payment is not in pending status")
 	}
 
+	// Only online payments go through the payment gateway
+	if payment.Channel != "online" {
+		return nil, errors.New("payment cannot be completed through the payment gateway")
+	}
+
+	// Cancelling an event stops its sales; its pending payments are settled by the cancellation
+	event, err := uc.eventRepo.GetByID(payment.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.Status == domain.EventCancelled {
+		return nil, fmt.Errorf("%w: event has been cancelled", domain.ErrConflict)
+	}
+
 	// Update payment status to completed
-	err = uc.paymentRepo.UpdateStatus(payment.ID, "completed", paymentKey)
+	err = uc.paymentRepo.UpdateStatus(ctx, payment.ID, "completed", paymentKey)
 	if err != nil {
 		return nil, fmt.Errorf("failed to update payment status: %w", err)
 	}
@@ -178,7 +317,7 @@ func (uc *paymentUseCase) CompletePayment(orderID string, paymentKey string) (*d
 	err = uc.eventRepo.UpdateAvailableTickets(payment.EventID, -payment.TicketQuantity)
 	if err != nil {
 		// Rollback payment status if ticket update fails
-		_ = uc.paymentRepo.UpdateStatus(payment.ID, "failed", paymentKey)
+		_ = uc.paymentRepo.UpdateStatus(ctx, payment.ID, "failed", paymentKey)
 		return nil, fmt.Errorf("failed to reserve tickets: %w", err)
 	}
 
@@ -195,20 +334,29 @@ func (uc *paymentUseCase) CompletePayment(orderID string, paymentKey string) (*d
 		}
 	}
 
+	uc.popularity.RecordTicketSale(payment.EventID, payment.TicketQuantity)
+
 	// Get updated payment
-	return uc.paymentRepo.GetByID(payment.ID)
+	updated, err := uc.paymentRepo.GetByID(payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.webhooks.DispatchPayment(domain.WebhookOrderCompleted, updated)
+
+	return updated, nil
 }
 
-func (uc *paymentUseCase) CancelPayment(paymentID uuid.UUID, userID *uuid.UUID) (*domain.Payment, error) {
+func (uc *paymentUseCase) CancelPayment(ctx context.Context, paymentID, userID uuid.UUID) (*domain.Payment, error) {
 	// Get payment
 	payment, err := uc.paymentRepo.GetByID(paymentID)
 	if err != nil {
 		return nil, fmt.Errorf("payment not found: %w", err)
 	}
 
-	// Check if user has permission to cancel (only the buyer can cancel)
-	if userID != nil && payment.UserID != nil && *userID != *payment.UserID {
-		return nil, errors.New("permission denied: you can only cancel your own payments")
+	// Check if user has permission to cancel (the buyer or a member with payments:refund)
+	if err := uc.policy.RequirePaymentAccess(payment, userID, domain.PermissionPaymentRefund); err != nil {
+		return nil, err
 	}
 
 	// Check if payment can be cancelled (only completed or pending status)
@@ -216,6 +364,15 @@ func (uc *paymentUseCase) CancelPayment(paymentID uuid.UUID, userID *uuid.UUID)
 		return nil, fmt.Errorf("cannot cancel payment with status: %s", payment.Status)
 	}
 
+	// Paid online orders are refunded through the gateway before they are marked; the payment ID keeps retries from refunding twice
+	status := "cancelled"
+	if refundMethod(payment) == domain.RefundGateway {
+		if err := uc.gateway.CancelPayment(payment.PaymentKey, "주문 취소", payment.ID.String()); err != nil {
+			return nil, fmt.Errorf("failed to refund payment: %w", err)
+		}
+		status = "refunded"
+	}
+
 	// If payment was completed, restore tickets
 	if payment.Status == "completed" {
 		err = uc.eventRepo.UpdateAvailableTickets(payment.EventID, payment.TicketQuantity)
@@ -224,8 +381,8 @@ func (uc *paymentUseCase) CancelPayment(paymentID uuid.UUID, userID *uuid.UUID)
 		}
 	}
 
-	// Update payment status to cancelled
-	err = uc.paymentRepo.UpdateStatus(payment.ID, "cancelled", "")
+	// Update payment status to cancelled, or refunded once the gateway returned the money
+	err = uc.paymentRepo.UpdateStatus(ctx, payment.ID, status, "")
 	if err != nil {
 		// Rollback ticket restoration if status update fails
 		if payment.Status == "completed" {
@@ -250,5 +407,81 @@ func (uc *paymentUseCase) CancelPayment(paymentID uuid.UUID, userID *uuid.UUID)
 	}
 
 	// Get updated payment
-	return uc.paymentRepo.GetByID(payment.ID)
+	updated, err := uc.paymentRepo.GetByID(payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.webhooks.DispatchPayment(paymentWebhookEvents[status], updated)
+
+	return updated, nil
+}
+
+// RefundForDateChange fully refunds an online order bought before the event date moved (buyer only)
+// It is available until the event's date change refund window closes
+func (uc *paymentUseCase) RefundForDateChange(ctx context.Context, paymentID, userID uuid.UUID) (*domain.Payment, error) {
+	payment, err := uc.paymentRepo.GetByID(paymentID)
+	if err != nil {
+		return nil, fmt.Errorf("payment not found: %w", err)
+	}
+	if payment.UserID == nil || *payment.UserID != userID {
+		return nil, domain.ErrPermissionDenied
+	}
+
+	if payment.Status != "completed" {
+		return nil, fmt.Errorf("cannot refund payment with status: %s", payment.Status)
+	}
+	if payment.Channel != "online" || payment.TotalPrice == 0 {
+		return nil, errors.New("only online purchases are refunded through the payment gateway")
+	}
+
+	event, err := uc.eventRepo.GetByID(payment.EventID)
+	if err != nil {
+		return nil, err
+	}
+	if event.DateChangeRefundUntil == nil || !time.Now().Before(*event.DateChangeRefundUntil) {
+		return nil, errors.New("no date change refund window is open for this event")
+	}
+
+	// Orders placed after the date moved were bought knowing the new date
+	changes, err := uc.changeRepo.GetByEventID(event.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, change := range changes {
+		if _, ok := change.After[domain.EventFieldStartTime]; ok {
+			if !payment.CreatedAt.Before(change.CreatedAt) {
+				return nil, errors.New("orders placed after the date change cannot be refunded this way")
+			}
+			break
+		}
+	}
+
+	if err := uc.gateway.CancelPayment(payment.PaymentKey, "행사 일정 변경에 따른 환불", payment.ID.String()); err != nil {
+		return nil, fmt.Errorf("failed to refund payment: %w", err)
+	}
+
+	if err := uc.paymentRepo.UpdateStatus(ctx, payment.ID, "refunded", payment.PaymentKey); err != nil {
+		return nil, fmt.Errorf("failed to update payment status: %w", err)
+	}
+
+	// Refunded tickets go back on sale
+	if err := uc.eventRepo.UpdateAvailableTickets(payment.EventID, payment.TicketQuantity); err != nil {
+		fmt.Printf("Warning: failed to restore tickets: %v\n", err)
+	}
+	participantCount, err := uc.paymentRepo.GetParticipantCountByEventID(payment.EventID)
+	if err != nil {
+		fmt.Printf("Warning: failed to get participant count: %v\n", err)
+	} else if err := uc.eventRepo.UpdateParticipantCount(payment.EventID, participantCount); err != nil {
+		fmt.Printf("Warning: failed to update participant count in DB: %v\n", err)
+	}
+
+	updated, err := uc.paymentRepo.GetByID(payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.webhooks.DispatchPayment(domain.WebhookOrderRefunded, updated)
+
+	return updated, nil
 }
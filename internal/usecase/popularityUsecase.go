@@ -0,0 +1,244 @@
+package usecase
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	// Weights of engagement signals in the popularity ranking
+	popularityViewWeight     = 1.0
+	popularityFavoriteWeight = 5.0
+	popularityTicketWeight   = 3.0 // Per ticket sold, so sales velocity is the decayed number of tickets
+	// Newly published events start with this weight, so they can rank before anyone engages with them
+	popularityPublishWeight = 30.0
+
+	// Repeated views of an event by the same visitor count once in this window
+	popularityViewWindow = time.Hour
+
+	// Ranked events are matched against the listing filters in batches, scanning at most maxPopularScan per page
+	popularBatchSize = 100
+	maxPopularScan   = 1000
+)
+
+type PopularityUseCase interface {
+	// Engagement signals; failures are only logged, since they must not fail the action that caused them
+	// viewer identifies a visitor, such as their IP address
+	RecordView(event *domain.Event, viewer string)
+	RecordTicketSale(eventID uuid.UUID, tickets int)
+	RecordPublish(eventID uuid.UUID)
+	RecordFavorite(eventID uuid.UUID, favoritedAt time.Time)
+	RemoveFavorite(eventID uuid.UUID, favoritedAt time.Time)
+
+	// GetPopularEvents returns one page of the ranking among events matching an already validated query
+	GetPopularEvents(query domain.EventListQuery) (*EventPage, error)
+
+	// BackfillPopularity seeds an empty ranking with the events already on sale
+	BackfillPopularity() error
+}
+
+type popularityUseCase struct {
+	ranking   domain.PopularityRanking
+	eventRepo domain.EventRepository
+}
+
+func NewPopularityUseCase(ranking domain.PopularityRanking, eventRepo domain.EventRepository) PopularityUseCase {
+	return &popularityUseCase{
+		ranking:   ranking,
+		eventRepo: eventRepo,
+	}
+}
+
+// RecordView counts a visitor's view of an event that is on sale
+func (uc *popularityUseCase) RecordView(event *domain.Event, viewer string) {
+	now := time.Now()
+	if !event.IsPublic || event.Status != domain.EventPublished || !event.StartTime.After(now) {
+		return
+	}
+
+	first, err := uc.ranking.FirstView(event.ID, viewer, popularityViewWindow)
+	if err != nil {
+		fmt.Printf("Warning: failed to record event view: %v\n", err)
+		return
+	}
+	if first {
+		uc.add(event.ID, popularityViewWeight, now)
+	}
+}
+
+// RecordTicketSale counts tickets sold for an event
+func (uc *popularityUseCase) RecordTicketSale(eventID uuid.UUID, tickets int) {
+	if tickets <= 0 {
+		return
+	}
+	if uc.isUpcoming(eventID) {
+		uc.add(eventID, popularityTicketWeight*float64(tickets), time.Now())
+	}
+}
+
+// RecordPublish gives a newly published event its recency boost
+func (uc *popularityUseCase) RecordPublish(eventID uuid.UUID) {
+	if uc.isUpcoming(eventID) {
+		uc.add(eventID, popularityPublishWeight, time.Now())
+	}
+}
+
+// RecordFavorite counts a favorite made at favoritedAt
+func (uc *popularityUseCase) RecordFavorite(eventID uuid.UUID, favoritedAt time.Time) {
+	if uc.isUpcoming(eventID) {
+		uc.add(eventID, popularityFavoriteWeight, favoritedAt)
+	}
+}
+
+// RemoveFavorite takes back a favorite made at favoritedAt, so favoriting over and over adds nothing
+func (uc *popularityUseCase) RemoveFavorite(eventID uuid.UUID, favoritedAt time.Time) {
+	uc.add(eventID, -popularityFavoriteWeight, favoritedAt)
+}
+
+// BackfillPopularity gives every public event on sale its publish weight as of when it was published,
+// so events published before the ranking existed, or before Redis lost it, are listed without waiting for engagement
+// A ranking with any event in it is left alone, so running it at every start adds nothing twice
+func (uc *popularityUseCase) BackfillPopularity() error {
+	size, err := uc.ranking.Size()
+	if err != nil {
+		return err
+	}
+	if size > 0 {
+		return nil
+	}
+
+	events, err := uc.eventRepo.GetEventsByStatus(domain.EventPublished)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, event := range events {
+		if !event.IsPublic || !event.StartTime.After(now) {
+			continue
+		}
+		// Scheduled events were published at their publish time; the rest are dated by creation
+		publishedAt := event.CreatedAt
+		if event.PublishAt != nil && event.PublishAt.Before(now) {
+			publishedAt = *event.PublishAt
+		}
+		if err := uc.ranking.Add(event.ID, popularityPublishWeight, publishedAt); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isUpcoming reports whether an event can still be ranked; started and deleted events are left out
+func (uc *popularityUseCase) isUpcoming(eventID uuid.UUID) bool {
+	ids, err := uc.eventRepo.GetUpcomingPublicEventIDs([]uuid.UUID{eventID})
+	if err != nil {
+		fmt.Printf("Warning: failed to check event for popularity ranking: %v\n", err)
+		return false
+	}
+	return len(ids) > 0
+}
+
+func (uc *popularityUseCase) add(eventID uuid.UUID, weight float64, at time.Time) {
+	if err := uc.ranking.Add(eventID, weight, at); err != nil {
+		fmt.Printf("Warning: failed to update event popularity: %v\n", err)
+	}
+}
+
+// GetPopularEvents walks the ranking from the cursor, keeping the events that match the query
+// Events that started, ended or were deleted are dropped from the ranking along the way
+// The cursor is a position in the ranking, so events moving up or down between requests may repeat or be skipped
+func (uc *popularityUseCase) GetPopularEvents(query domain.EventListQuery) (*EventPage, error) {
+	offset := 0
+	if query.Cursor != "" {
+		var err error
+		if offset, err = strconv.Atoi(query.Cursor); err != nil || offset <= 0 {
+			return nil, fmt.Errorf("%w: malformed cursor", domain.ErrInvalidInput)
+		}
+	}
+
+	events := make([]*domain.EventWithOrganization, 0, query.Limit)
+	more := true
+	for scanned := 0; len(events) < query.Limit && scanned < maxPopularScan; {
+		ids, err := uc.ranking.Page(offset, popularBatchSize)
+		if err != nil {
+			return nil, err
+		}
+
+		matched, err := uc.eventRepo.GetPublicEventsByIDs(ids, query)
+		if err != nil {
+			return nil, err
+		}
+		byID := make(map[uuid.UUID]*domain.EventWithOrganization, len(matched))
+		for _, event := range matched {
+			byID[event.ID] = event
+		}
+
+		gone := make(map[uuid.UUID]bool)
+		if len(matched) < len(ids) {
+			gone, err = uc.goneEvents(ids)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		// Events dropped before the resume point shift the rest of the ranking up
+		read, dropped := 0, 0
+		for _, id := range ids {
+			read++
+			if gone[id] {
+				dropped++
+			}
+			if event, ok := byID[id]; ok {
+				events = append(events, event)
+				if len(events) == query.Limit {
+					break
+				}
+			}
+		}
+		offset += read - dropped
+		scanned += read
+
+		if len(ids) < popularBatchSize && read == len(ids) {
+			more = false
+			break
+		}
+	}
+
+	page := &EventPage{Events: events}
+	if more {
+		page.NextCursor = strconv.Itoa(offset)
+	}
+	return page, nil
+}
+
+// goneEvents removes the events among ids that can no longer be ranked and returns them
+func (uc *popularityUseCase) goneEvents(ids []uuid.UUID) (map[uuid.UUID]bool, error) {
+	upcoming, err := uc.eventRepo.GetUpcomingPublicEventIDs(ids)
+	if err != nil {
+		return nil, err
+	}
+	keep := make(map[uuid.UUID]bool, len(upcoming))
+	for _, id := range upcoming {
+		keep[id] = true
+	}
+
+	gone := make(map[uuid.UUID]bool)
+	remove := make([]uuid.UUID, 0)
+	for _, id := range ids {
+		if !keep[id] {
+			gone[id] = true
+			remove = append(remove, id)
+		}
+	}
+	if err := uc.ranking.Remove(remove); err != nil {
+		return nil, err
+	}
+
+	return gone, nil
+}
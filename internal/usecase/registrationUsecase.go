@@ -0,0 +1,241 @@
+package usecase
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+const maxTextAnswerLength = 1000
+
+type RegistrationUseCase interface {
+	// GetForm shows the form to whoever can view the event; userID is uuid.Nil for anonymous callers
+	GetForm(eventID, userID uuid.UUID) ([]*domain.RegistrationQuestion, error)
+	UpdateForm(eventID, userID uuid.UUID, req UpdateRegistrationFormRequest) ([]*domain.RegistrationQuestion, error)
+}
+
+type RegistrationQuestionInput struct {
+	ID       uuid.UUID `json:"id"` // Omit to add a new question
+	Label    string    `json:"label"`
+	Type     string    `json:"type"` // text, select, multi_select, checkbox, date
+	Options  []string  `json:"options"`
+	Required bool      `json:"required"`
+}
+
+type UpdateRegistrationFormRequest struct {
+	Questions []RegistrationQuestionInput `json:"questions"`
+}
+
+// RegistrationAnswerInput is a submitted answer for one ticket of an order
+type RegistrationAnswerInput struct {
+	TicketIndex int       `json:"ticket_index"`
+	QuestionID  uuid.UUID `json:"question_id"`
+	Values      []string  `json:"values"`
+}
+
+type registrationUseCase struct {
+	registrationRepo domain.RegistrationRepository
+	eventRepo        domain.EventRepository
+	policy           AuthorizationPolicy
+}
+
+func NewRegistrationUseCase(registrationRepo domain.RegistrationRepository, eventRepo domain.EventRepository, policy AuthorizationPolicy) RegistrationUseCase {
+	return &registrationUseCase{
+		registrationRepo: registrationRepo,
+		eventRepo:        eventRepo,
+		policy:           policy,
+	}
+}
+
+// GetForm retrieves an event's registration questions if the user can view the event
+func (uc *registrationUseCase) GetForm(eventID, userID uuid.UUID) ([]*domain.RegistrationQuestion, error) {
+	event, err := uc.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.policy.CanViewEvent(event, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.registrationRepo.GetQuestionsByEventID(eventID)
+}
+
+// UpdateForm replaces an event's registration questions (registration:manage)
+func (uc *registrationUseCase) UpdateForm(eventID, userID uuid.UUID, req UpdateRegistrationFormRequest) ([]*domain.RegistrationQuestion, error) {
+	if _, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionRegistrationManage); err != nil {
+		return nil, err
+	}
+
+	validTypes := map[string]bool{
+		"text": true, "select": true, "multi_select": true, "checkbox": true, "date": true,
+	}
+
+	questions := make([]*domain.RegistrationQuestion, len(req.Questions))
+	seen := make(map[uuid.UUID]bool)
+	for i, input := range req.Questions {
+		label := strings.TrimSpace(input.Label)
+		if label == "" {
+			return nil, fmt.Errorf("question %d: label is required", i+1)
+		}
+		if !validTypes[input.Type] {
+			return nil, fmt.Errorf("question %d: invalid type %q", i+1, input.Type)
+		}
+
+		var options []string
+		if input.Type == "select" || input.Type == "multi_select" {
+			optionSeen := make(map[string]bool)
+			for _, option := range input.Options {
+				option = strings.TrimSpace(option)
+				if option == "" || optionSeen[option] {
+					continue
+				}
+				optionSeen[option] = true
+				options = append(options, option)
+			}
+			if len(options) == 0 {
+				return nil, fmt.Errorf("question %d: options are required for %s questions", i+1, input.Type)
+			}
+		}
+
+		id := input.ID
+		if id == uuid.Nil {
+			id = uuid.New()
+		}
+		if seen[id] {
+			return nil, fmt.Errorf("question %d: duplicate question ID", i+1)
+		}
+		seen[id] = true
+
+		questions[i] = &domain.RegistrationQuestion{
+			ID:       id,
+			EventID:  eventID,
+			Label:    label,
+			Type:     input.Type,
+			Options:  options,
+			Required: input.Required,
+			Position: i,
+		}
+	}
+
+	return uc.registrationRepo.ReplaceQuestions(eventID, questions)
+}
+
+// buildRegistrationAnswers validates submitted answers against an event's form
+// and returns them ready to be stored for the given payment
+func buildRegistrationAnswers(questions []*domain.RegistrationQuestion, ticketQuantity int, inputs []RegistrationAnswerInput, paymentID uuid.UUID) ([]*domain.RegistrationAnswer, error) {
+	byID := make(map[uuid.UUID]*domain.RegistrationQuestion, len(questions))
+	for _, q := range questions {
+		byID[q.ID] = q
+	}
+
+	type answerKey struct {
+		questionID  uuid.UUID
+		ticketIndex int
+	}
+	answered := make(map[answerKey]bool)
+
+	answers := make([]*domain.RegistrationAnswer, 0, len(inputs))
+	for _, input := range inputs {
+		question, ok := byID[input.QuestionID]
+		if !ok {
+			return nil, fmt.Errorf("unknown registration question: %s", input.QuestionID)
+		}
+		if input.TicketIndex < 0 || input.TicketIndex >= ticketQuantity {
+			return nil, fmt.Errorf("%s: ticket index out of range", question.Label)
+		}
+
+		key := answerKey{question.ID, input.TicketIndex}
+		if answered[key] {
+			return nil, fmt.Errorf("%s: duplicate answer for ticket %d", question.Label, input.TicketIndex+1)
+		}
+
+		values, err := validateAnswerValues(question, input.Values)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) == 0 {
+			continue
+		}
+		if question.Required && question.Type == "checkbox" && values[0] != "true" {
+			continue
+		}
+		answered[key] = true
+
+		answers = append(answers, &domain.RegistrationAnswer{
+			ID:            uuid.New(),
+			PaymentID:     paymentID,
+			TicketIndex:   input.TicketIndex,
+			QuestionID:    question.ID,
+			QuestionLabel: question.Label,
+			Values:        values,
+			CreatedAt:     time.Now(),
+		})
+	}
+
+	// Every ticket must answer every required question
+	for _, q := range questions {
+		if !q.Required {
+			continue
+		}
+		for i := 0; i < ticketQuantity; i++ {
+			if !answered[answerKey{q.ID, i}] {
+				return nil, fmt.Errorf("%s: answer is required for ticket %d", q.Label, i+1)
+			}
+		}
+	}
+
+	return answers, nil
+}
+
+// validateAnswerValues checks answer values against the question type
+func validateAnswerValues(question *domain.RegistrationQuestion, raw []string) ([]string, error) {
+	values := make([]string, 0, len(raw))
+	for _, v := range raw {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	if len(values) == 0 {
+		return values, nil
+	}
+
+	if question.Type != "multi_select" && len(values) > 1 {
+		return nil, fmt.Errorf("%s: only one value is allowed", question.Label)
+	}
+
+	switch question.Type {
+	case "text":
+		if utf8.RuneCountInString(values[0]) > maxTextAnswerLength {
+			return nil, fmt.Errorf("%s: answer must be at most %d characters", question.Label, maxTextAnswerLength)
+		}
+	case "select", "multi_select":
+		allowed := make(map[string]bool, len(question.Options))
+		for _, option := range question.Options {
+			allowed[option] = true
+		}
+		seen := make(map[string]bool, len(values))
+		for _, v := range values {
+			if !allowed[v] {
+				return nil, fmt.Errorf("%s: %q is not a valid option", question.Label, v)
+			}
+			if seen[v] {
+				return nil, fmt.Errorf("%s: duplicate option %q", question.Label, v)
+			}
+			seen[v] = true
+		}
+	case "checkbox":
+		if values[0] != "true" && values[0] != "false" {
+			return nil, fmt.Errorf("%s: value must be 'true' or 'false'", question.Label)
+		}
+	case "date":
+		if _, err := time.Parse("2006-01-02", values[0]); err != nil {
+			return nil, fmt.Errorf("%s: date must be in YYYY-MM-DD format", question.Label)
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,359 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type RsvpUseCase interface {
+	// Attendee actions
+	Respond(ctx context.Context, eventID, userID uuid.UUID, req RsvpRequest) (*domain.Rsvp, error)
+	GetMyRsvp(eventID, userID uuid.UUID) (*domain.Rsvp, error)
+
+	// Organizer actions
+	GetEventRsvps(eventID, requesterID uuid.UUID, status string) ([]*domain.Rsvp, error)
+	ApproveRsvp(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Rsvp, error)
+	DeclineRsvp(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Rsvp, error)
+}
+
+type RsvpRequest struct {
+	Status         string `json:"status"` // going, not_going
+	TicketQuantity int    `json:"ticket_quantity"`
+	BuyerName      string `json:"buyer_name"`
+	BuyerEmail     string `json:"buyer_email"`
+	BuyerPhone     string `json:"buyer_phone"`
+
+	Answers []RegistrationAnswerInput `json:"answers"`
+}
+
+type rsvpUseCase struct {
+	paymentRepo      domain.PaymentRepository
+	eventRepo        domain.EventRepository
+	policy           AuthorizationPolicy
+	userRepo         domain.UserRepository
+	registrationRepo domain.RegistrationRepository
+	webhooks         WebhookDispatcher
+	popularity       PopularityUseCase
+}
+
+func NewRsvpUseCase(paymentRepo domain.PaymentRepository, eventRepo domain.EventRepository, policy AuthorizationPolicy, userRepo domain.UserRepository, registrationRepo domain.RegistrationRepository, webhooks WebhookDispatcher, popularity PopularityUseCase) RsvpUseCase {
+	return &rsvpUseCase{
+		paymentRepo:      paymentRepo,
+		eventRepo:        eventRepo,
+		policy:           policy,
+		userRepo:         userRepo,
+		registrationRepo: registrationRepo,
+		webhooks:         webhooks,
+		popularity:       popularity,
+	}
+}
+
+// Respond records a "going" or "not going" response to a free event
+// Going reserves seats instantly unless the event requires organizer approval
+func (uc *rsvpUseCase) Respond(ctx context.Context, eventID, userID uuid.UUID, req RsvpRequest) (*domain.Rsvp, error) {
+	if req.Status != domain.RsvpStatusGoing && req.Status != domain.RsvpStatusNotGoing {
+		return nil, errors.New("invalid RSVP status: must be 'going' or 'not_going'")
+	}
+
+	event, err := uc.eventRepo.GetByID(eventID)
+	if err != nil {
+		return nil, fmt.Errorf("event not found: %w", err)
+	}
+
+	if event.TicketPrice != 0 {
+		return nil, errors.New("RSVP is only available for free events")
+	}
+
+	existing, err := uc.paymentRepo.GetLatestByEventIDAndUserID(eventID, userID, "rsvp")
+	if err != nil && !errors.Is(err, domain.ErrNotFound) {
+		return nil, err
+	}
+
+	if existing != nil && existing.Status == "failed" {
+		return nil, errors.New("RSVP was declined by the organizer")
+	}
+
+	if req.Status == domain.RsvpStatusNotGoing {
+		rsvp, err := uc.withdraw(ctx, existing)
+		if err != nil {
+			return nil, err
+		}
+		if existing.Status != "cancelled" {
+			uc.webhooks.DispatchPayment(domain.WebhookOrderCancelled, &rsvp.Payment)
+		}
+		return rsvp, nil
+	}
+
+	// Already going or waiting for approval
+	if existing != nil && (existing.Status == "completed" || existing.Status == "pending") {
+		return domain.NewRsvp(existing), nil
+	}
+
+	// Private events are only open to those allowed to see them
+	if err := uc.policy.CanViewEvent(event, userID); err != nil {
+		return nil, err
+	}
+	if err := uc.policy.RequireActiveOrganization(event.OrganizationID); err != nil {
+		return nil, err
+	}
+
+	if event.Status == "draft" || event.Status == "cancelled" || event.Status == "completed" {
+		return nil, fmt.Errorf("cannot RSVP to event with status: %s", event.Status)
+	}
+
+	quantity := req.TicketQuantity
+	if quantity == 0 {
+		quantity = 1
+	}
+	if quantity < 0 {
+		return nil, errors.New("ticket quantity must be positive")
+	}
+
+	// Fill missing buyer details from the user's profile
+	if req.BuyerName == "" || req.BuyerEmail == "" || req.BuyerPhone == "" {
+		user, err := uc.userRepo.GetUserByID(userID)
+		if err != nil {
+			return nil, fmt.Errorf("user not found: %w", err)
+		}
+		if req.BuyerName == "" {
+			req.BuyerName = strings.TrimSpace(user.FirstName + " " + user.LastName)
+		}
+		if req.BuyerEmail == "" {
+			req.BuyerEmail = user.Email
+		}
+		if req.BuyerPhone == "" {
+			req.BuyerPhone = user.PhoneNumber
+		}
+	}
+	if req.BuyerName == "" {
+		return nil, errors.New("buyer name is required")
+	}
+
+	status := "completed"
+	if event.RequiresApproval {
+		status = "pending"
+	} else if !event.HasUnlimitedSeats() && event.AvailableTickets < quantity {
+		return nil, errors.New("not enough tickets available")
+	}
+
+	// Validate answers to the event's registration questions
+	questions, err := uc.registrationRepo.GetQuestionsByEventID(eventID)
+	if err != nil {
+		return nil, err
+	}
+
+	paymentID := uuid.New()
+	answers, err := buildRegistrationAnswers(questions, quantity, req.Answers, paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	payment := &domain.Payment{
+		ID:             paymentID,
+		EventID:        eventID,
+		UserID:         &userID,
+		EventTitle:     event.Title,
+		TicketQuantity: quantity,
+		TotalPrice:     0,
+		Currency:       event.Currency,
+		BuyerName:      req.BuyerName,
+		BuyerEmail:     req.BuyerEmail,
+		BuyerPhone:     req.BuyerPhone,
+		OrderID:        fmt.Sprintf("RSVP-%s", uuid.New().String()[:8]),
+		Status:         status,
+		Channel:        "rsvp",
+		PaymentMethod:  "free",
+		CreatedAt:      time.Now(),
+		UpdatedAt:      time.Now(),
+	}
+
+	// Reserve seats immediately when no approval is needed
+	if status == "completed" {
+		if err := uc.eventRepo.UpdateAvailableTickets(eventID, -quantity); err != nil {
+			return nil, fmt.Errorf("failed to reserve tickets: %w", err)
+		}
+	}
+
+	created, err := uc.paymentRepo.Create(ctx, payment)
+	if err != nil {
+		if status == "completed" {
+			// Rollback ticket reservation if the RSVP could not be recorded
+			_ = uc.eventRepo.UpdateAvailableTickets(eventID, quantity)
+		}
+		return nil, err
+	}
+
+	if err := uc.registrationRepo.SaveAnswers(answers); err != nil {
+		// Withdraw the RSVP so it does not count without its answers
+		_, _ = uc.withdraw(ctx, created)
+		return nil, err
+	}
+
+	if status == "completed" {
+		uc.refreshParticipantCount(eventID)
+		uc.popularity.RecordTicketSale(eventID, quantity)
+		uc.webhooks.DispatchPayment(domain.WebhookOrderCompleted, created)
+	}
+
+	return domain.NewRsvp(created), nil
+}
+
+// withdraw marks an RSVP as "not going" and releases its seats
+func (uc *rsvpUseCase) withdraw(ctx context.Context, existing *domain.Payment) (*domain.Rsvp, error) {
+	if existing == nil {
+		return nil, errors.New("no RSVP found for this event")
+	}
+
+	switch existing.Status {
+	case "cancelled":
+		return domain.NewRsvp(existing), nil
+	case "completed":
+		if err := uc.eventRepo.UpdateAvailableTickets(existing.EventID, existing.TicketQuantity); err != nil {
+			return nil, fmt.Errorf("failed to release tickets: %w", err)
+		}
+	}
+
+	if err := uc.paymentRepo.UpdateStatus(ctx, existing.ID, "cancelled", ""); err != nil {
+		if existing.Status == "completed" {
+			// Rollback ticket release if status update fails
+			_ = uc.eventRepo.UpdateAvailableTickets(existing.EventID, -existing.TicketQuantity)
+		}
+		return nil, fmt.Errorf("failed to update RSVP: %w", err)
+	}
+
+	if existing.Status == "completed" {
+		uc.refreshParticipantCount(existing.EventID)
+	}
+
+	updated, err := uc.paymentRepo.GetByID(existing.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewRsvp(updated), nil
+}
+
+// GetMyRsvp retrieves the current user's RSVP for an event
+func (uc *rsvpUseCase) GetMyRsvp(eventID, userID uuid.UUID) (*domain.Rsvp, error) {
+	payment, err := uc.paymentRepo.GetLatestByEventIDAndUserID(eventID, userID, "rsvp")
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewRsvp(payment), nil
+}
+
+// GetEventRsvps retrieves RSVPs for an event, optionally filtered by RSVP status (rsvp:manage)
+func (uc *rsvpUseCase) GetEventRsvps(eventID, requesterID uuid.UUID, status string) ([]*domain.Rsvp, error) {
+	if _, err := uc.policy.RequireForEvent(requesterID, eventID, domain.PermissionRsvpManage); err != nil {
+		return nil, err
+	}
+
+	payments, err := uc.paymentRepo.GetByEventIDAndChannel(eventID, "rsvp")
+	if err != nil {
+		return nil, err
+	}
+
+	rsvps := make([]*domain.Rsvp, 0, len(payments))
+	for _, p := range payments {
+		rsvp := domain.NewRsvp(p)
+		if status != "" && rsvp.RsvpStatus != status {
+			continue
+		}
+		rsvps = append(rsvps, rsvp)
+	}
+
+	return rsvps, nil
+}
+
+// ApproveRsvp approves a pending RSVP and reserves its seats (rsvp:manage)
+func (uc *rsvpUseCase) ApproveRsvp(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Rsvp, error) {
+	payment, err := uc.getPendingRsvp(eventID, paymentID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.eventRepo.UpdateAvailableTickets(eventID, -payment.TicketQuantity); err != nil {
+		return nil, fmt.Errorf("failed to reserve tickets: %w", err)
+	}
+
+	if err := uc.paymentRepo.UpdateStatus(ctx, payment.ID, "completed", ""); err != nil {
+		// Rollback ticket reservation if status update fails
+		_ = uc.eventRepo.UpdateAvailableTickets(eventID, payment.TicketQuantity)
+		return nil, fmt.Errorf("failed to approve RSVP: %w", err)
+	}
+
+	uc.refreshParticipantCount(eventID)
+	uc.popularity.RecordTicketSale(eventID, payment.TicketQuantity)
+
+	updated, err := uc.paymentRepo.GetByID(payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	uc.webhooks.DispatchPayment(domain.WebhookOrderCompleted, updated)
+
+	return domain.NewRsvp(updated), nil
+}
+
+// DeclineRsvp declines a pending RSVP (rsvp:manage)
+func (uc *rsvpUseCase) DeclineRsvp(ctx context.Context, eventID, paymentID, requesterID uuid.UUID) (*domain.Rsvp, error) {
+	payment, err := uc.getPendingRsvp(eventID, paymentID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.paymentRepo.UpdateStatus(ctx, payment.ID, "failed", ""); err != nil {
+		return nil, fmt.Errorf("failed to decline RSVP: %w", err)
+	}
+
+	updated, err := uc.paymentRepo.GetByID(payment.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return domain.NewRsvp(updated), nil
+}
+
+// getPendingRsvp loads an RSVP awaiting approval after checking admin permission
+func (uc *rsvpUseCase) getPendingRsvp(eventID, paymentID, requesterID uuid.UUID) (*domain.Payment, error) {
+	if _, err := uc.policy.RequireForEvent(requesterID, eventID, domain.PermissionRsvpManage); err != nil {
+		return nil, err
+	}
+
+	payment, err := uc.paymentRepo.GetByID(paymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payment.EventID != eventID || payment.Channel != "rsvp" {
+		return nil, domain.ErrNotFound
+	}
+
+	if payment.Status != "pending" {
+		return nil, errors.New("RSVP is not awaiting approval")
+	}
+
+	return payment, nil
+}
+
+// refreshParticipantCount recalculates the event's participant count
+func (uc *rsvpUseCase) refreshParticipantCount(eventID uuid.UUID) {
+	participantCount, err := uc.paymentRepo.GetParticipantCountByEventID(eventID)
+	if err != nil {
+		// Log error but don't fail the RSVP
+		fmt.Printf("Warning: failed to update participant count: %v\n", err)
+		return
+	}
+
+	if err := uc.eventRepo.UpdateParticipantCount(eventID, participantCount); err != nil {
+		// Log error but don't fail the RSVP
+		fmt.Printf("Warning: failed to update participant count in DB: %v\n", err)
+	}
+}
@@ -0,0 +1,97 @@
+package usecase
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+)
+
+const (
+	// Suggestions of each type returned for one query
+	suggestionsPerType = 5
+
+	defaultTrendingKeywords = 10
+	maxTrendingKeywords     = 50
+
+	// Only keywords of this many characters are counted as trending
+	minTrendingKeywordLength = 2
+	maxTrendingKeywordLength = 50
+)
+
+type SearchUseCase interface {
+	// Suggest returns event titles, venues and organizations with a word starting with q
+	Suggest(q string) ([]*domain.SearchSuggestion, error)
+	GetTrendingKeywords(limit int) ([]*domain.TrendingKeyword, error)
+}
+
+type searchUseCase struct {
+	eventRepo domain.EventRepository
+	orgRepo   domain.OrganizationRepository
+	trends    domain.SearchTrends
+}
+
+func NewSearchUseCase(eventRepo domain.EventRepository, orgRepo domain.OrganizationRepository, trends domain.SearchTrends) SearchUseCase {
+	return &searchUseCase{
+		eventRepo: eventRepo,
+		orgRepo:   orgRepo,
+		trends:    trends,
+	}
+}
+
+// Suggest returns event titles, venues and organizations matching q
+// Typing only initial consonants such as "ㅅㅇ" matches words like "서울"
+func (uc *searchUseCase) Suggest(q string) ([]*domain.SearchSuggestion, error) {
+	q = strings.Join(strings.Fields(q), " ")
+	if q == "" {
+		return nil, errors.New("query is required")
+	}
+	if utf8.RuneCountInString(q) > maxSearchKeywordLength {
+		return nil, fmt.Errorf("%w: query must be at most %d characters", domain.ErrInvalidInput, maxSearchKeywordLength)
+	}
+
+	titles, err := uc.eventRepo.SuggestTitles(q, suggestionsPerType)
+	if err != nil {
+		return nil, err
+	}
+	venues, err := uc.eventRepo.SuggestVenues(q, suggestionsPerType)
+	if err != nil {
+		return nil, err
+	}
+	orgs, err := uc.orgRepo.SuggestPublic(q, suggestionsPerType)
+	if err != nil {
+		return nil, err
+	}
+
+	suggestions := make([]*domain.SearchSuggestion, 0, len(titles)+len(venues)+len(orgs))
+	suggestions = append(suggestions, titles...)
+	suggestions = append(suggestions, venues...)
+	suggestions = append(suggestions, orgs...)
+	return suggestions, nil
+}
+
+// GetTrendingKeywords returns the most searched keywords of the last day, recent searches weighing more
+func (uc *searchUseCase) GetTrendingKeywords(limit int) ([]*domain.TrendingKeyword, error) {
+	if limit == 0 {
+		limit = defaultTrendingKeywords
+	}
+	if limit < 0 || limit > maxTrendingKeywords {
+		return nil, fmt.Errorf("%w: limit must be between 1 and %d", domain.ErrInvalidInput, maxTrendingKeywords)
+	}
+
+	return uc.trends.Top(limit, time.Now())
+}
+
+// trendingKeyword normalizes a search keyword for counting, so "Jazz  Festival" and "jazz festival" count together
+// Keywords too short to mean much or too long to be shared by other users are not counted
+func trendingKeyword(keyword string) (string, bool) {
+	keyword = strings.ToLower(strings.Join(strings.Fields(keyword), " "))
+	length := utf8.RuneCountInString(keyword)
+	if length < minTrendingKeywordLength || length > maxTrendingKeywordLength {
+		return "", false
+	}
+	return keyword, true
+}
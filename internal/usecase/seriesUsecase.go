@@ -0,0 +1,443 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+type SeriesUseCase interface {
+	// Series management
+	CreateSeries(ctx context.Context, orgID, userID uuid.UUID, req CreateSeriesRequest) (*domain.EventSeries, error)
+	GetSeries(seriesID, userID uuid.UUID) (*domain.EventSeries, error)
+	GetOrganizationSeries(orgID, userID uuid.UUID) ([]*domain.EventSeries, error)
+	UpdateFutureOccurrences(ctx context.Context, eventID, userID uuid.UUID, req UpdateEventRequest) (*SeriesUpdateResult, error)
+
+	// Approval workflow, applied to every draft occurrence
+	SubmitSeriesForReview(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error)
+	ApproveSeries(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error)
+	RequestSeriesChanges(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error)
+	RejectSeries(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error)
+
+	// Public queries
+	GetPublicSeries(seriesID uuid.UUID) (*domain.EventSeries, error)
+}
+
+type CreateSeriesRequest struct {
+	Title            string            `json:"title"`
+	Description      string            `json:"description"`
+	Location         string            `json:"location"`
+	Venue            string            `json:"venue"`
+	VenueID          *uuid.UUID        `json:"venue_id"`
+	SeatingLayout    string            `json:"seating_layout"`
+	Address          *domain.Address   `json:"address"`
+	Coordinates      *domain.GeoPoint  `json:"coordinates"`
+	Category         string            `json:"category"`
+	StartTime        time.Time         `json:"start_time"` // First occurrence
+	EndTime          time.Time         `json:"end_time"`
+	Recurrence       domain.Recurrence `json:"recurrence"`
+	TotalTickets     int               `json:"total_tickets"` // Per occurrence
+	TicketPrice      float64           `json:"ticket_price"`
+	Currency         string            `json:"currency"`
+	ThumbnailURL     string            `json:"thumbnail_url"`
+	IsPublic         bool              `json:"is_public"`
+	RequiresApproval bool              `json:"requires_approval"`
+}
+
+// SeriesUpdateResult reports which occurrences a series-wide operation changed
+type SeriesUpdateResult struct {
+	Updated []uuid.UUID `json:"updated"`
+	Skipped []uuid.UUID `json:"skipped"` // Occurrences the operation does not apply to, e.g. already approved ones
+}
+
+type seriesUseCase struct {
+	seriesRepo   domain.EventSeriesRepository
+	eventRepo    domain.EventRepository
+	venueRepo    domain.VenueRepository
+	eventUseCase EventUseCase
+	policy       AuthorizationPolicy
+}
+
+func NewSeriesUseCase(seriesRepo domain.EventSeriesRepository, eventRepo domain.EventRepository, venueRepo domain.VenueRepository, eventUseCase EventUseCase, policy AuthorizationPolicy) SeriesUseCase {
+	return &seriesUseCase{
+		seriesRepo:   seriesRepo,
+		eventRepo:    eventRepo,
+		venueRepo:    venueRepo,
+		eventUseCase: eventUseCase,
+		policy:       policy,
+	}
+}
+
+// CreateSeries creates a series and a draft event with its own inventory for every occurrence (events:create)
+func (uc *seriesUseCase) CreateSeries(ctx context.Context, orgID, userID uuid.UUID, req CreateSeriesRequest) (*domain.EventSeries, error) {
+	if err := uc.policy.Require(userID, orgID, domain.PermissionEventCreate); err != nil {
+		return nil, err
+	}
+	if err := uc.policy.RequireActiveOrganization(orgID); err != nil {
+		return nil, err
+	}
+
+	// Validate required fields
+	if req.Title == "" {
+		return nil, errors.New("series title is required")
+	}
+	if req.StartTime.IsZero() {
+		return nil, errors.New("start time is required")
+	}
+	if req.EndTime.IsZero() {
+		return nil, errors.New("end time is required")
+	}
+	if req.StartTime.After(req.EndTime) {
+		return nil, errors.New("start time must be before end time")
+	}
+	if req.TotalTickets < 0 {
+		return nil, errors.New("total tickets must be non-negative")
+	}
+	if req.TicketPrice < 0 {
+		return nil, errors.New("ticket price must be non-negative")
+	}
+
+	category, err := normalizeCategory(req.Category)
+	if err != nil {
+		return nil, err
+	}
+	address, err := normalizeAddress(req.Address)
+	if err != nil {
+		return nil, err
+	}
+	if err := validateCoordinates(req.Coordinates); err != nil {
+		return nil, err
+	}
+	layout := strings.TrimSpace(req.SeatingLayout)
+	venue, err := venueForEvent(uc.venueRepo, orgID, req.VenueID, layout, req.TotalTickets)
+	if err != nil {
+		return nil, err
+	}
+
+	starts, err := req.Recurrence.Occurrences(req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	// Set default currency
+	if req.Currency == "" {
+		req.Currency = "KRW"
+	}
+
+	now := time.Now()
+	series := &domain.EventSeries{
+		ID:               uuid.New(),
+		OrganizationID:   orgID,
+		Title:            req.Title,
+		Description:      req.Description,
+		Location:         req.Location,
+		Venue:            req.Venue,
+		Address:          address,
+		Coordinates:      req.Coordinates,
+		Category:         category,
+		StartTime:        req.StartTime,
+		EndTime:          req.EndTime,
+		Recurrence:       req.Recurrence,
+		TotalTickets:     req.TotalTickets,
+		TicketPrice:      req.TicketPrice,
+		Currency:         req.Currency,
+		ThumbnailURL:     req.ThumbnailURL,
+		IsPublic:         req.IsPublic,
+		RequiresApproval: req.RequiresApproval,
+		CreatedBy:        userID,
+		CreatedAt:        now,
+		UpdatedAt:        now,
+	}
+	holdSeriesAt(series, venue, layout)
+
+	duration := req.EndTime.Sub(req.StartTime)
+	occurrences := make([]*domain.Event, len(starts))
+	for i, start := range starts {
+		seriesID := series.ID
+		occurrences[i] = &domain.Event{
+			ID:               uuid.New(),
+			OrganizationID:   orgID,
+			SeriesID:         &seriesID,
+			Title:            req.Title,
+			Description:      req.Description,
+			Location:         req.Location,
+			Venue:            req.Venue,
+			Address:          address,
+			Coordinates:      req.Coordinates,
+			Category:         category,
+			StartTime:        start,
+			EndTime:          start.Add(duration),
+			TotalTickets:     req.TotalTickets,
+			AvailableTickets: req.TotalTickets,
+			TicketPrice:      req.TicketPrice,
+			Currency:         req.Currency,
+			ThumbnailURL:     req.ThumbnailURL,
+			Status:           domain.EventDraft,
+			IsPublic:         req.IsPublic,
+			RequiresApproval: req.RequiresApproval,
+			Review:           &domain.EventReview{Status: domain.ReviewNotSubmitted},
+			CreatedBy:        userID,
+			CreatedAt:        now,
+			UpdatedAt:        now,
+		}
+		holdEventAt(occurrences[i], venue, layout)
+	}
+
+	created, err := uc.seriesRepo.Create(ctx, series, occurrences)
+	if err != nil {
+		return nil, err
+	}
+	created.Occurrences = occurrences
+
+	return created, nil
+}
+
+// GetSeries retrieves a series with all its occurrences (events:view)
+func (uc *seriesUseCase) GetSeries(seriesID, userID uuid.UUID) (*domain.EventSeries, error) {
+	series, err := uc.seriesRepo.GetByID(seriesID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.policy.Require(userID, series.OrganizationID, domain.PermissionEventView); err != nil {
+		return nil, err
+	}
+
+	if series.Occurrences, err = uc.eventRepo.GetBySeriesID(series.ID); err != nil {
+		return nil, err
+	}
+
+	return series, nil
+}
+
+// GetOrganizationSeries retrieves an organization's series without their occurrences (events:view)
+func (uc *seriesUseCase) GetOrganizationSeries(orgID, userID uuid.UUID) ([]*domain.EventSeries, error) {
+	if err := uc.policy.Require(userID, orgID, domain.PermissionEventView); err != nil {
+		return nil, err
+	}
+
+	return uc.seriesRepo.GetByOrganizationID(orgID)
+}
+
+// UpdateFutureOccurrences applies an edit to an occurrence and every later occurrence of its series (events:update)
+// A new start time shifts each occurrence by the same amount; a status is applied where the transition is allowed.
+// Completed and cancelled occurrences are skipped, and the series template is updated for reference.
+func (uc *seriesUseCase) UpdateFutureOccurrences(ctx context.Context, eventID, userID uuid.UUID, req UpdateEventRequest) (*SeriesUpdateResult, error) {
+	event, err := uc.policy.RequireForEvent(userID, eventID, domain.PermissionEventUpdate)
+	if err != nil {
+		return nil, err
+	}
+	if event.SeriesID == nil {
+		return nil, errors.New("event is not part of a series")
+	}
+
+	series, err := uc.seriesRepo.GetByID(*event.SeriesID)
+	if err != nil {
+		return nil, err
+	}
+	occurrences, err := uc.eventRepo.GetBySeriesID(series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Times in the request describe the chosen occurrence
+	var shift time.Duration
+	if !req.StartTime.IsZero() {
+		shift = req.StartTime.Sub(event.StartTime)
+	}
+	duration := event.EndTime.Sub(event.StartTime)
+	if !req.EndTime.IsZero() {
+		start := event.StartTime.Add(shift)
+		if req.EndTime.Before(start) {
+			return nil, errors.New("start time must be before end time")
+		}
+		duration = req.EndTime.Sub(start)
+	}
+
+	result := &SeriesUpdateResult{Updated: []uuid.UUID{}, Skipped: []uuid.UUID{}}
+	for _, occurrence := range occurrences {
+		if occurrence.StartTime.Before(event.StartTime) {
+			continue
+		}
+		if domain.IsFinalEventStatus(occurrence.Status) {
+			result.Skipped = append(result.Skipped, occurrence.ID)
+			continue
+		}
+
+		update := req
+		update.StartTime = occurrence.StartTime.Add(shift)
+		update.EndTime = update.StartTime.Add(duration)
+		update.PublishAt = occurrence.PublishAt
+		if req.Status == occurrence.Status || (req.Status != "" && !domain.CanTransitionEvent(occurrence.Status, req.Status)) {
+			update.Status = ""
+		}
+
+		if err := uc.eventUseCase.UpdateEvent(ctx, occurrence.ID, userID, update); err != nil {
+			return result, fmt.Errorf("failed to update occurrence on %s after updating %d: %w", occurrence.StartTime.Format("2006-01-02"), len(result.Updated), err)
+		}
+		result.Updated = append(result.Updated, occurrence.ID)
+	}
+
+	if req.Title != "" {
+		series.Title = req.Title
+	}
+	series.Description = req.Description
+	series.Location = req.Location
+	series.Venue = req.Venue
+	if series.Address, err = normalizeAddress(req.Address); err != nil {
+		return result, err
+	}
+	if err := validateCoordinates(req.Coordinates); err != nil {
+		return result, err
+	}
+	series.Coordinates = req.Coordinates
+	if series.Category, err = normalizeCategory(req.Category); err != nil {
+		return result, err
+	}
+	if req.TotalTickets > 0 {
+		series.TotalTickets = req.TotalTickets
+	}
+	layout := strings.TrimSpace(req.SeatingLayout)
+	venue, err := venueForEvent(uc.venueRepo, series.OrganizationID, req.VenueID, layout, series.TotalTickets)
+	if err != nil {
+		return result, err
+	}
+	holdSeriesAt(series, venue, layout)
+	series.TicketPrice = req.TicketPrice
+	series.ThumbnailURL = req.ThumbnailURL
+	series.IsPublic = req.IsPublic
+	series.RequiresApproval = req.RequiresApproval
+	if err := uc.seriesRepo.Update(ctx, series); err != nil {
+		return result, err
+	}
+
+	return result, nil
+}
+
+// holdSeriesAt links a series to a saved venue and copies its details, or unlinks it for a nil venue
+func holdSeriesAt(series *domain.EventSeries, venue *domain.Venue, layout string) {
+	if venue == nil {
+		series.VenueID = nil
+		series.SeatingLayout = ""
+		return
+	}
+
+	series.VenueID = &venue.ID
+	series.SeatingLayout = layout
+	series.Venue = venue.Name
+	series.Address = venue.Address
+	series.Coordinates = venue.Coordinates
+}
+
+// SubmitSeriesForReview submits every draft occurrence that is not yet submitted or had changes requested (events:update)
+func (uc *seriesUseCase) SubmitSeriesForReview(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error) {
+	return uc.reviewOccurrences(ctx, seriesID, userID, domain.PermissionEventUpdate,
+		func(review string) bool {
+			return review == domain.ReviewNotSubmitted || review == domain.ReviewChangesRequested
+		},
+		func(eventID uuid.UUID) error {
+			_, err := uc.eventUseCase.SubmitEventForReview(ctx, eventID, userID, comment)
+			return err
+		})
+}
+
+// ApproveSeries approves every occurrence waiting for review (events:approve)
+func (uc *seriesUseCase) ApproveSeries(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error) {
+	return uc.decideOccurrences(ctx, seriesID, userID, func(eventID uuid.UUID) error {
+		_, err := uc.eventUseCase.ApproveEvent(ctx, eventID, userID, comment)
+		return err
+	})
+}
+
+// RequestSeriesChanges sends every occurrence waiting for review back with a comment (events:approve)
+func (uc *seriesUseCase) RequestSeriesChanges(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error) {
+	return uc.decideOccurrences(ctx, seriesID, userID, func(eventID uuid.UUID) error {
+		_, err := uc.eventUseCase.RequestEventChanges(ctx, eventID, userID, comment)
+		return err
+	})
+}
+
+// RejectSeries rejects every occurrence waiting for review (events:approve)
+func (uc *seriesUseCase) RejectSeries(ctx context.Context, seriesID, userID uuid.UUID, comment string) (*SeriesUpdateResult, error) {
+	return uc.decideOccurrences(ctx, seriesID, userID, func(eventID uuid.UUID) error {
+		_, err := uc.eventUseCase.RejectEvent(ctx, eventID, userID, comment)
+		return err
+	})
+}
+
+// decideOccurrences records a reviewer's decision on every occurrence waiting for review
+func (uc *seriesUseCase) decideOccurrences(ctx context.Context, seriesID, userID uuid.UUID, decide func(eventID uuid.UUID) error) (*SeriesUpdateResult, error) {
+	return uc.reviewOccurrences(ctx, seriesID, userID, domain.PermissionEventApprove,
+		func(review string) bool {
+			return review == domain.ReviewPending
+		},
+		decide)
+}
+
+// reviewOccurrences applies a review step to the draft occurrences whose review status it accepts
+func (uc *seriesUseCase) reviewOccurrences(ctx context.Context, seriesID, userID uuid.UUID, permission domain.Permission, accepts func(review string) bool, apply func(eventID uuid.UUID) error) (*SeriesUpdateResult, error) {
+	series, err := uc.seriesRepo.GetByID(seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.policy.Require(userID, series.OrganizationID, permission); err != nil {
+		return nil, err
+	}
+
+	occurrences, err := uc.eventRepo.GetBySeriesID(series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &SeriesUpdateResult{Updated: []uuid.UUID{}, Skipped: []uuid.UUID{}}
+	for _, occurrence := range occurrences {
+		if occurrence.Status != domain.EventDraft || !accepts(occurrence.Review.Status) {
+			result.Skipped = append(result.Skipped, occurrence.ID)
+			continue
+		}
+		if err := apply(occurrence.ID); err != nil {
+			return result, fmt.Errorf("failed to review occurrence on %s after updating %d: %w", occurrence.StartTime.Format("2006-01-02"), len(result.Updated), err)
+		}
+		result.Updated = append(result.Updated, occurrence.ID)
+	}
+
+	return result, nil
+}
+
+// GetPublicSeries retrieves a public series of an active organization with its upcoming published occurrences
+func (uc *seriesUseCase) GetPublicSeries(seriesID uuid.UUID) (*domain.EventSeries, error) {
+	series, err := uc.seriesRepo.GetByID(seriesID)
+	if err != nil {
+		return nil, err
+	}
+	if !series.IsPublic {
+		return nil, domain.ErrNotFound
+	}
+	if err := uc.policy.RequireActiveOrganization(series.OrganizationID); err != nil {
+		if errors.Is(err, domain.ErrOrgInactive) {
+			return nil, domain.ErrNotFound
+		}
+		return nil, err
+	}
+
+	occurrences, err := uc.eventRepo.GetBySeriesID(series.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	series.Occurrences = make([]*domain.Event, 0, len(occurrences))
+	for _, occurrence := range occurrences {
+		if occurrence.IsPublic && occurrence.Status == domain.EventPublished && occurrence.StartTime.After(now) {
+			occurrence.Review = nil
+			series.Occurrences = append(series.Occurrences, occurrence)
+		}
+	}
+
+	return series, nil
+}
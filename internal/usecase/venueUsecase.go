@@ -0,0 +1,338 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/google/uuid"
+)
+
+const (
+	maxVenueNameLength  = 255
+	maxVenueNoteLength  = 2000 // Directions, parking and accessibility notes
+	maxSeatingLayouts   = 20
+	maxLayoutNameLength = 50
+)
+
+type VenueUseCase interface {
+	// Organization venues (venues:manage to change, events:view to see)
+	CreateVenue(ctx context.Context, orgID, userID uuid.UUID, req VenueRequest) (*domain.Venue, error)
+	GetOrganizationVenues(orgID, userID uuid.UUID) ([]*domain.Venue, error)
+
+	// Platform-wide venues, managed by platform admins and visible to every user
+	CreatePlatformVenue(ctx context.Context, userID uuid.UUID, req VenueRequest) (*domain.Venue, error)
+	GetPlatformVenues() ([]*domain.Venue, error)
+
+	GetVenue(venueID, userID uuid.UUID) (*domain.Venue, error)
+	UpdateVenue(ctx context.Context, venueID, userID uuid.UUID, req VenueRequest) (*domain.Venue, error)
+	DeleteVenue(ctx context.Context, venueID, userID uuid.UUID) error
+
+	// Public queries
+	GetPublicVenue(venueID uuid.UUID) (*domain.Venue, error)
+}
+
+type VenueRequest struct {
+	Name           string                 `json:"name"`
+	Address        *domain.Address        `json:"address"`
+	Coordinates    *domain.GeoPoint       `json:"coordinates"`
+	Capacity       int                    `json:"capacity"`
+	SeatingLayouts []domain.SeatingLayout `json:"seating_layouts"`
+	Directions     string                 `json:"directions"`
+	Parking        string                 `json:"parking"`
+	Accessibility  string                 `json:"accessibility"`
+}
+
+type venueUseCase struct {
+	venueRepo      domain.VenueRepository
+	policy         AuthorizationPolicy
+	platformAdmins map[uuid.UUID]bool
+}
+
+func NewVenueUseCase(venueRepo domain.VenueRepository, policy AuthorizationPolicy, platformAdmins []uuid.UUID) VenueUseCase {
+	admins := make(map[uuid.UUID]bool, len(platformAdmins))
+	for _, id := range platformAdmins {
+		admins[id] = true
+	}
+
+	return &venueUseCase{
+		venueRepo:      venueRepo,
+		policy:         policy,
+		platformAdmins: admins,
+	}
+}
+
+// CreateVenue saves a venue for an organization (venues:manage)
+func (uc *venueUseCase) CreateVenue(ctx context.Context, orgID, userID uuid.UUID, req VenueRequest) (*domain.Venue, error) {
+	if err := uc.policy.Require(userID, orgID, domain.PermissionVenueManage); err != nil {
+		return nil, err
+	}
+	if err := uc.policy.RequireActiveOrganization(orgID); err != nil {
+		return nil, err
+	}
+
+	return uc.create(ctx, &orgID, userID, req)
+}
+
+// CreatePlatformVenue saves a venue every organization can use (platform admins only)
+func (uc *venueUseCase) CreatePlatformVenue(ctx context.Context, userID uuid.UUID, req VenueRequest) (*domain.Venue, error) {
+	if !uc.platformAdmins[userID] {
+		return nil, permissionDenied("only platform admins can manage platform-wide venues")
+	}
+
+	return uc.create(ctx, nil, userID, req)
+}
+
+func (uc *venueUseCase) create(ctx context.Context, orgID *uuid.UUID, userID uuid.UUID, req VenueRequest) (*domain.Venue, error) {
+	now := time.Now()
+	venue := &domain.Venue{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		CreatedBy:      userID,
+		CreatedAt:      now,
+		UpdatedAt:      now,
+	}
+	if err := applyVenueRequest(venue, req); err != nil {
+		return nil, err
+	}
+
+	return uc.venueRepo.Create(ctx, venue)
+}
+
+// GetOrganizationVenues lists the venues an organization can hold events at: its own and the platform-wide ones
+func (uc *venueUseCase) GetOrganizationVenues(orgID, userID uuid.UUID) ([]*domain.Venue, error) {
+	if err := uc.requireView(orgID, userID); err != nil {
+		return nil, err
+	}
+
+	return uc.venueRepo.GetAvailable(orgID)
+}
+
+// GetPlatformVenues lists the platform-wide venues
+func (uc *venueUseCase) GetPlatformVenues() ([]*domain.Venue, error) {
+	return uc.venueRepo.GetPlatformWide()
+}
+
+// GetVenue retrieves a venue; organization venues are only shown to its members with events:view or venues:manage
+func (uc *venueUseCase) GetVenue(venueID, userID uuid.UUID) (*domain.Venue, error) {
+	venue, err := uc.venueRepo.GetByID(venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !venue.IsPlatformWide() {
+		if err := uc.requireView(*venue.OrganizationID, userID); err != nil {
+			return nil, err
+		}
+	}
+
+	return venue, nil
+}
+
+// UpdateVenue replaces a venue's details
+// Events keep the details copied when they were saved, and capacity is only checked against events saved afterwards
+func (uc *venueUseCase) UpdateVenue(ctx context.Context, venueID, userID uuid.UUID, req VenueRequest) (*domain.Venue, error) {
+	venue, err := uc.requireManage(venueID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := applyVenueRequest(venue, req); err != nil {
+		return nil, err
+	}
+	if err := uc.checkBookingsFit(venue); err != nil {
+		return nil, err
+	}
+	venue.UpdatedAt = time.Now()
+
+	if err := uc.venueRepo.Update(ctx, venue); err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// DeleteVenue deletes a venue; events and series at it keep their copied details
+func (uc *venueUseCase) DeleteVenue(ctx context.Context, venueID, userID uuid.UUID) error {
+	if _, err := uc.requireManage(venueID, userID); err != nil {
+		return err
+	}
+
+	return uc.venueRepo.Delete(ctx, venueID)
+}
+
+// GetPublicVenue retrieves a venue for attendees looking for directions, parking or accessibility notes
+func (uc *venueUseCase) GetPublicVenue(venueID uuid.UUID) (*domain.Venue, error) {
+	return uc.venueRepo.GetByID(venueID)
+}
+
+// checkBookingsFit refuses a venue change that leaves an upcoming event or series at the venue
+// without its seating layout or with more tickets than the layout holds
+func (uc *venueUseCase) checkBookingsFit(venue *domain.Venue) error {
+	bookings, err := uc.venueRepo.GetActiveBookings(venue.ID)
+	if err != nil {
+		return err
+	}
+
+	for _, booking := range bookings {
+		kind := "event"
+		if booking.SeriesID != nil {
+			kind = "series"
+		}
+
+		capacity, ok := venue.LayoutCapacity(booking.SeatingLayout)
+		if !ok {
+			return fmt.Errorf("%w: %s %q uses seating layout %q", domain.ErrConflict, kind, booking.Title, booking.SeatingLayout)
+		}
+		if booking.TotalTickets > capacity {
+			return fmt.Errorf("%w: %s %q has %d tickets, more than the new capacity of %d", domain.ErrConflict, kind, booking.Title, booking.TotalTickets, capacity)
+		}
+	}
+
+	return nil
+}
+
+// requireView allows members with events:view, who pick venues for events, or venues:manage
+func (uc *venueUseCase) requireView(orgID, userID uuid.UUID) error {
+	canManage, err := uc.policy.Can(userID, orgID, domain.PermissionVenueManage)
+	if err != nil {
+		return err
+	}
+	if canManage {
+		return nil
+	}
+
+	return uc.policy.Require(userID, orgID, domain.PermissionEventView)
+}
+
+// requireManage loads a venue and checks the user may change it:
+// platform admins manage platform-wide venues, members with venues:manage their organization's
+func (uc *venueUseCase) requireManage(venueID, userID uuid.UUID) (*domain.Venue, error) {
+	venue, err := uc.venueRepo.GetByID(venueID)
+	if err != nil {
+		return nil, err
+	}
+
+	if venue.IsPlatformWide() {
+		if !uc.platformAdmins[userID] {
+			return nil, permissionDenied("only platform admins can manage platform-wide venues")
+		}
+		return venue, nil
+	}
+
+	if err := uc.policy.Require(userID, *venue.OrganizationID, domain.PermissionVenueManage); err != nil {
+		return nil, err
+	}
+	return venue, nil
+}
+
+// applyVenueRequest validates a venue request and copies it onto venue
+func applyVenueRequest(venue *domain.Venue, req VenueRequest) error {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return errors.New("venue name is required")
+	}
+	if utf8.RuneCountInString(name) > maxVenueNameLength {
+		return fmt.Errorf("%w: venue name must be at most %d characters", domain.ErrInvalidInput, maxVenueNameLength)
+	}
+	if req.Capacity <= 0 {
+		return fmt.Errorf("%w: capacity must be positive", domain.ErrInvalidInput)
+	}
+	address, err := normalizeAddress(req.Address)
+	if err != nil {
+		return err
+	}
+	if err := validateCoordinates(req.Coordinates); err != nil {
+		return err
+	}
+
+	notes := []struct {
+		name  string
+		value string
+	}{
+		{"directions", req.Directions},
+		{"parking", req.Parking},
+		{"accessibility", req.Accessibility},
+	}
+	for _, note := range notes {
+		if utf8.RuneCountInString(note.value) > maxVenueNoteLength {
+			return fmt.Errorf("%w: %s must be at most %d characters", domain.ErrInvalidInput, note.name, maxVenueNoteLength)
+		}
+	}
+
+	if len(req.SeatingLayouts) > maxSeatingLayouts {
+		return fmt.Errorf("%w: a venue can have at most %d seating layouts", domain.ErrInvalidInput, maxSeatingLayouts)
+	}
+	layouts := make([]domain.SeatingLayout, 0, len(req.SeatingLayouts))
+	seen := make(map[string]bool, len(req.SeatingLayouts))
+	for _, layout := range req.SeatingLayouts {
+		layout.Name = strings.TrimSpace(layout.Name)
+		layout.Description = strings.TrimSpace(layout.Description)
+		if layout.Name == "" {
+			return fmt.Errorf("%w: seating layout name is required", domain.ErrInvalidInput)
+		}
+		if utf8.RuneCountInString(layout.Name) > maxLayoutNameLength {
+			return fmt.Errorf("%w: seating layout name must be at most %d characters", domain.ErrInvalidInput, maxLayoutNameLength)
+		}
+		if seen[strings.ToLower(layout.Name)] {
+			return fmt.Errorf("%w: duplicate seating layout %q", domain.ErrInvalidInput, layout.Name)
+		}
+		seen[strings.ToLower(layout.Name)] = true
+		if layout.Capacity <= 0 || layout.Capacity > req.Capacity {
+			return fmt.Errorf("%w: capacity of seating layout %q must be positive and at most the venue capacity", domain.ErrInvalidInput, layout.Name)
+		}
+		if utf8.RuneCountInString(layout.Description) > maxVenueNoteLength {
+			return fmt.Errorf("%w: description of seating layout %q must be at most %d characters", domain.ErrInvalidInput, layout.Name, maxVenueNoteLength)
+		}
+		layouts = append(layouts, layout)
+	}
+
+	venue.Name = name
+	venue.Address = address
+	venue.Coordinates = req.Coordinates
+	venue.Capacity = req.Capacity
+	venue.SeatingLayouts = layouts
+	venue.Directions = strings.TrimSpace(req.Directions)
+	venue.Parking = strings.TrimSpace(req.Parking)
+	venue.Accessibility = strings.TrimSpace(req.Accessibility)
+	return nil
+}
+
+// venueForEvent loads the saved venue an event of orgID is held at and checks that totalTickets fit the seating layout
+// It returns nil without a venue
+func venueForEvent(venueRepo domain.VenueRepository, orgID uuid.UUID, venueID *uuid.UUID, layout string, totalTickets int) (*domain.Venue, error) {
+	if venueID == nil {
+		if layout != "" {
+			return nil, fmt.Errorf("%w: seating_layout needs a venue_id", domain.ErrInvalidInput)
+		}
+		return nil, nil
+	}
+
+	venue, err := venueRepo.GetByID(*venueID)
+	if err != nil {
+		if errors.Is(err, domain.ErrNotFound) {
+			return nil, fmt.Errorf("%w: venue not found", domain.ErrInvalidInput)
+		}
+		return nil, err
+	}
+	if !venue.IsPlatformWide() && *venue.OrganizationID != orgID {
+		return nil, permissionDenied("venue belongs to another organization")
+	}
+
+	capacity, ok := venue.LayoutCapacity(layout)
+	if !ok {
+		return nil, fmt.Errorf("%w: venue has no seating layout %q", domain.ErrInvalidInput, layout)
+	}
+	// Without a ticket limit, attendance could exceed the venue's capacity
+	if totalTickets <= 0 {
+		return nil, fmt.Errorf("%w: events at a saved venue need a ticket limit of at most %d", domain.ErrInvalidInput, capacity)
+	}
+	if totalTickets > capacity {
+		return nil, fmt.Errorf("%w: total tickets (%d) exceed the venue capacity of %d", domain.ErrInvalidInput, totalTickets, capacity)
+	}
+
+	return venue, nil
+}
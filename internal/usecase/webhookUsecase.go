@@ -0,0 +1,401 @@
+package usecase
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+	"github.com/google/uuid"
+)
+
+const (
+	defaultWebhookDeliveryPageSize = 50
+	maxWebhookDeliveryPageSize     = 100
+	maxWebhookEndpointsPerOrg      = 10
+)
+
+type WebhookUseCase interface {
+	WebhookDispatcher
+
+	// Endpoints (webhooks:manage)
+	CreateEndpoint(ctx context.Context, orgID, requesterID uuid.UUID, req WebhookEndpointRequest) (*WebhookEndpointWithSecret, error)
+	GetEndpoints(orgID, requesterID uuid.UUID) ([]*domain.WebhookEndpoint, error)
+	UpdateEndpoint(ctx context.Context, orgID, endpointID, requesterID uuid.UUID, req UpdateWebhookEndpointRequest) (*WebhookEndpointWithSecret, error)
+	DeleteEndpoint(ctx context.Context, orgID, endpointID, requesterID uuid.UUID) error
+
+	// Delivery log (webhooks:manage)
+	GetDeliveries(orgID, endpointID, requesterID uuid.UUID, page, limit int) (*WebhookDeliveryPage, error)
+	ReplayDelivery(orgID, deliveryID, requesterID uuid.UUID) (*domain.WebhookDelivery, error)
+}
+
+// WebhookDispatcher queues webhook events for the organization endpoints subscribed to them
+// Dispatching never fails the calling action; problems are logged
+type WebhookDispatcher interface {
+	Dispatch(orgID uuid.UUID, eventType string, data interface{})
+	DispatchPayment(eventType string, payment *domain.Payment)
+}
+
+// paymentWebhookEvents maps payment statuses to the event sent when a payment reaches them
+var paymentWebhookEvents = map[string]string{
+	"completed": domain.WebhookOrderCompleted,
+	"cancelled": domain.WebhookOrderCancelled,
+	"refunded":  domain.WebhookOrderRefunded,
+}
+
+type WebhookEndpointRequest struct {
+	URL        string   `json:"url"`
+	EventTypes []string `json:"event_types"`
+}
+
+type UpdateWebhookEndpointRequest struct {
+	URL          *string  `json:"url"`
+	EventTypes   []string `json:"event_types"`
+	IsActive     *bool    `json:"is_active"`
+	RotateSecret bool     `json:"rotate_secret"`
+}
+
+// WebhookEndpointWithSecret is an endpoint with its signing secret, returned when the secret is created or rotated
+type WebhookEndpointWithSecret struct {
+	*domain.WebhookEndpoint
+	Secret string `json:"secret,omitempty"`
+}
+
+// WebhookDeliveryPage is one page of an endpoint's delivery log, newest first
+type WebhookDeliveryPage struct {
+	Deliveries []*domain.WebhookDelivery `json:"deliveries"`
+	Total      int                       `json:"total"`
+	Page       int                       `json:"page"`
+	Limit      int                       `json:"limit"`
+}
+
+type webhookUseCase struct {
+	webhookRepo domain.WebhookRepository
+	queue       domain.WebhookQueue
+	eventRepo   domain.EventRepository
+	policy      AuthorizationPolicy
+}
+
+// NewWebhookUseCase creates the webhook usecase, which also serves as the WebhookDispatcher of other usecases
+func NewWebhookUseCase(webhookRepo domain.WebhookRepository, queue domain.WebhookQueue, eventRepo domain.EventRepository, policy AuthorizationPolicy) WebhookUseCase {
+	return &webhookUseCase{
+		webhookRepo: webhookRepo,
+		queue:       queue,
+		eventRepo:   eventRepo,
+		policy:      policy,
+	}
+}
+
+// CreateEndpoint registers a webhook endpoint; the signing secret is only returned here and on rotation
+func (uc *webhookUseCase) CreateEndpoint(ctx context.Context, orgID, requesterID uuid.UUID, req WebhookEndpointRequest) (*WebhookEndpointWithSecret, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	endpointURL, err := validateWebhookURL(req.URL)
+	if err != nil {
+		return nil, err
+	}
+	eventTypes, err := validateWebhookEventTypes(req.EventTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.webhookRepo.GetEndpointsByOrganizationID(orgID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxWebhookEndpointsPerOrg {
+		return nil, fmt.Errorf("an organization can have at most %d webhook endpoints", maxWebhookEndpointsPerOrg)
+	}
+
+	secret, err := generateWebhookSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	endpoint, err := uc.webhookRepo.CreateEndpoint(ctx, &domain.WebhookEndpoint{
+		ID:             uuid.New(),
+		OrganizationID: orgID,
+		URL:            endpointURL,
+		Secret:         secret,
+		EventTypes:     eventTypes,
+		IsActive:       true,
+		CreatedBy:      requesterID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookEndpointWithSecret{WebhookEndpoint: endpoint, Secret: secret}, nil
+}
+
+// GetEndpoints lists an organization's webhook endpoints
+func (uc *webhookUseCase) GetEndpoints(orgID, requesterID uuid.UUID) ([]*domain.WebhookEndpoint, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	return uc.webhookRepo.GetEndpointsByOrganizationID(orgID)
+}
+
+// UpdateEndpoint changes an endpoint's URL, event types or state, and optionally rotates its secret
+func (uc *webhookUseCase) UpdateEndpoint(ctx context.Context, orgID, endpointID, requesterID uuid.UUID, req UpdateWebhookEndpointRequest) (*WebhookEndpointWithSecret, error) {
+	endpoint, err := uc.getEndpoint(orgID, endpointID, requesterID)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.URL != nil {
+		if endpoint.URL, err = validateWebhookURL(*req.URL); err != nil {
+			return nil, err
+		}
+	}
+	if req.EventTypes != nil {
+		if endpoint.EventTypes, err = validateWebhookEventTypes(req.EventTypes); err != nil {
+			return nil, err
+		}
+	}
+	if req.IsActive != nil {
+		endpoint.IsActive = *req.IsActive
+	}
+
+	result := &WebhookEndpointWithSecret{WebhookEndpoint: endpoint}
+	if req.RotateSecret {
+		if endpoint.Secret, err = generateWebhookSecret(); err != nil {
+			return nil, err
+		}
+		result.Secret = endpoint.Secret
+	}
+
+	if err := uc.webhookRepo.UpdateEndpoint(ctx, endpoint); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// DeleteEndpoint removes an endpoint and its delivery log; queued deliveries are dropped
+func (uc *webhookUseCase) DeleteEndpoint(ctx context.Context, orgID, endpointID, requesterID uuid.UUID) error {
+	if _, err := uc.getEndpoint(orgID, endpointID, requesterID); err != nil {
+		return err
+	}
+
+	return uc.webhookRepo.DeleteEndpoint(ctx, endpointID)
+}
+
+// GetDeliveries retrieves an endpoint's delivery log
+func (uc *webhookUseCase) GetDeliveries(orgID, endpointID, requesterID uuid.UUID, page, limit int) (*WebhookDeliveryPage, error) {
+	if _, err := uc.getEndpoint(orgID, endpointID, requesterID); err != nil {
+		return nil, err
+	}
+
+	if page < 1 {
+		page = 1
+	}
+	if limit < 1 {
+		limit = defaultWebhookDeliveryPageSize
+	}
+	if limit > maxWebhookDeliveryPageSize {
+		limit = maxWebhookDeliveryPageSize
+	}
+
+	deliveries, total, err := uc.webhookRepo.GetDeliveriesByEndpointID(endpointID, (page-1)*limit, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &WebhookDeliveryPage{
+		Deliveries: deliveries,
+		Total:      total,
+		Page:       page,
+		Limit:      limit,
+	}, nil
+}
+
+// ReplayDelivery sends a delivery's payload again as a new delivery with its own retries
+func (uc *webhookUseCase) ReplayDelivery(orgID, deliveryID, requesterID uuid.UUID) (*domain.WebhookDelivery, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	original, err := uc.webhookRepo.GetDeliveryByID(deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if original.OrganizationID != orgID {
+		return nil, domain.ErrNotFound
+	}
+	if original.Status == domain.DeliveryPending {
+		return nil, errors.New("delivery is still pending")
+	}
+
+	return uc.queueDelivery(&domain.WebhookDelivery{
+		ID:             uuid.New(),
+		EndpointID:     original.EndpointID,
+		OrganizationID: orgID,
+		EventID:        original.EventID,
+		EventType:      original.EventType,
+		Payload:        original.Payload,
+		ReplayOf:       &original.ID,
+	})
+}
+
+// Dispatch queues an event for every active endpoint of the organization subscribed to its type
+func (uc *webhookUseCase) Dispatch(orgID uuid.UUID, eventType string, data interface{}) {
+	endpoints, err := uc.webhookRepo.GetEndpointsByOrganizationID(orgID)
+	if err != nil {
+		fmt.Printf("Warning: failed to get webhook endpoints for %s: %v\n", orgID, err)
+		return
+	}
+
+	var subscribed []*domain.WebhookEndpoint
+	for _, endpoint := range endpoints {
+		if endpoint.IsActive && endpoint.Subscribes(eventType) {
+			subscribed = append(subscribed, endpoint)
+		}
+	}
+	if len(subscribed) == 0 {
+		return
+	}
+
+	event := domain.WebhookEvent{
+		ID:             uuid.New(),
+		Type:           eventType,
+		OrganizationID: orgID,
+		CreatedAt:      time.Now(),
+		Data:           data,
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		fmt.Printf("Warning: failed to encode webhook event %s: %v\n", eventType, err)
+		return
+	}
+
+	for _, endpoint := range subscribed {
+		_, err := uc.queueDelivery(&domain.WebhookDelivery{
+			ID:             uuid.New(),
+			EndpointID:     endpoint.ID,
+			OrganizationID: orgID,
+			EventID:        event.ID,
+			EventType:      eventType,
+			Payload:        string(payload),
+		})
+		if err != nil {
+			fmt.Printf("Warning: failed to queue webhook %s for endpoint %s: %v\n", eventType, endpoint.ID, err)
+		}
+	}
+}
+
+// DispatchPayment dispatches a payment event to the organization hosting the payment's event
+// The payment gateway key is left out of the payload
+func (uc *webhookUseCase) DispatchPayment(eventType string, payment *domain.Payment) {
+	event, err := uc.eventRepo.GetByID(payment.EventID)
+	if err != nil {
+		fmt.Printf("Warning: failed to get event for webhook %s: %v\n", eventType, err)
+		return
+	}
+
+	data := *payment
+	data.PaymentKey = ""
+	uc.Dispatch(event.OrganizationID, eventType, &data)
+}
+
+// queueDelivery stores a pending delivery and schedules its first attempt
+// A delivery missing from the queue is picked up again when the worker restarts
+func (uc *webhookUseCase) queueDelivery(delivery *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	now := time.Now()
+	delivery.Status = domain.DeliveryPending
+	delivery.NextAttemptAt = &now
+
+	created, err := uc.webhookRepo.CreateDelivery(delivery)
+	if err != nil {
+		return nil, err
+	}
+	if err := uc.queue.Enqueue(created.ID, now); err != nil {
+		fmt.Printf("Warning: failed to enqueue webhook delivery %s: %v\n", created.ID, err)
+	}
+
+	return created, nil
+}
+
+// getEndpoint loads an endpoint of the organization after checking webhooks:manage
+func (uc *webhookUseCase) getEndpoint(orgID, endpointID, requesterID uuid.UUID) (*domain.WebhookEndpoint, error) {
+	if err := uc.policy.Require(requesterID, orgID, domain.PermissionWebhookManage); err != nil {
+		return nil, err
+	}
+
+	endpoint, err := uc.webhookRepo.GetEndpointByID(endpointID)
+	if err != nil {
+		return nil, err
+	}
+	if endpoint.OrganizationID != orgID {
+		return nil, domain.ErrNotFound
+	}
+
+	return endpoint, nil
+}
+
+// validateWebhookURL requires an absolute http(s) URL whose host resolves only to public addresses
+// The worker checks the address again when it connects, since DNS can change after registration
+func validateWebhookURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	u, err := url.Parse(raw)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Hostname() == "" {
+		return "", errors.New("invalid webhook url: must be an http(s) URL")
+	}
+	if len(raw) > 2048 {
+		return "", errors.New("invalid webhook url: too long")
+	}
+
+	ips, err := net.LookupIP(u.Hostname())
+	if err != nil || len(ips) == 0 {
+		return "", errors.New("invalid webhook url: host cannot be resolved")
+	}
+	for _, ip := range ips {
+		if !util.IsPublicWebhookIP(ip) {
+			return "", errors.New("invalid webhook url: host must not be a private, loopback or link-local address")
+		}
+	}
+
+	return raw, nil
+}
+
+// validateWebhookEventTypes requires at least one known event type and removes duplicates
+func validateWebhookEventTypes(eventTypes []string) ([]string, error) {
+	if len(eventTypes) == 0 {
+		return nil, errors.New("at least one event type is required")
+	}
+
+	result := make([]string, 0, len(eventTypes))
+	seen := make(map[string]bool, len(eventTypes))
+	for _, eventType := range eventTypes {
+		if !domain.IsValidWebhookEventType(eventType) {
+			return nil, fmt.Errorf("invalid event type: %s", eventType)
+		}
+		if !seen[eventType] {
+			seen[eventType] = true
+			result = append(result, eventType)
+		}
+	}
+
+	return result, nil
+}
+
+// generateWebhookSecret returns a random signing secret
+func generateWebhookSecret() (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate webhook secret: %w", err)
+	}
+
+	return "whsec_" + base64.RawURLEncoding.EncodeToString(secret), nil
+}
@@ -0,0 +1,198 @@
+package usecase
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+	"github.com/google/uuid"
+)
+
+const (
+	webhookPollInterval   = time.Second
+	webhookClaimBatchSize = 20
+	webhookTimeout        = 10 * time.Second
+
+	// Failed attempts are retried after 1, 2, 4, ... minutes, up to webhookMaxAttempts in total
+	webhookMaxAttempts   = 8
+	webhookRetryBaseWait = time.Minute
+	webhookRetryMaxWait  = 6 * time.Hour
+
+	webhookErrorExcerptLength = 1000
+)
+
+// WebhookWorker delivers queued webhooks, retrying failures with exponential backoff
+type WebhookWorker struct {
+	webhookRepo domain.WebhookRepository
+	queue       domain.WebhookQueue
+	client      *http.Client
+}
+
+func NewWebhookWorker(webhookRepo domain.WebhookRepository, queue domain.WebhookQueue) *WebhookWorker {
+	dialer := &net.Dialer{
+		Timeout: webhookTimeout,
+		Control: util.WebhookDialControl,
+	}
+
+	return &WebhookWorker{
+		webhookRepo: webhookRepo,
+		queue:       queue,
+		client: &http.Client{
+			Timeout: webhookTimeout,
+			// No proxy, so the dialer sees the endpoint's own address and can refuse internal ones
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: webhookTimeout,
+			},
+			// Redirects count as failures so deliveries only go to the registered URL
+			CheckRedirect: func(req *http.Request, via []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+	}
+}
+
+// Run delivers webhooks until ctx is cancelled
+// Pending deliveries are queued again first, in case the queue lost them while the worker was down
+func (w *WebhookWorker) Run(ctx context.Context) {
+	w.restorePending()
+
+	ticker := time.NewTicker(webhookPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			for {
+				ids, err := w.queue.Claim(time.Now(), webhookClaimBatchSize)
+				if err != nil {
+					log.Printf("failed to claim webhook deliveries: %v", err)
+				}
+				for _, id := range ids {
+					w.deliver(id)
+				}
+				if len(ids) < webhookClaimBatchSize || ctx.Err() != nil {
+					break
+				}
+			}
+		}
+	}
+}
+
+func (w *WebhookWorker) restorePending() {
+	deliveries, err := w.webhookRepo.GetPendingDeliveries()
+	if err != nil {
+		log.Printf("failed to restore pending webhook deliveries: %v", err)
+		return
+	}
+
+	for _, delivery := range deliveries {
+		dueAt := time.Now()
+		if delivery.NextAttemptAt != nil {
+			dueAt = *delivery.NextAttemptAt
+		}
+		if err := w.queue.Enqueue(delivery.ID, dueAt); err != nil {
+			log.Printf("failed to restore webhook delivery %s: %v", delivery.ID, err)
+		}
+	}
+}
+
+// deliver makes one attempt at a delivery and records the outcome
+func (w *WebhookWorker) deliver(deliveryID uuid.UUID) {
+	delivery, err := w.webhookRepo.GetDeliveryByID(deliveryID)
+	if err != nil {
+		// Deliveries of deleted endpoints are gone; nothing to do
+		return
+	}
+	if delivery.Status != domain.DeliveryPending {
+		return
+	}
+	endpoint, err := w.webhookRepo.GetEndpointByID(delivery.EndpointID)
+	if err != nil {
+		return
+	}
+
+	statusCode, err := w.post(endpoint, delivery)
+
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = ""
+	delivery.NextAttemptAt = nil
+	if err == nil {
+		now := time.Now()
+		delivery.Status = domain.DeliverySucceeded
+		delivery.DeliveredAt = &now
+	} else {
+		delivery.LastError = truncateText(err.Error(), webhookErrorExcerptLength)
+		if delivery.Attempts >= webhookMaxAttempts {
+			delivery.Status = domain.DeliveryFailed
+		} else {
+			next := time.Now().Add(webhookRetryWait(delivery.Attempts))
+			delivery.NextAttemptAt = &next
+		}
+	}
+
+	if err := w.webhookRepo.UpdateDelivery(delivery); err != nil {
+		log.Printf("failed to record webhook delivery %s: %v", delivery.ID, err)
+	}
+	if delivery.NextAttemptAt != nil {
+		if err := w.queue.Enqueue(delivery.ID, *delivery.NextAttemptAt); err != nil {
+			log.Printf("failed to requeue webhook delivery %s: %v", delivery.ID, err)
+		}
+	}
+}
+
+// post sends a signed delivery to its endpoint; any status outside 2xx is an error
+// Response bodies are never read, so the delivery log cannot echo back what an endpoint serves
+func (w *WebhookWorker) post(endpoint *domain.WebhookEndpoint, delivery *domain.WebhookDelivery) (*int, error) {
+	body := []byte(delivery.Payload)
+
+	req, err := http.NewRequest(http.MethodPost, endpoint.URL, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("invalid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Ticketly-Webhooks/1.0")
+	req.Header.Set(util.WebhookEventHeader, delivery.EventType)
+	req.Header.Set(util.WebhookDeliveryHeader, delivery.ID.String())
+	req.Header.Set(util.WebhookSignatureHeader, util.SignWebhookPayload(endpoint.Secret, time.Now(), body))
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	statusCode := resp.StatusCode
+	if statusCode < 200 || statusCode >= 300 {
+		return &statusCode, fmt.Errorf("endpoint responded %d", statusCode)
+	}
+
+	return &statusCode, nil
+}
+
+// webhookRetryWait is the wait before the attempt following the given number of failed attempts
+func webhookRetryWait(attempts int) time.Duration {
+	wait := webhookRetryBaseWait << (attempts - 1)
+	if wait > webhookRetryMaxWait || wait <= 0 {
+		return webhookRetryMaxWait
+	}
+	return wait
+}
+
+// truncateText cuts s to at most max bytes without splitting a UTF-8 character
+func truncateText(s string, max int) string {
+	if len(s) > max {
+		s = s[:max]
+	}
+	return strings.ToValidUTF8(s, "")
+}
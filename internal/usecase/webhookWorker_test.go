@@ -0,0 +1,356 @@
+package usecase
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/internal/domain"
+	"github.com/dev-hyunsang/ticketly-backend/internal/util"
+	"github.com/google/uuid"
+)
+
+type fakeWebhookRepository struct {
+	domain.WebhookRepository
+	endpoints  map[uuid.UUID]*domain.WebhookEndpoint
+	deliveries map[uuid.UUID]*domain.WebhookDelivery
+}
+
+func (r *fakeWebhookRepository) GetEndpointByID(endpointID uuid.UUID) (*domain.WebhookEndpoint, error) {
+	endpoint, ok := r.endpoints[endpointID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	return endpoint, nil
+}
+
+func (r *fakeWebhookRepository) CreateDelivery(delivery *domain.WebhookDelivery) (*domain.WebhookDelivery, error) {
+	created := *delivery
+	r.deliveries[created.ID] = &created
+	return &created, nil
+}
+
+func (r *fakeWebhookRepository) GetDeliveryByID(deliveryID uuid.UUID) (*domain.WebhookDelivery, error) {
+	delivery, ok := r.deliveries[deliveryID]
+	if !ok {
+		return nil, domain.ErrNotFound
+	}
+	copied := *delivery
+	return &copied, nil
+}
+
+func (r *fakeWebhookRepository) UpdateDelivery(delivery *domain.WebhookDelivery) error {
+	updated := *delivery
+	r.deliveries[updated.ID] = &updated
+	return nil
+}
+
+type fakeWebhookQueue struct {
+	due map[uuid.UUID]time.Time
+}
+
+func (q *fakeWebhookQueue) Enqueue(deliveryID uuid.UUID, dueAt time.Time) error {
+	q.due[deliveryID] = dueAt
+	return nil
+}
+
+func (q *fakeWebhookQueue) Claim(now time.Time, limit int) ([]uuid.UUID, error) {
+	return nil, nil
+}
+
+type allowAllPolicy struct {
+	AuthorizationPolicy
+}
+
+func (p *allowAllPolicy) Require(userID, orgID uuid.UUID, permission domain.Permission) error {
+	return nil
+}
+
+// webhookReceiver is a local endpoint that records the requests it gets and answers with status
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	status   int
+	requests []*http.Request
+	bodies   []string
+}
+
+func newWebhookReceiver(t *testing.T, status int) *webhookReceiver {
+	r := &webhookReceiver{status: status}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+
+		r.mu.Lock()
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, string(body))
+		status := r.status
+		r.mu.Unlock()
+
+		w.WriteHeader(status)
+		io.WriteString(w, "internal details the organization must not see")
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *webhookReceiver) setStatus(status int) {
+	r.mu.Lock()
+	r.status = status
+	r.mu.Unlock()
+}
+
+type webhookFixture struct {
+	repo     *fakeWebhookRepository
+	queue    *fakeWebhookQueue
+	worker   *WebhookWorker
+	endpoint *domain.WebhookEndpoint
+}
+
+// newWebhookFixture delivers to receiver; the test client skips the dial check, since receivers listen on loopback
+func newWebhookFixture(receiver *webhookReceiver) *webhookFixture {
+	f := &webhookFixture{
+		repo: &fakeWebhookRepository{
+			endpoints:  make(map[uuid.UUID]*domain.WebhookEndpoint),
+			deliveries: make(map[uuid.UUID]*domain.WebhookDelivery),
+		},
+		queue: &fakeWebhookQueue{due: make(map[uuid.UUID]time.Time)},
+		endpoint: &domain.WebhookEndpoint{
+			ID:             uuid.New(),
+			OrganizationID: uuid.New(),
+			URL:            receiver.URL,
+			Secret:         "whsec_test",
+			EventTypes:     []string{domain.WebhookOrderCompleted},
+			IsActive:       true,
+		},
+	}
+	f.repo.endpoints[f.endpoint.ID] = f.endpoint
+
+	f.worker = NewWebhookWorker(f.repo, f.queue)
+	f.worker.client = receiver.Client()
+
+	return f
+}
+
+func (f *webhookFixture) addDelivery(payload string) *domain.WebhookDelivery {
+	now := time.Now()
+	delivery := &domain.WebhookDelivery{
+		ID:             uuid.New(),
+		EndpointID:     f.endpoint.ID,
+		OrganizationID: f.endpoint.OrganizationID,
+		EventID:        uuid.New(),
+		EventType:      domain.WebhookOrderCompleted,
+		Payload:        payload,
+		Status:         domain.DeliveryPending,
+		NextAttemptAt:  &now,
+	}
+	f.repo.deliveries[delivery.ID] = delivery
+	return delivery
+}
+
+// verifySignature checks a signature header the way receivers are told to
+func verifySignature(t *testing.T, header, secret, body string) {
+	t.Helper()
+
+	var timestamp, signature string
+	for _, part := range strings.Split(header, ",") {
+		key, value, _ := strings.Cut(part, "=")
+		switch key {
+		case "t":
+			timestamp = value
+		case "v1":
+			signature = value
+		}
+	}
+	if timestamp == "" || signature == "" {
+		t.Fatalf("malformed signature header %q", header)
+	}
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "." + body))
+	if !hmac.Equal([]byte(signature), []byte(hex.EncodeToString(mac.Sum(nil)))) {
+		t.Errorf("signature %q does not match the body", header)
+	}
+}
+
+func TestWebhookDeliverySigned(t *testing.T) {
+	receiver := newWebhookReceiver(t, http.StatusOK)
+	f := newWebhookFixture(receiver)
+	delivery := f.addDelivery(`{"type":"order.completed"}`)
+
+	f.worker.deliver(delivery.ID)
+
+	if len(receiver.requests) != 1 {
+		t.Fatalf("receiver got %d requests, want 1", len(receiver.requests))
+	}
+	req := receiver.requests[0]
+	verifySignature(t, req.Header.Get(util.WebhookSignatureHeader), f.endpoint.Secret, receiver.bodies[0])
+	if got := req.Header.Get(util.WebhookEventHeader); got != domain.WebhookOrderCompleted {
+		t.Errorf("event header = %q, want %q", got, domain.WebhookOrderCompleted)
+	}
+	if got := req.Header.Get(util.WebhookDeliveryHeader); got != delivery.ID.String() {
+		t.Errorf("delivery header = %q, want %q", got, delivery.ID)
+	}
+
+	stored := f.repo.deliveries[delivery.ID]
+	if stored.Status != domain.DeliverySucceeded || stored.Attempts != 1 || stored.DeliveredAt == nil {
+		t.Errorf("delivery = %s after %d attempts, want succeeded after 1", stored.Status, stored.Attempts)
+	}
+}
+
+func TestWebhookDeliveryRetriesServerErrors(t *testing.T) {
+	receiver := newWebhookReceiver(t, http.StatusServiceUnavailable)
+	f := newWebhookFixture(receiver)
+	delivery := f.addDelivery(`{}`)
+
+	for attempt := 1; attempt < webhookMaxAttempts; attempt++ {
+		before := time.Now()
+		f.worker.deliver(delivery.ID)
+
+		stored := f.repo.deliveries[delivery.ID]
+		if stored.Status != domain.DeliveryPending || stored.Attempts != attempt {
+			t.Fatalf("attempt %d: delivery = %s after %d attempts, want pending", attempt, stored.Status, stored.Attempts)
+		}
+		if stored.LastStatusCode == nil || *stored.LastStatusCode != http.StatusServiceUnavailable {
+			t.Errorf("attempt %d: last status code = %v, want 503", attempt, stored.LastStatusCode)
+		}
+		if strings.Contains(stored.LastError, "internal details") {
+			t.Errorf("attempt %d: last error %q records the response body", attempt, stored.LastError)
+		}
+
+		// Waits double from one minute
+		wait := webhookRetryBaseWait << (attempt - 1)
+		due, ok := f.queue.due[delivery.ID]
+		if !ok || due.Before(before.Add(wait)) || due.After(time.Now().Add(wait)) {
+			t.Errorf("attempt %d: requeued for %v, want %v from now", attempt, due, wait)
+		}
+	}
+
+	f.worker.deliver(delivery.ID)
+	stored := f.repo.deliveries[delivery.ID]
+	if stored.Status != domain.DeliveryFailed || stored.NextAttemptAt != nil {
+		t.Errorf("delivery = %s after %d attempts, want failed with no next attempt", stored.Status, stored.Attempts)
+	}
+	if len(receiver.requests) != webhookMaxAttempts {
+		t.Errorf("receiver got %d requests, want %d", len(receiver.requests), webhookMaxAttempts)
+	}
+}
+
+func TestWebhookRetryWaitIsCapped(t *testing.T) {
+	cases := map[int]time.Duration{
+		1:  time.Minute,
+		2:  2 * time.Minute,
+		5:  16 * time.Minute,
+		20: webhookRetryMaxWait,
+		80: webhookRetryMaxWait,
+	}
+	for attempts, want := range cases {
+		if got := webhookRetryWait(attempts); got != want {
+			t.Errorf("webhookRetryWait(%d) = %v, want %v", attempts, got, want)
+		}
+	}
+}
+
+func TestWebhookReplaySendsPayloadAgain(t *testing.T) {
+	receiver := newWebhookReceiver(t, http.StatusInternalServerError)
+	f := newWebhookFixture(receiver)
+	original := f.addDelivery(`{"id":"evt_1"}`)
+	original.Status = domain.DeliveryFailed
+
+	uc := NewWebhookUseCase(f.repo, f.queue, nil, &allowAllPolicy{})
+	if _, err := uc.ReplayDelivery(uuid.New(), original.ID, uuid.New()); err == nil {
+		t.Error("replaying another organization's delivery succeeded")
+	}
+
+	replay, err := uc.ReplayDelivery(f.endpoint.OrganizationID, original.ID, uuid.New())
+	if err != nil {
+		t.Fatalf("ReplayDelivery: %v", err)
+	}
+	if replay.ID == original.ID || replay.ReplayOf == nil || *replay.ReplayOf != original.ID {
+		t.Errorf("replay %s is not a new delivery of %s", replay.ID, original.ID)
+	}
+	if _, ok := f.queue.due[replay.ID]; !ok {
+		t.Error("replay was not queued")
+	}
+
+	receiver.setStatus(http.StatusOK)
+	f.worker.deliver(replay.ID)
+
+	if len(receiver.bodies) != 1 || receiver.bodies[0] != original.Payload {
+		t.Fatalf("receiver got %q, want the original payload", receiver.bodies)
+	}
+	verifySignature(t, receiver.requests[0].Header.Get(util.WebhookSignatureHeader), f.endpoint.Secret, receiver.bodies[0])
+	if got := f.repo.deliveries[replay.ID].Status; got != domain.DeliverySucceeded {
+		t.Errorf("replay = %s, want succeeded", got)
+	}
+	if got := f.repo.deliveries[original.ID].Status; got != domain.DeliveryFailed {
+		t.Errorf("original = %s, want it left failed", got)
+	}
+
+	if _, err := uc.ReplayDelivery(f.endpoint.OrganizationID, f.addDelivery(`{}`).ID, uuid.New()); err == nil {
+		t.Error("replaying a pending delivery succeeded")
+	}
+}
+
+func TestWebhookWorkerRefusesInternalAddresses(t *testing.T) {
+	receiver := newWebhookReceiver(t, http.StatusOK)
+	f := newWebhookFixture(receiver)
+	f.worker = NewWebhookWorker(f.repo, f.queue)
+	delivery := f.addDelivery(`{}`)
+
+	f.worker.deliver(delivery.ID)
+
+	if len(receiver.requests) != 0 {
+		t.Errorf("worker delivered to a loopback receiver")
+	}
+	if stored := f.repo.deliveries[delivery.ID]; stored.Status != domain.DeliveryPending || stored.LastError == "" {
+		t.Errorf("delivery = %s with error %q, want a failed attempt", stored.Status, stored.LastError)
+	}
+}
+
+func TestValidateWebhookURLRejectsInternalHosts(t *testing.T) {
+	internal := []string{
+		"http://127.0.0.1/hook",
+		"http://localhost:8080/hook",
+		"http://10.0.0.5/hook",
+		"http://172.16.0.1/hook",
+		"http://192.168.1.1/hook",
+		"http://169.254.169.254/latest/meta-data",
+		"http://100.64.0.1/hook",
+		"http://0.0.0.0/hook",
+		"http://[::1]/hook",
+		"http://[fd00::1]/hook",
+		"http://[fe80::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+	}
+	for _, raw := range internal {
+		if _, err := validateWebhookURL(raw); err == nil {
+			t.Errorf("validateWebhookURL(%q) accepted an internal host", raw)
+		}
+	}
+
+	for _, raw := range []string{"https://93.184.216.34/hook", "https://[2606:2800:220:1::1]/hook"} {
+		if _, err := validateWebhookURL(raw); err != nil {
+			t.Errorf("validateWebhookURL(%q): %v", raw, err)
+		}
+	}
+}
+
+func TestWebhookDialControl(t *testing.T) {
+	for _, address := range []string{"127.0.0.1:443", "10.1.2.3:80", "169.254.169.254:80", "[::1]:443"} {
+		if err := util.WebhookDialControl("tcp", address, nil); err == nil {
+			t.Errorf("dial to %s allowed", address)
+		}
+	}
+	if err := util.WebhookDialControl("tcp", net.JoinHostPort("93.184.216.34", "443"), nil); err != nil {
+		t.Errorf("dial to a public address refused: %v", err)
+	}
+}
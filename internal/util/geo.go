@@ -0,0 +1,117 @@
+package util
+
+import "math"
+
+const (
+	// EarthRadiusKm is the mean radius MySQL's ST_Distance_Sphere uses, so distances computed here match the database
+	EarthRadiusKm = 6370.986
+	kmPerDegree   = EarthRadiusKm * math.Pi / 180
+
+	// GeohashPrecision is the length of stored geohashes; cells are about 5 m across
+	GeohashPrecision = 9
+)
+
+const geohashAlphabet = "0123456789bcdefghjkmnpqrstuvwxyz"
+
+// EncodeGeohash returns the geohash of a coordinate with precision characters
+func EncodeGeohash(lat, lng float64, precision int) string {
+	latRange := [2]float64{-90, 90}
+	lngRange := [2]float64{-180, 180}
+
+	hash := make([]byte, precision)
+	even := true
+	for i := range hash {
+		var index byte
+		for range 5 {
+			// Bits alternate between longitude and latitude, starting with longitude
+			r, value := &latRange, lat
+			if even {
+				r, value = &lngRange, lng
+			}
+			index <<= 1
+			if mid := (r[0] + r[1]) / 2; value >= mid {
+				index |= 1
+				r[0] = mid
+			} else {
+				r[1] = mid
+			}
+			even = !even
+		}
+		hash[i] = geohashAlphabet[index]
+	}
+	return string(hash)
+}
+
+// GeohashCover returns geohash cells whose prefixes together contain every point within radiusKm of a coordinate:
+// the cell of the coordinate and its neighbours, at the finest precision whose cells are at least radiusKm across
+// It returns nil if no precision is coarse enough, such as close to the poles
+func GeohashCover(lat, lng, radiusKm float64) []string {
+	latSpan := radiusKm / kmPerDegree
+	// Degrees of longitude shrink towards the poles, so measure at the edge of the circle closest to one
+	edge := math.Abs(lat) + latSpan
+	if edge >= 90 {
+		return nil
+	}
+	lngSpan := latSpan / math.Cos(edge*math.Pi/180)
+
+	precision := 0
+	for p := GeohashPrecision; p > 0; p-- {
+		latDeg, lngDeg := geohashCellSize(p)
+		if latDeg >= latSpan && lngDeg >= lngSpan {
+			precision = p
+			break
+		}
+	}
+	if precision == 0 {
+		return nil
+	}
+
+	latDeg, lngDeg := geohashCellSize(precision)
+	seen := make(map[string]bool, 9)
+	cells := make([]string, 0, 9)
+	for dLat := -1; dLat <= 1; dLat++ {
+		cellLat := lat + float64(dLat)*latDeg
+		if cellLat < -90 || cellLat > 90 {
+			continue
+		}
+		for dLng := -1; dLng <= 1; dLng++ {
+			cell := EncodeGeohash(cellLat, wrapLongitude(lng+float64(dLng)*lngDeg), precision)
+			if !seen[cell] {
+				seen[cell] = true
+				cells = append(cells, cell)
+			}
+		}
+	}
+	return cells
+}
+
+// DistanceKm returns the great-circle distance between two coordinates
+func DistanceKm(lat1, lng1, lat2, lng2 float64) float64 {
+	rad1, rad2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dLat := rad2 - rad1
+	dLng := (lng2 - lng1) * math.Pi / 180
+
+	// Haversine formula
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(rad1)*math.Cos(rad2)*math.Sin(dLng/2)*math.Sin(dLng/2)
+	return 2 * EarthRadiusKm * math.Asin(math.Sqrt(h))
+}
+
+// geohashCellSize returns the height and width in degrees of geohash cells with precision characters
+func geohashCellSize(precision int) (float64, float64) {
+	bits := 5 * precision
+	lngBits := (bits + 1) / 2
+	latBits := bits / 2
+	return 180 / math.Exp2(float64(latBits)), 360 / math.Exp2(float64(lngBits))
+}
+
+// wrapLongitude brings a longitude into [-180, 180)
+// 180 becomes -180 so a point on the antimeridian is covered from the cells east of it, which start at -180
+func wrapLongitude(lng float64) float64 {
+	switch {
+	case lng >= 180:
+		return lng - 360
+	case lng < -180:
+		return lng + 360
+	}
+	return lng
+}
@@ -0,0 +1,130 @@
+package util
+
+import (
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestEncodeGeohash(t *testing.T) {
+	cases := []struct {
+		lat, lng  float64
+		precision int
+		want      string
+	}{
+		{57.64911, 10.40744, 11, "u4pruydqqvj"},
+		{0, 0, 1, "s"},
+		// Just below and left of the origin falls in the cells on the other side of both edges
+		{-0.000001, -0.000001, 1, "7"},
+		{-90, -180, 3, "000"},
+		{90, 180, 3, "zzz"},
+	}
+
+	for _, tc := range cases {
+		if got := EncodeGeohash(tc.lat, tc.lng, tc.precision); got != tc.want {
+			t.Errorf("EncodeGeohash(%v, %v, %d) = %q, want %q", tc.lat, tc.lng, tc.precision, got, tc.want)
+		}
+	}
+}
+
+func TestGeohashCoverContainsRadius(t *testing.T) {
+	cases := []struct {
+		name     string
+		lat, lng float64
+		radiusKm float64
+	}{
+		{"inside a cell", 37.5665, 126.9780, 5},
+		// Four top-level cells meet at the origin
+		{"on the corner of cells", 0, 0, 1},
+		{"just inside a cell edge", 37.5665, 129.375 - 0.0001, 2},
+		{"east of the antimeridian", 10, 179.9999, 3},
+		{"west of the antimeridian", -10, -179.9999, 3},
+		{"on the antimeridian", 0, 180, 1},
+		{"far from the equator", 69.6492, 18.9553, 10},
+		{"small radius", 37.5665, 126.9780, 0.01},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			cells := GeohashCover(tc.lat, tc.lng, tc.radiusKm)
+			if len(cells) == 0 {
+				t.Fatal("got no cells")
+			}
+
+			// Every point just inside the circle must fall in one of the cells
+			for bearing := 0.0; bearing < 360; bearing += 15 {
+				lat, lng := destination(tc.lat, tc.lng, tc.radiusKm*0.999, bearing)
+				hash := EncodeGeohash(lat, lng, GeohashPrecision)
+				if !hasAnyPrefix(hash, cells) {
+					t.Errorf("point %v, %v at bearing %v (%s) is outside cells %v", lat, lng, bearing, hash, cells)
+				}
+			}
+		})
+	}
+}
+
+func TestGeohashCoverCrossesAntimeridian(t *testing.T) {
+	cells := GeohashCover(0, 179.9999, 1)
+
+	east := EncodeGeohash(0, 179.9999, GeohashPrecision)
+	west := EncodeGeohash(0, -179.9999, GeohashPrecision)
+	if !hasAnyPrefix(east, cells) || !hasAnyPrefix(west, cells) {
+		t.Errorf("cells %v should cover both %s and %s", cells, east, west)
+	}
+}
+
+func TestGeohashCoverNearPoles(t *testing.T) {
+	if cells := GeohashCover(89.95, 0, 10); cells != nil {
+		t.Errorf("got %v, want nil for a circle reaching the pole", cells)
+	}
+	if cells := GeohashCover(-89.95, 0, 10); cells != nil {
+		t.Errorf("got %v, want nil for a circle reaching the pole", cells)
+	}
+}
+
+func TestDistanceKm(t *testing.T) {
+	cases := []struct {
+		name                   string
+		lat1, lng1, lat2, lng2 float64
+		want, tolerance        float64
+	}{
+		{"same point", 37.5665, 126.9780, 37.5665, 126.9780, 0, 1e-9},
+		{"one degree along a meridian", 10, 20, 11, 20, kmPerDegree, 1e-6},
+		{"one degree along the equator", 0, 20, 0, 21, kmPerDegree, 1e-6},
+		{"across the antimeridian", 0, 179.5, 0, -179.5, kmPerDegree, 1e-6},
+		{"pole to pole", 90, 0, -90, 0, math.Pi * EarthRadiusKm, 1e-6},
+		{"antipodes", 0, 0, 0, 180, math.Pi * EarthRadiusKm, 1e-6},
+		// Seoul City Hall to Busan City Hall
+		{"seoul to busan", 37.5663, 126.9779, 35.1798, 129.0750, 325, 1},
+	}
+
+	for _, tc := range cases {
+		got := DistanceKm(tc.lat1, tc.lng1, tc.lat2, tc.lng2)
+		if math.Abs(got-tc.want) > tc.tolerance {
+			t.Errorf("%s: got %v km, want %v km", tc.name, got, tc.want)
+		}
+		if back := DistanceKm(tc.lat2, tc.lng2, tc.lat1, tc.lng1); math.Abs(back-got) > 1e-9 {
+			t.Errorf("%s: distance is not symmetric: %v and %v", tc.name, got, back)
+		}
+	}
+}
+
+// destination returns the coordinate distanceKm from a point along a bearing in degrees from north
+func destination(lat, lng, distanceKm, bearing float64) (float64, float64) {
+	rad := math.Pi / 180
+	angular := distanceKm / EarthRadiusKm
+	lat1, lng1, theta := lat*rad, lng*rad, bearing*rad
+
+	lat2 := math.Asin(math.Sin(lat1)*math.Cos(angular) + math.Cos(lat1)*math.Sin(angular)*math.Cos(theta))
+	lng2 := lng1 + math.Atan2(math.Sin(theta)*math.Sin(angular)*math.Cos(lat1), math.Cos(angular)-math.Sin(lat1)*math.Sin(lat2))
+	return lat2 / rad, wrapLongitude(lng2 / rad)
+}
+
+func hasAnyPrefix(hash string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(hash, prefix) {
+			return true
+		}
+	}
+	return false
+}
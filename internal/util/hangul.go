@@ -0,0 +1,38 @@
+package util
+
+import "strings"
+
+const (
+	hangulSyllableFirst = '가'
+	hangulSyllableLast  = '힣'
+	// Each initial consonant is followed by 21 vowels × 28 finals in the syllable block
+	hangulSyllablesPerInitial = 21 * 28
+)
+
+// hangulInitials are the 19 initial consonants in syllable block order, as compatibility jamo
+var hangulInitials = []rune("ㄱㄲㄴㄷㄸㄹㅁㅂㅃㅅㅆㅇㅈㅉㅊㅋㅌㅍㅎ")
+
+// KoreanInitials lowercases text and reduces every Hangul syllable to its initial consonant (초성),
+// so "서울 재즈" becomes "ㅅㅇ ㅈㅈ"; other characters are kept
+func KoreanInitials(text string) string {
+	var b strings.Builder
+	b.Grow(len(text))
+	for _, r := range strings.ToLower(text) {
+		if r >= hangulSyllableFirst && r <= hangulSyllableLast {
+			r = hangulInitials[(r-hangulSyllableFirst)/hangulSyllablesPerInitial]
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// HasKoreanInitials reports whether text contains a standalone consonant such as ㅈ,
+// which users type to search by initial consonants
+func HasKoreanInitials(text string) bool {
+	for _, r := range text {
+		if r >= 'ㄱ' && r <= 'ㅎ' {
+			return true
+		}
+	}
+	return false
+}
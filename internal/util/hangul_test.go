@@ -0,0 +1,59 @@
+package util
+
+import "testing"
+
+func TestKoreanInitials(t *testing.T) {
+	cases := []struct {
+		text string
+		want string
+	}{
+		{"서울 재즈", "ㅅㅇ ㅈㅈ"},
+		// Doubled initial consonants keep their own jamo
+		{"까치", "ㄲㅊ"},
+		{"딸기 빵", "ㄸㄱ ㅃ"},
+		{"쌀 짜장", "ㅆ ㅉㅈ"},
+		// Compound finals drop out with the rest of the syllable
+		{"닭갈비", "ㄷㄱㅂ"},
+		{"읽기", "ㅇㄱ"},
+		// Mixed scripts are lowercased and kept
+		{"BTS 콘서트 2026", "bts ㅋㅅㅌ 2026"},
+		{"K-POP 페스티벌!", "k-pop ㅍㅅㅌㅂ!"},
+		// Standalone jamo pass through unchanged
+		{"ㅅㅇ 재즈", "ㅅㅇ ㅈㅈ"},
+		{"ㅏㅓ", "ㅏㅓ"},
+		// First and last syllables of the block
+		{"가힣", "ㄱㅎ"},
+		{"", ""},
+	}
+
+	for _, tc := range cases {
+		if got := KoreanInitials(tc.text); got != tc.want {
+			t.Errorf("KoreanInitials(%q) = %q, want %q", tc.text, got, tc.want)
+		}
+	}
+}
+
+func TestHasKoreanInitials(t *testing.T) {
+	cases := []struct {
+		text string
+		want bool
+	}{
+		{"ㅅㅇ", true},
+		{"ㄲㅊ", true},
+		{"서ㅇ", true},
+		{"jazz ㅈ", true},
+		// Compound consonant jamo are consonants too
+		{"ㄳ", true},
+		{"서울", false},
+		{"jazz", false},
+		// Vowels alone are not initial consonants
+		{"ㅏ", false},
+		{"", false},
+	}
+
+	for _, tc := range cases {
+		if got := HasKoreanInitials(tc.text); got != tc.want {
+			t.Errorf("HasKoreanInitials(%q) = %v, want %v", tc.text, got, tc.want)
+		}
+	}
+}
@@ -15,14 +15,23 @@ type Claims struct {
 	jwt.RegisteredClaims
 }
 
+// InvitationClaims identifies an organization invitation in a signed invitation token
+type InvitationClaims struct {
+	InvitationID uuid.UUID `json:"invitation_id"`
+	Email        string    `json:"email"`
+	jwt.RegisteredClaims
+}
+
 type JWTUtil struct {
-	accessSecret  string
-	refreshSecret string
+	accessSecret     string
+	refreshSecret    string
+	invitationSecret string
 }
 
 func NewJWTUtil() *JWTUtil {
 	accessSecret := config.Getenv("JWT_ACCESS_SECRET")
 	refreshSecret := config.Getenv("JWT_REFRESH_SECRET")
+	invitationSecret := config.Getenv("JWT_INVITATION_SECRET")
 
 	if accessSecret == "" {
 		accessSecret = "default-access-secret-change-this-in-production"
@@ -30,10 +39,14 @@ func NewJWTUtil() *JWTUtil {
 	if refreshSecret == "" {
 		refreshSecret = "default-refresh-secret-change-this-in-production"
 	}
+	if invitationSecret == "" {
+		invitationSecret = "default-invitation-secret-change-this-in-production"
+	}
 
 	return &JWTUtil{
-		accessSecret:  accessSecret,
-		refreshSecret: refreshSecret,
+		accessSecret:     accessSecret,
+		refreshSecret:    refreshSecret,
+		invitationSecret: invitationSecret,
 	}
 }
 
@@ -119,6 +132,47 @@ func (j *JWTUtil) ValidateRefreshToken(tokenString string) (*Claims, error) {
 	return nil, fmt.Errorf("invalid token")
 }
 
+// GenerateInvitationToken generates a signed token for an organization invitation that expires with it
+func (j *JWTUtil) GenerateInvitationToken(invitationID uuid.UUID, email string, expiresAt time.Time) (string, error) {
+	claims := &InvitationClaims{
+		InvitationID: invitationID,
+		Email:        email,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(expiresAt),
+			IssuedAt:  jwt.NewNumericDate(time.Now()),
+			NotBefore: jwt.NewNumericDate(time.Now()),
+		},
+	}
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	tokenString, err := token.SignedString([]byte(j.invitationSecret))
+	if err != nil {
+		return "", fmt.Errorf("failed to generate invitation token: %w", err)
+	}
+
+	return tokenString, nil
+}
+
+// ValidateInvitationToken validates an organization invitation token
+func (j *JWTUtil) ValidateInvitationToken(tokenString string) (*InvitationClaims, error) {
+	token, err := jwt.ParseWithClaims(tokenString, &InvitationClaims{}, func(token *jwt.Token) (interface{}, error) {
+		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
+			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+		}
+		return []byte(j.invitationSecret), nil
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse token: %w", err)
+	}
+
+	if claims, ok := token.Claims.(*InvitationClaims); ok && token.Valid {
+		return claims, nil
+	}
+
+	return nil, fmt.Errorf("invalid token")
+}
+
 // GetAccessTokenExpiry returns access token expiration duration
 func (j *JWTUtil) GetAccessTokenExpiry() time.Duration {
 	return 15 * time.Minute
@@ -0,0 +1,91 @@
+package util
+
+import (
+	"fmt"
+	"log"
+	"mime"
+	"net/smtp"
+	"strings"
+
+	"github.com/dev-hyunsang/ticketly-backend/config"
+)
+
+// Mailer sends plain-text emails
+type Mailer interface {
+	Send(to, subject, body string) error
+}
+
+// NewMailer returns an SMTP mailer when SMTP_HOST is set and a logging mailer otherwise
+func NewMailer() Mailer {
+	host := config.Getenv("SMTP_HOST")
+	if host == "" {
+		return &logMailer{}
+	}
+
+	port := config.Getenv("SMTP_PORT")
+	if port == "" {
+		port = "587"
+	}
+
+	from := config.Getenv("SMTP_FROM")
+	if from == "" {
+		from = "no-reply@ticketly.kr"
+	}
+
+	return &smtpMailer{
+		addr:     host + ":" + port,
+		host:     host,
+		username: config.Getenv("SMTP_USERNAME"),
+		password: config.Getenv("SMTP_PASSWORD"),
+		from:     from,
+	}
+}
+
+type smtpMailer struct {
+	addr     string
+	host     string
+	username string
+	password string
+	from     string
+}
+
+// Send delivers an email through the configured SMTP server
+func (m *smtpMailer) Send(to, subject, body string) error {
+	var auth smtp.Auth
+	if m.username != "" {
+		auth = smtp.PlainAuth("", m.username, m.password, m.host)
+	}
+
+	var msg strings.Builder
+	fmt.Fprintf(&msg, "From: %s\r\n", m.from)
+	fmt.Fprintf(&msg, "To: %s\r\n", to)
+	fmt.Fprintf(&msg, "Subject: %s\r\n", mimeEncodeHeader(subject))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n")
+	msg.WriteString("\r\n")
+	msg.WriteString(strings.ReplaceAll(body, "\n", "\r\n"))
+
+	if err := smtp.SendMail(m.addr, auth, m.from, []string{to}, []byte(msg.String())); err != nil {
+		return fmt.Errorf("failed to send email: %w", err)
+	}
+
+	return nil
+}
+
+// logMailer prints emails instead of sending them, for local development
+type logMailer struct{}
+
+func (m *logMailer) Send(to, subject, body string) error {
+	log.Printf("[mail] to=%s subject=%q\n%s", to, subject, body)
+	return nil
+}
+
+// mimeEncodeHeader encodes non-ASCII header values such as Korean subjects
+func mimeEncodeHeader(value string) string {
+	for _, r := range value {
+		if r > 127 {
+			return mime.BEncoding.Encode("UTF-8", value)
+		}
+	}
+	return value
+}
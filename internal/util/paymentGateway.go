@@ -0,0 +1,95 @@
+package util
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/dev-hyunsang/ticketly-backend/config"
+)
+
+const tossPaymentAPIURL = "https://api.tosspayments.com/v1/payments/"
+
+// PaymentGateway refunds payments made through the payment gateway
+type PaymentGateway interface {
+	// CancelPayment fully refunds a payment
+	// idempotencyKey makes retries of the same refund safe
+	CancelPayment(paymentKey, reason, idempotencyKey string) error
+}
+
+// NewPaymentGateway returns a Toss Payments client when TOSS_PG_SECRET_KEY is set and a logging gateway otherwise
+func NewPaymentGateway() PaymentGateway {
+	secretKey := config.Getenv("TOSS_PG_SECRET_KEY")
+	if secretKey == "" {
+		return &logGateway{}
+	}
+
+	return &tossGateway{
+		authorization: "Basic " + base64.StdEncoding.EncodeToString([]byte(secretKey+":")),
+		client:        &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+type tossGateway struct {
+	authorization string
+	client        *http.Client
+}
+
+// CancelPayment cancels a payment through the Toss Payments cancel API
+// A payment that is already cancelled counts as refunded
+func (g *tossGateway) CancelPayment(paymentKey, reason, idempotencyKey string) error {
+	if paymentKey == "" {
+		return fmt.Errorf("payment has no payment key")
+	}
+
+	payload, err := json.Marshal(map[string]string{"cancelReason": reason})
+	if err != nil {
+		return fmt.Errorf("failed to marshal payload: %w", err)
+	}
+
+	req, err := http.NewRequest(http.MethodPost, tossPaymentAPIURL+url.PathEscape(paymentKey)+"/cancel", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to create HTTP request: %w", err)
+	}
+	req.Header.Set("Authorization", g.authorization)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Idempotency-Key", idempotencyKey)
+
+	resp, err := g.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("HTTP request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+	var tossErr struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(body, &tossErr) == nil && tossErr.Code != "" {
+		if tossErr.Code == "ALREADY_CANCELED_PAYMENT" {
+			return nil
+		}
+		return fmt.Errorf("payment cancel failed: %s: %s", tossErr.Code, tossErr.Message)
+	}
+
+	return fmt.Errorf("payment cancel failed: status %d: %s", resp.StatusCode, string(body))
+}
+
+// logGateway prints refunds instead of sending them, for local development
+type logGateway struct{}
+
+func (g *logGateway) CancelPayment(paymentKey, reason, idempotencyKey string) error {
+	log.Printf("[payment] cancel paymentKey=%s reason=%q idempotencyKey=%s", paymentKey, reason, idempotencyKey)
+	return nil
+}
@@ -0,0 +1,43 @@
+package util
+
+import (
+	"regexp"
+	"strings"
+)
+
+const (
+	MinSlugLength = 3
+	MaxSlugLength = 50
+)
+
+var slugPattern = regexp.MustCompile(`^[a-z0-9]+(-[a-z0-9]+)*$`)
+
+// Slugify converts text to a URL slug of lowercase ASCII letters, digits and single hyphens
+// Other characters (including Korean) are dropped, so the result may be empty
+func Slugify(text string) string {
+	var b strings.Builder
+	hyphen := false
+	for _, r := range strings.ToLower(text) {
+		switch {
+		case r >= 'a' && r <= 'z', r >= '0' && r <= '9':
+			if hyphen && b.Len() > 0 {
+				b.WriteByte('-')
+			}
+			b.WriteRune(r)
+			hyphen = false
+		default:
+			hyphen = true
+		}
+	}
+
+	slug := b.String()
+	if len(slug) > MaxSlugLength {
+		slug = strings.TrimRight(slug[:MaxSlugLength], "-")
+	}
+	return slug
+}
+
+// IsValidSlug checks if a slug is lowercase letters, digits and single hyphens of an allowed length
+func IsValidSlug(slug string) bool {
+	return len(slug) >= MinSlugLength && len(slug) <= MaxSlugLength && slugPattern.MatchString(slug)
+}
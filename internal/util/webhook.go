@@ -0,0 +1,64 @@
+package util
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"net/netip"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+// Webhook request headers
+const (
+	WebhookSignatureHeader = "X-Ticketly-Signature"
+	WebhookEventHeader     = "X-Ticketly-Event"
+	WebhookDeliveryHeader  = "X-Ticketly-Delivery"
+)
+
+// SignWebhookPayload returns the signature header value for a webhook body
+// The format is t=<unix seconds>,v1=<hex HMAC-SHA256 of "<t>.<body>">; receivers recompute v1
+// with their endpoint secret and reject old timestamps to prevent replays
+func SignWebhookPayload(secret string, timestamp time.Time, body []byte) string {
+	t := strconv.FormatInt(timestamp.Unix(), 10)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return fmt.Sprintf("t=%s,v1=%s", t, hex.EncodeToString(mac.Sum(nil)))
+}
+
+// sharedAddressSpace is the carrier-grade NAT range, which is internal like private ranges
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// IsPublicWebhookIP reports whether webhooks may be delivered to an address
+// Loopback, private, link-local (which includes cloud metadata services), multicast and unspecified addresses are refused
+func IsPublicWebhookIP(ip net.IP) bool {
+	addr, ok := netip.AddrFromSlice(ip)
+	if !ok {
+		return false
+	}
+	addr = addr.Unmap()
+
+	return !(addr.IsLoopback() || addr.IsPrivate() || addr.IsUnspecified() ||
+		addr.IsLinkLocalUnicast() || addr.IsLinkLocalMulticast() || addr.IsInterfaceLocalMulticast() || addr.IsMulticast() ||
+		sharedAddressSpace.Contains(addr) || (addr.Is4() && addr.As4()[0] == 0))
+}
+
+// WebhookDialControl is a net.Dialer Control that refuses connections to addresses IsPublicWebhookIP rejects
+// It runs on the resolved address, so a host that resolves to an internal address after registration is refused too
+func WebhookDialControl(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	if ip := net.ParseIP(host); ip == nil || !IsPublicWebhookIP(ip) {
+		return fmt.Errorf("webhook address %s is not public", host)
+	}
+	return nil
+}
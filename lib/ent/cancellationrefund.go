@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/google/uuid"
+)
+
+// CancellationRefund is the model entity for the CancellationRefund schema.
+type CancellationRefund struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Event cancellation the refund belongs to
+	CancellationID uuid.UUID `json:"cancellation_id,omitempty"`
+	// Payment that is refunded or cancelled
+	PaymentID uuid.UUID `json:"payment_id,omitempty"`
+	// gateway for online payments, manual for box-office sales, none when nothing was charged; decided again at each attempt
+	Method cancellationrefund.Method `json:"method,omitempty"`
+	// awaiting_confirmation while the organizer pays back a manual refund; failed once every retry is used
+	Status cancellationrefund.Status `json:"status,omitempty"`
+	// Number of refund attempts made
+	Attempts int `json:"attempts,omitempty"`
+	// Error of the last failed attempt
+	LastError string `json:"last_error,omitempty"`
+	// When the next attempt is due while pending
+	NextAttemptAt *time.Time `json:"next_attempt_at,omitempty"`
+	// RefundedAt holds the value of the "refunded_at" field.
+	RefundedAt *time.Time `json:"refunded_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the CancellationRefundQuery when eager-loading is set.
+	Edges        CancellationRefundEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// CancellationRefundEdges holds the relations/edges for other nodes in the graph.
+type CancellationRefundEdges struct {
+	// Cancellation holds the value of the cancellation edge.
+	Cancellation *EventCancellation `json:"cancellation,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// CancellationOrErr returns the Cancellation value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e CancellationRefundEdges) CancellationOrErr() (*EventCancellation, error) {
+	if e.Cancellation != nil {
+		return e.Cancellation, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: eventcancellation.Label}
+	}
+	return nil, &NotLoadedError{edge: "cancellation"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*CancellationRefund) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case cancellationrefund.FieldAttempts:
+			values[i] = new(sql.NullInt64)
+		case cancellationrefund.FieldMethod, cancellationrefund.FieldStatus, cancellationrefund.FieldLastError:
+			values[i] = new(sql.NullString)
+		case cancellationrefund.FieldNextAttemptAt, cancellationrefund.FieldRefundedAt, cancellationrefund.FieldCreatedAt, cancellationrefund.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case cancellationrefund.FieldID, cancellationrefund.FieldCancellationID, cancellationrefund.FieldPaymentID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the CancellationRefund fields.
+func (_m *CancellationRefund) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case cancellationrefund.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case cancellationrefund.FieldCancellationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field cancellation_id", values[i])
+			} else if value != nil {
+				_m.CancellationID = *value
+			}
+		case cancellationrefund.FieldPaymentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_id", values[i])
+			} else if value != nil {
+				_m.PaymentID = *value
+			}
+		case cancellationrefund.FieldMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field method", values[i])
+			} else if value.Valid {
+				_m.Method = cancellationrefund.Method(value.String)
+			}
+		case cancellationrefund.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = cancellationrefund.Status(value.String)
+			}
+		case cancellationrefund.FieldAttempts:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field attempts", values[i])
+			} else if value.Valid {
+				_m.Attempts = int(value.Int64)
+			}
+		case cancellationrefund.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				_m.LastError = value.String
+			}
+		case cancellationrefund.FieldNextAttemptAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field next_attempt_at", values[i])
+			} else if value.Valid {
+				_m.NextAttemptAt = new(time.Time)
+				*_m.NextAttemptAt = value.Time
+			}
+		case cancellationrefund.FieldRefundedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refunded_at", values[i])
+			} else if value.Valid {
+				_m.RefundedAt = new(time.Time)
+				*_m.RefundedAt = value.Time
+			}
+		case cancellationrefund.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case cancellationrefund.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the CancellationRefund.
+// This includes values selected through modifiers, order, etc.
+func (_m *CancellationRefund) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryCancellation queries the "cancellation" edge of the CancellationRefund entity.
+func (_m *CancellationRefund) QueryCancellation() *EventCancellationQuery {
+	return NewCancellationRefundClient(_m.config).QueryCancellation(_m)
+}
+
+// Update returns a builder for updating this CancellationRefund.
+// Note that you need to call CancellationRefund.Unwrap() before calling this method if this CancellationRefund
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *CancellationRefund) Update() *CancellationRefundUpdateOne {
+	return NewCancellationRefundClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the CancellationRefund entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *CancellationRefund) Unwrap() *CancellationRefund {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: CancellationRefund is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *CancellationRefund) String() string {
+	var builder strings.Builder
+	builder.WriteString("CancellationRefund(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("cancellation_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CancellationID))
+	builder.WriteString(", ")
+	builder.WriteString("payment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentID))
+	builder.WriteString(", ")
+	builder.WriteString("method=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Method))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("attempts=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Attempts))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(_m.LastError)
+	builder.WriteString(", ")
+	if v := _m.NextAttemptAt; v != nil {
+		builder.WriteString("next_attempt_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.RefundedAt; v != nil {
+		builder.WriteString("refunded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// CancellationRefunds is a parsable slice of CancellationRefund.
+type CancellationRefunds []*CancellationRefund
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package cancellationrefund
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the cancellationrefund type in the database.
+	Label = "cancellation_refund"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldCancellationID holds the string denoting the cancellation_id field in the database.
+	FieldCancellationID = "cancellation_id"
+	// FieldPaymentID holds the string denoting the payment_id field in the database.
+	FieldPaymentID = "payment_id"
+	// FieldMethod holds the string denoting the method field in the database.
+	FieldMethod = "method"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldAttempts holds the string denoting the attempts field in the database.
+	FieldAttempts = "attempts"
+	// FieldLastError holds the string denoting the last_error field in the database.
+	FieldLastError = "last_error"
+	// FieldNextAttemptAt holds the string denoting the next_attempt_at field in the database.
+	FieldNextAttemptAt = "next_attempt_at"
+	// FieldRefundedAt holds the string denoting the refunded_at field in the database.
+	FieldRefundedAt = "refunded_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeCancellation holds the string denoting the cancellation edge name in mutations.
+	EdgeCancellation = "cancellation"
+	// Table holds the table name of the cancellationrefund in the database.
+	Table = "cancellation_refunds"
+	// CancellationTable is the table that holds the cancellation relation/edge.
+	CancellationTable = "cancellation_refunds"
+	// CancellationInverseTable is the table name for the EventCancellation entity.
+	// It exists in this package in order to avoid circular dependency with the "eventcancellation" package.
+	CancellationInverseTable = "event_cancellations"
+	// CancellationColumn is the table column denoting the cancellation relation/edge.
+	CancellationColumn = "cancellation_id"
+)
+
+// Columns holds all SQL columns for cancellationrefund fields.
+var Columns = []string{
+	FieldID,
+	FieldCancellationID,
+	FieldPaymentID,
+	FieldMethod,
+	FieldStatus,
+	FieldAttempts,
+	FieldLastError,
+	FieldNextAttemptAt,
+	FieldRefundedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultAttempts holds the default value on creation for the "attempts" field.
+	DefaultAttempts int
+	// AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	AttemptsValidator func(int) error
+	// LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	LastErrorValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Method defines the type for the "method" enum field.
+type Method string
+
+// Method values.
+const (
+	MethodGateway Method = "gateway"
+	MethodManual  Method = "manual"
+	MethodNone    Method = "none"
+)
+
+func (m Method) String() string {
+	return string(m)
+}
+
+// MethodValidator is a validator for the "method" field enum values. It is called by the builders before save.
+func MethodValidator(m Method) error {
+	switch m {
+	case MethodGateway, MethodManual, MethodNone:
+		return nil
+	default:
+		return fmt.Errorf("cancellationrefund: invalid enum value for method field: %q", m)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusPending is the default value of the Status enum.
+const DefaultStatus = StatusPending
+
+// Status values.
+const (
+	StatusPending              Status = "pending"
+	StatusAwaitingConfirmation Status = "awaiting_confirmation"
+	StatusSucceeded            Status = "succeeded"
+	StatusFailed               Status = "failed"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusPending, StatusAwaitingConfirmation, StatusSucceeded, StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("cancellationrefund: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the CancellationRefund queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByCancellationID orders the results by the cancellation_id field.
+func ByCancellationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCancellationID, opts...).ToFunc()
+}
+
+// ByPaymentID orders the results by the payment_id field.
+func ByPaymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentID, opts...).ToFunc()
+}
+
+// ByMethod orders the results by the method field.
+func ByMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMethod, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByAttempts orders the results by the attempts field.
+func ByAttempts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAttempts, opts...).ToFunc()
+}
+
+// ByLastError orders the results by the last_error field.
+func ByLastError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLastError, opts...).ToFunc()
+}
+
+// ByNextAttemptAt orders the results by the next_attempt_at field.
+func ByNextAttemptAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNextAttemptAt, opts...).ToFunc()
+}
+
+// ByRefundedAt orders the results by the refunded_at field.
+func ByRefundedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByCancellationField orders the results by cancellation field.
+func ByCancellationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newCancellationStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newCancellationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(CancellationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, CancellationTable, CancellationColumn),
+	)
+}
@@ -0,0 +1,436 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/google/uuid"
+)
+
+// CancellationRefundCreate is the builder for creating a CancellationRefund entity.
+type CancellationRefundCreate struct {
+	config
+	mutation *CancellationRefundMutation
+	hooks    []Hook
+}
+
+// SetCancellationID sets the "cancellation_id" field.
+func (_c *CancellationRefundCreate) SetCancellationID(v uuid.UUID) *CancellationRefundCreate {
+	_c.mutation.SetCancellationID(v)
+	return _c
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (_c *CancellationRefundCreate) SetPaymentID(v uuid.UUID) *CancellationRefundCreate {
+	_c.mutation.SetPaymentID(v)
+	return _c
+}
+
+// SetMethod sets the "method" field.
+func (_c *CancellationRefundCreate) SetMethod(v cancellationrefund.Method) *CancellationRefundCreate {
+	_c.mutation.SetMethod(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *CancellationRefundCreate) SetStatus(v cancellationrefund.Status) *CancellationRefundCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableStatus(v *cancellationrefund.Status) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetAttempts sets the "attempts" field.
+func (_c *CancellationRefundCreate) SetAttempts(v int) *CancellationRefundCreate {
+	_c.mutation.SetAttempts(v)
+	return _c
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableAttempts(v *int) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetAttempts(*v)
+	}
+	return _c
+}
+
+// SetLastError sets the "last_error" field.
+func (_c *CancellationRefundCreate) SetLastError(v string) *CancellationRefundCreate {
+	_c.mutation.SetLastError(v)
+	return _c
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableLastError(v *string) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetLastError(*v)
+	}
+	return _c
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_c *CancellationRefundCreate) SetNextAttemptAt(v time.Time) *CancellationRefundCreate {
+	_c.mutation.SetNextAttemptAt(v)
+	return _c
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableNextAttemptAt(v *time.Time) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetNextAttemptAt(*v)
+	}
+	return _c
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_c *CancellationRefundCreate) SetRefundedAt(v time.Time) *CancellationRefundCreate {
+	_c.mutation.SetRefundedAt(v)
+	return _c
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableRefundedAt(v *time.Time) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetRefundedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CancellationRefundCreate) SetCreatedAt(v time.Time) *CancellationRefundCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableCreatedAt(v *time.Time) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *CancellationRefundCreate) SetUpdatedAt(v time.Time) *CancellationRefundCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableUpdatedAt(v *time.Time) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CancellationRefundCreate) SetID(v uuid.UUID) *CancellationRefundCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CancellationRefundCreate) SetNillableID(v *uuid.UUID) *CancellationRefundCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetCancellation sets the "cancellation" edge to the EventCancellation entity.
+func (_c *CancellationRefundCreate) SetCancellation(v *EventCancellation) *CancellationRefundCreate {
+	return _c.SetCancellationID(v.ID)
+}
+
+// Mutation returns the CancellationRefundMutation object of the builder.
+func (_c *CancellationRefundCreate) Mutation() *CancellationRefundMutation {
+	return _c.mutation
+}
+
+// Save creates the CancellationRefund in the database.
+func (_c *CancellationRefundCreate) Save(ctx context.Context) (*CancellationRefund, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CancellationRefundCreate) SaveX(ctx context.Context) *CancellationRefund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CancellationRefundCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CancellationRefundCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CancellationRefundCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := cancellationrefund.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		v := cancellationrefund.DefaultAttempts
+		_c.mutation.SetAttempts(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := cancellationrefund.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := cancellationrefund.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := cancellationrefund.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CancellationRefundCreate) check() error {
+	if _, ok := _c.mutation.CancellationID(); !ok {
+		return &ValidationError{Name: "cancellation_id", err: errors.New(`ent: missing required field "CancellationRefund.cancellation_id"`)}
+	}
+	if _, ok := _c.mutation.PaymentID(); !ok {
+		return &ValidationError{Name: "payment_id", err: errors.New(`ent: missing required field "CancellationRefund.payment_id"`)}
+	}
+	if _, ok := _c.mutation.Method(); !ok {
+		return &ValidationError{Name: "method", err: errors.New(`ent: missing required field "CancellationRefund.method"`)}
+	}
+	if v, ok := _c.mutation.Method(); ok {
+		if err := cancellationrefund.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.method": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "CancellationRefund.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := cancellationrefund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Attempts(); !ok {
+		return &ValidationError{Name: "attempts", err: errors.New(`ent: missing required field "CancellationRefund.attempts"`)}
+	}
+	if v, ok := _c.mutation.Attempts(); ok {
+		if err := cancellationrefund.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.LastError(); ok {
+		if err := cancellationrefund.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.last_error": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "CancellationRefund.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "CancellationRefund.updated_at"`)}
+	}
+	if len(_c.mutation.CancellationIDs()) == 0 {
+		return &ValidationError{Name: "cancellation", err: errors.New(`ent: missing required edge "CancellationRefund.cancellation"`)}
+	}
+	return nil
+}
+
+func (_c *CancellationRefundCreate) sqlSave(ctx context.Context) (*CancellationRefund, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CancellationRefundCreate) createSpec() (*CancellationRefund, *sqlgraph.CreateSpec) {
+	var (
+		_node = &CancellationRefund{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(cancellationrefund.Table, sqlgraph.NewFieldSpec(cancellationrefund.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.PaymentID(); ok {
+		_spec.SetField(cancellationrefund.FieldPaymentID, field.TypeUUID, value)
+		_node.PaymentID = value
+	}
+	if value, ok := _c.mutation.Method(); ok {
+		_spec.SetField(cancellationrefund.FieldMethod, field.TypeEnum, value)
+		_node.Method = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(cancellationrefund.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.Attempts(); ok {
+		_spec.SetField(cancellationrefund.FieldAttempts, field.TypeInt, value)
+		_node.Attempts = value
+	}
+	if value, ok := _c.mutation.LastError(); ok {
+		_spec.SetField(cancellationrefund.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := _c.mutation.NextAttemptAt(); ok {
+		_spec.SetField(cancellationrefund.FieldNextAttemptAt, field.TypeTime, value)
+		_node.NextAttemptAt = &value
+	}
+	if value, ok := _c.mutation.RefundedAt(); ok {
+		_spec.SetField(cancellationrefund.FieldRefundedAt, field.TypeTime, value)
+		_node.RefundedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(cancellationrefund.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(cancellationrefund.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.CancellationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   cancellationrefund.CancellationTable,
+			Columns: []string{cancellationrefund.CancellationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventcancellation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.CancellationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// CancellationRefundCreateBulk is the builder for creating many CancellationRefund entities in bulk.
+type CancellationRefundCreateBulk struct {
+	config
+	err      error
+	builders []*CancellationRefundCreate
+}
+
+// Save creates the CancellationRefund entities in the database.
+func (_c *CancellationRefundCreateBulk) Save(ctx context.Context) ([]*CancellationRefund, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*CancellationRefund, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CancellationRefundMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CancellationRefundCreateBulk) SaveX(ctx context.Context) []*CancellationRefund {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CancellationRefundCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CancellationRefundCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
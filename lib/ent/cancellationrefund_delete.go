@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+)
+
+// CancellationRefundDelete is the builder for deleting a CancellationRefund entity.
+type CancellationRefundDelete struct {
+	config
+	hooks    []Hook
+	mutation *CancellationRefundMutation
+}
+
+// Where appends a list predicates to the CancellationRefundDelete builder.
+func (_d *CancellationRefundDelete) Where(ps ...predicate.CancellationRefund) *CancellationRefundDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *CancellationRefundDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CancellationRefundDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *CancellationRefundDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(cancellationrefund.Table, sqlgraph.NewFieldSpec(cancellationrefund.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// CancellationRefundDeleteOne is the builder for deleting a single CancellationRefund entity.
+type CancellationRefundDeleteOne struct {
+	_d *CancellationRefundDelete
+}
+
+// Where appends a list predicates to the CancellationRefundDelete builder.
+func (_d *CancellationRefundDeleteOne) Where(ps ...predicate.CancellationRefund) *CancellationRefundDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *CancellationRefundDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{cancellationrefund.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *CancellationRefundDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,552 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+)
+
+// CancellationRefundUpdate is the builder for updating CancellationRefund entities.
+type CancellationRefundUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CancellationRefundMutation
+}
+
+// Where appends a list predicates to the CancellationRefundUpdate builder.
+func (_u *CancellationRefundUpdate) Where(ps ...predicate.CancellationRefund) *CancellationRefundUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetMethod sets the "method" field.
+func (_u *CancellationRefundUpdate) SetMethod(v cancellationrefund.Method) *CancellationRefundUpdate {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *CancellationRefundUpdate) SetNillableMethod(v *cancellationrefund.Method) *CancellationRefundUpdate {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CancellationRefundUpdate) SetStatus(v cancellationrefund.Status) *CancellationRefundUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CancellationRefundUpdate) SetNillableStatus(v *cancellationrefund.Status) *CancellationRefundUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *CancellationRefundUpdate) SetAttempts(v int) *CancellationRefundUpdate {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CancellationRefundUpdate) SetNillableAttempts(v *int) *CancellationRefundUpdate {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *CancellationRefundUpdate) AddAttempts(v int) *CancellationRefundUpdate {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CancellationRefundUpdate) SetLastError(v string) *CancellationRefundUpdate {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CancellationRefundUpdate) SetNillableLastError(v *string) *CancellationRefundUpdate {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CancellationRefundUpdate) ClearLastError() *CancellationRefundUpdate {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CancellationRefundUpdate) SetNextAttemptAt(v time.Time) *CancellationRefundUpdate {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CancellationRefundUpdate) SetNillableNextAttemptAt(v *time.Time) *CancellationRefundUpdate {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *CancellationRefundUpdate) ClearNextAttemptAt() *CancellationRefundUpdate {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *CancellationRefundUpdate) SetRefundedAt(v time.Time) *CancellationRefundUpdate {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *CancellationRefundUpdate) SetNillableRefundedAt(v *time.Time) *CancellationRefundUpdate {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *CancellationRefundUpdate) ClearRefundedAt() *CancellationRefundUpdate {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CancellationRefundUpdate) SetUpdatedAt(v time.Time) *CancellationRefundUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CancellationRefundMutation object of the builder.
+func (_u *CancellationRefundUpdate) Mutation() *CancellationRefundMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CancellationRefundUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CancellationRefundUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CancellationRefundUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CancellationRefundUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CancellationRefundUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cancellationrefund.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CancellationRefundUpdate) check() error {
+	if v, ok := _u.mutation.Method(); ok {
+		if err := cancellationrefund.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := cancellationrefund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := cancellationrefund.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := cancellationrefund.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.last_error": %w`, err)}
+		}
+	}
+	if _u.mutation.CancellationCleared() && len(_u.mutation.CancellationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CancellationRefund.cancellation"`)
+	}
+	return nil
+}
+
+func (_u *CancellationRefundUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cancellationrefund.Table, cancellationrefund.Columns, sqlgraph.NewFieldSpec(cancellationrefund.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(cancellationrefund.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cancellationrefund.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(cancellationrefund.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(cancellationrefund.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(cancellationrefund.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(cancellationrefund.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(cancellationrefund.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(cancellationrefund.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(cancellationrefund.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(cancellationrefund.FieldRefundedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cancellationrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cancellationrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CancellationRefundUpdateOne is the builder for updating a single CancellationRefund entity.
+type CancellationRefundUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CancellationRefundMutation
+}
+
+// SetMethod sets the "method" field.
+func (_u *CancellationRefundUpdateOne) SetMethod(v cancellationrefund.Method) *CancellationRefundUpdateOne {
+	_u.mutation.SetMethod(v)
+	return _u
+}
+
+// SetNillableMethod sets the "method" field if the given value is not nil.
+func (_u *CancellationRefundUpdateOne) SetNillableMethod(v *cancellationrefund.Method) *CancellationRefundUpdateOne {
+	if v != nil {
+		_u.SetMethod(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *CancellationRefundUpdateOne) SetStatus(v cancellationrefund.Status) *CancellationRefundUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *CancellationRefundUpdateOne) SetNillableStatus(v *cancellationrefund.Status) *CancellationRefundUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetAttempts sets the "attempts" field.
+func (_u *CancellationRefundUpdateOne) SetAttempts(v int) *CancellationRefundUpdateOne {
+	_u.mutation.ResetAttempts()
+	_u.mutation.SetAttempts(v)
+	return _u
+}
+
+// SetNillableAttempts sets the "attempts" field if the given value is not nil.
+func (_u *CancellationRefundUpdateOne) SetNillableAttempts(v *int) *CancellationRefundUpdateOne {
+	if v != nil {
+		_u.SetAttempts(*v)
+	}
+	return _u
+}
+
+// AddAttempts adds value to the "attempts" field.
+func (_u *CancellationRefundUpdateOne) AddAttempts(v int) *CancellationRefundUpdateOne {
+	_u.mutation.AddAttempts(v)
+	return _u
+}
+
+// SetLastError sets the "last_error" field.
+func (_u *CancellationRefundUpdateOne) SetLastError(v string) *CancellationRefundUpdateOne {
+	_u.mutation.SetLastError(v)
+	return _u
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (_u *CancellationRefundUpdateOne) SetNillableLastError(v *string) *CancellationRefundUpdateOne {
+	if v != nil {
+		_u.SetLastError(*v)
+	}
+	return _u
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (_u *CancellationRefundUpdateOne) ClearLastError() *CancellationRefundUpdateOne {
+	_u.mutation.ClearLastError()
+	return _u
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (_u *CancellationRefundUpdateOne) SetNextAttemptAt(v time.Time) *CancellationRefundUpdateOne {
+	_u.mutation.SetNextAttemptAt(v)
+	return _u
+}
+
+// SetNillableNextAttemptAt sets the "next_attempt_at" field if the given value is not nil.
+func (_u *CancellationRefundUpdateOne) SetNillableNextAttemptAt(v *time.Time) *CancellationRefundUpdateOne {
+	if v != nil {
+		_u.SetNextAttemptAt(*v)
+	}
+	return _u
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (_u *CancellationRefundUpdateOne) ClearNextAttemptAt() *CancellationRefundUpdateOne {
+	_u.mutation.ClearNextAttemptAt()
+	return _u
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (_u *CancellationRefundUpdateOne) SetRefundedAt(v time.Time) *CancellationRefundUpdateOne {
+	_u.mutation.SetRefundedAt(v)
+	return _u
+}
+
+// SetNillableRefundedAt sets the "refunded_at" field if the given value is not nil.
+func (_u *CancellationRefundUpdateOne) SetNillableRefundedAt(v *time.Time) *CancellationRefundUpdateOne {
+	if v != nil {
+		_u.SetRefundedAt(*v)
+	}
+	return _u
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (_u *CancellationRefundUpdateOne) ClearRefundedAt() *CancellationRefundUpdateOne {
+	_u.mutation.ClearRefundedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *CancellationRefundUpdateOne) SetUpdatedAt(v time.Time) *CancellationRefundUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the CancellationRefundMutation object of the builder.
+func (_u *CancellationRefundUpdateOne) Mutation() *CancellationRefundMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CancellationRefundUpdate builder.
+func (_u *CancellationRefundUpdateOne) Where(ps ...predicate.CancellationRefund) *CancellationRefundUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CancellationRefundUpdateOne) Select(field string, fields ...string) *CancellationRefundUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated CancellationRefund entity.
+func (_u *CancellationRefundUpdateOne) Save(ctx context.Context) (*CancellationRefund, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CancellationRefundUpdateOne) SaveX(ctx context.Context) *CancellationRefund {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CancellationRefundUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CancellationRefundUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *CancellationRefundUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := cancellationrefund.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CancellationRefundUpdateOne) check() error {
+	if v, ok := _u.mutation.Method(); ok {
+		if err := cancellationrefund.MethodValidator(v); err != nil {
+			return &ValidationError{Name: "method", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.method": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := cancellationrefund.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.status": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Attempts(); ok {
+		if err := cancellationrefund.AttemptsValidator(v); err != nil {
+			return &ValidationError{Name: "attempts", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.attempts": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.LastError(); ok {
+		if err := cancellationrefund.LastErrorValidator(v); err != nil {
+			return &ValidationError{Name: "last_error", err: fmt.Errorf(`ent: validator failed for field "CancellationRefund.last_error": %w`, err)}
+		}
+	}
+	if _u.mutation.CancellationCleared() && len(_u.mutation.CancellationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "CancellationRefund.cancellation"`)
+	}
+	return nil
+}
+
+func (_u *CancellationRefundUpdateOne) sqlSave(ctx context.Context) (_node *CancellationRefund, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(cancellationrefund.Table, cancellationrefund.Columns, sqlgraph.NewFieldSpec(cancellationrefund.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "CancellationRefund.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, cancellationrefund.FieldID)
+		for _, f := range fields {
+			if !cancellationrefund.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != cancellationrefund.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Method(); ok {
+		_spec.SetField(cancellationrefund.FieldMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(cancellationrefund.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Attempts(); ok {
+		_spec.SetField(cancellationrefund.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAttempts(); ok {
+		_spec.AddField(cancellationrefund.FieldAttempts, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.LastError(); ok {
+		_spec.SetField(cancellationrefund.FieldLastError, field.TypeString, value)
+	}
+	if _u.mutation.LastErrorCleared() {
+		_spec.ClearField(cancellationrefund.FieldLastError, field.TypeString)
+	}
+	if value, ok := _u.mutation.NextAttemptAt(); ok {
+		_spec.SetField(cancellationrefund.FieldNextAttemptAt, field.TypeTime, value)
+	}
+	if _u.mutation.NextAttemptAtCleared() {
+		_spec.ClearField(cancellationrefund.FieldNextAttemptAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RefundedAt(); ok {
+		_spec.SetField(cancellationrefund.FieldRefundedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RefundedAtCleared() {
+		_spec.ClearField(cancellationrefund.FieldRefundedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(cancellationrefund.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &CancellationRefund{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{cancellationrefund.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
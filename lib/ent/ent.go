@@ -12,11 +12,26 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/apikey"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/auditlog"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventchange"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventfavorite"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationmember"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationrole"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookdelivery"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookendpoint"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -77,11 +92,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			event.Table:              event.ValidColumn,
-			organization.Table:       organization.ValidColumn,
-			organizationmember.Table: organizationmember.ValidColumn,
-			payment.Table:            payment.ValidColumn,
-			user.Table:               user.ValidColumn,
+			apikey.Table:                 apikey.ValidColumn,
+			auditlog.Table:               auditlog.ValidColumn,
+			cancellationrefund.Table:     cancellationrefund.ValidColumn,
+			event.Table:                  event.ValidColumn,
+			eventcancellation.Table:      eventcancellation.ValidColumn,
+			eventchange.Table:            eventchange.ValidColumn,
+			eventfavorite.Table:          eventfavorite.ValidColumn,
+			eventseries.Table:            eventseries.ValidColumn,
+			organization.Table:           organization.ValidColumn,
+			organizationinvitation.Table: organizationinvitation.ValidColumn,
+			organizationmember.Table:     organizationmember.ValidColumn,
+			organizationrole.Table:       organizationrole.ValidColumn,
+			ownershiptransfer.Table:      ownershiptransfer.ValidColumn,
+			payment.Table:                payment.ValidColumn,
+			registrationanswer.Table:     registrationanswer.ValidColumn,
+			registrationquestion.Table:   registrationquestion.ValidColumn,
+			user.Table:                   user.ValidColumn,
+			venue.Table:                  venue.ValidColumn,
+			webhookdelivery.Table:        webhookdelivery.ValidColumn,
+			webhookendpoint.Table:        webhookendpoint.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
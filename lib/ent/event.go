@@ -10,8 +10,10 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
 	"github.com/google/uuid"
 )
 
@@ -22,6 +24,8 @@ type Event struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Organization that hosts this event
 	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Series the event is an occurrence of
+	SeriesID *uuid.UUID `json:"series_id,omitempty"`
 	// Event title
 	Title string `json:"title,omitempty"`
 	// Event description
@@ -30,6 +34,32 @@ type Event struct {
 	Location string `json:"location,omitempty"`
 	// Event venue name
 	Venue string `json:"venue,omitempty"`
+	// Saved venue the event takes place at; its name, address and coordinates are copied to the event
+	VenueID *uuid.UUID `json:"venue_id,omitempty"`
+	// Seating layout of the saved venue; empty for the venue's full capacity
+	SeatingLayout string `json:"seating_layout,omitempty"`
+	// Street address of the venue
+	AddressStreet string `json:"address_street,omitempty"`
+	// AddressCity holds the value of the "address_city" field.
+	AddressCity string `json:"address_city,omitempty"`
+	// State, province or 시/도
+	AddressRegion string `json:"address_region,omitempty"`
+	// AddressPostalCode holds the value of the "address_postal_code" field.
+	AddressPostalCode string `json:"address_postal_code,omitempty"`
+	// ISO 3166-1 alpha-2 country code
+	AddressCountry string `json:"address_country,omitempty"`
+	// WGS 84 latitude of the venue; set together with longitude
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Geohash of the coordinates for nearby search; empty without coordinates
+	Geohash string `json:"geohash,omitempty"`
+	// Lowercase category such as concert, conference or workshop
+	Category string `json:"category,omitempty"`
+	// Lowercase title with Korean syllables reduced to their initial consonants, for search suggestions
+	TitleInitials string `json:"title_initials,omitempty"`
+	// Venue reduced like title_initials
+	VenueInitials string `json:"venue_initials,omitempty"`
 	// Event start time
 	StartTime time.Time `json:"start_time,omitempty"`
 	// Event end time
@@ -48,8 +78,26 @@ type Event struct {
 	ThumbnailURL string `json:"thumbnail_url,omitempty"`
 	// Event status
 	Status event.Status `json:"status,omitempty"`
+	// Approval workflow status, separate from the lifecycle status
+	ReviewStatus event.ReviewStatus `json:"review_status,omitempty"`
+	// Comment of the last submission or review
+	ReviewComment string `json:"review_comment,omitempty"`
+	// Member who last submitted the event for review
+	SubmittedBy *uuid.UUID `json:"submitted_by,omitempty"`
+	// When the event was last submitted for review
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// Member who last approved, rejected or requested changes
+	ReviewedBy *uuid.UUID `json:"reviewed_by,omitempty"`
+	// When the event was last reviewed
+	ReviewedAt *time.Time `json:"reviewed_at,omitempty"`
+	// When a draft event is published by the scheduler
+	PublishAt *time.Time `json:"publish_at,omitempty"`
+	// Ticket holders may get a full refund until then because the event date moved
+	DateChangeRefundUntil *time.Time `json:"date_change_refund_until,omitempty"`
 	// Whether the event is publicly visible
 	IsPublic bool `json:"is_public,omitempty"`
+	// Whether RSVPs to a free event must be approved by the organizer
+	RequiresApproval bool `json:"requires_approval,omitempty"`
 	// User ID who created this event
 	CreatedBy uuid.UUID `json:"created_by,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -68,11 +116,17 @@ type EventEdges struct {
 	Organization *Organization `json:"organization,omitempty"`
 	// Creator holds the value of the creator edge.
 	Creator *User `json:"creator,omitempty"`
+	// LinkedVenue holds the value of the linked_venue edge.
+	LinkedVenue *Venue `json:"linked_venue,omitempty"`
+	// Series holds the value of the series edge.
+	Series *EventSeries `json:"series,omitempty"`
 	// Payments holds the value of the payments edge.
 	Payments []*Payment `json:"payments,omitempty"`
+	// RegistrationQuestions holds the value of the registration_questions edge.
+	RegistrationQuestions []*RegistrationQuestion `json:"registration_questions,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // OrganizationOrErr returns the Organization value or an error if the edge
@@ -97,29 +151,62 @@ func (e EventEdges) CreatorOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "creator"}
 }
 
+// LinkedVenueOrErr returns the LinkedVenue value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventEdges) LinkedVenueOrErr() (*Venue, error) {
+	if e.LinkedVenue != nil {
+		return e.LinkedVenue, nil
+	} else if e.loadedTypes[2] {
+		return nil, &NotFoundError{label: venue.Label}
+	}
+	return nil, &NotLoadedError{edge: "linked_venue"}
+}
+
+// SeriesOrErr returns the Series value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventEdges) SeriesOrErr() (*EventSeries, error) {
+	if e.Series != nil {
+		return e.Series, nil
+	} else if e.loadedTypes[3] {
+		return nil, &NotFoundError{label: eventseries.Label}
+	}
+	return nil, &NotLoadedError{edge: "series"}
+}
+
 // PaymentsOrErr returns the Payments value or an error if the edge
 // was not loaded in eager-loading.
 func (e EventEdges) PaymentsOrErr() ([]*Payment, error) {
-	if e.loadedTypes[2] {
+	if e.loadedTypes[4] {
 		return e.Payments, nil
 	}
 	return nil, &NotLoadedError{edge: "payments"}
 }
 
+// RegistrationQuestionsOrErr returns the RegistrationQuestions value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventEdges) RegistrationQuestionsOrErr() ([]*RegistrationQuestion, error) {
+	if e.loadedTypes[5] {
+		return e.RegistrationQuestions, nil
+	}
+	return nil, &NotLoadedError{edge: "registration_questions"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Event) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case event.FieldIsPublic:
+		case event.FieldSeriesID, event.FieldVenueID, event.FieldSubmittedBy, event.FieldReviewedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case event.FieldIsPublic, event.FieldRequiresApproval:
 			values[i] = new(sql.NullBool)
-		case event.FieldTicketPrice:
+		case event.FieldLatitude, event.FieldLongitude, event.FieldTicketPrice:
 			values[i] = new(sql.NullFloat64)
 		case event.FieldTotalTickets, event.FieldAvailableTickets, event.FieldParticipantCount:
 			values[i] = new(sql.NullInt64)
-		case event.FieldTitle, event.FieldDescription, event.FieldLocation, event.FieldVenue, event.FieldCurrency, event.FieldThumbnailURL, event.FieldStatus:
+		case event.FieldTitle, event.FieldDescription, event.FieldLocation, event.FieldVenue, event.FieldSeatingLayout, event.FieldAddressStreet, event.FieldAddressCity, event.FieldAddressRegion, event.FieldAddressPostalCode, event.FieldAddressCountry, event.FieldGeohash, event.FieldCategory, event.FieldTitleInitials, event.FieldVenueInitials, event.FieldCurrency, event.FieldThumbnailURL, event.FieldStatus, event.FieldReviewStatus, event.FieldReviewComment:
 			values[i] = new(sql.NullString)
-		case event.FieldStartTime, event.FieldEndTime, event.FieldCreatedAt, event.FieldUpdatedAt:
+		case event.FieldStartTime, event.FieldEndTime, event.FieldSubmittedAt, event.FieldReviewedAt, event.FieldPublishAt, event.FieldDateChangeRefundUntil, event.FieldCreatedAt, event.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case event.FieldID, event.FieldOrganizationID, event.FieldCreatedBy:
 			values[i] = new(uuid.UUID)
@@ -150,6 +237,13 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 			} else if value != nil {
 				_m.OrganizationID = *value
 			}
+		case event.FieldSeriesID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field series_id", values[i])
+			} else if value.Valid {
+				_m.SeriesID = new(uuid.UUID)
+				*_m.SeriesID = *value.S.(*uuid.UUID)
+			}
 		case event.FieldTitle:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field title", values[i])
@@ -174,6 +268,87 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Venue = value.String
 			}
+		case event.FieldVenueID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field venue_id", values[i])
+			} else if value.Valid {
+				_m.VenueID = new(uuid.UUID)
+				*_m.VenueID = *value.S.(*uuid.UUID)
+			}
+		case event.FieldSeatingLayout:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seating_layout", values[i])
+			} else if value.Valid {
+				_m.SeatingLayout = value.String
+			}
+		case event.FieldAddressStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_street", values[i])
+			} else if value.Valid {
+				_m.AddressStreet = value.String
+			}
+		case event.FieldAddressCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_city", values[i])
+			} else if value.Valid {
+				_m.AddressCity = value.String
+			}
+		case event.FieldAddressRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_region", values[i])
+			} else if value.Valid {
+				_m.AddressRegion = value.String
+			}
+		case event.FieldAddressPostalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_postal_code", values[i])
+			} else if value.Valid {
+				_m.AddressPostalCode = value.String
+			}
+		case event.FieldAddressCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_country", values[i])
+			} else if value.Valid {
+				_m.AddressCountry = value.String
+			}
+		case event.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = new(float64)
+				*_m.Latitude = value.Float64
+			}
+		case event.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = new(float64)
+				*_m.Longitude = value.Float64
+			}
+		case event.FieldGeohash:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field geohash", values[i])
+			} else if value.Valid {
+				_m.Geohash = value.String
+			}
+		case event.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case event.FieldTitleInitials:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title_initials", values[i])
+			} else if value.Valid {
+				_m.TitleInitials = value.String
+			}
+		case event.FieldVenueInitials:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field venue_initials", values[i])
+			} else if value.Valid {
+				_m.VenueInitials = value.String
+			}
 		case event.FieldStartTime:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field start_time", values[i])
@@ -228,12 +403,72 @@ func (_m *Event) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = event.Status(value.String)
 			}
+		case event.FieldReviewStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_status", values[i])
+			} else if value.Valid {
+				_m.ReviewStatus = event.ReviewStatus(value.String)
+			}
+		case event.FieldReviewComment:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field review_comment", values[i])
+			} else if value.Valid {
+				_m.ReviewComment = value.String
+			}
+		case event.FieldSubmittedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_by", values[i])
+			} else if value.Valid {
+				_m.SubmittedBy = new(uuid.UUID)
+				*_m.SubmittedBy = *value.S.(*uuid.UUID)
+			}
+		case event.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = new(time.Time)
+				*_m.SubmittedAt = value.Time
+			}
+		case event.FieldReviewedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_by", values[i])
+			} else if value.Valid {
+				_m.ReviewedBy = new(uuid.UUID)
+				*_m.ReviewedBy = *value.S.(*uuid.UUID)
+			}
+		case event.FieldReviewedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reviewed_at", values[i])
+			} else if value.Valid {
+				_m.ReviewedAt = new(time.Time)
+				*_m.ReviewedAt = value.Time
+			}
+		case event.FieldPublishAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field publish_at", values[i])
+			} else if value.Valid {
+				_m.PublishAt = new(time.Time)
+				*_m.PublishAt = value.Time
+			}
+		case event.FieldDateChangeRefundUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field date_change_refund_until", values[i])
+			} else if value.Valid {
+				_m.DateChangeRefundUntil = new(time.Time)
+				*_m.DateChangeRefundUntil = value.Time
+			}
 		case event.FieldIsPublic:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_public", values[i])
 			} else if value.Valid {
 				_m.IsPublic = value.Bool
 			}
+		case event.FieldRequiresApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field requires_approval", values[i])
+			} else if value.Valid {
+				_m.RequiresApproval = value.Bool
+			}
 		case event.FieldCreatedBy:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field created_by", values[i])
@@ -275,11 +510,26 @@ func (_m *Event) QueryCreator() *UserQuery {
 	return NewEventClient(_m.config).QueryCreator(_m)
 }
 
+// QueryLinkedVenue queries the "linked_venue" edge of the Event entity.
+func (_m *Event) QueryLinkedVenue() *VenueQuery {
+	return NewEventClient(_m.config).QueryLinkedVenue(_m)
+}
+
+// QuerySeries queries the "series" edge of the Event entity.
+func (_m *Event) QuerySeries() *EventSeriesQuery {
+	return NewEventClient(_m.config).QuerySeries(_m)
+}
+
 // QueryPayments queries the "payments" edge of the Event entity.
 func (_m *Event) QueryPayments() *PaymentQuery {
 	return NewEventClient(_m.config).QueryPayments(_m)
 }
 
+// QueryRegistrationQuestions queries the "registration_questions" edge of the Event entity.
+func (_m *Event) QueryRegistrationQuestions() *RegistrationQuestionQuery {
+	return NewEventClient(_m.config).QueryRegistrationQuestions(_m)
+}
+
 // Update returns a builder for updating this Event.
 // Note that you need to call Event.Unwrap() before calling this method if this Event
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -306,6 +556,11 @@ func (_m *Event) String() string {
 	builder.WriteString("organization_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
 	builder.WriteString(", ")
+	if v := _m.SeriesID; v != nil {
+		builder.WriteString("series_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("title=")
 	builder.WriteString(_m.Title)
 	builder.WriteString(", ")
@@ -318,6 +573,51 @@ func (_m *Event) String() string {
 	builder.WriteString("venue=")
 	builder.WriteString(_m.Venue)
 	builder.WriteString(", ")
+	if v := _m.VenueID; v != nil {
+		builder.WriteString("venue_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("seating_layout=")
+	builder.WriteString(_m.SeatingLayout)
+	builder.WriteString(", ")
+	builder.WriteString("address_street=")
+	builder.WriteString(_m.AddressStreet)
+	builder.WriteString(", ")
+	builder.WriteString("address_city=")
+	builder.WriteString(_m.AddressCity)
+	builder.WriteString(", ")
+	builder.WriteString("address_region=")
+	builder.WriteString(_m.AddressRegion)
+	builder.WriteString(", ")
+	builder.WriteString("address_postal_code=")
+	builder.WriteString(_m.AddressPostalCode)
+	builder.WriteString(", ")
+	builder.WriteString("address_country=")
+	builder.WriteString(_m.AddressCountry)
+	builder.WriteString(", ")
+	if v := _m.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("geohash=")
+	builder.WriteString(_m.Geohash)
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("title_initials=")
+	builder.WriteString(_m.TitleInitials)
+	builder.WriteString(", ")
+	builder.WriteString("venue_initials=")
+	builder.WriteString(_m.VenueInitials)
+	builder.WriteString(", ")
 	builder.WriteString("start_time=")
 	builder.WriteString(_m.StartTime.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -345,9 +645,48 @@ func (_m *Event) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("review_status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReviewStatus))
+	builder.WriteString(", ")
+	builder.WriteString("review_comment=")
+	builder.WriteString(_m.ReviewComment)
+	builder.WriteString(", ")
+	if v := _m.SubmittedBy; v != nil {
+		builder.WriteString("submitted_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedBy; v != nil {
+		builder.WriteString("reviewed_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReviewedAt; v != nil {
+		builder.WriteString("reviewed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.PublishAt; v != nil {
+		builder.WriteString("publish_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.DateChangeRefundUntil; v != nil {
+		builder.WriteString("date_change_refund_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("is_public=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
 	builder.WriteString(", ")
+	builder.WriteString("requires_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiresApproval))
+	builder.WriteString(", ")
 	builder.WriteString("created_by=")
 	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
 	builder.WriteString(", ")
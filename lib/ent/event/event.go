@@ -18,6 +18,8 @@ const (
 	FieldID = "id"
 	// FieldOrganizationID holds the string denoting the organization_id field in the database.
 	FieldOrganizationID = "organization_id"
+	// FieldSeriesID holds the string denoting the series_id field in the database.
+	FieldSeriesID = "series_id"
 	// FieldTitle holds the string denoting the title field in the database.
 	FieldTitle = "title"
 	// FieldDescription holds the string denoting the description field in the database.
@@ -26,6 +28,32 @@ const (
 	FieldLocation = "location"
 	// FieldVenue holds the string denoting the venue field in the database.
 	FieldVenue = "venue"
+	// FieldVenueID holds the string denoting the venue_id field in the database.
+	FieldVenueID = "venue_id"
+	// FieldSeatingLayout holds the string denoting the seating_layout field in the database.
+	FieldSeatingLayout = "seating_layout"
+	// FieldAddressStreet holds the string denoting the address_street field in the database.
+	FieldAddressStreet = "address_street"
+	// FieldAddressCity holds the string denoting the address_city field in the database.
+	FieldAddressCity = "address_city"
+	// FieldAddressRegion holds the string denoting the address_region field in the database.
+	FieldAddressRegion = "address_region"
+	// FieldAddressPostalCode holds the string denoting the address_postal_code field in the database.
+	FieldAddressPostalCode = "address_postal_code"
+	// FieldAddressCountry holds the string denoting the address_country field in the database.
+	FieldAddressCountry = "address_country"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldGeohash holds the string denoting the geohash field in the database.
+	FieldGeohash = "geohash"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldTitleInitials holds the string denoting the title_initials field in the database.
+	FieldTitleInitials = "title_initials"
+	// FieldVenueInitials holds the string denoting the venue_initials field in the database.
+	FieldVenueInitials = "venue_initials"
 	// FieldStartTime holds the string denoting the start_time field in the database.
 	FieldStartTime = "start_time"
 	// FieldEndTime holds the string denoting the end_time field in the database.
@@ -44,8 +72,26 @@ const (
 	FieldThumbnailURL = "thumbnail_url"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldReviewStatus holds the string denoting the review_status field in the database.
+	FieldReviewStatus = "review_status"
+	// FieldReviewComment holds the string denoting the review_comment field in the database.
+	FieldReviewComment = "review_comment"
+	// FieldSubmittedBy holds the string denoting the submitted_by field in the database.
+	FieldSubmittedBy = "submitted_by"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldReviewedBy holds the string denoting the reviewed_by field in the database.
+	FieldReviewedBy = "reviewed_by"
+	// FieldReviewedAt holds the string denoting the reviewed_at field in the database.
+	FieldReviewedAt = "reviewed_at"
+	// FieldPublishAt holds the string denoting the publish_at field in the database.
+	FieldPublishAt = "publish_at"
+	// FieldDateChangeRefundUntil holds the string denoting the date_change_refund_until field in the database.
+	FieldDateChangeRefundUntil = "date_change_refund_until"
 	// FieldIsPublic holds the string denoting the is_public field in the database.
 	FieldIsPublic = "is_public"
+	// FieldRequiresApproval holds the string denoting the requires_approval field in the database.
+	FieldRequiresApproval = "requires_approval"
 	// FieldCreatedBy holds the string denoting the created_by field in the database.
 	FieldCreatedBy = "created_by"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
@@ -56,8 +102,14 @@ const (
 	EdgeOrganization = "organization"
 	// EdgeCreator holds the string denoting the creator edge name in mutations.
 	EdgeCreator = "creator"
+	// EdgeLinkedVenue holds the string denoting the linked_venue edge name in mutations.
+	EdgeLinkedVenue = "linked_venue"
+	// EdgeSeries holds the string denoting the series edge name in mutations.
+	EdgeSeries = "series"
 	// EdgePayments holds the string denoting the payments edge name in mutations.
 	EdgePayments = "payments"
+	// EdgeRegistrationQuestions holds the string denoting the registration_questions edge name in mutations.
+	EdgeRegistrationQuestions = "registration_questions"
 	// Table holds the table name of the event in the database.
 	Table = "events"
 	// OrganizationTable is the table that holds the organization relation/edge.
@@ -74,6 +126,20 @@ const (
 	CreatorInverseTable = "users"
 	// CreatorColumn is the table column denoting the creator relation/edge.
 	CreatorColumn = "created_by"
+	// LinkedVenueTable is the table that holds the linked_venue relation/edge.
+	LinkedVenueTable = "events"
+	// LinkedVenueInverseTable is the table name for the Venue entity.
+	// It exists in this package in order to avoid circular dependency with the "venue" package.
+	LinkedVenueInverseTable = "venues"
+	// LinkedVenueColumn is the table column denoting the linked_venue relation/edge.
+	LinkedVenueColumn = "venue_id"
+	// SeriesTable is the table that holds the series relation/edge.
+	SeriesTable = "events"
+	// SeriesInverseTable is the table name for the EventSeries entity.
+	// It exists in this package in order to avoid circular dependency with the "eventseries" package.
+	SeriesInverseTable = "event_series"
+	// SeriesColumn is the table column denoting the series relation/edge.
+	SeriesColumn = "series_id"
 	// PaymentsTable is the table that holds the payments relation/edge.
 	PaymentsTable = "payments"
 	// PaymentsInverseTable is the table name for the Payment entity.
@@ -81,16 +147,37 @@ const (
 	PaymentsInverseTable = "payments"
 	// PaymentsColumn is the table column denoting the payments relation/edge.
 	PaymentsColumn = "event_id"
+	// RegistrationQuestionsTable is the table that holds the registration_questions relation/edge.
+	RegistrationQuestionsTable = "registration_questions"
+	// RegistrationQuestionsInverseTable is the table name for the RegistrationQuestion entity.
+	// It exists in this package in order to avoid circular dependency with the "registrationquestion" package.
+	RegistrationQuestionsInverseTable = "registration_questions"
+	// RegistrationQuestionsColumn is the table column denoting the registration_questions relation/edge.
+	RegistrationQuestionsColumn = "event_id"
 )
 
 // Columns holds all SQL columns for event fields.
 var Columns = []string{
 	FieldID,
 	FieldOrganizationID,
+	FieldSeriesID,
 	FieldTitle,
 	FieldDescription,
 	FieldLocation,
 	FieldVenue,
+	FieldVenueID,
+	FieldSeatingLayout,
+	FieldAddressStreet,
+	FieldAddressCity,
+	FieldAddressRegion,
+	FieldAddressPostalCode,
+	FieldAddressCountry,
+	FieldLatitude,
+	FieldLongitude,
+	FieldGeohash,
+	FieldCategory,
+	FieldTitleInitials,
+	FieldVenueInitials,
 	FieldStartTime,
 	FieldEndTime,
 	FieldTotalTickets,
@@ -100,7 +187,16 @@ var Columns = []string{
 	FieldCurrency,
 	FieldThumbnailURL,
 	FieldStatus,
+	FieldReviewStatus,
+	FieldReviewComment,
+	FieldSubmittedBy,
+	FieldSubmittedAt,
+	FieldReviewedBy,
+	FieldReviewedAt,
+	FieldPublishAt,
+	FieldDateChangeRefundUntil,
 	FieldIsPublic,
+	FieldRequiresApproval,
 	FieldCreatedBy,
 	FieldCreatedAt,
 	FieldUpdatedAt,
@@ -119,6 +215,38 @@ func ValidColumn(column string) bool {
 var (
 	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	TitleValidator func(string) error
+	// DefaultSeatingLayout holds the default value on creation for the "seating_layout" field.
+	DefaultSeatingLayout string
+	// DefaultAddressStreet holds the default value on creation for the "address_street" field.
+	DefaultAddressStreet string
+	// DefaultAddressCity holds the default value on creation for the "address_city" field.
+	DefaultAddressCity string
+	// AddressCityValidator is a validator for the "address_city" field. It is called by the builders before save.
+	AddressCityValidator func(string) error
+	// DefaultAddressRegion holds the default value on creation for the "address_region" field.
+	DefaultAddressRegion string
+	// AddressRegionValidator is a validator for the "address_region" field. It is called by the builders before save.
+	AddressRegionValidator func(string) error
+	// DefaultAddressPostalCode holds the default value on creation for the "address_postal_code" field.
+	DefaultAddressPostalCode string
+	// AddressPostalCodeValidator is a validator for the "address_postal_code" field. It is called by the builders before save.
+	AddressPostalCodeValidator func(string) error
+	// DefaultAddressCountry holds the default value on creation for the "address_country" field.
+	DefaultAddressCountry string
+	// AddressCountryValidator is a validator for the "address_country" field. It is called by the builders before save.
+	AddressCountryValidator func(string) error
+	// DefaultGeohash holds the default value on creation for the "geohash" field.
+	DefaultGeohash string
+	// GeohashValidator is a validator for the "geohash" field. It is called by the builders before save.
+	GeohashValidator func(string) error
+	// DefaultCategory holds the default value on creation for the "category" field.
+	DefaultCategory string
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// DefaultTitleInitials holds the default value on creation for the "title_initials" field.
+	DefaultTitleInitials string
+	// DefaultVenueInitials holds the default value on creation for the "venue_initials" field.
+	DefaultVenueInitials string
 	// DefaultTotalTickets holds the default value on creation for the "total_tickets" field.
 	DefaultTotalTickets int
 	// TotalTicketsValidator is a validator for the "total_tickets" field. It is called by the builders before save.
@@ -139,6 +267,8 @@ var (
 	DefaultCurrency string
 	// DefaultIsPublic holds the default value on creation for the "is_public" field.
 	DefaultIsPublic bool
+	// DefaultRequiresApproval holds the default value on creation for the "requires_approval" field.
+	DefaultRequiresApproval bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -178,6 +308,35 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// ReviewStatus defines the type for the "review_status" enum field.
+type ReviewStatus string
+
+// ReviewStatusNotSubmitted is the default value of the ReviewStatus enum.
+const DefaultReviewStatus = ReviewStatusNotSubmitted
+
+// ReviewStatus values.
+const (
+	ReviewStatusNotSubmitted     ReviewStatus = "not_submitted"
+	ReviewStatusPending          ReviewStatus = "pending"
+	ReviewStatusChangesRequested ReviewStatus = "changes_requested"
+	ReviewStatusApproved         ReviewStatus = "approved"
+	ReviewStatusRejected         ReviewStatus = "rejected"
+)
+
+func (rs ReviewStatus) String() string {
+	return string(rs)
+}
+
+// ReviewStatusValidator is a validator for the "review_status" field enum values. It is called by the builders before save.
+func ReviewStatusValidator(rs ReviewStatus) error {
+	switch rs {
+	case ReviewStatusNotSubmitted, ReviewStatusPending, ReviewStatusChangesRequested, ReviewStatusApproved, ReviewStatusRejected:
+		return nil
+	default:
+		return fmt.Errorf("event: invalid enum value for review_status field: %q", rs)
+	}
+}
+
 // OrderOption defines the ordering options for the Event queries.
 type OrderOption func(*sql.Selector)
 
@@ -191,6 +350,11 @@ func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
 }
 
+// BySeriesID orders the results by the series_id field.
+func BySeriesID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeriesID, opts...).ToFunc()
+}
+
 // ByTitle orders the results by the title field.
 func ByTitle(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldTitle, opts...).ToFunc()
@@ -211,6 +375,71 @@ func ByVenue(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldVenue, opts...).ToFunc()
 }
 
+// ByVenueID orders the results by the venue_id field.
+func ByVenueID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVenueID, opts...).ToFunc()
+}
+
+// BySeatingLayout orders the results by the seating_layout field.
+func BySeatingLayout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeatingLayout, opts...).ToFunc()
+}
+
+// ByAddressStreet orders the results by the address_street field.
+func ByAddressStreet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressStreet, opts...).ToFunc()
+}
+
+// ByAddressCity orders the results by the address_city field.
+func ByAddressCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressCity, opts...).ToFunc()
+}
+
+// ByAddressRegion orders the results by the address_region field.
+func ByAddressRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressRegion, opts...).ToFunc()
+}
+
+// ByAddressPostalCode orders the results by the address_postal_code field.
+func ByAddressPostalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressPostalCode, opts...).ToFunc()
+}
+
+// ByAddressCountry orders the results by the address_country field.
+func ByAddressCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressCountry, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByGeohash orders the results by the geohash field.
+func ByGeohash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldGeohash, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByTitleInitials orders the results by the title_initials field.
+func ByTitleInitials(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitleInitials, opts...).ToFunc()
+}
+
+// ByVenueInitials orders the results by the venue_initials field.
+func ByVenueInitials(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVenueInitials, opts...).ToFunc()
+}
+
 // ByStartTime orders the results by the start_time field.
 func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
@@ -256,11 +485,56 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByReviewStatus orders the results by the review_status field.
+func ByReviewStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewStatus, opts...).ToFunc()
+}
+
+// ByReviewComment orders the results by the review_comment field.
+func ByReviewComment(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewComment, opts...).ToFunc()
+}
+
+// BySubmittedBy orders the results by the submitted_by field.
+func BySubmittedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedBy, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByReviewedBy orders the results by the reviewed_by field.
+func ByReviewedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedBy, opts...).ToFunc()
+}
+
+// ByReviewedAt orders the results by the reviewed_at field.
+func ByReviewedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReviewedAt, opts...).ToFunc()
+}
+
+// ByPublishAt orders the results by the publish_at field.
+func ByPublishAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPublishAt, opts...).ToFunc()
+}
+
+// ByDateChangeRefundUntil orders the results by the date_change_refund_until field.
+func ByDateChangeRefundUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDateChangeRefundUntil, opts...).ToFunc()
+}
+
 // ByIsPublic orders the results by the is_public field.
 func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
 }
 
+// ByRequiresApproval orders the results by the requires_approval field.
+func ByRequiresApproval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiresApproval, opts...).ToFunc()
+}
+
 // ByCreatedBy orders the results by the created_by field.
 func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
@@ -290,6 +564,20 @@ func ByCreatorField(field string, opts ...sql.OrderTermOption) OrderOption {
 	}
 }
 
+// ByLinkedVenueField orders the results by linked_venue field.
+func ByLinkedVenueField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkedVenueStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// BySeriesField orders the results by series field.
+func BySeriesField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSeriesStep(), sql.OrderByField(field, opts...))
+	}
+}
+
 // ByPaymentsCount orders the results by payments count.
 func ByPaymentsCount(opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -303,6 +591,20 @@ func ByPayments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPaymentsStep(), append([]sql.OrderTerm{term}, terms...)...)
 	}
 }
+
+// ByRegistrationQuestionsCount orders the results by registration_questions count.
+func ByRegistrationQuestionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRegistrationQuestionsStep(), opts...)
+	}
+}
+
+// ByRegistrationQuestions orders the results by registration_questions terms.
+func ByRegistrationQuestions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegistrationQuestionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newOrganizationStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -317,6 +619,20 @@ func newCreatorStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, CreatorTable, CreatorColumn),
 	)
 }
+func newLinkedVenueStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkedVenueInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LinkedVenueTable, LinkedVenueColumn),
+	)
+}
+func newSeriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SeriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, SeriesTable, SeriesColumn),
+	)
+}
 func newPaymentsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -324,3 +640,10 @@ func newPaymentsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, PaymentsTable, PaymentsColumn),
 	)
 }
+func newRegistrationQuestionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegistrationQuestionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RegistrationQuestionsTable, RegistrationQuestionsColumn),
+	)
+}
@@ -61,6 +61,11 @@ func OrganizationID(v uuid.UUID) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldOrganizationID, v))
 }
 
+// SeriesID applies equality check predicate on the "series_id" field. It's identical to SeriesIDEQ.
+func SeriesID(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSeriesID, v))
+}
+
 // Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
 func Title(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldTitle, v))
@@ -81,6 +86,71 @@ func Venue(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldVenue, v))
 }
 
+// VenueID applies equality check predicate on the "venue_id" field. It's identical to VenueIDEQ.
+func VenueID(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldVenueID, v))
+}
+
+// SeatingLayout applies equality check predicate on the "seating_layout" field. It's identical to SeatingLayoutEQ.
+func SeatingLayout(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSeatingLayout, v))
+}
+
+// AddressStreet applies equality check predicate on the "address_street" field. It's identical to AddressStreetEQ.
+func AddressStreet(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressStreet, v))
+}
+
+// AddressCity applies equality check predicate on the "address_city" field. It's identical to AddressCityEQ.
+func AddressCity(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressCity, v))
+}
+
+// AddressRegion applies equality check predicate on the "address_region" field. It's identical to AddressRegionEQ.
+func AddressRegion(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressRegion, v))
+}
+
+// AddressPostalCode applies equality check predicate on the "address_postal_code" field. It's identical to AddressPostalCodeEQ.
+func AddressPostalCode(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressPostalCode, v))
+}
+
+// AddressCountry applies equality check predicate on the "address_country" field. It's identical to AddressCountryEQ.
+func AddressCountry(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressCountry, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldLongitude, v))
+}
+
+// Geohash applies equality check predicate on the "geohash" field. It's identical to GeohashEQ.
+func Geohash(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldGeohash, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCategory, v))
+}
+
+// TitleInitials applies equality check predicate on the "title_initials" field. It's identical to TitleInitialsEQ.
+func TitleInitials(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTitleInitials, v))
+}
+
+// VenueInitials applies equality check predicate on the "venue_initials" field. It's identical to VenueInitialsEQ.
+func VenueInitials(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldVenueInitials, v))
+}
+
 // StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
 func StartTime(v time.Time) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldStartTime, v))
@@ -121,11 +191,51 @@ func ThumbnailURL(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldThumbnailURL, v))
 }
 
+// ReviewComment applies equality check predicate on the "review_comment" field. It's identical to ReviewCommentEQ.
+func ReviewComment(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// SubmittedBy applies equality check predicate on the "submitted_by" field. It's identical to SubmittedByEQ.
+func SubmittedBy(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// ReviewedBy applies equality check predicate on the "reviewed_by" field. It's identical to ReviewedByEQ.
+func ReviewedBy(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedAt applies equality check predicate on the "reviewed_at" field. It's identical to ReviewedAtEQ.
+func ReviewedAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// PublishAt applies equality check predicate on the "publish_at" field. It's identical to PublishAtEQ.
+func PublishAt(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// DateChangeRefundUntil applies equality check predicate on the "date_change_refund_until" field. It's identical to DateChangeRefundUntilEQ.
+func DateChangeRefundUntil(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDateChangeRefundUntil, v))
+}
+
 // IsPublic applies equality check predicate on the "is_public" field. It's identical to IsPublicEQ.
 func IsPublic(v bool) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldIsPublic, v))
 }
 
+// RequiresApproval applies equality check predicate on the "requires_approval" field. It's identical to RequiresApprovalEQ.
+func RequiresApproval(v bool) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRequiresApproval, v))
+}
+
 // CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
 func CreatedBy(v uuid.UUID) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldCreatedBy, v))
@@ -161,6 +271,36 @@ func OrganizationIDNotIn(vs ...uuid.UUID) predicate.Event {
 	return predicate.Event(sql.FieldNotIn(FieldOrganizationID, vs...))
 }
 
+// SeriesIDEQ applies the EQ predicate on the "series_id" field.
+func SeriesIDEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSeriesID, v))
+}
+
+// SeriesIDNEQ applies the NEQ predicate on the "series_id" field.
+func SeriesIDNEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSeriesID, v))
+}
+
+// SeriesIDIn applies the In predicate on the "series_id" field.
+func SeriesIDIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDNotIn applies the NotIn predicate on the "series_id" field.
+func SeriesIDNotIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSeriesID, vs...))
+}
+
+// SeriesIDIsNil applies the IsNil predicate on the "series_id" field.
+func SeriesIDIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldSeriesID))
+}
+
+// SeriesIDNotNil applies the NotNil predicate on the "series_id" field.
+func SeriesIDNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldSeriesID))
+}
+
 // TitleEQ applies the EQ predicate on the "title" field.
 func TitleEQ(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldTitle, v))
@@ -381,74 +521,854 @@ func VenueEQ(v string) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldVenue, v))
 }
 
-// VenueNEQ applies the NEQ predicate on the "venue" field.
-func VenueNEQ(v string) predicate.Event {
-	return predicate.Event(sql.FieldNEQ(FieldVenue, v))
+// VenueNEQ applies the NEQ predicate on the "venue" field.
+func VenueNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldVenue, v))
+}
+
+// VenueIn applies the In predicate on the "venue" field.
+func VenueIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldVenue, vs...))
+}
+
+// VenueNotIn applies the NotIn predicate on the "venue" field.
+func VenueNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldVenue, vs...))
+}
+
+// VenueGT applies the GT predicate on the "venue" field.
+func VenueGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldVenue, v))
+}
+
+// VenueGTE applies the GTE predicate on the "venue" field.
+func VenueGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldVenue, v))
+}
+
+// VenueLT applies the LT predicate on the "venue" field.
+func VenueLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldVenue, v))
+}
+
+// VenueLTE applies the LTE predicate on the "venue" field.
+func VenueLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldVenue, v))
+}
+
+// VenueContains applies the Contains predicate on the "venue" field.
+func VenueContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldVenue, v))
+}
+
+// VenueHasPrefix applies the HasPrefix predicate on the "venue" field.
+func VenueHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldVenue, v))
+}
+
+// VenueHasSuffix applies the HasSuffix predicate on the "venue" field.
+func VenueHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldVenue, v))
+}
+
+// VenueIsNil applies the IsNil predicate on the "venue" field.
+func VenueIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldVenue))
+}
+
+// VenueNotNil applies the NotNil predicate on the "venue" field.
+func VenueNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldVenue))
+}
+
+// VenueEqualFold applies the EqualFold predicate on the "venue" field.
+func VenueEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldVenue, v))
+}
+
+// VenueContainsFold applies the ContainsFold predicate on the "venue" field.
+func VenueContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldVenue, v))
+}
+
+// VenueIDEQ applies the EQ predicate on the "venue_id" field.
+func VenueIDEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldVenueID, v))
+}
+
+// VenueIDNEQ applies the NEQ predicate on the "venue_id" field.
+func VenueIDNEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldVenueID, v))
+}
+
+// VenueIDIn applies the In predicate on the "venue_id" field.
+func VenueIDIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldVenueID, vs...))
+}
+
+// VenueIDNotIn applies the NotIn predicate on the "venue_id" field.
+func VenueIDNotIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldVenueID, vs...))
+}
+
+// VenueIDIsNil applies the IsNil predicate on the "venue_id" field.
+func VenueIDIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldVenueID))
+}
+
+// VenueIDNotNil applies the NotNil predicate on the "venue_id" field.
+func VenueIDNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldVenueID))
+}
+
+// SeatingLayoutEQ applies the EQ predicate on the "seating_layout" field.
+func SeatingLayoutEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutNEQ applies the NEQ predicate on the "seating_layout" field.
+func SeatingLayoutNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutIn applies the In predicate on the "seating_layout" field.
+func SeatingLayoutIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSeatingLayout, vs...))
+}
+
+// SeatingLayoutNotIn applies the NotIn predicate on the "seating_layout" field.
+func SeatingLayoutNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSeatingLayout, vs...))
+}
+
+// SeatingLayoutGT applies the GT predicate on the "seating_layout" field.
+func SeatingLayoutGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutGTE applies the GTE predicate on the "seating_layout" field.
+func SeatingLayoutGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutLT applies the LT predicate on the "seating_layout" field.
+func SeatingLayoutLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutLTE applies the LTE predicate on the "seating_layout" field.
+func SeatingLayoutLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutContains applies the Contains predicate on the "seating_layout" field.
+func SeatingLayoutContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutHasPrefix applies the HasPrefix predicate on the "seating_layout" field.
+func SeatingLayoutHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutHasSuffix applies the HasSuffix predicate on the "seating_layout" field.
+func SeatingLayoutHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutEqualFold applies the EqualFold predicate on the "seating_layout" field.
+func SeatingLayoutEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutContainsFold applies the ContainsFold predicate on the "seating_layout" field.
+func SeatingLayoutContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldSeatingLayout, v))
+}
+
+// AddressStreetEQ applies the EQ predicate on the "address_street" field.
+func AddressStreetEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressStreet, v))
+}
+
+// AddressStreetNEQ applies the NEQ predicate on the "address_street" field.
+func AddressStreetNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldAddressStreet, v))
+}
+
+// AddressStreetIn applies the In predicate on the "address_street" field.
+func AddressStreetIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldAddressStreet, vs...))
+}
+
+// AddressStreetNotIn applies the NotIn predicate on the "address_street" field.
+func AddressStreetNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldAddressStreet, vs...))
+}
+
+// AddressStreetGT applies the GT predicate on the "address_street" field.
+func AddressStreetGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldAddressStreet, v))
+}
+
+// AddressStreetGTE applies the GTE predicate on the "address_street" field.
+func AddressStreetGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldAddressStreet, v))
+}
+
+// AddressStreetLT applies the LT predicate on the "address_street" field.
+func AddressStreetLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldAddressStreet, v))
+}
+
+// AddressStreetLTE applies the LTE predicate on the "address_street" field.
+func AddressStreetLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldAddressStreet, v))
+}
+
+// AddressStreetContains applies the Contains predicate on the "address_street" field.
+func AddressStreetContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldAddressStreet, v))
+}
+
+// AddressStreetHasPrefix applies the HasPrefix predicate on the "address_street" field.
+func AddressStreetHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldAddressStreet, v))
+}
+
+// AddressStreetHasSuffix applies the HasSuffix predicate on the "address_street" field.
+func AddressStreetHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldAddressStreet, v))
+}
+
+// AddressStreetEqualFold applies the EqualFold predicate on the "address_street" field.
+func AddressStreetEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldAddressStreet, v))
+}
+
+// AddressStreetContainsFold applies the ContainsFold predicate on the "address_street" field.
+func AddressStreetContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldAddressStreet, v))
+}
+
+// AddressCityEQ applies the EQ predicate on the "address_city" field.
+func AddressCityEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressCity, v))
+}
+
+// AddressCityNEQ applies the NEQ predicate on the "address_city" field.
+func AddressCityNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldAddressCity, v))
+}
+
+// AddressCityIn applies the In predicate on the "address_city" field.
+func AddressCityIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldAddressCity, vs...))
+}
+
+// AddressCityNotIn applies the NotIn predicate on the "address_city" field.
+func AddressCityNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldAddressCity, vs...))
+}
+
+// AddressCityGT applies the GT predicate on the "address_city" field.
+func AddressCityGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldAddressCity, v))
+}
+
+// AddressCityGTE applies the GTE predicate on the "address_city" field.
+func AddressCityGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldAddressCity, v))
+}
+
+// AddressCityLT applies the LT predicate on the "address_city" field.
+func AddressCityLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldAddressCity, v))
+}
+
+// AddressCityLTE applies the LTE predicate on the "address_city" field.
+func AddressCityLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldAddressCity, v))
+}
+
+// AddressCityContains applies the Contains predicate on the "address_city" field.
+func AddressCityContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldAddressCity, v))
+}
+
+// AddressCityHasPrefix applies the HasPrefix predicate on the "address_city" field.
+func AddressCityHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldAddressCity, v))
+}
+
+// AddressCityHasSuffix applies the HasSuffix predicate on the "address_city" field.
+func AddressCityHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldAddressCity, v))
+}
+
+// AddressCityEqualFold applies the EqualFold predicate on the "address_city" field.
+func AddressCityEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldAddressCity, v))
+}
+
+// AddressCityContainsFold applies the ContainsFold predicate on the "address_city" field.
+func AddressCityContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldAddressCity, v))
+}
+
+// AddressRegionEQ applies the EQ predicate on the "address_region" field.
+func AddressRegionEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressRegion, v))
+}
+
+// AddressRegionNEQ applies the NEQ predicate on the "address_region" field.
+func AddressRegionNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldAddressRegion, v))
+}
+
+// AddressRegionIn applies the In predicate on the "address_region" field.
+func AddressRegionIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldAddressRegion, vs...))
+}
+
+// AddressRegionNotIn applies the NotIn predicate on the "address_region" field.
+func AddressRegionNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldAddressRegion, vs...))
+}
+
+// AddressRegionGT applies the GT predicate on the "address_region" field.
+func AddressRegionGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldAddressRegion, v))
+}
+
+// AddressRegionGTE applies the GTE predicate on the "address_region" field.
+func AddressRegionGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldAddressRegion, v))
+}
+
+// AddressRegionLT applies the LT predicate on the "address_region" field.
+func AddressRegionLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldAddressRegion, v))
+}
+
+// AddressRegionLTE applies the LTE predicate on the "address_region" field.
+func AddressRegionLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldAddressRegion, v))
+}
+
+// AddressRegionContains applies the Contains predicate on the "address_region" field.
+func AddressRegionContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldAddressRegion, v))
+}
+
+// AddressRegionHasPrefix applies the HasPrefix predicate on the "address_region" field.
+func AddressRegionHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldAddressRegion, v))
+}
+
+// AddressRegionHasSuffix applies the HasSuffix predicate on the "address_region" field.
+func AddressRegionHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldAddressRegion, v))
+}
+
+// AddressRegionEqualFold applies the EqualFold predicate on the "address_region" field.
+func AddressRegionEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldAddressRegion, v))
+}
+
+// AddressRegionContainsFold applies the ContainsFold predicate on the "address_region" field.
+func AddressRegionContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldAddressRegion, v))
+}
+
+// AddressPostalCodeEQ applies the EQ predicate on the "address_postal_code" field.
+func AddressPostalCodeEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeNEQ applies the NEQ predicate on the "address_postal_code" field.
+func AddressPostalCodeNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeIn applies the In predicate on the "address_postal_code" field.
+func AddressPostalCodeIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldAddressPostalCode, vs...))
+}
+
+// AddressPostalCodeNotIn applies the NotIn predicate on the "address_postal_code" field.
+func AddressPostalCodeNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldAddressPostalCode, vs...))
+}
+
+// AddressPostalCodeGT applies the GT predicate on the "address_postal_code" field.
+func AddressPostalCodeGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeGTE applies the GTE predicate on the "address_postal_code" field.
+func AddressPostalCodeGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeLT applies the LT predicate on the "address_postal_code" field.
+func AddressPostalCodeLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeLTE applies the LTE predicate on the "address_postal_code" field.
+func AddressPostalCodeLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeContains applies the Contains predicate on the "address_postal_code" field.
+func AddressPostalCodeContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeHasPrefix applies the HasPrefix predicate on the "address_postal_code" field.
+func AddressPostalCodeHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeHasSuffix applies the HasSuffix predicate on the "address_postal_code" field.
+func AddressPostalCodeHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeEqualFold applies the EqualFold predicate on the "address_postal_code" field.
+func AddressPostalCodeEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeContainsFold applies the ContainsFold predicate on the "address_postal_code" field.
+func AddressPostalCodeContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldAddressPostalCode, v))
+}
+
+// AddressCountryEQ applies the EQ predicate on the "address_country" field.
+func AddressCountryEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldAddressCountry, v))
+}
+
+// AddressCountryNEQ applies the NEQ predicate on the "address_country" field.
+func AddressCountryNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldAddressCountry, v))
+}
+
+// AddressCountryIn applies the In predicate on the "address_country" field.
+func AddressCountryIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldAddressCountry, vs...))
+}
+
+// AddressCountryNotIn applies the NotIn predicate on the "address_country" field.
+func AddressCountryNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldAddressCountry, vs...))
+}
+
+// AddressCountryGT applies the GT predicate on the "address_country" field.
+func AddressCountryGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldAddressCountry, v))
+}
+
+// AddressCountryGTE applies the GTE predicate on the "address_country" field.
+func AddressCountryGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldAddressCountry, v))
+}
+
+// AddressCountryLT applies the LT predicate on the "address_country" field.
+func AddressCountryLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldAddressCountry, v))
+}
+
+// AddressCountryLTE applies the LTE predicate on the "address_country" field.
+func AddressCountryLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldAddressCountry, v))
+}
+
+// AddressCountryContains applies the Contains predicate on the "address_country" field.
+func AddressCountryContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldAddressCountry, v))
+}
+
+// AddressCountryHasPrefix applies the HasPrefix predicate on the "address_country" field.
+func AddressCountryHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldAddressCountry, v))
+}
+
+// AddressCountryHasSuffix applies the HasSuffix predicate on the "address_country" field.
+func AddressCountryHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldAddressCountry, v))
+}
+
+// AddressCountryEqualFold applies the EqualFold predicate on the "address_country" field.
+func AddressCountryEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldAddressCountry, v))
+}
+
+// AddressCountryContainsFold applies the ContainsFold predicate on the "address_country" field.
+func AddressCountryContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldAddressCountry, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldLongitude))
+}
+
+// GeohashEQ applies the EQ predicate on the "geohash" field.
+func GeohashEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldGeohash, v))
+}
+
+// GeohashNEQ applies the NEQ predicate on the "geohash" field.
+func GeohashNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldGeohash, v))
+}
+
+// GeohashIn applies the In predicate on the "geohash" field.
+func GeohashIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldGeohash, vs...))
+}
+
+// GeohashNotIn applies the NotIn predicate on the "geohash" field.
+func GeohashNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldGeohash, vs...))
+}
+
+// GeohashGT applies the GT predicate on the "geohash" field.
+func GeohashGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldGeohash, v))
+}
+
+// GeohashGTE applies the GTE predicate on the "geohash" field.
+func GeohashGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldGeohash, v))
+}
+
+// GeohashLT applies the LT predicate on the "geohash" field.
+func GeohashLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldGeohash, v))
+}
+
+// GeohashLTE applies the LTE predicate on the "geohash" field.
+func GeohashLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldGeohash, v))
+}
+
+// GeohashContains applies the Contains predicate on the "geohash" field.
+func GeohashContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldGeohash, v))
+}
+
+// GeohashHasPrefix applies the HasPrefix predicate on the "geohash" field.
+func GeohashHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldGeohash, v))
+}
+
+// GeohashHasSuffix applies the HasSuffix predicate on the "geohash" field.
+func GeohashHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldGeohash, v))
+}
+
+// GeohashEqualFold applies the EqualFold predicate on the "geohash" field.
+func GeohashEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldGeohash, v))
+}
+
+// GeohashContainsFold applies the ContainsFold predicate on the "geohash" field.
+func GeohashContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldGeohash, v))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// TitleInitialsEQ applies the EQ predicate on the "title_initials" field.
+func TitleInitialsEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldTitleInitials, v))
+}
+
+// TitleInitialsNEQ applies the NEQ predicate on the "title_initials" field.
+func TitleInitialsNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldTitleInitials, v))
+}
+
+// TitleInitialsIn applies the In predicate on the "title_initials" field.
+func TitleInitialsIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldTitleInitials, vs...))
+}
+
+// TitleInitialsNotIn applies the NotIn predicate on the "title_initials" field.
+func TitleInitialsNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldTitleInitials, vs...))
+}
+
+// TitleInitialsGT applies the GT predicate on the "title_initials" field.
+func TitleInitialsGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldTitleInitials, v))
+}
+
+// TitleInitialsGTE applies the GTE predicate on the "title_initials" field.
+func TitleInitialsGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldTitleInitials, v))
+}
+
+// TitleInitialsLT applies the LT predicate on the "title_initials" field.
+func TitleInitialsLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldTitleInitials, v))
+}
+
+// TitleInitialsLTE applies the LTE predicate on the "title_initials" field.
+func TitleInitialsLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldTitleInitials, v))
+}
+
+// TitleInitialsContains applies the Contains predicate on the "title_initials" field.
+func TitleInitialsContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldTitleInitials, v))
+}
+
+// TitleInitialsHasPrefix applies the HasPrefix predicate on the "title_initials" field.
+func TitleInitialsHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldTitleInitials, v))
+}
+
+// TitleInitialsHasSuffix applies the HasSuffix predicate on the "title_initials" field.
+func TitleInitialsHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldTitleInitials, v))
+}
+
+// TitleInitialsEqualFold applies the EqualFold predicate on the "title_initials" field.
+func TitleInitialsEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldTitleInitials, v))
+}
+
+// TitleInitialsContainsFold applies the ContainsFold predicate on the "title_initials" field.
+func TitleInitialsContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldTitleInitials, v))
 }
 
-// VenueIn applies the In predicate on the "venue" field.
-func VenueIn(vs ...string) predicate.Event {
-	return predicate.Event(sql.FieldIn(FieldVenue, vs...))
+// VenueInitialsEQ applies the EQ predicate on the "venue_initials" field.
+func VenueInitialsEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldVenueInitials, v))
 }
 
-// VenueNotIn applies the NotIn predicate on the "venue" field.
-func VenueNotIn(vs ...string) predicate.Event {
-	return predicate.Event(sql.FieldNotIn(FieldVenue, vs...))
+// VenueInitialsNEQ applies the NEQ predicate on the "venue_initials" field.
+func VenueInitialsNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldVenueInitials, v))
 }
 
-// VenueGT applies the GT predicate on the "venue" field.
-func VenueGT(v string) predicate.Event {
-	return predicate.Event(sql.FieldGT(FieldVenue, v))
+// VenueInitialsIn applies the In predicate on the "venue_initials" field.
+func VenueInitialsIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldVenueInitials, vs...))
 }
 
-// VenueGTE applies the GTE predicate on the "venue" field.
-func VenueGTE(v string) predicate.Event {
-	return predicate.Event(sql.FieldGTE(FieldVenue, v))
+// VenueInitialsNotIn applies the NotIn predicate on the "venue_initials" field.
+func VenueInitialsNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldVenueInitials, vs...))
 }
 
-// VenueLT applies the LT predicate on the "venue" field.
-func VenueLT(v string) predicate.Event {
-	return predicate.Event(sql.FieldLT(FieldVenue, v))
+// VenueInitialsGT applies the GT predicate on the "venue_initials" field.
+func VenueInitialsGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldVenueInitials, v))
 }
 
-// VenueLTE applies the LTE predicate on the "venue" field.
-func VenueLTE(v string) predicate.Event {
-	return predicate.Event(sql.FieldLTE(FieldVenue, v))
+// VenueInitialsGTE applies the GTE predicate on the "venue_initials" field.
+func VenueInitialsGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldVenueInitials, v))
 }
 
-// VenueContains applies the Contains predicate on the "venue" field.
-func VenueContains(v string) predicate.Event {
-	return predicate.Event(sql.FieldContains(FieldVenue, v))
+// VenueInitialsLT applies the LT predicate on the "venue_initials" field.
+func VenueInitialsLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldVenueInitials, v))
 }
 
-// VenueHasPrefix applies the HasPrefix predicate on the "venue" field.
-func VenueHasPrefix(v string) predicate.Event {
-	return predicate.Event(sql.FieldHasPrefix(FieldVenue, v))
+// VenueInitialsLTE applies the LTE predicate on the "venue_initials" field.
+func VenueInitialsLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldVenueInitials, v))
 }
 
-// VenueHasSuffix applies the HasSuffix predicate on the "venue" field.
-func VenueHasSuffix(v string) predicate.Event {
-	return predicate.Event(sql.FieldHasSuffix(FieldVenue, v))
+// VenueInitialsContains applies the Contains predicate on the "venue_initials" field.
+func VenueInitialsContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldVenueInitials, v))
 }
 
-// VenueIsNil applies the IsNil predicate on the "venue" field.
-func VenueIsNil() predicate.Event {
-	return predicate.Event(sql.FieldIsNull(FieldVenue))
+// VenueInitialsHasPrefix applies the HasPrefix predicate on the "venue_initials" field.
+func VenueInitialsHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldVenueInitials, v))
 }
 
-// VenueNotNil applies the NotNil predicate on the "venue" field.
-func VenueNotNil() predicate.Event {
-	return predicate.Event(sql.FieldNotNull(FieldVenue))
+// VenueInitialsHasSuffix applies the HasSuffix predicate on the "venue_initials" field.
+func VenueInitialsHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldVenueInitials, v))
 }
 
-// VenueEqualFold applies the EqualFold predicate on the "venue" field.
-func VenueEqualFold(v string) predicate.Event {
-	return predicate.Event(sql.FieldEqualFold(FieldVenue, v))
+// VenueInitialsEqualFold applies the EqualFold predicate on the "venue_initials" field.
+func VenueInitialsEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldVenueInitials, v))
 }
 
-// VenueContainsFold applies the ContainsFold predicate on the "venue" field.
-func VenueContainsFold(v string) predicate.Event {
-	return predicate.Event(sql.FieldContainsFold(FieldVenue, v))
+// VenueInitialsContainsFold applies the ContainsFold predicate on the "venue_initials" field.
+func VenueInitialsContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldVenueInitials, v))
 }
 
 // StartTimeEQ applies the EQ predicate on the "start_time" field.
@@ -851,6 +1771,401 @@ func StatusNotIn(vs ...Status) predicate.Event {
 	return predicate.Event(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// ReviewStatusEQ applies the EQ predicate on the "review_status" field.
+func ReviewStatusEQ(v ReviewStatus) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldReviewStatus, v))
+}
+
+// ReviewStatusNEQ applies the NEQ predicate on the "review_status" field.
+func ReviewStatusNEQ(v ReviewStatus) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldReviewStatus, v))
+}
+
+// ReviewStatusIn applies the In predicate on the "review_status" field.
+func ReviewStatusIn(vs ...ReviewStatus) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldReviewStatus, vs...))
+}
+
+// ReviewStatusNotIn applies the NotIn predicate on the "review_status" field.
+func ReviewStatusNotIn(vs ...ReviewStatus) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldReviewStatus, vs...))
+}
+
+// ReviewCommentEQ applies the EQ predicate on the "review_comment" field.
+func ReviewCommentEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentNEQ applies the NEQ predicate on the "review_comment" field.
+func ReviewCommentNEQ(v string) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldReviewComment, v))
+}
+
+// ReviewCommentIn applies the In predicate on the "review_comment" field.
+func ReviewCommentIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentNotIn applies the NotIn predicate on the "review_comment" field.
+func ReviewCommentNotIn(vs ...string) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldReviewComment, vs...))
+}
+
+// ReviewCommentGT applies the GT predicate on the "review_comment" field.
+func ReviewCommentGT(v string) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldReviewComment, v))
+}
+
+// ReviewCommentGTE applies the GTE predicate on the "review_comment" field.
+func ReviewCommentGTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldReviewComment, v))
+}
+
+// ReviewCommentLT applies the LT predicate on the "review_comment" field.
+func ReviewCommentLT(v string) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldReviewComment, v))
+}
+
+// ReviewCommentLTE applies the LTE predicate on the "review_comment" field.
+func ReviewCommentLTE(v string) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldReviewComment, v))
+}
+
+// ReviewCommentContains applies the Contains predicate on the "review_comment" field.
+func ReviewCommentContains(v string) predicate.Event {
+	return predicate.Event(sql.FieldContains(FieldReviewComment, v))
+}
+
+// ReviewCommentHasPrefix applies the HasPrefix predicate on the "review_comment" field.
+func ReviewCommentHasPrefix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasPrefix(FieldReviewComment, v))
+}
+
+// ReviewCommentHasSuffix applies the HasSuffix predicate on the "review_comment" field.
+func ReviewCommentHasSuffix(v string) predicate.Event {
+	return predicate.Event(sql.FieldHasSuffix(FieldReviewComment, v))
+}
+
+// ReviewCommentIsNil applies the IsNil predicate on the "review_comment" field.
+func ReviewCommentIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldReviewComment))
+}
+
+// ReviewCommentNotNil applies the NotNil predicate on the "review_comment" field.
+func ReviewCommentNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldReviewComment))
+}
+
+// ReviewCommentEqualFold applies the EqualFold predicate on the "review_comment" field.
+func ReviewCommentEqualFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldEqualFold(FieldReviewComment, v))
+}
+
+// ReviewCommentContainsFold applies the ContainsFold predicate on the "review_comment" field.
+func ReviewCommentContainsFold(v string) predicate.Event {
+	return predicate.Event(sql.FieldContainsFold(FieldReviewComment, v))
+}
+
+// SubmittedByEQ applies the EQ predicate on the "submitted_by" field.
+func SubmittedByEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedByNEQ applies the NEQ predicate on the "submitted_by" field.
+func SubmittedByNEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSubmittedBy, v))
+}
+
+// SubmittedByIn applies the In predicate on the "submitted_by" field.
+func SubmittedByIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSubmittedBy, vs...))
+}
+
+// SubmittedByNotIn applies the NotIn predicate on the "submitted_by" field.
+func SubmittedByNotIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSubmittedBy, vs...))
+}
+
+// SubmittedByGT applies the GT predicate on the "submitted_by" field.
+func SubmittedByGT(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldSubmittedBy, v))
+}
+
+// SubmittedByGTE applies the GTE predicate on the "submitted_by" field.
+func SubmittedByGTE(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldSubmittedBy, v))
+}
+
+// SubmittedByLT applies the LT predicate on the "submitted_by" field.
+func SubmittedByLT(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldSubmittedBy, v))
+}
+
+// SubmittedByLTE applies the LTE predicate on the "submitted_by" field.
+func SubmittedByLTE(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldSubmittedBy, v))
+}
+
+// SubmittedByIsNil applies the IsNil predicate on the "submitted_by" field.
+func SubmittedByIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldSubmittedBy))
+}
+
+// SubmittedByNotNil applies the NotNil predicate on the "submitted_by" field.
+func SubmittedByNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldSubmittedBy))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// ReviewedByEQ applies the EQ predicate on the "reviewed_by" field.
+func ReviewedByEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByNEQ applies the NEQ predicate on the "reviewed_by" field.
+func ReviewedByNEQ(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldReviewedBy, v))
+}
+
+// ReviewedByIn applies the In predicate on the "reviewed_by" field.
+func ReviewedByIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByNotIn applies the NotIn predicate on the "reviewed_by" field.
+func ReviewedByNotIn(vs ...uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldReviewedBy, vs...))
+}
+
+// ReviewedByGT applies the GT predicate on the "reviewed_by" field.
+func ReviewedByGT(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldReviewedBy, v))
+}
+
+// ReviewedByGTE applies the GTE predicate on the "reviewed_by" field.
+func ReviewedByGTE(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldReviewedBy, v))
+}
+
+// ReviewedByLT applies the LT predicate on the "reviewed_by" field.
+func ReviewedByLT(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldReviewedBy, v))
+}
+
+// ReviewedByLTE applies the LTE predicate on the "reviewed_by" field.
+func ReviewedByLTE(v uuid.UUID) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldReviewedBy, v))
+}
+
+// ReviewedByIsNil applies the IsNil predicate on the "reviewed_by" field.
+func ReviewedByIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldReviewedBy))
+}
+
+// ReviewedByNotNil applies the NotNil predicate on the "reviewed_by" field.
+func ReviewedByNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldReviewedBy))
+}
+
+// ReviewedAtEQ applies the EQ predicate on the "reviewed_at" field.
+func ReviewedAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtNEQ applies the NEQ predicate on the "reviewed_at" field.
+func ReviewedAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldReviewedAt, v))
+}
+
+// ReviewedAtIn applies the In predicate on the "reviewed_at" field.
+func ReviewedAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtNotIn applies the NotIn predicate on the "reviewed_at" field.
+func ReviewedAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldReviewedAt, vs...))
+}
+
+// ReviewedAtGT applies the GT predicate on the "reviewed_at" field.
+func ReviewedAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldReviewedAt, v))
+}
+
+// ReviewedAtGTE applies the GTE predicate on the "reviewed_at" field.
+func ReviewedAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtLT applies the LT predicate on the "reviewed_at" field.
+func ReviewedAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldReviewedAt, v))
+}
+
+// ReviewedAtLTE applies the LTE predicate on the "reviewed_at" field.
+func ReviewedAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldReviewedAt, v))
+}
+
+// ReviewedAtIsNil applies the IsNil predicate on the "reviewed_at" field.
+func ReviewedAtIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldReviewedAt))
+}
+
+// ReviewedAtNotNil applies the NotNil predicate on the "reviewed_at" field.
+func ReviewedAtNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldReviewedAt))
+}
+
+// PublishAtEQ applies the EQ predicate on the "publish_at" field.
+func PublishAtEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldPublishAt, v))
+}
+
+// PublishAtNEQ applies the NEQ predicate on the "publish_at" field.
+func PublishAtNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldPublishAt, v))
+}
+
+// PublishAtIn applies the In predicate on the "publish_at" field.
+func PublishAtIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldPublishAt, vs...))
+}
+
+// PublishAtNotIn applies the NotIn predicate on the "publish_at" field.
+func PublishAtNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldPublishAt, vs...))
+}
+
+// PublishAtGT applies the GT predicate on the "publish_at" field.
+func PublishAtGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldPublishAt, v))
+}
+
+// PublishAtGTE applies the GTE predicate on the "publish_at" field.
+func PublishAtGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldPublishAt, v))
+}
+
+// PublishAtLT applies the LT predicate on the "publish_at" field.
+func PublishAtLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldPublishAt, v))
+}
+
+// PublishAtLTE applies the LTE predicate on the "publish_at" field.
+func PublishAtLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldPublishAt, v))
+}
+
+// PublishAtIsNil applies the IsNil predicate on the "publish_at" field.
+func PublishAtIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldPublishAt))
+}
+
+// PublishAtNotNil applies the NotNil predicate on the "publish_at" field.
+func PublishAtNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldPublishAt))
+}
+
+// DateChangeRefundUntilEQ applies the EQ predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldDateChangeRefundUntil, v))
+}
+
+// DateChangeRefundUntilNEQ applies the NEQ predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilNEQ(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldDateChangeRefundUntil, v))
+}
+
+// DateChangeRefundUntilIn applies the In predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldIn(FieldDateChangeRefundUntil, vs...))
+}
+
+// DateChangeRefundUntilNotIn applies the NotIn predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilNotIn(vs ...time.Time) predicate.Event {
+	return predicate.Event(sql.FieldNotIn(FieldDateChangeRefundUntil, vs...))
+}
+
+// DateChangeRefundUntilGT applies the GT predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilGT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGT(FieldDateChangeRefundUntil, v))
+}
+
+// DateChangeRefundUntilGTE applies the GTE predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilGTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldGTE(FieldDateChangeRefundUntil, v))
+}
+
+// DateChangeRefundUntilLT applies the LT predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilLT(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLT(FieldDateChangeRefundUntil, v))
+}
+
+// DateChangeRefundUntilLTE applies the LTE predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilLTE(v time.Time) predicate.Event {
+	return predicate.Event(sql.FieldLTE(FieldDateChangeRefundUntil, v))
+}
+
+// DateChangeRefundUntilIsNil applies the IsNil predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilIsNil() predicate.Event {
+	return predicate.Event(sql.FieldIsNull(FieldDateChangeRefundUntil))
+}
+
+// DateChangeRefundUntilNotNil applies the NotNil predicate on the "date_change_refund_until" field.
+func DateChangeRefundUntilNotNil() predicate.Event {
+	return predicate.Event(sql.FieldNotNull(FieldDateChangeRefundUntil))
+}
+
 // IsPublicEQ applies the EQ predicate on the "is_public" field.
 func IsPublicEQ(v bool) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldIsPublic, v))
@@ -861,6 +2176,16 @@ func IsPublicNEQ(v bool) predicate.Event {
 	return predicate.Event(sql.FieldNEQ(FieldIsPublic, v))
 }
 
+// RequiresApprovalEQ applies the EQ predicate on the "requires_approval" field.
+func RequiresApprovalEQ(v bool) predicate.Event {
+	return predicate.Event(sql.FieldEQ(FieldRequiresApproval, v))
+}
+
+// RequiresApprovalNEQ applies the NEQ predicate on the "requires_approval" field.
+func RequiresApprovalNEQ(v bool) predicate.Event {
+	return predicate.Event(sql.FieldNEQ(FieldRequiresApproval, v))
+}
+
 // CreatedByEQ applies the EQ predicate on the "created_by" field.
 func CreatedByEQ(v uuid.UUID) predicate.Event {
 	return predicate.Event(sql.FieldEQ(FieldCreatedBy, v))
@@ -1007,6 +2332,52 @@ func HasCreatorWith(preds ...predicate.User) predicate.Event {
 	})
 }
 
+// HasLinkedVenue applies the HasEdge predicate on the "linked_venue" edge.
+func HasLinkedVenue() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkedVenueTable, LinkedVenueColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkedVenueWith applies the HasEdge predicate on the "linked_venue" edge with a given conditions (other predicates).
+func HasLinkedVenueWith(preds ...predicate.Venue) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newLinkedVenueStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSeries applies the HasEdge predicate on the "series" edge.
+func HasSeries() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, SeriesTable, SeriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSeriesWith applies the HasEdge predicate on the "series" edge with a given conditions (other predicates).
+func HasSeriesWith(preds ...predicate.EventSeries) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newSeriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasPayments applies the HasEdge predicate on the "payments" edge.
 func HasPayments() predicate.Event {
 	return predicate.Event(func(s *sql.Selector) {
@@ -1030,6 +2401,29 @@ func HasPaymentsWith(preds ...predicate.Payment) predicate.Event {
 	})
 }
 
+// HasRegistrationQuestions applies the HasEdge predicate on the "registration_questions" edge.
+func HasRegistrationQuestions() predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RegistrationQuestionsTable, RegistrationQuestionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegistrationQuestionsWith applies the HasEdge predicate on the "registration_questions" edge with a given conditions (other predicates).
+func HasRegistrationQuestionsWith(preds ...predicate.RegistrationQuestion) predicate.Event {
+	return predicate.Event(func(s *sql.Selector) {
+		step := newRegistrationQuestionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Event) predicate.Event {
 	return predicate.Event(sql.AndPredicates(predicates...))
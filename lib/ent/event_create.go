@@ -11,9 +11,12 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
 	"github.com/google/uuid"
 )
 
@@ -30,6 +33,20 @@ func (_c *EventCreate) SetOrganizationID(v uuid.UUID) *EventCreate {
 	return _c
 }
 
+// SetSeriesID sets the "series_id" field.
+func (_c *EventCreate) SetSeriesID(v uuid.UUID) *EventCreate {
+	_c.mutation.SetSeriesID(v)
+	return _c
+}
+
+// SetNillableSeriesID sets the "series_id" field if the given value is not nil.
+func (_c *EventCreate) SetNillableSeriesID(v *uuid.UUID) *EventCreate {
+	if v != nil {
+		_c.SetSeriesID(*v)
+	}
+	return _c
+}
+
 // SetTitle sets the "title" field.
 func (_c *EventCreate) SetTitle(v string) *EventCreate {
 	_c.mutation.SetTitle(v)
@@ -78,6 +95,188 @@ func (_c *EventCreate) SetNillableVenue(v *string) *EventCreate {
 	return _c
 }
 
+// SetVenueID sets the "venue_id" field.
+func (_c *EventCreate) SetVenueID(v uuid.UUID) *EventCreate {
+	_c.mutation.SetVenueID(v)
+	return _c
+}
+
+// SetNillableVenueID sets the "venue_id" field if the given value is not nil.
+func (_c *EventCreate) SetNillableVenueID(v *uuid.UUID) *EventCreate {
+	if v != nil {
+		_c.SetVenueID(*v)
+	}
+	return _c
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (_c *EventCreate) SetSeatingLayout(v string) *EventCreate {
+	_c.mutation.SetSeatingLayout(v)
+	return _c
+}
+
+// SetNillableSeatingLayout sets the "seating_layout" field if the given value is not nil.
+func (_c *EventCreate) SetNillableSeatingLayout(v *string) *EventCreate {
+	if v != nil {
+		_c.SetSeatingLayout(*v)
+	}
+	return _c
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_c *EventCreate) SetAddressStreet(v string) *EventCreate {
+	_c.mutation.SetAddressStreet(v)
+	return _c
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_c *EventCreate) SetNillableAddressStreet(v *string) *EventCreate {
+	if v != nil {
+		_c.SetAddressStreet(*v)
+	}
+	return _c
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_c *EventCreate) SetAddressCity(v string) *EventCreate {
+	_c.mutation.SetAddressCity(v)
+	return _c
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_c *EventCreate) SetNillableAddressCity(v *string) *EventCreate {
+	if v != nil {
+		_c.SetAddressCity(*v)
+	}
+	return _c
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_c *EventCreate) SetAddressRegion(v string) *EventCreate {
+	_c.mutation.SetAddressRegion(v)
+	return _c
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_c *EventCreate) SetNillableAddressRegion(v *string) *EventCreate {
+	if v != nil {
+		_c.SetAddressRegion(*v)
+	}
+	return _c
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_c *EventCreate) SetAddressPostalCode(v string) *EventCreate {
+	_c.mutation.SetAddressPostalCode(v)
+	return _c
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_c *EventCreate) SetNillableAddressPostalCode(v *string) *EventCreate {
+	if v != nil {
+		_c.SetAddressPostalCode(*v)
+	}
+	return _c
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_c *EventCreate) SetAddressCountry(v string) *EventCreate {
+	_c.mutation.SetAddressCountry(v)
+	return _c
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_c *EventCreate) SetNillableAddressCountry(v *string) *EventCreate {
+	if v != nil {
+		_c.SetAddressCountry(*v)
+	}
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *EventCreate) SetLatitude(v float64) *EventCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_c *EventCreate) SetNillableLatitude(v *float64) *EventCreate {
+	if v != nil {
+		_c.SetLatitude(*v)
+	}
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *EventCreate) SetLongitude(v float64) *EventCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_c *EventCreate) SetNillableLongitude(v *float64) *EventCreate {
+	if v != nil {
+		_c.SetLongitude(*v)
+	}
+	return _c
+}
+
+// SetGeohash sets the "geohash" field.
+func (_c *EventCreate) SetGeohash(v string) *EventCreate {
+	_c.mutation.SetGeohash(v)
+	return _c
+}
+
+// SetNillableGeohash sets the "geohash" field if the given value is not nil.
+func (_c *EventCreate) SetNillableGeohash(v *string) *EventCreate {
+	if v != nil {
+		_c.SetGeohash(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *EventCreate) SetCategory(v string) *EventCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *EventCreate) SetNillableCategory(v *string) *EventCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetTitleInitials sets the "title_initials" field.
+func (_c *EventCreate) SetTitleInitials(v string) *EventCreate {
+	_c.mutation.SetTitleInitials(v)
+	return _c
+}
+
+// SetNillableTitleInitials sets the "title_initials" field if the given value is not nil.
+func (_c *EventCreate) SetNillableTitleInitials(v *string) *EventCreate {
+	if v != nil {
+		_c.SetTitleInitials(*v)
+	}
+	return _c
+}
+
+// SetVenueInitials sets the "venue_initials" field.
+func (_c *EventCreate) SetVenueInitials(v string) *EventCreate {
+	_c.mutation.SetVenueInitials(v)
+	return _c
+}
+
+// SetNillableVenueInitials sets the "venue_initials" field if the given value is not nil.
+func (_c *EventCreate) SetNillableVenueInitials(v *string) *EventCreate {
+	if v != nil {
+		_c.SetVenueInitials(*v)
+	}
+	return _c
+}
+
 // SetStartTime sets the "start_time" field.
 func (_c *EventCreate) SetStartTime(v time.Time) *EventCreate {
 	_c.mutation.SetStartTime(v)
@@ -188,6 +387,118 @@ func (_c *EventCreate) SetNillableStatus(v *event.Status) *EventCreate {
 	return _c
 }
 
+// SetReviewStatus sets the "review_status" field.
+func (_c *EventCreate) SetReviewStatus(v event.ReviewStatus) *EventCreate {
+	_c.mutation.SetReviewStatus(v)
+	return _c
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (_c *EventCreate) SetNillableReviewStatus(v *event.ReviewStatus) *EventCreate {
+	if v != nil {
+		_c.SetReviewStatus(*v)
+	}
+	return _c
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_c *EventCreate) SetReviewComment(v string) *EventCreate {
+	_c.mutation.SetReviewComment(v)
+	return _c
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_c *EventCreate) SetNillableReviewComment(v *string) *EventCreate {
+	if v != nil {
+		_c.SetReviewComment(*v)
+	}
+	return _c
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_c *EventCreate) SetSubmittedBy(v uuid.UUID) *EventCreate {
+	_c.mutation.SetSubmittedBy(v)
+	return _c
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_c *EventCreate) SetNillableSubmittedBy(v *uuid.UUID) *EventCreate {
+	if v != nil {
+		_c.SetSubmittedBy(*v)
+	}
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *EventCreate) SetSubmittedAt(v time.Time) *EventCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *EventCreate) SetNillableSubmittedAt(v *time.Time) *EventCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_c *EventCreate) SetReviewedBy(v uuid.UUID) *EventCreate {
+	_c.mutation.SetReviewedBy(v)
+	return _c
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_c *EventCreate) SetNillableReviewedBy(v *uuid.UUID) *EventCreate {
+	if v != nil {
+		_c.SetReviewedBy(*v)
+	}
+	return _c
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_c *EventCreate) SetReviewedAt(v time.Time) *EventCreate {
+	_c.mutation.SetReviewedAt(v)
+	return _c
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_c *EventCreate) SetNillableReviewedAt(v *time.Time) *EventCreate {
+	if v != nil {
+		_c.SetReviewedAt(*v)
+	}
+	return _c
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_c *EventCreate) SetPublishAt(v time.Time) *EventCreate {
+	_c.mutation.SetPublishAt(v)
+	return _c
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_c *EventCreate) SetNillablePublishAt(v *time.Time) *EventCreate {
+	if v != nil {
+		_c.SetPublishAt(*v)
+	}
+	return _c
+}
+
+// SetDateChangeRefundUntil sets the "date_change_refund_until" field.
+func (_c *EventCreate) SetDateChangeRefundUntil(v time.Time) *EventCreate {
+	_c.mutation.SetDateChangeRefundUntil(v)
+	return _c
+}
+
+// SetNillableDateChangeRefundUntil sets the "date_change_refund_until" field if the given value is not nil.
+func (_c *EventCreate) SetNillableDateChangeRefundUntil(v *time.Time) *EventCreate {
+	if v != nil {
+		_c.SetDateChangeRefundUntil(*v)
+	}
+	return _c
+}
+
 // SetIsPublic sets the "is_public" field.
 func (_c *EventCreate) SetIsPublic(v bool) *EventCreate {
 	_c.mutation.SetIsPublic(v)
@@ -202,6 +513,20 @@ func (_c *EventCreate) SetNillableIsPublic(v *bool) *EventCreate {
 	return _c
 }
 
+// SetRequiresApproval sets the "requires_approval" field.
+func (_c *EventCreate) SetRequiresApproval(v bool) *EventCreate {
+	_c.mutation.SetRequiresApproval(v)
+	return _c
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_c *EventCreate) SetNillableRequiresApproval(v *bool) *EventCreate {
+	if v != nil {
+		_c.SetRequiresApproval(*v)
+	}
+	return _c
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_c *EventCreate) SetCreatedBy(v uuid.UUID) *EventCreate {
 	_c.mutation.SetCreatedBy(v)
@@ -266,6 +591,30 @@ func (_c *EventCreate) SetCreator(v *User) *EventCreate {
 	return _c.SetCreatorID(v.ID)
 }
 
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID.
+func (_c *EventCreate) SetLinkedVenueID(id uuid.UUID) *EventCreate {
+	_c.mutation.SetLinkedVenueID(id)
+	return _c
+}
+
+// SetNillableLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID if the given value is not nil.
+func (_c *EventCreate) SetNillableLinkedVenueID(id *uuid.UUID) *EventCreate {
+	if id != nil {
+		_c = _c.SetLinkedVenueID(*id)
+	}
+	return _c
+}
+
+// SetLinkedVenue sets the "linked_venue" edge to the Venue entity.
+func (_c *EventCreate) SetLinkedVenue(v *Venue) *EventCreate {
+	return _c.SetLinkedVenueID(v.ID)
+}
+
+// SetSeries sets the "series" edge to the EventSeries entity.
+func (_c *EventCreate) SetSeries(v *EventSeries) *EventCreate {
+	return _c.SetSeriesID(v.ID)
+}
+
 // AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
 func (_c *EventCreate) AddPaymentIDs(ids ...uuid.UUID) *EventCreate {
 	_c.mutation.AddPaymentIDs(ids...)
@@ -281,6 +630,21 @@ func (_c *EventCreate) AddPayments(v ...*Payment) *EventCreate {
 	return _c.AddPaymentIDs(ids...)
 }
 
+// AddRegistrationQuestionIDs adds the "registration_questions" edge to the RegistrationQuestion entity by IDs.
+func (_c *EventCreate) AddRegistrationQuestionIDs(ids ...uuid.UUID) *EventCreate {
+	_c.mutation.AddRegistrationQuestionIDs(ids...)
+	return _c
+}
+
+// AddRegistrationQuestions adds the "registration_questions" edges to the RegistrationQuestion entity.
+func (_c *EventCreate) AddRegistrationQuestions(v ...*RegistrationQuestion) *EventCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRegistrationQuestionIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (_c *EventCreate) Mutation() *EventMutation {
 	return _c.mutation
@@ -316,6 +680,46 @@ func (_c *EventCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *EventCreate) defaults() {
+	if _, ok := _c.mutation.SeatingLayout(); !ok {
+		v := event.DefaultSeatingLayout
+		_c.mutation.SetSeatingLayout(v)
+	}
+	if _, ok := _c.mutation.AddressStreet(); !ok {
+		v := event.DefaultAddressStreet
+		_c.mutation.SetAddressStreet(v)
+	}
+	if _, ok := _c.mutation.AddressCity(); !ok {
+		v := event.DefaultAddressCity
+		_c.mutation.SetAddressCity(v)
+	}
+	if _, ok := _c.mutation.AddressRegion(); !ok {
+		v := event.DefaultAddressRegion
+		_c.mutation.SetAddressRegion(v)
+	}
+	if _, ok := _c.mutation.AddressPostalCode(); !ok {
+		v := event.DefaultAddressPostalCode
+		_c.mutation.SetAddressPostalCode(v)
+	}
+	if _, ok := _c.mutation.AddressCountry(); !ok {
+		v := event.DefaultAddressCountry
+		_c.mutation.SetAddressCountry(v)
+	}
+	if _, ok := _c.mutation.Geohash(); !ok {
+		v := event.DefaultGeohash
+		_c.mutation.SetGeohash(v)
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		v := event.DefaultCategory
+		_c.mutation.SetCategory(v)
+	}
+	if _, ok := _c.mutation.TitleInitials(); !ok {
+		v := event.DefaultTitleInitials
+		_c.mutation.SetTitleInitials(v)
+	}
+	if _, ok := _c.mutation.VenueInitials(); !ok {
+		v := event.DefaultVenueInitials
+		_c.mutation.SetVenueInitials(v)
+	}
 	if _, ok := _c.mutation.TotalTickets(); !ok {
 		v := event.DefaultTotalTickets
 		_c.mutation.SetTotalTickets(v)
@@ -340,10 +744,18 @@ func (_c *EventCreate) defaults() {
 		v := event.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.ReviewStatus(); !ok {
+		v := event.DefaultReviewStatus
+		_c.mutation.SetReviewStatus(v)
+	}
 	if _, ok := _c.mutation.IsPublic(); !ok {
 		v := event.DefaultIsPublic
 		_c.mutation.SetIsPublic(v)
 	}
+	if _, ok := _c.mutation.RequiresApproval(); !ok {
+		v := event.DefaultRequiresApproval
+		_c.mutation.SetRequiresApproval(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := event.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -371,6 +783,66 @@ func (_c *EventCreate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.SeatingLayout(); !ok {
+		return &ValidationError{Name: "seating_layout", err: errors.New(`ent: missing required field "Event.seating_layout"`)}
+	}
+	if _, ok := _c.mutation.AddressStreet(); !ok {
+		return &ValidationError{Name: "address_street", err: errors.New(`ent: missing required field "Event.address_street"`)}
+	}
+	if _, ok := _c.mutation.AddressCity(); !ok {
+		return &ValidationError{Name: "address_city", err: errors.New(`ent: missing required field "Event.address_city"`)}
+	}
+	if v, ok := _c.mutation.AddressCity(); ok {
+		if err := event.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "Event.address_city": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressRegion(); !ok {
+		return &ValidationError{Name: "address_region", err: errors.New(`ent: missing required field "Event.address_region"`)}
+	}
+	if v, ok := _c.mutation.AddressRegion(); ok {
+		if err := event.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "Event.address_region": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressPostalCode(); !ok {
+		return &ValidationError{Name: "address_postal_code", err: errors.New(`ent: missing required field "Event.address_postal_code"`)}
+	}
+	if v, ok := _c.mutation.AddressPostalCode(); ok {
+		if err := event.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "Event.address_postal_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressCountry(); !ok {
+		return &ValidationError{Name: "address_country", err: errors.New(`ent: missing required field "Event.address_country"`)}
+	}
+	if v, ok := _c.mutation.AddressCountry(); ok {
+		if err := event.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "Event.address_country": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Geohash(); !ok {
+		return &ValidationError{Name: "geohash", err: errors.New(`ent: missing required field "Event.geohash"`)}
+	}
+	if v, ok := _c.mutation.Geohash(); ok {
+		if err := event.GeohashValidator(v); err != nil {
+			return &ValidationError{Name: "geohash", err: fmt.Errorf(`ent: validator failed for field "Event.geohash": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "Event.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := event.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Event.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TitleInitials(); !ok {
+		return &ValidationError{Name: "title_initials", err: errors.New(`ent: missing required field "Event.title_initials"`)}
+	}
+	if _, ok := _c.mutation.VenueInitials(); !ok {
+		return &ValidationError{Name: "venue_initials", err: errors.New(`ent: missing required field "Event.venue_initials"`)}
+	}
 	if _, ok := _c.mutation.StartTime(); !ok {
 		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "Event.start_time"`)}
 	}
@@ -420,9 +892,20 @@ func (_c *EventCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Event.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.ReviewStatus(); !ok {
+		return &ValidationError{Name: "review_status", err: errors.New(`ent: missing required field "Event.review_status"`)}
+	}
+	if v, ok := _c.mutation.ReviewStatus(); ok {
+		if err := event.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "Event.review_status": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.IsPublic(); !ok {
 		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "Event.is_public"`)}
 	}
+	if _, ok := _c.mutation.RequiresApproval(); !ok {
+		return &ValidationError{Name: "requires_approval", err: errors.New(`ent: missing required field "Event.requires_approval"`)}
+	}
 	if _, ok := _c.mutation.CreatedBy(); !ok {
 		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Event.created_by"`)}
 	}
@@ -489,6 +972,54 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldVenue, field.TypeString, value)
 		_node.Venue = value
 	}
+	if value, ok := _c.mutation.SeatingLayout(); ok {
+		_spec.SetField(event.FieldSeatingLayout, field.TypeString, value)
+		_node.SeatingLayout = value
+	}
+	if value, ok := _c.mutation.AddressStreet(); ok {
+		_spec.SetField(event.FieldAddressStreet, field.TypeString, value)
+		_node.AddressStreet = value
+	}
+	if value, ok := _c.mutation.AddressCity(); ok {
+		_spec.SetField(event.FieldAddressCity, field.TypeString, value)
+		_node.AddressCity = value
+	}
+	if value, ok := _c.mutation.AddressRegion(); ok {
+		_spec.SetField(event.FieldAddressRegion, field.TypeString, value)
+		_node.AddressRegion = value
+	}
+	if value, ok := _c.mutation.AddressPostalCode(); ok {
+		_spec.SetField(event.FieldAddressPostalCode, field.TypeString, value)
+		_node.AddressPostalCode = value
+	}
+	if value, ok := _c.mutation.AddressCountry(); ok {
+		_spec.SetField(event.FieldAddressCountry, field.TypeString, value)
+		_node.AddressCountry = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(event.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(event.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := _c.mutation.Geohash(); ok {
+		_spec.SetField(event.FieldGeohash, field.TypeString, value)
+		_node.Geohash = value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(event.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.TitleInitials(); ok {
+		_spec.SetField(event.FieldTitleInitials, field.TypeString, value)
+		_node.TitleInitials = value
+	}
+	if value, ok := _c.mutation.VenueInitials(); ok {
+		_spec.SetField(event.FieldVenueInitials, field.TypeString, value)
+		_node.VenueInitials = value
+	}
 	if value, ok := _c.mutation.StartTime(); ok {
 		_spec.SetField(event.FieldStartTime, field.TypeTime, value)
 		_node.StartTime = value
@@ -525,10 +1056,46 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_spec.SetField(event.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.ReviewStatus(); ok {
+		_spec.SetField(event.FieldReviewStatus, field.TypeEnum, value)
+		_node.ReviewStatus = value
+	}
+	if value, ok := _c.mutation.ReviewComment(); ok {
+		_spec.SetField(event.FieldReviewComment, field.TypeString, value)
+		_node.ReviewComment = value
+	}
+	if value, ok := _c.mutation.SubmittedBy(); ok {
+		_spec.SetField(event.FieldSubmittedBy, field.TypeUUID, value)
+		_node.SubmittedBy = &value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(event.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := _c.mutation.ReviewedBy(); ok {
+		_spec.SetField(event.FieldReviewedBy, field.TypeUUID, value)
+		_node.ReviewedBy = &value
+	}
+	if value, ok := _c.mutation.ReviewedAt(); ok {
+		_spec.SetField(event.FieldReviewedAt, field.TypeTime, value)
+		_node.ReviewedAt = &value
+	}
+	if value, ok := _c.mutation.PublishAt(); ok {
+		_spec.SetField(event.FieldPublishAt, field.TypeTime, value)
+		_node.PublishAt = &value
+	}
+	if value, ok := _c.mutation.DateChangeRefundUntil(); ok {
+		_spec.SetField(event.FieldDateChangeRefundUntil, field.TypeTime, value)
+		_node.DateChangeRefundUntil = &value
+	}
 	if value, ok := _c.mutation.IsPublic(); ok {
 		_spec.SetField(event.FieldIsPublic, field.TypeBool, value)
 		_node.IsPublic = value
 	}
+	if value, ok := _c.mutation.RequiresApproval(); ok {
+		_spec.SetField(event.FieldRequiresApproval, field.TypeBool, value)
+		_node.RequiresApproval = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(event.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -571,6 +1138,40 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		_node.CreatedBy = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.LinkedVenueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.LinkedVenueTable,
+			Columns: []string{event.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VenueID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.SeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.SeriesTable,
+			Columns: []string{event.SeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.SeriesID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.PaymentsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -587,6 +1188,22 @@ func (_c *EventCreate) createSpec() (*Event, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RegistrationQuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RegistrationQuestionsTable,
+			Columns: []string{event.RegistrationQuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -13,23 +13,29 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
 	"github.com/google/uuid"
 )
 
 // EventQuery is the builder for querying Event entities.
 type EventQuery struct {
 	config
-	ctx              *QueryContext
-	order            []event.OrderOption
-	inters           []Interceptor
-	predicates       []predicate.Event
-	withOrganization *OrganizationQuery
-	withCreator      *UserQuery
-	withPayments     *PaymentQuery
+	ctx                       *QueryContext
+	order                     []event.OrderOption
+	inters                    []Interceptor
+	predicates                []predicate.Event
+	withOrganization          *OrganizationQuery
+	withCreator               *UserQuery
+	withLinkedVenue           *VenueQuery
+	withSeries                *EventSeriesQuery
+	withPayments              *PaymentQuery
+	withRegistrationQuestions *RegistrationQuestionQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -110,6 +116,50 @@ func (_q *EventQuery) QueryCreator() *UserQuery {
 	return query
 }
 
+// QueryLinkedVenue chains the current query on the "linked_venue" edge.
+func (_q *EventQuery) QueryLinkedVenue() *VenueQuery {
+	query := (&VenueClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(venue.Table, venue.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.LinkedVenueTable, event.LinkedVenueColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySeries chains the current query on the "series" edge.
+func (_q *EventQuery) QuerySeries() *EventSeriesQuery {
+	query := (&EventSeriesClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(eventseries.Table, eventseries.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, event.SeriesTable, event.SeriesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPayments chains the current query on the "payments" edge.
 func (_q *EventQuery) QueryPayments() *PaymentQuery {
 	query := (&PaymentClient{config: _q.config}).Query()
@@ -132,6 +182,28 @@ func (_q *EventQuery) QueryPayments() *PaymentQuery {
 	return query
 }
 
+// QueryRegistrationQuestions chains the current query on the "registration_questions" edge.
+func (_q *EventQuery) QueryRegistrationQuestions() *RegistrationQuestionQuery {
+	query := (&RegistrationQuestionClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(event.Table, event.FieldID, selector),
+			sqlgraph.To(registrationquestion.Table, registrationquestion.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, event.RegistrationQuestionsTable, event.RegistrationQuestionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Event entity from the query.
 // Returns a *NotFoundError when no Event was found.
 func (_q *EventQuery) First(ctx context.Context) (*Event, error) {
@@ -319,14 +391,17 @@ func (_q *EventQuery) Clone() *EventQuery {
 		return nil
 	}
 	return &EventQuery{
-		config:           _q.config,
-		ctx:              _q.ctx.Clone(),
-		order:            append([]event.OrderOption{}, _q.order...),
-		inters:           append([]Interceptor{}, _q.inters...),
-		predicates:       append([]predicate.Event{}, _q.predicates...),
-		withOrganization: _q.withOrganization.Clone(),
-		withCreator:      _q.withCreator.Clone(),
-		withPayments:     _q.withPayments.Clone(),
+		config:                    _q.config,
+		ctx:                       _q.ctx.Clone(),
+		order:                     append([]event.OrderOption{}, _q.order...),
+		inters:                    append([]Interceptor{}, _q.inters...),
+		predicates:                append([]predicate.Event{}, _q.predicates...),
+		withOrganization:          _q.withOrganization.Clone(),
+		withCreator:               _q.withCreator.Clone(),
+		withLinkedVenue:           _q.withLinkedVenue.Clone(),
+		withSeries:                _q.withSeries.Clone(),
+		withPayments:              _q.withPayments.Clone(),
+		withRegistrationQuestions: _q.withRegistrationQuestions.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -355,6 +430,28 @@ func (_q *EventQuery) WithCreator(opts ...func(*UserQuery)) *EventQuery {
 	return _q
 }
 
+// WithLinkedVenue tells the query-builder to eager-load the nodes that are connected to
+// the "linked_venue" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EventQuery) WithLinkedVenue(opts ...func(*VenueQuery)) *EventQuery {
+	query := (&VenueClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withLinkedVenue = query
+	return _q
+}
+
+// WithSeries tells the query-builder to eager-load the nodes that are connected to
+// the "series" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EventQuery) WithSeries(opts ...func(*EventSeriesQuery)) *EventQuery {
+	query := (&EventSeriesClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withSeries = query
+	return _q
+}
+
 // WithPayments tells the query-builder to eager-load the nodes that are connected to
 // the "payments" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *EventQuery) WithPayments(opts ...func(*PaymentQuery)) *EventQuery {
@@ -366,6 +463,17 @@ func (_q *EventQuery) WithPayments(opts ...func(*PaymentQuery)) *EventQuery {
 	return _q
 }
 
+// WithRegistrationQuestions tells the query-builder to eager-load the nodes that are connected to
+// the "registration_questions" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *EventQuery) WithRegistrationQuestions(opts ...func(*RegistrationQuestionQuery)) *EventQuery {
+	query := (&RegistrationQuestionClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRegistrationQuestions = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +552,13 @@ func (_q *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 	var (
 		nodes       = []*Event{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			_q.withOrganization != nil,
 			_q.withCreator != nil,
+			_q.withLinkedVenue != nil,
+			_q.withSeries != nil,
 			_q.withPayments != nil,
+			_q.withRegistrationQuestions != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -480,6 +591,18 @@ func (_q *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			return nil, err
 		}
 	}
+	if query := _q.withLinkedVenue; query != nil {
+		if err := _q.loadLinkedVenue(ctx, query, nodes, nil,
+			func(n *Event, e *Venue) { n.Edges.LinkedVenue = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withSeries; query != nil {
+		if err := _q.loadSeries(ctx, query, nodes, nil,
+			func(n *Event, e *EventSeries) { n.Edges.Series = e }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withPayments; query != nil {
 		if err := _q.loadPayments(ctx, query, nodes,
 			func(n *Event) { n.Edges.Payments = []*Payment{} },
@@ -487,6 +610,15 @@ func (_q *EventQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Event,
 			return nil, err
 		}
 	}
+	if query := _q.withRegistrationQuestions; query != nil {
+		if err := _q.loadRegistrationQuestions(ctx, query, nodes,
+			func(n *Event) { n.Edges.RegistrationQuestions = []*RegistrationQuestion{} },
+			func(n *Event, e *RegistrationQuestion) {
+				n.Edges.RegistrationQuestions = append(n.Edges.RegistrationQuestions, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -548,6 +680,70 @@ func (_q *EventQuery) loadCreator(ctx context.Context, query *UserQuery, nodes [
 	}
 	return nil
 }
+func (_q *EventQuery) loadLinkedVenue(ctx context.Context, query *VenueQuery, nodes []*Event, init func(*Event), assign func(*Event, *Venue)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Event)
+	for i := range nodes {
+		if nodes[i].VenueID == nil {
+			continue
+		}
+		fk := *nodes[i].VenueID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(venue.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "venue_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (_q *EventQuery) loadSeries(ctx context.Context, query *EventSeriesQuery, nodes []*Event, init func(*Event), assign func(*Event, *EventSeries)) error {
+	ids := make([]uuid.UUID, 0, len(nodes))
+	nodeids := make(map[uuid.UUID][]*Event)
+	for i := range nodes {
+		if nodes[i].SeriesID == nil {
+			continue
+		}
+		fk := *nodes[i].SeriesID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(eventseries.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "series_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
 func (_q *EventQuery) loadPayments(ctx context.Context, query *PaymentQuery, nodes []*Event, init func(*Event), assign func(*Event, *Payment)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Event)
@@ -578,6 +774,36 @@ func (_q *EventQuery) loadPayments(ctx context.Context, query *PaymentQuery, nod
 	}
 	return nil
 }
+func (_q *EventQuery) loadRegistrationQuestions(ctx context.Context, query *RegistrationQuestionQuery, nodes []*Event, init func(*Event), assign func(*Event, *RegistrationQuestion)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Event)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(registrationquestion.FieldEventID)
+	}
+	query.Where(predicate.RegistrationQuestion(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(event.RegistrationQuestionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.EventID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "event_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *EventQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -610,6 +836,12 @@ func (_q *EventQuery) querySpec() *sqlgraph.QuerySpec {
 		if _q.withCreator != nil {
 			_spec.Node.AddColumnOnce(event.FieldCreatedBy)
 		}
+		if _q.withLinkedVenue != nil {
+			_spec.Node.AddColumnOnce(event.FieldVenueID)
+		}
+		if _q.withSeries != nil {
+			_spec.Node.AddColumnOnce(event.FieldSeriesID)
+		}
 	}
 	if ps := _q.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
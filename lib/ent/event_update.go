@@ -15,7 +15,9 @@ import (
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
 	"github.com/google/uuid"
 )
 
@@ -120,6 +122,220 @@ func (_u *EventUpdate) ClearVenue() *EventUpdate {
 	return _u
 }
 
+// SetVenueID sets the "venue_id" field.
+func (_u *EventUpdate) SetVenueID(v uuid.UUID) *EventUpdate {
+	_u.mutation.SetVenueID(v)
+	return _u
+}
+
+// SetNillableVenueID sets the "venue_id" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableVenueID(v *uuid.UUID) *EventUpdate {
+	if v != nil {
+		_u.SetVenueID(*v)
+	}
+	return _u
+}
+
+// ClearVenueID clears the value of the "venue_id" field.
+func (_u *EventUpdate) ClearVenueID() *EventUpdate {
+	_u.mutation.ClearVenueID()
+	return _u
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (_u *EventUpdate) SetSeatingLayout(v string) *EventUpdate {
+	_u.mutation.SetSeatingLayout(v)
+	return _u
+}
+
+// SetNillableSeatingLayout sets the "seating_layout" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableSeatingLayout(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetSeatingLayout(*v)
+	}
+	return _u
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_u *EventUpdate) SetAddressStreet(v string) *EventUpdate {
+	_u.mutation.SetAddressStreet(v)
+	return _u
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableAddressStreet(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetAddressStreet(*v)
+	}
+	return _u
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_u *EventUpdate) SetAddressCity(v string) *EventUpdate {
+	_u.mutation.SetAddressCity(v)
+	return _u
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableAddressCity(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetAddressCity(*v)
+	}
+	return _u
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_u *EventUpdate) SetAddressRegion(v string) *EventUpdate {
+	_u.mutation.SetAddressRegion(v)
+	return _u
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableAddressRegion(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetAddressRegion(*v)
+	}
+	return _u
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_u *EventUpdate) SetAddressPostalCode(v string) *EventUpdate {
+	_u.mutation.SetAddressPostalCode(v)
+	return _u
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableAddressPostalCode(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetAddressPostalCode(*v)
+	}
+	return _u
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_u *EventUpdate) SetAddressCountry(v string) *EventUpdate {
+	_u.mutation.SetAddressCountry(v)
+	return _u
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableAddressCountry(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetAddressCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *EventUpdate) SetLatitude(v float64) *EventUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableLatitude(v *float64) *EventUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *EventUpdate) AddLatitude(v float64) *EventUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *EventUpdate) ClearLatitude() *EventUpdate {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *EventUpdate) SetLongitude(v float64) *EventUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableLongitude(v *float64) *EventUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *EventUpdate) AddLongitude(v float64) *EventUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *EventUpdate) ClearLongitude() *EventUpdate {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetGeohash sets the "geohash" field.
+func (_u *EventUpdate) SetGeohash(v string) *EventUpdate {
+	_u.mutation.SetGeohash(v)
+	return _u
+}
+
+// SetNillableGeohash sets the "geohash" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableGeohash(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetGeohash(*v)
+	}
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *EventUpdate) SetCategory(v string) *EventUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableCategory(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetTitleInitials sets the "title_initials" field.
+func (_u *EventUpdate) SetTitleInitials(v string) *EventUpdate {
+	_u.mutation.SetTitleInitials(v)
+	return _u
+}
+
+// SetNillableTitleInitials sets the "title_initials" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableTitleInitials(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetTitleInitials(*v)
+	}
+	return _u
+}
+
+// SetVenueInitials sets the "venue_initials" field.
+func (_u *EventUpdate) SetVenueInitials(v string) *EventUpdate {
+	_u.mutation.SetVenueInitials(v)
+	return _u
+}
+
+// SetNillableVenueInitials sets the "venue_initials" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableVenueInitials(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetVenueInitials(*v)
+	}
+	return _u
+}
+
 // SetStartTime sets the "start_time" field.
 func (_u *EventUpdate) SetStartTime(v time.Time) *EventUpdate {
 	_u.mutation.SetStartTime(v)
@@ -280,6 +496,160 @@ func (_u *EventUpdate) SetNillableStatus(v *event.Status) *EventUpdate {
 	return _u
 }
 
+// SetReviewStatus sets the "review_status" field.
+func (_u *EventUpdate) SetReviewStatus(v event.ReviewStatus) *EventUpdate {
+	_u.mutation.SetReviewStatus(v)
+	return _u
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableReviewStatus(v *event.ReviewStatus) *EventUpdate {
+	if v != nil {
+		_u.SetReviewStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_u *EventUpdate) SetReviewComment(v string) *EventUpdate {
+	_u.mutation.SetReviewComment(v)
+	return _u
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableReviewComment(v *string) *EventUpdate {
+	if v != nil {
+		_u.SetReviewComment(*v)
+	}
+	return _u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (_u *EventUpdate) ClearReviewComment() *EventUpdate {
+	_u.mutation.ClearReviewComment()
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *EventUpdate) SetSubmittedBy(v uuid.UUID) *EventUpdate {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableSubmittedBy(v *uuid.UUID) *EventUpdate {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (_u *EventUpdate) ClearSubmittedBy() *EventUpdate {
+	_u.mutation.ClearSubmittedBy()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *EventUpdate) SetSubmittedAt(v time.Time) *EventUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableSubmittedAt(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *EventUpdate) ClearSubmittedAt() *EventUpdate {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *EventUpdate) SetReviewedBy(v uuid.UUID) *EventUpdate {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableReviewedBy(v *uuid.UUID) *EventUpdate {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *EventUpdate) ClearReviewedBy() *EventUpdate {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *EventUpdate) SetReviewedAt(v time.Time) *EventUpdate {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableReviewedAt(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *EventUpdate) ClearReviewedAt() *EventUpdate {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_u *EventUpdate) SetPublishAt(v time.Time) *EventUpdate {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_u *EventUpdate) SetNillablePublishAt(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetPublishAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (_u *EventUpdate) ClearPublishAt() *EventUpdate {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetDateChangeRefundUntil sets the "date_change_refund_until" field.
+func (_u *EventUpdate) SetDateChangeRefundUntil(v time.Time) *EventUpdate {
+	_u.mutation.SetDateChangeRefundUntil(v)
+	return _u
+}
+
+// SetNillableDateChangeRefundUntil sets the "date_change_refund_until" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableDateChangeRefundUntil(v *time.Time) *EventUpdate {
+	if v != nil {
+		_u.SetDateChangeRefundUntil(*v)
+	}
+	return _u
+}
+
+// ClearDateChangeRefundUntil clears the value of the "date_change_refund_until" field.
+func (_u *EventUpdate) ClearDateChangeRefundUntil() *EventUpdate {
+	_u.mutation.ClearDateChangeRefundUntil()
+	return _u
+}
+
 // SetIsPublic sets the "is_public" field.
 func (_u *EventUpdate) SetIsPublic(v bool) *EventUpdate {
 	_u.mutation.SetIsPublic(v)
@@ -294,6 +664,20 @@ func (_u *EventUpdate) SetNillableIsPublic(v *bool) *EventUpdate {
 	return _u
 }
 
+// SetRequiresApproval sets the "requires_approval" field.
+func (_u *EventUpdate) SetRequiresApproval(v bool) *EventUpdate {
+	_u.mutation.SetRequiresApproval(v)
+	return _u
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_u *EventUpdate) SetNillableRequiresApproval(v *bool) *EventUpdate {
+	if v != nil {
+		_u.SetRequiresApproval(*v)
+	}
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *EventUpdate) SetCreatedBy(v uuid.UUID) *EventUpdate {
 	_u.mutation.SetCreatedBy(v)
@@ -330,6 +714,25 @@ func (_u *EventUpdate) SetCreator(v *User) *EventUpdate {
 	return _u.SetCreatorID(v.ID)
 }
 
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID.
+func (_u *EventUpdate) SetLinkedVenueID(id uuid.UUID) *EventUpdate {
+	_u.mutation.SetLinkedVenueID(id)
+	return _u
+}
+
+// SetNillableLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID if the given value is not nil.
+func (_u *EventUpdate) SetNillableLinkedVenueID(id *uuid.UUID) *EventUpdate {
+	if id != nil {
+		_u = _u.SetLinkedVenueID(*id)
+	}
+	return _u
+}
+
+// SetLinkedVenue sets the "linked_venue" edge to the Venue entity.
+func (_u *EventUpdate) SetLinkedVenue(v *Venue) *EventUpdate {
+	return _u.SetLinkedVenueID(v.ID)
+}
+
 // AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
 func (_u *EventUpdate) AddPaymentIDs(ids ...uuid.UUID) *EventUpdate {
 	_u.mutation.AddPaymentIDs(ids...)
@@ -345,6 +748,21 @@ func (_u *EventUpdate) AddPayments(v ...*Payment) *EventUpdate {
 	return _u.AddPaymentIDs(ids...)
 }
 
+// AddRegistrationQuestionIDs adds the "registration_questions" edge to the RegistrationQuestion entity by IDs.
+func (_u *EventUpdate) AddRegistrationQuestionIDs(ids ...uuid.UUID) *EventUpdate {
+	_u.mutation.AddRegistrationQuestionIDs(ids...)
+	return _u
+}
+
+// AddRegistrationQuestions adds the "registration_questions" edges to the RegistrationQuestion entity.
+func (_u *EventUpdate) AddRegistrationQuestions(v ...*RegistrationQuestion) *EventUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRegistrationQuestionIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (_u *EventUpdate) Mutation() *EventMutation {
 	return _u.mutation
@@ -362,6 +780,12 @@ func (_u *EventUpdate) ClearCreator() *EventUpdate {
 	return _u
 }
 
+// ClearLinkedVenue clears the "linked_venue" edge to the Venue entity.
+func (_u *EventUpdate) ClearLinkedVenue() *EventUpdate {
+	_u.mutation.ClearLinkedVenue()
+	return _u
+}
+
 // ClearPayments clears all "payments" edges to the Payment entity.
 func (_u *EventUpdate) ClearPayments() *EventUpdate {
 	_u.mutation.ClearPayments()
@@ -383,6 +807,27 @@ func (_u *EventUpdate) RemovePayments(v ...*Payment) *EventUpdate {
 	return _u.RemovePaymentIDs(ids...)
 }
 
+// ClearRegistrationQuestions clears all "registration_questions" edges to the RegistrationQuestion entity.
+func (_u *EventUpdate) ClearRegistrationQuestions() *EventUpdate {
+	_u.mutation.ClearRegistrationQuestions()
+	return _u
+}
+
+// RemoveRegistrationQuestionIDs removes the "registration_questions" edge to RegistrationQuestion entities by IDs.
+func (_u *EventUpdate) RemoveRegistrationQuestionIDs(ids ...uuid.UUID) *EventUpdate {
+	_u.mutation.RemoveRegistrationQuestionIDs(ids...)
+	return _u
+}
+
+// RemoveRegistrationQuestions removes "registration_questions" edges to RegistrationQuestion entities.
+func (_u *EventUpdate) RemoveRegistrationQuestions(v ...*RegistrationQuestion) *EventUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRegistrationQuestionIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *EventUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -426,6 +871,36 @@ func (_u *EventUpdate) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AddressCity(); ok {
+		if err := event.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "Event.address_city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressRegion(); ok {
+		if err := event.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "Event.address_region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressPostalCode(); ok {
+		if err := event.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "Event.address_postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCountry(); ok {
+		if err := event.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "Event.address_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Geohash(); ok {
+		if err := event.GeohashValidator(v); err != nil {
+			return &ValidationError{Name: "geohash", err: fmt.Errorf(`ent: validator failed for field "Event.geohash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := event.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Event.category": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TotalTickets(); ok {
 		if err := event.TotalTicketsValidator(v); err != nil {
 			return &ValidationError{Name: "total_tickets", err: fmt.Errorf(`ent: validator failed for field "Event.total_tickets": %w`, err)}
@@ -451,6 +926,11 @@ func (_u *EventUpdate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Event.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReviewStatus(); ok {
+		if err := event.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "Event.review_status": %w`, err)}
+		}
+	}
 	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Event.organization"`)
 	}
@@ -493,6 +973,54 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if _u.mutation.VenueCleared() {
 		_spec.ClearField(event.FieldVenue, field.TypeString)
 	}
+	if value, ok := _u.mutation.SeatingLayout(); ok {
+		_spec.SetField(event.FieldSeatingLayout, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressStreet(); ok {
+		_spec.SetField(event.FieldAddressStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCity(); ok {
+		_spec.SetField(event.FieldAddressCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressRegion(); ok {
+		_spec.SetField(event.FieldAddressRegion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressPostalCode(); ok {
+		_spec.SetField(event.FieldAddressPostalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCountry(); ok {
+		_spec.SetField(event.FieldAddressCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(event.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(event.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(event.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(event.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(event.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(event.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Geohash(); ok {
+		_spec.SetField(event.FieldGeohash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(event.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TitleInitials(); ok {
+		_spec.SetField(event.FieldTitleInitials, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VenueInitials(); ok {
+		_spec.SetField(event.FieldVenueInitials, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.StartTime(); ok {
 		_spec.SetField(event.FieldStartTime, field.TypeTime, value)
 	}
@@ -535,9 +1063,57 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(event.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.ReviewStatus(); ok {
+		_spec.SetField(event.FieldReviewStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewComment(); ok {
+		_spec.SetField(event.FieldReviewComment, field.TypeString, value)
+	}
+	if _u.mutation.ReviewCommentCleared() {
+		_spec.ClearField(event.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(event.FieldSubmittedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.SubmittedByCleared() {
+		_spec.ClearField(event.FieldSubmittedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(event.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(event.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(event.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(event.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(event.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(event.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(event.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(event.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DateChangeRefundUntil(); ok {
+		_spec.SetField(event.FieldDateChangeRefundUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DateChangeRefundUntilCleared() {
+		_spec.ClearField(event.FieldDateChangeRefundUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(event.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.RequiresApproval(); ok {
+		_spec.SetField(event.FieldRequiresApproval, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(event.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -599,6 +1175,35 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LinkedVenueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.LinkedVenueTable,
+			Columns: []string{event.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedVenueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.LinkedVenueTable,
+			Columns: []string{event.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.PaymentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -644,6 +1249,51 @@ func (_u *EventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RegistrationQuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RegistrationQuestionsTable,
+			Columns: []string{event.RegistrationQuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRegistrationQuestionsIDs(); len(nodes) > 0 && !_u.mutation.RegistrationQuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RegistrationQuestionsTable,
+			Columns: []string{event.RegistrationQuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegistrationQuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RegistrationQuestionsTable,
+			Columns: []string{event.RegistrationQuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{event.Label}
@@ -706,49 +1356,263 @@ func (_u *EventUpdateOne) SetNillableDescription(v *string) *EventUpdateOne {
 	return _u
 }
 
-// ClearDescription clears the value of the "description" field.
-func (_u *EventUpdateOne) ClearDescription() *EventUpdateOne {
-	_u.mutation.ClearDescription()
+// ClearDescription clears the value of the "description" field.
+func (_u *EventUpdateOne) ClearDescription() *EventUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventUpdateOne) SetLocation(v string) *EventUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableLocation(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *EventUpdateOne) ClearLocation() *EventUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetVenue sets the "venue" field.
+func (_u *EventUpdateOne) SetVenue(v string) *EventUpdateOne {
+	_u.mutation.SetVenue(v)
+	return _u
+}
+
+// SetNillableVenue sets the "venue" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableVenue(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetVenue(*v)
+	}
+	return _u
+}
+
+// ClearVenue clears the value of the "venue" field.
+func (_u *EventUpdateOne) ClearVenue() *EventUpdateOne {
+	_u.mutation.ClearVenue()
+	return _u
+}
+
+// SetVenueID sets the "venue_id" field.
+func (_u *EventUpdateOne) SetVenueID(v uuid.UUID) *EventUpdateOne {
+	_u.mutation.SetVenueID(v)
+	return _u
+}
+
+// SetNillableVenueID sets the "venue_id" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableVenueID(v *uuid.UUID) *EventUpdateOne {
+	if v != nil {
+		_u.SetVenueID(*v)
+	}
+	return _u
+}
+
+// ClearVenueID clears the value of the "venue_id" field.
+func (_u *EventUpdateOne) ClearVenueID() *EventUpdateOne {
+	_u.mutation.ClearVenueID()
+	return _u
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (_u *EventUpdateOne) SetSeatingLayout(v string) *EventUpdateOne {
+	_u.mutation.SetSeatingLayout(v)
+	return _u
+}
+
+// SetNillableSeatingLayout sets the "seating_layout" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableSeatingLayout(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetSeatingLayout(*v)
+	}
+	return _u
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_u *EventUpdateOne) SetAddressStreet(v string) *EventUpdateOne {
+	_u.mutation.SetAddressStreet(v)
+	return _u
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableAddressStreet(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetAddressStreet(*v)
+	}
+	return _u
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_u *EventUpdateOne) SetAddressCity(v string) *EventUpdateOne {
+	_u.mutation.SetAddressCity(v)
+	return _u
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableAddressCity(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetAddressCity(*v)
+	}
+	return _u
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_u *EventUpdateOne) SetAddressRegion(v string) *EventUpdateOne {
+	_u.mutation.SetAddressRegion(v)
+	return _u
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableAddressRegion(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetAddressRegion(*v)
+	}
+	return _u
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_u *EventUpdateOne) SetAddressPostalCode(v string) *EventUpdateOne {
+	_u.mutation.SetAddressPostalCode(v)
+	return _u
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableAddressPostalCode(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetAddressPostalCode(*v)
+	}
+	return _u
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_u *EventUpdateOne) SetAddressCountry(v string) *EventUpdateOne {
+	_u.mutation.SetAddressCountry(v)
+	return _u
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableAddressCountry(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetAddressCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *EventUpdateOne) SetLatitude(v float64) *EventUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableLatitude(v *float64) *EventUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *EventUpdateOne) AddLatitude(v float64) *EventUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *EventUpdateOne) ClearLatitude() *EventUpdateOne {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *EventUpdateOne) SetLongitude(v float64) *EventUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableLongitude(v *float64) *EventUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *EventUpdateOne) AddLongitude(v float64) *EventUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *EventUpdateOne) ClearLongitude() *EventUpdateOne {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetGeohash sets the "geohash" field.
+func (_u *EventUpdateOne) SetGeohash(v string) *EventUpdateOne {
+	_u.mutation.SetGeohash(v)
+	return _u
+}
+
+// SetNillableGeohash sets the "geohash" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableGeohash(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetGeohash(*v)
+	}
 	return _u
 }
 
-// SetLocation sets the "location" field.
-func (_u *EventUpdateOne) SetLocation(v string) *EventUpdateOne {
-	_u.mutation.SetLocation(v)
+// SetCategory sets the "category" field.
+func (_u *EventUpdateOne) SetCategory(v string) *EventUpdateOne {
+	_u.mutation.SetCategory(v)
 	return _u
 }
 
-// SetNillableLocation sets the "location" field if the given value is not nil.
-func (_u *EventUpdateOne) SetNillableLocation(v *string) *EventUpdateOne {
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableCategory(v *string) *EventUpdateOne {
 	if v != nil {
-		_u.SetLocation(*v)
+		_u.SetCategory(*v)
 	}
 	return _u
 }
 
-// ClearLocation clears the value of the "location" field.
-func (_u *EventUpdateOne) ClearLocation() *EventUpdateOne {
-	_u.mutation.ClearLocation()
+// SetTitleInitials sets the "title_initials" field.
+func (_u *EventUpdateOne) SetTitleInitials(v string) *EventUpdateOne {
+	_u.mutation.SetTitleInitials(v)
 	return _u
 }
 
-// SetVenue sets the "venue" field.
-func (_u *EventUpdateOne) SetVenue(v string) *EventUpdateOne {
-	_u.mutation.SetVenue(v)
+// SetNillableTitleInitials sets the "title_initials" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableTitleInitials(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetTitleInitials(*v)
+	}
 	return _u
 }
 
-// SetNillableVenue sets the "venue" field if the given value is not nil.
-func (_u *EventUpdateOne) SetNillableVenue(v *string) *EventUpdateOne {
-	if v != nil {
-		_u.SetVenue(*v)
-	}
+// SetVenueInitials sets the "venue_initials" field.
+func (_u *EventUpdateOne) SetVenueInitials(v string) *EventUpdateOne {
+	_u.mutation.SetVenueInitials(v)
 	return _u
 }
 
-// ClearVenue clears the value of the "venue" field.
-func (_u *EventUpdateOne) ClearVenue() *EventUpdateOne {
-	_u.mutation.ClearVenue()
+// SetNillableVenueInitials sets the "venue_initials" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableVenueInitials(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetVenueInitials(*v)
+	}
 	return _u
 }
 
@@ -912,6 +1776,160 @@ func (_u *EventUpdateOne) SetNillableStatus(v *event.Status) *EventUpdateOne {
 	return _u
 }
 
+// SetReviewStatus sets the "review_status" field.
+func (_u *EventUpdateOne) SetReviewStatus(v event.ReviewStatus) *EventUpdateOne {
+	_u.mutation.SetReviewStatus(v)
+	return _u
+}
+
+// SetNillableReviewStatus sets the "review_status" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableReviewStatus(v *event.ReviewStatus) *EventUpdateOne {
+	if v != nil {
+		_u.SetReviewStatus(*v)
+	}
+	return _u
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (_u *EventUpdateOne) SetReviewComment(v string) *EventUpdateOne {
+	_u.mutation.SetReviewComment(v)
+	return _u
+}
+
+// SetNillableReviewComment sets the "review_comment" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableReviewComment(v *string) *EventUpdateOne {
+	if v != nil {
+		_u.SetReviewComment(*v)
+	}
+	return _u
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (_u *EventUpdateOne) ClearReviewComment() *EventUpdateOne {
+	_u.mutation.ClearReviewComment()
+	return _u
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (_u *EventUpdateOne) SetSubmittedBy(v uuid.UUID) *EventUpdateOne {
+	_u.mutation.SetSubmittedBy(v)
+	return _u
+}
+
+// SetNillableSubmittedBy sets the "submitted_by" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableSubmittedBy(v *uuid.UUID) *EventUpdateOne {
+	if v != nil {
+		_u.SetSubmittedBy(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (_u *EventUpdateOne) ClearSubmittedBy() *EventUpdateOne {
+	_u.mutation.ClearSubmittedBy()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *EventUpdateOne) SetSubmittedAt(v time.Time) *EventUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableSubmittedAt(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *EventUpdateOne) ClearSubmittedAt() *EventUpdateOne {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (_u *EventUpdateOne) SetReviewedBy(v uuid.UUID) *EventUpdateOne {
+	_u.mutation.SetReviewedBy(v)
+	return _u
+}
+
+// SetNillableReviewedBy sets the "reviewed_by" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableReviewedBy(v *uuid.UUID) *EventUpdateOne {
+	if v != nil {
+		_u.SetReviewedBy(*v)
+	}
+	return _u
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (_u *EventUpdateOne) ClearReviewedBy() *EventUpdateOne {
+	_u.mutation.ClearReviewedBy()
+	return _u
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (_u *EventUpdateOne) SetReviewedAt(v time.Time) *EventUpdateOne {
+	_u.mutation.SetReviewedAt(v)
+	return _u
+}
+
+// SetNillableReviewedAt sets the "reviewed_at" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableReviewedAt(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetReviewedAt(*v)
+	}
+	return _u
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (_u *EventUpdateOne) ClearReviewedAt() *EventUpdateOne {
+	_u.mutation.ClearReviewedAt()
+	return _u
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (_u *EventUpdateOne) SetPublishAt(v time.Time) *EventUpdateOne {
+	_u.mutation.SetPublishAt(v)
+	return _u
+}
+
+// SetNillablePublishAt sets the "publish_at" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillablePublishAt(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetPublishAt(*v)
+	}
+	return _u
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (_u *EventUpdateOne) ClearPublishAt() *EventUpdateOne {
+	_u.mutation.ClearPublishAt()
+	return _u
+}
+
+// SetDateChangeRefundUntil sets the "date_change_refund_until" field.
+func (_u *EventUpdateOne) SetDateChangeRefundUntil(v time.Time) *EventUpdateOne {
+	_u.mutation.SetDateChangeRefundUntil(v)
+	return _u
+}
+
+// SetNillableDateChangeRefundUntil sets the "date_change_refund_until" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableDateChangeRefundUntil(v *time.Time) *EventUpdateOne {
+	if v != nil {
+		_u.SetDateChangeRefundUntil(*v)
+	}
+	return _u
+}
+
+// ClearDateChangeRefundUntil clears the value of the "date_change_refund_until" field.
+func (_u *EventUpdateOne) ClearDateChangeRefundUntil() *EventUpdateOne {
+	_u.mutation.ClearDateChangeRefundUntil()
+	return _u
+}
+
 // SetIsPublic sets the "is_public" field.
 func (_u *EventUpdateOne) SetIsPublic(v bool) *EventUpdateOne {
 	_u.mutation.SetIsPublic(v)
@@ -926,6 +1944,20 @@ func (_u *EventUpdateOne) SetNillableIsPublic(v *bool) *EventUpdateOne {
 	return _u
 }
 
+// SetRequiresApproval sets the "requires_approval" field.
+func (_u *EventUpdateOne) SetRequiresApproval(v bool) *EventUpdateOne {
+	_u.mutation.SetRequiresApproval(v)
+	return _u
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableRequiresApproval(v *bool) *EventUpdateOne {
+	if v != nil {
+		_u.SetRequiresApproval(*v)
+	}
+	return _u
+}
+
 // SetCreatedBy sets the "created_by" field.
 func (_u *EventUpdateOne) SetCreatedBy(v uuid.UUID) *EventUpdateOne {
 	_u.mutation.SetCreatedBy(v)
@@ -962,6 +1994,25 @@ func (_u *EventUpdateOne) SetCreator(v *User) *EventUpdateOne {
 	return _u.SetCreatorID(v.ID)
 }
 
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID.
+func (_u *EventUpdateOne) SetLinkedVenueID(id uuid.UUID) *EventUpdateOne {
+	_u.mutation.SetLinkedVenueID(id)
+	return _u
+}
+
+// SetNillableLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID if the given value is not nil.
+func (_u *EventUpdateOne) SetNillableLinkedVenueID(id *uuid.UUID) *EventUpdateOne {
+	if id != nil {
+		_u = _u.SetLinkedVenueID(*id)
+	}
+	return _u
+}
+
+// SetLinkedVenue sets the "linked_venue" edge to the Venue entity.
+func (_u *EventUpdateOne) SetLinkedVenue(v *Venue) *EventUpdateOne {
+	return _u.SetLinkedVenueID(v.ID)
+}
+
 // AddPaymentIDs adds the "payments" edge to the Payment entity by IDs.
 func (_u *EventUpdateOne) AddPaymentIDs(ids ...uuid.UUID) *EventUpdateOne {
 	_u.mutation.AddPaymentIDs(ids...)
@@ -977,6 +2028,21 @@ func (_u *EventUpdateOne) AddPayments(v ...*Payment) *EventUpdateOne {
 	return _u.AddPaymentIDs(ids...)
 }
 
+// AddRegistrationQuestionIDs adds the "registration_questions" edge to the RegistrationQuestion entity by IDs.
+func (_u *EventUpdateOne) AddRegistrationQuestionIDs(ids ...uuid.UUID) *EventUpdateOne {
+	_u.mutation.AddRegistrationQuestionIDs(ids...)
+	return _u
+}
+
+// AddRegistrationQuestions adds the "registration_questions" edges to the RegistrationQuestion entity.
+func (_u *EventUpdateOne) AddRegistrationQuestions(v ...*RegistrationQuestion) *EventUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRegistrationQuestionIDs(ids...)
+}
+
 // Mutation returns the EventMutation object of the builder.
 func (_u *EventUpdateOne) Mutation() *EventMutation {
 	return _u.mutation
@@ -994,6 +2060,12 @@ func (_u *EventUpdateOne) ClearCreator() *EventUpdateOne {
 	return _u
 }
 
+// ClearLinkedVenue clears the "linked_venue" edge to the Venue entity.
+func (_u *EventUpdateOne) ClearLinkedVenue() *EventUpdateOne {
+	_u.mutation.ClearLinkedVenue()
+	return _u
+}
+
 // ClearPayments clears all "payments" edges to the Payment entity.
 func (_u *EventUpdateOne) ClearPayments() *EventUpdateOne {
 	_u.mutation.ClearPayments()
@@ -1015,6 +2087,27 @@ func (_u *EventUpdateOne) RemovePayments(v ...*Payment) *EventUpdateOne {
 	return _u.RemovePaymentIDs(ids...)
 }
 
+// ClearRegistrationQuestions clears all "registration_questions" edges to the RegistrationQuestion entity.
+func (_u *EventUpdateOne) ClearRegistrationQuestions() *EventUpdateOne {
+	_u.mutation.ClearRegistrationQuestions()
+	return _u
+}
+
+// RemoveRegistrationQuestionIDs removes the "registration_questions" edge to RegistrationQuestion entities by IDs.
+func (_u *EventUpdateOne) RemoveRegistrationQuestionIDs(ids ...uuid.UUID) *EventUpdateOne {
+	_u.mutation.RemoveRegistrationQuestionIDs(ids...)
+	return _u
+}
+
+// RemoveRegistrationQuestions removes "registration_questions" edges to RegistrationQuestion entities.
+func (_u *EventUpdateOne) RemoveRegistrationQuestions(v ...*RegistrationQuestion) *EventUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRegistrationQuestionIDs(ids...)
+}
+
 // Where appends a list predicates to the EventUpdate builder.
 func (_u *EventUpdateOne) Where(ps ...predicate.Event) *EventUpdateOne {
 	_u.mutation.Where(ps...)
@@ -1071,6 +2164,36 @@ func (_u *EventUpdateOne) check() error {
 			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "Event.title": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.AddressCity(); ok {
+		if err := event.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "Event.address_city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressRegion(); ok {
+		if err := event.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "Event.address_region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressPostalCode(); ok {
+		if err := event.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "Event.address_postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCountry(); ok {
+		if err := event.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "Event.address_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Geohash(); ok {
+		if err := event.GeohashValidator(v); err != nil {
+			return &ValidationError{Name: "geohash", err: fmt.Errorf(`ent: validator failed for field "Event.geohash": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := event.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "Event.category": %w`, err)}
+		}
+	}
 	if v, ok := _u.mutation.TotalTickets(); ok {
 		if err := event.TotalTicketsValidator(v); err != nil {
 			return &ValidationError{Name: "total_tickets", err: fmt.Errorf(`ent: validator failed for field "Event.total_tickets": %w`, err)}
@@ -1096,6 +2219,11 @@ func (_u *EventUpdateOne) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Event.status": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.ReviewStatus(); ok {
+		if err := event.ReviewStatusValidator(v); err != nil {
+			return &ValidationError{Name: "review_status", err: fmt.Errorf(`ent: validator failed for field "Event.review_status": %w`, err)}
+		}
+	}
 	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Event.organization"`)
 	}
@@ -1155,6 +2283,54 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 	if _u.mutation.VenueCleared() {
 		_spec.ClearField(event.FieldVenue, field.TypeString)
 	}
+	if value, ok := _u.mutation.SeatingLayout(); ok {
+		_spec.SetField(event.FieldSeatingLayout, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressStreet(); ok {
+		_spec.SetField(event.FieldAddressStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCity(); ok {
+		_spec.SetField(event.FieldAddressCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressRegion(); ok {
+		_spec.SetField(event.FieldAddressRegion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressPostalCode(); ok {
+		_spec.SetField(event.FieldAddressPostalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCountry(); ok {
+		_spec.SetField(event.FieldAddressCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(event.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(event.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(event.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(event.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(event.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(event.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Geohash(); ok {
+		_spec.SetField(event.FieldGeohash, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(event.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TitleInitials(); ok {
+		_spec.SetField(event.FieldTitleInitials, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.VenueInitials(); ok {
+		_spec.SetField(event.FieldVenueInitials, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.StartTime(); ok {
 		_spec.SetField(event.FieldStartTime, field.TypeTime, value)
 	}
@@ -1197,9 +2373,57 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(event.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.ReviewStatus(); ok {
+		_spec.SetField(event.FieldReviewStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ReviewComment(); ok {
+		_spec.SetField(event.FieldReviewComment, field.TypeString, value)
+	}
+	if _u.mutation.ReviewCommentCleared() {
+		_spec.ClearField(event.FieldReviewComment, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubmittedBy(); ok {
+		_spec.SetField(event.FieldSubmittedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.SubmittedByCleared() {
+		_spec.ClearField(event.FieldSubmittedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(event.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(event.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReviewedBy(); ok {
+		_spec.SetField(event.FieldReviewedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReviewedByCleared() {
+		_spec.ClearField(event.FieldReviewedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReviewedAt(); ok {
+		_spec.SetField(event.FieldReviewedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReviewedAtCleared() {
+		_spec.ClearField(event.FieldReviewedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.PublishAt(); ok {
+		_spec.SetField(event.FieldPublishAt, field.TypeTime, value)
+	}
+	if _u.mutation.PublishAtCleared() {
+		_spec.ClearField(event.FieldPublishAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.DateChangeRefundUntil(); ok {
+		_spec.SetField(event.FieldDateChangeRefundUntil, field.TypeTime, value)
+	}
+	if _u.mutation.DateChangeRefundUntilCleared() {
+		_spec.ClearField(event.FieldDateChangeRefundUntil, field.TypeTime)
+	}
 	if value, ok := _u.mutation.IsPublic(); ok {
 		_spec.SetField(event.FieldIsPublic, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.RequiresApproval(); ok {
+		_spec.SetField(event.FieldRequiresApproval, field.TypeBool, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(event.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -1261,6 +2485,35 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.LinkedVenueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.LinkedVenueTable,
+			Columns: []string{event.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedVenueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   event.LinkedVenueTable,
+			Columns: []string{event.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.PaymentsCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -1306,6 +2559,51 @@ func (_u *EventUpdateOne) sqlSave(ctx context.Context) (_node *Event, err error)
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RegistrationQuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RegistrationQuestionsTable,
+			Columns: []string{event.RegistrationQuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRegistrationQuestionsIDs(); len(nodes) > 0 && !_u.mutation.RegistrationQuestionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RegistrationQuestionsTable,
+			Columns: []string{event.RegistrationQuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegistrationQuestionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   event.RegistrationQuestionsTable,
+			Columns: []string{event.RegistrationQuestionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Event{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
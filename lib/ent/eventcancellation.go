@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/google/uuid"
+)
+
+// EventCancellation is the model entity for the EventCancellation schema.
+type EventCancellation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Cancelled event; an event is cancelled at most once
+	EventID uuid.UUID `json:"event_id,omitempty"`
+	// Organization the event belongs to
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Member who cancelled the event
+	RequestedBy uuid.UUID `json:"requested_by,omitempty"`
+	// Reason shown to attendees and sent to the payment gateway
+	Reason string `json:"reason,omitempty"`
+	// running while refunds are still being attempted
+	Status eventcancellation.Status `json:"status,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EventCancellationQuery when eager-loading is set.
+	Edges        EventCancellationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EventCancellationEdges holds the relations/edges for other nodes in the graph.
+type EventCancellationEdges struct {
+	// Refunds holds the value of the refunds edge.
+	Refunds []*CancellationRefund `json:"refunds,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// RefundsOrErr returns the Refunds value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventCancellationEdges) RefundsOrErr() ([]*CancellationRefund, error) {
+	if e.loadedTypes[0] {
+		return e.Refunds, nil
+	}
+	return nil, &NotLoadedError{edge: "refunds"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventCancellation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventcancellation.FieldReason, eventcancellation.FieldStatus:
+			values[i] = new(sql.NullString)
+		case eventcancellation.FieldCompletedAt, eventcancellation.FieldCreatedAt, eventcancellation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case eventcancellation.FieldID, eventcancellation.FieldEventID, eventcancellation.FieldOrganizationID, eventcancellation.FieldRequestedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventCancellation fields.
+func (_m *EventCancellation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventcancellation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case eventcancellation.FieldEventID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value != nil {
+				_m.EventID = *value
+			}
+		case eventcancellation.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case eventcancellation.FieldRequestedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field requested_by", values[i])
+			} else if value != nil {
+				_m.RequestedBy = *value
+			}
+		case eventcancellation.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case eventcancellation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = eventcancellation.Status(value.String)
+			}
+		case eventcancellation.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case eventcancellation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case eventcancellation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventCancellation.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventCancellation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryRefunds queries the "refunds" edge of the EventCancellation entity.
+func (_m *EventCancellation) QueryRefunds() *CancellationRefundQuery {
+	return NewEventCancellationClient(_m.config).QueryRefunds(_m)
+}
+
+// Update returns a builder for updating this EventCancellation.
+// Note that you need to call EventCancellation.Unwrap() before calling this method if this EventCancellation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventCancellation) Update() *EventCancellationUpdateOne {
+	return NewEventCancellationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventCancellation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventCancellation) Unwrap() *EventCancellation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventCancellation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventCancellation) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventCancellation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("requested_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequestedBy))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventCancellations is a parsable slice of EventCancellation.
+type EventCancellations []*EventCancellation
@@ -0,0 +1,388 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/google/uuid"
+)
+
+// EventCancellationCreate is the builder for creating a EventCancellation entity.
+type EventCancellationCreate struct {
+	config
+	mutation *EventCancellationMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *EventCancellationCreate) SetEventID(v uuid.UUID) *EventCancellationCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *EventCancellationCreate) SetOrganizationID(v uuid.UUID) *EventCancellationCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (_c *EventCancellationCreate) SetRequestedBy(v uuid.UUID) *EventCancellationCreate {
+	_c.mutation.SetRequestedBy(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *EventCancellationCreate) SetReason(v string) *EventCancellationCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *EventCancellationCreate) SetNillableReason(v *string) *EventCancellationCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *EventCancellationCreate) SetStatus(v eventcancellation.Status) *EventCancellationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *EventCancellationCreate) SetNillableStatus(v *eventcancellation.Status) *EventCancellationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *EventCancellationCreate) SetCompletedAt(v time.Time) *EventCancellationCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *EventCancellationCreate) SetNillableCompletedAt(v *time.Time) *EventCancellationCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventCancellationCreate) SetCreatedAt(v time.Time) *EventCancellationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventCancellationCreate) SetNillableCreatedAt(v *time.Time) *EventCancellationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EventCancellationCreate) SetUpdatedAt(v time.Time) *EventCancellationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EventCancellationCreate) SetNillableUpdatedAt(v *time.Time) *EventCancellationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EventCancellationCreate) SetID(v uuid.UUID) *EventCancellationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EventCancellationCreate) SetNillableID(v *uuid.UUID) *EventCancellationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddRefundIDs adds the "refunds" edge to the CancellationRefund entity by IDs.
+func (_c *EventCancellationCreate) AddRefundIDs(ids ...uuid.UUID) *EventCancellationCreate {
+	_c.mutation.AddRefundIDs(ids...)
+	return _c
+}
+
+// AddRefunds adds the "refunds" edges to the CancellationRefund entity.
+func (_c *EventCancellationCreate) AddRefunds(v ...*CancellationRefund) *EventCancellationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRefundIDs(ids...)
+}
+
+// Mutation returns the EventCancellationMutation object of the builder.
+func (_c *EventCancellationCreate) Mutation() *EventCancellationMutation {
+	return _c.mutation
+}
+
+// Save creates the EventCancellation in the database.
+func (_c *EventCancellationCreate) Save(ctx context.Context) (*EventCancellation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventCancellationCreate) SaveX(ctx context.Context) *EventCancellation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventCancellationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventCancellationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventCancellationCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := eventcancellation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventcancellation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := eventcancellation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := eventcancellation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventCancellationCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventCancellation.event_id"`)}
+	}
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "EventCancellation.organization_id"`)}
+	}
+	if _, ok := _c.mutation.RequestedBy(); !ok {
+		return &ValidationError{Name: "requested_by", err: errors.New(`ent: missing required field "EventCancellation.requested_by"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "EventCancellation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := eventcancellation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "EventCancellation.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventCancellation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EventCancellation.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventCancellationCreate) sqlSave(ctx context.Context) (*EventCancellation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventCancellationCreate) createSpec() (*EventCancellation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventCancellation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventcancellation.Table, sqlgraph.NewFieldSpec(eventcancellation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(eventcancellation.FieldEventID, field.TypeUUID, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.OrganizationID(); ok {
+		_spec.SetField(eventcancellation.FieldOrganizationID, field.TypeUUID, value)
+		_node.OrganizationID = value
+	}
+	if value, ok := _c.mutation.RequestedBy(); ok {
+		_spec.SetField(eventcancellation.FieldRequestedBy, field.TypeUUID, value)
+		_node.RequestedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(eventcancellation.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(eventcancellation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(eventcancellation.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventcancellation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventcancellation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.RefundsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventcancellation.RefundsTable,
+			Columns: []string{eventcancellation.RefundsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(cancellationrefund.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EventCancellationCreateBulk is the builder for creating many EventCancellation entities in bulk.
+type EventCancellationCreateBulk struct {
+	config
+	err      error
+	builders []*EventCancellationCreate
+}
+
+// Save creates the EventCancellation entities in the database.
+func (_c *EventCancellationCreateBulk) Save(ctx context.Context) ([]*EventCancellation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventCancellation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventCancellationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventCancellationCreateBulk) SaveX(ctx context.Context) []*EventCancellation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventCancellationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventCancellationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
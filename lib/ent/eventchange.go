@@ -0,0 +1,183 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventchange"
+	"github.com/google/uuid"
+)
+
+// EventChange is the model entity for the EventChange schema.
+type EventChange struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Event that changed
+	EventID uuid.UUID `json:"event_id,omitempty"`
+	// Member who changed the event
+	ChangedBy uuid.UUID `json:"changed_by,omitempty"`
+	// Previous values of the changed fields
+	Before map[string]string `json:"before,omitempty"`
+	// New values of the changed fields
+	After map[string]string `json:"after,omitempty"`
+	// Number of ticket holders notified
+	NotifiedCount int `json:"notified_count,omitempty"`
+	// End of the refund window opened by a date change
+	RefundUntil *time.Time `json:"refund_until,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventChange) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventchange.FieldBefore, eventchange.FieldAfter:
+			values[i] = new([]byte)
+		case eventchange.FieldNotifiedCount:
+			values[i] = new(sql.NullInt64)
+		case eventchange.FieldRefundUntil, eventchange.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case eventchange.FieldID, eventchange.FieldEventID, eventchange.FieldChangedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventChange fields.
+func (_m *EventChange) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventchange.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case eventchange.FieldEventID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value != nil {
+				_m.EventID = *value
+			}
+		case eventchange.FieldChangedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field changed_by", values[i])
+			} else if value != nil {
+				_m.ChangedBy = *value
+			}
+		case eventchange.FieldBefore:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field before", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Before); err != nil {
+					return fmt.Errorf("unmarshal field before: %w", err)
+				}
+			}
+		case eventchange.FieldAfter:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field after", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.After); err != nil {
+					return fmt.Errorf("unmarshal field after: %w", err)
+				}
+			}
+		case eventchange.FieldNotifiedCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field notified_count", values[i])
+			} else if value.Valid {
+				_m.NotifiedCount = int(value.Int64)
+			}
+		case eventchange.FieldRefundUntil:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field refund_until", values[i])
+			} else if value.Valid {
+				_m.RefundUntil = new(time.Time)
+				*_m.RefundUntil = value.Time
+			}
+		case eventchange.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventChange.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventChange) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this EventChange.
+// Note that you need to call EventChange.Unwrap() before calling this method if this EventChange
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventChange) Update() *EventChangeUpdateOne {
+	return NewEventChangeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventChange entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventChange) Unwrap() *EventChange {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventChange is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventChange) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventChange(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("changed_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ChangedBy))
+	builder.WriteString(", ")
+	builder.WriteString("before=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Before))
+	builder.WriteString(", ")
+	builder.WriteString("after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.After))
+	builder.WriteString(", ")
+	builder.WriteString("notified_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.NotifiedCount))
+	builder.WriteString(", ")
+	if v := _m.RefundUntil; v != nil {
+		builder.WriteString("refund_until=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventChanges is a parsable slice of EventChange.
+type EventChanges []*EventChange
@@ -0,0 +1,99 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the eventchange type in the database.
+	Label = "event_change"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldEventID holds the string denoting the event_id field in the database.
+	FieldEventID = "event_id"
+	// FieldChangedBy holds the string denoting the changed_by field in the database.
+	FieldChangedBy = "changed_by"
+	// FieldBefore holds the string denoting the before field in the database.
+	FieldBefore = "before"
+	// FieldAfter holds the string denoting the after field in the database.
+	FieldAfter = "after"
+	// FieldNotifiedCount holds the string denoting the notified_count field in the database.
+	FieldNotifiedCount = "notified_count"
+	// FieldRefundUntil holds the string denoting the refund_until field in the database.
+	FieldRefundUntil = "refund_until"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the eventchange in the database.
+	Table = "event_changes"
+)
+
+// Columns holds all SQL columns for eventchange fields.
+var Columns = []string{
+	FieldID,
+	FieldEventID,
+	FieldChangedBy,
+	FieldBefore,
+	FieldAfter,
+	FieldNotifiedCount,
+	FieldRefundUntil,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultNotifiedCount holds the default value on creation for the "notified_count" field.
+	DefaultNotifiedCount int
+	// NotifiedCountValidator is a validator for the "notified_count" field. It is called by the builders before save.
+	NotifiedCountValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the EventChange queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByEventID orders the results by the event_id field.
+func ByEventID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEventID, opts...).ToFunc()
+}
+
+// ByChangedBy orders the results by the changed_by field.
+func ByChangedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChangedBy, opts...).ToFunc()
+}
+
+// ByNotifiedCount orders the results by the notified_count field.
+func ByNotifiedCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNotifiedCount, opts...).ToFunc()
+}
+
+// ByRefundUntil orders the results by the refund_until field.
+func ByRefundUntil(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRefundUntil, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,306 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventchange
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLTE(FieldID, id))
+}
+
+// EventID applies equality check predicate on the "event_id" field. It's identical to EventIDEQ.
+func EventID(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldEventID, v))
+}
+
+// ChangedBy applies equality check predicate on the "changed_by" field. It's identical to ChangedByEQ.
+func ChangedBy(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// NotifiedCount applies equality check predicate on the "notified_count" field. It's identical to NotifiedCountEQ.
+func NotifiedCount(v int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldNotifiedCount, v))
+}
+
+// RefundUntil applies equality check predicate on the "refund_until" field. It's identical to RefundUntilEQ.
+func RefundUntil(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldRefundUntil, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// EventIDEQ applies the EQ predicate on the "event_id" field.
+func EventIDEQ(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldEventID, v))
+}
+
+// EventIDNEQ applies the NEQ predicate on the "event_id" field.
+func EventIDNEQ(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNEQ(FieldEventID, v))
+}
+
+// EventIDIn applies the In predicate on the "event_id" field.
+func EventIDIn(vs ...uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldIn(FieldEventID, vs...))
+}
+
+// EventIDNotIn applies the NotIn predicate on the "event_id" field.
+func EventIDNotIn(vs ...uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNotIn(FieldEventID, vs...))
+}
+
+// EventIDGT applies the GT predicate on the "event_id" field.
+func EventIDGT(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGT(FieldEventID, v))
+}
+
+// EventIDGTE applies the GTE predicate on the "event_id" field.
+func EventIDGTE(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGTE(FieldEventID, v))
+}
+
+// EventIDLT applies the LT predicate on the "event_id" field.
+func EventIDLT(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLT(FieldEventID, v))
+}
+
+// EventIDLTE applies the LTE predicate on the "event_id" field.
+func EventIDLTE(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLTE(FieldEventID, v))
+}
+
+// ChangedByEQ applies the EQ predicate on the "changed_by" field.
+func ChangedByEQ(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldChangedBy, v))
+}
+
+// ChangedByNEQ applies the NEQ predicate on the "changed_by" field.
+func ChangedByNEQ(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNEQ(FieldChangedBy, v))
+}
+
+// ChangedByIn applies the In predicate on the "changed_by" field.
+func ChangedByIn(vs ...uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldIn(FieldChangedBy, vs...))
+}
+
+// ChangedByNotIn applies the NotIn predicate on the "changed_by" field.
+func ChangedByNotIn(vs ...uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNotIn(FieldChangedBy, vs...))
+}
+
+// ChangedByGT applies the GT predicate on the "changed_by" field.
+func ChangedByGT(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGT(FieldChangedBy, v))
+}
+
+// ChangedByGTE applies the GTE predicate on the "changed_by" field.
+func ChangedByGTE(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGTE(FieldChangedBy, v))
+}
+
+// ChangedByLT applies the LT predicate on the "changed_by" field.
+func ChangedByLT(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLT(FieldChangedBy, v))
+}
+
+// ChangedByLTE applies the LTE predicate on the "changed_by" field.
+func ChangedByLTE(v uuid.UUID) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLTE(FieldChangedBy, v))
+}
+
+// NotifiedCountEQ applies the EQ predicate on the "notified_count" field.
+func NotifiedCountEQ(v int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldNotifiedCount, v))
+}
+
+// NotifiedCountNEQ applies the NEQ predicate on the "notified_count" field.
+func NotifiedCountNEQ(v int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNEQ(FieldNotifiedCount, v))
+}
+
+// NotifiedCountIn applies the In predicate on the "notified_count" field.
+func NotifiedCountIn(vs ...int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldIn(FieldNotifiedCount, vs...))
+}
+
+// NotifiedCountNotIn applies the NotIn predicate on the "notified_count" field.
+func NotifiedCountNotIn(vs ...int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNotIn(FieldNotifiedCount, vs...))
+}
+
+// NotifiedCountGT applies the GT predicate on the "notified_count" field.
+func NotifiedCountGT(v int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGT(FieldNotifiedCount, v))
+}
+
+// NotifiedCountGTE applies the GTE predicate on the "notified_count" field.
+func NotifiedCountGTE(v int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGTE(FieldNotifiedCount, v))
+}
+
+// NotifiedCountLT applies the LT predicate on the "notified_count" field.
+func NotifiedCountLT(v int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLT(FieldNotifiedCount, v))
+}
+
+// NotifiedCountLTE applies the LTE predicate on the "notified_count" field.
+func NotifiedCountLTE(v int) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLTE(FieldNotifiedCount, v))
+}
+
+// RefundUntilEQ applies the EQ predicate on the "refund_until" field.
+func RefundUntilEQ(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldRefundUntil, v))
+}
+
+// RefundUntilNEQ applies the NEQ predicate on the "refund_until" field.
+func RefundUntilNEQ(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNEQ(FieldRefundUntil, v))
+}
+
+// RefundUntilIn applies the In predicate on the "refund_until" field.
+func RefundUntilIn(vs ...time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldIn(FieldRefundUntil, vs...))
+}
+
+// RefundUntilNotIn applies the NotIn predicate on the "refund_until" field.
+func RefundUntilNotIn(vs ...time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNotIn(FieldRefundUntil, vs...))
+}
+
+// RefundUntilGT applies the GT predicate on the "refund_until" field.
+func RefundUntilGT(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGT(FieldRefundUntil, v))
+}
+
+// RefundUntilGTE applies the GTE predicate on the "refund_until" field.
+func RefundUntilGTE(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGTE(FieldRefundUntil, v))
+}
+
+// RefundUntilLT applies the LT predicate on the "refund_until" field.
+func RefundUntilLT(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLT(FieldRefundUntil, v))
+}
+
+// RefundUntilLTE applies the LTE predicate on the "refund_until" field.
+func RefundUntilLTE(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLTE(FieldRefundUntil, v))
+}
+
+// RefundUntilIsNil applies the IsNil predicate on the "refund_until" field.
+func RefundUntilIsNil() predicate.EventChange {
+	return predicate.EventChange(sql.FieldIsNull(FieldRefundUntil))
+}
+
+// RefundUntilNotNil applies the NotNil predicate on the "refund_until" field.
+func RefundUntilNotNil() predicate.EventChange {
+	return predicate.EventChange(sql.FieldNotNull(FieldRefundUntil))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EventChange {
+	return predicate.EventChange(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventChange) predicate.EventChange {
+	return predicate.EventChange(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventChange) predicate.EventChange {
+	return predicate.EventChange(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventChange) predicate.EventChange {
+	return predicate.EventChange(sql.NotPredicates(p))
+}
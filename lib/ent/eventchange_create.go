@@ -0,0 +1,326 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventchange"
+	"github.com/google/uuid"
+)
+
+// EventChangeCreate is the builder for creating a EventChange entity.
+type EventChangeCreate struct {
+	config
+	mutation *EventChangeMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *EventChangeCreate) SetEventID(v uuid.UUID) *EventChangeCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (_c *EventChangeCreate) SetChangedBy(v uuid.UUID) *EventChangeCreate {
+	_c.mutation.SetChangedBy(v)
+	return _c
+}
+
+// SetBefore sets the "before" field.
+func (_c *EventChangeCreate) SetBefore(v map[string]string) *EventChangeCreate {
+	_c.mutation.SetBefore(v)
+	return _c
+}
+
+// SetAfter sets the "after" field.
+func (_c *EventChangeCreate) SetAfter(v map[string]string) *EventChangeCreate {
+	_c.mutation.SetAfter(v)
+	return _c
+}
+
+// SetNotifiedCount sets the "notified_count" field.
+func (_c *EventChangeCreate) SetNotifiedCount(v int) *EventChangeCreate {
+	_c.mutation.SetNotifiedCount(v)
+	return _c
+}
+
+// SetNillableNotifiedCount sets the "notified_count" field if the given value is not nil.
+func (_c *EventChangeCreate) SetNillableNotifiedCount(v *int) *EventChangeCreate {
+	if v != nil {
+		_c.SetNotifiedCount(*v)
+	}
+	return _c
+}
+
+// SetRefundUntil sets the "refund_until" field.
+func (_c *EventChangeCreate) SetRefundUntil(v time.Time) *EventChangeCreate {
+	_c.mutation.SetRefundUntil(v)
+	return _c
+}
+
+// SetNillableRefundUntil sets the "refund_until" field if the given value is not nil.
+func (_c *EventChangeCreate) SetNillableRefundUntil(v *time.Time) *EventChangeCreate {
+	if v != nil {
+		_c.SetRefundUntil(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventChangeCreate) SetCreatedAt(v time.Time) *EventChangeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventChangeCreate) SetNillableCreatedAt(v *time.Time) *EventChangeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EventChangeCreate) SetID(v uuid.UUID) *EventChangeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EventChangeCreate) SetNillableID(v *uuid.UUID) *EventChangeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventChangeMutation object of the builder.
+func (_c *EventChangeCreate) Mutation() *EventChangeMutation {
+	return _c.mutation
+}
+
+// Save creates the EventChange in the database.
+func (_c *EventChangeCreate) Save(ctx context.Context) (*EventChange, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventChangeCreate) SaveX(ctx context.Context) *EventChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventChangeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventChangeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventChangeCreate) defaults() {
+	if _, ok := _c.mutation.NotifiedCount(); !ok {
+		v := eventchange.DefaultNotifiedCount
+		_c.mutation.SetNotifiedCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventchange.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := eventchange.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventChangeCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventChange.event_id"`)}
+	}
+	if _, ok := _c.mutation.ChangedBy(); !ok {
+		return &ValidationError{Name: "changed_by", err: errors.New(`ent: missing required field "EventChange.changed_by"`)}
+	}
+	if _, ok := _c.mutation.Before(); !ok {
+		return &ValidationError{Name: "before", err: errors.New(`ent: missing required field "EventChange.before"`)}
+	}
+	if _, ok := _c.mutation.After(); !ok {
+		return &ValidationError{Name: "after", err: errors.New(`ent: missing required field "EventChange.after"`)}
+	}
+	if _, ok := _c.mutation.NotifiedCount(); !ok {
+		return &ValidationError{Name: "notified_count", err: errors.New(`ent: missing required field "EventChange.notified_count"`)}
+	}
+	if v, ok := _c.mutation.NotifiedCount(); ok {
+		if err := eventchange.NotifiedCountValidator(v); err != nil {
+			return &ValidationError{Name: "notified_count", err: fmt.Errorf(`ent: validator failed for field "EventChange.notified_count": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventChange.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventChangeCreate) sqlSave(ctx context.Context) (*EventChange, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventChangeCreate) createSpec() (*EventChange, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventChange{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventchange.Table, sqlgraph.NewFieldSpec(eventchange.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(eventchange.FieldEventID, field.TypeUUID, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.ChangedBy(); ok {
+		_spec.SetField(eventchange.FieldChangedBy, field.TypeUUID, value)
+		_node.ChangedBy = value
+	}
+	if value, ok := _c.mutation.Before(); ok {
+		_spec.SetField(eventchange.FieldBefore, field.TypeJSON, value)
+		_node.Before = value
+	}
+	if value, ok := _c.mutation.After(); ok {
+		_spec.SetField(eventchange.FieldAfter, field.TypeJSON, value)
+		_node.After = value
+	}
+	if value, ok := _c.mutation.NotifiedCount(); ok {
+		_spec.SetField(eventchange.FieldNotifiedCount, field.TypeInt, value)
+		_node.NotifiedCount = value
+	}
+	if value, ok := _c.mutation.RefundUntil(); ok {
+		_spec.SetField(eventchange.FieldRefundUntil, field.TypeTime, value)
+		_node.RefundUntil = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventchange.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventChangeCreateBulk is the builder for creating many EventChange entities in bulk.
+type EventChangeCreateBulk struct {
+	config
+	err      error
+	builders []*EventChangeCreate
+}
+
+// Save creates the EventChange entities in the database.
+func (_c *EventChangeCreateBulk) Save(ctx context.Context) ([]*EventChange, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventChange, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventChangeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventChangeCreateBulk) SaveX(ctx context.Context) []*EventChange {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventChangeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventChangeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
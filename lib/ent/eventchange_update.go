@@ -0,0 +1,181 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventchange"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+)
+
+// EventChangeUpdate is the builder for updating EventChange entities.
+type EventChangeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventChangeMutation
+}
+
+// Where appends a list predicates to the EventChangeUpdate builder.
+func (_u *EventChangeUpdate) Where(ps ...predicate.EventChange) *EventChangeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the EventChangeMutation object of the builder.
+func (_u *EventChangeUpdate) Mutation() *EventChangeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventChangeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventChangeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventChangeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventChangeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EventChangeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventchange.Table, eventchange.Columns, sqlgraph.NewFieldSpec(eventchange.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.RefundUntilCleared() {
+		_spec.ClearField(eventchange.FieldRefundUntil, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventChangeUpdateOne is the builder for updating a single EventChange entity.
+type EventChangeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventChangeMutation
+}
+
+// Mutation returns the EventChangeMutation object of the builder.
+func (_u *EventChangeUpdateOne) Mutation() *EventChangeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventChangeUpdate builder.
+func (_u *EventChangeUpdateOne) Where(ps ...predicate.EventChange) *EventChangeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventChangeUpdateOne) Select(field string, fields ...string) *EventChangeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventChange entity.
+func (_u *EventChangeUpdateOne) Save(ctx context.Context) (*EventChange, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventChangeUpdateOne) SaveX(ctx context.Context) *EventChange {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventChangeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventChangeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EventChangeUpdateOne) sqlSave(ctx context.Context) (_node *EventChange, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventchange.Table, eventchange.Columns, sqlgraph.NewFieldSpec(eventchange.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventChange.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventchange.FieldID)
+		for _, f := range fields {
+			if !eventchange.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventchange.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.RefundUntilCleared() {
+		_spec.ClearField(eventchange.FieldRefundUntil, field.TypeTime)
+	}
+	_node = &EventChange{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventchange.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
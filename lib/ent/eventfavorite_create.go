@@ -0,0 +1,252 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventfavorite"
+	"github.com/google/uuid"
+)
+
+// EventFavoriteCreate is the builder for creating a EventFavorite entity.
+type EventFavoriteCreate struct {
+	config
+	mutation *EventFavoriteMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *EventFavoriteCreate) SetUserID(v uuid.UUID) *EventFavoriteCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *EventFavoriteCreate) SetEventID(v uuid.UUID) *EventFavoriteCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventFavoriteCreate) SetCreatedAt(v time.Time) *EventFavoriteCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventFavoriteCreate) SetNillableCreatedAt(v *time.Time) *EventFavoriteCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EventFavoriteCreate) SetID(v uuid.UUID) *EventFavoriteCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EventFavoriteCreate) SetNillableID(v *uuid.UUID) *EventFavoriteCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the EventFavoriteMutation object of the builder.
+func (_c *EventFavoriteCreate) Mutation() *EventFavoriteMutation {
+	return _c.mutation
+}
+
+// Save creates the EventFavorite in the database.
+func (_c *EventFavoriteCreate) Save(ctx context.Context) (*EventFavorite, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventFavoriteCreate) SaveX(ctx context.Context) *EventFavorite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventFavoriteCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventFavoriteCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventFavoriteCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventfavorite.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := eventfavorite.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventFavoriteCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "EventFavorite.user_id"`)}
+	}
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "EventFavorite.event_id"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventFavorite.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *EventFavoriteCreate) sqlSave(ctx context.Context) (*EventFavorite, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventFavoriteCreate) createSpec() (*EventFavorite, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventFavorite{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventfavorite.Table, sqlgraph.NewFieldSpec(eventfavorite.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(eventfavorite.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.EventID(); ok {
+		_spec.SetField(eventfavorite.FieldEventID, field.TypeUUID, value)
+		_node.EventID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventfavorite.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// EventFavoriteCreateBulk is the builder for creating many EventFavorite entities in bulk.
+type EventFavoriteCreateBulk struct {
+	config
+	err      error
+	builders []*EventFavoriteCreate
+}
+
+// Save creates the EventFavorite entities in the database.
+func (_c *EventFavoriteCreateBulk) Save(ctx context.Context) ([]*EventFavorite, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventFavorite, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventFavoriteMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventFavoriteCreateBulk) SaveX(ctx context.Context) []*EventFavorite {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventFavoriteCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventFavoriteCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventfavorite"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+)
+
+// EventFavoriteUpdate is the builder for updating EventFavorite entities.
+type EventFavoriteUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventFavoriteMutation
+}
+
+// Where appends a list predicates to the EventFavoriteUpdate builder.
+func (_u *EventFavoriteUpdate) Where(ps ...predicate.EventFavorite) *EventFavoriteUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Mutation returns the EventFavoriteMutation object of the builder.
+func (_u *EventFavoriteUpdate) Mutation() *EventFavoriteMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventFavoriteUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventFavoriteUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventFavoriteUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventFavoriteUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EventFavoriteUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventfavorite.Table, eventfavorite.Columns, sqlgraph.NewFieldSpec(eventfavorite.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventfavorite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventFavoriteUpdateOne is the builder for updating a single EventFavorite entity.
+type EventFavoriteUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventFavoriteMutation
+}
+
+// Mutation returns the EventFavoriteMutation object of the builder.
+func (_u *EventFavoriteUpdateOne) Mutation() *EventFavoriteMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the EventFavoriteUpdate builder.
+func (_u *EventFavoriteUpdateOne) Where(ps ...predicate.EventFavorite) *EventFavoriteUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventFavoriteUpdateOne) Select(field string, fields ...string) *EventFavoriteUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventFavorite entity.
+func (_u *EventFavoriteUpdateOne) Save(ctx context.Context) (*EventFavorite, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventFavoriteUpdateOne) SaveX(ctx context.Context) *EventFavorite {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventFavoriteUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventFavoriteUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *EventFavoriteUpdateOne) sqlSave(ctx context.Context) (_node *EventFavorite, err error) {
+	_spec := sqlgraph.NewUpdateSpec(eventfavorite.Table, eventfavorite.Columns, sqlgraph.NewFieldSpec(eventfavorite.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventFavorite.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventfavorite.FieldID)
+		for _, f := range fields {
+			if !eventfavorite.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventfavorite.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &EventFavorite{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventfavorite.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/google/uuid"
+)
+
+// EventSeries is the model entity for the EventSeries schema.
+type EventSeries struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Organization that hosts the series
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Series title, copied to its occurrences
+	Title string `json:"title,omitempty"`
+	// Description holds the value of the "description" field.
+	Description string `json:"description,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// Venue holds the value of the "venue" field.
+	Venue string `json:"venue,omitempty"`
+	// Saved venue copied to the occurrences
+	VenueID *uuid.UUID `json:"venue_id,omitempty"`
+	// SeatingLayout holds the value of the "seating_layout" field.
+	SeatingLayout string `json:"seating_layout,omitempty"`
+	// AddressStreet holds the value of the "address_street" field.
+	AddressStreet string `json:"address_street,omitempty"`
+	// AddressCity holds the value of the "address_city" field.
+	AddressCity string `json:"address_city,omitempty"`
+	// AddressRegion holds the value of the "address_region" field.
+	AddressRegion string `json:"address_region,omitempty"`
+	// AddressPostalCode holds the value of the "address_postal_code" field.
+	AddressPostalCode string `json:"address_postal_code,omitempty"`
+	// AddressCountry holds the value of the "address_country" field.
+	AddressCountry string `json:"address_country,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Category holds the value of the "category" field.
+	Category string `json:"category,omitempty"`
+	// Start of the first occurrence; later occurrences keep its time of day
+	StartTime time.Time `json:"start_time,omitempty"`
+	// End of the first occurrence; every occurrence lasts as long
+	EndTime time.Time `json:"end_time,omitempty"`
+	// JSON encoded recurrence rule
+	Recurrence string `json:"recurrence,omitempty"`
+	// Tickets per occurrence
+	TotalTickets int `json:"total_tickets,omitempty"`
+	// TicketPrice holds the value of the "ticket_price" field.
+	TicketPrice float64 `json:"ticket_price,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ThumbnailURL holds the value of the "thumbnail_url" field.
+	ThumbnailURL string `json:"thumbnail_url,omitempty"`
+	// Whether the series page is publicly visible
+	IsPublic bool `json:"is_public,omitempty"`
+	// RequiresApproval holds the value of the "requires_approval" field.
+	RequiresApproval bool `json:"requires_approval,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the EventSeriesQuery when eager-loading is set.
+	Edges        EventSeriesEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// EventSeriesEdges holds the relations/edges for other nodes in the graph.
+type EventSeriesEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// LinkedVenue holds the value of the linked_venue edge.
+	LinkedVenue *Venue `json:"linked_venue,omitempty"`
+	// Occurrences holds the value of the occurrences edge.
+	Occurrences []*Event `json:"occurrences,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventSeriesEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// LinkedVenueOrErr returns the LinkedVenue value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e EventSeriesEdges) LinkedVenueOrErr() (*Venue, error) {
+	if e.LinkedVenue != nil {
+		return e.LinkedVenue, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: venue.Label}
+	}
+	return nil, &NotLoadedError{edge: "linked_venue"}
+}
+
+// OccurrencesOrErr returns the Occurrences value or an error if the edge
+// was not loaded in eager-loading.
+func (e EventSeriesEdges) OccurrencesOrErr() ([]*Event, error) {
+	if e.loadedTypes[2] {
+		return e.Occurrences, nil
+	}
+	return nil, &NotLoadedError{edge: "occurrences"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*EventSeries) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case eventseries.FieldVenueID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case eventseries.FieldIsPublic, eventseries.FieldRequiresApproval:
+			values[i] = new(sql.NullBool)
+		case eventseries.FieldLatitude, eventseries.FieldLongitude, eventseries.FieldTicketPrice:
+			values[i] = new(sql.NullFloat64)
+		case eventseries.FieldTotalTickets:
+			values[i] = new(sql.NullInt64)
+		case eventseries.FieldTitle, eventseries.FieldDescription, eventseries.FieldLocation, eventseries.FieldVenue, eventseries.FieldSeatingLayout, eventseries.FieldAddressStreet, eventseries.FieldAddressCity, eventseries.FieldAddressRegion, eventseries.FieldAddressPostalCode, eventseries.FieldAddressCountry, eventseries.FieldCategory, eventseries.FieldRecurrence, eventseries.FieldCurrency, eventseries.FieldThumbnailURL:
+			values[i] = new(sql.NullString)
+		case eventseries.FieldStartTime, eventseries.FieldEndTime, eventseries.FieldCreatedAt, eventseries.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case eventseries.FieldID, eventseries.FieldOrganizationID, eventseries.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the EventSeries fields.
+func (_m *EventSeries) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case eventseries.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case eventseries.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case eventseries.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case eventseries.FieldDescription:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field description", values[i])
+			} else if value.Valid {
+				_m.Description = value.String
+			}
+		case eventseries.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				_m.Location = value.String
+			}
+		case eventseries.FieldVenue:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field venue", values[i])
+			} else if value.Valid {
+				_m.Venue = value.String
+			}
+		case eventseries.FieldVenueID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field venue_id", values[i])
+			} else if value.Valid {
+				_m.VenueID = new(uuid.UUID)
+				*_m.VenueID = *value.S.(*uuid.UUID)
+			}
+		case eventseries.FieldSeatingLayout:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seating_layout", values[i])
+			} else if value.Valid {
+				_m.SeatingLayout = value.String
+			}
+		case eventseries.FieldAddressStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_street", values[i])
+			} else if value.Valid {
+				_m.AddressStreet = value.String
+			}
+		case eventseries.FieldAddressCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_city", values[i])
+			} else if value.Valid {
+				_m.AddressCity = value.String
+			}
+		case eventseries.FieldAddressRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_region", values[i])
+			} else if value.Valid {
+				_m.AddressRegion = value.String
+			}
+		case eventseries.FieldAddressPostalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_postal_code", values[i])
+			} else if value.Valid {
+				_m.AddressPostalCode = value.String
+			}
+		case eventseries.FieldAddressCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_country", values[i])
+			} else if value.Valid {
+				_m.AddressCountry = value.String
+			}
+		case eventseries.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = new(float64)
+				*_m.Latitude = value.Float64
+			}
+		case eventseries.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = new(float64)
+				*_m.Longitude = value.Float64
+			}
+		case eventseries.FieldCategory:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field category", values[i])
+			} else if value.Valid {
+				_m.Category = value.String
+			}
+		case eventseries.FieldStartTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field start_time", values[i])
+			} else if value.Valid {
+				_m.StartTime = value.Time
+			}
+		case eventseries.FieldEndTime:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field end_time", values[i])
+			} else if value.Valid {
+				_m.EndTime = value.Time
+			}
+		case eventseries.FieldRecurrence:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field recurrence", values[i])
+			} else if value.Valid {
+				_m.Recurrence = value.String
+			}
+		case eventseries.FieldTotalTickets:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_tickets", values[i])
+			} else if value.Valid {
+				_m.TotalTickets = int(value.Int64)
+			}
+		case eventseries.FieldTicketPrice:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_price", values[i])
+			} else if value.Valid {
+				_m.TicketPrice = value.Float64
+			}
+		case eventseries.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case eventseries.FieldThumbnailURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field thumbnail_url", values[i])
+			} else if value.Valid {
+				_m.ThumbnailURL = value.String
+			}
+		case eventseries.FieldIsPublic:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_public", values[i])
+			} else if value.Valid {
+				_m.IsPublic = value.Bool
+			}
+		case eventseries.FieldRequiresApproval:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field requires_approval", values[i])
+			} else if value.Valid {
+				_m.RequiresApproval = value.Bool
+			}
+		case eventseries.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case eventseries.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case eventseries.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the EventSeries.
+// This includes values selected through modifiers, order, etc.
+func (_m *EventSeries) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the EventSeries entity.
+func (_m *EventSeries) QueryOrganization() *OrganizationQuery {
+	return NewEventSeriesClient(_m.config).QueryOrganization(_m)
+}
+
+// QueryLinkedVenue queries the "linked_venue" edge of the EventSeries entity.
+func (_m *EventSeries) QueryLinkedVenue() *VenueQuery {
+	return NewEventSeriesClient(_m.config).QueryLinkedVenue(_m)
+}
+
+// QueryOccurrences queries the "occurrences" edge of the EventSeries entity.
+func (_m *EventSeries) QueryOccurrences() *EventQuery {
+	return NewEventSeriesClient(_m.config).QueryOccurrences(_m)
+}
+
+// Update returns a builder for updating this EventSeries.
+// Note that you need to call EventSeries.Unwrap() before calling this method if this EventSeries
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *EventSeries) Update() *EventSeriesUpdateOne {
+	return NewEventSeriesClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the EventSeries entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *EventSeries) Unwrap() *EventSeries {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: EventSeries is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *EventSeries) String() string {
+	var builder strings.Builder
+	builder.WriteString("EventSeries(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("description=")
+	builder.WriteString(_m.Description)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(_m.Location)
+	builder.WriteString(", ")
+	builder.WriteString("venue=")
+	builder.WriteString(_m.Venue)
+	builder.WriteString(", ")
+	if v := _m.VenueID; v != nil {
+		builder.WriteString("venue_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("seating_layout=")
+	builder.WriteString(_m.SeatingLayout)
+	builder.WriteString(", ")
+	builder.WriteString("address_street=")
+	builder.WriteString(_m.AddressStreet)
+	builder.WriteString(", ")
+	builder.WriteString("address_city=")
+	builder.WriteString(_m.AddressCity)
+	builder.WriteString(", ")
+	builder.WriteString("address_region=")
+	builder.WriteString(_m.AddressRegion)
+	builder.WriteString(", ")
+	builder.WriteString("address_postal_code=")
+	builder.WriteString(_m.AddressPostalCode)
+	builder.WriteString(", ")
+	builder.WriteString("address_country=")
+	builder.WriteString(_m.AddressCountry)
+	builder.WriteString(", ")
+	if v := _m.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("category=")
+	builder.WriteString(_m.Category)
+	builder.WriteString(", ")
+	builder.WriteString("start_time=")
+	builder.WriteString(_m.StartTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("end_time=")
+	builder.WriteString(_m.EndTime.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("recurrence=")
+	builder.WriteString(_m.Recurrence)
+	builder.WriteString(", ")
+	builder.WriteString("total_tickets=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalTickets))
+	builder.WriteString(", ")
+	builder.WriteString("ticket_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TicketPrice))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("thumbnail_url=")
+	builder.WriteString(_m.ThumbnailURL)
+	builder.WriteString(", ")
+	builder.WriteString("is_public=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsPublic))
+	builder.WriteString(", ")
+	builder.WriteString("requires_approval=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RequiresApproval))
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// EventSeriesSlice is a parsable slice of EventSeries.
+type EventSeriesSlice []*EventSeries
@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventseries
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the eventseries type in the database.
+	Label = "event_series"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldTitle holds the string denoting the title field in the database.
+	FieldTitle = "title"
+	// FieldDescription holds the string denoting the description field in the database.
+	FieldDescription = "description"
+	// FieldLocation holds the string denoting the location field in the database.
+	FieldLocation = "location"
+	// FieldVenue holds the string denoting the venue field in the database.
+	FieldVenue = "venue"
+	// FieldVenueID holds the string denoting the venue_id field in the database.
+	FieldVenueID = "venue_id"
+	// FieldSeatingLayout holds the string denoting the seating_layout field in the database.
+	FieldSeatingLayout = "seating_layout"
+	// FieldAddressStreet holds the string denoting the address_street field in the database.
+	FieldAddressStreet = "address_street"
+	// FieldAddressCity holds the string denoting the address_city field in the database.
+	FieldAddressCity = "address_city"
+	// FieldAddressRegion holds the string denoting the address_region field in the database.
+	FieldAddressRegion = "address_region"
+	// FieldAddressPostalCode holds the string denoting the address_postal_code field in the database.
+	FieldAddressPostalCode = "address_postal_code"
+	// FieldAddressCountry holds the string denoting the address_country field in the database.
+	FieldAddressCountry = "address_country"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldCategory holds the string denoting the category field in the database.
+	FieldCategory = "category"
+	// FieldStartTime holds the string denoting the start_time field in the database.
+	FieldStartTime = "start_time"
+	// FieldEndTime holds the string denoting the end_time field in the database.
+	FieldEndTime = "end_time"
+	// FieldRecurrence holds the string denoting the recurrence field in the database.
+	FieldRecurrence = "recurrence"
+	// FieldTotalTickets holds the string denoting the total_tickets field in the database.
+	FieldTotalTickets = "total_tickets"
+	// FieldTicketPrice holds the string denoting the ticket_price field in the database.
+	FieldTicketPrice = "ticket_price"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldThumbnailURL holds the string denoting the thumbnail_url field in the database.
+	FieldThumbnailURL = "thumbnail_url"
+	// FieldIsPublic holds the string denoting the is_public field in the database.
+	FieldIsPublic = "is_public"
+	// FieldRequiresApproval holds the string denoting the requires_approval field in the database.
+	FieldRequiresApproval = "requires_approval"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeLinkedVenue holds the string denoting the linked_venue edge name in mutations.
+	EdgeLinkedVenue = "linked_venue"
+	// EdgeOccurrences holds the string denoting the occurrences edge name in mutations.
+	EdgeOccurrences = "occurrences"
+	// Table holds the table name of the eventseries in the database.
+	Table = "event_series"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "event_series"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// LinkedVenueTable is the table that holds the linked_venue relation/edge.
+	LinkedVenueTable = "event_series"
+	// LinkedVenueInverseTable is the table name for the Venue entity.
+	// It exists in this package in order to avoid circular dependency with the "venue" package.
+	LinkedVenueInverseTable = "venues"
+	// LinkedVenueColumn is the table column denoting the linked_venue relation/edge.
+	LinkedVenueColumn = "venue_id"
+	// OccurrencesTable is the table that holds the occurrences relation/edge.
+	OccurrencesTable = "events"
+	// OccurrencesInverseTable is the table name for the Event entity.
+	// It exists in this package in order to avoid circular dependency with the "event" package.
+	OccurrencesInverseTable = "events"
+	// OccurrencesColumn is the table column denoting the occurrences relation/edge.
+	OccurrencesColumn = "series_id"
+)
+
+// Columns holds all SQL columns for eventseries fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationID,
+	FieldTitle,
+	FieldDescription,
+	FieldLocation,
+	FieldVenue,
+	FieldVenueID,
+	FieldSeatingLayout,
+	FieldAddressStreet,
+	FieldAddressCity,
+	FieldAddressRegion,
+	FieldAddressPostalCode,
+	FieldAddressCountry,
+	FieldLatitude,
+	FieldLongitude,
+	FieldCategory,
+	FieldStartTime,
+	FieldEndTime,
+	FieldRecurrence,
+	FieldTotalTickets,
+	FieldTicketPrice,
+	FieldCurrency,
+	FieldThumbnailURL,
+	FieldIsPublic,
+	FieldRequiresApproval,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	TitleValidator func(string) error
+	// DefaultSeatingLayout holds the default value on creation for the "seating_layout" field.
+	DefaultSeatingLayout string
+	// DefaultAddressStreet holds the default value on creation for the "address_street" field.
+	DefaultAddressStreet string
+	// DefaultAddressCity holds the default value on creation for the "address_city" field.
+	DefaultAddressCity string
+	// AddressCityValidator is a validator for the "address_city" field. It is called by the builders before save.
+	AddressCityValidator func(string) error
+	// DefaultAddressRegion holds the default value on creation for the "address_region" field.
+	DefaultAddressRegion string
+	// AddressRegionValidator is a validator for the "address_region" field. It is called by the builders before save.
+	AddressRegionValidator func(string) error
+	// DefaultAddressPostalCode holds the default value on creation for the "address_postal_code" field.
+	DefaultAddressPostalCode string
+	// AddressPostalCodeValidator is a validator for the "address_postal_code" field. It is called by the builders before save.
+	AddressPostalCodeValidator func(string) error
+	// DefaultAddressCountry holds the default value on creation for the "address_country" field.
+	DefaultAddressCountry string
+	// AddressCountryValidator is a validator for the "address_country" field. It is called by the builders before save.
+	AddressCountryValidator func(string) error
+	// DefaultCategory holds the default value on creation for the "category" field.
+	DefaultCategory string
+	// CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	CategoryValidator func(string) error
+	// DefaultTotalTickets holds the default value on creation for the "total_tickets" field.
+	DefaultTotalTickets int
+	// TotalTicketsValidator is a validator for the "total_tickets" field. It is called by the builders before save.
+	TotalTicketsValidator func(int) error
+	// DefaultTicketPrice holds the default value on creation for the "ticket_price" field.
+	DefaultTicketPrice float64
+	// TicketPriceValidator is a validator for the "ticket_price" field. It is called by the builders before save.
+	TicketPriceValidator func(float64) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// DefaultIsPublic holds the default value on creation for the "is_public" field.
+	DefaultIsPublic bool
+	// DefaultRequiresApproval holds the default value on creation for the "requires_approval" field.
+	DefaultRequiresApproval bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the EventSeries queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByTitle orders the results by the title field.
+func ByTitle(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTitle, opts...).ToFunc()
+}
+
+// ByDescription orders the results by the description field.
+func ByDescription(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDescription, opts...).ToFunc()
+}
+
+// ByLocation orders the results by the location field.
+func ByLocation(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLocation, opts...).ToFunc()
+}
+
+// ByVenue orders the results by the venue field.
+func ByVenue(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVenue, opts...).ToFunc()
+}
+
+// ByVenueID orders the results by the venue_id field.
+func ByVenueID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVenueID, opts...).ToFunc()
+}
+
+// BySeatingLayout orders the results by the seating_layout field.
+func BySeatingLayout(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeatingLayout, opts...).ToFunc()
+}
+
+// ByAddressStreet orders the results by the address_street field.
+func ByAddressStreet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressStreet, opts...).ToFunc()
+}
+
+// ByAddressCity orders the results by the address_city field.
+func ByAddressCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressCity, opts...).ToFunc()
+}
+
+// ByAddressRegion orders the results by the address_region field.
+func ByAddressRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressRegion, opts...).ToFunc()
+}
+
+// ByAddressPostalCode orders the results by the address_postal_code field.
+func ByAddressPostalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressPostalCode, opts...).ToFunc()
+}
+
+// ByAddressCountry orders the results by the address_country field.
+func ByAddressCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressCountry, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByCategory orders the results by the category field.
+func ByCategory(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCategory, opts...).ToFunc()
+}
+
+// ByStartTime orders the results by the start_time field.
+func ByStartTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStartTime, opts...).ToFunc()
+}
+
+// ByEndTime orders the results by the end_time field.
+func ByEndTime(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEndTime, opts...).ToFunc()
+}
+
+// ByRecurrence orders the results by the recurrence field.
+func ByRecurrence(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRecurrence, opts...).ToFunc()
+}
+
+// ByTotalTickets orders the results by the total_tickets field.
+func ByTotalTickets(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalTickets, opts...).ToFunc()
+}
+
+// ByTicketPrice orders the results by the ticket_price field.
+func ByTicketPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketPrice, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByThumbnailURL orders the results by the thumbnail_url field.
+func ByThumbnailURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldThumbnailURL, opts...).ToFunc()
+}
+
+// ByIsPublic orders the results by the is_public field.
+func ByIsPublic(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsPublic, opts...).ToFunc()
+}
+
+// ByRequiresApproval orders the results by the requires_approval field.
+func ByRequiresApproval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRequiresApproval, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByLinkedVenueField orders the results by linked_venue field.
+func ByLinkedVenueField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newLinkedVenueStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByOccurrencesCount orders the results by occurrences count.
+func ByOccurrencesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOccurrencesStep(), opts...)
+	}
+}
+
+// ByOccurrences orders the results by occurrences terms.
+func ByOccurrences(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOccurrencesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
+func newLinkedVenueStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(LinkedVenueInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, LinkedVenueTable, LinkedVenueColumn),
+	)
+}
+func newOccurrencesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OccurrencesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OccurrencesTable, OccurrencesColumn),
+	)
+}
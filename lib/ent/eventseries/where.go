@@ -0,0 +1,1676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package eventseries
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTitle, v))
+}
+
+// Description applies equality check predicate on the "description" field. It's identical to DescriptionEQ.
+func Description(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldDescription, v))
+}
+
+// Location applies equality check predicate on the "location" field. It's identical to LocationEQ.
+func Location(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLocation, v))
+}
+
+// Venue applies equality check predicate on the "venue" field. It's identical to VenueEQ.
+func Venue(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldVenue, v))
+}
+
+// VenueID applies equality check predicate on the "venue_id" field. It's identical to VenueIDEQ.
+func VenueID(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldVenueID, v))
+}
+
+// SeatingLayout applies equality check predicate on the "seating_layout" field. It's identical to SeatingLayoutEQ.
+func SeatingLayout(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldSeatingLayout, v))
+}
+
+// AddressStreet applies equality check predicate on the "address_street" field. It's identical to AddressStreetEQ.
+func AddressStreet(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressStreet, v))
+}
+
+// AddressCity applies equality check predicate on the "address_city" field. It's identical to AddressCityEQ.
+func AddressCity(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressCity, v))
+}
+
+// AddressRegion applies equality check predicate on the "address_region" field. It's identical to AddressRegionEQ.
+func AddressRegion(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressRegion, v))
+}
+
+// AddressPostalCode applies equality check predicate on the "address_postal_code" field. It's identical to AddressPostalCodeEQ.
+func AddressPostalCode(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressPostalCode, v))
+}
+
+// AddressCountry applies equality check predicate on the "address_country" field. It's identical to AddressCountryEQ.
+func AddressCountry(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressCountry, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLongitude, v))
+}
+
+// Category applies equality check predicate on the "category" field. It's identical to CategoryEQ.
+func Category(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCategory, v))
+}
+
+// StartTime applies equality check predicate on the "start_time" field. It's identical to StartTimeEQ.
+func StartTime(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldStartTime, v))
+}
+
+// EndTime applies equality check predicate on the "end_time" field. It's identical to EndTimeEQ.
+func EndTime(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldEndTime, v))
+}
+
+// Recurrence applies equality check predicate on the "recurrence" field. It's identical to RecurrenceEQ.
+func Recurrence(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldRecurrence, v))
+}
+
+// TotalTickets applies equality check predicate on the "total_tickets" field. It's identical to TotalTicketsEQ.
+func TotalTickets(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTotalTickets, v))
+}
+
+// TicketPrice applies equality check predicate on the "ticket_price" field. It's identical to TicketPriceEQ.
+func TicketPrice(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTicketPrice, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ThumbnailURL applies equality check predicate on the "thumbnail_url" field. It's identical to ThumbnailURLEQ.
+func ThumbnailURL(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// IsPublic applies equality check predicate on the "is_public" field. It's identical to IsPublicEQ.
+func IsPublic(v bool) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldIsPublic, v))
+}
+
+// RequiresApproval applies equality check predicate on the "requires_approval" field. It's identical to RequiresApprovalEQ.
+func RequiresApproval(v bool) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldRequiresApproval, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// DescriptionEQ applies the EQ predicate on the "description" field.
+func DescriptionEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldDescription, v))
+}
+
+// DescriptionNEQ applies the NEQ predicate on the "description" field.
+func DescriptionNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldDescription, v))
+}
+
+// DescriptionIn applies the In predicate on the "description" field.
+func DescriptionIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldDescription, vs...))
+}
+
+// DescriptionNotIn applies the NotIn predicate on the "description" field.
+func DescriptionNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldDescription, vs...))
+}
+
+// DescriptionGT applies the GT predicate on the "description" field.
+func DescriptionGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldDescription, v))
+}
+
+// DescriptionGTE applies the GTE predicate on the "description" field.
+func DescriptionGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldDescription, v))
+}
+
+// DescriptionLT applies the LT predicate on the "description" field.
+func DescriptionLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldDescription, v))
+}
+
+// DescriptionLTE applies the LTE predicate on the "description" field.
+func DescriptionLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldDescription, v))
+}
+
+// DescriptionContains applies the Contains predicate on the "description" field.
+func DescriptionContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldDescription, v))
+}
+
+// DescriptionHasPrefix applies the HasPrefix predicate on the "description" field.
+func DescriptionHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldDescription, v))
+}
+
+// DescriptionHasSuffix applies the HasSuffix predicate on the "description" field.
+func DescriptionHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldDescription, v))
+}
+
+// DescriptionIsNil applies the IsNil predicate on the "description" field.
+func DescriptionIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldDescription))
+}
+
+// DescriptionNotNil applies the NotNil predicate on the "description" field.
+func DescriptionNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldDescription))
+}
+
+// DescriptionEqualFold applies the EqualFold predicate on the "description" field.
+func DescriptionEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldDescription, v))
+}
+
+// DescriptionContainsFold applies the ContainsFold predicate on the "description" field.
+func DescriptionContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldDescription, v))
+}
+
+// LocationEQ applies the EQ predicate on the "location" field.
+func LocationEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLocation, v))
+}
+
+// LocationNEQ applies the NEQ predicate on the "location" field.
+func LocationNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldLocation, v))
+}
+
+// LocationIn applies the In predicate on the "location" field.
+func LocationIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldLocation, vs...))
+}
+
+// LocationNotIn applies the NotIn predicate on the "location" field.
+func LocationNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldLocation, vs...))
+}
+
+// LocationGT applies the GT predicate on the "location" field.
+func LocationGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldLocation, v))
+}
+
+// LocationGTE applies the GTE predicate on the "location" field.
+func LocationGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldLocation, v))
+}
+
+// LocationLT applies the LT predicate on the "location" field.
+func LocationLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldLocation, v))
+}
+
+// LocationLTE applies the LTE predicate on the "location" field.
+func LocationLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldLocation, v))
+}
+
+// LocationContains applies the Contains predicate on the "location" field.
+func LocationContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldLocation, v))
+}
+
+// LocationHasPrefix applies the HasPrefix predicate on the "location" field.
+func LocationHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldLocation, v))
+}
+
+// LocationHasSuffix applies the HasSuffix predicate on the "location" field.
+func LocationHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldLocation, v))
+}
+
+// LocationIsNil applies the IsNil predicate on the "location" field.
+func LocationIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldLocation))
+}
+
+// LocationNotNil applies the NotNil predicate on the "location" field.
+func LocationNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldLocation))
+}
+
+// LocationEqualFold applies the EqualFold predicate on the "location" field.
+func LocationEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldLocation, v))
+}
+
+// LocationContainsFold applies the ContainsFold predicate on the "location" field.
+func LocationContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldLocation, v))
+}
+
+// VenueEQ applies the EQ predicate on the "venue" field.
+func VenueEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldVenue, v))
+}
+
+// VenueNEQ applies the NEQ predicate on the "venue" field.
+func VenueNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldVenue, v))
+}
+
+// VenueIn applies the In predicate on the "venue" field.
+func VenueIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldVenue, vs...))
+}
+
+// VenueNotIn applies the NotIn predicate on the "venue" field.
+func VenueNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldVenue, vs...))
+}
+
+// VenueGT applies the GT predicate on the "venue" field.
+func VenueGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldVenue, v))
+}
+
+// VenueGTE applies the GTE predicate on the "venue" field.
+func VenueGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldVenue, v))
+}
+
+// VenueLT applies the LT predicate on the "venue" field.
+func VenueLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldVenue, v))
+}
+
+// VenueLTE applies the LTE predicate on the "venue" field.
+func VenueLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldVenue, v))
+}
+
+// VenueContains applies the Contains predicate on the "venue" field.
+func VenueContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldVenue, v))
+}
+
+// VenueHasPrefix applies the HasPrefix predicate on the "venue" field.
+func VenueHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldVenue, v))
+}
+
+// VenueHasSuffix applies the HasSuffix predicate on the "venue" field.
+func VenueHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldVenue, v))
+}
+
+// VenueIsNil applies the IsNil predicate on the "venue" field.
+func VenueIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldVenue))
+}
+
+// VenueNotNil applies the NotNil predicate on the "venue" field.
+func VenueNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldVenue))
+}
+
+// VenueEqualFold applies the EqualFold predicate on the "venue" field.
+func VenueEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldVenue, v))
+}
+
+// VenueContainsFold applies the ContainsFold predicate on the "venue" field.
+func VenueContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldVenue, v))
+}
+
+// VenueIDEQ applies the EQ predicate on the "venue_id" field.
+func VenueIDEQ(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldVenueID, v))
+}
+
+// VenueIDNEQ applies the NEQ predicate on the "venue_id" field.
+func VenueIDNEQ(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldVenueID, v))
+}
+
+// VenueIDIn applies the In predicate on the "venue_id" field.
+func VenueIDIn(vs ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldVenueID, vs...))
+}
+
+// VenueIDNotIn applies the NotIn predicate on the "venue_id" field.
+func VenueIDNotIn(vs ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldVenueID, vs...))
+}
+
+// VenueIDIsNil applies the IsNil predicate on the "venue_id" field.
+func VenueIDIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldVenueID))
+}
+
+// VenueIDNotNil applies the NotNil predicate on the "venue_id" field.
+func VenueIDNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldVenueID))
+}
+
+// SeatingLayoutEQ applies the EQ predicate on the "seating_layout" field.
+func SeatingLayoutEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutNEQ applies the NEQ predicate on the "seating_layout" field.
+func SeatingLayoutNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutIn applies the In predicate on the "seating_layout" field.
+func SeatingLayoutIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldSeatingLayout, vs...))
+}
+
+// SeatingLayoutNotIn applies the NotIn predicate on the "seating_layout" field.
+func SeatingLayoutNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldSeatingLayout, vs...))
+}
+
+// SeatingLayoutGT applies the GT predicate on the "seating_layout" field.
+func SeatingLayoutGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutGTE applies the GTE predicate on the "seating_layout" field.
+func SeatingLayoutGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutLT applies the LT predicate on the "seating_layout" field.
+func SeatingLayoutLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutLTE applies the LTE predicate on the "seating_layout" field.
+func SeatingLayoutLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutContains applies the Contains predicate on the "seating_layout" field.
+func SeatingLayoutContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutHasPrefix applies the HasPrefix predicate on the "seating_layout" field.
+func SeatingLayoutHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutHasSuffix applies the HasSuffix predicate on the "seating_layout" field.
+func SeatingLayoutHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutEqualFold applies the EqualFold predicate on the "seating_layout" field.
+func SeatingLayoutEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldSeatingLayout, v))
+}
+
+// SeatingLayoutContainsFold applies the ContainsFold predicate on the "seating_layout" field.
+func SeatingLayoutContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldSeatingLayout, v))
+}
+
+// AddressStreetEQ applies the EQ predicate on the "address_street" field.
+func AddressStreetEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressStreet, v))
+}
+
+// AddressStreetNEQ applies the NEQ predicate on the "address_street" field.
+func AddressStreetNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldAddressStreet, v))
+}
+
+// AddressStreetIn applies the In predicate on the "address_street" field.
+func AddressStreetIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldAddressStreet, vs...))
+}
+
+// AddressStreetNotIn applies the NotIn predicate on the "address_street" field.
+func AddressStreetNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldAddressStreet, vs...))
+}
+
+// AddressStreetGT applies the GT predicate on the "address_street" field.
+func AddressStreetGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldAddressStreet, v))
+}
+
+// AddressStreetGTE applies the GTE predicate on the "address_street" field.
+func AddressStreetGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldAddressStreet, v))
+}
+
+// AddressStreetLT applies the LT predicate on the "address_street" field.
+func AddressStreetLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldAddressStreet, v))
+}
+
+// AddressStreetLTE applies the LTE predicate on the "address_street" field.
+func AddressStreetLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldAddressStreet, v))
+}
+
+// AddressStreetContains applies the Contains predicate on the "address_street" field.
+func AddressStreetContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldAddressStreet, v))
+}
+
+// AddressStreetHasPrefix applies the HasPrefix predicate on the "address_street" field.
+func AddressStreetHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldAddressStreet, v))
+}
+
+// AddressStreetHasSuffix applies the HasSuffix predicate on the "address_street" field.
+func AddressStreetHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldAddressStreet, v))
+}
+
+// AddressStreetEqualFold applies the EqualFold predicate on the "address_street" field.
+func AddressStreetEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldAddressStreet, v))
+}
+
+// AddressStreetContainsFold applies the ContainsFold predicate on the "address_street" field.
+func AddressStreetContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldAddressStreet, v))
+}
+
+// AddressCityEQ applies the EQ predicate on the "address_city" field.
+func AddressCityEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressCity, v))
+}
+
+// AddressCityNEQ applies the NEQ predicate on the "address_city" field.
+func AddressCityNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldAddressCity, v))
+}
+
+// AddressCityIn applies the In predicate on the "address_city" field.
+func AddressCityIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldAddressCity, vs...))
+}
+
+// AddressCityNotIn applies the NotIn predicate on the "address_city" field.
+func AddressCityNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldAddressCity, vs...))
+}
+
+// AddressCityGT applies the GT predicate on the "address_city" field.
+func AddressCityGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldAddressCity, v))
+}
+
+// AddressCityGTE applies the GTE predicate on the "address_city" field.
+func AddressCityGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldAddressCity, v))
+}
+
+// AddressCityLT applies the LT predicate on the "address_city" field.
+func AddressCityLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldAddressCity, v))
+}
+
+// AddressCityLTE applies the LTE predicate on the "address_city" field.
+func AddressCityLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldAddressCity, v))
+}
+
+// AddressCityContains applies the Contains predicate on the "address_city" field.
+func AddressCityContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldAddressCity, v))
+}
+
+// AddressCityHasPrefix applies the HasPrefix predicate on the "address_city" field.
+func AddressCityHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldAddressCity, v))
+}
+
+// AddressCityHasSuffix applies the HasSuffix predicate on the "address_city" field.
+func AddressCityHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldAddressCity, v))
+}
+
+// AddressCityEqualFold applies the EqualFold predicate on the "address_city" field.
+func AddressCityEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldAddressCity, v))
+}
+
+// AddressCityContainsFold applies the ContainsFold predicate on the "address_city" field.
+func AddressCityContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldAddressCity, v))
+}
+
+// AddressRegionEQ applies the EQ predicate on the "address_region" field.
+func AddressRegionEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressRegion, v))
+}
+
+// AddressRegionNEQ applies the NEQ predicate on the "address_region" field.
+func AddressRegionNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldAddressRegion, v))
+}
+
+// AddressRegionIn applies the In predicate on the "address_region" field.
+func AddressRegionIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldAddressRegion, vs...))
+}
+
+// AddressRegionNotIn applies the NotIn predicate on the "address_region" field.
+func AddressRegionNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldAddressRegion, vs...))
+}
+
+// AddressRegionGT applies the GT predicate on the "address_region" field.
+func AddressRegionGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldAddressRegion, v))
+}
+
+// AddressRegionGTE applies the GTE predicate on the "address_region" field.
+func AddressRegionGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldAddressRegion, v))
+}
+
+// AddressRegionLT applies the LT predicate on the "address_region" field.
+func AddressRegionLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldAddressRegion, v))
+}
+
+// AddressRegionLTE applies the LTE predicate on the "address_region" field.
+func AddressRegionLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldAddressRegion, v))
+}
+
+// AddressRegionContains applies the Contains predicate on the "address_region" field.
+func AddressRegionContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldAddressRegion, v))
+}
+
+// AddressRegionHasPrefix applies the HasPrefix predicate on the "address_region" field.
+func AddressRegionHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldAddressRegion, v))
+}
+
+// AddressRegionHasSuffix applies the HasSuffix predicate on the "address_region" field.
+func AddressRegionHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldAddressRegion, v))
+}
+
+// AddressRegionEqualFold applies the EqualFold predicate on the "address_region" field.
+func AddressRegionEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldAddressRegion, v))
+}
+
+// AddressRegionContainsFold applies the ContainsFold predicate on the "address_region" field.
+func AddressRegionContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldAddressRegion, v))
+}
+
+// AddressPostalCodeEQ applies the EQ predicate on the "address_postal_code" field.
+func AddressPostalCodeEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeNEQ applies the NEQ predicate on the "address_postal_code" field.
+func AddressPostalCodeNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeIn applies the In predicate on the "address_postal_code" field.
+func AddressPostalCodeIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldAddressPostalCode, vs...))
+}
+
+// AddressPostalCodeNotIn applies the NotIn predicate on the "address_postal_code" field.
+func AddressPostalCodeNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldAddressPostalCode, vs...))
+}
+
+// AddressPostalCodeGT applies the GT predicate on the "address_postal_code" field.
+func AddressPostalCodeGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeGTE applies the GTE predicate on the "address_postal_code" field.
+func AddressPostalCodeGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeLT applies the LT predicate on the "address_postal_code" field.
+func AddressPostalCodeLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeLTE applies the LTE predicate on the "address_postal_code" field.
+func AddressPostalCodeLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeContains applies the Contains predicate on the "address_postal_code" field.
+func AddressPostalCodeContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeHasPrefix applies the HasPrefix predicate on the "address_postal_code" field.
+func AddressPostalCodeHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeHasSuffix applies the HasSuffix predicate on the "address_postal_code" field.
+func AddressPostalCodeHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeEqualFold applies the EqualFold predicate on the "address_postal_code" field.
+func AddressPostalCodeEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeContainsFold applies the ContainsFold predicate on the "address_postal_code" field.
+func AddressPostalCodeContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldAddressPostalCode, v))
+}
+
+// AddressCountryEQ applies the EQ predicate on the "address_country" field.
+func AddressCountryEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldAddressCountry, v))
+}
+
+// AddressCountryNEQ applies the NEQ predicate on the "address_country" field.
+func AddressCountryNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldAddressCountry, v))
+}
+
+// AddressCountryIn applies the In predicate on the "address_country" field.
+func AddressCountryIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldAddressCountry, vs...))
+}
+
+// AddressCountryNotIn applies the NotIn predicate on the "address_country" field.
+func AddressCountryNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldAddressCountry, vs...))
+}
+
+// AddressCountryGT applies the GT predicate on the "address_country" field.
+func AddressCountryGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldAddressCountry, v))
+}
+
+// AddressCountryGTE applies the GTE predicate on the "address_country" field.
+func AddressCountryGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldAddressCountry, v))
+}
+
+// AddressCountryLT applies the LT predicate on the "address_country" field.
+func AddressCountryLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldAddressCountry, v))
+}
+
+// AddressCountryLTE applies the LTE predicate on the "address_country" field.
+func AddressCountryLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldAddressCountry, v))
+}
+
+// AddressCountryContains applies the Contains predicate on the "address_country" field.
+func AddressCountryContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldAddressCountry, v))
+}
+
+// AddressCountryHasPrefix applies the HasPrefix predicate on the "address_country" field.
+func AddressCountryHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldAddressCountry, v))
+}
+
+// AddressCountryHasSuffix applies the HasSuffix predicate on the "address_country" field.
+func AddressCountryHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldAddressCountry, v))
+}
+
+// AddressCountryEqualFold applies the EqualFold predicate on the "address_country" field.
+func AddressCountryEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldAddressCountry, v))
+}
+
+// AddressCountryContainsFold applies the ContainsFold predicate on the "address_country" field.
+func AddressCountryContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldAddressCountry, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldLongitude))
+}
+
+// CategoryEQ applies the EQ predicate on the "category" field.
+func CategoryEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCategory, v))
+}
+
+// CategoryNEQ applies the NEQ predicate on the "category" field.
+func CategoryNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldCategory, v))
+}
+
+// CategoryIn applies the In predicate on the "category" field.
+func CategoryIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldCategory, vs...))
+}
+
+// CategoryNotIn applies the NotIn predicate on the "category" field.
+func CategoryNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldCategory, vs...))
+}
+
+// CategoryGT applies the GT predicate on the "category" field.
+func CategoryGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldCategory, v))
+}
+
+// CategoryGTE applies the GTE predicate on the "category" field.
+func CategoryGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldCategory, v))
+}
+
+// CategoryLT applies the LT predicate on the "category" field.
+func CategoryLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldCategory, v))
+}
+
+// CategoryLTE applies the LTE predicate on the "category" field.
+func CategoryLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldCategory, v))
+}
+
+// CategoryContains applies the Contains predicate on the "category" field.
+func CategoryContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldCategory, v))
+}
+
+// CategoryHasPrefix applies the HasPrefix predicate on the "category" field.
+func CategoryHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldCategory, v))
+}
+
+// CategoryHasSuffix applies the HasSuffix predicate on the "category" field.
+func CategoryHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldCategory, v))
+}
+
+// CategoryEqualFold applies the EqualFold predicate on the "category" field.
+func CategoryEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldCategory, v))
+}
+
+// CategoryContainsFold applies the ContainsFold predicate on the "category" field.
+func CategoryContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldCategory, v))
+}
+
+// StartTimeEQ applies the EQ predicate on the "start_time" field.
+func StartTimeEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldStartTime, v))
+}
+
+// StartTimeNEQ applies the NEQ predicate on the "start_time" field.
+func StartTimeNEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldStartTime, v))
+}
+
+// StartTimeIn applies the In predicate on the "start_time" field.
+func StartTimeIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldStartTime, vs...))
+}
+
+// StartTimeNotIn applies the NotIn predicate on the "start_time" field.
+func StartTimeNotIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldStartTime, vs...))
+}
+
+// StartTimeGT applies the GT predicate on the "start_time" field.
+func StartTimeGT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldStartTime, v))
+}
+
+// StartTimeGTE applies the GTE predicate on the "start_time" field.
+func StartTimeGTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldStartTime, v))
+}
+
+// StartTimeLT applies the LT predicate on the "start_time" field.
+func StartTimeLT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldStartTime, v))
+}
+
+// StartTimeLTE applies the LTE predicate on the "start_time" field.
+func StartTimeLTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldStartTime, v))
+}
+
+// EndTimeEQ applies the EQ predicate on the "end_time" field.
+func EndTimeEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldEndTime, v))
+}
+
+// EndTimeNEQ applies the NEQ predicate on the "end_time" field.
+func EndTimeNEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldEndTime, v))
+}
+
+// EndTimeIn applies the In predicate on the "end_time" field.
+func EndTimeIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldEndTime, vs...))
+}
+
+// EndTimeNotIn applies the NotIn predicate on the "end_time" field.
+func EndTimeNotIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldEndTime, vs...))
+}
+
+// EndTimeGT applies the GT predicate on the "end_time" field.
+func EndTimeGT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldEndTime, v))
+}
+
+// EndTimeGTE applies the GTE predicate on the "end_time" field.
+func EndTimeGTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldEndTime, v))
+}
+
+// EndTimeLT applies the LT predicate on the "end_time" field.
+func EndTimeLT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldEndTime, v))
+}
+
+// EndTimeLTE applies the LTE predicate on the "end_time" field.
+func EndTimeLTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldEndTime, v))
+}
+
+// RecurrenceEQ applies the EQ predicate on the "recurrence" field.
+func RecurrenceEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldRecurrence, v))
+}
+
+// RecurrenceNEQ applies the NEQ predicate on the "recurrence" field.
+func RecurrenceNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldRecurrence, v))
+}
+
+// RecurrenceIn applies the In predicate on the "recurrence" field.
+func RecurrenceIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldRecurrence, vs...))
+}
+
+// RecurrenceNotIn applies the NotIn predicate on the "recurrence" field.
+func RecurrenceNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldRecurrence, vs...))
+}
+
+// RecurrenceGT applies the GT predicate on the "recurrence" field.
+func RecurrenceGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldRecurrence, v))
+}
+
+// RecurrenceGTE applies the GTE predicate on the "recurrence" field.
+func RecurrenceGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldRecurrence, v))
+}
+
+// RecurrenceLT applies the LT predicate on the "recurrence" field.
+func RecurrenceLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldRecurrence, v))
+}
+
+// RecurrenceLTE applies the LTE predicate on the "recurrence" field.
+func RecurrenceLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldRecurrence, v))
+}
+
+// RecurrenceContains applies the Contains predicate on the "recurrence" field.
+func RecurrenceContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldRecurrence, v))
+}
+
+// RecurrenceHasPrefix applies the HasPrefix predicate on the "recurrence" field.
+func RecurrenceHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldRecurrence, v))
+}
+
+// RecurrenceHasSuffix applies the HasSuffix predicate on the "recurrence" field.
+func RecurrenceHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldRecurrence, v))
+}
+
+// RecurrenceEqualFold applies the EqualFold predicate on the "recurrence" field.
+func RecurrenceEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldRecurrence, v))
+}
+
+// RecurrenceContainsFold applies the ContainsFold predicate on the "recurrence" field.
+func RecurrenceContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldRecurrence, v))
+}
+
+// TotalTicketsEQ applies the EQ predicate on the "total_tickets" field.
+func TotalTicketsEQ(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTotalTickets, v))
+}
+
+// TotalTicketsNEQ applies the NEQ predicate on the "total_tickets" field.
+func TotalTicketsNEQ(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldTotalTickets, v))
+}
+
+// TotalTicketsIn applies the In predicate on the "total_tickets" field.
+func TotalTicketsIn(vs ...int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldTotalTickets, vs...))
+}
+
+// TotalTicketsNotIn applies the NotIn predicate on the "total_tickets" field.
+func TotalTicketsNotIn(vs ...int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldTotalTickets, vs...))
+}
+
+// TotalTicketsGT applies the GT predicate on the "total_tickets" field.
+func TotalTicketsGT(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldTotalTickets, v))
+}
+
+// TotalTicketsGTE applies the GTE predicate on the "total_tickets" field.
+func TotalTicketsGTE(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldTotalTickets, v))
+}
+
+// TotalTicketsLT applies the LT predicate on the "total_tickets" field.
+func TotalTicketsLT(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldTotalTickets, v))
+}
+
+// TotalTicketsLTE applies the LTE predicate on the "total_tickets" field.
+func TotalTicketsLTE(v int) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldTotalTickets, v))
+}
+
+// TicketPriceEQ applies the EQ predicate on the "ticket_price" field.
+func TicketPriceEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldTicketPrice, v))
+}
+
+// TicketPriceNEQ applies the NEQ predicate on the "ticket_price" field.
+func TicketPriceNEQ(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldTicketPrice, v))
+}
+
+// TicketPriceIn applies the In predicate on the "ticket_price" field.
+func TicketPriceIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldTicketPrice, vs...))
+}
+
+// TicketPriceNotIn applies the NotIn predicate on the "ticket_price" field.
+func TicketPriceNotIn(vs ...float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldTicketPrice, vs...))
+}
+
+// TicketPriceGT applies the GT predicate on the "ticket_price" field.
+func TicketPriceGT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldTicketPrice, v))
+}
+
+// TicketPriceGTE applies the GTE predicate on the "ticket_price" field.
+func TicketPriceGTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldTicketPrice, v))
+}
+
+// TicketPriceLT applies the LT predicate on the "ticket_price" field.
+func TicketPriceLT(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldTicketPrice, v))
+}
+
+// TicketPriceLTE applies the LTE predicate on the "ticket_price" field.
+func TicketPriceLTE(v float64) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldTicketPrice, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ThumbnailURLEQ applies the EQ predicate on the "thumbnail_url" field.
+func ThumbnailURLEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLNEQ applies the NEQ predicate on the "thumbnail_url" field.
+func ThumbnailURLNEQ(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIn applies the In predicate on the "thumbnail_url" field.
+func ThumbnailURLIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLNotIn applies the NotIn predicate on the "thumbnail_url" field.
+func ThumbnailURLNotIn(vs ...string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldThumbnailURL, vs...))
+}
+
+// ThumbnailURLGT applies the GT predicate on the "thumbnail_url" field.
+func ThumbnailURLGT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLGTE applies the GTE predicate on the "thumbnail_url" field.
+func ThumbnailURLGTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLT applies the LT predicate on the "thumbnail_url" field.
+func ThumbnailURLLT(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLLTE applies the LTE predicate on the "thumbnail_url" field.
+func ThumbnailURLLTE(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContains applies the Contains predicate on the "thumbnail_url" field.
+func ThumbnailURLContains(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContains(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasPrefix applies the HasPrefix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasPrefix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasPrefix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLHasSuffix applies the HasSuffix predicate on the "thumbnail_url" field.
+func ThumbnailURLHasSuffix(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldHasSuffix(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLIsNil applies the IsNil predicate on the "thumbnail_url" field.
+func ThumbnailURLIsNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIsNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLNotNil applies the NotNil predicate on the "thumbnail_url" field.
+func ThumbnailURLNotNil() predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotNull(FieldThumbnailURL))
+}
+
+// ThumbnailURLEqualFold applies the EqualFold predicate on the "thumbnail_url" field.
+func ThumbnailURLEqualFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEqualFold(FieldThumbnailURL, v))
+}
+
+// ThumbnailURLContainsFold applies the ContainsFold predicate on the "thumbnail_url" field.
+func ThumbnailURLContainsFold(v string) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldContainsFold(FieldThumbnailURL, v))
+}
+
+// IsPublicEQ applies the EQ predicate on the "is_public" field.
+func IsPublicEQ(v bool) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldIsPublic, v))
+}
+
+// IsPublicNEQ applies the NEQ predicate on the "is_public" field.
+func IsPublicNEQ(v bool) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldIsPublic, v))
+}
+
+// RequiresApprovalEQ applies the EQ predicate on the "requires_approval" field.
+func RequiresApprovalEQ(v bool) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldRequiresApproval, v))
+}
+
+// RequiresApprovalNEQ applies the NEQ predicate on the "requires_approval" field.
+func RequiresApprovalNEQ(v bool) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldRequiresApproval, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.EventSeries {
+	return predicate.EventSeries(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.EventSeries {
+	return predicate.EventSeries(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.EventSeries {
+	return predicate.EventSeries(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasLinkedVenue applies the HasEdge predicate on the "linked_venue" edge.
+func HasLinkedVenue() predicate.EventSeries {
+	return predicate.EventSeries(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, LinkedVenueTable, LinkedVenueColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasLinkedVenueWith applies the HasEdge predicate on the "linked_venue" edge with a given conditions (other predicates).
+func HasLinkedVenueWith(preds ...predicate.Venue) predicate.EventSeries {
+	return predicate.EventSeries(func(s *sql.Selector) {
+		step := newLinkedVenueStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasOccurrences applies the HasEdge predicate on the "occurrences" edge.
+func HasOccurrences() predicate.EventSeries {
+	return predicate.EventSeries(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OccurrencesTable, OccurrencesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOccurrencesWith applies the HasEdge predicate on the "occurrences" edge with a given conditions (other predicates).
+func HasOccurrencesWith(preds ...predicate.Event) predicate.EventSeries {
+	return predicate.EventSeries(func(s *sql.Selector) {
+		step := newOccurrencesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.EventSeries) predicate.EventSeries {
+	return predicate.EventSeries(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.EventSeries) predicate.EventSeries {
+	return predicate.EventSeries(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.EventSeries) predicate.EventSeries {
+	return predicate.EventSeries(sql.NotPredicates(p))
+}
@@ -0,0 +1,882 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/google/uuid"
+)
+
+// EventSeriesCreate is the builder for creating a EventSeries entity.
+type EventSeriesCreate struct {
+	config
+	mutation *EventSeriesMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *EventSeriesCreate) SetOrganizationID(v uuid.UUID) *EventSeriesCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *EventSeriesCreate) SetTitle(v string) *EventSeriesCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetDescription sets the "description" field.
+func (_c *EventSeriesCreate) SetDescription(v string) *EventSeriesCreate {
+	_c.mutation.SetDescription(v)
+	return _c
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableDescription(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetDescription(*v)
+	}
+	return _c
+}
+
+// SetLocation sets the "location" field.
+func (_c *EventSeriesCreate) SetLocation(v string) *EventSeriesCreate {
+	_c.mutation.SetLocation(v)
+	return _c
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableLocation(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetLocation(*v)
+	}
+	return _c
+}
+
+// SetVenue sets the "venue" field.
+func (_c *EventSeriesCreate) SetVenue(v string) *EventSeriesCreate {
+	_c.mutation.SetVenue(v)
+	return _c
+}
+
+// SetNillableVenue sets the "venue" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableVenue(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetVenue(*v)
+	}
+	return _c
+}
+
+// SetVenueID sets the "venue_id" field.
+func (_c *EventSeriesCreate) SetVenueID(v uuid.UUID) *EventSeriesCreate {
+	_c.mutation.SetVenueID(v)
+	return _c
+}
+
+// SetNillableVenueID sets the "venue_id" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableVenueID(v *uuid.UUID) *EventSeriesCreate {
+	if v != nil {
+		_c.SetVenueID(*v)
+	}
+	return _c
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (_c *EventSeriesCreate) SetSeatingLayout(v string) *EventSeriesCreate {
+	_c.mutation.SetSeatingLayout(v)
+	return _c
+}
+
+// SetNillableSeatingLayout sets the "seating_layout" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableSeatingLayout(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetSeatingLayout(*v)
+	}
+	return _c
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_c *EventSeriesCreate) SetAddressStreet(v string) *EventSeriesCreate {
+	_c.mutation.SetAddressStreet(v)
+	return _c
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableAddressStreet(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetAddressStreet(*v)
+	}
+	return _c
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_c *EventSeriesCreate) SetAddressCity(v string) *EventSeriesCreate {
+	_c.mutation.SetAddressCity(v)
+	return _c
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableAddressCity(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetAddressCity(*v)
+	}
+	return _c
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_c *EventSeriesCreate) SetAddressRegion(v string) *EventSeriesCreate {
+	_c.mutation.SetAddressRegion(v)
+	return _c
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableAddressRegion(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetAddressRegion(*v)
+	}
+	return _c
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_c *EventSeriesCreate) SetAddressPostalCode(v string) *EventSeriesCreate {
+	_c.mutation.SetAddressPostalCode(v)
+	return _c
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableAddressPostalCode(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetAddressPostalCode(*v)
+	}
+	return _c
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_c *EventSeriesCreate) SetAddressCountry(v string) *EventSeriesCreate {
+	_c.mutation.SetAddressCountry(v)
+	return _c
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableAddressCountry(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetAddressCountry(*v)
+	}
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *EventSeriesCreate) SetLatitude(v float64) *EventSeriesCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableLatitude(v *float64) *EventSeriesCreate {
+	if v != nil {
+		_c.SetLatitude(*v)
+	}
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *EventSeriesCreate) SetLongitude(v float64) *EventSeriesCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableLongitude(v *float64) *EventSeriesCreate {
+	if v != nil {
+		_c.SetLongitude(*v)
+	}
+	return _c
+}
+
+// SetCategory sets the "category" field.
+func (_c *EventSeriesCreate) SetCategory(v string) *EventSeriesCreate {
+	_c.mutation.SetCategory(v)
+	return _c
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableCategory(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetCategory(*v)
+	}
+	return _c
+}
+
+// SetStartTime sets the "start_time" field.
+func (_c *EventSeriesCreate) SetStartTime(v time.Time) *EventSeriesCreate {
+	_c.mutation.SetStartTime(v)
+	return _c
+}
+
+// SetEndTime sets the "end_time" field.
+func (_c *EventSeriesCreate) SetEndTime(v time.Time) *EventSeriesCreate {
+	_c.mutation.SetEndTime(v)
+	return _c
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (_c *EventSeriesCreate) SetRecurrence(v string) *EventSeriesCreate {
+	_c.mutation.SetRecurrence(v)
+	return _c
+}
+
+// SetTotalTickets sets the "total_tickets" field.
+func (_c *EventSeriesCreate) SetTotalTickets(v int) *EventSeriesCreate {
+	_c.mutation.SetTotalTickets(v)
+	return _c
+}
+
+// SetNillableTotalTickets sets the "total_tickets" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableTotalTickets(v *int) *EventSeriesCreate {
+	if v != nil {
+		_c.SetTotalTickets(*v)
+	}
+	return _c
+}
+
+// SetTicketPrice sets the "ticket_price" field.
+func (_c *EventSeriesCreate) SetTicketPrice(v float64) *EventSeriesCreate {
+	_c.mutation.SetTicketPrice(v)
+	return _c
+}
+
+// SetNillableTicketPrice sets the "ticket_price" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableTicketPrice(v *float64) *EventSeriesCreate {
+	if v != nil {
+		_c.SetTicketPrice(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *EventSeriesCreate) SetCurrency(v string) *EventSeriesCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableCurrency(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_c *EventSeriesCreate) SetThumbnailURL(v string) *EventSeriesCreate {
+	_c.mutation.SetThumbnailURL(v)
+	return _c
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableThumbnailURL(v *string) *EventSeriesCreate {
+	if v != nil {
+		_c.SetThumbnailURL(*v)
+	}
+	return _c
+}
+
+// SetIsPublic sets the "is_public" field.
+func (_c *EventSeriesCreate) SetIsPublic(v bool) *EventSeriesCreate {
+	_c.mutation.SetIsPublic(v)
+	return _c
+}
+
+// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableIsPublic(v *bool) *EventSeriesCreate {
+	if v != nil {
+		_c.SetIsPublic(*v)
+	}
+	return _c
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (_c *EventSeriesCreate) SetRequiresApproval(v bool) *EventSeriesCreate {
+	_c.mutation.SetRequiresApproval(v)
+	return _c
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableRequiresApproval(v *bool) *EventSeriesCreate {
+	if v != nil {
+		_c.SetRequiresApproval(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *EventSeriesCreate) SetCreatedBy(v uuid.UUID) *EventSeriesCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *EventSeriesCreate) SetCreatedAt(v time.Time) *EventSeriesCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableCreatedAt(v *time.Time) *EventSeriesCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *EventSeriesCreate) SetUpdatedAt(v time.Time) *EventSeriesCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableUpdatedAt(v *time.Time) *EventSeriesCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *EventSeriesCreate) SetID(v uuid.UUID) *EventSeriesCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableID(v *uuid.UUID) *EventSeriesCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *EventSeriesCreate) SetOrganization(v *Organization) *EventSeriesCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID.
+func (_c *EventSeriesCreate) SetLinkedVenueID(id uuid.UUID) *EventSeriesCreate {
+	_c.mutation.SetLinkedVenueID(id)
+	return _c
+}
+
+// SetNillableLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID if the given value is not nil.
+func (_c *EventSeriesCreate) SetNillableLinkedVenueID(id *uuid.UUID) *EventSeriesCreate {
+	if id != nil {
+		_c = _c.SetLinkedVenueID(*id)
+	}
+	return _c
+}
+
+// SetLinkedVenue sets the "linked_venue" edge to the Venue entity.
+func (_c *EventSeriesCreate) SetLinkedVenue(v *Venue) *EventSeriesCreate {
+	return _c.SetLinkedVenueID(v.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the Event entity by IDs.
+func (_c *EventSeriesCreate) AddOccurrenceIDs(ids ...uuid.UUID) *EventSeriesCreate {
+	_c.mutation.AddOccurrenceIDs(ids...)
+	return _c
+}
+
+// AddOccurrences adds the "occurrences" edges to the Event entity.
+func (_c *EventSeriesCreate) AddOccurrences(v ...*Event) *EventSeriesCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOccurrenceIDs(ids...)
+}
+
+// Mutation returns the EventSeriesMutation object of the builder.
+func (_c *EventSeriesCreate) Mutation() *EventSeriesMutation {
+	return _c.mutation
+}
+
+// Save creates the EventSeries in the database.
+func (_c *EventSeriesCreate) Save(ctx context.Context) (*EventSeries, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *EventSeriesCreate) SaveX(ctx context.Context) *EventSeries {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventSeriesCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventSeriesCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *EventSeriesCreate) defaults() {
+	if _, ok := _c.mutation.SeatingLayout(); !ok {
+		v := eventseries.DefaultSeatingLayout
+		_c.mutation.SetSeatingLayout(v)
+	}
+	if _, ok := _c.mutation.AddressStreet(); !ok {
+		v := eventseries.DefaultAddressStreet
+		_c.mutation.SetAddressStreet(v)
+	}
+	if _, ok := _c.mutation.AddressCity(); !ok {
+		v := eventseries.DefaultAddressCity
+		_c.mutation.SetAddressCity(v)
+	}
+	if _, ok := _c.mutation.AddressRegion(); !ok {
+		v := eventseries.DefaultAddressRegion
+		_c.mutation.SetAddressRegion(v)
+	}
+	if _, ok := _c.mutation.AddressPostalCode(); !ok {
+		v := eventseries.DefaultAddressPostalCode
+		_c.mutation.SetAddressPostalCode(v)
+	}
+	if _, ok := _c.mutation.AddressCountry(); !ok {
+		v := eventseries.DefaultAddressCountry
+		_c.mutation.SetAddressCountry(v)
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		v := eventseries.DefaultCategory
+		_c.mutation.SetCategory(v)
+	}
+	if _, ok := _c.mutation.TotalTickets(); !ok {
+		v := eventseries.DefaultTotalTickets
+		_c.mutation.SetTotalTickets(v)
+	}
+	if _, ok := _c.mutation.TicketPrice(); !ok {
+		v := eventseries.DefaultTicketPrice
+		_c.mutation.SetTicketPrice(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := eventseries.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.IsPublic(); !ok {
+		v := eventseries.DefaultIsPublic
+		_c.mutation.SetIsPublic(v)
+	}
+	if _, ok := _c.mutation.RequiresApproval(); !ok {
+		v := eventseries.DefaultRequiresApproval
+		_c.mutation.SetRequiresApproval(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := eventseries.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := eventseries.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := eventseries.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *EventSeriesCreate) check() error {
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "EventSeries.organization_id"`)}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "EventSeries.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := eventseries.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "EventSeries.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SeatingLayout(); !ok {
+		return &ValidationError{Name: "seating_layout", err: errors.New(`ent: missing required field "EventSeries.seating_layout"`)}
+	}
+	if _, ok := _c.mutation.AddressStreet(); !ok {
+		return &ValidationError{Name: "address_street", err: errors.New(`ent: missing required field "EventSeries.address_street"`)}
+	}
+	if _, ok := _c.mutation.AddressCity(); !ok {
+		return &ValidationError{Name: "address_city", err: errors.New(`ent: missing required field "EventSeries.address_city"`)}
+	}
+	if v, ok := _c.mutation.AddressCity(); ok {
+		if err := eventseries.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_city": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressRegion(); !ok {
+		return &ValidationError{Name: "address_region", err: errors.New(`ent: missing required field "EventSeries.address_region"`)}
+	}
+	if v, ok := _c.mutation.AddressRegion(); ok {
+		if err := eventseries.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_region": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressPostalCode(); !ok {
+		return &ValidationError{Name: "address_postal_code", err: errors.New(`ent: missing required field "EventSeries.address_postal_code"`)}
+	}
+	if v, ok := _c.mutation.AddressPostalCode(); ok {
+		if err := eventseries.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_postal_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressCountry(); !ok {
+		return &ValidationError{Name: "address_country", err: errors.New(`ent: missing required field "EventSeries.address_country"`)}
+	}
+	if v, ok := _c.mutation.AddressCountry(); ok {
+		if err := eventseries.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_country": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Category(); !ok {
+		return &ValidationError{Name: "category", err: errors.New(`ent: missing required field "EventSeries.category"`)}
+	}
+	if v, ok := _c.mutation.Category(); ok {
+		if err := eventseries.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "EventSeries.category": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StartTime(); !ok {
+		return &ValidationError{Name: "start_time", err: errors.New(`ent: missing required field "EventSeries.start_time"`)}
+	}
+	if _, ok := _c.mutation.EndTime(); !ok {
+		return &ValidationError{Name: "end_time", err: errors.New(`ent: missing required field "EventSeries.end_time"`)}
+	}
+	if _, ok := _c.mutation.Recurrence(); !ok {
+		return &ValidationError{Name: "recurrence", err: errors.New(`ent: missing required field "EventSeries.recurrence"`)}
+	}
+	if _, ok := _c.mutation.TotalTickets(); !ok {
+		return &ValidationError{Name: "total_tickets", err: errors.New(`ent: missing required field "EventSeries.total_tickets"`)}
+	}
+	if v, ok := _c.mutation.TotalTickets(); ok {
+		if err := eventseries.TotalTicketsValidator(v); err != nil {
+			return &ValidationError{Name: "total_tickets", err: fmt.Errorf(`ent: validator failed for field "EventSeries.total_tickets": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TicketPrice(); !ok {
+		return &ValidationError{Name: "ticket_price", err: errors.New(`ent: missing required field "EventSeries.ticket_price"`)}
+	}
+	if v, ok := _c.mutation.TicketPrice(); ok {
+		if err := eventseries.TicketPriceValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_price", err: fmt.Errorf(`ent: validator failed for field "EventSeries.ticket_price": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "EventSeries.currency"`)}
+	}
+	if _, ok := _c.mutation.IsPublic(); !ok {
+		return &ValidationError{Name: "is_public", err: errors.New(`ent: missing required field "EventSeries.is_public"`)}
+	}
+	if _, ok := _c.mutation.RequiresApproval(); !ok {
+		return &ValidationError{Name: "requires_approval", err: errors.New(`ent: missing required field "EventSeries.requires_approval"`)}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "EventSeries.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "EventSeries.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "EventSeries.updated_at"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "EventSeries.organization"`)}
+	}
+	return nil
+}
+
+func (_c *EventSeriesCreate) sqlSave(ctx context.Context) (*EventSeries, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *EventSeriesCreate) createSpec() (*EventSeries, *sqlgraph.CreateSpec) {
+	var (
+		_node = &EventSeries{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(eventseries.Table, sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(eventseries.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Description(); ok {
+		_spec.SetField(eventseries.FieldDescription, field.TypeString, value)
+		_node.Description = value
+	}
+	if value, ok := _c.mutation.Location(); ok {
+		_spec.SetField(eventseries.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := _c.mutation.Venue(); ok {
+		_spec.SetField(eventseries.FieldVenue, field.TypeString, value)
+		_node.Venue = value
+	}
+	if value, ok := _c.mutation.SeatingLayout(); ok {
+		_spec.SetField(eventseries.FieldSeatingLayout, field.TypeString, value)
+		_node.SeatingLayout = value
+	}
+	if value, ok := _c.mutation.AddressStreet(); ok {
+		_spec.SetField(eventseries.FieldAddressStreet, field.TypeString, value)
+		_node.AddressStreet = value
+	}
+	if value, ok := _c.mutation.AddressCity(); ok {
+		_spec.SetField(eventseries.FieldAddressCity, field.TypeString, value)
+		_node.AddressCity = value
+	}
+	if value, ok := _c.mutation.AddressRegion(); ok {
+		_spec.SetField(eventseries.FieldAddressRegion, field.TypeString, value)
+		_node.AddressRegion = value
+	}
+	if value, ok := _c.mutation.AddressPostalCode(); ok {
+		_spec.SetField(eventseries.FieldAddressPostalCode, field.TypeString, value)
+		_node.AddressPostalCode = value
+	}
+	if value, ok := _c.mutation.AddressCountry(); ok {
+		_spec.SetField(eventseries.FieldAddressCountry, field.TypeString, value)
+		_node.AddressCountry = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(eventseries.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(eventseries.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := _c.mutation.Category(); ok {
+		_spec.SetField(eventseries.FieldCategory, field.TypeString, value)
+		_node.Category = value
+	}
+	if value, ok := _c.mutation.StartTime(); ok {
+		_spec.SetField(eventseries.FieldStartTime, field.TypeTime, value)
+		_node.StartTime = value
+	}
+	if value, ok := _c.mutation.EndTime(); ok {
+		_spec.SetField(eventseries.FieldEndTime, field.TypeTime, value)
+		_node.EndTime = value
+	}
+	if value, ok := _c.mutation.Recurrence(); ok {
+		_spec.SetField(eventseries.FieldRecurrence, field.TypeString, value)
+		_node.Recurrence = value
+	}
+	if value, ok := _c.mutation.TotalTickets(); ok {
+		_spec.SetField(eventseries.FieldTotalTickets, field.TypeInt, value)
+		_node.TotalTickets = value
+	}
+	if value, ok := _c.mutation.TicketPrice(); ok {
+		_spec.SetField(eventseries.FieldTicketPrice, field.TypeFloat64, value)
+		_node.TicketPrice = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(eventseries.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventseries.FieldThumbnailURL, field.TypeString, value)
+		_node.ThumbnailURL = value
+	}
+	if value, ok := _c.mutation.IsPublic(); ok {
+		_spec.SetField(eventseries.FieldIsPublic, field.TypeBool, value)
+		_node.IsPublic = value
+	}
+	if value, ok := _c.mutation.RequiresApproval(); ok {
+		_spec.SetField(eventseries.FieldRequiresApproval, field.TypeBool, value)
+		_node.RequiresApproval = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(eventseries.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(eventseries.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventseries.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventseries.OrganizationTable,
+			Columns: []string{eventseries.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.LinkedVenueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventseries.LinkedVenueTable,
+			Columns: []string{eventseries.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.VenueID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventseries.OccurrencesTable,
+			Columns: []string{eventseries.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// EventSeriesCreateBulk is the builder for creating many EventSeries entities in bulk.
+type EventSeriesCreateBulk struct {
+	config
+	err      error
+	builders []*EventSeriesCreate
+}
+
+// Save creates the EventSeries entities in the database.
+func (_c *EventSeriesCreateBulk) Save(ctx context.Context) ([]*EventSeries, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*EventSeries, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*EventSeriesMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *EventSeriesCreateBulk) SaveX(ctx context.Context) []*EventSeries {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *EventSeriesCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *EventSeriesCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
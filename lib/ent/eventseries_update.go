@@ -0,0 +1,1461 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/google/uuid"
+)
+
+// EventSeriesUpdate is the builder for updating EventSeries entities.
+type EventSeriesUpdate struct {
+	config
+	hooks    []Hook
+	mutation *EventSeriesMutation
+}
+
+// Where appends a list predicates to the EventSeriesUpdate builder.
+func (_u *EventSeriesUpdate) Where(ps ...predicate.EventSeries) *EventSeriesUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *EventSeriesUpdate) SetTitle(v string) *EventSeriesUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableTitle(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventSeriesUpdate) SetDescription(v string) *EventSeriesUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableDescription(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EventSeriesUpdate) ClearDescription() *EventSeriesUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventSeriesUpdate) SetLocation(v string) *EventSeriesUpdate {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLocation(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *EventSeriesUpdate) ClearLocation() *EventSeriesUpdate {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetVenue sets the "venue" field.
+func (_u *EventSeriesUpdate) SetVenue(v string) *EventSeriesUpdate {
+	_u.mutation.SetVenue(v)
+	return _u
+}
+
+// SetNillableVenue sets the "venue" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableVenue(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetVenue(*v)
+	}
+	return _u
+}
+
+// ClearVenue clears the value of the "venue" field.
+func (_u *EventSeriesUpdate) ClearVenue() *EventSeriesUpdate {
+	_u.mutation.ClearVenue()
+	return _u
+}
+
+// SetVenueID sets the "venue_id" field.
+func (_u *EventSeriesUpdate) SetVenueID(v uuid.UUID) *EventSeriesUpdate {
+	_u.mutation.SetVenueID(v)
+	return _u
+}
+
+// SetNillableVenueID sets the "venue_id" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableVenueID(v *uuid.UUID) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetVenueID(*v)
+	}
+	return _u
+}
+
+// ClearVenueID clears the value of the "venue_id" field.
+func (_u *EventSeriesUpdate) ClearVenueID() *EventSeriesUpdate {
+	_u.mutation.ClearVenueID()
+	return _u
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (_u *EventSeriesUpdate) SetSeatingLayout(v string) *EventSeriesUpdate {
+	_u.mutation.SetSeatingLayout(v)
+	return _u
+}
+
+// SetNillableSeatingLayout sets the "seating_layout" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableSeatingLayout(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetSeatingLayout(*v)
+	}
+	return _u
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_u *EventSeriesUpdate) SetAddressStreet(v string) *EventSeriesUpdate {
+	_u.mutation.SetAddressStreet(v)
+	return _u
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableAddressStreet(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetAddressStreet(*v)
+	}
+	return _u
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_u *EventSeriesUpdate) SetAddressCity(v string) *EventSeriesUpdate {
+	_u.mutation.SetAddressCity(v)
+	return _u
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableAddressCity(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetAddressCity(*v)
+	}
+	return _u
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_u *EventSeriesUpdate) SetAddressRegion(v string) *EventSeriesUpdate {
+	_u.mutation.SetAddressRegion(v)
+	return _u
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableAddressRegion(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetAddressRegion(*v)
+	}
+	return _u
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_u *EventSeriesUpdate) SetAddressPostalCode(v string) *EventSeriesUpdate {
+	_u.mutation.SetAddressPostalCode(v)
+	return _u
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableAddressPostalCode(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetAddressPostalCode(*v)
+	}
+	return _u
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_u *EventSeriesUpdate) SetAddressCountry(v string) *EventSeriesUpdate {
+	_u.mutation.SetAddressCountry(v)
+	return _u
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableAddressCountry(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetAddressCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *EventSeriesUpdate) SetLatitude(v float64) *EventSeriesUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLatitude(v *float64) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *EventSeriesUpdate) AddLatitude(v float64) *EventSeriesUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *EventSeriesUpdate) ClearLatitude() *EventSeriesUpdate {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *EventSeriesUpdate) SetLongitude(v float64) *EventSeriesUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLongitude(v *float64) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *EventSeriesUpdate) AddLongitude(v float64) *EventSeriesUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *EventSeriesUpdate) ClearLongitude() *EventSeriesUpdate {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *EventSeriesUpdate) SetCategory(v string) *EventSeriesUpdate {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableCategory(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetTotalTickets sets the "total_tickets" field.
+func (_u *EventSeriesUpdate) SetTotalTickets(v int) *EventSeriesUpdate {
+	_u.mutation.ResetTotalTickets()
+	_u.mutation.SetTotalTickets(v)
+	return _u
+}
+
+// SetNillableTotalTickets sets the "total_tickets" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableTotalTickets(v *int) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetTotalTickets(*v)
+	}
+	return _u
+}
+
+// AddTotalTickets adds value to the "total_tickets" field.
+func (_u *EventSeriesUpdate) AddTotalTickets(v int) *EventSeriesUpdate {
+	_u.mutation.AddTotalTickets(v)
+	return _u
+}
+
+// SetTicketPrice sets the "ticket_price" field.
+func (_u *EventSeriesUpdate) SetTicketPrice(v float64) *EventSeriesUpdate {
+	_u.mutation.ResetTicketPrice()
+	_u.mutation.SetTicketPrice(v)
+	return _u
+}
+
+// SetNillableTicketPrice sets the "ticket_price" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableTicketPrice(v *float64) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetTicketPrice(*v)
+	}
+	return _u
+}
+
+// AddTicketPrice adds value to the "ticket_price" field.
+func (_u *EventSeriesUpdate) AddTicketPrice(v float64) *EventSeriesUpdate {
+	_u.mutation.AddTicketPrice(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *EventSeriesUpdate) SetCurrency(v string) *EventSeriesUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableCurrency(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *EventSeriesUpdate) SetThumbnailURL(v string) *EventSeriesUpdate {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableThumbnailURL(v *string) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *EventSeriesUpdate) ClearThumbnailURL() *EventSeriesUpdate {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetIsPublic sets the "is_public" field.
+func (_u *EventSeriesUpdate) SetIsPublic(v bool) *EventSeriesUpdate {
+	_u.mutation.SetIsPublic(v)
+	return _u
+}
+
+// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableIsPublic(v *bool) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetIsPublic(*v)
+	}
+	return _u
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (_u *EventSeriesUpdate) SetRequiresApproval(v bool) *EventSeriesUpdate {
+	_u.mutation.SetRequiresApproval(v)
+	return _u
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableRequiresApproval(v *bool) *EventSeriesUpdate {
+	if v != nil {
+		_u.SetRequiresApproval(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventSeriesUpdate) SetUpdatedAt(v time.Time) *EventSeriesUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID.
+func (_u *EventSeriesUpdate) SetLinkedVenueID(id uuid.UUID) *EventSeriesUpdate {
+	_u.mutation.SetLinkedVenueID(id)
+	return _u
+}
+
+// SetNillableLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID if the given value is not nil.
+func (_u *EventSeriesUpdate) SetNillableLinkedVenueID(id *uuid.UUID) *EventSeriesUpdate {
+	if id != nil {
+		_u = _u.SetLinkedVenueID(*id)
+	}
+	return _u
+}
+
+// SetLinkedVenue sets the "linked_venue" edge to the Venue entity.
+func (_u *EventSeriesUpdate) SetLinkedVenue(v *Venue) *EventSeriesUpdate {
+	return _u.SetLinkedVenueID(v.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the Event entity by IDs.
+func (_u *EventSeriesUpdate) AddOccurrenceIDs(ids ...uuid.UUID) *EventSeriesUpdate {
+	_u.mutation.AddOccurrenceIDs(ids...)
+	return _u
+}
+
+// AddOccurrences adds the "occurrences" edges to the Event entity.
+func (_u *EventSeriesUpdate) AddOccurrences(v ...*Event) *EventSeriesUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOccurrenceIDs(ids...)
+}
+
+// Mutation returns the EventSeriesMutation object of the builder.
+func (_u *EventSeriesUpdate) Mutation() *EventSeriesMutation {
+	return _u.mutation
+}
+
+// ClearLinkedVenue clears the "linked_venue" edge to the Venue entity.
+func (_u *EventSeriesUpdate) ClearLinkedVenue() *EventSeriesUpdate {
+	_u.mutation.ClearLinkedVenue()
+	return _u
+}
+
+// ClearOccurrences clears all "occurrences" edges to the Event entity.
+func (_u *EventSeriesUpdate) ClearOccurrences() *EventSeriesUpdate {
+	_u.mutation.ClearOccurrences()
+	return _u
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to Event entities by IDs.
+func (_u *EventSeriesUpdate) RemoveOccurrenceIDs(ids ...uuid.UUID) *EventSeriesUpdate {
+	_u.mutation.RemoveOccurrenceIDs(ids...)
+	return _u
+}
+
+// RemoveOccurrences removes "occurrences" edges to Event entities.
+func (_u *EventSeriesUpdate) RemoveOccurrences(v ...*Event) *EventSeriesUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOccurrenceIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *EventSeriesUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventSeriesUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *EventSeriesUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventSeriesUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventSeriesUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventseries.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventSeriesUpdate) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := eventseries.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "EventSeries.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCity(); ok {
+		if err := eventseries.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressRegion(); ok {
+		if err := eventseries.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressPostalCode(); ok {
+		if err := eventseries.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCountry(); ok {
+		if err := eventseries.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := eventseries.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "EventSeries.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalTickets(); ok {
+		if err := eventseries.TotalTicketsValidator(v); err != nil {
+			return &ValidationError{Name: "total_tickets", err: fmt.Errorf(`ent: validator failed for field "EventSeries.total_tickets": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TicketPrice(); ok {
+		if err := eventseries.TicketPriceValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_price", err: fmt.Errorf(`ent: validator failed for field "EventSeries.ticket_price": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EventSeries.organization"`)
+	}
+	return nil
+}
+
+func (_u *EventSeriesUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventseries.Table, eventseries.Columns, sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(eventseries.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventseries.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(eventseries.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(eventseries.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(eventseries.FieldLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Venue(); ok {
+		_spec.SetField(eventseries.FieldVenue, field.TypeString, value)
+	}
+	if _u.mutation.VenueCleared() {
+		_spec.ClearField(eventseries.FieldVenue, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeatingLayout(); ok {
+		_spec.SetField(eventseries.FieldSeatingLayout, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressStreet(); ok {
+		_spec.SetField(eventseries.FieldAddressStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCity(); ok {
+		_spec.SetField(eventseries.FieldAddressCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressRegion(); ok {
+		_spec.SetField(eventseries.FieldAddressRegion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressPostalCode(); ok {
+		_spec.SetField(eventseries.FieldAddressPostalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCountry(); ok {
+		_spec.SetField(eventseries.FieldAddressCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(eventseries.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(eventseries.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(eventseries.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(eventseries.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(eventseries.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(eventseries.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(eventseries.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TotalTickets(); ok {
+		_spec.SetField(eventseries.FieldTotalTickets, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTickets(); ok {
+		_spec.AddField(eventseries.FieldTotalTickets, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TicketPrice(); ok {
+		_spec.SetField(eventseries.FieldTicketPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTicketPrice(); ok {
+		_spec.AddField(eventseries.FieldTicketPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(eventseries.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventseries.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(eventseries.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsPublic(); ok {
+		_spec.SetField(eventseries.FieldIsPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequiresApproval(); ok {
+		_spec.SetField(eventseries.FieldRequiresApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventseries.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinkedVenueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventseries.LinkedVenueTable,
+			Columns: []string{eventseries.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedVenueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventseries.LinkedVenueTable,
+			Columns: []string{eventseries.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventseries.OccurrencesTable,
+			Columns: []string{eventseries.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOccurrencesIDs(); len(nodes) > 0 && !_u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventseries.OccurrencesTable,
+			Columns: []string{eventseries.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventseries.OccurrencesTable,
+			Columns: []string{eventseries.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// EventSeriesUpdateOne is the builder for updating a single EventSeries entity.
+type EventSeriesUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *EventSeriesMutation
+}
+
+// SetTitle sets the "title" field.
+func (_u *EventSeriesUpdateOne) SetTitle(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableTitle(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *EventSeriesUpdateOne) SetDescription(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableDescription(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *EventSeriesUpdateOne) ClearDescription() *EventSeriesUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetLocation sets the "location" field.
+func (_u *EventSeriesUpdateOne) SetLocation(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetLocation(v)
+	return _u
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLocation(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetLocation(*v)
+	}
+	return _u
+}
+
+// ClearLocation clears the value of the "location" field.
+func (_u *EventSeriesUpdateOne) ClearLocation() *EventSeriesUpdateOne {
+	_u.mutation.ClearLocation()
+	return _u
+}
+
+// SetVenue sets the "venue" field.
+func (_u *EventSeriesUpdateOne) SetVenue(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetVenue(v)
+	return _u
+}
+
+// SetNillableVenue sets the "venue" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableVenue(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetVenue(*v)
+	}
+	return _u
+}
+
+// ClearVenue clears the value of the "venue" field.
+func (_u *EventSeriesUpdateOne) ClearVenue() *EventSeriesUpdateOne {
+	_u.mutation.ClearVenue()
+	return _u
+}
+
+// SetVenueID sets the "venue_id" field.
+func (_u *EventSeriesUpdateOne) SetVenueID(v uuid.UUID) *EventSeriesUpdateOne {
+	_u.mutation.SetVenueID(v)
+	return _u
+}
+
+// SetNillableVenueID sets the "venue_id" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableVenueID(v *uuid.UUID) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetVenueID(*v)
+	}
+	return _u
+}
+
+// ClearVenueID clears the value of the "venue_id" field.
+func (_u *EventSeriesUpdateOne) ClearVenueID() *EventSeriesUpdateOne {
+	_u.mutation.ClearVenueID()
+	return _u
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (_u *EventSeriesUpdateOne) SetSeatingLayout(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetSeatingLayout(v)
+	return _u
+}
+
+// SetNillableSeatingLayout sets the "seating_layout" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableSeatingLayout(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetSeatingLayout(*v)
+	}
+	return _u
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_u *EventSeriesUpdateOne) SetAddressStreet(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetAddressStreet(v)
+	return _u
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableAddressStreet(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetAddressStreet(*v)
+	}
+	return _u
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_u *EventSeriesUpdateOne) SetAddressCity(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetAddressCity(v)
+	return _u
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableAddressCity(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetAddressCity(*v)
+	}
+	return _u
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_u *EventSeriesUpdateOne) SetAddressRegion(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetAddressRegion(v)
+	return _u
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableAddressRegion(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetAddressRegion(*v)
+	}
+	return _u
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_u *EventSeriesUpdateOne) SetAddressPostalCode(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetAddressPostalCode(v)
+	return _u
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableAddressPostalCode(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetAddressPostalCode(*v)
+	}
+	return _u
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_u *EventSeriesUpdateOne) SetAddressCountry(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetAddressCountry(v)
+	return _u
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableAddressCountry(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetAddressCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *EventSeriesUpdateOne) SetLatitude(v float64) *EventSeriesUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLatitude(v *float64) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *EventSeriesUpdateOne) AddLatitude(v float64) *EventSeriesUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *EventSeriesUpdateOne) ClearLatitude() *EventSeriesUpdateOne {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *EventSeriesUpdateOne) SetLongitude(v float64) *EventSeriesUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLongitude(v *float64) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *EventSeriesUpdateOne) AddLongitude(v float64) *EventSeriesUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *EventSeriesUpdateOne) ClearLongitude() *EventSeriesUpdateOne {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetCategory sets the "category" field.
+func (_u *EventSeriesUpdateOne) SetCategory(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetCategory(v)
+	return _u
+}
+
+// SetNillableCategory sets the "category" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableCategory(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetCategory(*v)
+	}
+	return _u
+}
+
+// SetTotalTickets sets the "total_tickets" field.
+func (_u *EventSeriesUpdateOne) SetTotalTickets(v int) *EventSeriesUpdateOne {
+	_u.mutation.ResetTotalTickets()
+	_u.mutation.SetTotalTickets(v)
+	return _u
+}
+
+// SetNillableTotalTickets sets the "total_tickets" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableTotalTickets(v *int) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetTotalTickets(*v)
+	}
+	return _u
+}
+
+// AddTotalTickets adds value to the "total_tickets" field.
+func (_u *EventSeriesUpdateOne) AddTotalTickets(v int) *EventSeriesUpdateOne {
+	_u.mutation.AddTotalTickets(v)
+	return _u
+}
+
+// SetTicketPrice sets the "ticket_price" field.
+func (_u *EventSeriesUpdateOne) SetTicketPrice(v float64) *EventSeriesUpdateOne {
+	_u.mutation.ResetTicketPrice()
+	_u.mutation.SetTicketPrice(v)
+	return _u
+}
+
+// SetNillableTicketPrice sets the "ticket_price" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableTicketPrice(v *float64) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetTicketPrice(*v)
+	}
+	return _u
+}
+
+// AddTicketPrice adds value to the "ticket_price" field.
+func (_u *EventSeriesUpdateOne) AddTicketPrice(v float64) *EventSeriesUpdateOne {
+	_u.mutation.AddTicketPrice(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *EventSeriesUpdateOne) SetCurrency(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableCurrency(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (_u *EventSeriesUpdateOne) SetThumbnailURL(v string) *EventSeriesUpdateOne {
+	_u.mutation.SetThumbnailURL(v)
+	return _u
+}
+
+// SetNillableThumbnailURL sets the "thumbnail_url" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableThumbnailURL(v *string) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetThumbnailURL(*v)
+	}
+	return _u
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (_u *EventSeriesUpdateOne) ClearThumbnailURL() *EventSeriesUpdateOne {
+	_u.mutation.ClearThumbnailURL()
+	return _u
+}
+
+// SetIsPublic sets the "is_public" field.
+func (_u *EventSeriesUpdateOne) SetIsPublic(v bool) *EventSeriesUpdateOne {
+	_u.mutation.SetIsPublic(v)
+	return _u
+}
+
+// SetNillableIsPublic sets the "is_public" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableIsPublic(v *bool) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetIsPublic(*v)
+	}
+	return _u
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (_u *EventSeriesUpdateOne) SetRequiresApproval(v bool) *EventSeriesUpdateOne {
+	_u.mutation.SetRequiresApproval(v)
+	return _u
+}
+
+// SetNillableRequiresApproval sets the "requires_approval" field if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableRequiresApproval(v *bool) *EventSeriesUpdateOne {
+	if v != nil {
+		_u.SetRequiresApproval(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *EventSeriesUpdateOne) SetUpdatedAt(v time.Time) *EventSeriesUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID.
+func (_u *EventSeriesUpdateOne) SetLinkedVenueID(id uuid.UUID) *EventSeriesUpdateOne {
+	_u.mutation.SetLinkedVenueID(id)
+	return _u
+}
+
+// SetNillableLinkedVenueID sets the "linked_venue" edge to the Venue entity by ID if the given value is not nil.
+func (_u *EventSeriesUpdateOne) SetNillableLinkedVenueID(id *uuid.UUID) *EventSeriesUpdateOne {
+	if id != nil {
+		_u = _u.SetLinkedVenueID(*id)
+	}
+	return _u
+}
+
+// SetLinkedVenue sets the "linked_venue" edge to the Venue entity.
+func (_u *EventSeriesUpdateOne) SetLinkedVenue(v *Venue) *EventSeriesUpdateOne {
+	return _u.SetLinkedVenueID(v.ID)
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the Event entity by IDs.
+func (_u *EventSeriesUpdateOne) AddOccurrenceIDs(ids ...uuid.UUID) *EventSeriesUpdateOne {
+	_u.mutation.AddOccurrenceIDs(ids...)
+	return _u
+}
+
+// AddOccurrences adds the "occurrences" edges to the Event entity.
+func (_u *EventSeriesUpdateOne) AddOccurrences(v ...*Event) *EventSeriesUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOccurrenceIDs(ids...)
+}
+
+// Mutation returns the EventSeriesMutation object of the builder.
+func (_u *EventSeriesUpdateOne) Mutation() *EventSeriesMutation {
+	return _u.mutation
+}
+
+// ClearLinkedVenue clears the "linked_venue" edge to the Venue entity.
+func (_u *EventSeriesUpdateOne) ClearLinkedVenue() *EventSeriesUpdateOne {
+	_u.mutation.ClearLinkedVenue()
+	return _u
+}
+
+// ClearOccurrences clears all "occurrences" edges to the Event entity.
+func (_u *EventSeriesUpdateOne) ClearOccurrences() *EventSeriesUpdateOne {
+	_u.mutation.ClearOccurrences()
+	return _u
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to Event entities by IDs.
+func (_u *EventSeriesUpdateOne) RemoveOccurrenceIDs(ids ...uuid.UUID) *EventSeriesUpdateOne {
+	_u.mutation.RemoveOccurrenceIDs(ids...)
+	return _u
+}
+
+// RemoveOccurrences removes "occurrences" edges to Event entities.
+func (_u *EventSeriesUpdateOne) RemoveOccurrences(v ...*Event) *EventSeriesUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOccurrenceIDs(ids...)
+}
+
+// Where appends a list predicates to the EventSeriesUpdate builder.
+func (_u *EventSeriesUpdateOne) Where(ps ...predicate.EventSeries) *EventSeriesUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *EventSeriesUpdateOne) Select(field string, fields ...string) *EventSeriesUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated EventSeries entity.
+func (_u *EventSeriesUpdateOne) Save(ctx context.Context) (*EventSeries, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *EventSeriesUpdateOne) SaveX(ctx context.Context) *EventSeries {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *EventSeriesUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *EventSeriesUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *EventSeriesUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := eventseries.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *EventSeriesUpdateOne) check() error {
+	if v, ok := _u.mutation.Title(); ok {
+		if err := eventseries.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "EventSeries.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCity(); ok {
+		if err := eventseries.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressRegion(); ok {
+		if err := eventseries.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressPostalCode(); ok {
+		if err := eventseries.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCountry(); ok {
+		if err := eventseries.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "EventSeries.address_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Category(); ok {
+		if err := eventseries.CategoryValidator(v); err != nil {
+			return &ValidationError{Name: "category", err: fmt.Errorf(`ent: validator failed for field "EventSeries.category": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TotalTickets(); ok {
+		if err := eventseries.TotalTicketsValidator(v); err != nil {
+			return &ValidationError{Name: "total_tickets", err: fmt.Errorf(`ent: validator failed for field "EventSeries.total_tickets": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TicketPrice(); ok {
+		if err := eventseries.TicketPriceValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_price", err: fmt.Errorf(`ent: validator failed for field "EventSeries.ticket_price": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "EventSeries.organization"`)
+	}
+	return nil
+}
+
+func (_u *EventSeriesUpdateOne) sqlSave(ctx context.Context) (_node *EventSeries, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(eventseries.Table, eventseries.Columns, sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "EventSeries.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, eventseries.FieldID)
+		for _, f := range fields {
+			if !eventseries.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != eventseries.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(eventseries.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(eventseries.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(eventseries.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Location(); ok {
+		_spec.SetField(eventseries.FieldLocation, field.TypeString, value)
+	}
+	if _u.mutation.LocationCleared() {
+		_spec.ClearField(eventseries.FieldLocation, field.TypeString)
+	}
+	if value, ok := _u.mutation.Venue(); ok {
+		_spec.SetField(eventseries.FieldVenue, field.TypeString, value)
+	}
+	if _u.mutation.VenueCleared() {
+		_spec.ClearField(eventseries.FieldVenue, field.TypeString)
+	}
+	if value, ok := _u.mutation.SeatingLayout(); ok {
+		_spec.SetField(eventseries.FieldSeatingLayout, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressStreet(); ok {
+		_spec.SetField(eventseries.FieldAddressStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCity(); ok {
+		_spec.SetField(eventseries.FieldAddressCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressRegion(); ok {
+		_spec.SetField(eventseries.FieldAddressRegion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressPostalCode(); ok {
+		_spec.SetField(eventseries.FieldAddressPostalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCountry(); ok {
+		_spec.SetField(eventseries.FieldAddressCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(eventseries.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(eventseries.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(eventseries.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(eventseries.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(eventseries.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(eventseries.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Category(); ok {
+		_spec.SetField(eventseries.FieldCategory, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.TotalTickets(); ok {
+		_spec.SetField(eventseries.FieldTotalTickets, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTotalTickets(); ok {
+		_spec.AddField(eventseries.FieldTotalTickets, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TicketPrice(); ok {
+		_spec.SetField(eventseries.FieldTicketPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTicketPrice(); ok {
+		_spec.AddField(eventseries.FieldTicketPrice, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(eventseries.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ThumbnailURL(); ok {
+		_spec.SetField(eventseries.FieldThumbnailURL, field.TypeString, value)
+	}
+	if _u.mutation.ThumbnailURLCleared() {
+		_spec.ClearField(eventseries.FieldThumbnailURL, field.TypeString)
+	}
+	if value, ok := _u.mutation.IsPublic(); ok {
+		_spec.SetField(eventseries.FieldIsPublic, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.RequiresApproval(); ok {
+		_spec.SetField(eventseries.FieldRequiresApproval, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(eventseries.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LinkedVenueCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventseries.LinkedVenueTable,
+			Columns: []string{eventseries.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.LinkedVenueIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   eventseries.LinkedVenueTable,
+			Columns: []string{eventseries.LinkedVenueColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventseries.OccurrencesTable,
+			Columns: []string{eventseries.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOccurrencesIDs(); len(nodes) > 0 && !_u.mutation.OccurrencesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventseries.OccurrencesTable,
+			Columns: []string{eventseries.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OccurrencesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   eventseries.OccurrencesTable,
+			Columns: []string{eventseries.OccurrencesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &EventSeries{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{eventseries.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -3,11 +3,116 @@
 package migrate
 
 import (
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/dialect/sql/schema"
 	"entgo.io/ent/schema/field"
 )
 
 var (
+	// APIKeysColumns holds the columns for the "api_keys" table.
+	APIKeysColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "prefix", Type: field.TypeString, Unique: true},
+		{Name: "secret_hash", Type: field.TypeString},
+		{Name: "scopes", Type: field.TypeJSON},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// APIKeysTable holds the schema information for the "api_keys" table.
+	APIKeysTable = &schema.Table{
+		Name:       "api_keys",
+		Columns:    APIKeysColumns,
+		PrimaryKey: []*schema.Column{APIKeysColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "api_keys_organizations_api_keys",
+				Columns:    []*schema.Column{APIKeysColumns[11]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "apikey_organization_id",
+				Unique:  false,
+				Columns: []*schema.Column{APIKeysColumns[11]},
+			},
+		},
+	}
+	// AuditLogsColumns holds the columns for the "audit_logs" table.
+	AuditLogsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "actor_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "action", Type: field.TypeString},
+		{Name: "target_type", Type: field.TypeString},
+		{Name: "target_id", Type: field.TypeString, Nullable: true},
+		{Name: "before", Type: field.TypeJSON, Nullable: true},
+		{Name: "after", Type: field.TypeJSON, Nullable: true},
+		{Name: "metadata", Type: field.TypeJSON, Nullable: true},
+		{Name: "ip", Type: field.TypeString, Nullable: true},
+		{Name: "user_agent", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditLogsTable holds the schema information for the "audit_logs" table.
+	AuditLogsTable = &schema.Table{
+		Name:       "audit_logs",
+		Columns:    AuditLogsColumns,
+		PrimaryKey: []*schema.Column{AuditLogsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditlog_organization_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[1], AuditLogsColumns[11]},
+			},
+			{
+				Name:    "auditlog_target_type_target_id",
+				Unique:  false,
+				Columns: []*schema.Column{AuditLogsColumns[4], AuditLogsColumns[5]},
+			},
+		},
+	}
+	// CancellationRefundsColumns holds the columns for the "cancellation_refunds" table.
+	CancellationRefundsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "payment_id", Type: field.TypeUUID, Unique: true},
+		{Name: "method", Type: field.TypeEnum, Enums: []string{"gateway", "manual", "none"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "awaiting_confirmation", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "refunded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "cancellation_id", Type: field.TypeUUID},
+	}
+	// CancellationRefundsTable holds the schema information for the "cancellation_refunds" table.
+	CancellationRefundsTable = &schema.Table{
+		Name:       "cancellation_refunds",
+		Columns:    CancellationRefundsColumns,
+		PrimaryKey: []*schema.Column{CancellationRefundsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "cancellation_refunds_event_cancellations_refunds",
+				Columns:    []*schema.Column{CancellationRefundsColumns[10]},
+				RefColumns: []*schema.Column{EventCancellationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "cancellationrefund_cancellation_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{CancellationRefundsColumns[10], CancellationRefundsColumns[3]},
+			},
+		},
+	}
 	// EventsColumns holds the columns for the "events" table.
 	EventsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -15,6 +120,18 @@ var (
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "location", Type: field.TypeString, Nullable: true},
 		{Name: "venue", Type: field.TypeString, Nullable: true},
+		{Name: "seating_layout", Type: field.TypeString, Default: ""},
+		{Name: "address_street", Type: field.TypeString, Default: ""},
+		{Name: "address_city", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "address_region", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "address_postal_code", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "address_country", Type: field.TypeString, Size: 2, Default: ""},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "geohash", Type: field.TypeString, Size: 12, Default: ""},
+		{Name: "category", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "title_initials", Type: field.TypeString, Default: ""},
+		{Name: "venue_initials", Type: field.TypeString, Default: ""},
 		{Name: "start_time", Type: field.TypeTime},
 		{Name: "end_time", Type: field.TypeTime},
 		{Name: "total_tickets", Type: field.TypeInt, Default: 0},
@@ -24,11 +141,22 @@ var (
 		{Name: "currency", Type: field.TypeString, Default: "KRW"},
 		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"draft", "published", "ongoing", "completed", "cancelled"}, Default: "draft"},
+		{Name: "review_status", Type: field.TypeEnum, Enums: []string{"not_submitted", "pending", "changes_requested", "approved", "rejected"}, Default: "not_submitted"},
+		{Name: "review_comment", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "submitted_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reviewed_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "reviewed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "publish_at", Type: field.TypeTime, Nullable: true},
+		{Name: "date_change_refund_until", Type: field.TypeTime, Nullable: true},
 		{Name: "is_public", Type: field.TypeBool, Default: true},
+		{Name: "requires_approval", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "series_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "organization_id", Type: field.TypeUUID},
 		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "venue_id", Type: field.TypeUUID, Nullable: true},
 	}
 	// EventsTable holds the schema information for the "events" table.
 	EventsTable = &schema.Table{
@@ -36,28 +164,242 @@ var (
 		Columns:    EventsColumns,
 		PrimaryKey: []*schema.Column{EventsColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "events_event_series_occurrences",
+				Columns:    []*schema.Column{EventsColumns[38]},
+				RefColumns: []*schema.Column{EventSeriesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "events_organizations_events",
-				Columns:    []*schema.Column{EventsColumns[17]},
+				Columns:    []*schema.Column{EventsColumns[39]},
 				RefColumns: []*schema.Column{OrganizationsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "events_users_created_events",
-				Columns:    []*schema.Column{EventsColumns[18]},
+				Columns:    []*schema.Column{EventsColumns[40]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
+			{
+				Symbol:     "events_venues_events",
+				Columns:    []*schema.Column{EventsColumns[41]},
+				RefColumns: []*schema.Column{VenuesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "event_status_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[25], EventsColumns[17]},
+			},
+			{
+				Name:    "event_status_end_time",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[25], EventsColumns[18]},
+			},
+			{
+				Name:    "event_status_publish_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[25], EventsColumns[32]},
+			},
+			{
+				Name:    "event_series_id_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[38], EventsColumns[17]},
+			},
+			{
+				Name:    "event_category_start_time",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[14], EventsColumns[17]},
+			},
+			{
+				Name:    "event_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[36]},
+			},
+			{
+				Name:    "event_ticket_price",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[22]},
+			},
+			{
+				Name:    "event_participant_count",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[21]},
+			},
+			{
+				Name:    "event_geohash",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[13]},
+			},
+			{
+				Name:    "event_title_description_location_venue",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[1], EventsColumns[2], EventsColumns[3], EventsColumns[4]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "FULLTEXT",
+				},
+			},
+			{
+				Name:    "event_title",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "FULLTEXT",
+				},
+			},
+			{
+				Name:    "event_title_initials",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[15]},
+			},
+			{
+				Name:    "event_venue_initials",
+				Unique:  false,
+				Columns: []*schema.Column{EventsColumns[16]},
+			},
+		},
+	}
+	// EventCancellationsColumns holds the columns for the "event_cancellations" table.
+	EventCancellationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "event_id", Type: field.TypeUUID, Unique: true},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "requested_by", Type: field.TypeUUID},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"running", "completed"}, Default: "running"},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// EventCancellationsTable holds the schema information for the "event_cancellations" table.
+	EventCancellationsTable = &schema.Table{
+		Name:       "event_cancellations",
+		Columns:    EventCancellationsColumns,
+		PrimaryKey: []*schema.Column{EventCancellationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventcancellation_status",
+				Unique:  false,
+				Columns: []*schema.Column{EventCancellationsColumns[5]},
+			},
+		},
+	}
+	// EventChangesColumns holds the columns for the "event_changes" table.
+	EventChangesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "changed_by", Type: field.TypeUUID},
+		{Name: "before", Type: field.TypeJSON},
+		{Name: "after", Type: field.TypeJSON},
+		{Name: "notified_count", Type: field.TypeInt, Default: 0},
+		{Name: "refund_until", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EventChangesTable holds the schema information for the "event_changes" table.
+	EventChangesTable = &schema.Table{
+		Name:       "event_changes",
+		Columns:    EventChangesColumns,
+		PrimaryKey: []*schema.Column{EventChangesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventchange_event_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventChangesColumns[1], EventChangesColumns[7]},
+			},
+		},
+	}
+	// EventFavoritesColumns holds the columns for the "event_favorites" table.
+	EventFavoritesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// EventFavoritesTable holds the schema information for the "event_favorites" table.
+	EventFavoritesTable = &schema.Table{
+		Name:       "event_favorites",
+		Columns:    EventFavoritesColumns,
+		PrimaryKey: []*schema.Column{EventFavoritesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "eventfavorite_user_id_event_id",
+				Unique:  true,
+				Columns: []*schema.Column{EventFavoritesColumns[1], EventFavoritesColumns[2]},
+			},
+			{
+				Name:    "eventfavorite_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{EventFavoritesColumns[1], EventFavoritesColumns[3]},
+			},
+		},
+	}
+	// EventSeriesColumns holds the columns for the "event_series" table.
+	EventSeriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "venue", Type: field.TypeString, Nullable: true},
+		{Name: "seating_layout", Type: field.TypeString, Default: ""},
+		{Name: "address_street", Type: field.TypeString, Default: ""},
+		{Name: "address_city", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "address_region", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "address_postal_code", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "address_country", Type: field.TypeString, Size: 2, Default: ""},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "category", Type: field.TypeString, Size: 50, Default: ""},
+		{Name: "start_time", Type: field.TypeTime},
+		{Name: "end_time", Type: field.TypeTime},
+		{Name: "recurrence", Type: field.TypeString, Size: 2147483647},
+		{Name: "total_tickets", Type: field.TypeInt, Default: 0},
+		{Name: "ticket_price", Type: field.TypeFloat64, Default: 0},
+		{Name: "currency", Type: field.TypeString, Default: "KRW"},
+		{Name: "thumbnail_url", Type: field.TypeString, Nullable: true},
+		{Name: "is_public", Type: field.TypeBool, Default: true},
+		{Name: "requires_approval", Type: field.TypeBool, Default: false},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "venue_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// EventSeriesTable holds the schema information for the "event_series" table.
+	EventSeriesTable = &schema.Table{
+		Name:       "event_series",
+		Columns:    EventSeriesColumns,
+		PrimaryKey: []*schema.Column{EventSeriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "event_series_organizations_event_series",
+				Columns:    []*schema.Column{EventSeriesColumns[26]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+			{
+				Symbol:     "event_series_venues_event_series",
+				Columns:    []*schema.Column{EventSeriesColumns[27]},
+				RefColumns: []*schema.Column{VenuesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 		},
 	}
 	// OrganizationsColumns holds the columns for the "organizations" table.
 	OrganizationsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "name", Type: field.TypeString},
+		{Name: "name_initials", Type: field.TypeString, Default: ""},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
 		{Name: "logo_url", Type: field.TypeString, Nullable: true},
 		{Name: "category", Type: field.TypeString, Nullable: true},
+		{Name: "slug", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "social_links", Type: field.TypeJSON, Nullable: true},
 		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "deleted_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "owner_id", Type: field.TypeUUID},
@@ -70,16 +412,71 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "organizations_users_owned_organizations",
-				Columns:    []*schema.Column{OrganizationsColumns[8]},
+				Columns:    []*schema.Column{OrganizationsColumns[12]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organization_name",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationsColumns[1]},
+				Annotation: &entsql.IndexAnnotation{
+					Type: "FULLTEXT",
+				},
+			},
+			{
+				Name:    "organization_name_initials",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationsColumns[2]},
+			},
+		},
+	}
+	// OrganizationInvitationsColumns holds the columns for the "organization_invitations" table.
+	OrganizationInvitationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "email", Type: field.TypeString},
+		{Name: "role", Type: field.TypeString},
+		{Name: "invited_by", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "revoked"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// OrganizationInvitationsTable holds the schema information for the "organization_invitations" table.
+	OrganizationInvitationsTable = &schema.Table{
+		Name:       "organization_invitations",
+		Columns:    OrganizationInvitationsColumns,
+		PrimaryKey: []*schema.Column{OrganizationInvitationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_invitations_organizations_invitations",
+				Columns:    []*schema.Column{OrganizationInvitationsColumns[10]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationinvitation_organization_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationInvitationsColumns[10], OrganizationInvitationsColumns[4]},
+			},
+			{
+				Name:    "organizationinvitation_email_status",
+				Unique:  false,
+				Columns: []*schema.Column{OrganizationInvitationsColumns[1], OrganizationInvitationsColumns[4]},
+			},
+		},
 	}
 	// OrganizationMembersColumns holds the columns for the "organization_members" table.
 	OrganizationMembersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
-		{Name: "role", Type: field.TypeEnum, Enums: []string{"admin", "member"}, Default: "member"},
+		{Name: "role", Type: field.TypeString, Default: "viewer"},
 		{Name: "joined_at", Type: field.TypeTime},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
@@ -106,6 +503,70 @@ var (
 			},
 		},
 	}
+	// OrganizationRolesColumns holds the columns for the "organization_roles" table.
+	OrganizationRolesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "description", Type: field.TypeString, Nullable: true},
+		{Name: "permissions", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// OrganizationRolesTable holds the schema information for the "organization_roles" table.
+	OrganizationRolesTable = &schema.Table{
+		Name:       "organization_roles",
+		Columns:    OrganizationRolesColumns,
+		PrimaryKey: []*schema.Column{OrganizationRolesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "organization_roles_organizations_roles",
+				Columns:    []*schema.Column{OrganizationRolesColumns[6]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "organizationrole_organization_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{OrganizationRolesColumns[6], OrganizationRolesColumns[1]},
+			},
+		},
+	}
+	// OwnershipTransfersColumns holds the columns for the "ownership_transfers" table.
+	OwnershipTransfersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "from_user_id", Type: field.TypeUUID},
+		{Name: "to_user_id", Type: field.TypeUUID},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "accepted", "declined", "cancelled"}, Default: "pending"},
+		{Name: "expires_at", Type: field.TypeTime},
+		{Name: "responded_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// OwnershipTransfersTable holds the schema information for the "ownership_transfers" table.
+	OwnershipTransfersTable = &schema.Table{
+		Name:       "ownership_transfers",
+		Columns:    OwnershipTransfersColumns,
+		PrimaryKey: []*schema.Column{OwnershipTransfersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "ownership_transfers_organizations_ownership_transfers",
+				Columns:    []*schema.Column{OwnershipTransfersColumns[8]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "ownershiptransfer_organization_id_status",
+				Unique:  false,
+				Columns: []*schema.Column{OwnershipTransfersColumns[8], OwnershipTransfersColumns[3]},
+			},
+		},
+	}
 	// PaymentsColumns holds the columns for the "payments" table.
 	PaymentsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -119,6 +580,11 @@ var (
 		{Name: "payment_key", Type: field.TypeString, Nullable: true},
 		{Name: "order_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "completed", "failed", "cancelled", "refunded"}, Default: "pending"},
+		{Name: "channel", Type: field.TypeEnum, Enums: []string{"online", "box_office", "rsvp"}, Default: "online"},
+		{Name: "payment_method", Type: field.TypeEnum, Enums: []string{"gateway", "cash", "card_terminal", "transfer", "free"}, Default: "gateway"},
+		{Name: "operator_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "receipt_number", Type: field.TypeString, Nullable: true},
+		{Name: "checked_in_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "event_id", Type: field.TypeUUID},
@@ -132,18 +598,68 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "payments_events_payments",
-				Columns:    []*schema.Column{PaymentsColumns[13]},
+				Columns:    []*schema.Column{PaymentsColumns[18]},
 				RefColumns: []*schema.Column{EventsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "payments_users_payments",
-				Columns:    []*schema.Column{PaymentsColumns[14]},
+				Columns:    []*schema.Column{PaymentsColumns[19]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 		},
 	}
+	// RegistrationAnswersColumns holds the columns for the "registration_answers" table.
+	RegistrationAnswersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "ticket_index", Type: field.TypeInt},
+		{Name: "question_id", Type: field.TypeUUID},
+		{Name: "question_label", Type: field.TypeString},
+		{Name: "values", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "payment_id", Type: field.TypeUUID},
+	}
+	// RegistrationAnswersTable holds the schema information for the "registration_answers" table.
+	RegistrationAnswersTable = &schema.Table{
+		Name:       "registration_answers",
+		Columns:    RegistrationAnswersColumns,
+		PrimaryKey: []*schema.Column{RegistrationAnswersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "registration_answers_payments_registration_answers",
+				Columns:    []*schema.Column{RegistrationAnswersColumns[6]},
+				RefColumns: []*schema.Column{PaymentsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
+	// RegistrationQuestionsColumns holds the columns for the "registration_questions" table.
+	RegistrationQuestionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "label", Type: field.TypeString},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"text", "select", "multi_select", "checkbox", "date"}},
+		{Name: "options", Type: field.TypeJSON, Nullable: true},
+		{Name: "required", Type: field.TypeBool, Default: false},
+		{Name: "position", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "event_id", Type: field.TypeUUID},
+	}
+	// RegistrationQuestionsTable holds the schema information for the "registration_questions" table.
+	RegistrationQuestionsTable = &schema.Table{
+		Name:       "registration_questions",
+		Columns:    RegistrationQuestionsColumns,
+		PrimaryKey: []*schema.Column{RegistrationQuestionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "registration_questions_events_registration_questions",
+				Columns:    []*schema.Column{RegistrationQuestionsColumns[8]},
+				RefColumns: []*schema.Column{EventsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -164,22 +680,175 @@ var (
 		Columns:    UsersColumns,
 		PrimaryKey: []*schema.Column{UsersColumns[0]},
 	}
+	// VenuesColumns holds the columns for the "venues" table.
+	VenuesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "address_street", Type: field.TypeString, Default: ""},
+		{Name: "address_city", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "address_region", Type: field.TypeString, Size: 100, Default: ""},
+		{Name: "address_postal_code", Type: field.TypeString, Size: 20, Default: ""},
+		{Name: "address_country", Type: field.TypeString, Size: 2, Default: ""},
+		{Name: "latitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "longitude", Type: field.TypeFloat64, Nullable: true},
+		{Name: "capacity", Type: field.TypeInt},
+		{Name: "seating_layouts", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "directions", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "parking", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "accessibility", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID, Nullable: true},
+	}
+	// VenuesTable holds the schema information for the "venues" table.
+	VenuesTable = &schema.Table{
+		Name:       "venues",
+		Columns:    VenuesColumns,
+		PrimaryKey: []*schema.Column{VenuesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "venues_organizations_venues",
+				Columns:    []*schema.Column{VenuesColumns[17]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "venue_organization_id_name",
+				Unique:  false,
+				Columns: []*schema.Column{VenuesColumns[17], VenuesColumns[1]},
+			},
+		},
+	}
+	// WebhookDeliveriesColumns holds the columns for the "webhook_deliveries" table.
+	WebhookDeliveriesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "organization_id", Type: field.TypeUUID},
+		{Name: "event_id", Type: field.TypeUUID},
+		{Name: "event_type", Type: field.TypeString},
+		{Name: "payload", Type: field.TypeString, Size: 2147483647},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "succeeded", "failed"}, Default: "pending"},
+		{Name: "attempts", Type: field.TypeInt, Default: 0},
+		{Name: "last_status_code", Type: field.TypeInt, Nullable: true},
+		{Name: "last_error", Type: field.TypeString, Nullable: true, Size: 1000},
+		{Name: "next_attempt_at", Type: field.TypeTime, Nullable: true},
+		{Name: "delivered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "replay_of", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "endpoint_id", Type: field.TypeUUID},
+	}
+	// WebhookDeliveriesTable holds the schema information for the "webhook_deliveries" table.
+	WebhookDeliveriesTable = &schema.Table{
+		Name:       "webhook_deliveries",
+		Columns:    WebhookDeliveriesColumns,
+		PrimaryKey: []*schema.Column{WebhookDeliveriesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_deliveries_webhook_endpoints_deliveries",
+				Columns:    []*schema.Column{WebhookDeliveriesColumns[14]},
+				RefColumns: []*schema.Column{WebhookEndpointsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookdelivery_endpoint_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[14], WebhookDeliveriesColumns[12]},
+			},
+			{
+				Name:    "webhookdelivery_organization_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[1], WebhookDeliveriesColumns[12]},
+			},
+			{
+				Name:    "webhookdelivery_status",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookDeliveriesColumns[5]},
+			},
+		},
+	}
+	// WebhookEndpointsColumns holds the columns for the "webhook_endpoints" table.
+	WebhookEndpointsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "url", Type: field.TypeString, Size: 2048},
+		{Name: "secret", Type: field.TypeString},
+		{Name: "event_types", Type: field.TypeJSON},
+		{Name: "is_active", Type: field.TypeBool, Default: true},
+		{Name: "created_by", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "organization_id", Type: field.TypeUUID},
+	}
+	// WebhookEndpointsTable holds the schema information for the "webhook_endpoints" table.
+	WebhookEndpointsTable = &schema.Table{
+		Name:       "webhook_endpoints",
+		Columns:    WebhookEndpointsColumns,
+		PrimaryKey: []*schema.Column{WebhookEndpointsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "webhook_endpoints_organizations_webhook_endpoints",
+				Columns:    []*schema.Column{WebhookEndpointsColumns[8]},
+				RefColumns: []*schema.Column{OrganizationsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "webhookendpoint_organization_id_is_active",
+				Unique:  false,
+				Columns: []*schema.Column{WebhookEndpointsColumns[8], WebhookEndpointsColumns[4]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		APIKeysTable,
+		AuditLogsTable,
+		CancellationRefundsTable,
 		EventsTable,
+		EventCancellationsTable,
+		EventChangesTable,
+		EventFavoritesTable,
+		EventSeriesTable,
 		OrganizationsTable,
+		OrganizationInvitationsTable,
 		OrganizationMembersTable,
+		OrganizationRolesTable,
+		OwnershipTransfersTable,
 		PaymentsTable,
+		RegistrationAnswersTable,
+		RegistrationQuestionsTable,
 		UsersTable,
+		VenuesTable,
+		WebhookDeliveriesTable,
+		WebhookEndpointsTable,
 	}
 )
 
 func init() {
-	EventsTable.ForeignKeys[0].RefTable = OrganizationsTable
-	EventsTable.ForeignKeys[1].RefTable = UsersTable
+	APIKeysTable.ForeignKeys[0].RefTable = OrganizationsTable
+	CancellationRefundsTable.ForeignKeys[0].RefTable = EventCancellationsTable
+	EventsTable.ForeignKeys[0].RefTable = EventSeriesTable
+	EventsTable.ForeignKeys[1].RefTable = OrganizationsTable
+	EventsTable.ForeignKeys[2].RefTable = UsersTable
+	EventsTable.ForeignKeys[3].RefTable = VenuesTable
+	EventSeriesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	EventSeriesTable.ForeignKeys[1].RefTable = VenuesTable
 	OrganizationsTable.ForeignKeys[0].RefTable = UsersTable
+	OrganizationInvitationsTable.ForeignKeys[0].RefTable = OrganizationsTable
 	OrganizationMembersTable.ForeignKeys[0].RefTable = OrganizationsTable
 	OrganizationMembersTable.ForeignKeys[1].RefTable = UsersTable
+	OrganizationRolesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	OwnershipTransfersTable.ForeignKeys[0].RefTable = OrganizationsTable
 	PaymentsTable.ForeignKeys[0].RefTable = EventsTable
 	PaymentsTable.ForeignKeys[1].RefTable = UsersTable
+	RegistrationAnswersTable.ForeignKeys[0].RefTable = PaymentsTable
+	RegistrationQuestionsTable.ForeignKeys[0].RefTable = EventsTable
+	VenuesTable.ForeignKeys[0].RefTable = OrganizationsTable
+	WebhookDeliveriesTable.ForeignKeys[0].RefTable = WebhookEndpointsTable
+	WebhookEndpointsTable.ForeignKeys[0].RefTable = OrganizationsTable
 }
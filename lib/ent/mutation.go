@@ -11,12 +11,27 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/apikey"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/auditlog"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventchange"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventfavorite"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationmember"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationrole"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookdelivery"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookendpoint"
 	"github.com/google/uuid"
 )
 
@@ -29,63 +44,64 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeEvent              = "Event"
-	TypeOrganization       = "Organization"
-	TypeOrganizationMember = "OrganizationMember"
-	TypePayment            = "Payment"
-	TypeUser               = "User"
+	TypeAPIKey                 = "APIKey"
+	TypeAuditLog               = "AuditLog"
+	TypeCancellationRefund     = "CancellationRefund"
+	TypeEvent                  = "Event"
+	TypeEventCancellation      = "EventCancellation"
+	TypeEventChange            = "EventChange"
+	TypeEventFavorite          = "EventFavorite"
+	TypeEventSeries            = "EventSeries"
+	TypeOrganization           = "Organization"
+	TypeOrganizationInvitation = "OrganizationInvitation"
+	TypeOrganizationMember     = "OrganizationMember"
+	TypeOrganizationRole       = "OrganizationRole"
+	TypeOwnershipTransfer      = "OwnershipTransfer"
+	TypePayment                = "Payment"
+	TypeRegistrationAnswer     = "RegistrationAnswer"
+	TypeRegistrationQuestion   = "RegistrationQuestion"
+	TypeUser                   = "User"
+	TypeVenue                  = "Venue"
+	TypeWebhookDelivery        = "WebhookDelivery"
+	TypeWebhookEndpoint        = "WebhookEndpoint"
 )
 
-// EventMutation represents an operation that mutates the Event nodes in the graph.
-type EventMutation struct {
+// APIKeyMutation represents an operation that mutates the APIKey nodes in the graph.
+type APIKeyMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	title                *string
-	description          *string
-	location             *string
-	venue                *string
-	start_time           *time.Time
-	end_time             *time.Time
-	total_tickets        *int
-	addtotal_tickets     *int
-	available_tickets    *int
-	addavailable_tickets *int
-	participant_count    *int
-	addparticipant_count *int
-	ticket_price         *float64
-	addticket_price      *float64
-	currency             *string
-	thumbnail_url        *string
-	status               *event.Status
-	is_public            *bool
-	created_at           *time.Time
-	updated_at           *time.Time
-	clearedFields        map[string]struct{}
-	organization         *uuid.UUID
-	clearedorganization  bool
-	creator              *uuid.UUID
-	clearedcreator       bool
-	payments             map[uuid.UUID]struct{}
-	removedpayments      map[uuid.UUID]struct{}
-	clearedpayments      bool
-	done                 bool
-	oldValue             func(context.Context) (*Event, error)
-	predicates           []predicate.Event
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	name                *string
+	prefix              *string
+	secret_hash         *string
+	scopes              *[]string
+	appendscopes        []string
+	created_by          *uuid.UUID
+	expires_at          *time.Time
+	last_used_at        *time.Time
+	revoked_at          *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*APIKey, error)
+	predicates          []predicate.APIKey
 }
 
-var _ ent.Mutation = (*EventMutation)(nil)
+var _ ent.Mutation = (*APIKeyMutation)(nil)
 
-// eventOption allows management of the mutation configuration using functional options.
-type eventOption func(*EventMutation)
+// apikeyOption allows management of the mutation configuration using functional options.
+type apikeyOption func(*APIKeyMutation)
 
-// newEventMutation creates new mutation for the Event entity.
-func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
-	m := &EventMutation{
+// newAPIKeyMutation creates new mutation for the APIKey entity.
+func newAPIKeyMutation(c config, op Op, opts ...apikeyOption) *APIKeyMutation {
+	m := &APIKeyMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeEvent,
+		typ:           TypeAPIKey,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -94,20 +110,20 @@ func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
 	return m
 }
 
-// withEventID sets the ID field of the mutation.
-func withEventID(id uuid.UUID) eventOption {
-	return func(m *EventMutation) {
+// withAPIKeyID sets the ID field of the mutation.
+func withAPIKeyID(id uuid.UUID) apikeyOption {
+	return func(m *APIKeyMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Event
+			value *APIKey
 		)
-		m.oldValue = func(ctx context.Context) (*Event, error) {
+		m.oldValue = func(ctx context.Context) (*APIKey, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Event.Get(ctx, id)
+					value, err = m.Client().APIKey.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -116,10 +132,10 @@ func withEventID(id uuid.UUID) eventOption {
 	}
 }
 
-// withEvent sets the old Event of the mutation.
-func withEvent(node *Event) eventOption {
-	return func(m *EventMutation) {
-		m.oldValue = func(context.Context) (*Event, error) {
+// withAPIKey sets the old APIKey of the mutation.
+func withAPIKey(node *APIKey) apikeyOption {
+	return func(m *APIKeyMutation) {
+		m.oldValue = func(context.Context) (*APIKey, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -128,7 +144,7 @@ func withEvent(node *Event) eventOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m EventMutation) Client() *Client {
+func (m APIKeyMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -136,7 +152,7 @@ func (m EventMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m EventMutation) Tx() (*Tx, error) {
+func (m APIKeyMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -146,14 +162,14 @@ func (m EventMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Event entities.
-func (m *EventMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of APIKey entities.
+func (m *APIKeyMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *EventMutation) ID() (id uuid.UUID, exists bool) {
+func (m *APIKeyMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -164,7 +180,7 @@ func (m *EventMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *EventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *APIKeyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -173,19 +189,19 @@ func (m *EventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().APIKey.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetOrganizationID sets the "organization_id" field.
-func (m *EventMutation) SetOrganizationID(u uuid.UUID) {
+func (m *APIKeyMutation) SetOrganizationID(u uuid.UUID) {
 	m.organization = &u
 }
 
 // OrganizationID returns the value of the "organization_id" field in the mutation.
-func (m *EventMutation) OrganizationID() (r uuid.UUID, exists bool) {
+func (m *APIKeyMutation) OrganizationID() (r uuid.UUID, exists bool) {
 	v := m.organization
 	if v == nil {
 		return
@@ -193,10 +209,10 @@ func (m *EventMutation) OrganizationID() (r uuid.UUID, exists bool) {
 	return *v, true
 }
 
-// OldOrganizationID returns the old "organization_id" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *APIKeyMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
@@ -211,963 +227,1541 @@ func (m *EventMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err
 }
 
 // ResetOrganizationID resets all changes to the "organization_id" field.
-func (m *EventMutation) ResetOrganizationID() {
+func (m *APIKeyMutation) ResetOrganizationID() {
 	m.organization = nil
 }
 
-// SetTitle sets the "title" field.
-func (m *EventMutation) SetTitle(s string) {
-	m.title = &s
+// SetName sets the "name" field.
+func (m *APIKeyMutation) SetName(s string) {
+	m.name = &s
 }
 
-// Title returns the value of the "title" field in the mutation.
-func (m *EventMutation) Title() (r string, exists bool) {
-	v := m.title
+// Name returns the value of the "name" field in the mutation.
+func (m *APIKeyMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTitle returns the old "title" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldTitle(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTitle requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.Title, nil
+	return oldValue.Name, nil
 }
 
-// ResetTitle resets all changes to the "title" field.
-func (m *EventMutation) ResetTitle() {
-	m.title = nil
+// ResetName resets all changes to the "name" field.
+func (m *APIKeyMutation) ResetName() {
+	m.name = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *EventMutation) SetDescription(s string) {
-	m.description = &s
+// SetPrefix sets the "prefix" field.
+func (m *APIKeyMutation) SetPrefix(s string) {
+	m.prefix = &s
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *EventMutation) Description() (r string, exists bool) {
-	v := m.description
+// Prefix returns the value of the "prefix" field in the mutation.
+func (m *APIKeyMutation) Prefix() (r string, exists bool) {
+	v := m.prefix
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldPrefix returns the old "prefix" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldDescription(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldPrefix(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldPrefix is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldPrefix requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldPrefix: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.Prefix, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *EventMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[event.FieldDescription] = struct{}{}
-}
-
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *EventMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[event.FieldDescription]
-	return ok
-}
-
-// ResetDescription resets all changes to the "description" field.
-func (m *EventMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, event.FieldDescription)
+// ResetPrefix resets all changes to the "prefix" field.
+func (m *APIKeyMutation) ResetPrefix() {
+	m.prefix = nil
 }
 
-// SetLocation sets the "location" field.
-func (m *EventMutation) SetLocation(s string) {
-	m.location = &s
+// SetSecretHash sets the "secret_hash" field.
+func (m *APIKeyMutation) SetSecretHash(s string) {
+	m.secret_hash = &s
 }
 
-// Location returns the value of the "location" field in the mutation.
-func (m *EventMutation) Location() (r string, exists bool) {
-	v := m.location
+// SecretHash returns the value of the "secret_hash" field in the mutation.
+func (m *APIKeyMutation) SecretHash() (r string, exists bool) {
+	v := m.secret_hash
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLocation returns the old "location" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldSecretHash returns the old "secret_hash" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldLocation(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldSecretHash(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecretHash is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLocation requires an ID field in the mutation")
+		return v, errors.New("OldSecretHash requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecretHash: %w", err)
 	}
-	return oldValue.Location, nil
-}
-
-// ClearLocation clears the value of the "location" field.
-func (m *EventMutation) ClearLocation() {
-	m.location = nil
-	m.clearedFields[event.FieldLocation] = struct{}{}
-}
-
-// LocationCleared returns if the "location" field was cleared in this mutation.
-func (m *EventMutation) LocationCleared() bool {
-	_, ok := m.clearedFields[event.FieldLocation]
-	return ok
+	return oldValue.SecretHash, nil
 }
 
-// ResetLocation resets all changes to the "location" field.
-func (m *EventMutation) ResetLocation() {
-	m.location = nil
-	delete(m.clearedFields, event.FieldLocation)
+// ResetSecretHash resets all changes to the "secret_hash" field.
+func (m *APIKeyMutation) ResetSecretHash() {
+	m.secret_hash = nil
 }
 
-// SetVenue sets the "venue" field.
-func (m *EventMutation) SetVenue(s string) {
-	m.venue = &s
+// SetScopes sets the "scopes" field.
+func (m *APIKeyMutation) SetScopes(s []string) {
+	m.scopes = &s
+	m.appendscopes = nil
 }
 
-// Venue returns the value of the "venue" field in the mutation.
-func (m *EventMutation) Venue() (r string, exists bool) {
-	v := m.venue
+// Scopes returns the value of the "scopes" field in the mutation.
+func (m *APIKeyMutation) Scopes() (r []string, exists bool) {
+	v := m.scopes
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVenue returns the old "venue" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldScopes returns the old "scopes" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldVenue(ctx context.Context) (v string, err error) {
+func (m *APIKeyMutation) OldScopes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVenue is only allowed on UpdateOne operations")
+		return v, errors.New("OldScopes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVenue requires an ID field in the mutation")
+		return v, errors.New("OldScopes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVenue: %w", err)
+		return v, fmt.Errorf("querying old value for OldScopes: %w", err)
 	}
-	return oldValue.Venue, nil
+	return oldValue.Scopes, nil
 }
 
-// ClearVenue clears the value of the "venue" field.
-func (m *EventMutation) ClearVenue() {
-	m.venue = nil
-	m.clearedFields[event.FieldVenue] = struct{}{}
+// AppendScopes adds s to the "scopes" field.
+func (m *APIKeyMutation) AppendScopes(s []string) {
+	m.appendscopes = append(m.appendscopes, s...)
 }
 
-// VenueCleared returns if the "venue" field was cleared in this mutation.
-func (m *EventMutation) VenueCleared() bool {
-	_, ok := m.clearedFields[event.FieldVenue]
-	return ok
+// AppendedScopes returns the list of values that were appended to the "scopes" field in this mutation.
+func (m *APIKeyMutation) AppendedScopes() ([]string, bool) {
+	if len(m.appendscopes) == 0 {
+		return nil, false
+	}
+	return m.appendscopes, true
 }
 
-// ResetVenue resets all changes to the "venue" field.
-func (m *EventMutation) ResetVenue() {
-	m.venue = nil
-	delete(m.clearedFields, event.FieldVenue)
+// ResetScopes resets all changes to the "scopes" field.
+func (m *APIKeyMutation) ResetScopes() {
+	m.scopes = nil
+	m.appendscopes = nil
 }
 
-// SetStartTime sets the "start_time" field.
-func (m *EventMutation) SetStartTime(t time.Time) {
-	m.start_time = &t
+// SetCreatedBy sets the "created_by" field.
+func (m *APIKeyMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
 }
 
-// StartTime returns the value of the "start_time" field in the mutation.
-func (m *EventMutation) StartTime() (r time.Time, exists bool) {
-	v := m.start_time
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *APIKeyMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStartTime returns the old "start_time" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStartTime requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.StartTime, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetStartTime resets all changes to the "start_time" field.
-func (m *EventMutation) ResetStartTime() {
-	m.start_time = nil
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *APIKeyMutation) ResetCreatedBy() {
+	m.created_by = nil
 }
 
-// SetEndTime sets the "end_time" field.
-func (m *EventMutation) SetEndTime(t time.Time) {
-	m.end_time = &t
+// SetExpiresAt sets the "expires_at" field.
+func (m *APIKeyMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
 }
 
-// EndTime returns the value of the "end_time" field in the mutation.
-func (m *EventMutation) EndTime() (r time.Time, exists bool) {
-	v := m.end_time
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *APIKeyMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEndTime returns the old "end_time" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldExpiresAt returns the old "expires_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+func (m *APIKeyMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEndTime requires an ID field in the mutation")
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
 	}
-	return oldValue.EndTime, nil
+	return oldValue.ExpiresAt, nil
 }
 
-// ResetEndTime resets all changes to the "end_time" field.
-func (m *EventMutation) ResetEndTime() {
-	m.end_time = nil
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *APIKeyMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[apikey.FieldExpiresAt] = struct{}{}
 }
 
-// SetTotalTickets sets the "total_tickets" field.
-func (m *EventMutation) SetTotalTickets(i int) {
-	m.total_tickets = &i
-	m.addtotal_tickets = nil
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *APIKeyMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldExpiresAt]
+	return ok
 }
 
-// TotalTickets returns the value of the "total_tickets" field in the mutation.
-func (m *EventMutation) TotalTickets() (r int, exists bool) {
-	v := m.total_tickets
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *APIKeyMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, apikey.FieldExpiresAt)
+}
+
+// SetLastUsedAt sets the "last_used_at" field.
+func (m *APIKeyMutation) SetLastUsedAt(t time.Time) {
+	m.last_used_at = &t
+}
+
+// LastUsedAt returns the value of the "last_used_at" field in the mutation.
+func (m *APIKeyMutation) LastUsedAt() (r time.Time, exists bool) {
+	v := m.last_used_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalTickets returns the old "total_tickets" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldLastUsedAt returns the old "last_used_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldTotalTickets(ctx context.Context) (v int, err error) {
+func (m *APIKeyMutation) OldLastUsedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalTickets is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastUsedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalTickets requires an ID field in the mutation")
+		return v, errors.New("OldLastUsedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalTickets: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastUsedAt: %w", err)
 	}
-	return oldValue.TotalTickets, nil
+	return oldValue.LastUsedAt, nil
 }
 
-// AddTotalTickets adds i to the "total_tickets" field.
-func (m *EventMutation) AddTotalTickets(i int) {
-	if m.addtotal_tickets != nil {
-		*m.addtotal_tickets += i
-	} else {
-		m.addtotal_tickets = &i
-	}
+// ClearLastUsedAt clears the value of the "last_used_at" field.
+func (m *APIKeyMutation) ClearLastUsedAt() {
+	m.last_used_at = nil
+	m.clearedFields[apikey.FieldLastUsedAt] = struct{}{}
 }
 
-// AddedTotalTickets returns the value that was added to the "total_tickets" field in this mutation.
-func (m *EventMutation) AddedTotalTickets() (r int, exists bool) {
-	v := m.addtotal_tickets
-	if v == nil {
-		return
-	}
-	return *v, true
+// LastUsedAtCleared returns if the "last_used_at" field was cleared in this mutation.
+func (m *APIKeyMutation) LastUsedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldLastUsedAt]
+	return ok
 }
 
-// ResetTotalTickets resets all changes to the "total_tickets" field.
-func (m *EventMutation) ResetTotalTickets() {
-	m.total_tickets = nil
-	m.addtotal_tickets = nil
+// ResetLastUsedAt resets all changes to the "last_used_at" field.
+func (m *APIKeyMutation) ResetLastUsedAt() {
+	m.last_used_at = nil
+	delete(m.clearedFields, apikey.FieldLastUsedAt)
 }
 
-// SetAvailableTickets sets the "available_tickets" field.
-func (m *EventMutation) SetAvailableTickets(i int) {
-	m.available_tickets = &i
-	m.addavailable_tickets = nil
+// SetRevokedAt sets the "revoked_at" field.
+func (m *APIKeyMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// AvailableTickets returns the value of the "available_tickets" field in the mutation.
-func (m *EventMutation) AvailableTickets() (r int, exists bool) {
-	v := m.available_tickets
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *APIKeyMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvailableTickets returns the old "available_tickets" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldAvailableTickets(ctx context.Context) (v int, err error) {
+func (m *APIKeyMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvailableTickets is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvailableTickets requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvailableTickets: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.AvailableTickets, nil
+	return oldValue.RevokedAt, nil
 }
 
-// AddAvailableTickets adds i to the "available_tickets" field.
-func (m *EventMutation) AddAvailableTickets(i int) {
-	if m.addavailable_tickets != nil {
-		*m.addavailable_tickets += i
-	} else {
-		m.addavailable_tickets = &i
-	}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *APIKeyMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[apikey.FieldRevokedAt] = struct{}{}
 }
 
-// AddedAvailableTickets returns the value that was added to the "available_tickets" field in this mutation.
-func (m *EventMutation) AddedAvailableTickets() (r int, exists bool) {
-	v := m.addavailable_tickets
-	if v == nil {
-		return
-	}
-	return *v, true
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *APIKeyMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[apikey.FieldRevokedAt]
+	return ok
 }
 
-// ResetAvailableTickets resets all changes to the "available_tickets" field.
-func (m *EventMutation) ResetAvailableTickets() {
-	m.available_tickets = nil
-	m.addavailable_tickets = nil
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *APIKeyMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, apikey.FieldRevokedAt)
 }
 
-// SetParticipantCount sets the "participant_count" field.
-func (m *EventMutation) SetParticipantCount(i int) {
-	m.participant_count = &i
-	m.addparticipant_count = nil
+// SetCreatedAt sets the "created_at" field.
+func (m *APIKeyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// ParticipantCount returns the value of the "participant_count" field in the mutation.
-func (m *EventMutation) ParticipantCount() (r int, exists bool) {
-	v := m.participant_count
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *APIKeyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldParticipantCount returns the old "participant_count" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldParticipantCount(ctx context.Context) (v int, err error) {
+func (m *APIKeyMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldParticipantCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldParticipantCount requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldParticipantCount: %w", err)
-	}
-	return oldValue.ParticipantCount, nil
-}
-
-// AddParticipantCount adds i to the "participant_count" field.
-func (m *EventMutation) AddParticipantCount(i int) {
-	if m.addparticipant_count != nil {
-		*m.addparticipant_count += i
-	} else {
-		m.addparticipant_count = &i
-	}
-}
-
-// AddedParticipantCount returns the value that was added to the "participant_count" field in this mutation.
-func (m *EventMutation) AddedParticipantCount() (r int, exists bool) {
-	v := m.addparticipant_count
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.CreatedAt, nil
 }
 
-// ResetParticipantCount resets all changes to the "participant_count" field.
-func (m *EventMutation) ResetParticipantCount() {
-	m.participant_count = nil
-	m.addparticipant_count = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *APIKeyMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetTicketPrice sets the "ticket_price" field.
-func (m *EventMutation) SetTicketPrice(f float64) {
-	m.ticket_price = &f
-	m.addticket_price = nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *APIKeyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// TicketPrice returns the value of the "ticket_price" field in the mutation.
-func (m *EventMutation) TicketPrice() (r float64, exists bool) {
-	v := m.ticket_price
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *APIKeyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTicketPrice returns the old "ticket_price" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the APIKey entity.
+// If the APIKey object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldTicketPrice(ctx context.Context) (v float64, err error) {
+func (m *APIKeyMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTicketPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTicketPrice requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTicketPrice: %w", err)
-	}
-	return oldValue.TicketPrice, nil
-}
-
-// AddTicketPrice adds f to the "ticket_price" field.
-func (m *EventMutation) AddTicketPrice(f float64) {
-	if m.addticket_price != nil {
-		*m.addticket_price += f
-	} else {
-		m.addticket_price = &f
-	}
-}
-
-// AddedTicketPrice returns the value that was added to the "ticket_price" field in this mutation.
-func (m *EventMutation) AddedTicketPrice() (r float64, exists bool) {
-	v := m.addticket_price
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return *v, true
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetTicketPrice resets all changes to the "ticket_price" field.
-func (m *EventMutation) ResetTicketPrice() {
-	m.ticket_price = nil
-	m.addticket_price = nil
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *APIKeyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// SetCurrency sets the "currency" field.
-func (m *EventMutation) SetCurrency(s string) {
-	m.currency = &s
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *APIKeyMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[apikey.FieldOrganizationID] = struct{}{}
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *EventMutation) Currency() (r string, exists bool) {
-	v := m.currency
-	if v == nil {
-		return
-	}
-	return *v, true
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *APIKeyMutation) OrganizationCleared() bool {
+	return m.clearedorganization
 }
 
-// OldCurrency returns the old "currency" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldCurrency(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *APIKeyMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
 	}
-	return oldValue.Currency, nil
-}
-
-// ResetCurrency resets all changes to the "currency" field.
-func (m *EventMutation) ResetCurrency() {
-	m.currency = nil
+	return
 }
 
-// SetThumbnailURL sets the "thumbnail_url" field.
-func (m *EventMutation) SetThumbnailURL(s string) {
-	m.thumbnail_url = &s
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *APIKeyMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
-func (m *EventMutation) ThumbnailURL() (r string, exists bool) {
-	v := m.thumbnail_url
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the APIKeyMutation builder.
+func (m *APIKeyMutation) Where(ps ...predicate.APIKey) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldThumbnailURL returns the old "thumbnail_url" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+// WhereP appends storage-level predicates to the APIKeyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *APIKeyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.APIKey, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.ThumbnailURL, nil
-}
-
-// ClearThumbnailURL clears the value of the "thumbnail_url" field.
-func (m *EventMutation) ClearThumbnailURL() {
-	m.thumbnail_url = nil
-	m.clearedFields[event.FieldThumbnailURL] = struct{}{}
+	m.Where(p...)
 }
 
-// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
-func (m *EventMutation) ThumbnailURLCleared() bool {
-	_, ok := m.clearedFields[event.FieldThumbnailURL]
-	return ok
+// Op returns the operation name.
+func (m *APIKeyMutation) Op() Op {
+	return m.op
 }
 
-// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
-func (m *EventMutation) ResetThumbnailURL() {
-	m.thumbnail_url = nil
-	delete(m.clearedFields, event.FieldThumbnailURL)
+// SetOp allows setting the mutation operation.
+func (m *APIKeyMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// SetStatus sets the "status" field.
-func (m *EventMutation) SetStatus(e event.Status) {
-	m.status = &e
+// Type returns the node type of this mutation (APIKey).
+func (m *APIKeyMutation) Type() string {
+	return m.typ
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *EventMutation) Status() (r event.Status, exists bool) {
-	v := m.status
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *APIKeyMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.organization != nil {
+		fields = append(fields, apikey.FieldOrganizationID)
 	}
-	return *v, true
-}
-
-// OldStatus returns the old "status" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldStatus(ctx context.Context) (v event.Status, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	if m.name != nil {
+		fields = append(fields, apikey.FieldName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+	if m.prefix != nil {
+		fields = append(fields, apikey.FieldPrefix)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	if m.secret_hash != nil {
+		fields = append(fields, apikey.FieldSecretHash)
 	}
-	return oldValue.Status, nil
-}
-
-// ResetStatus resets all changes to the "status" field.
-func (m *EventMutation) ResetStatus() {
-	m.status = nil
-}
-
-// SetIsPublic sets the "is_public" field.
-func (m *EventMutation) SetIsPublic(b bool) {
-	m.is_public = &b
-}
-
-// IsPublic returns the value of the "is_public" field in the mutation.
-func (m *EventMutation) IsPublic() (r bool, exists bool) {
-	v := m.is_public
-	if v == nil {
-		return
+	if m.scopes != nil {
+		fields = append(fields, apikey.FieldScopes)
 	}
-	return *v, true
-}
-
-// OldIsPublic returns the old "is_public" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	if m.created_by != nil {
+		fields = append(fields, apikey.FieldCreatedBy)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	if m.expires_at != nil {
+		fields = append(fields, apikey.FieldExpiresAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	if m.last_used_at != nil {
+		fields = append(fields, apikey.FieldLastUsedAt)
 	}
-	return oldValue.IsPublic, nil
+	if m.revoked_at != nil {
+		fields = append(fields, apikey.FieldRevokedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, apikey.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, apikey.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ResetIsPublic resets all changes to the "is_public" field.
-func (m *EventMutation) ResetIsPublic() {
-	m.is_public = nil
-}
-
-// SetCreatedBy sets the "created_by" field.
-func (m *EventMutation) SetCreatedBy(u uuid.UUID) {
-	m.creator = &u
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *APIKeyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case apikey.FieldOrganizationID:
+		return m.OrganizationID()
+	case apikey.FieldName:
+		return m.Name()
+	case apikey.FieldPrefix:
+		return m.Prefix()
+	case apikey.FieldSecretHash:
+		return m.SecretHash()
+	case apikey.FieldScopes:
+		return m.Scopes()
+	case apikey.FieldCreatedBy:
+		return m.CreatedBy()
+	case apikey.FieldExpiresAt:
+		return m.ExpiresAt()
+	case apikey.FieldLastUsedAt:
+		return m.LastUsedAt()
+	case apikey.FieldRevokedAt:
+		return m.RevokedAt()
+	case apikey.FieldCreatedAt:
+		return m.CreatedAt()
+	case apikey.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// CreatedBy returns the value of the "created_by" field in the mutation.
-func (m *EventMutation) CreatedBy() (r uuid.UUID, exists bool) {
-	v := m.creator
-	if v == nil {
-		return
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *APIKeyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case apikey.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case apikey.FieldName:
+		return m.OldName(ctx)
+	case apikey.FieldPrefix:
+		return m.OldPrefix(ctx)
+	case apikey.FieldSecretHash:
+		return m.OldSecretHash(ctx)
+	case apikey.FieldScopes:
+		return m.OldScopes(ctx)
+	case apikey.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case apikey.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case apikey.FieldLastUsedAt:
+		return m.OldLastUsedAt(ctx)
+	case apikey.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case apikey.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case apikey.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return *v, true
+	return nil, fmt.Errorf("unknown APIKey field %s", name)
 }
 
-// OldCreatedBy returns the old "created_by" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case apikey.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case apikey.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case apikey.FieldPrefix:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrefix(v)
+		return nil
+	case apikey.FieldSecretHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSecretHash(v)
+		return nil
+	case apikey.FieldScopes:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetScopes(v)
+		return nil
+	case apikey.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case apikey.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case apikey.FieldLastUsedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUsedAt(v)
+		return nil
+	case apikey.FieldRevokedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRevokedAt(v)
+		return nil
+	case apikey.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case apikey.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return oldValue.CreatedBy, nil
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
-// ResetCreatedBy resets all changes to the "created_by" field.
-func (m *EventMutation) ResetCreatedBy() {
-	m.creator = nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *APIKeyMutation) AddedFields() []string {
+	return nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *EventMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *APIKeyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *EventMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *APIKeyMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIKey numeric field %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *APIKeyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(apikey.FieldExpiresAt) {
+		fields = append(fields, apikey.FieldExpiresAt)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	if m.FieldCleared(apikey.FieldLastUsedAt) {
+		fields = append(fields, apikey.FieldLastUsedAt)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	if m.FieldCleared(apikey.FieldRevokedAt) {
+		fields = append(fields, apikey.FieldRevokedAt)
 	}
-	return oldValue.CreatedAt, nil
+	return fields
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *EventMutation) ResetCreatedAt() {
-	m.created_at = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *APIKeyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *EventMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *APIKeyMutation) ClearField(name string) error {
+	switch name {
+	case apikey.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ClearLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ClearRevokedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey nullable field %s", name)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *EventMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *APIKeyMutation) ResetField(name string) error {
+	switch name {
+	case apikey.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case apikey.FieldName:
+		m.ResetName()
+		return nil
+	case apikey.FieldPrefix:
+		m.ResetPrefix()
+		return nil
+	case apikey.FieldSecretHash:
+		m.ResetSecretHash()
+		return nil
+	case apikey.FieldScopes:
+		m.ResetScopes()
+		return nil
+	case apikey.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case apikey.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case apikey.FieldLastUsedAt:
+		m.ResetLastUsedAt()
+		return nil
+	case apikey.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case apikey.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case apikey.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown APIKey field %s", name)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Event entity.
-// If the Event object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *APIKeyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.organization != nil {
+		edges = append(edges, apikey.EdgeOrganization)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *APIKeyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case apikey.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.UpdatedAt, nil
+	return nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *EventMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *APIKeyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *EventMutation) ClearOrganization() {
-	m.clearedorganization = true
-	m.clearedFields[event.FieldOrganizationID] = struct{}{}
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *APIKeyMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *EventMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *APIKeyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedorganization {
+		edges = append(edges, apikey.EdgeOrganization)
+	}
+	return edges
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *EventMutation) OrganizationIDs() (ids []uuid.UUID) {
-	if id := m.organization; id != nil {
-		ids = append(ids, *id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *APIKeyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case apikey.EdgeOrganization:
+		return m.clearedorganization
 	}
-	return
+	return false
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *EventMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *APIKeyMutation) ClearEdge(name string) error {
+	switch name {
+	case apikey.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey unique edge %s", name)
 }
 
-// SetCreatorID sets the "creator" edge to the User entity by id.
-func (m *EventMutation) SetCreatorID(id uuid.UUID) {
-	m.creator = &id
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *APIKeyMutation) ResetEdge(name string) error {
+	switch name {
+	case apikey.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown APIKey edge %s", name)
 }
 
-// ClearCreator clears the "creator" edge to the User entity.
-func (m *EventMutation) ClearCreator() {
-	m.clearedcreator = true
-	m.clearedFields[event.FieldCreatedBy] = struct{}{}
+// AuditLogMutation represents an operation that mutates the AuditLog nodes in the graph.
+type AuditLogMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	organization_id *uuid.UUID
+	actor_id        *uuid.UUID
+	action          *string
+	target_type     *string
+	target_id       *string
+	before          *map[string]interface{}
+	after           *map[string]interface{}
+	metadata        *map[string]interface{}
+	ip              *string
+	user_agent      *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*AuditLog, error)
+	predicates      []predicate.AuditLog
+}
+
+var _ ent.Mutation = (*AuditLogMutation)(nil)
+
+// auditlogOption allows management of the mutation configuration using functional options.
+type auditlogOption func(*AuditLogMutation)
+
+// newAuditLogMutation creates new mutation for the AuditLog entity.
+func newAuditLogMutation(c config, op Op, opts ...auditlogOption) *AuditLogMutation {
+	m := &AuditLogMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeAuditLog,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// CreatorCleared reports if the "creator" edge to the User entity was cleared.
-func (m *EventMutation) CreatorCleared() bool {
-	return m.clearedcreator
+// withAuditLogID sets the ID field of the mutation.
+func withAuditLogID(id uuid.UUID) auditlogOption {
+	return func(m *AuditLogMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *AuditLog
+		)
+		m.oldValue = func(ctx context.Context) (*AuditLog, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().AuditLog.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// CreatorID returns the "creator" edge ID in the mutation.
-func (m *EventMutation) CreatorID() (id uuid.UUID, exists bool) {
-	if m.creator != nil {
-		return *m.creator, true
+// withAuditLog sets the old AuditLog of the mutation.
+func withAuditLog(node *AuditLog) auditlogOption {
+	return func(m *AuditLogMutation) {
+		m.oldValue = func(context.Context) (*AuditLog, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// CreatorIDs returns the "creator" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// CreatorID instead. It exists only for internal usage by the builders.
-func (m *EventMutation) CreatorIDs() (ids []uuid.UUID) {
-	if id := m.creator; id != nil {
-		ids = append(ids, *id)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m AuditLogMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m AuditLogMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetCreator resets all changes to the "creator" edge.
-func (m *EventMutation) ResetCreator() {
-	m.creator = nil
-	m.clearedcreator = false
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of AuditLog entities.
+func (m *AuditLogMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddPaymentIDs adds the "payments" edge to the Payment entity by ids.
-func (m *EventMutation) AddPaymentIDs(ids ...uuid.UUID) {
-	if m.payments == nil {
-		m.payments = make(map[uuid.UUID]struct{})
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *AuditLogMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	for i := range ids {
-		m.payments[ids[i]] = struct{}{}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *AuditLogMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().AuditLog.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// ClearPayments clears the "payments" edge to the Payment entity.
-func (m *EventMutation) ClearPayments() {
-	m.clearedpayments = true
+// SetOrganizationID sets the "organization_id" field.
+func (m *AuditLogMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization_id = &u
 }
 
-// PaymentsCleared reports if the "payments" edge to the Payment entity was cleared.
-func (m *EventMutation) PaymentsCleared() bool {
-	return m.clearedpayments
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *AuditLogMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// RemovePaymentIDs removes the "payments" edge to the Payment entity by IDs.
-func (m *EventMutation) RemovePaymentIDs(ids ...uuid.UUID) {
-	if m.removedpayments == nil {
-		m.removedpayments = make(map[uuid.UUID]struct{})
+// OldOrganizationID returns the old "organization_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldOrganizationID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.payments, ids[i])
-		m.removedpayments[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
 	}
+	return oldValue.OrganizationID, nil
 }
 
-// RemovedPayments returns the removed IDs of the "payments" edge to the Payment entity.
-func (m *EventMutation) RemovedPaymentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpayments {
-		ids = append(ids, id)
-	}
-	return
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (m *AuditLogMutation) ClearOrganizationID() {
+	m.organization_id = nil
+	m.clearedFields[auditlog.FieldOrganizationID] = struct{}{}
 }
 
-// PaymentsIDs returns the "payments" edge IDs in the mutation.
-func (m *EventMutation) PaymentsIDs() (ids []uuid.UUID) {
-	for id := range m.payments {
-		ids = append(ids, id)
-	}
-	return
+// OrganizationIDCleared returns if the "organization_id" field was cleared in this mutation.
+func (m *AuditLogMutation) OrganizationIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldOrganizationID]
+	return ok
 }
 
-// ResetPayments resets all changes to the "payments" edge.
-func (m *EventMutation) ResetPayments() {
-	m.payments = nil
-	m.clearedpayments = false
-	m.removedpayments = nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *AuditLogMutation) ResetOrganizationID() {
+	m.organization_id = nil
+	delete(m.clearedFields, auditlog.FieldOrganizationID)
 }
 
-// Where appends a list predicates to the EventMutation builder.
-func (m *EventMutation) Where(ps ...predicate.Event) {
-	m.predicates = append(m.predicates, ps...)
+// SetActorID sets the "actor_id" field.
+func (m *AuditLogMutation) SetActorID(u uuid.UUID) {
+	m.actor_id = &u
 }
 
-// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Event, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ActorID returns the value of the "actor_id" field in the mutation.
+func (m *AuditLogMutation) ActorID() (r uuid.UUID, exists bool) {
+	v := m.actor_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *EventMutation) Op() Op {
-	return m.op
+// OldActorID returns the old "actor_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldActorID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActorID: %w", err)
+	}
+	return oldValue.ActorID, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *EventMutation) SetOp(op Op) {
-	m.op = op
+// ClearActorID clears the value of the "actor_id" field.
+func (m *AuditLogMutation) ClearActorID() {
+	m.actor_id = nil
+	m.clearedFields[auditlog.FieldActorID] = struct{}{}
 }
 
-// Type returns the node type of this mutation (Event).
-func (m *EventMutation) Type() string {
-	return m.typ
+// ActorIDCleared returns if the "actor_id" field was cleared in this mutation.
+func (m *AuditLogMutation) ActorIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldActorID]
+	return ok
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *EventMutation) Fields() []string {
-	fields := make([]string, 0, 18)
-	if m.organization != nil {
-		fields = append(fields, event.FieldOrganizationID)
-	}
-	if m.title != nil {
-		fields = append(fields, event.FieldTitle)
+// ResetActorID resets all changes to the "actor_id" field.
+func (m *AuditLogMutation) ResetActorID() {
+	m.actor_id = nil
+	delete(m.clearedFields, auditlog.FieldActorID)
+}
+
+// SetAction sets the "action" field.
+func (m *AuditLogMutation) SetAction(s string) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditLogMutation) Action() (r string, exists bool) {
+	v := m.action
+	if v == nil {
+		return
 	}
-	if m.description != nil {
-		fields = append(fields, event.FieldDescription)
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAction(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
-	if m.location != nil {
-		fields = append(fields, event.FieldLocation)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
-	if m.venue != nil {
-		fields = append(fields, event.FieldVenue)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	if m.start_time != nil {
-		fields = append(fields, event.FieldStartTime)
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *AuditLogMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetTargetType sets the "target_type" field.
+func (m *AuditLogMutation) SetTargetType(s string) {
+	m.target_type = &s
+}
+
+// TargetType returns the value of the "target_type" field in the mutation.
+func (m *AuditLogMutation) TargetType() (r string, exists bool) {
+	v := m.target_type
+	if v == nil {
+		return
 	}
-	if m.end_time != nil {
-		fields = append(fields, event.FieldEndTime)
+	return *v, true
+}
+
+// OldTargetType returns the old "target_type" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTargetType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetType is only allowed on UpdateOne operations")
 	}
-	if m.total_tickets != nil {
-		fields = append(fields, event.FieldTotalTickets)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetType requires an ID field in the mutation")
 	}
-	if m.available_tickets != nil {
-		fields = append(fields, event.FieldAvailableTickets)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetType: %w", err)
 	}
-	if m.participant_count != nil {
-		fields = append(fields, event.FieldParticipantCount)
+	return oldValue.TargetType, nil
+}
+
+// ResetTargetType resets all changes to the "target_type" field.
+func (m *AuditLogMutation) ResetTargetType() {
+	m.target_type = nil
+}
+
+// SetTargetID sets the "target_id" field.
+func (m *AuditLogMutation) SetTargetID(s string) {
+	m.target_id = &s
+}
+
+// TargetID returns the value of the "target_id" field in the mutation.
+func (m *AuditLogMutation) TargetID() (r string, exists bool) {
+	v := m.target_id
+	if v == nil {
+		return
 	}
-	if m.ticket_price != nil {
-		fields = append(fields, event.FieldTicketPrice)
+	return *v, true
+}
+
+// OldTargetID returns the old "target_id" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldTargetID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTargetID is only allowed on UpdateOne operations")
 	}
-	if m.currency != nil {
-		fields = append(fields, event.FieldCurrency)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTargetID requires an ID field in the mutation")
 	}
-	if m.thumbnail_url != nil {
-		fields = append(fields, event.FieldThumbnailURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTargetID: %w", err)
 	}
-	if m.status != nil {
-		fields = append(fields, event.FieldStatus)
+	return oldValue.TargetID, nil
+}
+
+// ClearTargetID clears the value of the "target_id" field.
+func (m *AuditLogMutation) ClearTargetID() {
+	m.target_id = nil
+	m.clearedFields[auditlog.FieldTargetID] = struct{}{}
+}
+
+// TargetIDCleared returns if the "target_id" field was cleared in this mutation.
+func (m *AuditLogMutation) TargetIDCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldTargetID]
+	return ok
+}
+
+// ResetTargetID resets all changes to the "target_id" field.
+func (m *AuditLogMutation) ResetTargetID() {
+	m.target_id = nil
+	delete(m.clearedFields, auditlog.FieldTargetID)
+}
+
+// SetBefore sets the "before" field.
+func (m *AuditLogMutation) SetBefore(value map[string]interface{}) {
+	m.before = &value
+}
+
+// Before returns the value of the "before" field in the mutation.
+func (m *AuditLogMutation) Before() (r map[string]interface{}, exists bool) {
+	v := m.before
+	if v == nil {
+		return
 	}
-	if m.is_public != nil {
-		fields = append(fields, event.FieldIsPublic)
+	return *v, true
+}
+
+// OldBefore returns the old "before" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldBefore(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBefore is only allowed on UpdateOne operations")
 	}
-	if m.creator != nil {
-		fields = append(fields, event.FieldCreatedBy)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBefore requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, event.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, event.FieldUpdatedAt)
+	return oldValue.Before, nil
+}
+
+// ClearBefore clears the value of the "before" field.
+func (m *AuditLogMutation) ClearBefore() {
+	m.before = nil
+	m.clearedFields[auditlog.FieldBefore] = struct{}{}
+}
+
+// BeforeCleared returns if the "before" field was cleared in this mutation.
+func (m *AuditLogMutation) BeforeCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldBefore]
+	return ok
+}
+
+// ResetBefore resets all changes to the "before" field.
+func (m *AuditLogMutation) ResetBefore() {
+	m.before = nil
+	delete(m.clearedFields, auditlog.FieldBefore)
+}
+
+// SetAfter sets the "after" field.
+func (m *AuditLogMutation) SetAfter(value map[string]interface{}) {
+	m.after = &value
+}
+
+// After returns the value of the "after" field in the mutation.
+func (m *AuditLogMutation) After() (r map[string]interface{}, exists bool) {
+	v := m.after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfter returns the old "after" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldAfter(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
+	}
+	return oldValue.After, nil
+}
+
+// ClearAfter clears the value of the "after" field.
+func (m *AuditLogMutation) ClearAfter() {
+	m.after = nil
+	m.clearedFields[auditlog.FieldAfter] = struct{}{}
+}
+
+// AfterCleared returns if the "after" field was cleared in this mutation.
+func (m *AuditLogMutation) AfterCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldAfter]
+	return ok
+}
+
+// ResetAfter resets all changes to the "after" field.
+func (m *AuditLogMutation) ResetAfter() {
+	m.after = nil
+	delete(m.clearedFields, auditlog.FieldAfter)
+}
+
+// SetMetadata sets the "metadata" field.
+func (m *AuditLogMutation) SetMetadata(value map[string]interface{}) {
+	m.metadata = &value
+}
+
+// Metadata returns the value of the "metadata" field in the mutation.
+func (m *AuditLogMutation) Metadata() (r map[string]interface{}, exists bool) {
+	v := m.metadata
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMetadata returns the old "metadata" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldMetadata(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMetadata is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMetadata requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMetadata: %w", err)
+	}
+	return oldValue.Metadata, nil
+}
+
+// ClearMetadata clears the value of the "metadata" field.
+func (m *AuditLogMutation) ClearMetadata() {
+	m.metadata = nil
+	m.clearedFields[auditlog.FieldMetadata] = struct{}{}
+}
+
+// MetadataCleared returns if the "metadata" field was cleared in this mutation.
+func (m *AuditLogMutation) MetadataCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldMetadata]
+	return ok
+}
+
+// ResetMetadata resets all changes to the "metadata" field.
+func (m *AuditLogMutation) ResetMetadata() {
+	m.metadata = nil
+	delete(m.clearedFields, auditlog.FieldMetadata)
+}
+
+// SetIP sets the "ip" field.
+func (m *AuditLogMutation) SetIP(s string) {
+	m.ip = &s
+}
+
+// IP returns the value of the "ip" field in the mutation.
+func (m *AuditLogMutation) IP() (r string, exists bool) {
+	v := m.ip
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIP returns the old "ip" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldIP(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIP is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIP requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIP: %w", err)
+	}
+	return oldValue.IP, nil
+}
+
+// ClearIP clears the value of the "ip" field.
+func (m *AuditLogMutation) ClearIP() {
+	m.ip = nil
+	m.clearedFields[auditlog.FieldIP] = struct{}{}
+}
+
+// IPCleared returns if the "ip" field was cleared in this mutation.
+func (m *AuditLogMutation) IPCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldIP]
+	return ok
+}
+
+// ResetIP resets all changes to the "ip" field.
+func (m *AuditLogMutation) ResetIP() {
+	m.ip = nil
+	delete(m.clearedFields, auditlog.FieldIP)
+}
+
+// SetUserAgent sets the "user_agent" field.
+func (m *AuditLogMutation) SetUserAgent(s string) {
+	m.user_agent = &s
+}
+
+// UserAgent returns the value of the "user_agent" field in the mutation.
+func (m *AuditLogMutation) UserAgent() (r string, exists bool) {
+	v := m.user_agent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserAgent returns the old "user_agent" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldUserAgent(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserAgent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserAgent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserAgent: %w", err)
+	}
+	return oldValue.UserAgent, nil
+}
+
+// ClearUserAgent clears the value of the "user_agent" field.
+func (m *AuditLogMutation) ClearUserAgent() {
+	m.user_agent = nil
+	m.clearedFields[auditlog.FieldUserAgent] = struct{}{}
+}
+
+// UserAgentCleared returns if the "user_agent" field was cleared in this mutation.
+func (m *AuditLogMutation) UserAgentCleared() bool {
+	_, ok := m.clearedFields[auditlog.FieldUserAgent]
+	return ok
+}
+
+// ResetUserAgent resets all changes to the "user_agent" field.
+func (m *AuditLogMutation) ResetUserAgent() {
+	m.user_agent = nil
+	delete(m.clearedFields, auditlog.FieldUserAgent)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditLogMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditLogMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditLog entity.
+// If the AuditLog object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditLogMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditLogMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditLogMutation builder.
+func (m *AuditLogMutation) Where(ps ...predicate.AuditLog) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditLogMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditLogMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditLog, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditLogMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditLogMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditLog).
+func (m *AuditLogMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *AuditLogMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.organization_id != nil {
+		fields = append(fields, auditlog.FieldOrganizationID)
+	}
+	if m.actor_id != nil {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.action != nil {
+		fields = append(fields, auditlog.FieldAction)
+	}
+	if m.target_type != nil {
+		fields = append(fields, auditlog.FieldTargetType)
+	}
+	if m.target_id != nil {
+		fields = append(fields, auditlog.FieldTargetID)
+	}
+	if m.before != nil {
+		fields = append(fields, auditlog.FieldBefore)
+	}
+	if m.after != nil {
+		fields = append(fields, auditlog.FieldAfter)
+	}
+	if m.metadata != nil {
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	if m.ip != nil {
+		fields = append(fields, auditlog.FieldIP)
+	}
+	if m.user_agent != nil {
+		fields = append(fields, auditlog.FieldUserAgent)
+	}
+	if m.created_at != nil {
+		fields = append(fields, auditlog.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1175,44 +1769,30 @@ func (m *EventMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *EventMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditLogMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case event.FieldOrganizationID:
+	case auditlog.FieldOrganizationID:
 		return m.OrganizationID()
-	case event.FieldTitle:
-		return m.Title()
-	case event.FieldDescription:
-		return m.Description()
-	case event.FieldLocation:
-		return m.Location()
-	case event.FieldVenue:
-		return m.Venue()
-	case event.FieldStartTime:
-		return m.StartTime()
-	case event.FieldEndTime:
-		return m.EndTime()
-	case event.FieldTotalTickets:
-		return m.TotalTickets()
-	case event.FieldAvailableTickets:
-		return m.AvailableTickets()
-	case event.FieldParticipantCount:
-		return m.ParticipantCount()
-	case event.FieldTicketPrice:
-		return m.TicketPrice()
-	case event.FieldCurrency:
-		return m.Currency()
-	case event.FieldThumbnailURL:
-		return m.ThumbnailURL()
-	case event.FieldStatus:
-		return m.Status()
-	case event.FieldIsPublic:
-		return m.IsPublic()
-	case event.FieldCreatedBy:
-		return m.CreatedBy()
-	case event.FieldCreatedAt:
+	case auditlog.FieldActorID:
+		return m.ActorID()
+	case auditlog.FieldAction:
+		return m.Action()
+	case auditlog.FieldTargetType:
+		return m.TargetType()
+	case auditlog.FieldTargetID:
+		return m.TargetID()
+	case auditlog.FieldBefore:
+		return m.Before()
+	case auditlog.FieldAfter:
+		return m.After()
+	case auditlog.FieldMetadata:
+		return m.Metadata()
+	case auditlog.FieldIP:
+		return m.IP()
+	case auditlog.FieldUserAgent:
+		return m.UserAgent()
+	case auditlog.FieldCreatedAt:
 		return m.CreatedAt()
-	case event.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -1220,1751 +1800,19009 @@ func (m *EventMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditLogMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case event.FieldOrganizationID:
+	case auditlog.FieldOrganizationID:
 		return m.OldOrganizationID(ctx)
-	case event.FieldTitle:
-		return m.OldTitle(ctx)
-	case event.FieldDescription:
-		return m.OldDescription(ctx)
-	case event.FieldLocation:
-		return m.OldLocation(ctx)
-	case event.FieldVenue:
-		return m.OldVenue(ctx)
-	case event.FieldStartTime:
-		return m.OldStartTime(ctx)
-	case event.FieldEndTime:
-		return m.OldEndTime(ctx)
-	case event.FieldTotalTickets:
-		return m.OldTotalTickets(ctx)
-	case event.FieldAvailableTickets:
-		return m.OldAvailableTickets(ctx)
-	case event.FieldParticipantCount:
-		return m.OldParticipantCount(ctx)
-	case event.FieldTicketPrice:
-		return m.OldTicketPrice(ctx)
-	case event.FieldCurrency:
-		return m.OldCurrency(ctx)
-	case event.FieldThumbnailURL:
-		return m.OldThumbnailURL(ctx)
-	case event.FieldStatus:
-		return m.OldStatus(ctx)
-	case event.FieldIsPublic:
-		return m.OldIsPublic(ctx)
-	case event.FieldCreatedBy:
-		return m.OldCreatedBy(ctx)
-	case event.FieldCreatedAt:
+	case auditlog.FieldActorID:
+		return m.OldActorID(ctx)
+	case auditlog.FieldAction:
+		return m.OldAction(ctx)
+	case auditlog.FieldTargetType:
+		return m.OldTargetType(ctx)
+	case auditlog.FieldTargetID:
+		return m.OldTargetID(ctx)
+	case auditlog.FieldBefore:
+		return m.OldBefore(ctx)
+	case auditlog.FieldAfter:
+		return m.OldAfter(ctx)
+	case auditlog.FieldMetadata:
+		return m.OldMetadata(ctx)
+	case auditlog.FieldIP:
+		return m.OldIP(ctx)
+	case auditlog.FieldUserAgent:
+		return m.OldUserAgent(ctx)
+	case auditlog.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case event.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Event field %s", name)
+	return nil, fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *EventMutation) SetField(name string, value ent.Value) error {
+func (m *AuditLogMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case event.FieldOrganizationID:
+	case auditlog.FieldOrganizationID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOrganizationID(v)
 		return nil
-	case event.FieldTitle:
-		v, ok := value.(string)
+	case auditlog.FieldActorID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTitle(v)
+		m.SetActorID(v)
 		return nil
-	case event.FieldDescription:
+	case auditlog.FieldAction:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetAction(v)
 		return nil
-	case event.FieldLocation:
+	case auditlog.FieldTargetType:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLocation(v)
+		m.SetTargetType(v)
 		return nil
-	case event.FieldVenue:
+	case auditlog.FieldTargetID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVenue(v)
-		return nil
-	case event.FieldStartTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStartTime(v)
-		return nil
-	case event.FieldEndTime:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEndTime(v)
-		return nil
-	case event.FieldTotalTickets:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalTickets(v)
+		m.SetTargetID(v)
 		return nil
-	case event.FieldAvailableTickets:
-		v, ok := value.(int)
+	case auditlog.FieldBefore:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAvailableTickets(v)
+		m.SetBefore(v)
 		return nil
-	case event.FieldParticipantCount:
-		v, ok := value.(int)
+	case auditlog.FieldAfter:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetParticipantCount(v)
+		m.SetAfter(v)
 		return nil
-	case event.FieldTicketPrice:
-		v, ok := value.(float64)
+	case auditlog.FieldMetadata:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTicketPrice(v)
+		m.SetMetadata(v)
 		return nil
-	case event.FieldCurrency:
+	case auditlog.FieldIP:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrency(v)
+		m.SetIP(v)
 		return nil
-	case event.FieldThumbnailURL:
+	case auditlog.FieldUserAgent:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetThumbnailURL(v)
-		return nil
-	case event.FieldStatus:
-		v, ok := value.(event.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case event.FieldIsPublic:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsPublic(v)
-		return nil
-	case event.FieldCreatedBy:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedBy(v)
+		m.SetUserAgent(v)
 		return nil
-	case event.FieldCreatedAt:
+	case auditlog.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case event.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Event field %s", name)
+	return fmt.Errorf("unknown AuditLog field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *EventMutation) AddedFields() []string {
+func (m *AuditLogMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *AuditLogMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *AuditLogMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown AuditLog numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *AuditLogMutation) ClearedFields() []string {
 	var fields []string
-	if m.addtotal_tickets != nil {
-		fields = append(fields, event.FieldTotalTickets)
+	if m.FieldCleared(auditlog.FieldOrganizationID) {
+		fields = append(fields, auditlog.FieldOrganizationID)
 	}
-	if m.addavailable_tickets != nil {
-		fields = append(fields, event.FieldAvailableTickets)
+	if m.FieldCleared(auditlog.FieldActorID) {
+		fields = append(fields, auditlog.FieldActorID)
+	}
+	if m.FieldCleared(auditlog.FieldTargetID) {
+		fields = append(fields, auditlog.FieldTargetID)
+	}
+	if m.FieldCleared(auditlog.FieldBefore) {
+		fields = append(fields, auditlog.FieldBefore)
+	}
+	if m.FieldCleared(auditlog.FieldAfter) {
+		fields = append(fields, auditlog.FieldAfter)
+	}
+	if m.FieldCleared(auditlog.FieldMetadata) {
+		fields = append(fields, auditlog.FieldMetadata)
+	}
+	if m.FieldCleared(auditlog.FieldIP) {
+		fields = append(fields, auditlog.FieldIP)
+	}
+	if m.FieldCleared(auditlog.FieldUserAgent) {
+		fields = append(fields, auditlog.FieldUserAgent)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *AuditLogMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *AuditLogMutation) ClearField(name string) error {
+	switch name {
+	case auditlog.FieldOrganizationID:
+		m.ClearOrganizationID()
+		return nil
+	case auditlog.FieldActorID:
+		m.ClearActorID()
+		return nil
+	case auditlog.FieldTargetID:
+		m.ClearTargetID()
+		return nil
+	case auditlog.FieldBefore:
+		m.ClearBefore()
+		return nil
+	case auditlog.FieldAfter:
+		m.ClearAfter()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ClearMetadata()
+		return nil
+	case auditlog.FieldIP:
+		m.ClearIP()
+		return nil
+	case auditlog.FieldUserAgent:
+		m.ClearUserAgent()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *AuditLogMutation) ResetField(name string) error {
+	switch name {
+	case auditlog.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case auditlog.FieldActorID:
+		m.ResetActorID()
+		return nil
+	case auditlog.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditlog.FieldTargetType:
+		m.ResetTargetType()
+		return nil
+	case auditlog.FieldTargetID:
+		m.ResetTargetID()
+		return nil
+	case auditlog.FieldBefore:
+		m.ResetBefore()
+		return nil
+	case auditlog.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case auditlog.FieldMetadata:
+		m.ResetMetadata()
+		return nil
+	case auditlog.FieldIP:
+		m.ResetIP()
+		return nil
+	case auditlog.FieldUserAgent:
+		m.ResetUserAgent()
+		return nil
+	case auditlog.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditLog field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *AuditLogMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *AuditLogMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *AuditLogMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *AuditLogMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *AuditLogMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *AuditLogMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *AuditLogMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *AuditLogMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditLog edge %s", name)
+}
+
+// CancellationRefundMutation represents an operation that mutates the CancellationRefund nodes in the graph.
+type CancellationRefundMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	payment_id          *uuid.UUID
+	method              *cancellationrefund.Method
+	status              *cancellationrefund.Status
+	attempts            *int
+	addattempts         *int
+	last_error          *string
+	next_attempt_at     *time.Time
+	refunded_at         *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	cancellation        *uuid.UUID
+	clearedcancellation bool
+	done                bool
+	oldValue            func(context.Context) (*CancellationRefund, error)
+	predicates          []predicate.CancellationRefund
+}
+
+var _ ent.Mutation = (*CancellationRefundMutation)(nil)
+
+// cancellationrefundOption allows management of the mutation configuration using functional options.
+type cancellationrefundOption func(*CancellationRefundMutation)
+
+// newCancellationRefundMutation creates new mutation for the CancellationRefund entity.
+func newCancellationRefundMutation(c config, op Op, opts ...cancellationrefundOption) *CancellationRefundMutation {
+	m := &CancellationRefundMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCancellationRefund,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCancellationRefundID sets the ID field of the mutation.
+func withCancellationRefundID(id uuid.UUID) cancellationrefundOption {
+	return func(m *CancellationRefundMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *CancellationRefund
+		)
+		m.oldValue = func(ctx context.Context) (*CancellationRefund, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().CancellationRefund.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCancellationRefund sets the old CancellationRefund of the mutation.
+func withCancellationRefund(node *CancellationRefund) cancellationrefundOption {
+	return func(m *CancellationRefundMutation) {
+		m.oldValue = func(context.Context) (*CancellationRefund, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CancellationRefundMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CancellationRefundMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of CancellationRefund entities.
+func (m *CancellationRefundMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CancellationRefundMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CancellationRefundMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().CancellationRefund.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetCancellationID sets the "cancellation_id" field.
+func (m *CancellationRefundMutation) SetCancellationID(u uuid.UUID) {
+	m.cancellation = &u
+}
+
+// CancellationID returns the value of the "cancellation_id" field in the mutation.
+func (m *CancellationRefundMutation) CancellationID() (r uuid.UUID, exists bool) {
+	v := m.cancellation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCancellationID returns the old "cancellation_id" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldCancellationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCancellationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCancellationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCancellationID: %w", err)
+	}
+	return oldValue.CancellationID, nil
+}
+
+// ResetCancellationID resets all changes to the "cancellation_id" field.
+func (m *CancellationRefundMutation) ResetCancellationID() {
+	m.cancellation = nil
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (m *CancellationRefundMutation) SetPaymentID(u uuid.UUID) {
+	m.payment_id = &u
+}
+
+// PaymentID returns the value of the "payment_id" field in the mutation.
+func (m *CancellationRefundMutation) PaymentID() (r uuid.UUID, exists bool) {
+	v := m.payment_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentID returns the old "payment_id" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldPaymentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentID: %w", err)
+	}
+	return oldValue.PaymentID, nil
+}
+
+// ResetPaymentID resets all changes to the "payment_id" field.
+func (m *CancellationRefundMutation) ResetPaymentID() {
+	m.payment_id = nil
+}
+
+// SetMethod sets the "method" field.
+func (m *CancellationRefundMutation) SetMethod(c cancellationrefund.Method) {
+	m.method = &c
+}
+
+// Method returns the value of the "method" field in the mutation.
+func (m *CancellationRefundMutation) Method() (r cancellationrefund.Method, exists bool) {
+	v := m.method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMethod returns the old "method" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldMethod(ctx context.Context) (v cancellationrefund.Method, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMethod: %w", err)
+	}
+	return oldValue.Method, nil
+}
+
+// ResetMethod resets all changes to the "method" field.
+func (m *CancellationRefundMutation) ResetMethod() {
+	m.method = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *CancellationRefundMutation) SetStatus(c cancellationrefund.Status) {
+	m.status = &c
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *CancellationRefundMutation) Status() (r cancellationrefund.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldStatus(ctx context.Context) (v cancellationrefund.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *CancellationRefundMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetAttempts sets the "attempts" field.
+func (m *CancellationRefundMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
+}
+
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *CancellationRefundMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAttempts returns the old "attempts" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldAttempts(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
+	}
+	return oldValue.Attempts, nil
+}
+
+// AddAttempts adds i to the "attempts" field.
+func (m *CancellationRefundMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
+}
+
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *CancellationRefundMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *CancellationRefundMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastError sets the "last_error" field.
+func (m *CancellationRefundMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *CancellationRefundMutation) LastError() (r string, exists bool) {
+	v := m.last_error
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastError returns the old "last_error" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldLastError(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastError requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
+	}
+	return oldValue.LastError, nil
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (m *CancellationRefundMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[cancellationrefund.FieldLastError] = struct{}{}
+}
+
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *CancellationRefundMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[cancellationrefund.FieldLastError]
+	return ok
+}
+
+// ResetLastError resets all changes to the "last_error" field.
+func (m *CancellationRefundMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, cancellationrefund.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *CancellationRefundMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *CancellationRefundMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
+	}
+	return oldValue.NextAttemptAt, nil
+}
+
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *CancellationRefundMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[cancellationrefund.FieldNextAttemptAt] = struct{}{}
+}
+
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *CancellationRefundMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[cancellationrefund.FieldNextAttemptAt]
+	return ok
+}
+
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *CancellationRefundMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, cancellationrefund.FieldNextAttemptAt)
+}
+
+// SetRefundedAt sets the "refunded_at" field.
+func (m *CancellationRefundMutation) SetRefundedAt(t time.Time) {
+	m.refunded_at = &t
+}
+
+// RefundedAt returns the value of the "refunded_at" field in the mutation.
+func (m *CancellationRefundMutation) RefundedAt() (r time.Time, exists bool) {
+	v := m.refunded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefundedAt returns the old "refunded_at" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldRefundedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefundedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefundedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundedAt: %w", err)
+	}
+	return oldValue.RefundedAt, nil
+}
+
+// ClearRefundedAt clears the value of the "refunded_at" field.
+func (m *CancellationRefundMutation) ClearRefundedAt() {
+	m.refunded_at = nil
+	m.clearedFields[cancellationrefund.FieldRefundedAt] = struct{}{}
+}
+
+// RefundedAtCleared returns if the "refunded_at" field was cleared in this mutation.
+func (m *CancellationRefundMutation) RefundedAtCleared() bool {
+	_, ok := m.clearedFields[cancellationrefund.FieldRefundedAt]
+	return ok
+}
+
+// ResetRefundedAt resets all changes to the "refunded_at" field.
+func (m *CancellationRefundMutation) ResetRefundedAt() {
+	m.refunded_at = nil
+	delete(m.clearedFields, cancellationrefund.FieldRefundedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CancellationRefundMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CancellationRefundMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CancellationRefundMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *CancellationRefundMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *CancellationRefundMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the CancellationRefund entity.
+// If the CancellationRefund object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CancellationRefundMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *CancellationRefundMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearCancellation clears the "cancellation" edge to the EventCancellation entity.
+func (m *CancellationRefundMutation) ClearCancellation() {
+	m.clearedcancellation = true
+	m.clearedFields[cancellationrefund.FieldCancellationID] = struct{}{}
+}
+
+// CancellationCleared reports if the "cancellation" edge to the EventCancellation entity was cleared.
+func (m *CancellationRefundMutation) CancellationCleared() bool {
+	return m.clearedcancellation
+}
+
+// CancellationIDs returns the "cancellation" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CancellationID instead. It exists only for internal usage by the builders.
+func (m *CancellationRefundMutation) CancellationIDs() (ids []uuid.UUID) {
+	if id := m.cancellation; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCancellation resets all changes to the "cancellation" edge.
+func (m *CancellationRefundMutation) ResetCancellation() {
+	m.cancellation = nil
+	m.clearedcancellation = false
+}
+
+// Where appends a list predicates to the CancellationRefundMutation builder.
+func (m *CancellationRefundMutation) Where(ps ...predicate.CancellationRefund) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CancellationRefundMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CancellationRefundMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.CancellationRefund, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CancellationRefundMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CancellationRefundMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (CancellationRefund).
+func (m *CancellationRefundMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CancellationRefundMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.cancellation != nil {
+		fields = append(fields, cancellationrefund.FieldCancellationID)
+	}
+	if m.payment_id != nil {
+		fields = append(fields, cancellationrefund.FieldPaymentID)
+	}
+	if m.method != nil {
+		fields = append(fields, cancellationrefund.FieldMethod)
+	}
+	if m.status != nil {
+		fields = append(fields, cancellationrefund.FieldStatus)
+	}
+	if m.attempts != nil {
+		fields = append(fields, cancellationrefund.FieldAttempts)
+	}
+	if m.last_error != nil {
+		fields = append(fields, cancellationrefund.FieldLastError)
+	}
+	if m.next_attempt_at != nil {
+		fields = append(fields, cancellationrefund.FieldNextAttemptAt)
+	}
+	if m.refunded_at != nil {
+		fields = append(fields, cancellationrefund.FieldRefundedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, cancellationrefund.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, cancellationrefund.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CancellationRefundMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case cancellationrefund.FieldCancellationID:
+		return m.CancellationID()
+	case cancellationrefund.FieldPaymentID:
+		return m.PaymentID()
+	case cancellationrefund.FieldMethod:
+		return m.Method()
+	case cancellationrefund.FieldStatus:
+		return m.Status()
+	case cancellationrefund.FieldAttempts:
+		return m.Attempts()
+	case cancellationrefund.FieldLastError:
+		return m.LastError()
+	case cancellationrefund.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case cancellationrefund.FieldRefundedAt:
+		return m.RefundedAt()
+	case cancellationrefund.FieldCreatedAt:
+		return m.CreatedAt()
+	case cancellationrefund.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CancellationRefundMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case cancellationrefund.FieldCancellationID:
+		return m.OldCancellationID(ctx)
+	case cancellationrefund.FieldPaymentID:
+		return m.OldPaymentID(ctx)
+	case cancellationrefund.FieldMethod:
+		return m.OldMethod(ctx)
+	case cancellationrefund.FieldStatus:
+		return m.OldStatus(ctx)
+	case cancellationrefund.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case cancellationrefund.FieldLastError:
+		return m.OldLastError(ctx)
+	case cancellationrefund.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case cancellationrefund.FieldRefundedAt:
+		return m.OldRefundedAt(ctx)
+	case cancellationrefund.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case cancellationrefund.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown CancellationRefund field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CancellationRefundMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case cancellationrefund.FieldCancellationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCancellationID(v)
+		return nil
+	case cancellationrefund.FieldPaymentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentID(v)
+		return nil
+	case cancellationrefund.FieldMethod:
+		v, ok := value.(cancellationrefund.Method)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMethod(v)
+		return nil
+	case cancellationrefund.FieldStatus:
+		v, ok := value.(cancellationrefund.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case cancellationrefund.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case cancellationrefund.FieldLastError:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastError(v)
+		return nil
+	case cancellationrefund.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNextAttemptAt(v)
+		return nil
+	case cancellationrefund.FieldRefundedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundedAt(v)
+		return nil
+	case cancellationrefund.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case cancellationrefund.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CancellationRefund field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CancellationRefundMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, cancellationrefund.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CancellationRefundMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case cancellationrefund.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CancellationRefundMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case cancellationrefund.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown CancellationRefund numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CancellationRefundMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(cancellationrefund.FieldLastError) {
+		fields = append(fields, cancellationrefund.FieldLastError)
+	}
+	if m.FieldCleared(cancellationrefund.FieldNextAttemptAt) {
+		fields = append(fields, cancellationrefund.FieldNextAttemptAt)
+	}
+	if m.FieldCleared(cancellationrefund.FieldRefundedAt) {
+		fields = append(fields, cancellationrefund.FieldRefundedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CancellationRefundMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CancellationRefundMutation) ClearField(name string) error {
+	switch name {
+	case cancellationrefund.FieldLastError:
+		m.ClearLastError()
+		return nil
+	case cancellationrefund.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case cancellationrefund.FieldRefundedAt:
+		m.ClearRefundedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CancellationRefund nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CancellationRefundMutation) ResetField(name string) error {
+	switch name {
+	case cancellationrefund.FieldCancellationID:
+		m.ResetCancellationID()
+		return nil
+	case cancellationrefund.FieldPaymentID:
+		m.ResetPaymentID()
+		return nil
+	case cancellationrefund.FieldMethod:
+		m.ResetMethod()
+		return nil
+	case cancellationrefund.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case cancellationrefund.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case cancellationrefund.FieldLastError:
+		m.ResetLastError()
+		return nil
+	case cancellationrefund.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
+		return nil
+	case cancellationrefund.FieldRefundedAt:
+		m.ResetRefundedAt()
+		return nil
+	case cancellationrefund.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case cancellationrefund.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown CancellationRefund field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CancellationRefundMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cancellation != nil {
+		edges = append(edges, cancellationrefund.EdgeCancellation)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CancellationRefundMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case cancellationrefund.EdgeCancellation:
+		if id := m.cancellation; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CancellationRefundMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CancellationRefundMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CancellationRefundMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedcancellation {
+		edges = append(edges, cancellationrefund.EdgeCancellation)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CancellationRefundMutation) EdgeCleared(name string) bool {
+	switch name {
+	case cancellationrefund.EdgeCancellation:
+		return m.clearedcancellation
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CancellationRefundMutation) ClearEdge(name string) error {
+	switch name {
+	case cancellationrefund.EdgeCancellation:
+		m.ClearCancellation()
+		return nil
+	}
+	return fmt.Errorf("unknown CancellationRefund unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CancellationRefundMutation) ResetEdge(name string) error {
+	switch name {
+	case cancellationrefund.EdgeCancellation:
+		m.ResetCancellation()
+		return nil
+	}
+	return fmt.Errorf("unknown CancellationRefund edge %s", name)
+}
+
+// EventMutation represents an operation that mutates the Event nodes in the graph.
+type EventMutation struct {
+	config
+	op                            Op
+	typ                           string
+	id                            *uuid.UUID
+	title                         *string
+	description                   *string
+	location                      *string
+	venue                         *string
+	seating_layout                *string
+	address_street                *string
+	address_city                  *string
+	address_region                *string
+	address_postal_code           *string
+	address_country               *string
+	latitude                      *float64
+	addlatitude                   *float64
+	longitude                     *float64
+	addlongitude                  *float64
+	geohash                       *string
+	category                      *string
+	title_initials                *string
+	venue_initials                *string
+	start_time                    *time.Time
+	end_time                      *time.Time
+	total_tickets                 *int
+	addtotal_tickets              *int
+	available_tickets             *int
+	addavailable_tickets          *int
+	participant_count             *int
+	addparticipant_count          *int
+	ticket_price                  *float64
+	addticket_price               *float64
+	currency                      *string
+	thumbnail_url                 *string
+	status                        *event.Status
+	review_status                 *event.ReviewStatus
+	review_comment                *string
+	submitted_by                  *uuid.UUID
+	submitted_at                  *time.Time
+	reviewed_by                   *uuid.UUID
+	reviewed_at                   *time.Time
+	publish_at                    *time.Time
+	date_change_refund_until      *time.Time
+	is_public                     *bool
+	requires_approval             *bool
+	created_at                    *time.Time
+	updated_at                    *time.Time
+	clearedFields                 map[string]struct{}
+	organization                  *uuid.UUID
+	clearedorganization           bool
+	creator                       *uuid.UUID
+	clearedcreator                bool
+	linked_venue                  *uuid.UUID
+	clearedlinked_venue           bool
+	series                        *uuid.UUID
+	clearedseries                 bool
+	payments                      map[uuid.UUID]struct{}
+	removedpayments               map[uuid.UUID]struct{}
+	clearedpayments               bool
+	registration_questions        map[uuid.UUID]struct{}
+	removedregistration_questions map[uuid.UUID]struct{}
+	clearedregistration_questions bool
+	done                          bool
+	oldValue                      func(context.Context) (*Event, error)
+	predicates                    []predicate.Event
+}
+
+var _ ent.Mutation = (*EventMutation)(nil)
+
+// eventOption allows management of the mutation configuration using functional options.
+type eventOption func(*EventMutation)
+
+// newEventMutation creates new mutation for the Event entity.
+func newEventMutation(c config, op Op, opts ...eventOption) *EventMutation {
+	m := &EventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventID sets the ID field of the mutation.
+func withEventID(id uuid.UUID) eventOption {
+	return func(m *EventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Event
+		)
+		m.oldValue = func(ctx context.Context) (*Event, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Event.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEvent sets the old Event of the mutation.
+func withEvent(node *Event) eventOption {
+	return func(m *EventMutation) {
+		m.oldValue = func(context.Context) (*Event, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Event entities.
+func (m *EventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Event.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *EventMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *EventMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *EventMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetSeriesID sets the "series_id" field.
+func (m *EventMutation) SetSeriesID(u uuid.UUID) {
+	m.series = &u
+}
+
+// SeriesID returns the value of the "series_id" field in the mutation.
+func (m *EventMutation) SeriesID() (r uuid.UUID, exists bool) {
+	v := m.series
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeriesID returns the old "series_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSeriesID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeriesID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeriesID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeriesID: %w", err)
+	}
+	return oldValue.SeriesID, nil
+}
+
+// ClearSeriesID clears the value of the "series_id" field.
+func (m *EventMutation) ClearSeriesID() {
+	m.series = nil
+	m.clearedFields[event.FieldSeriesID] = struct{}{}
+}
+
+// SeriesIDCleared returns if the "series_id" field was cleared in this mutation.
+func (m *EventMutation) SeriesIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldSeriesID]
+	return ok
+}
+
+// ResetSeriesID resets all changes to the "series_id" field.
+func (m *EventMutation) ResetSeriesID() {
+	m.series = nil
+	delete(m.clearedFields, event.FieldSeriesID)
+}
+
+// SetTitle sets the "title" field.
+func (m *EventMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *EventMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *EventMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EventMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EventMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[event.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EventMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[event.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, event.FieldDescription)
+}
+
+// SetLocation sets the "location" field.
+func (m *EventMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *EventMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *EventMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[event.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *EventMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[event.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *EventMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, event.FieldLocation)
+}
+
+// SetVenue sets the "venue" field.
+func (m *EventMutation) SetVenue(s string) {
+	m.venue = &s
+}
+
+// Venue returns the value of the "venue" field in the mutation.
+func (m *EventMutation) Venue() (r string, exists bool) {
+	v := m.venue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVenue returns the old "venue" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldVenue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVenue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVenue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVenue: %w", err)
+	}
+	return oldValue.Venue, nil
+}
+
+// ClearVenue clears the value of the "venue" field.
+func (m *EventMutation) ClearVenue() {
+	m.venue = nil
+	m.clearedFields[event.FieldVenue] = struct{}{}
+}
+
+// VenueCleared returns if the "venue" field was cleared in this mutation.
+func (m *EventMutation) VenueCleared() bool {
+	_, ok := m.clearedFields[event.FieldVenue]
+	return ok
+}
+
+// ResetVenue resets all changes to the "venue" field.
+func (m *EventMutation) ResetVenue() {
+	m.venue = nil
+	delete(m.clearedFields, event.FieldVenue)
+}
+
+// SetVenueID sets the "venue_id" field.
+func (m *EventMutation) SetVenueID(u uuid.UUID) {
+	m.linked_venue = &u
+}
+
+// VenueID returns the value of the "venue_id" field in the mutation.
+func (m *EventMutation) VenueID() (r uuid.UUID, exists bool) {
+	v := m.linked_venue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVenueID returns the old "venue_id" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldVenueID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVenueID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVenueID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVenueID: %w", err)
+	}
+	return oldValue.VenueID, nil
+}
+
+// ClearVenueID clears the value of the "venue_id" field.
+func (m *EventMutation) ClearVenueID() {
+	m.linked_venue = nil
+	m.clearedFields[event.FieldVenueID] = struct{}{}
+}
+
+// VenueIDCleared returns if the "venue_id" field was cleared in this mutation.
+func (m *EventMutation) VenueIDCleared() bool {
+	_, ok := m.clearedFields[event.FieldVenueID]
+	return ok
+}
+
+// ResetVenueID resets all changes to the "venue_id" field.
+func (m *EventMutation) ResetVenueID() {
+	m.linked_venue = nil
+	delete(m.clearedFields, event.FieldVenueID)
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (m *EventMutation) SetSeatingLayout(s string) {
+	m.seating_layout = &s
+}
+
+// SeatingLayout returns the value of the "seating_layout" field in the mutation.
+func (m *EventMutation) SeatingLayout() (r string, exists bool) {
+	v := m.seating_layout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeatingLayout returns the old "seating_layout" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSeatingLayout(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeatingLayout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeatingLayout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeatingLayout: %w", err)
+	}
+	return oldValue.SeatingLayout, nil
+}
+
+// ResetSeatingLayout resets all changes to the "seating_layout" field.
+func (m *EventMutation) ResetSeatingLayout() {
+	m.seating_layout = nil
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (m *EventMutation) SetAddressStreet(s string) {
+	m.address_street = &s
+}
+
+// AddressStreet returns the value of the "address_street" field in the mutation.
+func (m *EventMutation) AddressStreet() (r string, exists bool) {
+	v := m.address_street
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressStreet returns the old "address_street" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldAddressStreet(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressStreet is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressStreet requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressStreet: %w", err)
+	}
+	return oldValue.AddressStreet, nil
+}
+
+// ResetAddressStreet resets all changes to the "address_street" field.
+func (m *EventMutation) ResetAddressStreet() {
+	m.address_street = nil
+}
+
+// SetAddressCity sets the "address_city" field.
+func (m *EventMutation) SetAddressCity(s string) {
+	m.address_city = &s
+}
+
+// AddressCity returns the value of the "address_city" field in the mutation.
+func (m *EventMutation) AddressCity() (r string, exists bool) {
+	v := m.address_city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressCity returns the old "address_city" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldAddressCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressCity: %w", err)
+	}
+	return oldValue.AddressCity, nil
+}
+
+// ResetAddressCity resets all changes to the "address_city" field.
+func (m *EventMutation) ResetAddressCity() {
+	m.address_city = nil
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (m *EventMutation) SetAddressRegion(s string) {
+	m.address_region = &s
+}
+
+// AddressRegion returns the value of the "address_region" field in the mutation.
+func (m *EventMutation) AddressRegion() (r string, exists bool) {
+	v := m.address_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressRegion returns the old "address_region" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldAddressRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressRegion: %w", err)
+	}
+	return oldValue.AddressRegion, nil
+}
+
+// ResetAddressRegion resets all changes to the "address_region" field.
+func (m *EventMutation) ResetAddressRegion() {
+	m.address_region = nil
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (m *EventMutation) SetAddressPostalCode(s string) {
+	m.address_postal_code = &s
+}
+
+// AddressPostalCode returns the value of the "address_postal_code" field in the mutation.
+func (m *EventMutation) AddressPostalCode() (r string, exists bool) {
+	v := m.address_postal_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressPostalCode returns the old "address_postal_code" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldAddressPostalCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressPostalCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressPostalCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressPostalCode: %w", err)
+	}
+	return oldValue.AddressPostalCode, nil
+}
+
+// ResetAddressPostalCode resets all changes to the "address_postal_code" field.
+func (m *EventMutation) ResetAddressPostalCode() {
+	m.address_postal_code = nil
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (m *EventMutation) SetAddressCountry(s string) {
+	m.address_country = &s
+}
+
+// AddressCountry returns the value of the "address_country" field in the mutation.
+func (m *EventMutation) AddressCountry() (r string, exists bool) {
+	v := m.address_country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressCountry returns the old "address_country" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldAddressCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressCountry: %w", err)
+	}
+	return oldValue.AddressCountry, nil
+}
+
+// ResetAddressCountry resets all changes to the "address_country" field.
+func (m *EventMutation) ResetAddressCountry() {
+	m.address_country = nil
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *EventMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *EventMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLatitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (m *EventMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
+}
+
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *EventMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (m *EventMutation) ClearLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	m.clearedFields[event.FieldLatitude] = struct{}{}
+}
+
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *EventMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[event.FieldLatitude]
+	return ok
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *EventMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	delete(m.clearedFields, event.FieldLatitude)
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *EventMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *EventMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldLongitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *EventMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *EventMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (m *EventMutation) ClearLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	m.clearedFields[event.FieldLongitude] = struct{}{}
+}
+
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *EventMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[event.FieldLongitude]
+	return ok
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *EventMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	delete(m.clearedFields, event.FieldLongitude)
+}
+
+// SetGeohash sets the "geohash" field.
+func (m *EventMutation) SetGeohash(s string) {
+	m.geohash = &s
+}
+
+// Geohash returns the value of the "geohash" field in the mutation.
+func (m *EventMutation) Geohash() (r string, exists bool) {
+	v := m.geohash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGeohash returns the old "geohash" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldGeohash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGeohash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGeohash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGeohash: %w", err)
+	}
+	return oldValue.Geohash, nil
+}
+
+// ResetGeohash resets all changes to the "geohash" field.
+func (m *EventMutation) ResetGeohash() {
+	m.geohash = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *EventMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *EventMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *EventMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetTitleInitials sets the "title_initials" field.
+func (m *EventMutation) SetTitleInitials(s string) {
+	m.title_initials = &s
+}
+
+// TitleInitials returns the value of the "title_initials" field in the mutation.
+func (m *EventMutation) TitleInitials() (r string, exists bool) {
+	v := m.title_initials
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitleInitials returns the old "title_initials" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTitleInitials(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitleInitials is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitleInitials requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitleInitials: %w", err)
+	}
+	return oldValue.TitleInitials, nil
+}
+
+// ResetTitleInitials resets all changes to the "title_initials" field.
+func (m *EventMutation) ResetTitleInitials() {
+	m.title_initials = nil
+}
+
+// SetVenueInitials sets the "venue_initials" field.
+func (m *EventMutation) SetVenueInitials(s string) {
+	m.venue_initials = &s
+}
+
+// VenueInitials returns the value of the "venue_initials" field in the mutation.
+func (m *EventMutation) VenueInitials() (r string, exists bool) {
+	v := m.venue_initials
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVenueInitials returns the old "venue_initials" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldVenueInitials(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVenueInitials is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVenueInitials requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVenueInitials: %w", err)
+	}
+	return oldValue.VenueInitials, nil
+}
+
+// ResetVenueInitials resets all changes to the "venue_initials" field.
+func (m *EventMutation) ResetVenueInitials() {
+	m.venue_initials = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *EventMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *EventMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *EventMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *EventMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *EventMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *EventMutation) ResetEndTime() {
+	m.end_time = nil
+}
+
+// SetTotalTickets sets the "total_tickets" field.
+func (m *EventMutation) SetTotalTickets(i int) {
+	m.total_tickets = &i
+	m.addtotal_tickets = nil
+}
+
+// TotalTickets returns the value of the "total_tickets" field in the mutation.
+func (m *EventMutation) TotalTickets() (r int, exists bool) {
+	v := m.total_tickets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTickets returns the old "total_tickets" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTotalTickets(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTickets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTickets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTickets: %w", err)
+	}
+	return oldValue.TotalTickets, nil
+}
+
+// AddTotalTickets adds i to the "total_tickets" field.
+func (m *EventMutation) AddTotalTickets(i int) {
+	if m.addtotal_tickets != nil {
+		*m.addtotal_tickets += i
+	} else {
+		m.addtotal_tickets = &i
+	}
+}
+
+// AddedTotalTickets returns the value that was added to the "total_tickets" field in this mutation.
+func (m *EventMutation) AddedTotalTickets() (r int, exists bool) {
+	v := m.addtotal_tickets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalTickets resets all changes to the "total_tickets" field.
+func (m *EventMutation) ResetTotalTickets() {
+	m.total_tickets = nil
+	m.addtotal_tickets = nil
+}
+
+// SetAvailableTickets sets the "available_tickets" field.
+func (m *EventMutation) SetAvailableTickets(i int) {
+	m.available_tickets = &i
+	m.addavailable_tickets = nil
+}
+
+// AvailableTickets returns the value of the "available_tickets" field in the mutation.
+func (m *EventMutation) AvailableTickets() (r int, exists bool) {
+	v := m.available_tickets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvailableTickets returns the old "available_tickets" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldAvailableTickets(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvailableTickets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvailableTickets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvailableTickets: %w", err)
+	}
+	return oldValue.AvailableTickets, nil
+}
+
+// AddAvailableTickets adds i to the "available_tickets" field.
+func (m *EventMutation) AddAvailableTickets(i int) {
+	if m.addavailable_tickets != nil {
+		*m.addavailable_tickets += i
+	} else {
+		m.addavailable_tickets = &i
+	}
+}
+
+// AddedAvailableTickets returns the value that was added to the "available_tickets" field in this mutation.
+func (m *EventMutation) AddedAvailableTickets() (r int, exists bool) {
+	v := m.addavailable_tickets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAvailableTickets resets all changes to the "available_tickets" field.
+func (m *EventMutation) ResetAvailableTickets() {
+	m.available_tickets = nil
+	m.addavailable_tickets = nil
+}
+
+// SetParticipantCount sets the "participant_count" field.
+func (m *EventMutation) SetParticipantCount(i int) {
+	m.participant_count = &i
+	m.addparticipant_count = nil
+}
+
+// ParticipantCount returns the value of the "participant_count" field in the mutation.
+func (m *EventMutation) ParticipantCount() (r int, exists bool) {
+	v := m.participant_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldParticipantCount returns the old "participant_count" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldParticipantCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldParticipantCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldParticipantCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldParticipantCount: %w", err)
+	}
+	return oldValue.ParticipantCount, nil
+}
+
+// AddParticipantCount adds i to the "participant_count" field.
+func (m *EventMutation) AddParticipantCount(i int) {
+	if m.addparticipant_count != nil {
+		*m.addparticipant_count += i
+	} else {
+		m.addparticipant_count = &i
+	}
+}
+
+// AddedParticipantCount returns the value that was added to the "participant_count" field in this mutation.
+func (m *EventMutation) AddedParticipantCount() (r int, exists bool) {
+	v := m.addparticipant_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetParticipantCount resets all changes to the "participant_count" field.
+func (m *EventMutation) ResetParticipantCount() {
+	m.participant_count = nil
+	m.addparticipant_count = nil
+}
+
+// SetTicketPrice sets the "ticket_price" field.
+func (m *EventMutation) SetTicketPrice(f float64) {
+	m.ticket_price = &f
+	m.addticket_price = nil
+}
+
+// TicketPrice returns the value of the "ticket_price" field in the mutation.
+func (m *EventMutation) TicketPrice() (r float64, exists bool) {
+	v := m.ticket_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTicketPrice returns the old "ticket_price" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldTicketPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTicketPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTicketPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTicketPrice: %w", err)
+	}
+	return oldValue.TicketPrice, nil
+}
+
+// AddTicketPrice adds f to the "ticket_price" field.
+func (m *EventMutation) AddTicketPrice(f float64) {
+	if m.addticket_price != nil {
+		*m.addticket_price += f
+	} else {
+		m.addticket_price = &f
+	}
+}
+
+// AddedTicketPrice returns the value that was added to the "ticket_price" field in this mutation.
+func (m *EventMutation) AddedTicketPrice() (r float64, exists bool) {
+	v := m.addticket_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTicketPrice resets all changes to the "ticket_price" field.
+func (m *EventMutation) ResetTicketPrice() {
+	m.ticket_price = nil
+	m.addticket_price = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *EventMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *EventMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *EventMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *EventMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *EventMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *EventMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[event.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *EventMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[event.FieldThumbnailURL]
+	return ok
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *EventMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, event.FieldThumbnailURL)
+}
+
+// SetStatus sets the "status" field.
+func (m *EventMutation) SetStatus(e event.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EventMutation) Status() (r event.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldStatus(ctx context.Context) (v event.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EventMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetReviewStatus sets the "review_status" field.
+func (m *EventMutation) SetReviewStatus(es event.ReviewStatus) {
+	m.review_status = &es
+}
+
+// ReviewStatus returns the value of the "review_status" field in the mutation.
+func (m *EventMutation) ReviewStatus() (r event.ReviewStatus, exists bool) {
+	v := m.review_status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewStatus returns the old "review_status" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldReviewStatus(ctx context.Context) (v event.ReviewStatus, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewStatus: %w", err)
+	}
+	return oldValue.ReviewStatus, nil
+}
+
+// ResetReviewStatus resets all changes to the "review_status" field.
+func (m *EventMutation) ResetReviewStatus() {
+	m.review_status = nil
+}
+
+// SetReviewComment sets the "review_comment" field.
+func (m *EventMutation) SetReviewComment(s string) {
+	m.review_comment = &s
+}
+
+// ReviewComment returns the value of the "review_comment" field in the mutation.
+func (m *EventMutation) ReviewComment() (r string, exists bool) {
+	v := m.review_comment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewComment returns the old "review_comment" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldReviewComment(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewComment is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewComment requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewComment: %w", err)
+	}
+	return oldValue.ReviewComment, nil
+}
+
+// ClearReviewComment clears the value of the "review_comment" field.
+func (m *EventMutation) ClearReviewComment() {
+	m.review_comment = nil
+	m.clearedFields[event.FieldReviewComment] = struct{}{}
+}
+
+// ReviewCommentCleared returns if the "review_comment" field was cleared in this mutation.
+func (m *EventMutation) ReviewCommentCleared() bool {
+	_, ok := m.clearedFields[event.FieldReviewComment]
+	return ok
+}
+
+// ResetReviewComment resets all changes to the "review_comment" field.
+func (m *EventMutation) ResetReviewComment() {
+	m.review_comment = nil
+	delete(m.clearedFields, event.FieldReviewComment)
+}
+
+// SetSubmittedBy sets the "submitted_by" field.
+func (m *EventMutation) SetSubmittedBy(u uuid.UUID) {
+	m.submitted_by = &u
+}
+
+// SubmittedBy returns the value of the "submitted_by" field in the mutation.
+func (m *EventMutation) SubmittedBy() (r uuid.UUID, exists bool) {
+	v := m.submitted_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedBy returns the old "submitted_by" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSubmittedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedBy: %w", err)
+	}
+	return oldValue.SubmittedBy, nil
+}
+
+// ClearSubmittedBy clears the value of the "submitted_by" field.
+func (m *EventMutation) ClearSubmittedBy() {
+	m.submitted_by = nil
+	m.clearedFields[event.FieldSubmittedBy] = struct{}{}
+}
+
+// SubmittedByCleared returns if the "submitted_by" field was cleared in this mutation.
+func (m *EventMutation) SubmittedByCleared() bool {
+	_, ok := m.clearedFields[event.FieldSubmittedBy]
+	return ok
+}
+
+// ResetSubmittedBy resets all changes to the "submitted_by" field.
+func (m *EventMutation) ResetSubmittedBy() {
+	m.submitted_by = nil
+	delete(m.clearedFields, event.FieldSubmittedBy)
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *EventMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *EventMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldSubmittedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (m *EventMutation) ClearSubmittedAt() {
+	m.submitted_at = nil
+	m.clearedFields[event.FieldSubmittedAt] = struct{}{}
+}
+
+// SubmittedAtCleared returns if the "submitted_at" field was cleared in this mutation.
+func (m *EventMutation) SubmittedAtCleared() bool {
+	_, ok := m.clearedFields[event.FieldSubmittedAt]
+	return ok
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *EventMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+	delete(m.clearedFields, event.FieldSubmittedAt)
+}
+
+// SetReviewedBy sets the "reviewed_by" field.
+func (m *EventMutation) SetReviewedBy(u uuid.UUID) {
+	m.reviewed_by = &u
+}
+
+// ReviewedBy returns the value of the "reviewed_by" field in the mutation.
+func (m *EventMutation) ReviewedBy() (r uuid.UUID, exists bool) {
+	v := m.reviewed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedBy returns the old "reviewed_by" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldReviewedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedBy: %w", err)
+	}
+	return oldValue.ReviewedBy, nil
+}
+
+// ClearReviewedBy clears the value of the "reviewed_by" field.
+func (m *EventMutation) ClearReviewedBy() {
+	m.reviewed_by = nil
+	m.clearedFields[event.FieldReviewedBy] = struct{}{}
+}
+
+// ReviewedByCleared returns if the "reviewed_by" field was cleared in this mutation.
+func (m *EventMutation) ReviewedByCleared() bool {
+	_, ok := m.clearedFields[event.FieldReviewedBy]
+	return ok
+}
+
+// ResetReviewedBy resets all changes to the "reviewed_by" field.
+func (m *EventMutation) ResetReviewedBy() {
+	m.reviewed_by = nil
+	delete(m.clearedFields, event.FieldReviewedBy)
+}
+
+// SetReviewedAt sets the "reviewed_at" field.
+func (m *EventMutation) SetReviewedAt(t time.Time) {
+	m.reviewed_at = &t
+}
+
+// ReviewedAt returns the value of the "reviewed_at" field in the mutation.
+func (m *EventMutation) ReviewedAt() (r time.Time, exists bool) {
+	v := m.reviewed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewedAt returns the old "reviewed_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldReviewedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewedAt: %w", err)
+	}
+	return oldValue.ReviewedAt, nil
+}
+
+// ClearReviewedAt clears the value of the "reviewed_at" field.
+func (m *EventMutation) ClearReviewedAt() {
+	m.reviewed_at = nil
+	m.clearedFields[event.FieldReviewedAt] = struct{}{}
+}
+
+// ReviewedAtCleared returns if the "reviewed_at" field was cleared in this mutation.
+func (m *EventMutation) ReviewedAtCleared() bool {
+	_, ok := m.clearedFields[event.FieldReviewedAt]
+	return ok
+}
+
+// ResetReviewedAt resets all changes to the "reviewed_at" field.
+func (m *EventMutation) ResetReviewedAt() {
+	m.reviewed_at = nil
+	delete(m.clearedFields, event.FieldReviewedAt)
+}
+
+// SetPublishAt sets the "publish_at" field.
+func (m *EventMutation) SetPublishAt(t time.Time) {
+	m.publish_at = &t
+}
+
+// PublishAt returns the value of the "publish_at" field in the mutation.
+func (m *EventMutation) PublishAt() (r time.Time, exists bool) {
+	v := m.publish_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPublishAt returns the old "publish_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldPublishAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPublishAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPublishAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPublishAt: %w", err)
+	}
+	return oldValue.PublishAt, nil
+}
+
+// ClearPublishAt clears the value of the "publish_at" field.
+func (m *EventMutation) ClearPublishAt() {
+	m.publish_at = nil
+	m.clearedFields[event.FieldPublishAt] = struct{}{}
+}
+
+// PublishAtCleared returns if the "publish_at" field was cleared in this mutation.
+func (m *EventMutation) PublishAtCleared() bool {
+	_, ok := m.clearedFields[event.FieldPublishAt]
+	return ok
+}
+
+// ResetPublishAt resets all changes to the "publish_at" field.
+func (m *EventMutation) ResetPublishAt() {
+	m.publish_at = nil
+	delete(m.clearedFields, event.FieldPublishAt)
+}
+
+// SetDateChangeRefundUntil sets the "date_change_refund_until" field.
+func (m *EventMutation) SetDateChangeRefundUntil(t time.Time) {
+	m.date_change_refund_until = &t
+}
+
+// DateChangeRefundUntil returns the value of the "date_change_refund_until" field in the mutation.
+func (m *EventMutation) DateChangeRefundUntil() (r time.Time, exists bool) {
+	v := m.date_change_refund_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDateChangeRefundUntil returns the old "date_change_refund_until" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldDateChangeRefundUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDateChangeRefundUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDateChangeRefundUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDateChangeRefundUntil: %w", err)
+	}
+	return oldValue.DateChangeRefundUntil, nil
+}
+
+// ClearDateChangeRefundUntil clears the value of the "date_change_refund_until" field.
+func (m *EventMutation) ClearDateChangeRefundUntil() {
+	m.date_change_refund_until = nil
+	m.clearedFields[event.FieldDateChangeRefundUntil] = struct{}{}
+}
+
+// DateChangeRefundUntilCleared returns if the "date_change_refund_until" field was cleared in this mutation.
+func (m *EventMutation) DateChangeRefundUntilCleared() bool {
+	_, ok := m.clearedFields[event.FieldDateChangeRefundUntil]
+	return ok
+}
+
+// ResetDateChangeRefundUntil resets all changes to the "date_change_refund_until" field.
+func (m *EventMutation) ResetDateChangeRefundUntil() {
+	m.date_change_refund_until = nil
+	delete(m.clearedFields, event.FieldDateChangeRefundUntil)
+}
+
+// SetIsPublic sets the "is_public" field.
+func (m *EventMutation) SetIsPublic(b bool) {
+	m.is_public = &b
+}
+
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *EventMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublic returns the old "is_public" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
+}
+
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *EventMutation) ResetIsPublic() {
+	m.is_public = nil
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (m *EventMutation) SetRequiresApproval(b bool) {
+	m.requires_approval = &b
+}
+
+// RequiresApproval returns the value of the "requires_approval" field in the mutation.
+func (m *EventMutation) RequiresApproval() (r bool, exists bool) {
+	v := m.requires_approval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiresApproval returns the old "requires_approval" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldRequiresApproval(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiresApproval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiresApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiresApproval: %w", err)
+	}
+	return oldValue.RequiresApproval, nil
+}
+
+// ResetRequiresApproval resets all changes to the "requires_approval" field.
+func (m *EventMutation) ResetRequiresApproval() {
+	m.requires_approval = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *EventMutation) SetCreatedBy(u uuid.UUID) {
+	m.creator = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EventMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.creator
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EventMutation) ResetCreatedBy() {
+	m.creator = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Event entity.
+// If the Event object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *EventMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[event.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *EventMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *EventMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// SetCreatorID sets the "creator" edge to the User entity by id.
+func (m *EventMutation) SetCreatorID(id uuid.UUID) {
+	m.creator = &id
+}
+
+// ClearCreator clears the "creator" edge to the User entity.
+func (m *EventMutation) ClearCreator() {
+	m.clearedcreator = true
+	m.clearedFields[event.FieldCreatedBy] = struct{}{}
+}
+
+// CreatorCleared reports if the "creator" edge to the User entity was cleared.
+func (m *EventMutation) CreatorCleared() bool {
+	return m.clearedcreator
+}
+
+// CreatorID returns the "creator" edge ID in the mutation.
+func (m *EventMutation) CreatorID() (id uuid.UUID, exists bool) {
+	if m.creator != nil {
+		return *m.creator, true
+	}
+	return
+}
+
+// CreatorIDs returns the "creator" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// CreatorID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) CreatorIDs() (ids []uuid.UUID) {
+	if id := m.creator; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetCreator resets all changes to the "creator" edge.
+func (m *EventMutation) ResetCreator() {
+	m.creator = nil
+	m.clearedcreator = false
+}
+
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by id.
+func (m *EventMutation) SetLinkedVenueID(id uuid.UUID) {
+	m.linked_venue = &id
+}
+
+// ClearLinkedVenue clears the "linked_venue" edge to the Venue entity.
+func (m *EventMutation) ClearLinkedVenue() {
+	m.clearedlinked_venue = true
+	m.clearedFields[event.FieldVenueID] = struct{}{}
+}
+
+// LinkedVenueCleared reports if the "linked_venue" edge to the Venue entity was cleared.
+func (m *EventMutation) LinkedVenueCleared() bool {
+	return m.VenueIDCleared() || m.clearedlinked_venue
+}
+
+// LinkedVenueID returns the "linked_venue" edge ID in the mutation.
+func (m *EventMutation) LinkedVenueID() (id uuid.UUID, exists bool) {
+	if m.linked_venue != nil {
+		return *m.linked_venue, true
+	}
+	return
+}
+
+// LinkedVenueIDs returns the "linked_venue" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LinkedVenueID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) LinkedVenueIDs() (ids []uuid.UUID) {
+	if id := m.linked_venue; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLinkedVenue resets all changes to the "linked_venue" edge.
+func (m *EventMutation) ResetLinkedVenue() {
+	m.linked_venue = nil
+	m.clearedlinked_venue = false
+}
+
+// ClearSeries clears the "series" edge to the EventSeries entity.
+func (m *EventMutation) ClearSeries() {
+	m.clearedseries = true
+	m.clearedFields[event.FieldSeriesID] = struct{}{}
+}
+
+// SeriesCleared reports if the "series" edge to the EventSeries entity was cleared.
+func (m *EventMutation) SeriesCleared() bool {
+	return m.SeriesIDCleared() || m.clearedseries
+}
+
+// SeriesIDs returns the "series" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SeriesID instead. It exists only for internal usage by the builders.
+func (m *EventMutation) SeriesIDs() (ids []uuid.UUID) {
+	if id := m.series; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSeries resets all changes to the "series" edge.
+func (m *EventMutation) ResetSeries() {
+	m.series = nil
+	m.clearedseries = false
+}
+
+// AddPaymentIDs adds the "payments" edge to the Payment entity by ids.
+func (m *EventMutation) AddPaymentIDs(ids ...uuid.UUID) {
+	if m.payments == nil {
+		m.payments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.payments[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPayments clears the "payments" edge to the Payment entity.
+func (m *EventMutation) ClearPayments() {
+	m.clearedpayments = true
+}
+
+// PaymentsCleared reports if the "payments" edge to the Payment entity was cleared.
+func (m *EventMutation) PaymentsCleared() bool {
+	return m.clearedpayments
+}
+
+// RemovePaymentIDs removes the "payments" edge to the Payment entity by IDs.
+func (m *EventMutation) RemovePaymentIDs(ids ...uuid.UUID) {
+	if m.removedpayments == nil {
+		m.removedpayments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.payments, ids[i])
+		m.removedpayments[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPayments returns the removed IDs of the "payments" edge to the Payment entity.
+func (m *EventMutation) RemovedPaymentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpayments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PaymentsIDs returns the "payments" edge IDs in the mutation.
+func (m *EventMutation) PaymentsIDs() (ids []uuid.UUID) {
+	for id := range m.payments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPayments resets all changes to the "payments" edge.
+func (m *EventMutation) ResetPayments() {
+	m.payments = nil
+	m.clearedpayments = false
+	m.removedpayments = nil
+}
+
+// AddRegistrationQuestionIDs adds the "registration_questions" edge to the RegistrationQuestion entity by ids.
+func (m *EventMutation) AddRegistrationQuestionIDs(ids ...uuid.UUID) {
+	if m.registration_questions == nil {
+		m.registration_questions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.registration_questions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRegistrationQuestions clears the "registration_questions" edge to the RegistrationQuestion entity.
+func (m *EventMutation) ClearRegistrationQuestions() {
+	m.clearedregistration_questions = true
+}
+
+// RegistrationQuestionsCleared reports if the "registration_questions" edge to the RegistrationQuestion entity was cleared.
+func (m *EventMutation) RegistrationQuestionsCleared() bool {
+	return m.clearedregistration_questions
+}
+
+// RemoveRegistrationQuestionIDs removes the "registration_questions" edge to the RegistrationQuestion entity by IDs.
+func (m *EventMutation) RemoveRegistrationQuestionIDs(ids ...uuid.UUID) {
+	if m.removedregistration_questions == nil {
+		m.removedregistration_questions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.registration_questions, ids[i])
+		m.removedregistration_questions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRegistrationQuestions returns the removed IDs of the "registration_questions" edge to the RegistrationQuestion entity.
+func (m *EventMutation) RemovedRegistrationQuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedregistration_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RegistrationQuestionsIDs returns the "registration_questions" edge IDs in the mutation.
+func (m *EventMutation) RegistrationQuestionsIDs() (ids []uuid.UUID) {
+	for id := range m.registration_questions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRegistrationQuestions resets all changes to the "registration_questions" edge.
+func (m *EventMutation) ResetRegistrationQuestions() {
+	m.registration_questions = nil
+	m.clearedregistration_questions = false
+	m.removedregistration_questions = nil
+}
+
+// Where appends a list predicates to the EventMutation builder.
+func (m *EventMutation) Where(ps ...predicate.Event) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Event, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Event).
+func (m *EventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventMutation) Fields() []string {
+	fields := make([]string, 0, 41)
+	if m.organization != nil {
+		fields = append(fields, event.FieldOrganizationID)
+	}
+	if m.series != nil {
+		fields = append(fields, event.FieldSeriesID)
+	}
+	if m.title != nil {
+		fields = append(fields, event.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, event.FieldDescription)
+	}
+	if m.location != nil {
+		fields = append(fields, event.FieldLocation)
+	}
+	if m.venue != nil {
+		fields = append(fields, event.FieldVenue)
+	}
+	if m.linked_venue != nil {
+		fields = append(fields, event.FieldVenueID)
+	}
+	if m.seating_layout != nil {
+		fields = append(fields, event.FieldSeatingLayout)
+	}
+	if m.address_street != nil {
+		fields = append(fields, event.FieldAddressStreet)
+	}
+	if m.address_city != nil {
+		fields = append(fields, event.FieldAddressCity)
+	}
+	if m.address_region != nil {
+		fields = append(fields, event.FieldAddressRegion)
+	}
+	if m.address_postal_code != nil {
+		fields = append(fields, event.FieldAddressPostalCode)
+	}
+	if m.address_country != nil {
+		fields = append(fields, event.FieldAddressCountry)
+	}
+	if m.latitude != nil {
+		fields = append(fields, event.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, event.FieldLongitude)
+	}
+	if m.geohash != nil {
+		fields = append(fields, event.FieldGeohash)
+	}
+	if m.category != nil {
+		fields = append(fields, event.FieldCategory)
+	}
+	if m.title_initials != nil {
+		fields = append(fields, event.FieldTitleInitials)
+	}
+	if m.venue_initials != nil {
+		fields = append(fields, event.FieldVenueInitials)
+	}
+	if m.start_time != nil {
+		fields = append(fields, event.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, event.FieldEndTime)
+	}
+	if m.total_tickets != nil {
+		fields = append(fields, event.FieldTotalTickets)
+	}
+	if m.available_tickets != nil {
+		fields = append(fields, event.FieldAvailableTickets)
+	}
+	if m.participant_count != nil {
+		fields = append(fields, event.FieldParticipantCount)
+	}
+	if m.ticket_price != nil {
+		fields = append(fields, event.FieldTicketPrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, event.FieldCurrency)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, event.FieldThumbnailURL)
+	}
+	if m.status != nil {
+		fields = append(fields, event.FieldStatus)
+	}
+	if m.review_status != nil {
+		fields = append(fields, event.FieldReviewStatus)
+	}
+	if m.review_comment != nil {
+		fields = append(fields, event.FieldReviewComment)
+	}
+	if m.submitted_by != nil {
+		fields = append(fields, event.FieldSubmittedBy)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, event.FieldSubmittedAt)
+	}
+	if m.reviewed_by != nil {
+		fields = append(fields, event.FieldReviewedBy)
+	}
+	if m.reviewed_at != nil {
+		fields = append(fields, event.FieldReviewedAt)
+	}
+	if m.publish_at != nil {
+		fields = append(fields, event.FieldPublishAt)
+	}
+	if m.date_change_refund_until != nil {
+		fields = append(fields, event.FieldDateChangeRefundUntil)
+	}
+	if m.is_public != nil {
+		fields = append(fields, event.FieldIsPublic)
+	}
+	if m.requires_approval != nil {
+		fields = append(fields, event.FieldRequiresApproval)
+	}
+	if m.creator != nil {
+		fields = append(fields, event.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, event.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, event.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldOrganizationID:
+		return m.OrganizationID()
+	case event.FieldSeriesID:
+		return m.SeriesID()
+	case event.FieldTitle:
+		return m.Title()
+	case event.FieldDescription:
+		return m.Description()
+	case event.FieldLocation:
+		return m.Location()
+	case event.FieldVenue:
+		return m.Venue()
+	case event.FieldVenueID:
+		return m.VenueID()
+	case event.FieldSeatingLayout:
+		return m.SeatingLayout()
+	case event.FieldAddressStreet:
+		return m.AddressStreet()
+	case event.FieldAddressCity:
+		return m.AddressCity()
+	case event.FieldAddressRegion:
+		return m.AddressRegion()
+	case event.FieldAddressPostalCode:
+		return m.AddressPostalCode()
+	case event.FieldAddressCountry:
+		return m.AddressCountry()
+	case event.FieldLatitude:
+		return m.Latitude()
+	case event.FieldLongitude:
+		return m.Longitude()
+	case event.FieldGeohash:
+		return m.Geohash()
+	case event.FieldCategory:
+		return m.Category()
+	case event.FieldTitleInitials:
+		return m.TitleInitials()
+	case event.FieldVenueInitials:
+		return m.VenueInitials()
+	case event.FieldStartTime:
+		return m.StartTime()
+	case event.FieldEndTime:
+		return m.EndTime()
+	case event.FieldTotalTickets:
+		return m.TotalTickets()
+	case event.FieldAvailableTickets:
+		return m.AvailableTickets()
+	case event.FieldParticipantCount:
+		return m.ParticipantCount()
+	case event.FieldTicketPrice:
+		return m.TicketPrice()
+	case event.FieldCurrency:
+		return m.Currency()
+	case event.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case event.FieldStatus:
+		return m.Status()
+	case event.FieldReviewStatus:
+		return m.ReviewStatus()
+	case event.FieldReviewComment:
+		return m.ReviewComment()
+	case event.FieldSubmittedBy:
+		return m.SubmittedBy()
+	case event.FieldSubmittedAt:
+		return m.SubmittedAt()
+	case event.FieldReviewedBy:
+		return m.ReviewedBy()
+	case event.FieldReviewedAt:
+		return m.ReviewedAt()
+	case event.FieldPublishAt:
+		return m.PublishAt()
+	case event.FieldDateChangeRefundUntil:
+		return m.DateChangeRefundUntil()
+	case event.FieldIsPublic:
+		return m.IsPublic()
+	case event.FieldRequiresApproval:
+		return m.RequiresApproval()
+	case event.FieldCreatedBy:
+		return m.CreatedBy()
+	case event.FieldCreatedAt:
+		return m.CreatedAt()
+	case event.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case event.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case event.FieldSeriesID:
+		return m.OldSeriesID(ctx)
+	case event.FieldTitle:
+		return m.OldTitle(ctx)
+	case event.FieldDescription:
+		return m.OldDescription(ctx)
+	case event.FieldLocation:
+		return m.OldLocation(ctx)
+	case event.FieldVenue:
+		return m.OldVenue(ctx)
+	case event.FieldVenueID:
+		return m.OldVenueID(ctx)
+	case event.FieldSeatingLayout:
+		return m.OldSeatingLayout(ctx)
+	case event.FieldAddressStreet:
+		return m.OldAddressStreet(ctx)
+	case event.FieldAddressCity:
+		return m.OldAddressCity(ctx)
+	case event.FieldAddressRegion:
+		return m.OldAddressRegion(ctx)
+	case event.FieldAddressPostalCode:
+		return m.OldAddressPostalCode(ctx)
+	case event.FieldAddressCountry:
+		return m.OldAddressCountry(ctx)
+	case event.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case event.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case event.FieldGeohash:
+		return m.OldGeohash(ctx)
+	case event.FieldCategory:
+		return m.OldCategory(ctx)
+	case event.FieldTitleInitials:
+		return m.OldTitleInitials(ctx)
+	case event.FieldVenueInitials:
+		return m.OldVenueInitials(ctx)
+	case event.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case event.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case event.FieldTotalTickets:
+		return m.OldTotalTickets(ctx)
+	case event.FieldAvailableTickets:
+		return m.OldAvailableTickets(ctx)
+	case event.FieldParticipantCount:
+		return m.OldParticipantCount(ctx)
+	case event.FieldTicketPrice:
+		return m.OldTicketPrice(ctx)
+	case event.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case event.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case event.FieldStatus:
+		return m.OldStatus(ctx)
+	case event.FieldReviewStatus:
+		return m.OldReviewStatus(ctx)
+	case event.FieldReviewComment:
+		return m.OldReviewComment(ctx)
+	case event.FieldSubmittedBy:
+		return m.OldSubmittedBy(ctx)
+	case event.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	case event.FieldReviewedBy:
+		return m.OldReviewedBy(ctx)
+	case event.FieldReviewedAt:
+		return m.OldReviewedAt(ctx)
+	case event.FieldPublishAt:
+		return m.OldPublishAt(ctx)
+	case event.FieldDateChangeRefundUntil:
+		return m.OldDateChangeRefundUntil(ctx)
+	case event.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case event.FieldRequiresApproval:
+		return m.OldRequiresApproval(ctx)
+	case event.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case event.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case event.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Event field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case event.FieldSeriesID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeriesID(v)
+		return nil
+	case event.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case event.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case event.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case event.FieldVenue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVenue(v)
+		return nil
+	case event.FieldVenueID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVenueID(v)
+		return nil
+	case event.FieldSeatingLayout:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeatingLayout(v)
+		return nil
+	case event.FieldAddressStreet:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressStreet(v)
+		return nil
+	case event.FieldAddressCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressCity(v)
+		return nil
+	case event.FieldAddressRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressRegion(v)
+		return nil
+	case event.FieldAddressPostalCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressPostalCode(v)
+		return nil
+	case event.FieldAddressCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressCountry(v)
+		return nil
+	case event.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case event.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case event.FieldGeohash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGeohash(v)
+		return nil
+	case event.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case event.FieldTitleInitials:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitleInitials(v)
+		return nil
+	case event.FieldVenueInitials:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVenueInitials(v)
+		return nil
+	case event.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case event.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case event.FieldTotalTickets:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTickets(v)
+		return nil
+	case event.FieldAvailableTickets:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailableTickets(v)
+		return nil
+	case event.FieldParticipantCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParticipantCount(v)
+		return nil
+	case event.FieldTicketPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTicketPrice(v)
+		return nil
+	case event.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case event.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case event.FieldStatus:
+		v, ok := value.(event.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case event.FieldReviewStatus:
+		v, ok := value.(event.ReviewStatus)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewStatus(v)
+		return nil
+	case event.FieldReviewComment:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewComment(v)
+		return nil
+	case event.FieldSubmittedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedBy(v)
+		return nil
+	case event.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	case event.FieldReviewedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedBy(v)
+		return nil
+	case event.FieldReviewedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewedAt(v)
+		return nil
+	case event.FieldPublishAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPublishAt(v)
+		return nil
+	case event.FieldDateChangeRefundUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDateChangeRefundUntil(v)
+		return nil
+	case event.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case event.FieldRequiresApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiresApproval(v)
+		return nil
+	case event.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case event.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case event.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatitude != nil {
+		fields = append(fields, event.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, event.FieldLongitude)
+	}
+	if m.addtotal_tickets != nil {
+		fields = append(fields, event.FieldTotalTickets)
+	}
+	if m.addavailable_tickets != nil {
+		fields = append(fields, event.FieldAvailableTickets)
+	}
+	if m.addparticipant_count != nil {
+		fields = append(fields, event.FieldParticipantCount)
+	}
+	if m.addticket_price != nil {
+		fields = append(fields, event.FieldTicketPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case event.FieldLatitude:
+		return m.AddedLatitude()
+	case event.FieldLongitude:
+		return m.AddedLongitude()
+	case event.FieldTotalTickets:
+		return m.AddedTotalTickets()
+	case event.FieldAvailableTickets:
+		return m.AddedAvailableTickets()
+	case event.FieldParticipantCount:
+		return m.AddedParticipantCount()
+	case event.FieldTicketPrice:
+		return m.AddedTicketPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case event.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case event.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	case event.FieldTotalTickets:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTickets(v)
+		return nil
+	case event.FieldAvailableTickets:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAvailableTickets(v)
+		return nil
+	case event.FieldParticipantCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddParticipantCount(v)
+		return nil
+	case event.FieldTicketPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTicketPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Event numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(event.FieldSeriesID) {
+		fields = append(fields, event.FieldSeriesID)
+	}
+	if m.FieldCleared(event.FieldDescription) {
+		fields = append(fields, event.FieldDescription)
+	}
+	if m.FieldCleared(event.FieldLocation) {
+		fields = append(fields, event.FieldLocation)
+	}
+	if m.FieldCleared(event.FieldVenue) {
+		fields = append(fields, event.FieldVenue)
+	}
+	if m.FieldCleared(event.FieldVenueID) {
+		fields = append(fields, event.FieldVenueID)
+	}
+	if m.FieldCleared(event.FieldLatitude) {
+		fields = append(fields, event.FieldLatitude)
+	}
+	if m.FieldCleared(event.FieldLongitude) {
+		fields = append(fields, event.FieldLongitude)
+	}
+	if m.FieldCleared(event.FieldThumbnailURL) {
+		fields = append(fields, event.FieldThumbnailURL)
+	}
+	if m.FieldCleared(event.FieldReviewComment) {
+		fields = append(fields, event.FieldReviewComment)
+	}
+	if m.FieldCleared(event.FieldSubmittedBy) {
+		fields = append(fields, event.FieldSubmittedBy)
+	}
+	if m.FieldCleared(event.FieldSubmittedAt) {
+		fields = append(fields, event.FieldSubmittedAt)
+	}
+	if m.FieldCleared(event.FieldReviewedBy) {
+		fields = append(fields, event.FieldReviewedBy)
+	}
+	if m.FieldCleared(event.FieldReviewedAt) {
+		fields = append(fields, event.FieldReviewedAt)
+	}
+	if m.FieldCleared(event.FieldPublishAt) {
+		fields = append(fields, event.FieldPublishAt)
+	}
+	if m.FieldCleared(event.FieldDateChangeRefundUntil) {
+		fields = append(fields, event.FieldDateChangeRefundUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventMutation) ClearField(name string) error {
+	switch name {
+	case event.FieldSeriesID:
+		m.ClearSeriesID()
+		return nil
+	case event.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case event.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case event.FieldVenue:
+		m.ClearVenue()
+		return nil
+	case event.FieldVenueID:
+		m.ClearVenueID()
+		return nil
+	case event.FieldLatitude:
+		m.ClearLatitude()
+		return nil
+	case event.FieldLongitude:
+		m.ClearLongitude()
+		return nil
+	case event.FieldThumbnailURL:
+		m.ClearThumbnailURL()
+		return nil
+	case event.FieldReviewComment:
+		m.ClearReviewComment()
+		return nil
+	case event.FieldSubmittedBy:
+		m.ClearSubmittedBy()
+		return nil
+	case event.FieldSubmittedAt:
+		m.ClearSubmittedAt()
+		return nil
+	case event.FieldReviewedBy:
+		m.ClearReviewedBy()
+		return nil
+	case event.FieldReviewedAt:
+		m.ClearReviewedAt()
+		return nil
+	case event.FieldPublishAt:
+		m.ClearPublishAt()
+		return nil
+	case event.FieldDateChangeRefundUntil:
+		m.ClearDateChangeRefundUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown Event nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventMutation) ResetField(name string) error {
+	switch name {
+	case event.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case event.FieldSeriesID:
+		m.ResetSeriesID()
+		return nil
+	case event.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case event.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case event.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case event.FieldVenue:
+		m.ResetVenue()
+		return nil
+	case event.FieldVenueID:
+		m.ResetVenueID()
+		return nil
+	case event.FieldSeatingLayout:
+		m.ResetSeatingLayout()
+		return nil
+	case event.FieldAddressStreet:
+		m.ResetAddressStreet()
+		return nil
+	case event.FieldAddressCity:
+		m.ResetAddressCity()
+		return nil
+	case event.FieldAddressRegion:
+		m.ResetAddressRegion()
+		return nil
+	case event.FieldAddressPostalCode:
+		m.ResetAddressPostalCode()
+		return nil
+	case event.FieldAddressCountry:
+		m.ResetAddressCountry()
+		return nil
+	case event.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case event.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case event.FieldGeohash:
+		m.ResetGeohash()
+		return nil
+	case event.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case event.FieldTitleInitials:
+		m.ResetTitleInitials()
+		return nil
+	case event.FieldVenueInitials:
+		m.ResetVenueInitials()
+		return nil
+	case event.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case event.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case event.FieldTotalTickets:
+		m.ResetTotalTickets()
+		return nil
+	case event.FieldAvailableTickets:
+		m.ResetAvailableTickets()
+		return nil
+	case event.FieldParticipantCount:
+		m.ResetParticipantCount()
+		return nil
+	case event.FieldTicketPrice:
+		m.ResetTicketPrice()
+		return nil
+	case event.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case event.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case event.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case event.FieldReviewStatus:
+		m.ResetReviewStatus()
+		return nil
+	case event.FieldReviewComment:
+		m.ResetReviewComment()
+		return nil
+	case event.FieldSubmittedBy:
+		m.ResetSubmittedBy()
+		return nil
+	case event.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
+	case event.FieldReviewedBy:
+		m.ResetReviewedBy()
+		return nil
+	case event.FieldReviewedAt:
+		m.ResetReviewedAt()
+		return nil
+	case event.FieldPublishAt:
+		m.ResetPublishAt()
+		return nil
+	case event.FieldDateChangeRefundUntil:
+		m.ResetDateChangeRefundUntil()
+		return nil
+	case event.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case event.FieldRequiresApproval:
+		m.ResetRequiresApproval()
+		return nil
+	case event.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case event.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case event.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Event field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.organization != nil {
+		edges = append(edges, event.EdgeOrganization)
+	}
+	if m.creator != nil {
+		edges = append(edges, event.EdgeCreator)
+	}
+	if m.linked_venue != nil {
+		edges = append(edges, event.EdgeLinkedVenue)
+	}
+	if m.series != nil {
+		edges = append(edges, event.EdgeSeries)
+	}
+	if m.payments != nil {
+		edges = append(edges, event.EdgePayments)
+	}
+	if m.registration_questions != nil {
+		edges = append(edges, event.EdgeRegistrationQuestions)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case event.EdgeCreator:
+		if id := m.creator; id != nil {
+			return []ent.Value{*id}
+		}
+	case event.EdgeLinkedVenue:
+		if id := m.linked_venue; id != nil {
+			return []ent.Value{*id}
+		}
+	case event.EdgeSeries:
+		if id := m.series; id != nil {
+			return []ent.Value{*id}
+		}
+	case event.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.payments))
+		for id := range m.payments {
+			ids = append(ids, id)
+		}
+		return ids
+	case event.EdgeRegistrationQuestions:
+		ids := make([]ent.Value, 0, len(m.registration_questions))
+		for id := range m.registration_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedpayments != nil {
+		edges = append(edges, event.EdgePayments)
+	}
+	if m.removedregistration_questions != nil {
+		edges = append(edges, event.EdgeRegistrationQuestions)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case event.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.removedpayments))
+		for id := range m.removedpayments {
+			ids = append(ids, id)
+		}
+		return ids
+	case event.EdgeRegistrationQuestions:
+		ids := make([]ent.Value, 0, len(m.removedregistration_questions))
+		for id := range m.removedregistration_questions {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedorganization {
+		edges = append(edges, event.EdgeOrganization)
+	}
+	if m.clearedcreator {
+		edges = append(edges, event.EdgeCreator)
+	}
+	if m.clearedlinked_venue {
+		edges = append(edges, event.EdgeLinkedVenue)
+	}
+	if m.clearedseries {
+		edges = append(edges, event.EdgeSeries)
+	}
+	if m.clearedpayments {
+		edges = append(edges, event.EdgePayments)
+	}
+	if m.clearedregistration_questions {
+		edges = append(edges, event.EdgeRegistrationQuestions)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventMutation) EdgeCleared(name string) bool {
+	switch name {
+	case event.EdgeOrganization:
+		return m.clearedorganization
+	case event.EdgeCreator:
+		return m.clearedcreator
+	case event.EdgeLinkedVenue:
+		return m.clearedlinked_venue
+	case event.EdgeSeries:
+		return m.clearedseries
+	case event.EdgePayments:
+		return m.clearedpayments
+	case event.EdgeRegistrationQuestions:
+		return m.clearedregistration_questions
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventMutation) ClearEdge(name string) error {
+	switch name {
+	case event.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	case event.EdgeCreator:
+		m.ClearCreator()
+		return nil
+	case event.EdgeLinkedVenue:
+		m.ClearLinkedVenue()
+		return nil
+	case event.EdgeSeries:
+		m.ClearSeries()
+		return nil
+	}
+	return fmt.Errorf("unknown Event unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventMutation) ResetEdge(name string) error {
+	switch name {
+	case event.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case event.EdgeCreator:
+		m.ResetCreator()
+		return nil
+	case event.EdgeLinkedVenue:
+		m.ResetLinkedVenue()
+		return nil
+	case event.EdgeSeries:
+		m.ResetSeries()
+		return nil
+	case event.EdgePayments:
+		m.ResetPayments()
+		return nil
+	case event.EdgeRegistrationQuestions:
+		m.ResetRegistrationQuestions()
+		return nil
+	}
+	return fmt.Errorf("unknown Event edge %s", name)
+}
+
+// EventCancellationMutation represents an operation that mutates the EventCancellation nodes in the graph.
+type EventCancellationMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	event_id        *uuid.UUID
+	organization_id *uuid.UUID
+	requested_by    *uuid.UUID
+	reason          *string
+	status          *eventcancellation.Status
+	completed_at    *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	refunds         map[uuid.UUID]struct{}
+	removedrefunds  map[uuid.UUID]struct{}
+	clearedrefunds  bool
+	done            bool
+	oldValue        func(context.Context) (*EventCancellation, error)
+	predicates      []predicate.EventCancellation
+}
+
+var _ ent.Mutation = (*EventCancellationMutation)(nil)
+
+// eventcancellationOption allows management of the mutation configuration using functional options.
+type eventcancellationOption func(*EventCancellationMutation)
+
+// newEventCancellationMutation creates new mutation for the EventCancellation entity.
+func newEventCancellationMutation(c config, op Op, opts ...eventcancellationOption) *EventCancellationMutation {
+	m := &EventCancellationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventCancellation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventCancellationID sets the ID field of the mutation.
+func withEventCancellationID(id uuid.UUID) eventcancellationOption {
+	return func(m *EventCancellationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventCancellation
+		)
+		m.oldValue = func(ctx context.Context) (*EventCancellation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventCancellation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventCancellation sets the old EventCancellation of the mutation.
+func withEventCancellation(node *EventCancellation) eventcancellationOption {
+	return func(m *EventCancellationMutation) {
+		m.oldValue = func(context.Context) (*EventCancellation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventCancellationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventCancellationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EventCancellation entities.
+func (m *EventCancellationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventCancellationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventCancellationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventCancellation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventID sets the "event_id" field.
+func (m *EventCancellationMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *EventCancellationMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *EventCancellationMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *EventCancellationMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization_id = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *EventCancellationMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *EventCancellationMutation) ResetOrganizationID() {
+	m.organization_id = nil
+}
+
+// SetRequestedBy sets the "requested_by" field.
+func (m *EventCancellationMutation) SetRequestedBy(u uuid.UUID) {
+	m.requested_by = &u
+}
+
+// RequestedBy returns the value of the "requested_by" field in the mutation.
+func (m *EventCancellationMutation) RequestedBy() (r uuid.UUID, exists bool) {
+	v := m.requested_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequestedBy returns the old "requested_by" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldRequestedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestedBy: %w", err)
+	}
+	return oldValue.RequestedBy, nil
+}
+
+// ResetRequestedBy resets all changes to the "requested_by" field.
+func (m *EventCancellationMutation) ResetRequestedBy() {
+	m.requested_by = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *EventCancellationMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *EventCancellationMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *EventCancellationMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[eventcancellation.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *EventCancellationMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[eventcancellation.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *EventCancellationMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, eventcancellation.FieldReason)
+}
+
+// SetStatus sets the "status" field.
+func (m *EventCancellationMutation) SetStatus(e eventcancellation.Status) {
+	m.status = &e
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *EventCancellationMutation) Status() (r eventcancellation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldStatus(ctx context.Context) (v eventcancellation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *EventCancellationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *EventCancellationMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *EventCancellationMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *EventCancellationMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[eventcancellation.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *EventCancellationMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[eventcancellation.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *EventCancellationMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, eventcancellation.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventCancellationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventCancellationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventCancellationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventCancellationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventCancellationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EventCancellation entity.
+// If the EventCancellation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventCancellationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventCancellationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddRefundIDs adds the "refunds" edge to the CancellationRefund entity by ids.
+func (m *EventCancellationMutation) AddRefundIDs(ids ...uuid.UUID) {
+	if m.refunds == nil {
+		m.refunds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.refunds[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRefunds clears the "refunds" edge to the CancellationRefund entity.
+func (m *EventCancellationMutation) ClearRefunds() {
+	m.clearedrefunds = true
+}
+
+// RefundsCleared reports if the "refunds" edge to the CancellationRefund entity was cleared.
+func (m *EventCancellationMutation) RefundsCleared() bool {
+	return m.clearedrefunds
+}
+
+// RemoveRefundIDs removes the "refunds" edge to the CancellationRefund entity by IDs.
+func (m *EventCancellationMutation) RemoveRefundIDs(ids ...uuid.UUID) {
+	if m.removedrefunds == nil {
+		m.removedrefunds = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.refunds, ids[i])
+		m.removedrefunds[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRefunds returns the removed IDs of the "refunds" edge to the CancellationRefund entity.
+func (m *EventCancellationMutation) RemovedRefundsIDs() (ids []uuid.UUID) {
+	for id := range m.removedrefunds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RefundsIDs returns the "refunds" edge IDs in the mutation.
+func (m *EventCancellationMutation) RefundsIDs() (ids []uuid.UUID) {
+	for id := range m.refunds {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRefunds resets all changes to the "refunds" edge.
+func (m *EventCancellationMutation) ResetRefunds() {
+	m.refunds = nil
+	m.clearedrefunds = false
+	m.removedrefunds = nil
+}
+
+// Where appends a list predicates to the EventCancellationMutation builder.
+func (m *EventCancellationMutation) Where(ps ...predicate.EventCancellation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventCancellationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventCancellationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventCancellation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventCancellationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventCancellationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventCancellation).
+func (m *EventCancellationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventCancellationMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.event_id != nil {
+		fields = append(fields, eventcancellation.FieldEventID)
+	}
+	if m.organization_id != nil {
+		fields = append(fields, eventcancellation.FieldOrganizationID)
+	}
+	if m.requested_by != nil {
+		fields = append(fields, eventcancellation.FieldRequestedBy)
+	}
+	if m.reason != nil {
+		fields = append(fields, eventcancellation.FieldReason)
+	}
+	if m.status != nil {
+		fields = append(fields, eventcancellation.FieldStatus)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, eventcancellation.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, eventcancellation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, eventcancellation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventCancellationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventcancellation.FieldEventID:
+		return m.EventID()
+	case eventcancellation.FieldOrganizationID:
+		return m.OrganizationID()
+	case eventcancellation.FieldRequestedBy:
+		return m.RequestedBy()
+	case eventcancellation.FieldReason:
+		return m.Reason()
+	case eventcancellation.FieldStatus:
+		return m.Status()
+	case eventcancellation.FieldCompletedAt:
+		return m.CompletedAt()
+	case eventcancellation.FieldCreatedAt:
+		return m.CreatedAt()
+	case eventcancellation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventCancellationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventcancellation.FieldEventID:
+		return m.OldEventID(ctx)
+	case eventcancellation.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case eventcancellation.FieldRequestedBy:
+		return m.OldRequestedBy(ctx)
+	case eventcancellation.FieldReason:
+		return m.OldReason(ctx)
+	case eventcancellation.FieldStatus:
+		return m.OldStatus(ctx)
+	case eventcancellation.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case eventcancellation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case eventcancellation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventCancellation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventCancellationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventcancellation.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case eventcancellation.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case eventcancellation.FieldRequestedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestedBy(v)
+		return nil
+	case eventcancellation.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case eventcancellation.FieldStatus:
+		v, ok := value.(eventcancellation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case eventcancellation.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case eventcancellation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case eventcancellation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventCancellation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventCancellationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventCancellationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventCancellationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EventCancellation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventCancellationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(eventcancellation.FieldReason) {
+		fields = append(fields, eventcancellation.FieldReason)
+	}
+	if m.FieldCleared(eventcancellation.FieldCompletedAt) {
+		fields = append(fields, eventcancellation.FieldCompletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventCancellationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventCancellationMutation) ClearField(name string) error {
+	switch name {
+	case eventcancellation.FieldReason:
+		m.ClearReason()
+		return nil
+	case eventcancellation.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventCancellation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventCancellationMutation) ResetField(name string) error {
+	switch name {
+	case eventcancellation.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case eventcancellation.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case eventcancellation.FieldRequestedBy:
+		m.ResetRequestedBy()
+		return nil
+	case eventcancellation.FieldReason:
+		m.ResetReason()
+		return nil
+	case eventcancellation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case eventcancellation.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case eventcancellation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case eventcancellation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventCancellation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventCancellationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.refunds != nil {
+		edges = append(edges, eventcancellation.EdgeRefunds)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventCancellationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case eventcancellation.EdgeRefunds:
+		ids := make([]ent.Value, 0, len(m.refunds))
+		for id := range m.refunds {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventCancellationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.removedrefunds != nil {
+		edges = append(edges, eventcancellation.EdgeRefunds)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventCancellationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case eventcancellation.EdgeRefunds:
+		ids := make([]ent.Value, 0, len(m.removedrefunds))
+		for id := range m.removedrefunds {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventCancellationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedrefunds {
+		edges = append(edges, eventcancellation.EdgeRefunds)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventCancellationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case eventcancellation.EdgeRefunds:
+		return m.clearedrefunds
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventCancellationMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EventCancellation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventCancellationMutation) ResetEdge(name string) error {
+	switch name {
+	case eventcancellation.EdgeRefunds:
+		m.ResetRefunds()
+		return nil
+	}
+	return fmt.Errorf("unknown EventCancellation edge %s", name)
+}
+
+// EventChangeMutation represents an operation that mutates the EventChange nodes in the graph.
+type EventChangeMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	event_id          *uuid.UUID
+	changed_by        *uuid.UUID
+	before            *map[string]string
+	after             *map[string]string
+	notified_count    *int
+	addnotified_count *int
+	refund_until      *time.Time
+	created_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*EventChange, error)
+	predicates        []predicate.EventChange
+}
+
+var _ ent.Mutation = (*EventChangeMutation)(nil)
+
+// eventchangeOption allows management of the mutation configuration using functional options.
+type eventchangeOption func(*EventChangeMutation)
+
+// newEventChangeMutation creates new mutation for the EventChange entity.
+func newEventChangeMutation(c config, op Op, opts ...eventchangeOption) *EventChangeMutation {
+	m := &EventChangeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventChange,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventChangeID sets the ID field of the mutation.
+func withEventChangeID(id uuid.UUID) eventchangeOption {
+	return func(m *EventChangeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventChange
+		)
+		m.oldValue = func(ctx context.Context) (*EventChange, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventChange.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventChange sets the old EventChange of the mutation.
+func withEventChange(node *EventChange) eventchangeOption {
+	return func(m *EventChangeMutation) {
+		m.oldValue = func(context.Context) (*EventChange, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventChangeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventChangeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EventChange entities.
+func (m *EventChangeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventChangeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventChangeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventChange.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventID sets the "event_id" field.
+func (m *EventChangeMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *EventChangeMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the EventChange entity.
+// If the EventChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventChangeMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *EventChangeMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetChangedBy sets the "changed_by" field.
+func (m *EventChangeMutation) SetChangedBy(u uuid.UUID) {
+	m.changed_by = &u
+}
+
+// ChangedBy returns the value of the "changed_by" field in the mutation.
+func (m *EventChangeMutation) ChangedBy() (r uuid.UUID, exists bool) {
+	v := m.changed_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChangedBy returns the old "changed_by" field's value of the EventChange entity.
+// If the EventChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventChangeMutation) OldChangedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChangedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChangedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChangedBy: %w", err)
+	}
+	return oldValue.ChangedBy, nil
+}
+
+// ResetChangedBy resets all changes to the "changed_by" field.
+func (m *EventChangeMutation) ResetChangedBy() {
+	m.changed_by = nil
+}
+
+// SetBefore sets the "before" field.
+func (m *EventChangeMutation) SetBefore(value map[string]string) {
+	m.before = &value
+}
+
+// Before returns the value of the "before" field in the mutation.
+func (m *EventChangeMutation) Before() (r map[string]string, exists bool) {
+	v := m.before
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBefore returns the old "before" field's value of the EventChange entity.
+// If the EventChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventChangeMutation) OldBefore(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBefore is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBefore requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBefore: %w", err)
+	}
+	return oldValue.Before, nil
+}
+
+// ResetBefore resets all changes to the "before" field.
+func (m *EventChangeMutation) ResetBefore() {
+	m.before = nil
+}
+
+// SetAfter sets the "after" field.
+func (m *EventChangeMutation) SetAfter(value map[string]string) {
+	m.after = &value
+}
+
+// After returns the value of the "after" field in the mutation.
+func (m *EventChangeMutation) After() (r map[string]string, exists bool) {
+	v := m.after
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfter returns the old "after" field's value of the EventChange entity.
+// If the EventChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventChangeMutation) OldAfter(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfter is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfter requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfter: %w", err)
+	}
+	return oldValue.After, nil
+}
+
+// ResetAfter resets all changes to the "after" field.
+func (m *EventChangeMutation) ResetAfter() {
+	m.after = nil
+}
+
+// SetNotifiedCount sets the "notified_count" field.
+func (m *EventChangeMutation) SetNotifiedCount(i int) {
+	m.notified_count = &i
+	m.addnotified_count = nil
+}
+
+// NotifiedCount returns the value of the "notified_count" field in the mutation.
+func (m *EventChangeMutation) NotifiedCount() (r int, exists bool) {
+	v := m.notified_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNotifiedCount returns the old "notified_count" field's value of the EventChange entity.
+// If the EventChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventChangeMutation) OldNotifiedCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNotifiedCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNotifiedCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNotifiedCount: %w", err)
+	}
+	return oldValue.NotifiedCount, nil
+}
+
+// AddNotifiedCount adds i to the "notified_count" field.
+func (m *EventChangeMutation) AddNotifiedCount(i int) {
+	if m.addnotified_count != nil {
+		*m.addnotified_count += i
+	} else {
+		m.addnotified_count = &i
+	}
+}
+
+// AddedNotifiedCount returns the value that was added to the "notified_count" field in this mutation.
+func (m *EventChangeMutation) AddedNotifiedCount() (r int, exists bool) {
+	v := m.addnotified_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetNotifiedCount resets all changes to the "notified_count" field.
+func (m *EventChangeMutation) ResetNotifiedCount() {
+	m.notified_count = nil
+	m.addnotified_count = nil
+}
+
+// SetRefundUntil sets the "refund_until" field.
+func (m *EventChangeMutation) SetRefundUntil(t time.Time) {
+	m.refund_until = &t
+}
+
+// RefundUntil returns the value of the "refund_until" field in the mutation.
+func (m *EventChangeMutation) RefundUntil() (r time.Time, exists bool) {
+	v := m.refund_until
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRefundUntil returns the old "refund_until" field's value of the EventChange entity.
+// If the EventChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventChangeMutation) OldRefundUntil(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRefundUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRefundUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRefundUntil: %w", err)
+	}
+	return oldValue.RefundUntil, nil
+}
+
+// ClearRefundUntil clears the value of the "refund_until" field.
+func (m *EventChangeMutation) ClearRefundUntil() {
+	m.refund_until = nil
+	m.clearedFields[eventchange.FieldRefundUntil] = struct{}{}
+}
+
+// RefundUntilCleared returns if the "refund_until" field was cleared in this mutation.
+func (m *EventChangeMutation) RefundUntilCleared() bool {
+	_, ok := m.clearedFields[eventchange.FieldRefundUntil]
+	return ok
+}
+
+// ResetRefundUntil resets all changes to the "refund_until" field.
+func (m *EventChangeMutation) ResetRefundUntil() {
+	m.refund_until = nil
+	delete(m.clearedFields, eventchange.FieldRefundUntil)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventChangeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventChangeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EventChange entity.
+// If the EventChange object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventChangeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventChangeMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EventChangeMutation builder.
+func (m *EventChangeMutation) Where(ps ...predicate.EventChange) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventChangeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventChangeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventChange, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventChangeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventChangeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventChange).
+func (m *EventChangeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventChangeMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.event_id != nil {
+		fields = append(fields, eventchange.FieldEventID)
+	}
+	if m.changed_by != nil {
+		fields = append(fields, eventchange.FieldChangedBy)
+	}
+	if m.before != nil {
+		fields = append(fields, eventchange.FieldBefore)
+	}
+	if m.after != nil {
+		fields = append(fields, eventchange.FieldAfter)
+	}
+	if m.notified_count != nil {
+		fields = append(fields, eventchange.FieldNotifiedCount)
+	}
+	if m.refund_until != nil {
+		fields = append(fields, eventchange.FieldRefundUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, eventchange.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventChangeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventchange.FieldEventID:
+		return m.EventID()
+	case eventchange.FieldChangedBy:
+		return m.ChangedBy()
+	case eventchange.FieldBefore:
+		return m.Before()
+	case eventchange.FieldAfter:
+		return m.After()
+	case eventchange.FieldNotifiedCount:
+		return m.NotifiedCount()
+	case eventchange.FieldRefundUntil:
+		return m.RefundUntil()
+	case eventchange.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventChangeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventchange.FieldEventID:
+		return m.OldEventID(ctx)
+	case eventchange.FieldChangedBy:
+		return m.OldChangedBy(ctx)
+	case eventchange.FieldBefore:
+		return m.OldBefore(ctx)
+	case eventchange.FieldAfter:
+		return m.OldAfter(ctx)
+	case eventchange.FieldNotifiedCount:
+		return m.OldNotifiedCount(ctx)
+	case eventchange.FieldRefundUntil:
+		return m.OldRefundUntil(ctx)
+	case eventchange.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventChange field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventChangeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventchange.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case eventchange.FieldChangedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChangedBy(v)
+		return nil
+	case eventchange.FieldBefore:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBefore(v)
+		return nil
+	case eventchange.FieldAfter:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfter(v)
+		return nil
+	case eventchange.FieldNotifiedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifiedCount(v)
+		return nil
+	case eventchange.FieldRefundUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRefundUntil(v)
+		return nil
+	case eventchange.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventChange field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventChangeMutation) AddedFields() []string {
+	var fields []string
+	if m.addnotified_count != nil {
+		fields = append(fields, eventchange.FieldNotifiedCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventChangeMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventchange.FieldNotifiedCount:
+		return m.AddedNotifiedCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventChangeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventchange.FieldNotifiedCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddNotifiedCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventChange numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventChangeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(eventchange.FieldRefundUntil) {
+		fields = append(fields, eventchange.FieldRefundUntil)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventChangeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventChangeMutation) ClearField(name string) error {
+	switch name {
+	case eventchange.FieldRefundUntil:
+		m.ClearRefundUntil()
+		return nil
+	}
+	return fmt.Errorf("unknown EventChange nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventChangeMutation) ResetField(name string) error {
+	switch name {
+	case eventchange.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case eventchange.FieldChangedBy:
+		m.ResetChangedBy()
+		return nil
+	case eventchange.FieldBefore:
+		m.ResetBefore()
+		return nil
+	case eventchange.FieldAfter:
+		m.ResetAfter()
+		return nil
+	case eventchange.FieldNotifiedCount:
+		m.ResetNotifiedCount()
+		return nil
+	case eventchange.FieldRefundUntil:
+		m.ResetRefundUntil()
+		return nil
+	case eventchange.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventChange field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventChangeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventChangeMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventChangeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventChangeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventChangeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventChangeMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventChangeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventChange unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventChangeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventChange edge %s", name)
+}
+
+// EventFavoriteMutation represents an operation that mutates the EventFavorite nodes in the graph.
+type EventFavoriteMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	user_id       *uuid.UUID
+	event_id      *uuid.UUID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*EventFavorite, error)
+	predicates    []predicate.EventFavorite
+}
+
+var _ ent.Mutation = (*EventFavoriteMutation)(nil)
+
+// eventfavoriteOption allows management of the mutation configuration using functional options.
+type eventfavoriteOption func(*EventFavoriteMutation)
+
+// newEventFavoriteMutation creates new mutation for the EventFavorite entity.
+func newEventFavoriteMutation(c config, op Op, opts ...eventfavoriteOption) *EventFavoriteMutation {
+	m := &EventFavoriteMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventFavorite,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventFavoriteID sets the ID field of the mutation.
+func withEventFavoriteID(id uuid.UUID) eventfavoriteOption {
+	return func(m *EventFavoriteMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventFavorite
+		)
+		m.oldValue = func(ctx context.Context) (*EventFavorite, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventFavorite.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventFavorite sets the old EventFavorite of the mutation.
+func withEventFavorite(node *EventFavorite) eventfavoriteOption {
+	return func(m *EventFavoriteMutation) {
+		m.oldValue = func(context.Context) (*EventFavorite, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventFavoriteMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventFavoriteMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EventFavorite entities.
+func (m *EventFavoriteMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventFavoriteMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventFavoriteMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventFavorite.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *EventFavoriteMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *EventFavoriteMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the EventFavorite entity.
+// If the EventFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventFavoriteMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *EventFavoriteMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetEventID sets the "event_id" field.
+func (m *EventFavoriteMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *EventFavoriteMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the EventFavorite entity.
+// If the EventFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventFavoriteMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *EventFavoriteMutation) ResetEventID() {
+	m.event_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventFavoriteMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventFavoriteMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EventFavorite entity.
+// If the EventFavorite object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventFavoriteMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventFavoriteMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the EventFavoriteMutation builder.
+func (m *EventFavoriteMutation) Where(ps ...predicate.EventFavorite) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventFavoriteMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventFavoriteMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventFavorite, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventFavoriteMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventFavoriteMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventFavorite).
+func (m *EventFavoriteMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventFavoriteMutation) Fields() []string {
+	fields := make([]string, 0, 3)
+	if m.user_id != nil {
+		fields = append(fields, eventfavorite.FieldUserID)
+	}
+	if m.event_id != nil {
+		fields = append(fields, eventfavorite.FieldEventID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, eventfavorite.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventFavoriteMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventfavorite.FieldUserID:
+		return m.UserID()
+	case eventfavorite.FieldEventID:
+		return m.EventID()
+	case eventfavorite.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventFavoriteMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventfavorite.FieldUserID:
+		return m.OldUserID(ctx)
+	case eventfavorite.FieldEventID:
+		return m.OldEventID(ctx)
+	case eventfavorite.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventFavorite field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventFavoriteMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventfavorite.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case eventfavorite.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case eventfavorite.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventFavorite field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventFavoriteMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventFavoriteMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventFavoriteMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown EventFavorite numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventFavoriteMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventFavoriteMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventFavoriteMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown EventFavorite nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventFavoriteMutation) ResetField(name string) error {
+	switch name {
+	case eventfavorite.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case eventfavorite.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case eventfavorite.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventFavorite field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventFavoriteMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventFavoriteMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventFavoriteMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventFavoriteMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventFavoriteMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventFavoriteMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventFavoriteMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown EventFavorite unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventFavoriteMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown EventFavorite edge %s", name)
+}
+
+// EventSeriesMutation represents an operation that mutates the EventSeries nodes in the graph.
+type EventSeriesMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	title               *string
+	description         *string
+	location            *string
+	venue               *string
+	seating_layout      *string
+	address_street      *string
+	address_city        *string
+	address_region      *string
+	address_postal_code *string
+	address_country     *string
+	latitude            *float64
+	addlatitude         *float64
+	longitude           *float64
+	addlongitude        *float64
+	category            *string
+	start_time          *time.Time
+	end_time            *time.Time
+	recurrence          *string
+	total_tickets       *int
+	addtotal_tickets    *int
+	ticket_price        *float64
+	addticket_price     *float64
+	currency            *string
+	thumbnail_url       *string
+	is_public           *bool
+	requires_approval   *bool
+	created_by          *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	linked_venue        *uuid.UUID
+	clearedlinked_venue bool
+	occurrences         map[uuid.UUID]struct{}
+	removedoccurrences  map[uuid.UUID]struct{}
+	clearedoccurrences  bool
+	done                bool
+	oldValue            func(context.Context) (*EventSeries, error)
+	predicates          []predicate.EventSeries
+}
+
+var _ ent.Mutation = (*EventSeriesMutation)(nil)
+
+// eventseriesOption allows management of the mutation configuration using functional options.
+type eventseriesOption func(*EventSeriesMutation)
+
+// newEventSeriesMutation creates new mutation for the EventSeries entity.
+func newEventSeriesMutation(c config, op Op, opts ...eventseriesOption) *EventSeriesMutation {
+	m := &EventSeriesMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeEventSeries,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withEventSeriesID sets the ID field of the mutation.
+func withEventSeriesID(id uuid.UUID) eventseriesOption {
+	return func(m *EventSeriesMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *EventSeries
+		)
+		m.oldValue = func(ctx context.Context) (*EventSeries, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().EventSeries.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withEventSeries sets the old EventSeries of the mutation.
+func withEventSeries(node *EventSeries) eventseriesOption {
+	return func(m *EventSeriesMutation) {
+		m.oldValue = func(context.Context) (*EventSeries, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m EventSeriesMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m EventSeriesMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of EventSeries entities.
+func (m *EventSeriesMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *EventSeriesMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *EventSeriesMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().EventSeries.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *EventSeriesMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *EventSeriesMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *EventSeriesMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *EventSeriesMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *EventSeriesMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *EventSeriesMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *EventSeriesMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *EventSeriesMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *EventSeriesMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[eventseries.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *EventSeriesMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[eventseries.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *EventSeriesMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, eventseries.FieldDescription)
+}
+
+// SetLocation sets the "location" field.
+func (m *EventSeriesMutation) SetLocation(s string) {
+	m.location = &s
+}
+
+// Location returns the value of the "location" field in the mutation.
+func (m *EventSeriesMutation) Location() (r string, exists bool) {
+	v := m.location
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLocation returns the old "location" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldLocation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLocation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLocation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLocation: %w", err)
+	}
+	return oldValue.Location, nil
+}
+
+// ClearLocation clears the value of the "location" field.
+func (m *EventSeriesMutation) ClearLocation() {
+	m.location = nil
+	m.clearedFields[eventseries.FieldLocation] = struct{}{}
+}
+
+// LocationCleared returns if the "location" field was cleared in this mutation.
+func (m *EventSeriesMutation) LocationCleared() bool {
+	_, ok := m.clearedFields[eventseries.FieldLocation]
+	return ok
+}
+
+// ResetLocation resets all changes to the "location" field.
+func (m *EventSeriesMutation) ResetLocation() {
+	m.location = nil
+	delete(m.clearedFields, eventseries.FieldLocation)
+}
+
+// SetVenue sets the "venue" field.
+func (m *EventSeriesMutation) SetVenue(s string) {
+	m.venue = &s
+}
+
+// Venue returns the value of the "venue" field in the mutation.
+func (m *EventSeriesMutation) Venue() (r string, exists bool) {
+	v := m.venue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVenue returns the old "venue" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldVenue(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVenue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVenue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVenue: %w", err)
+	}
+	return oldValue.Venue, nil
+}
+
+// ClearVenue clears the value of the "venue" field.
+func (m *EventSeriesMutation) ClearVenue() {
+	m.venue = nil
+	m.clearedFields[eventseries.FieldVenue] = struct{}{}
+}
+
+// VenueCleared returns if the "venue" field was cleared in this mutation.
+func (m *EventSeriesMutation) VenueCleared() bool {
+	_, ok := m.clearedFields[eventseries.FieldVenue]
+	return ok
+}
+
+// ResetVenue resets all changes to the "venue" field.
+func (m *EventSeriesMutation) ResetVenue() {
+	m.venue = nil
+	delete(m.clearedFields, eventseries.FieldVenue)
+}
+
+// SetVenueID sets the "venue_id" field.
+func (m *EventSeriesMutation) SetVenueID(u uuid.UUID) {
+	m.linked_venue = &u
+}
+
+// VenueID returns the value of the "venue_id" field in the mutation.
+func (m *EventSeriesMutation) VenueID() (r uuid.UUID, exists bool) {
+	v := m.linked_venue
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVenueID returns the old "venue_id" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldVenueID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVenueID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVenueID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVenueID: %w", err)
+	}
+	return oldValue.VenueID, nil
+}
+
+// ClearVenueID clears the value of the "venue_id" field.
+func (m *EventSeriesMutation) ClearVenueID() {
+	m.linked_venue = nil
+	m.clearedFields[eventseries.FieldVenueID] = struct{}{}
+}
+
+// VenueIDCleared returns if the "venue_id" field was cleared in this mutation.
+func (m *EventSeriesMutation) VenueIDCleared() bool {
+	_, ok := m.clearedFields[eventseries.FieldVenueID]
+	return ok
+}
+
+// ResetVenueID resets all changes to the "venue_id" field.
+func (m *EventSeriesMutation) ResetVenueID() {
+	m.linked_venue = nil
+	delete(m.clearedFields, eventseries.FieldVenueID)
+}
+
+// SetSeatingLayout sets the "seating_layout" field.
+func (m *EventSeriesMutation) SetSeatingLayout(s string) {
+	m.seating_layout = &s
+}
+
+// SeatingLayout returns the value of the "seating_layout" field in the mutation.
+func (m *EventSeriesMutation) SeatingLayout() (r string, exists bool) {
+	v := m.seating_layout
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSeatingLayout returns the old "seating_layout" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldSeatingLayout(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeatingLayout is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeatingLayout requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeatingLayout: %w", err)
+	}
+	return oldValue.SeatingLayout, nil
+}
+
+// ResetSeatingLayout resets all changes to the "seating_layout" field.
+func (m *EventSeriesMutation) ResetSeatingLayout() {
+	m.seating_layout = nil
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (m *EventSeriesMutation) SetAddressStreet(s string) {
+	m.address_street = &s
+}
+
+// AddressStreet returns the value of the "address_street" field in the mutation.
+func (m *EventSeriesMutation) AddressStreet() (r string, exists bool) {
+	v := m.address_street
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressStreet returns the old "address_street" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldAddressStreet(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressStreet is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressStreet requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressStreet: %w", err)
+	}
+	return oldValue.AddressStreet, nil
+}
+
+// ResetAddressStreet resets all changes to the "address_street" field.
+func (m *EventSeriesMutation) ResetAddressStreet() {
+	m.address_street = nil
+}
+
+// SetAddressCity sets the "address_city" field.
+func (m *EventSeriesMutation) SetAddressCity(s string) {
+	m.address_city = &s
+}
+
+// AddressCity returns the value of the "address_city" field in the mutation.
+func (m *EventSeriesMutation) AddressCity() (r string, exists bool) {
+	v := m.address_city
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressCity returns the old "address_city" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldAddressCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressCity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressCity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressCity: %w", err)
+	}
+	return oldValue.AddressCity, nil
+}
+
+// ResetAddressCity resets all changes to the "address_city" field.
+func (m *EventSeriesMutation) ResetAddressCity() {
+	m.address_city = nil
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (m *EventSeriesMutation) SetAddressRegion(s string) {
+	m.address_region = &s
+}
+
+// AddressRegion returns the value of the "address_region" field in the mutation.
+func (m *EventSeriesMutation) AddressRegion() (r string, exists bool) {
+	v := m.address_region
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressRegion returns the old "address_region" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldAddressRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressRegion requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressRegion: %w", err)
+	}
+	return oldValue.AddressRegion, nil
+}
+
+// ResetAddressRegion resets all changes to the "address_region" field.
+func (m *EventSeriesMutation) ResetAddressRegion() {
+	m.address_region = nil
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (m *EventSeriesMutation) SetAddressPostalCode(s string) {
+	m.address_postal_code = &s
+}
+
+// AddressPostalCode returns the value of the "address_postal_code" field in the mutation.
+func (m *EventSeriesMutation) AddressPostalCode() (r string, exists bool) {
+	v := m.address_postal_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressPostalCode returns the old "address_postal_code" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldAddressPostalCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressPostalCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressPostalCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressPostalCode: %w", err)
+	}
+	return oldValue.AddressPostalCode, nil
+}
+
+// ResetAddressPostalCode resets all changes to the "address_postal_code" field.
+func (m *EventSeriesMutation) ResetAddressPostalCode() {
+	m.address_postal_code = nil
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (m *EventSeriesMutation) SetAddressCountry(s string) {
+	m.address_country = &s
+}
+
+// AddressCountry returns the value of the "address_country" field in the mutation.
+func (m *EventSeriesMutation) AddressCountry() (r string, exists bool) {
+	v := m.address_country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAddressCountry returns the old "address_country" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldAddressCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressCountry: %w", err)
+	}
+	return oldValue.AddressCountry, nil
+}
+
+// ResetAddressCountry resets all changes to the "address_country" field.
+func (m *EventSeriesMutation) ResetAddressCountry() {
+	m.address_country = nil
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *EventSeriesMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *EventSeriesMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatitude returns the old "latitude" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldLatitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
+}
+
+// AddLatitude adds f to the "latitude" field.
+func (m *EventSeriesMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
+	}
+}
+
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *EventSeriesMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (m *EventSeriesMutation) ClearLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	m.clearedFields[eventseries.FieldLatitude] = struct{}{}
+}
+
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *EventSeriesMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[eventseries.FieldLatitude]
+	return ok
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *EventSeriesMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	delete(m.clearedFields, eventseries.FieldLatitude)
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *EventSeriesMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *EventSeriesMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLongitude returns the old "longitude" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldLongitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
+}
+
+// AddLongitude adds f to the "longitude" field.
+func (m *EventSeriesMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
+	}
+}
+
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *EventSeriesMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (m *EventSeriesMutation) ClearLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	m.clearedFields[eventseries.FieldLongitude] = struct{}{}
+}
+
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *EventSeriesMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[eventseries.FieldLongitude]
+	return ok
+}
+
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *EventSeriesMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	delete(m.clearedFields, eventseries.FieldLongitude)
+}
+
+// SetCategory sets the "category" field.
+func (m *EventSeriesMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *EventSeriesMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *EventSeriesMutation) ResetCategory() {
+	m.category = nil
+}
+
+// SetStartTime sets the "start_time" field.
+func (m *EventSeriesMutation) SetStartTime(t time.Time) {
+	m.start_time = &t
+}
+
+// StartTime returns the value of the "start_time" field in the mutation.
+func (m *EventSeriesMutation) StartTime() (r time.Time, exists bool) {
+	v := m.start_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStartTime returns the old "start_time" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldStartTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStartTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStartTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStartTime: %w", err)
+	}
+	return oldValue.StartTime, nil
+}
+
+// ResetStartTime resets all changes to the "start_time" field.
+func (m *EventSeriesMutation) ResetStartTime() {
+	m.start_time = nil
+}
+
+// SetEndTime sets the "end_time" field.
+func (m *EventSeriesMutation) SetEndTime(t time.Time) {
+	m.end_time = &t
+}
+
+// EndTime returns the value of the "end_time" field in the mutation.
+func (m *EventSeriesMutation) EndTime() (r time.Time, exists bool) {
+	v := m.end_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEndTime returns the old "end_time" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldEndTime(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEndTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEndTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEndTime: %w", err)
+	}
+	return oldValue.EndTime, nil
+}
+
+// ResetEndTime resets all changes to the "end_time" field.
+func (m *EventSeriesMutation) ResetEndTime() {
+	m.end_time = nil
+}
+
+// SetRecurrence sets the "recurrence" field.
+func (m *EventSeriesMutation) SetRecurrence(s string) {
+	m.recurrence = &s
+}
+
+// Recurrence returns the value of the "recurrence" field in the mutation.
+func (m *EventSeriesMutation) Recurrence() (r string, exists bool) {
+	v := m.recurrence
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRecurrence returns the old "recurrence" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldRecurrence(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRecurrence is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRecurrence requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRecurrence: %w", err)
+	}
+	return oldValue.Recurrence, nil
+}
+
+// ResetRecurrence resets all changes to the "recurrence" field.
+func (m *EventSeriesMutation) ResetRecurrence() {
+	m.recurrence = nil
+}
+
+// SetTotalTickets sets the "total_tickets" field.
+func (m *EventSeriesMutation) SetTotalTickets(i int) {
+	m.total_tickets = &i
+	m.addtotal_tickets = nil
+}
+
+// TotalTickets returns the value of the "total_tickets" field in the mutation.
+func (m *EventSeriesMutation) TotalTickets() (r int, exists bool) {
+	v := m.total_tickets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalTickets returns the old "total_tickets" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldTotalTickets(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalTickets is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalTickets requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalTickets: %w", err)
+	}
+	return oldValue.TotalTickets, nil
+}
+
+// AddTotalTickets adds i to the "total_tickets" field.
+func (m *EventSeriesMutation) AddTotalTickets(i int) {
+	if m.addtotal_tickets != nil {
+		*m.addtotal_tickets += i
+	} else {
+		m.addtotal_tickets = &i
+	}
+}
+
+// AddedTotalTickets returns the value that was added to the "total_tickets" field in this mutation.
+func (m *EventSeriesMutation) AddedTotalTickets() (r int, exists bool) {
+	v := m.addtotal_tickets
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalTickets resets all changes to the "total_tickets" field.
+func (m *EventSeriesMutation) ResetTotalTickets() {
+	m.total_tickets = nil
+	m.addtotal_tickets = nil
+}
+
+// SetTicketPrice sets the "ticket_price" field.
+func (m *EventSeriesMutation) SetTicketPrice(f float64) {
+	m.ticket_price = &f
+	m.addticket_price = nil
+}
+
+// TicketPrice returns the value of the "ticket_price" field in the mutation.
+func (m *EventSeriesMutation) TicketPrice() (r float64, exists bool) {
+	v := m.ticket_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTicketPrice returns the old "ticket_price" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldTicketPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTicketPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTicketPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTicketPrice: %w", err)
+	}
+	return oldValue.TicketPrice, nil
+}
+
+// AddTicketPrice adds f to the "ticket_price" field.
+func (m *EventSeriesMutation) AddTicketPrice(f float64) {
+	if m.addticket_price != nil {
+		*m.addticket_price += f
+	} else {
+		m.addticket_price = &f
+	}
+}
+
+// AddedTicketPrice returns the value that was added to the "ticket_price" field in this mutation.
+func (m *EventSeriesMutation) AddedTicketPrice() (r float64, exists bool) {
+	v := m.addticket_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTicketPrice resets all changes to the "ticket_price" field.
+func (m *EventSeriesMutation) ResetTicketPrice() {
+	m.ticket_price = nil
+	m.addticket_price = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *EventSeriesMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *EventSeriesMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *EventSeriesMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetThumbnailURL sets the "thumbnail_url" field.
+func (m *EventSeriesMutation) SetThumbnailURL(s string) {
+	m.thumbnail_url = &s
+}
+
+// ThumbnailURL returns the value of the "thumbnail_url" field in the mutation.
+func (m *EventSeriesMutation) ThumbnailURL() (r string, exists bool) {
+	v := m.thumbnail_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldThumbnailURL returns the old "thumbnail_url" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldThumbnailURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldThumbnailURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldThumbnailURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldThumbnailURL: %w", err)
+	}
+	return oldValue.ThumbnailURL, nil
+}
+
+// ClearThumbnailURL clears the value of the "thumbnail_url" field.
+func (m *EventSeriesMutation) ClearThumbnailURL() {
+	m.thumbnail_url = nil
+	m.clearedFields[eventseries.FieldThumbnailURL] = struct{}{}
+}
+
+// ThumbnailURLCleared returns if the "thumbnail_url" field was cleared in this mutation.
+func (m *EventSeriesMutation) ThumbnailURLCleared() bool {
+	_, ok := m.clearedFields[eventseries.FieldThumbnailURL]
+	return ok
+}
+
+// ResetThumbnailURL resets all changes to the "thumbnail_url" field.
+func (m *EventSeriesMutation) ResetThumbnailURL() {
+	m.thumbnail_url = nil
+	delete(m.clearedFields, eventseries.FieldThumbnailURL)
+}
+
+// SetIsPublic sets the "is_public" field.
+func (m *EventSeriesMutation) SetIsPublic(b bool) {
+	m.is_public = &b
+}
+
+// IsPublic returns the value of the "is_public" field in the mutation.
+func (m *EventSeriesMutation) IsPublic() (r bool, exists bool) {
+	v := m.is_public
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsPublic returns the old "is_public" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldIsPublic(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsPublic is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsPublic requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsPublic: %w", err)
+	}
+	return oldValue.IsPublic, nil
+}
+
+// ResetIsPublic resets all changes to the "is_public" field.
+func (m *EventSeriesMutation) ResetIsPublic() {
+	m.is_public = nil
+}
+
+// SetRequiresApproval sets the "requires_approval" field.
+func (m *EventSeriesMutation) SetRequiresApproval(b bool) {
+	m.requires_approval = &b
+}
+
+// RequiresApproval returns the value of the "requires_approval" field in the mutation.
+func (m *EventSeriesMutation) RequiresApproval() (r bool, exists bool) {
+	v := m.requires_approval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequiresApproval returns the old "requires_approval" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldRequiresApproval(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequiresApproval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequiresApproval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequiresApproval: %w", err)
+	}
+	return oldValue.RequiresApproval, nil
+}
+
+// ResetRequiresApproval resets all changes to the "requires_approval" field.
+func (m *EventSeriesMutation) ResetRequiresApproval() {
+	m.requires_approval = nil
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *EventSeriesMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *EventSeriesMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedBy returns the old "created_by" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
+	}
+	return oldValue.CreatedBy, nil
+}
+
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *EventSeriesMutation) ResetCreatedBy() {
+	m.created_by = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *EventSeriesMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *EventSeriesMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *EventSeriesMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *EventSeriesMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *EventSeriesMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the EventSeries entity.
+// If the EventSeries object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *EventSeriesMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *EventSeriesMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *EventSeriesMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[eventseries.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *EventSeriesMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *EventSeriesMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *EventSeriesMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// SetLinkedVenueID sets the "linked_venue" edge to the Venue entity by id.
+func (m *EventSeriesMutation) SetLinkedVenueID(id uuid.UUID) {
+	m.linked_venue = &id
+}
+
+// ClearLinkedVenue clears the "linked_venue" edge to the Venue entity.
+func (m *EventSeriesMutation) ClearLinkedVenue() {
+	m.clearedlinked_venue = true
+	m.clearedFields[eventseries.FieldVenueID] = struct{}{}
+}
+
+// LinkedVenueCleared reports if the "linked_venue" edge to the Venue entity was cleared.
+func (m *EventSeriesMutation) LinkedVenueCleared() bool {
+	return m.VenueIDCleared() || m.clearedlinked_venue
+}
+
+// LinkedVenueID returns the "linked_venue" edge ID in the mutation.
+func (m *EventSeriesMutation) LinkedVenueID() (id uuid.UUID, exists bool) {
+	if m.linked_venue != nil {
+		return *m.linked_venue, true
+	}
+	return
+}
+
+// LinkedVenueIDs returns the "linked_venue" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// LinkedVenueID instead. It exists only for internal usage by the builders.
+func (m *EventSeriesMutation) LinkedVenueIDs() (ids []uuid.UUID) {
+	if id := m.linked_venue; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetLinkedVenue resets all changes to the "linked_venue" edge.
+func (m *EventSeriesMutation) ResetLinkedVenue() {
+	m.linked_venue = nil
+	m.clearedlinked_venue = false
+}
+
+// AddOccurrenceIDs adds the "occurrences" edge to the Event entity by ids.
+func (m *EventSeriesMutation) AddOccurrenceIDs(ids ...uuid.UUID) {
+	if m.occurrences == nil {
+		m.occurrences = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.occurrences[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOccurrences clears the "occurrences" edge to the Event entity.
+func (m *EventSeriesMutation) ClearOccurrences() {
+	m.clearedoccurrences = true
+}
+
+// OccurrencesCleared reports if the "occurrences" edge to the Event entity was cleared.
+func (m *EventSeriesMutation) OccurrencesCleared() bool {
+	return m.clearedoccurrences
+}
+
+// RemoveOccurrenceIDs removes the "occurrences" edge to the Event entity by IDs.
+func (m *EventSeriesMutation) RemoveOccurrenceIDs(ids ...uuid.UUID) {
+	if m.removedoccurrences == nil {
+		m.removedoccurrences = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.occurrences, ids[i])
+		m.removedoccurrences[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOccurrences returns the removed IDs of the "occurrences" edge to the Event entity.
+func (m *EventSeriesMutation) RemovedOccurrencesIDs() (ids []uuid.UUID) {
+	for id := range m.removedoccurrences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OccurrencesIDs returns the "occurrences" edge IDs in the mutation.
+func (m *EventSeriesMutation) OccurrencesIDs() (ids []uuid.UUID) {
+	for id := range m.occurrences {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOccurrences resets all changes to the "occurrences" edge.
+func (m *EventSeriesMutation) ResetOccurrences() {
+	m.occurrences = nil
+	m.clearedoccurrences = false
+	m.removedoccurrences = nil
+}
+
+// Where appends a list predicates to the EventSeriesMutation builder.
+func (m *EventSeriesMutation) Where(ps ...predicate.EventSeries) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the EventSeriesMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *EventSeriesMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.EventSeries, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *EventSeriesMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *EventSeriesMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (EventSeries).
+func (m *EventSeriesMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *EventSeriesMutation) Fields() []string {
+	fields := make([]string, 0, 27)
+	if m.organization != nil {
+		fields = append(fields, eventseries.FieldOrganizationID)
+	}
+	if m.title != nil {
+		fields = append(fields, eventseries.FieldTitle)
+	}
+	if m.description != nil {
+		fields = append(fields, eventseries.FieldDescription)
+	}
+	if m.location != nil {
+		fields = append(fields, eventseries.FieldLocation)
+	}
+	if m.venue != nil {
+		fields = append(fields, eventseries.FieldVenue)
+	}
+	if m.linked_venue != nil {
+		fields = append(fields, eventseries.FieldVenueID)
+	}
+	if m.seating_layout != nil {
+		fields = append(fields, eventseries.FieldSeatingLayout)
+	}
+	if m.address_street != nil {
+		fields = append(fields, eventseries.FieldAddressStreet)
+	}
+	if m.address_city != nil {
+		fields = append(fields, eventseries.FieldAddressCity)
+	}
+	if m.address_region != nil {
+		fields = append(fields, eventseries.FieldAddressRegion)
+	}
+	if m.address_postal_code != nil {
+		fields = append(fields, eventseries.FieldAddressPostalCode)
+	}
+	if m.address_country != nil {
+		fields = append(fields, eventseries.FieldAddressCountry)
+	}
+	if m.latitude != nil {
+		fields = append(fields, eventseries.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, eventseries.FieldLongitude)
+	}
+	if m.category != nil {
+		fields = append(fields, eventseries.FieldCategory)
+	}
+	if m.start_time != nil {
+		fields = append(fields, eventseries.FieldStartTime)
+	}
+	if m.end_time != nil {
+		fields = append(fields, eventseries.FieldEndTime)
+	}
+	if m.recurrence != nil {
+		fields = append(fields, eventseries.FieldRecurrence)
+	}
+	if m.total_tickets != nil {
+		fields = append(fields, eventseries.FieldTotalTickets)
+	}
+	if m.ticket_price != nil {
+		fields = append(fields, eventseries.FieldTicketPrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, eventseries.FieldCurrency)
+	}
+	if m.thumbnail_url != nil {
+		fields = append(fields, eventseries.FieldThumbnailURL)
+	}
+	if m.is_public != nil {
+		fields = append(fields, eventseries.FieldIsPublic)
+	}
+	if m.requires_approval != nil {
+		fields = append(fields, eventseries.FieldRequiresApproval)
+	}
+	if m.created_by != nil {
+		fields = append(fields, eventseries.FieldCreatedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, eventseries.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, eventseries.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *EventSeriesMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case eventseries.FieldOrganizationID:
+		return m.OrganizationID()
+	case eventseries.FieldTitle:
+		return m.Title()
+	case eventseries.FieldDescription:
+		return m.Description()
+	case eventseries.FieldLocation:
+		return m.Location()
+	case eventseries.FieldVenue:
+		return m.Venue()
+	case eventseries.FieldVenueID:
+		return m.VenueID()
+	case eventseries.FieldSeatingLayout:
+		return m.SeatingLayout()
+	case eventseries.FieldAddressStreet:
+		return m.AddressStreet()
+	case eventseries.FieldAddressCity:
+		return m.AddressCity()
+	case eventseries.FieldAddressRegion:
+		return m.AddressRegion()
+	case eventseries.FieldAddressPostalCode:
+		return m.AddressPostalCode()
+	case eventseries.FieldAddressCountry:
+		return m.AddressCountry()
+	case eventseries.FieldLatitude:
+		return m.Latitude()
+	case eventseries.FieldLongitude:
+		return m.Longitude()
+	case eventseries.FieldCategory:
+		return m.Category()
+	case eventseries.FieldStartTime:
+		return m.StartTime()
+	case eventseries.FieldEndTime:
+		return m.EndTime()
+	case eventseries.FieldRecurrence:
+		return m.Recurrence()
+	case eventseries.FieldTotalTickets:
+		return m.TotalTickets()
+	case eventseries.FieldTicketPrice:
+		return m.TicketPrice()
+	case eventseries.FieldCurrency:
+		return m.Currency()
+	case eventseries.FieldThumbnailURL:
+		return m.ThumbnailURL()
+	case eventseries.FieldIsPublic:
+		return m.IsPublic()
+	case eventseries.FieldRequiresApproval:
+		return m.RequiresApproval()
+	case eventseries.FieldCreatedBy:
+		return m.CreatedBy()
+	case eventseries.FieldCreatedAt:
+		return m.CreatedAt()
+	case eventseries.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *EventSeriesMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case eventseries.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case eventseries.FieldTitle:
+		return m.OldTitle(ctx)
+	case eventseries.FieldDescription:
+		return m.OldDescription(ctx)
+	case eventseries.FieldLocation:
+		return m.OldLocation(ctx)
+	case eventseries.FieldVenue:
+		return m.OldVenue(ctx)
+	case eventseries.FieldVenueID:
+		return m.OldVenueID(ctx)
+	case eventseries.FieldSeatingLayout:
+		return m.OldSeatingLayout(ctx)
+	case eventseries.FieldAddressStreet:
+		return m.OldAddressStreet(ctx)
+	case eventseries.FieldAddressCity:
+		return m.OldAddressCity(ctx)
+	case eventseries.FieldAddressRegion:
+		return m.OldAddressRegion(ctx)
+	case eventseries.FieldAddressPostalCode:
+		return m.OldAddressPostalCode(ctx)
+	case eventseries.FieldAddressCountry:
+		return m.OldAddressCountry(ctx)
+	case eventseries.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case eventseries.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case eventseries.FieldCategory:
+		return m.OldCategory(ctx)
+	case eventseries.FieldStartTime:
+		return m.OldStartTime(ctx)
+	case eventseries.FieldEndTime:
+		return m.OldEndTime(ctx)
+	case eventseries.FieldRecurrence:
+		return m.OldRecurrence(ctx)
+	case eventseries.FieldTotalTickets:
+		return m.OldTotalTickets(ctx)
+	case eventseries.FieldTicketPrice:
+		return m.OldTicketPrice(ctx)
+	case eventseries.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case eventseries.FieldThumbnailURL:
+		return m.OldThumbnailURL(ctx)
+	case eventseries.FieldIsPublic:
+		return m.OldIsPublic(ctx)
+	case eventseries.FieldRequiresApproval:
+		return m.OldRequiresApproval(ctx)
+	case eventseries.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case eventseries.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case eventseries.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown EventSeries field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventSeriesMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case eventseries.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case eventseries.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case eventseries.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case eventseries.FieldLocation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLocation(v)
+		return nil
+	case eventseries.FieldVenue:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVenue(v)
+		return nil
+	case eventseries.FieldVenueID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVenueID(v)
+		return nil
+	case eventseries.FieldSeatingLayout:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeatingLayout(v)
+		return nil
+	case eventseries.FieldAddressStreet:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressStreet(v)
+		return nil
+	case eventseries.FieldAddressCity:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressCity(v)
+		return nil
+	case eventseries.FieldAddressRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressRegion(v)
+		return nil
+	case eventseries.FieldAddressPostalCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressPostalCode(v)
+		return nil
+	case eventseries.FieldAddressCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressCountry(v)
+		return nil
+	case eventseries.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case eventseries.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case eventseries.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case eventseries.FieldStartTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStartTime(v)
+		return nil
+	case eventseries.FieldEndTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEndTime(v)
+		return nil
+	case eventseries.FieldRecurrence:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRecurrence(v)
+		return nil
+	case eventseries.FieldTotalTickets:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalTickets(v)
+		return nil
+	case eventseries.FieldTicketPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTicketPrice(v)
+		return nil
+	case eventseries.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case eventseries.FieldThumbnailURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetThumbnailURL(v)
+		return nil
+	case eventseries.FieldIsPublic:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsPublic(v)
+		return nil
+	case eventseries.FieldRequiresApproval:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequiresApproval(v)
+		return nil
+	case eventseries.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case eventseries.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case eventseries.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventSeries field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *EventSeriesMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatitude != nil {
+		fields = append(fields, eventseries.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, eventseries.FieldLongitude)
+	}
+	if m.addtotal_tickets != nil {
+		fields = append(fields, eventseries.FieldTotalTickets)
+	}
+	if m.addticket_price != nil {
+		fields = append(fields, eventseries.FieldTicketPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *EventSeriesMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case eventseries.FieldLatitude:
+		return m.AddedLatitude()
+	case eventseries.FieldLongitude:
+		return m.AddedLongitude()
+	case eventseries.FieldTotalTickets:
+		return m.AddedTotalTickets()
+	case eventseries.FieldTicketPrice:
+		return m.AddedTicketPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *EventSeriesMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case eventseries.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case eventseries.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	case eventseries.FieldTotalTickets:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalTickets(v)
+		return nil
+	case eventseries.FieldTicketPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTicketPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown EventSeries numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *EventSeriesMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(eventseries.FieldDescription) {
+		fields = append(fields, eventseries.FieldDescription)
+	}
+	if m.FieldCleared(eventseries.FieldLocation) {
+		fields = append(fields, eventseries.FieldLocation)
+	}
+	if m.FieldCleared(eventseries.FieldVenue) {
+		fields = append(fields, eventseries.FieldVenue)
+	}
+	if m.FieldCleared(eventseries.FieldVenueID) {
+		fields = append(fields, eventseries.FieldVenueID)
+	}
+	if m.FieldCleared(eventseries.FieldLatitude) {
+		fields = append(fields, eventseries.FieldLatitude)
+	}
+	if m.FieldCleared(eventseries.FieldLongitude) {
+		fields = append(fields, eventseries.FieldLongitude)
+	}
+	if m.FieldCleared(eventseries.FieldThumbnailURL) {
+		fields = append(fields, eventseries.FieldThumbnailURL)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *EventSeriesMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *EventSeriesMutation) ClearField(name string) error {
+	switch name {
+	case eventseries.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case eventseries.FieldLocation:
+		m.ClearLocation()
+		return nil
+	case eventseries.FieldVenue:
+		m.ClearVenue()
+		return nil
+	case eventseries.FieldVenueID:
+		m.ClearVenueID()
+		return nil
+	case eventseries.FieldLatitude:
+		m.ClearLatitude()
+		return nil
+	case eventseries.FieldLongitude:
+		m.ClearLongitude()
+		return nil
+	case eventseries.FieldThumbnailURL:
+		m.ClearThumbnailURL()
+		return nil
+	}
+	return fmt.Errorf("unknown EventSeries nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *EventSeriesMutation) ResetField(name string) error {
+	switch name {
+	case eventseries.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case eventseries.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case eventseries.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case eventseries.FieldLocation:
+		m.ResetLocation()
+		return nil
+	case eventseries.FieldVenue:
+		m.ResetVenue()
+		return nil
+	case eventseries.FieldVenueID:
+		m.ResetVenueID()
+		return nil
+	case eventseries.FieldSeatingLayout:
+		m.ResetSeatingLayout()
+		return nil
+	case eventseries.FieldAddressStreet:
+		m.ResetAddressStreet()
+		return nil
+	case eventseries.FieldAddressCity:
+		m.ResetAddressCity()
+		return nil
+	case eventseries.FieldAddressRegion:
+		m.ResetAddressRegion()
+		return nil
+	case eventseries.FieldAddressPostalCode:
+		m.ResetAddressPostalCode()
+		return nil
+	case eventseries.FieldAddressCountry:
+		m.ResetAddressCountry()
+		return nil
+	case eventseries.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case eventseries.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case eventseries.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case eventseries.FieldStartTime:
+		m.ResetStartTime()
+		return nil
+	case eventseries.FieldEndTime:
+		m.ResetEndTime()
+		return nil
+	case eventseries.FieldRecurrence:
+		m.ResetRecurrence()
+		return nil
+	case eventseries.FieldTotalTickets:
+		m.ResetTotalTickets()
+		return nil
+	case eventseries.FieldTicketPrice:
+		m.ResetTicketPrice()
+		return nil
+	case eventseries.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case eventseries.FieldThumbnailURL:
+		m.ResetThumbnailURL()
+		return nil
+	case eventseries.FieldIsPublic:
+		m.ResetIsPublic()
+		return nil
+	case eventseries.FieldRequiresApproval:
+		m.ResetRequiresApproval()
+		return nil
+	case eventseries.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case eventseries.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case eventseries.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown EventSeries field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *EventSeriesMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.organization != nil {
+		edges = append(edges, eventseries.EdgeOrganization)
+	}
+	if m.linked_venue != nil {
+		edges = append(edges, eventseries.EdgeLinkedVenue)
+	}
+	if m.occurrences != nil {
+		edges = append(edges, eventseries.EdgeOccurrences)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *EventSeriesMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case eventseries.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case eventseries.EdgeLinkedVenue:
+		if id := m.linked_venue; id != nil {
+			return []ent.Value{*id}
+		}
+	case eventseries.EdgeOccurrences:
+		ids := make([]ent.Value, 0, len(m.occurrences))
+		for id := range m.occurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *EventSeriesMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedoccurrences != nil {
+		edges = append(edges, eventseries.EdgeOccurrences)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *EventSeriesMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case eventseries.EdgeOccurrences:
+		ids := make([]ent.Value, 0, len(m.removedoccurrences))
+		for id := range m.removedoccurrences {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *EventSeriesMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedorganization {
+		edges = append(edges, eventseries.EdgeOrganization)
+	}
+	if m.clearedlinked_venue {
+		edges = append(edges, eventseries.EdgeLinkedVenue)
+	}
+	if m.clearedoccurrences {
+		edges = append(edges, eventseries.EdgeOccurrences)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *EventSeriesMutation) EdgeCleared(name string) bool {
+	switch name {
+	case eventseries.EdgeOrganization:
+		return m.clearedorganization
+	case eventseries.EdgeLinkedVenue:
+		return m.clearedlinked_venue
+	case eventseries.EdgeOccurrences:
+		return m.clearedoccurrences
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *EventSeriesMutation) ClearEdge(name string) error {
+	switch name {
+	case eventseries.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	case eventseries.EdgeLinkedVenue:
+		m.ClearLinkedVenue()
+		return nil
+	}
+	return fmt.Errorf("unknown EventSeries unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *EventSeriesMutation) ResetEdge(name string) error {
+	switch name {
+	case eventseries.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case eventseries.EdgeLinkedVenue:
+		m.ResetLinkedVenue()
+		return nil
+	case eventseries.EdgeOccurrences:
+		m.ResetOccurrences()
+		return nil
+	}
+	return fmt.Errorf("unknown EventSeries edge %s", name)
+}
+
+// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
+type OrganizationMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	name                       *string
+	name_initials              *string
+	description                *string
+	logo_url                   *string
+	category                   *string
+	slug                       *string
+	social_links               *map[string]string
+	is_active                  *bool
+	deleted_at                 *time.Time
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	members                    map[uuid.UUID]struct{}
+	removedmembers             map[uuid.UUID]struct{}
+	clearedmembers             bool
+	roles                      map[uuid.UUID]struct{}
+	removedroles               map[uuid.UUID]struct{}
+	clearedroles               bool
+	invitations                map[uuid.UUID]struct{}
+	removedinvitations         map[uuid.UUID]struct{}
+	clearedinvitations         bool
+	ownership_transfers        map[uuid.UUID]struct{}
+	removedownership_transfers map[uuid.UUID]struct{}
+	clearedownership_transfers bool
+	api_keys                   map[uuid.UUID]struct{}
+	removedapi_keys            map[uuid.UUID]struct{}
+	clearedapi_keys            bool
+	webhook_endpoints          map[uuid.UUID]struct{}
+	removedwebhook_endpoints   map[uuid.UUID]struct{}
+	clearedwebhook_endpoints   bool
+	events                     map[uuid.UUID]struct{}
+	removedevents              map[uuid.UUID]struct{}
+	clearedevents              bool
+	event_series               map[uuid.UUID]struct{}
+	removedevent_series        map[uuid.UUID]struct{}
+	clearedevent_series        bool
+	venues                     map[uuid.UUID]struct{}
+	removedvenues              map[uuid.UUID]struct{}
+	clearedvenues              bool
+	owner                      *uuid.UUID
+	clearedowner               bool
+	done                       bool
+	oldValue                   func(context.Context) (*Organization, error)
+	predicates                 []predicate.Organization
+}
+
+var _ ent.Mutation = (*OrganizationMutation)(nil)
+
+// organizationOption allows management of the mutation configuration using functional options.
+type organizationOption func(*OrganizationMutation)
+
+// newOrganizationMutation creates new mutation for the Organization entity.
+func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
+	m := &OrganizationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganization,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationID sets the ID field of the mutation.
+func withOrganizationID(id uuid.UUID) organizationOption {
+	return func(m *OrganizationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Organization
+		)
+		m.oldValue = func(ctx context.Context) (*Organization, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Organization.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganization sets the old Organization of the mutation.
+func withOrganization(node *Organization) organizationOption {
+	return func(m *OrganizationMutation) {
+		m.oldValue = func(context.Context) (*Organization, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Organization entities.
+func (m *OrganizationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrganizationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Organization.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetName sets the "name" field.
+func (m *OrganizationMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OrganizationMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OrganizationMutation) ResetName() {
+	m.name = nil
+}
+
+// SetNameInitials sets the "name_initials" field.
+func (m *OrganizationMutation) SetNameInitials(s string) {
+	m.name_initials = &s
+}
+
+// NameInitials returns the value of the "name_initials" field in the mutation.
+func (m *OrganizationMutation) NameInitials() (r string, exists bool) {
+	v := m.name_initials
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNameInitials returns the old "name_initials" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldNameInitials(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNameInitials is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNameInitials requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNameInitials: %w", err)
+	}
+	return oldValue.NameInitials, nil
+}
+
+// ResetNameInitials resets all changes to the "name_initials" field.
+func (m *OrganizationMutation) ResetNameInitials() {
+	m.name_initials = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *OrganizationMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *OrganizationMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *OrganizationMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[organization.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *OrganizationMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[organization.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *OrganizationMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, organization.FieldDescription)
+}
+
+// SetLogoURL sets the "logo_url" field.
+func (m *OrganizationMutation) SetLogoURL(s string) {
+	m.logo_url = &s
+}
+
+// LogoURL returns the value of the "logo_url" field in the mutation.
+func (m *OrganizationMutation) LogoURL() (r string, exists bool) {
+	v := m.logo_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLogoURL returns the old "logo_url" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldLogoURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLogoURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+	}
+	return oldValue.LogoURL, nil
+}
+
+// ClearLogoURL clears the value of the "logo_url" field.
+func (m *OrganizationMutation) ClearLogoURL() {
+	m.logo_url = nil
+	m.clearedFields[organization.FieldLogoURL] = struct{}{}
+}
+
+// LogoURLCleared returns if the "logo_url" field was cleared in this mutation.
+func (m *OrganizationMutation) LogoURLCleared() bool {
+	_, ok := m.clearedFields[organization.FieldLogoURL]
+	return ok
+}
+
+// ResetLogoURL resets all changes to the "logo_url" field.
+func (m *OrganizationMutation) ResetLogoURL() {
+	m.logo_url = nil
+	delete(m.clearedFields, organization.FieldLogoURL)
+}
+
+// SetCategory sets the "category" field.
+func (m *OrganizationMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *OrganizationMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ClearCategory clears the value of the "category" field.
+func (m *OrganizationMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[organization.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *OrganizationMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[organization.FieldCategory]
+	return ok
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *OrganizationMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, organization.FieldCategory)
+}
+
+// SetSlug sets the "slug" field.
+func (m *OrganizationMutation) SetSlug(s string) {
+	m.slug = &s
+}
+
+// Slug returns the value of the "slug" field in the mutation.
+func (m *OrganizationMutation) Slug() (r string, exists bool) {
+	v := m.slug
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSlug returns the old "slug" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldSlug(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSlug is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSlug requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSlug: %w", err)
+	}
+	return oldValue.Slug, nil
+}
+
+// ClearSlug clears the value of the "slug" field.
+func (m *OrganizationMutation) ClearSlug() {
+	m.slug = nil
+	m.clearedFields[organization.FieldSlug] = struct{}{}
+}
+
+// SlugCleared returns if the "slug" field was cleared in this mutation.
+func (m *OrganizationMutation) SlugCleared() bool {
+	_, ok := m.clearedFields[organization.FieldSlug]
+	return ok
+}
+
+// ResetSlug resets all changes to the "slug" field.
+func (m *OrganizationMutation) ResetSlug() {
+	m.slug = nil
+	delete(m.clearedFields, organization.FieldSlug)
+}
+
+// SetSocialLinks sets the "social_links" field.
+func (m *OrganizationMutation) SetSocialLinks(value map[string]string) {
+	m.social_links = &value
+}
+
+// SocialLinks returns the value of the "social_links" field in the mutation.
+func (m *OrganizationMutation) SocialLinks() (r map[string]string, exists bool) {
+	v := m.social_links
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSocialLinks returns the old "social_links" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldSocialLinks(ctx context.Context) (v map[string]string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSocialLinks is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSocialLinks requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSocialLinks: %w", err)
+	}
+	return oldValue.SocialLinks, nil
+}
+
+// ClearSocialLinks clears the value of the "social_links" field.
+func (m *OrganizationMutation) ClearSocialLinks() {
+	m.social_links = nil
+	m.clearedFields[organization.FieldSocialLinks] = struct{}{}
+}
+
+// SocialLinksCleared returns if the "social_links" field was cleared in this mutation.
+func (m *OrganizationMutation) SocialLinksCleared() bool {
+	_, ok := m.clearedFields[organization.FieldSocialLinks]
+	return ok
+}
+
+// ResetSocialLinks resets all changes to the "social_links" field.
+func (m *OrganizationMutation) ResetSocialLinks() {
+	m.social_links = nil
+	delete(m.clearedFields, organization.FieldSocialLinks)
+}
+
+// SetOwnerID sets the "owner_id" field.
+func (m *OrganizationMutation) SetOwnerID(u uuid.UUID) {
+	m.owner = &u
+}
+
+// OwnerID returns the value of the "owner_id" field in the mutation.
+func (m *OrganizationMutation) OwnerID() (r uuid.UUID, exists bool) {
+	v := m.owner
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOwnerID returns the old "owner_id" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	}
+	return oldValue.OwnerID, nil
+}
+
+// ResetOwnerID resets all changes to the "owner_id" field.
+func (m *OrganizationMutation) ResetOwnerID() {
+	m.owner = nil
+}
+
+// SetIsActive sets the "is_active" field.
+func (m *OrganizationMutation) SetIsActive(b bool) {
+	m.is_active = &b
+}
+
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *OrganizationMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsActive returns the old "is_active" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldIsActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	}
+	return oldValue.IsActive, nil
+}
+
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *OrganizationMutation) ResetIsActive() {
+	m.is_active = nil
+}
+
+// SetDeletedAt sets the "deleted_at" field.
+func (m *OrganizationMutation) SetDeletedAt(t time.Time) {
+	m.deleted_at = &t
+}
+
+// DeletedAt returns the value of the "deleted_at" field in the mutation.
+func (m *OrganizationMutation) DeletedAt() (r time.Time, exists bool) {
+	v := m.deleted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDeletedAt returns the old "deleted_at" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldDeletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeletedAt: %w", err)
+	}
+	return oldValue.DeletedAt, nil
+}
+
+// ClearDeletedAt clears the value of the "deleted_at" field.
+func (m *OrganizationMutation) ClearDeletedAt() {
+	m.deleted_at = nil
+	m.clearedFields[organization.FieldDeletedAt] = struct{}{}
+}
+
+// DeletedAtCleared returns if the "deleted_at" field was cleared in this mutation.
+func (m *OrganizationMutation) DeletedAtCleared() bool {
+	_, ok := m.clearedFields[organization.FieldDeletedAt]
+	return ok
+}
+
+// ResetDeletedAt resets all changes to the "deleted_at" field.
+func (m *OrganizationMutation) ResetDeletedAt() {
+	m.deleted_at = nil
+	delete(m.clearedFields, organization.FieldDeletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrganizationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrganizationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrganizationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrganizationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrganizationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Organization entity.
+// If the Organization object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrganizationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// AddMemberIDs adds the "members" edge to the OrganizationMember entity by ids.
+func (m *OrganizationMutation) AddMemberIDs(ids ...uuid.UUID) {
+	if m.members == nil {
+		m.members = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.members[ids[i]] = struct{}{}
+	}
+}
+
+// ClearMembers clears the "members" edge to the OrganizationMember entity.
+func (m *OrganizationMutation) ClearMembers() {
+	m.clearedmembers = true
+}
+
+// MembersCleared reports if the "members" edge to the OrganizationMember entity was cleared.
+func (m *OrganizationMutation) MembersCleared() bool {
+	return m.clearedmembers
+}
+
+// RemoveMemberIDs removes the "members" edge to the OrganizationMember entity by IDs.
+func (m *OrganizationMutation) RemoveMemberIDs(ids ...uuid.UUID) {
+	if m.removedmembers == nil {
+		m.removedmembers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.members, ids[i])
+		m.removedmembers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMembers returns the removed IDs of the "members" edge to the OrganizationMember entity.
+func (m *OrganizationMutation) RemovedMembersIDs() (ids []uuid.UUID) {
+	for id := range m.removedmembers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// MembersIDs returns the "members" edge IDs in the mutation.
+func (m *OrganizationMutation) MembersIDs() (ids []uuid.UUID) {
+	for id := range m.members {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetMembers resets all changes to the "members" edge.
+func (m *OrganizationMutation) ResetMembers() {
+	m.members = nil
+	m.clearedmembers = false
+	m.removedmembers = nil
+}
+
+// AddRoleIDs adds the "roles" edge to the OrganizationRole entity by ids.
+func (m *OrganizationMutation) AddRoleIDs(ids ...uuid.UUID) {
+	if m.roles == nil {
+		m.roles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.roles[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRoles clears the "roles" edge to the OrganizationRole entity.
+func (m *OrganizationMutation) ClearRoles() {
+	m.clearedroles = true
+}
+
+// RolesCleared reports if the "roles" edge to the OrganizationRole entity was cleared.
+func (m *OrganizationMutation) RolesCleared() bool {
+	return m.clearedroles
+}
+
+// RemoveRoleIDs removes the "roles" edge to the OrganizationRole entity by IDs.
+func (m *OrganizationMutation) RemoveRoleIDs(ids ...uuid.UUID) {
+	if m.removedroles == nil {
+		m.removedroles = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.roles, ids[i])
+		m.removedroles[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRoles returns the removed IDs of the "roles" edge to the OrganizationRole entity.
+func (m *OrganizationMutation) RemovedRolesIDs() (ids []uuid.UUID) {
+	for id := range m.removedroles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RolesIDs returns the "roles" edge IDs in the mutation.
+func (m *OrganizationMutation) RolesIDs() (ids []uuid.UUID) {
+	for id := range m.roles {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRoles resets all changes to the "roles" edge.
+func (m *OrganizationMutation) ResetRoles() {
+	m.roles = nil
+	m.clearedroles = false
+	m.removedroles = nil
+}
+
+// AddInvitationIDs adds the "invitations" edge to the OrganizationInvitation entity by ids.
+func (m *OrganizationMutation) AddInvitationIDs(ids ...uuid.UUID) {
+	if m.invitations == nil {
+		m.invitations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.invitations[ids[i]] = struct{}{}
+	}
+}
+
+// ClearInvitations clears the "invitations" edge to the OrganizationInvitation entity.
+func (m *OrganizationMutation) ClearInvitations() {
+	m.clearedinvitations = true
+}
+
+// InvitationsCleared reports if the "invitations" edge to the OrganizationInvitation entity was cleared.
+func (m *OrganizationMutation) InvitationsCleared() bool {
+	return m.clearedinvitations
+}
+
+// RemoveInvitationIDs removes the "invitations" edge to the OrganizationInvitation entity by IDs.
+func (m *OrganizationMutation) RemoveInvitationIDs(ids ...uuid.UUID) {
+	if m.removedinvitations == nil {
+		m.removedinvitations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.invitations, ids[i])
+		m.removedinvitations[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedInvitations returns the removed IDs of the "invitations" edge to the OrganizationInvitation entity.
+func (m *OrganizationMutation) RemovedInvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedinvitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// InvitationsIDs returns the "invitations" edge IDs in the mutation.
+func (m *OrganizationMutation) InvitationsIDs() (ids []uuid.UUID) {
+	for id := range m.invitations {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetInvitations resets all changes to the "invitations" edge.
+func (m *OrganizationMutation) ResetInvitations() {
+	m.invitations = nil
+	m.clearedinvitations = false
+	m.removedinvitations = nil
+}
+
+// AddOwnershipTransferIDs adds the "ownership_transfers" edge to the OwnershipTransfer entity by ids.
+func (m *OrganizationMutation) AddOwnershipTransferIDs(ids ...uuid.UUID) {
+	if m.ownership_transfers == nil {
+		m.ownership_transfers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.ownership_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOwnershipTransfers clears the "ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *OrganizationMutation) ClearOwnershipTransfers() {
+	m.clearedownership_transfers = true
+}
+
+// OwnershipTransfersCleared reports if the "ownership_transfers" edge to the OwnershipTransfer entity was cleared.
+func (m *OrganizationMutation) OwnershipTransfersCleared() bool {
+	return m.clearedownership_transfers
+}
+
+// RemoveOwnershipTransferIDs removes the "ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (m *OrganizationMutation) RemoveOwnershipTransferIDs(ids ...uuid.UUID) {
+	if m.removedownership_transfers == nil {
+		m.removedownership_transfers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.ownership_transfers, ids[i])
+		m.removedownership_transfers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOwnershipTransfers returns the removed IDs of the "ownership_transfers" edge to the OwnershipTransfer entity.
+func (m *OrganizationMutation) RemovedOwnershipTransfersIDs() (ids []uuid.UUID) {
+	for id := range m.removedownership_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OwnershipTransfersIDs returns the "ownership_transfers" edge IDs in the mutation.
+func (m *OrganizationMutation) OwnershipTransfersIDs() (ids []uuid.UUID) {
+	for id := range m.ownership_transfers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOwnershipTransfers resets all changes to the "ownership_transfers" edge.
+func (m *OrganizationMutation) ResetOwnershipTransfers() {
+	m.ownership_transfers = nil
+	m.clearedownership_transfers = false
+	m.removedownership_transfers = nil
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by ids.
+func (m *OrganizationMutation) AddAPIKeyIDs(ids ...uuid.UUID) {
+	if m.api_keys == nil {
+		m.api_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.api_keys[ids[i]] = struct{}{}
+	}
+}
+
+// ClearAPIKeys clears the "api_keys" edge to the APIKey entity.
+func (m *OrganizationMutation) ClearAPIKeys() {
+	m.clearedapi_keys = true
+}
+
+// APIKeysCleared reports if the "api_keys" edge to the APIKey entity was cleared.
+func (m *OrganizationMutation) APIKeysCleared() bool {
+	return m.clearedapi_keys
+}
+
+// RemoveAPIKeyIDs removes the "api_keys" edge to the APIKey entity by IDs.
+func (m *OrganizationMutation) RemoveAPIKeyIDs(ids ...uuid.UUID) {
+	if m.removedapi_keys == nil {
+		m.removedapi_keys = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.api_keys, ids[i])
+		m.removedapi_keys[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedAPIKeys returns the removed IDs of the "api_keys" edge to the APIKey entity.
+func (m *OrganizationMutation) RemovedAPIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.removedapi_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// APIKeysIDs returns the "api_keys" edge IDs in the mutation.
+func (m *OrganizationMutation) APIKeysIDs() (ids []uuid.UUID) {
+	for id := range m.api_keys {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetAPIKeys resets all changes to the "api_keys" edge.
+func (m *OrganizationMutation) ResetAPIKeys() {
+	m.api_keys = nil
+	m.clearedapi_keys = false
+	m.removedapi_keys = nil
+}
+
+// AddWebhookEndpointIDs adds the "webhook_endpoints" edge to the WebhookEndpoint entity by ids.
+func (m *OrganizationMutation) AddWebhookEndpointIDs(ids ...uuid.UUID) {
+	if m.webhook_endpoints == nil {
+		m.webhook_endpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.webhook_endpoints[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWebhookEndpoints clears the "webhook_endpoints" edge to the WebhookEndpoint entity.
+func (m *OrganizationMutation) ClearWebhookEndpoints() {
+	m.clearedwebhook_endpoints = true
+}
+
+// WebhookEndpointsCleared reports if the "webhook_endpoints" edge to the WebhookEndpoint entity was cleared.
+func (m *OrganizationMutation) WebhookEndpointsCleared() bool {
+	return m.clearedwebhook_endpoints
+}
+
+// RemoveWebhookEndpointIDs removes the "webhook_endpoints" edge to the WebhookEndpoint entity by IDs.
+func (m *OrganizationMutation) RemoveWebhookEndpointIDs(ids ...uuid.UUID) {
+	if m.removedwebhook_endpoints == nil {
+		m.removedwebhook_endpoints = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.webhook_endpoints, ids[i])
+		m.removedwebhook_endpoints[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWebhookEndpoints returns the removed IDs of the "webhook_endpoints" edge to the WebhookEndpoint entity.
+func (m *OrganizationMutation) RemovedWebhookEndpointsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwebhook_endpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WebhookEndpointsIDs returns the "webhook_endpoints" edge IDs in the mutation.
+func (m *OrganizationMutation) WebhookEndpointsIDs() (ids []uuid.UUID) {
+	for id := range m.webhook_endpoints {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWebhookEndpoints resets all changes to the "webhook_endpoints" edge.
+func (m *OrganizationMutation) ResetWebhookEndpoints() {
+	m.webhook_endpoints = nil
+	m.clearedwebhook_endpoints = false
+	m.removedwebhook_endpoints = nil
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by ids.
+func (m *OrganizationMutation) AddEventIDs(ids ...uuid.UUID) {
+	if m.events == nil {
+		m.events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the Event entity.
+func (m *OrganizationMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the Event entity was cleared.
+func (m *OrganizationMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the Event entity by IDs.
+func (m *OrganizationMutation) RemoveEventIDs(ids ...uuid.UUID) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEvents returns the removed IDs of the "events" edge to the Event entity.
+func (m *OrganizationMutation) RemovedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *OrganizationMutation) EventsIDs() (ids []uuid.UUID) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEvents resets all changes to the "events" edge.
+func (m *OrganizationMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
+}
+
+// AddEventSeriesIDs adds the "event_series" edge to the EventSeries entity by ids.
+func (m *OrganizationMutation) AddEventSeriesIDs(ids ...uuid.UUID) {
+	if m.event_series == nil {
+		m.event_series = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.event_series[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEventSeries clears the "event_series" edge to the EventSeries entity.
+func (m *OrganizationMutation) ClearEventSeries() {
+	m.clearedevent_series = true
+}
+
+// EventSeriesCleared reports if the "event_series" edge to the EventSeries entity was cleared.
+func (m *OrganizationMutation) EventSeriesCleared() bool {
+	return m.clearedevent_series
+}
+
+// RemoveEventSeriesIDs removes the "event_series" edge to the EventSeries entity by IDs.
+func (m *OrganizationMutation) RemoveEventSeriesIDs(ids ...uuid.UUID) {
+	if m.removedevent_series == nil {
+		m.removedevent_series = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.event_series, ids[i])
+		m.removedevent_series[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedEventSeries returns the removed IDs of the "event_series" edge to the EventSeries entity.
+func (m *OrganizationMutation) RemovedEventSeriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedevent_series {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// EventSeriesIDs returns the "event_series" edge IDs in the mutation.
+func (m *OrganizationMutation) EventSeriesIDs() (ids []uuid.UUID) {
+	for id := range m.event_series {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetEventSeries resets all changes to the "event_series" edge.
+func (m *OrganizationMutation) ResetEventSeries() {
+	m.event_series = nil
+	m.clearedevent_series = false
+	m.removedevent_series = nil
+}
+
+// AddVenueIDs adds the "venues" edge to the Venue entity by ids.
+func (m *OrganizationMutation) AddVenueIDs(ids ...uuid.UUID) {
+	if m.venues == nil {
+		m.venues = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.venues[ids[i]] = struct{}{}
+	}
+}
+
+// ClearVenues clears the "venues" edge to the Venue entity.
+func (m *OrganizationMutation) ClearVenues() {
+	m.clearedvenues = true
+}
+
+// VenuesCleared reports if the "venues" edge to the Venue entity was cleared.
+func (m *OrganizationMutation) VenuesCleared() bool {
+	return m.clearedvenues
+}
+
+// RemoveVenueIDs removes the "venues" edge to the Venue entity by IDs.
+func (m *OrganizationMutation) RemoveVenueIDs(ids ...uuid.UUID) {
+	if m.removedvenues == nil {
+		m.removedvenues = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.venues, ids[i])
+		m.removedvenues[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedVenues returns the removed IDs of the "venues" edge to the Venue entity.
+func (m *OrganizationMutation) RemovedVenuesIDs() (ids []uuid.UUID) {
+	for id := range m.removedvenues {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// VenuesIDs returns the "venues" edge IDs in the mutation.
+func (m *OrganizationMutation) VenuesIDs() (ids []uuid.UUID) {
+	for id := range m.venues {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetVenues resets all changes to the "venues" edge.
+func (m *OrganizationMutation) ResetVenues() {
+	m.venues = nil
+	m.clearedvenues = false
+	m.removedvenues = nil
+}
+
+// ClearOwner clears the "owner" edge to the User entity.
+func (m *OrganizationMutation) ClearOwner() {
+	m.clearedowner = true
+	m.clearedFields[organization.FieldOwnerID] = struct{}{}
+}
+
+// OwnerCleared reports if the "owner" edge to the User entity was cleared.
+func (m *OrganizationMutation) OwnerCleared() bool {
+	return m.clearedowner
+}
+
+// OwnerIDs returns the "owner" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OwnerID instead. It exists only for internal usage by the builders.
+func (m *OrganizationMutation) OwnerIDs() (ids []uuid.UUID) {
+	if id := m.owner; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOwner resets all changes to the "owner" edge.
+func (m *OrganizationMutation) ResetOwner() {
+	m.owner = nil
+	m.clearedowner = false
+}
+
+// Where appends a list predicates to the OrganizationMutation builder.
+func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrganizationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrganizationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Organization, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrganizationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Organization).
+func (m *OrganizationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.name != nil {
+		fields = append(fields, organization.FieldName)
+	}
+	if m.name_initials != nil {
+		fields = append(fields, organization.FieldNameInitials)
+	}
+	if m.description != nil {
+		fields = append(fields, organization.FieldDescription)
+	}
+	if m.logo_url != nil {
+		fields = append(fields, organization.FieldLogoURL)
+	}
+	if m.category != nil {
+		fields = append(fields, organization.FieldCategory)
+	}
+	if m.slug != nil {
+		fields = append(fields, organization.FieldSlug)
+	}
+	if m.social_links != nil {
+		fields = append(fields, organization.FieldSocialLinks)
+	}
+	if m.owner != nil {
+		fields = append(fields, organization.FieldOwnerID)
+	}
+	if m.is_active != nil {
+		fields = append(fields, organization.FieldIsActive)
+	}
+	if m.deleted_at != nil {
+		fields = append(fields, organization.FieldDeletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, organization.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, organization.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organization.FieldName:
+		return m.Name()
+	case organization.FieldNameInitials:
+		return m.NameInitials()
+	case organization.FieldDescription:
+		return m.Description()
+	case organization.FieldLogoURL:
+		return m.LogoURL()
+	case organization.FieldCategory:
+		return m.Category()
+	case organization.FieldSlug:
+		return m.Slug()
+	case organization.FieldSocialLinks:
+		return m.SocialLinks()
+	case organization.FieldOwnerID:
+		return m.OwnerID()
+	case organization.FieldIsActive:
+		return m.IsActive()
+	case organization.FieldDeletedAt:
+		return m.DeletedAt()
+	case organization.FieldCreatedAt:
+		return m.CreatedAt()
+	case organization.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organization.FieldName:
+		return m.OldName(ctx)
+	case organization.FieldNameInitials:
+		return m.OldNameInitials(ctx)
+	case organization.FieldDescription:
+		return m.OldDescription(ctx)
+	case organization.FieldLogoURL:
+		return m.OldLogoURL(ctx)
+	case organization.FieldCategory:
+		return m.OldCategory(ctx)
+	case organization.FieldSlug:
+		return m.OldSlug(ctx)
+	case organization.FieldSocialLinks:
+		return m.OldSocialLinks(ctx)
+	case organization.FieldOwnerID:
+		return m.OldOwnerID(ctx)
+	case organization.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case organization.FieldDeletedAt:
+		return m.OldDeletedAt(ctx)
+	case organization.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case organization.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Organization field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organization.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case organization.FieldNameInitials:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNameInitials(v)
+		return nil
+	case organization.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case organization.FieldLogoURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLogoURL(v)
+		return nil
+	case organization.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case organization.FieldSlug:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSlug(v)
+		return nil
+	case organization.FieldSocialLinks:
+		v, ok := value.(map[string]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSocialLinks(v)
+		return nil
+	case organization.FieldOwnerID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOwnerID(v)
+		return nil
+	case organization.FieldIsActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsActive(v)
+		return nil
+	case organization.FieldDeletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDeletedAt(v)
+		return nil
+	case organization.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case organization.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Organization numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(organization.FieldDescription) {
+		fields = append(fields, organization.FieldDescription)
+	}
+	if m.FieldCleared(organization.FieldLogoURL) {
+		fields = append(fields, organization.FieldLogoURL)
+	}
+	if m.FieldCleared(organization.FieldCategory) {
+		fields = append(fields, organization.FieldCategory)
+	}
+	if m.FieldCleared(organization.FieldSlug) {
+		fields = append(fields, organization.FieldSlug)
+	}
+	if m.FieldCleared(organization.FieldSocialLinks) {
+		fields = append(fields, organization.FieldSocialLinks)
+	}
+	if m.FieldCleared(organization.FieldDeletedAt) {
+		fields = append(fields, organization.FieldDeletedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationMutation) ClearField(name string) error {
+	switch name {
+	case organization.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case organization.FieldLogoURL:
+		m.ClearLogoURL()
+		return nil
+	case organization.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case organization.FieldSlug:
+		m.ClearSlug()
+		return nil
+	case organization.FieldSocialLinks:
+		m.ClearSocialLinks()
+		return nil
+	case organization.FieldDeletedAt:
+		m.ClearDeletedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationMutation) ResetField(name string) error {
+	switch name {
+	case organization.FieldName:
+		m.ResetName()
+		return nil
+	case organization.FieldNameInitials:
+		m.ResetNameInitials()
+		return nil
+	case organization.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case organization.FieldLogoURL:
+		m.ResetLogoURL()
+		return nil
+	case organization.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case organization.FieldSlug:
+		m.ResetSlug()
+		return nil
+	case organization.FieldSocialLinks:
+		m.ResetSocialLinks()
+		return nil
+	case organization.FieldOwnerID:
+		m.ResetOwnerID()
+		return nil
+	case organization.FieldIsActive:
+		m.ResetIsActive()
+		return nil
+	case organization.FieldDeletedAt:
+		m.ResetDeletedAt()
+		return nil
+	case organization.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case organization.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.members != nil {
+		edges = append(edges, organization.EdgeMembers)
+	}
+	if m.roles != nil {
+		edges = append(edges, organization.EdgeRoles)
+	}
+	if m.invitations != nil {
+		edges = append(edges, organization.EdgeInvitations)
+	}
+	if m.ownership_transfers != nil {
+		edges = append(edges, organization.EdgeOwnershipTransfers)
+	}
+	if m.api_keys != nil {
+		edges = append(edges, organization.EdgeAPIKeys)
+	}
+	if m.webhook_endpoints != nil {
+		edges = append(edges, organization.EdgeWebhookEndpoints)
+	}
+	if m.events != nil {
+		edges = append(edges, organization.EdgeEvents)
+	}
+	if m.event_series != nil {
+		edges = append(edges, organization.EdgeEventSeries)
+	}
+	if m.venues != nil {
+		edges = append(edges, organization.EdgeVenues)
+	}
+	if m.owner != nil {
+		edges = append(edges, organization.EdgeOwner)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.members))
+		for id := range m.members {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.roles))
+		for id := range m.roles {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.invitations))
+		for id := range m.invitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.ownership_transfers))
+		for id := range m.ownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.api_keys))
+		for id := range m.api_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeWebhookEndpoints:
+		ids := make([]ent.Value, 0, len(m.webhook_endpoints))
+		for id := range m.webhook_endpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeEventSeries:
+		ids := make([]ent.Value, 0, len(m.event_series))
+		for id := range m.event_series {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeVenues:
+		ids := make([]ent.Value, 0, len(m.venues))
+		for id := range m.venues {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeOwner:
+		if id := m.owner; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.removedmembers != nil {
+		edges = append(edges, organization.EdgeMembers)
+	}
+	if m.removedroles != nil {
+		edges = append(edges, organization.EdgeRoles)
+	}
+	if m.removedinvitations != nil {
+		edges = append(edges, organization.EdgeInvitations)
+	}
+	if m.removedownership_transfers != nil {
+		edges = append(edges, organization.EdgeOwnershipTransfers)
+	}
+	if m.removedapi_keys != nil {
+		edges = append(edges, organization.EdgeAPIKeys)
+	}
+	if m.removedwebhook_endpoints != nil {
+		edges = append(edges, organization.EdgeWebhookEndpoints)
+	}
+	if m.removedevents != nil {
+		edges = append(edges, organization.EdgeEvents)
+	}
+	if m.removedevent_series != nil {
+		edges = append(edges, organization.EdgeEventSeries)
+	}
+	if m.removedvenues != nil {
+		edges = append(edges, organization.EdgeVenues)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case organization.EdgeMembers:
+		ids := make([]ent.Value, 0, len(m.removedmembers))
+		for id := range m.removedmembers {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeRoles:
+		ids := make([]ent.Value, 0, len(m.removedroles))
+		for id := range m.removedroles {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeInvitations:
+		ids := make([]ent.Value, 0, len(m.removedinvitations))
+		for id := range m.removedinvitations {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeOwnershipTransfers:
+		ids := make([]ent.Value, 0, len(m.removedownership_transfers))
+		for id := range m.removedownership_transfers {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeAPIKeys:
+		ids := make([]ent.Value, 0, len(m.removedapi_keys))
+		for id := range m.removedapi_keys {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeWebhookEndpoints:
+		ids := make([]ent.Value, 0, len(m.removedwebhook_endpoints))
+		for id := range m.removedwebhook_endpoints {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeEventSeries:
+		ids := make([]ent.Value, 0, len(m.removedevent_series))
+		for id := range m.removedevent_series {
+			ids = append(ids, id)
+		}
+		return ids
+	case organization.EdgeVenues:
+		ids := make([]ent.Value, 0, len(m.removedvenues))
+		for id := range m.removedvenues {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 10)
+	if m.clearedmembers {
+		edges = append(edges, organization.EdgeMembers)
+	}
+	if m.clearedroles {
+		edges = append(edges, organization.EdgeRoles)
+	}
+	if m.clearedinvitations {
+		edges = append(edges, organization.EdgeInvitations)
+	}
+	if m.clearedownership_transfers {
+		edges = append(edges, organization.EdgeOwnershipTransfers)
+	}
+	if m.clearedapi_keys {
+		edges = append(edges, organization.EdgeAPIKeys)
+	}
+	if m.clearedwebhook_endpoints {
+		edges = append(edges, organization.EdgeWebhookEndpoints)
+	}
+	if m.clearedevents {
+		edges = append(edges, organization.EdgeEvents)
+	}
+	if m.clearedevent_series {
+		edges = append(edges, organization.EdgeEventSeries)
+	}
+	if m.clearedvenues {
+		edges = append(edges, organization.EdgeVenues)
+	}
+	if m.clearedowner {
+		edges = append(edges, organization.EdgeOwner)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organization.EdgeMembers:
+		return m.clearedmembers
+	case organization.EdgeRoles:
+		return m.clearedroles
+	case organization.EdgeInvitations:
+		return m.clearedinvitations
+	case organization.EdgeOwnershipTransfers:
+		return m.clearedownership_transfers
+	case organization.EdgeAPIKeys:
+		return m.clearedapi_keys
+	case organization.EdgeWebhookEndpoints:
+		return m.clearedwebhook_endpoints
+	case organization.EdgeEvents:
+		return m.clearedevents
+	case organization.EdgeEventSeries:
+		return m.clearedevent_series
+	case organization.EdgeVenues:
+		return m.clearedvenues
+	case organization.EdgeOwner:
+		return m.clearedowner
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationMutation) ClearEdge(name string) error {
+	switch name {
+	case organization.EdgeOwner:
+		m.ClearOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationMutation) ResetEdge(name string) error {
+	switch name {
+	case organization.EdgeMembers:
+		m.ResetMembers()
+		return nil
+	case organization.EdgeRoles:
+		m.ResetRoles()
+		return nil
+	case organization.EdgeInvitations:
+		m.ResetInvitations()
+		return nil
+	case organization.EdgeOwnershipTransfers:
+		m.ResetOwnershipTransfers()
+		return nil
+	case organization.EdgeAPIKeys:
+		m.ResetAPIKeys()
+		return nil
+	case organization.EdgeWebhookEndpoints:
+		m.ResetWebhookEndpoints()
+		return nil
+	case organization.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case organization.EdgeEventSeries:
+		m.ResetEventSeries()
+		return nil
+	case organization.EdgeVenues:
+		m.ResetVenues()
+		return nil
+	case organization.EdgeOwner:
+		m.ResetOwner()
+		return nil
+	}
+	return fmt.Errorf("unknown Organization edge %s", name)
+}
+
+// OrganizationInvitationMutation represents an operation that mutates the OrganizationInvitation nodes in the graph.
+type OrganizationInvitationMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	email               *string
+	role                *string
+	invited_by          *uuid.UUID
+	status              *organizationinvitation.Status
+	expires_at          *time.Time
+	responded_by        *uuid.UUID
+	responded_at        *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*OrganizationInvitation, error)
+	predicates          []predicate.OrganizationInvitation
+}
+
+var _ ent.Mutation = (*OrganizationInvitationMutation)(nil)
+
+// organizationinvitationOption allows management of the mutation configuration using functional options.
+type organizationinvitationOption func(*OrganizationInvitationMutation)
+
+// newOrganizationInvitationMutation creates new mutation for the OrganizationInvitation entity.
+func newOrganizationInvitationMutation(c config, op Op, opts ...organizationinvitationOption) *OrganizationInvitationMutation {
+	m := &OrganizationInvitationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganizationInvitation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationInvitationID sets the ID field of the mutation.
+func withOrganizationInvitationID(id uuid.UUID) organizationinvitationOption {
+	return func(m *OrganizationInvitationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrganizationInvitation
+		)
+		m.oldValue = func(ctx context.Context) (*OrganizationInvitation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrganizationInvitation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganizationInvitation sets the old OrganizationInvitation of the mutation.
+func withOrganizationInvitation(node *OrganizationInvitation) organizationinvitationOption {
+	return func(m *OrganizationInvitationMutation) {
+		m.oldValue = func(context.Context) (*OrganizationInvitation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationInvitationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationInvitationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OrganizationInvitation entities.
+func (m *OrganizationInvitationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationInvitationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrganizationInvitationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrganizationInvitation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *OrganizationInvitationMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *OrganizationInvitationMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *OrganizationInvitationMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *OrganizationInvitationMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *OrganizationInvitationMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *OrganizationInvitationMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetRole sets the "role" field.
+func (m *OrganizationInvitationMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *OrganizationInvitationMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *OrganizationInvitationMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (m *OrganizationInvitationMutation) SetInvitedBy(u uuid.UUID) {
+	m.invited_by = &u
+}
+
+// InvitedBy returns the value of the "invited_by" field in the mutation.
+func (m *OrganizationInvitationMutation) InvitedBy() (r uuid.UUID, exists bool) {
+	v := m.invited_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInvitedBy returns the old "invited_by" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldInvitedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInvitedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInvitedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInvitedBy: %w", err)
+	}
+	return oldValue.InvitedBy, nil
+}
+
+// ResetInvitedBy resets all changes to the "invited_by" field.
+func (m *OrganizationInvitationMutation) ResetInvitedBy() {
+	m.invited_by = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OrganizationInvitationMutation) SetStatus(o organizationinvitation.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OrganizationInvitationMutation) Status() (r organizationinvitation.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldStatus(ctx context.Context) (v organizationinvitation.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OrganizationInvitationMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *OrganizationInvitationMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *OrganizationInvitationMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *OrganizationInvitationMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRespondedBy sets the "responded_by" field.
+func (m *OrganizationInvitationMutation) SetRespondedBy(u uuid.UUID) {
+	m.responded_by = &u
+}
+
+// RespondedBy returns the value of the "responded_by" field in the mutation.
+func (m *OrganizationInvitationMutation) RespondedBy() (r uuid.UUID, exists bool) {
+	v := m.responded_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedBy returns the old "responded_by" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldRespondedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedBy: %w", err)
+	}
+	return oldValue.RespondedBy, nil
+}
+
+// ClearRespondedBy clears the value of the "responded_by" field.
+func (m *OrganizationInvitationMutation) ClearRespondedBy() {
+	m.responded_by = nil
+	m.clearedFields[organizationinvitation.FieldRespondedBy] = struct{}{}
+}
+
+// RespondedByCleared returns if the "responded_by" field was cleared in this mutation.
+func (m *OrganizationInvitationMutation) RespondedByCleared() bool {
+	_, ok := m.clearedFields[organizationinvitation.FieldRespondedBy]
+	return ok
+}
+
+// ResetRespondedBy resets all changes to the "responded_by" field.
+func (m *OrganizationInvitationMutation) ResetRespondedBy() {
+	m.responded_by = nil
+	delete(m.clearedFields, organizationinvitation.FieldRespondedBy)
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *OrganizationInvitationMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *OrganizationInvitationMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldRespondedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+	}
+	return oldValue.RespondedAt, nil
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *OrganizationInvitationMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[organizationinvitation.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *OrganizationInvitationMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[organizationinvitation.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *OrganizationInvitationMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, organizationinvitation.FieldRespondedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrganizationInvitationMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrganizationInvitationMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrganizationInvitationMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrganizationInvitationMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrganizationInvitationMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OrganizationInvitation entity.
+// If the OrganizationInvitation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationInvitationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrganizationInvitationMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *OrganizationInvitationMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[organizationinvitation.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *OrganizationInvitationMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *OrganizationInvitationMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *OrganizationInvitationMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the OrganizationInvitationMutation builder.
+func (m *OrganizationInvitationMutation) Where(ps ...predicate.OrganizationInvitation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrganizationInvitationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrganizationInvitationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrganizationInvitation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationInvitationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrganizationInvitationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrganizationInvitation).
+func (m *OrganizationInvitationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationInvitationMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.organization != nil {
+		fields = append(fields, organizationinvitation.FieldOrganizationID)
+	}
+	if m.email != nil {
+		fields = append(fields, organizationinvitation.FieldEmail)
+	}
+	if m.role != nil {
+		fields = append(fields, organizationinvitation.FieldRole)
+	}
+	if m.invited_by != nil {
+		fields = append(fields, organizationinvitation.FieldInvitedBy)
+	}
+	if m.status != nil {
+		fields = append(fields, organizationinvitation.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, organizationinvitation.FieldExpiresAt)
+	}
+	if m.responded_by != nil {
+		fields = append(fields, organizationinvitation.FieldRespondedBy)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, organizationinvitation.FieldRespondedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, organizationinvitation.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, organizationinvitation.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationInvitationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organizationinvitation.FieldOrganizationID:
+		return m.OrganizationID()
+	case organizationinvitation.FieldEmail:
+		return m.Email()
+	case organizationinvitation.FieldRole:
+		return m.Role()
+	case organizationinvitation.FieldInvitedBy:
+		return m.InvitedBy()
+	case organizationinvitation.FieldStatus:
+		return m.Status()
+	case organizationinvitation.FieldExpiresAt:
+		return m.ExpiresAt()
+	case organizationinvitation.FieldRespondedBy:
+		return m.RespondedBy()
+	case organizationinvitation.FieldRespondedAt:
+		return m.RespondedAt()
+	case organizationinvitation.FieldCreatedAt:
+		return m.CreatedAt()
+	case organizationinvitation.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationInvitationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organizationinvitation.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case organizationinvitation.FieldEmail:
+		return m.OldEmail(ctx)
+	case organizationinvitation.FieldRole:
+		return m.OldRole(ctx)
+	case organizationinvitation.FieldInvitedBy:
+		return m.OldInvitedBy(ctx)
+	case organizationinvitation.FieldStatus:
+		return m.OldStatus(ctx)
+	case organizationinvitation.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case organizationinvitation.FieldRespondedBy:
+		return m.OldRespondedBy(ctx)
+	case organizationinvitation.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	case organizationinvitation.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case organizationinvitation.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrganizationInvitation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationInvitationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organizationinvitation.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case organizationinvitation.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case organizationinvitation.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case organizationinvitation.FieldInvitedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInvitedBy(v)
+		return nil
+	case organizationinvitation.FieldStatus:
+		v, ok := value.(organizationinvitation.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case organizationinvitation.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case organizationinvitation.FieldRespondedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedBy(v)
+		return nil
+	case organizationinvitation.FieldRespondedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedAt(v)
+		return nil
+	case organizationinvitation.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case organizationinvitation.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationInvitation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationInvitationMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationInvitationMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationInvitationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OrganizationInvitation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationInvitationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(organizationinvitation.FieldRespondedBy) {
+		fields = append(fields, organizationinvitation.FieldRespondedBy)
+	}
+	if m.FieldCleared(organizationinvitation.FieldRespondedAt) {
+		fields = append(fields, organizationinvitation.FieldRespondedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationInvitationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationInvitationMutation) ClearField(name string) error {
+	switch name {
+	case organizationinvitation.FieldRespondedBy:
+		m.ClearRespondedBy()
+		return nil
+	case organizationinvitation.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationInvitation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationInvitationMutation) ResetField(name string) error {
+	switch name {
+	case organizationinvitation.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case organizationinvitation.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case organizationinvitation.FieldRole:
+		m.ResetRole()
+		return nil
+	case organizationinvitation.FieldInvitedBy:
+		m.ResetInvitedBy()
+		return nil
+	case organizationinvitation.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case organizationinvitation.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case organizationinvitation.FieldRespondedBy:
+		m.ResetRespondedBy()
+		return nil
+	case organizationinvitation.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	case organizationinvitation.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case organizationinvitation.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationInvitation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationInvitationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.organization != nil {
+		edges = append(edges, organizationinvitation.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationInvitationMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organizationinvitation.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationInvitationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationInvitationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationInvitationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedorganization {
+		edges = append(edges, organizationinvitation.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationInvitationMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organizationinvitation.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationInvitationMutation) ClearEdge(name string) error {
+	switch name {
+	case organizationinvitation.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationInvitation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationInvitationMutation) ResetEdge(name string) error {
+	switch name {
+	case organizationinvitation.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationInvitation edge %s", name)
+}
+
+// OrganizationMemberMutation represents an operation that mutates the OrganizationMember nodes in the graph.
+type OrganizationMemberMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	role                *string
+	joined_at           *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	user                *uuid.UUID
+	cleareduser         bool
+	done                bool
+	oldValue            func(context.Context) (*OrganizationMember, error)
+	predicates          []predicate.OrganizationMember
+}
+
+var _ ent.Mutation = (*OrganizationMemberMutation)(nil)
+
+// organizationmemberOption allows management of the mutation configuration using functional options.
+type organizationmemberOption func(*OrganizationMemberMutation)
+
+// newOrganizationMemberMutation creates new mutation for the OrganizationMember entity.
+func newOrganizationMemberMutation(c config, op Op, opts ...organizationmemberOption) *OrganizationMemberMutation {
+	m := &OrganizationMemberMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganizationMember,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationMemberID sets the ID field of the mutation.
+func withOrganizationMemberID(id uuid.UUID) organizationmemberOption {
+	return func(m *OrganizationMemberMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrganizationMember
+		)
+		m.oldValue = func(ctx context.Context) (*OrganizationMember, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrganizationMember.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganizationMember sets the old OrganizationMember of the mutation.
+func withOrganizationMember(node *OrganizationMember) organizationmemberOption {
+	return func(m *OrganizationMemberMutation) {
+		m.oldValue = func(context.Context) (*OrganizationMember, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationMemberMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationMemberMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OrganizationMember entities.
+func (m *OrganizationMemberMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationMemberMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrganizationMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrganizationMember.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *OrganizationMemberMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *OrganizationMemberMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the OrganizationMember entity.
+// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMemberMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *OrganizationMemberMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OrganizationMemberMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OrganizationMemberMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the OrganizationMember entity.
+// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OrganizationMemberMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetRole sets the "role" field.
+func (m *OrganizationMemberMutation) SetRole(s string) {
+	m.role = &s
+}
+
+// Role returns the value of the "role" field in the mutation.
+func (m *OrganizationMemberMutation) Role() (r string, exists bool) {
+	v := m.role
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRole returns the old "role" field's value of the OrganizationMember entity.
+// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMemberMutation) OldRole(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRole requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+	}
+	return oldValue.Role, nil
+}
+
+// ResetRole resets all changes to the "role" field.
+func (m *OrganizationMemberMutation) ResetRole() {
+	m.role = nil
+}
+
+// SetJoinedAt sets the "joined_at" field.
+func (m *OrganizationMemberMutation) SetJoinedAt(t time.Time) {
+	m.joined_at = &t
+}
+
+// JoinedAt returns the value of the "joined_at" field in the mutation.
+func (m *OrganizationMemberMutation) JoinedAt() (r time.Time, exists bool) {
+	v := m.joined_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldJoinedAt returns the old "joined_at" field's value of the OrganizationMember entity.
+// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+	}
+	return oldValue.JoinedAt, nil
+}
+
+// ResetJoinedAt resets all changes to the "joined_at" field.
+func (m *OrganizationMemberMutation) ResetJoinedAt() {
+	m.joined_at = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrganizationMemberMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrganizationMemberMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OrganizationMember entity.
+// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrganizationMemberMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrganizationMemberMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrganizationMemberMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OrganizationMember entity.
+// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationMemberMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrganizationMemberMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *OrganizationMemberMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[organizationmember.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *OrganizationMemberMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *OrganizationMemberMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *OrganizationMemberMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *OrganizationMemberMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[organizationmember.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *OrganizationMemberMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *OrganizationMemberMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *OrganizationMemberMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the OrganizationMemberMutation builder.
+func (m *OrganizationMemberMutation) Where(ps ...predicate.OrganizationMember) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrganizationMemberMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrganizationMemberMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrganizationMember, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationMemberMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrganizationMemberMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrganizationMember).
+func (m *OrganizationMemberMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationMemberMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.organization != nil {
+		fields = append(fields, organizationmember.FieldOrganizationID)
+	}
+	if m.user != nil {
+		fields = append(fields, organizationmember.FieldUserID)
+	}
+	if m.role != nil {
+		fields = append(fields, organizationmember.FieldRole)
+	}
+	if m.joined_at != nil {
+		fields = append(fields, organizationmember.FieldJoinedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, organizationmember.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, organizationmember.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationMemberMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organizationmember.FieldOrganizationID:
+		return m.OrganizationID()
+	case organizationmember.FieldUserID:
+		return m.UserID()
+	case organizationmember.FieldRole:
+		return m.Role()
+	case organizationmember.FieldJoinedAt:
+		return m.JoinedAt()
+	case organizationmember.FieldCreatedAt:
+		return m.CreatedAt()
+	case organizationmember.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organizationmember.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case organizationmember.FieldUserID:
+		return m.OldUserID(ctx)
+	case organizationmember.FieldRole:
+		return m.OldRole(ctx)
+	case organizationmember.FieldJoinedAt:
+		return m.OldJoinedAt(ctx)
+	case organizationmember.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case organizationmember.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrganizationMember field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMemberMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organizationmember.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case organizationmember.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case organizationmember.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case organizationmember.FieldJoinedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetJoinedAt(v)
+		return nil
+	case organizationmember.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case organizationmember.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationMember field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationMemberMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationMemberMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationMemberMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OrganizationMember numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationMemberMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationMemberMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationMemberMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown OrganizationMember nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationMemberMutation) ResetField(name string) error {
+	switch name {
+	case organizationmember.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case organizationmember.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case organizationmember.FieldRole:
+		m.ResetRole()
+		return nil
+	case organizationmember.FieldJoinedAt:
+		m.ResetJoinedAt()
+		return nil
+	case organizationmember.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case organizationmember.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationMember field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationMemberMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.organization != nil {
+		edges = append(edges, organizationmember.EdgeOrganization)
+	}
+	if m.user != nil {
+		edges = append(edges, organizationmember.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationMemberMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organizationmember.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	case organizationmember.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationMemberMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationMemberMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationMemberMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorganization {
+		edges = append(edges, organizationmember.EdgeOrganization)
+	}
+	if m.cleareduser {
+		edges = append(edges, organizationmember.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationMemberMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organizationmember.EdgeOrganization:
+		return m.clearedorganization
+	case organizationmember.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationMemberMutation) ClearEdge(name string) error {
+	switch name {
+	case organizationmember.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	case organizationmember.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationMember unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationMemberMutation) ResetEdge(name string) error {
+	switch name {
+	case organizationmember.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	case organizationmember.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationMember edge %s", name)
+}
+
+// OrganizationRoleMutation represents an operation that mutates the OrganizationRole nodes in the graph.
+type OrganizationRoleMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	name                *string
+	description         *string
+	permissions         *[]string
+	appendpermissions   []string
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*OrganizationRole, error)
+	predicates          []predicate.OrganizationRole
+}
+
+var _ ent.Mutation = (*OrganizationRoleMutation)(nil)
+
+// organizationroleOption allows management of the mutation configuration using functional options.
+type organizationroleOption func(*OrganizationRoleMutation)
+
+// newOrganizationRoleMutation creates new mutation for the OrganizationRole entity.
+func newOrganizationRoleMutation(c config, op Op, opts ...organizationroleOption) *OrganizationRoleMutation {
+	m := &OrganizationRoleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrganizationRole,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrganizationRoleID sets the ID field of the mutation.
+func withOrganizationRoleID(id uuid.UUID) organizationroleOption {
+	return func(m *OrganizationRoleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OrganizationRole
+		)
+		m.oldValue = func(ctx context.Context) (*OrganizationRole, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OrganizationRole.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrganizationRole sets the old OrganizationRole of the mutation.
+func withOrganizationRole(node *OrganizationRole) organizationroleOption {
+	return func(m *OrganizationRoleMutation) {
+		m.oldValue = func(context.Context) (*OrganizationRole, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrganizationRoleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrganizationRoleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OrganizationRole entities.
+func (m *OrganizationRoleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrganizationRoleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrganizationRoleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OrganizationRole.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *OrganizationRoleMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *OrganizationRoleMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the OrganizationRole entity.
+// If the OrganizationRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationRoleMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *OrganizationRoleMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetName sets the "name" field.
+func (m *OrganizationRoleMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *OrganizationRoleMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the OrganizationRole entity.
+// If the OrganizationRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationRoleMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *OrganizationRoleMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *OrganizationRoleMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *OrganizationRoleMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the OrganizationRole entity.
+// If the OrganizationRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationRoleMutation) OldDescription(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *OrganizationRoleMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[organizationrole.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *OrganizationRoleMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[organizationrole.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *OrganizationRoleMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, organizationrole.FieldDescription)
+}
+
+// SetPermissions sets the "permissions" field.
+func (m *OrganizationRoleMutation) SetPermissions(s []string) {
+	m.permissions = &s
+	m.appendpermissions = nil
+}
+
+// Permissions returns the value of the "permissions" field in the mutation.
+func (m *OrganizationRoleMutation) Permissions() (r []string, exists bool) {
+	v := m.permissions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPermissions returns the old "permissions" field's value of the OrganizationRole entity.
+// If the OrganizationRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationRoleMutation) OldPermissions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPermissions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPermissions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPermissions: %w", err)
+	}
+	return oldValue.Permissions, nil
+}
+
+// AppendPermissions adds s to the "permissions" field.
+func (m *OrganizationRoleMutation) AppendPermissions(s []string) {
+	m.appendpermissions = append(m.appendpermissions, s...)
+}
+
+// AppendedPermissions returns the list of values that were appended to the "permissions" field in this mutation.
+func (m *OrganizationRoleMutation) AppendedPermissions() ([]string, bool) {
+	if len(m.appendpermissions) == 0 {
+		return nil, false
+	}
+	return m.appendpermissions, true
+}
+
+// ResetPermissions resets all changes to the "permissions" field.
+func (m *OrganizationRoleMutation) ResetPermissions() {
+	m.permissions = nil
+	m.appendpermissions = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrganizationRoleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrganizationRoleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OrganizationRole entity.
+// If the OrganizationRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationRoleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrganizationRoleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrganizationRoleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrganizationRoleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OrganizationRole entity.
+// If the OrganizationRole object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrganizationRoleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrganizationRoleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *OrganizationRoleMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[organizationrole.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *OrganizationRoleMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *OrganizationRoleMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *OrganizationRoleMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the OrganizationRoleMutation builder.
+func (m *OrganizationRoleMutation) Where(ps ...predicate.OrganizationRole) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrganizationRoleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrganizationRoleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OrganizationRole, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrganizationRoleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrganizationRoleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OrganizationRole).
+func (m *OrganizationRoleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrganizationRoleMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.organization != nil {
+		fields = append(fields, organizationrole.FieldOrganizationID)
+	}
+	if m.name != nil {
+		fields = append(fields, organizationrole.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, organizationrole.FieldDescription)
+	}
+	if m.permissions != nil {
+		fields = append(fields, organizationrole.FieldPermissions)
+	}
+	if m.created_at != nil {
+		fields = append(fields, organizationrole.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, organizationrole.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrganizationRoleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case organizationrole.FieldOrganizationID:
+		return m.OrganizationID()
+	case organizationrole.FieldName:
+		return m.Name()
+	case organizationrole.FieldDescription:
+		return m.Description()
+	case organizationrole.FieldPermissions:
+		return m.Permissions()
+	case organizationrole.FieldCreatedAt:
+		return m.CreatedAt()
+	case organizationrole.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrganizationRoleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case organizationrole.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case organizationrole.FieldName:
+		return m.OldName(ctx)
+	case organizationrole.FieldDescription:
+		return m.OldDescription(ctx)
+	case organizationrole.FieldPermissions:
+		return m.OldPermissions(ctx)
+	case organizationrole.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case organizationrole.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OrganizationRole field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationRoleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case organizationrole.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case organizationrole.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case organizationrole.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case organizationrole.FieldPermissions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPermissions(v)
+		return nil
+	case organizationrole.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case organizationrole.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationRole field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrganizationRoleMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrganizationRoleMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrganizationRoleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OrganizationRole numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrganizationRoleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(organizationrole.FieldDescription) {
+		fields = append(fields, organizationrole.FieldDescription)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrganizationRoleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrganizationRoleMutation) ClearField(name string) error {
+	switch name {
+	case organizationrole.FieldDescription:
+		m.ClearDescription()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationRole nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrganizationRoleMutation) ResetField(name string) error {
+	switch name {
+	case organizationrole.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case organizationrole.FieldName:
+		m.ResetName()
+		return nil
+	case organizationrole.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case organizationrole.FieldPermissions:
+		m.ResetPermissions()
+		return nil
+	case organizationrole.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case organizationrole.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationRole field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrganizationRoleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.organization != nil {
+		edges = append(edges, organizationrole.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrganizationRoleMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case organizationrole.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrganizationRoleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrganizationRoleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrganizationRoleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedorganization {
+		edges = append(edges, organizationrole.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrganizationRoleMutation) EdgeCleared(name string) bool {
+	switch name {
+	case organizationrole.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrganizationRoleMutation) ClearEdge(name string) error {
+	switch name {
+	case organizationrole.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationRole unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrganizationRoleMutation) ResetEdge(name string) error {
+	switch name {
+	case organizationrole.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OrganizationRole edge %s", name)
+}
+
+// OwnershipTransferMutation represents an operation that mutates the OwnershipTransfer nodes in the graph.
+type OwnershipTransferMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	from_user_id        *uuid.UUID
+	to_user_id          *uuid.UUID
+	status              *ownershiptransfer.Status
+	expires_at          *time.Time
+	responded_at        *time.Time
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	done                bool
+	oldValue            func(context.Context) (*OwnershipTransfer, error)
+	predicates          []predicate.OwnershipTransfer
+}
+
+var _ ent.Mutation = (*OwnershipTransferMutation)(nil)
+
+// ownershiptransferOption allows management of the mutation configuration using functional options.
+type ownershiptransferOption func(*OwnershipTransferMutation)
+
+// newOwnershipTransferMutation creates new mutation for the OwnershipTransfer entity.
+func newOwnershipTransferMutation(c config, op Op, opts ...ownershiptransferOption) *OwnershipTransferMutation {
+	m := &OwnershipTransferMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOwnershipTransfer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOwnershipTransferID sets the ID field of the mutation.
+func withOwnershipTransferID(id uuid.UUID) ownershiptransferOption {
+	return func(m *OwnershipTransferMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *OwnershipTransfer
+		)
+		m.oldValue = func(ctx context.Context) (*OwnershipTransfer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().OwnershipTransfer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOwnershipTransfer sets the old OwnershipTransfer of the mutation.
+func withOwnershipTransfer(node *OwnershipTransfer) ownershiptransferOption {
+	return func(m *OwnershipTransferMutation) {
+		m.oldValue = func(context.Context) (*OwnershipTransfer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OwnershipTransferMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OwnershipTransferMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of OwnershipTransfer entities.
+func (m *OwnershipTransferMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OwnershipTransferMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OwnershipTransferMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().OwnershipTransfer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (m *OwnershipTransferMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
+}
+
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *OwnershipTransferMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrganizationID returns the old "organization_id" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
+}
+
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *OwnershipTransferMutation) ResetOrganizationID() {
+	m.organization = nil
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (m *OwnershipTransferMutation) SetFromUserID(u uuid.UUID) {
+	m.from_user_id = &u
+}
+
+// FromUserID returns the value of the "from_user_id" field in the mutation.
+func (m *OwnershipTransferMutation) FromUserID() (r uuid.UUID, exists bool) {
+	v := m.from_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFromUserID returns the old "from_user_id" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldFromUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFromUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFromUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFromUserID: %w", err)
+	}
+	return oldValue.FromUserID, nil
+}
+
+// ResetFromUserID resets all changes to the "from_user_id" field.
+func (m *OwnershipTransferMutation) ResetFromUserID() {
+	m.from_user_id = nil
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (m *OwnershipTransferMutation) SetToUserID(u uuid.UUID) {
+	m.to_user_id = &u
+}
+
+// ToUserID returns the value of the "to_user_id" field in the mutation.
+func (m *OwnershipTransferMutation) ToUserID() (r uuid.UUID, exists bool) {
+	v := m.to_user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldToUserID returns the old "to_user_id" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldToUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldToUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldToUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldToUserID: %w", err)
+	}
+	return oldValue.ToUserID, nil
+}
+
+// ResetToUserID resets all changes to the "to_user_id" field.
+func (m *OwnershipTransferMutation) ResetToUserID() {
+	m.to_user_id = nil
+}
+
+// SetStatus sets the "status" field.
+func (m *OwnershipTransferMutation) SetStatus(o ownershiptransfer.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OwnershipTransferMutation) Status() (r ownershiptransfer.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldStatus(ctx context.Context) (v ownershiptransfer.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OwnershipTransferMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *OwnershipTransferMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *OwnershipTransferMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *OwnershipTransferMutation) ResetExpiresAt() {
+	m.expires_at = nil
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (m *OwnershipTransferMutation) SetRespondedAt(t time.Time) {
+	m.responded_at = &t
+}
+
+// RespondedAt returns the value of the "responded_at" field in the mutation.
+func (m *OwnershipTransferMutation) RespondedAt() (r time.Time, exists bool) {
+	v := m.responded_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRespondedAt returns the old "responded_at" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldRespondedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRespondedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRespondedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRespondedAt: %w", err)
+	}
+	return oldValue.RespondedAt, nil
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (m *OwnershipTransferMutation) ClearRespondedAt() {
+	m.responded_at = nil
+	m.clearedFields[ownershiptransfer.FieldRespondedAt] = struct{}{}
+}
+
+// RespondedAtCleared returns if the "responded_at" field was cleared in this mutation.
+func (m *OwnershipTransferMutation) RespondedAtCleared() bool {
+	_, ok := m.clearedFields[ownershiptransfer.FieldRespondedAt]
+	return ok
+}
+
+// ResetRespondedAt resets all changes to the "responded_at" field.
+func (m *OwnershipTransferMutation) ResetRespondedAt() {
+	m.responded_at = nil
+	delete(m.clearedFields, ownershiptransfer.FieldRespondedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OwnershipTransferMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OwnershipTransferMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OwnershipTransferMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OwnershipTransferMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OwnershipTransferMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the OwnershipTransfer entity.
+// If the OwnershipTransfer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OwnershipTransferMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OwnershipTransferMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *OwnershipTransferMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[ownershiptransfer.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *OwnershipTransferMutation) OrganizationCleared() bool {
+	return m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *OwnershipTransferMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *OwnershipTransferMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// Where appends a list predicates to the OwnershipTransferMutation builder.
+func (m *OwnershipTransferMutation) Where(ps ...predicate.OwnershipTransfer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OwnershipTransferMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OwnershipTransferMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.OwnershipTransfer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OwnershipTransferMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OwnershipTransferMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (OwnershipTransfer).
+func (m *OwnershipTransferMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OwnershipTransferMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.organization != nil {
+		fields = append(fields, ownershiptransfer.FieldOrganizationID)
+	}
+	if m.from_user_id != nil {
+		fields = append(fields, ownershiptransfer.FieldFromUserID)
+	}
+	if m.to_user_id != nil {
+		fields = append(fields, ownershiptransfer.FieldToUserID)
+	}
+	if m.status != nil {
+		fields = append(fields, ownershiptransfer.FieldStatus)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, ownershiptransfer.FieldExpiresAt)
+	}
+	if m.responded_at != nil {
+		fields = append(fields, ownershiptransfer.FieldRespondedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, ownershiptransfer.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, ownershiptransfer.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OwnershipTransferMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case ownershiptransfer.FieldOrganizationID:
+		return m.OrganizationID()
+	case ownershiptransfer.FieldFromUserID:
+		return m.FromUserID()
+	case ownershiptransfer.FieldToUserID:
+		return m.ToUserID()
+	case ownershiptransfer.FieldStatus:
+		return m.Status()
+	case ownershiptransfer.FieldExpiresAt:
+		return m.ExpiresAt()
+	case ownershiptransfer.FieldRespondedAt:
+		return m.RespondedAt()
+	case ownershiptransfer.FieldCreatedAt:
+		return m.CreatedAt()
+	case ownershiptransfer.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OwnershipTransferMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case ownershiptransfer.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case ownershiptransfer.FieldFromUserID:
+		return m.OldFromUserID(ctx)
+	case ownershiptransfer.FieldToUserID:
+		return m.OldToUserID(ctx)
+	case ownershiptransfer.FieldStatus:
+		return m.OldStatus(ctx)
+	case ownershiptransfer.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case ownershiptransfer.FieldRespondedAt:
+		return m.OldRespondedAt(ctx)
+	case ownershiptransfer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case ownershiptransfer.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown OwnershipTransfer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnershipTransferMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case ownershiptransfer.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrganizationID(v)
+		return nil
+	case ownershiptransfer.FieldFromUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFromUserID(v)
+		return nil
+	case ownershiptransfer.FieldToUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetToUserID(v)
+		return nil
+	case ownershiptransfer.FieldStatus:
+		v, ok := value.(ownershiptransfer.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case ownershiptransfer.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	case ownershiptransfer.FieldRespondedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRespondedAt(v)
+		return nil
+	case ownershiptransfer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case ownershiptransfer.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OwnershipTransferMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OwnershipTransferMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OwnershipTransferMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown OwnershipTransfer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OwnershipTransferMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(ownershiptransfer.FieldRespondedAt) {
+		fields = append(fields, ownershiptransfer.FieldRespondedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OwnershipTransferMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OwnershipTransferMutation) ClearField(name string) error {
+	switch name {
+	case ownershiptransfer.FieldRespondedAt:
+		m.ClearRespondedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OwnershipTransferMutation) ResetField(name string) error {
+	switch name {
+	case ownershiptransfer.FieldOrganizationID:
+		m.ResetOrganizationID()
+		return nil
+	case ownershiptransfer.FieldFromUserID:
+		m.ResetFromUserID()
+		return nil
+	case ownershiptransfer.FieldToUserID:
+		m.ResetToUserID()
+		return nil
+	case ownershiptransfer.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case ownershiptransfer.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	case ownershiptransfer.FieldRespondedAt:
+		m.ResetRespondedAt()
+		return nil
+	case ownershiptransfer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case ownershiptransfer.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OwnershipTransferMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.organization != nil {
+		edges = append(edges, ownershiptransfer.EdgeOrganization)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OwnershipTransferMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case ownershiptransfer.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OwnershipTransferMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OwnershipTransferMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OwnershipTransferMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedorganization {
+		edges = append(edges, ownershiptransfer.EdgeOrganization)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OwnershipTransferMutation) EdgeCleared(name string) bool {
+	switch name {
+	case ownershiptransfer.EdgeOrganization:
+		return m.clearedorganization
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OwnershipTransferMutation) ClearEdge(name string) error {
+	switch name {
+	case ownershiptransfer.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OwnershipTransferMutation) ResetEdge(name string) error {
+	switch name {
+	case ownershiptransfer.EdgeOrganization:
+		m.ResetOrganization()
+		return nil
+	}
+	return fmt.Errorf("unknown OwnershipTransfer edge %s", name)
+}
+
+// PaymentMutation represents an operation that mutates the Payment nodes in the graph.
+type PaymentMutation struct {
+	config
+	op                          Op
+	typ                         string
+	id                          *uuid.UUID
+	event_title                 *string
+	ticket_quantity             *int
+	addticket_quantity          *int
+	total_price                 *float64
+	addtotal_price              *float64
+	currency                    *string
+	buyer_name                  *string
+	buyer_email                 *string
+	buyer_phone                 *string
+	payment_key                 *string
+	order_id                    *string
+	status                      *payment.Status
+	channel                     *payment.Channel
+	payment_method              *payment.PaymentMethod
+	operator_id                 *uuid.UUID
+	receipt_number              *string
+	checked_in_at               *time.Time
+	created_at                  *time.Time
+	updated_at                  *time.Time
+	clearedFields               map[string]struct{}
+	event                       *uuid.UUID
+	clearedevent                bool
+	user                        *uuid.UUID
+	cleareduser                 bool
+	registration_answers        map[uuid.UUID]struct{}
+	removedregistration_answers map[uuid.UUID]struct{}
+	clearedregistration_answers bool
+	done                        bool
+	oldValue                    func(context.Context) (*Payment, error)
+	predicates                  []predicate.Payment
+}
+
+var _ ent.Mutation = (*PaymentMutation)(nil)
+
+// paymentOption allows management of the mutation configuration using functional options.
+type paymentOption func(*PaymentMutation)
+
+// newPaymentMutation creates new mutation for the Payment entity.
+func newPaymentMutation(c config, op Op, opts ...paymentOption) *PaymentMutation {
+	m := &PaymentMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePayment,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaymentID sets the ID field of the mutation.
+func withPaymentID(id uuid.UUID) paymentOption {
+	return func(m *PaymentMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Payment
+		)
+		m.oldValue = func(ctx context.Context) (*Payment, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Payment.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPayment sets the old Payment of the mutation.
+func withPayment(node *Payment) paymentOption {
+	return func(m *PaymentMutation) {
+		m.oldValue = func(context.Context) (*Payment, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaymentMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaymentMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Payment entities.
+func (m *PaymentMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaymentMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaymentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Payment.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventID sets the "event_id" field.
+func (m *PaymentMutation) SetEventID(u uuid.UUID) {
+	m.event = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *PaymentMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *PaymentMutation) ResetEventID() {
+	m.event = nil
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PaymentMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PaymentMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *PaymentMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[payment.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *PaymentMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[payment.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PaymentMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, payment.FieldUserID)
+}
+
+// SetEventTitle sets the "event_title" field.
+func (m *PaymentMutation) SetEventTitle(s string) {
+	m.event_title = &s
+}
+
+// EventTitle returns the value of the "event_title" field in the mutation.
+func (m *PaymentMutation) EventTitle() (r string, exists bool) {
+	v := m.event_title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventTitle returns the old "event_title" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldEventTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventTitle: %w", err)
+	}
+	return oldValue.EventTitle, nil
+}
+
+// ResetEventTitle resets all changes to the "event_title" field.
+func (m *PaymentMutation) ResetEventTitle() {
+	m.event_title = nil
+}
+
+// SetTicketQuantity sets the "ticket_quantity" field.
+func (m *PaymentMutation) SetTicketQuantity(i int) {
+	m.ticket_quantity = &i
+	m.addticket_quantity = nil
+}
+
+// TicketQuantity returns the value of the "ticket_quantity" field in the mutation.
+func (m *PaymentMutation) TicketQuantity() (r int, exists bool) {
+	v := m.ticket_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTicketQuantity returns the old "ticket_quantity" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldTicketQuantity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTicketQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTicketQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTicketQuantity: %w", err)
+	}
+	return oldValue.TicketQuantity, nil
+}
+
+// AddTicketQuantity adds i to the "ticket_quantity" field.
+func (m *PaymentMutation) AddTicketQuantity(i int) {
+	if m.addticket_quantity != nil {
+		*m.addticket_quantity += i
+	} else {
+		m.addticket_quantity = &i
+	}
+}
+
+// AddedTicketQuantity returns the value that was added to the "ticket_quantity" field in this mutation.
+func (m *PaymentMutation) AddedTicketQuantity() (r int, exists bool) {
+	v := m.addticket_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTicketQuantity resets all changes to the "ticket_quantity" field.
+func (m *PaymentMutation) ResetTicketQuantity() {
+	m.ticket_quantity = nil
+	m.addticket_quantity = nil
+}
+
+// SetTotalPrice sets the "total_price" field.
+func (m *PaymentMutation) SetTotalPrice(f float64) {
+	m.total_price = &f
+	m.addtotal_price = nil
+}
+
+// TotalPrice returns the value of the "total_price" field in the mutation.
+func (m *PaymentMutation) TotalPrice() (r float64, exists bool) {
+	v := m.total_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalPrice returns the old "total_price" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldTotalPrice(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalPrice: %w", err)
+	}
+	return oldValue.TotalPrice, nil
+}
+
+// AddTotalPrice adds f to the "total_price" field.
+func (m *PaymentMutation) AddTotalPrice(f float64) {
+	if m.addtotal_price != nil {
+		*m.addtotal_price += f
+	} else {
+		m.addtotal_price = &f
+	}
+}
+
+// AddedTotalPrice returns the value that was added to the "total_price" field in this mutation.
+func (m *PaymentMutation) AddedTotalPrice() (r float64, exists bool) {
+	v := m.addtotal_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTotalPrice resets all changes to the "total_price" field.
+func (m *PaymentMutation) ResetTotalPrice() {
+	m.total_price = nil
+	m.addtotal_price = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *PaymentMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *PaymentMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *PaymentMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetBuyerName sets the "buyer_name" field.
+func (m *PaymentMutation) SetBuyerName(s string) {
+	m.buyer_name = &s
+}
+
+// BuyerName returns the value of the "buyer_name" field in the mutation.
+func (m *PaymentMutation) BuyerName() (r string, exists bool) {
+	v := m.buyer_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerName returns the old "buyer_name" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldBuyerName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerName: %w", err)
+	}
+	return oldValue.BuyerName, nil
+}
+
+// ResetBuyerName resets all changes to the "buyer_name" field.
+func (m *PaymentMutation) ResetBuyerName() {
+	m.buyer_name = nil
+}
+
+// SetBuyerEmail sets the "buyer_email" field.
+func (m *PaymentMutation) SetBuyerEmail(s string) {
+	m.buyer_email = &s
+}
+
+// BuyerEmail returns the value of the "buyer_email" field in the mutation.
+func (m *PaymentMutation) BuyerEmail() (r string, exists bool) {
+	v := m.buyer_email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerEmail returns the old "buyer_email" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldBuyerEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerEmail: %w", err)
+	}
+	return oldValue.BuyerEmail, nil
+}
+
+// ResetBuyerEmail resets all changes to the "buyer_email" field.
+func (m *PaymentMutation) ResetBuyerEmail() {
+	m.buyer_email = nil
+}
+
+// SetBuyerPhone sets the "buyer_phone" field.
+func (m *PaymentMutation) SetBuyerPhone(s string) {
+	m.buyer_phone = &s
+}
+
+// BuyerPhone returns the value of the "buyer_phone" field in the mutation.
+func (m *PaymentMutation) BuyerPhone() (r string, exists bool) {
+	v := m.buyer_phone
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBuyerPhone returns the old "buyer_phone" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldBuyerPhone(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBuyerPhone is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBuyerPhone requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBuyerPhone: %w", err)
+	}
+	return oldValue.BuyerPhone, nil
+}
+
+// ResetBuyerPhone resets all changes to the "buyer_phone" field.
+func (m *PaymentMutation) ResetBuyerPhone() {
+	m.buyer_phone = nil
+}
+
+// SetPaymentKey sets the "payment_key" field.
+func (m *PaymentMutation) SetPaymentKey(s string) {
+	m.payment_key = &s
+}
+
+// PaymentKey returns the value of the "payment_key" field in the mutation.
+func (m *PaymentMutation) PaymentKey() (r string, exists bool) {
+	v := m.payment_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentKey returns the old "payment_key" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldPaymentKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentKey: %w", err)
+	}
+	return oldValue.PaymentKey, nil
+}
+
+// ClearPaymentKey clears the value of the "payment_key" field.
+func (m *PaymentMutation) ClearPaymentKey() {
+	m.payment_key = nil
+	m.clearedFields[payment.FieldPaymentKey] = struct{}{}
+}
+
+// PaymentKeyCleared returns if the "payment_key" field was cleared in this mutation.
+func (m *PaymentMutation) PaymentKeyCleared() bool {
+	_, ok := m.clearedFields[payment.FieldPaymentKey]
+	return ok
+}
+
+// ResetPaymentKey resets all changes to the "payment_key" field.
+func (m *PaymentMutation) ResetPaymentKey() {
+	m.payment_key = nil
+	delete(m.clearedFields, payment.FieldPaymentKey)
+}
+
+// SetOrderID sets the "order_id" field.
+func (m *PaymentMutation) SetOrderID(s string) {
+	m.order_id = &s
+}
+
+// OrderID returns the value of the "order_id" field in the mutation.
+func (m *PaymentMutation) OrderID() (r string, exists bool) {
+	v := m.order_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderID returns the old "order_id" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
+	}
+	return oldValue.OrderID, nil
+}
+
+// ClearOrderID clears the value of the "order_id" field.
+func (m *PaymentMutation) ClearOrderID() {
+	m.order_id = nil
+	m.clearedFields[payment.FieldOrderID] = struct{}{}
+}
+
+// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
+func (m *PaymentMutation) OrderIDCleared() bool {
+	_, ok := m.clearedFields[payment.FieldOrderID]
+	return ok
+}
+
+// ResetOrderID resets all changes to the "order_id" field.
+func (m *PaymentMutation) ResetOrderID() {
+	m.order_id = nil
+	delete(m.clearedFields, payment.FieldOrderID)
+}
+
+// SetStatus sets the "status" field.
+func (m *PaymentMutation) SetStatus(pa payment.Status) {
+	m.status = &pa
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *PaymentMutation) Status() (r payment.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldStatus(ctx context.Context) (v payment.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *PaymentMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetChannel sets the "channel" field.
+func (m *PaymentMutation) SetChannel(pa payment.Channel) {
+	m.channel = &pa
+}
+
+// Channel returns the value of the "channel" field in the mutation.
+func (m *PaymentMutation) Channel() (r payment.Channel, exists bool) {
+	v := m.channel
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChannel returns the old "channel" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldChannel(ctx context.Context) (v payment.Channel, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChannel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChannel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChannel: %w", err)
+	}
+	return oldValue.Channel, nil
+}
+
+// ResetChannel resets all changes to the "channel" field.
+func (m *PaymentMutation) ResetChannel() {
+	m.channel = nil
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (m *PaymentMutation) SetPaymentMethod(pm payment.PaymentMethod) {
+	m.payment_method = &pm
+}
+
+// PaymentMethod returns the value of the "payment_method" field in the mutation.
+func (m *PaymentMutation) PaymentMethod() (r payment.PaymentMethod, exists bool) {
+	v := m.payment_method
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentMethod returns the old "payment_method" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldPaymentMethod(ctx context.Context) (v payment.PaymentMethod, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentMethod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentMethod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentMethod: %w", err)
+	}
+	return oldValue.PaymentMethod, nil
+}
+
+// ResetPaymentMethod resets all changes to the "payment_method" field.
+func (m *PaymentMutation) ResetPaymentMethod() {
+	m.payment_method = nil
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (m *PaymentMutation) SetOperatorID(u uuid.UUID) {
+	m.operator_id = &u
+}
+
+// OperatorID returns the value of the "operator_id" field in the mutation.
+func (m *PaymentMutation) OperatorID() (r uuid.UUID, exists bool) {
+	v := m.operator_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperatorID returns the old "operator_id" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldOperatorID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperatorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperatorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperatorID: %w", err)
+	}
+	return oldValue.OperatorID, nil
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (m *PaymentMutation) ClearOperatorID() {
+	m.operator_id = nil
+	m.clearedFields[payment.FieldOperatorID] = struct{}{}
+}
+
+// OperatorIDCleared returns if the "operator_id" field was cleared in this mutation.
+func (m *PaymentMutation) OperatorIDCleared() bool {
+	_, ok := m.clearedFields[payment.FieldOperatorID]
+	return ok
+}
+
+// ResetOperatorID resets all changes to the "operator_id" field.
+func (m *PaymentMutation) ResetOperatorID() {
+	m.operator_id = nil
+	delete(m.clearedFields, payment.FieldOperatorID)
+}
+
+// SetReceiptNumber sets the "receipt_number" field.
+func (m *PaymentMutation) SetReceiptNumber(s string) {
+	m.receipt_number = &s
+}
+
+// ReceiptNumber returns the value of the "receipt_number" field in the mutation.
+func (m *PaymentMutation) ReceiptNumber() (r string, exists bool) {
+	v := m.receipt_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReceiptNumber returns the old "receipt_number" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldReceiptNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReceiptNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReceiptNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReceiptNumber: %w", err)
+	}
+	return oldValue.ReceiptNumber, nil
+}
+
+// ClearReceiptNumber clears the value of the "receipt_number" field.
+func (m *PaymentMutation) ClearReceiptNumber() {
+	m.receipt_number = nil
+	m.clearedFields[payment.FieldReceiptNumber] = struct{}{}
+}
+
+// ReceiptNumberCleared returns if the "receipt_number" field was cleared in this mutation.
+func (m *PaymentMutation) ReceiptNumberCleared() bool {
+	_, ok := m.clearedFields[payment.FieldReceiptNumber]
+	return ok
+}
+
+// ResetReceiptNumber resets all changes to the "receipt_number" field.
+func (m *PaymentMutation) ResetReceiptNumber() {
+	m.receipt_number = nil
+	delete(m.clearedFields, payment.FieldReceiptNumber)
+}
+
+// SetCheckedInAt sets the "checked_in_at" field.
+func (m *PaymentMutation) SetCheckedInAt(t time.Time) {
+	m.checked_in_at = &t
+}
+
+// CheckedInAt returns the value of the "checked_in_at" field in the mutation.
+func (m *PaymentMutation) CheckedInAt() (r time.Time, exists bool) {
+	v := m.checked_in_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedInAt returns the old "checked_in_at" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldCheckedInAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedInAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedInAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedInAt: %w", err)
+	}
+	return oldValue.CheckedInAt, nil
+}
+
+// ClearCheckedInAt clears the value of the "checked_in_at" field.
+func (m *PaymentMutation) ClearCheckedInAt() {
+	m.checked_in_at = nil
+	m.clearedFields[payment.FieldCheckedInAt] = struct{}{}
+}
+
+// CheckedInAtCleared returns if the "checked_in_at" field was cleared in this mutation.
+func (m *PaymentMutation) CheckedInAtCleared() bool {
+	_, ok := m.clearedFields[payment.FieldCheckedInAt]
+	return ok
+}
+
+// ResetCheckedInAt resets all changes to the "checked_in_at" field.
+func (m *PaymentMutation) ResetCheckedInAt() {
+	m.checked_in_at = nil
+	delete(m.clearedFields, payment.FieldCheckedInAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PaymentMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaymentMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaymentMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaymentMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaymentMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Payment entity.
+// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaymentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaymentMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (m *PaymentMutation) ClearEvent() {
+	m.clearedevent = true
+	m.clearedFields[payment.FieldEventID] = struct{}{}
+}
+
+// EventCleared reports if the "event" edge to the Event entity was cleared.
+func (m *PaymentMutation) EventCleared() bool {
+	return m.clearedevent
+}
+
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *PaymentMutation) EventIDs() (ids []uuid.UUID) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEvent resets all changes to the "event" edge.
+func (m *PaymentMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PaymentMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[payment.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PaymentMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PaymentMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PaymentMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddRegistrationAnswerIDs adds the "registration_answers" edge to the RegistrationAnswer entity by ids.
+func (m *PaymentMutation) AddRegistrationAnswerIDs(ids ...uuid.UUID) {
+	if m.registration_answers == nil {
+		m.registration_answers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.registration_answers[ids[i]] = struct{}{}
+	}
+}
+
+// ClearRegistrationAnswers clears the "registration_answers" edge to the RegistrationAnswer entity.
+func (m *PaymentMutation) ClearRegistrationAnswers() {
+	m.clearedregistration_answers = true
+}
+
+// RegistrationAnswersCleared reports if the "registration_answers" edge to the RegistrationAnswer entity was cleared.
+func (m *PaymentMutation) RegistrationAnswersCleared() bool {
+	return m.clearedregistration_answers
+}
+
+// RemoveRegistrationAnswerIDs removes the "registration_answers" edge to the RegistrationAnswer entity by IDs.
+func (m *PaymentMutation) RemoveRegistrationAnswerIDs(ids ...uuid.UUID) {
+	if m.removedregistration_answers == nil {
+		m.removedregistration_answers = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.registration_answers, ids[i])
+		m.removedregistration_answers[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedRegistrationAnswers returns the removed IDs of the "registration_answers" edge to the RegistrationAnswer entity.
+func (m *PaymentMutation) RemovedRegistrationAnswersIDs() (ids []uuid.UUID) {
+	for id := range m.removedregistration_answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// RegistrationAnswersIDs returns the "registration_answers" edge IDs in the mutation.
+func (m *PaymentMutation) RegistrationAnswersIDs() (ids []uuid.UUID) {
+	for id := range m.registration_answers {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetRegistrationAnswers resets all changes to the "registration_answers" edge.
+func (m *PaymentMutation) ResetRegistrationAnswers() {
+	m.registration_answers = nil
+	m.clearedregistration_answers = false
+	m.removedregistration_answers = nil
+}
+
+// Where appends a list predicates to the PaymentMutation builder.
+func (m *PaymentMutation) Where(ps ...predicate.Payment) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaymentMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaymentMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Payment, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaymentMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaymentMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Payment).
+func (m *PaymentMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaymentMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.event != nil {
+		fields = append(fields, payment.FieldEventID)
+	}
+	if m.user != nil {
+		fields = append(fields, payment.FieldUserID)
+	}
+	if m.event_title != nil {
+		fields = append(fields, payment.FieldEventTitle)
+	}
+	if m.ticket_quantity != nil {
+		fields = append(fields, payment.FieldTicketQuantity)
+	}
+	if m.total_price != nil {
+		fields = append(fields, payment.FieldTotalPrice)
+	}
+	if m.currency != nil {
+		fields = append(fields, payment.FieldCurrency)
+	}
+	if m.buyer_name != nil {
+		fields = append(fields, payment.FieldBuyerName)
+	}
+	if m.buyer_email != nil {
+		fields = append(fields, payment.FieldBuyerEmail)
+	}
+	if m.buyer_phone != nil {
+		fields = append(fields, payment.FieldBuyerPhone)
+	}
+	if m.payment_key != nil {
+		fields = append(fields, payment.FieldPaymentKey)
+	}
+	if m.order_id != nil {
+		fields = append(fields, payment.FieldOrderID)
+	}
+	if m.status != nil {
+		fields = append(fields, payment.FieldStatus)
+	}
+	if m.channel != nil {
+		fields = append(fields, payment.FieldChannel)
+	}
+	if m.payment_method != nil {
+		fields = append(fields, payment.FieldPaymentMethod)
+	}
+	if m.operator_id != nil {
+		fields = append(fields, payment.FieldOperatorID)
+	}
+	if m.receipt_number != nil {
+		fields = append(fields, payment.FieldReceiptNumber)
+	}
+	if m.checked_in_at != nil {
+		fields = append(fields, payment.FieldCheckedInAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, payment.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, payment.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaymentMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case payment.FieldEventID:
+		return m.EventID()
+	case payment.FieldUserID:
+		return m.UserID()
+	case payment.FieldEventTitle:
+		return m.EventTitle()
+	case payment.FieldTicketQuantity:
+		return m.TicketQuantity()
+	case payment.FieldTotalPrice:
+		return m.TotalPrice()
+	case payment.FieldCurrency:
+		return m.Currency()
+	case payment.FieldBuyerName:
+		return m.BuyerName()
+	case payment.FieldBuyerEmail:
+		return m.BuyerEmail()
+	case payment.FieldBuyerPhone:
+		return m.BuyerPhone()
+	case payment.FieldPaymentKey:
+		return m.PaymentKey()
+	case payment.FieldOrderID:
+		return m.OrderID()
+	case payment.FieldStatus:
+		return m.Status()
+	case payment.FieldChannel:
+		return m.Channel()
+	case payment.FieldPaymentMethod:
+		return m.PaymentMethod()
+	case payment.FieldOperatorID:
+		return m.OperatorID()
+	case payment.FieldReceiptNumber:
+		return m.ReceiptNumber()
+	case payment.FieldCheckedInAt:
+		return m.CheckedInAt()
+	case payment.FieldCreatedAt:
+		return m.CreatedAt()
+	case payment.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case payment.FieldEventID:
+		return m.OldEventID(ctx)
+	case payment.FieldUserID:
+		return m.OldUserID(ctx)
+	case payment.FieldEventTitle:
+		return m.OldEventTitle(ctx)
+	case payment.FieldTicketQuantity:
+		return m.OldTicketQuantity(ctx)
+	case payment.FieldTotalPrice:
+		return m.OldTotalPrice(ctx)
+	case payment.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case payment.FieldBuyerName:
+		return m.OldBuyerName(ctx)
+	case payment.FieldBuyerEmail:
+		return m.OldBuyerEmail(ctx)
+	case payment.FieldBuyerPhone:
+		return m.OldBuyerPhone(ctx)
+	case payment.FieldPaymentKey:
+		return m.OldPaymentKey(ctx)
+	case payment.FieldOrderID:
+		return m.OldOrderID(ctx)
+	case payment.FieldStatus:
+		return m.OldStatus(ctx)
+	case payment.FieldChannel:
+		return m.OldChannel(ctx)
+	case payment.FieldPaymentMethod:
+		return m.OldPaymentMethod(ctx)
+	case payment.FieldOperatorID:
+		return m.OldOperatorID(ctx)
+	case payment.FieldReceiptNumber:
+		return m.OldReceiptNumber(ctx)
+	case payment.FieldCheckedInAt:
+		return m.OldCheckedInAt(ctx)
+	case payment.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case payment.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Payment field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case payment.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case payment.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case payment.FieldEventTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventTitle(v)
+		return nil
+	case payment.FieldTicketQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTicketQuantity(v)
+		return nil
+	case payment.FieldTotalPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalPrice(v)
+		return nil
+	case payment.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case payment.FieldBuyerName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerName(v)
+		return nil
+	case payment.FieldBuyerEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerEmail(v)
+		return nil
+	case payment.FieldBuyerPhone:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBuyerPhone(v)
+		return nil
+	case payment.FieldPaymentKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentKey(v)
+		return nil
+	case payment.FieldOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderID(v)
+		return nil
+	case payment.FieldStatus:
+		v, ok := value.(payment.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case payment.FieldChannel:
+		v, ok := value.(payment.Channel)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChannel(v)
+		return nil
+	case payment.FieldPaymentMethod:
+		v, ok := value.(payment.PaymentMethod)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentMethod(v)
+		return nil
+	case payment.FieldOperatorID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperatorID(v)
+		return nil
+	case payment.FieldReceiptNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReceiptNumber(v)
+		return nil
+	case payment.FieldCheckedInAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedInAt(v)
+		return nil
+	case payment.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case payment.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payment field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaymentMutation) AddedFields() []string {
+	var fields []string
+	if m.addticket_quantity != nil {
+		fields = append(fields, payment.FieldTicketQuantity)
+	}
+	if m.addtotal_price != nil {
+		fields = append(fields, payment.FieldTotalPrice)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaymentMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case payment.FieldTicketQuantity:
+		return m.AddedTicketQuantity()
+	case payment.FieldTotalPrice:
+		return m.AddedTotalPrice()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaymentMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case payment.FieldTicketQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTicketQuantity(v)
+		return nil
+	case payment.FieldTotalPrice:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalPrice(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Payment numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaymentMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(payment.FieldUserID) {
+		fields = append(fields, payment.FieldUserID)
+	}
+	if m.FieldCleared(payment.FieldPaymentKey) {
+		fields = append(fields, payment.FieldPaymentKey)
+	}
+	if m.FieldCleared(payment.FieldOrderID) {
+		fields = append(fields, payment.FieldOrderID)
+	}
+	if m.FieldCleared(payment.FieldOperatorID) {
+		fields = append(fields, payment.FieldOperatorID)
+	}
+	if m.FieldCleared(payment.FieldReceiptNumber) {
+		fields = append(fields, payment.FieldReceiptNumber)
+	}
+	if m.FieldCleared(payment.FieldCheckedInAt) {
+		fields = append(fields, payment.FieldCheckedInAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaymentMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaymentMutation) ClearField(name string) error {
+	switch name {
+	case payment.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case payment.FieldPaymentKey:
+		m.ClearPaymentKey()
+		return nil
+	case payment.FieldOrderID:
+		m.ClearOrderID()
+		return nil
+	case payment.FieldOperatorID:
+		m.ClearOperatorID()
+		return nil
+	case payment.FieldReceiptNumber:
+		m.ClearReceiptNumber()
+		return nil
+	case payment.FieldCheckedInAt:
+		m.ClearCheckedInAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaymentMutation) ResetField(name string) error {
+	switch name {
+	case payment.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case payment.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case payment.FieldEventTitle:
+		m.ResetEventTitle()
+		return nil
+	case payment.FieldTicketQuantity:
+		m.ResetTicketQuantity()
+		return nil
+	case payment.FieldTotalPrice:
+		m.ResetTotalPrice()
+		return nil
+	case payment.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case payment.FieldBuyerName:
+		m.ResetBuyerName()
+		return nil
+	case payment.FieldBuyerEmail:
+		m.ResetBuyerEmail()
+		return nil
+	case payment.FieldBuyerPhone:
+		m.ResetBuyerPhone()
+		return nil
+	case payment.FieldPaymentKey:
+		m.ResetPaymentKey()
+		return nil
+	case payment.FieldOrderID:
+		m.ResetOrderID()
+		return nil
+	case payment.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case payment.FieldChannel:
+		m.ResetChannel()
+		return nil
+	case payment.FieldPaymentMethod:
+		m.ResetPaymentMethod()
+		return nil
+	case payment.FieldOperatorID:
+		m.ResetOperatorID()
+		return nil
+	case payment.FieldReceiptNumber:
+		m.ResetReceiptNumber()
+		return nil
+	case payment.FieldCheckedInAt:
+		m.ResetCheckedInAt()
+		return nil
+	case payment.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case payment.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaymentMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.event != nil {
+		edges = append(edges, payment.EdgeEvent)
+	}
+	if m.user != nil {
+		edges = append(edges, payment.EdgeUser)
+	}
+	if m.registration_answers != nil {
+		edges = append(edges, payment.EdgeRegistrationAnswers)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaymentMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case payment.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
+		}
+	case payment.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case payment.EdgeRegistrationAnswers:
+		ids := make([]ent.Value, 0, len(m.registration_answers))
+		for id := range m.registration_answers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaymentMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedregistration_answers != nil {
+		edges = append(edges, payment.EdgeRegistrationAnswers)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaymentMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case payment.EdgeRegistrationAnswers:
+		ids := make([]ent.Value, 0, len(m.removedregistration_answers))
+		for id := range m.removedregistration_answers {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaymentMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.clearedevent {
+		edges = append(edges, payment.EdgeEvent)
+	}
+	if m.cleareduser {
+		edges = append(edges, payment.EdgeUser)
+	}
+	if m.clearedregistration_answers {
+		edges = append(edges, payment.EdgeRegistrationAnswers)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaymentMutation) EdgeCleared(name string) bool {
+	switch name {
+	case payment.EdgeEvent:
+		return m.clearedevent
+	case payment.EdgeUser:
+		return m.cleareduser
+	case payment.EdgeRegistrationAnswers:
+		return m.clearedregistration_answers
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaymentMutation) ClearEdge(name string) error {
+	switch name {
+	case payment.EdgeEvent:
+		m.ClearEvent()
+		return nil
+	case payment.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaymentMutation) ResetEdge(name string) error {
+	switch name {
+	case payment.EdgeEvent:
+		m.ResetEvent()
+		return nil
+	case payment.EdgeUser:
+		m.ResetUser()
+		return nil
+	case payment.EdgeRegistrationAnswers:
+		m.ResetRegistrationAnswers()
+		return nil
+	}
+	return fmt.Errorf("unknown Payment edge %s", name)
+}
+
+// RegistrationAnswerMutation represents an operation that mutates the RegistrationAnswer nodes in the graph.
+type RegistrationAnswerMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	ticket_index    *int
+	addticket_index *int
+	question_id     *uuid.UUID
+	question_label  *string
+	values          *[]string
+	appendvalues    []string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	payment         *uuid.UUID
+	clearedpayment  bool
+	done            bool
+	oldValue        func(context.Context) (*RegistrationAnswer, error)
+	predicates      []predicate.RegistrationAnswer
+}
+
+var _ ent.Mutation = (*RegistrationAnswerMutation)(nil)
+
+// registrationanswerOption allows management of the mutation configuration using functional options.
+type registrationanswerOption func(*RegistrationAnswerMutation)
+
+// newRegistrationAnswerMutation creates new mutation for the RegistrationAnswer entity.
+func newRegistrationAnswerMutation(c config, op Op, opts ...registrationanswerOption) *RegistrationAnswerMutation {
+	m := &RegistrationAnswerMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegistrationAnswer,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegistrationAnswerID sets the ID field of the mutation.
+func withRegistrationAnswerID(id uuid.UUID) registrationanswerOption {
+	return func(m *RegistrationAnswerMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RegistrationAnswer
+		)
+		m.oldValue = func(ctx context.Context) (*RegistrationAnswer, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RegistrationAnswer.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegistrationAnswer sets the old RegistrationAnswer of the mutation.
+func withRegistrationAnswer(node *RegistrationAnswer) registrationanswerOption {
+	return func(m *RegistrationAnswerMutation) {
+		m.oldValue = func(context.Context) (*RegistrationAnswer, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegistrationAnswerMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegistrationAnswerMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RegistrationAnswer entities.
+func (m *RegistrationAnswerMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegistrationAnswerMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegistrationAnswerMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RegistrationAnswer.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (m *RegistrationAnswerMutation) SetPaymentID(u uuid.UUID) {
+	m.payment = &u
+}
+
+// PaymentID returns the value of the "payment_id" field in the mutation.
+func (m *RegistrationAnswerMutation) PaymentID() (r uuid.UUID, exists bool) {
+	v := m.payment
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPaymentID returns the old "payment_id" field's value of the RegistrationAnswer entity.
+// If the RegistrationAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationAnswerMutation) OldPaymentID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPaymentID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPaymentID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPaymentID: %w", err)
+	}
+	return oldValue.PaymentID, nil
+}
+
+// ResetPaymentID resets all changes to the "payment_id" field.
+func (m *RegistrationAnswerMutation) ResetPaymentID() {
+	m.payment = nil
+}
+
+// SetTicketIndex sets the "ticket_index" field.
+func (m *RegistrationAnswerMutation) SetTicketIndex(i int) {
+	m.ticket_index = &i
+	m.addticket_index = nil
+}
+
+// TicketIndex returns the value of the "ticket_index" field in the mutation.
+func (m *RegistrationAnswerMutation) TicketIndex() (r int, exists bool) {
+	v := m.ticket_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTicketIndex returns the old "ticket_index" field's value of the RegistrationAnswer entity.
+// If the RegistrationAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationAnswerMutation) OldTicketIndex(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTicketIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTicketIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTicketIndex: %w", err)
+	}
+	return oldValue.TicketIndex, nil
+}
+
+// AddTicketIndex adds i to the "ticket_index" field.
+func (m *RegistrationAnswerMutation) AddTicketIndex(i int) {
+	if m.addticket_index != nil {
+		*m.addticket_index += i
+	} else {
+		m.addticket_index = &i
+	}
+}
+
+// AddedTicketIndex returns the value that was added to the "ticket_index" field in this mutation.
+func (m *RegistrationAnswerMutation) AddedTicketIndex() (r int, exists bool) {
+	v := m.addticket_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTicketIndex resets all changes to the "ticket_index" field.
+func (m *RegistrationAnswerMutation) ResetTicketIndex() {
+	m.ticket_index = nil
+	m.addticket_index = nil
+}
+
+// SetQuestionID sets the "question_id" field.
+func (m *RegistrationAnswerMutation) SetQuestionID(u uuid.UUID) {
+	m.question_id = &u
+}
+
+// QuestionID returns the value of the "question_id" field in the mutation.
+func (m *RegistrationAnswerMutation) QuestionID() (r uuid.UUID, exists bool) {
+	v := m.question_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionID returns the old "question_id" field's value of the RegistrationAnswer entity.
+// If the RegistrationAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationAnswerMutation) OldQuestionID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionID: %w", err)
+	}
+	return oldValue.QuestionID, nil
+}
+
+// ResetQuestionID resets all changes to the "question_id" field.
+func (m *RegistrationAnswerMutation) ResetQuestionID() {
+	m.question_id = nil
+}
+
+// SetQuestionLabel sets the "question_label" field.
+func (m *RegistrationAnswerMutation) SetQuestionLabel(s string) {
+	m.question_label = &s
+}
+
+// QuestionLabel returns the value of the "question_label" field in the mutation.
+func (m *RegistrationAnswerMutation) QuestionLabel() (r string, exists bool) {
+	v := m.question_label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuestionLabel returns the old "question_label" field's value of the RegistrationAnswer entity.
+// If the RegistrationAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationAnswerMutation) OldQuestionLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuestionLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuestionLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuestionLabel: %w", err)
+	}
+	return oldValue.QuestionLabel, nil
+}
+
+// ResetQuestionLabel resets all changes to the "question_label" field.
+func (m *RegistrationAnswerMutation) ResetQuestionLabel() {
+	m.question_label = nil
+}
+
+// SetValues sets the "values" field.
+func (m *RegistrationAnswerMutation) SetValues(s []string) {
+	m.values = &s
+	m.appendvalues = nil
+}
+
+// Values returns the value of the "values" field in the mutation.
+func (m *RegistrationAnswerMutation) Values() (r []string, exists bool) {
+	v := m.values
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldValues returns the old "values" field's value of the RegistrationAnswer entity.
+// If the RegistrationAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationAnswerMutation) OldValues(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldValues is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldValues requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldValues: %w", err)
+	}
+	return oldValue.Values, nil
+}
+
+// AppendValues adds s to the "values" field.
+func (m *RegistrationAnswerMutation) AppendValues(s []string) {
+	m.appendvalues = append(m.appendvalues, s...)
+}
+
+// AppendedValues returns the list of values that were appended to the "values" field in this mutation.
+func (m *RegistrationAnswerMutation) AppendedValues() ([]string, bool) {
+	if len(m.appendvalues) == 0 {
+		return nil, false
+	}
+	return m.appendvalues, true
+}
+
+// ResetValues resets all changes to the "values" field.
+func (m *RegistrationAnswerMutation) ResetValues() {
+	m.values = nil
+	m.appendvalues = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RegistrationAnswerMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegistrationAnswerMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RegistrationAnswer entity.
+// If the RegistrationAnswer object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationAnswerMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegistrationAnswerMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearPayment clears the "payment" edge to the Payment entity.
+func (m *RegistrationAnswerMutation) ClearPayment() {
+	m.clearedpayment = true
+	m.clearedFields[registrationanswer.FieldPaymentID] = struct{}{}
+}
+
+// PaymentCleared reports if the "payment" edge to the Payment entity was cleared.
+func (m *RegistrationAnswerMutation) PaymentCleared() bool {
+	return m.clearedpayment
+}
+
+// PaymentIDs returns the "payment" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PaymentID instead. It exists only for internal usage by the builders.
+func (m *RegistrationAnswerMutation) PaymentIDs() (ids []uuid.UUID) {
+	if id := m.payment; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPayment resets all changes to the "payment" edge.
+func (m *RegistrationAnswerMutation) ResetPayment() {
+	m.payment = nil
+	m.clearedpayment = false
+}
+
+// Where appends a list predicates to the RegistrationAnswerMutation builder.
+func (m *RegistrationAnswerMutation) Where(ps ...predicate.RegistrationAnswer) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegistrationAnswerMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegistrationAnswerMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RegistrationAnswer, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegistrationAnswerMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegistrationAnswerMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RegistrationAnswer).
+func (m *RegistrationAnswerMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegistrationAnswerMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.payment != nil {
+		fields = append(fields, registrationanswer.FieldPaymentID)
+	}
+	if m.ticket_index != nil {
+		fields = append(fields, registrationanswer.FieldTicketIndex)
+	}
+	if m.question_id != nil {
+		fields = append(fields, registrationanswer.FieldQuestionID)
+	}
+	if m.question_label != nil {
+		fields = append(fields, registrationanswer.FieldQuestionLabel)
+	}
+	if m.values != nil {
+		fields = append(fields, registrationanswer.FieldValues)
+	}
+	if m.created_at != nil {
+		fields = append(fields, registrationanswer.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegistrationAnswerMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case registrationanswer.FieldPaymentID:
+		return m.PaymentID()
+	case registrationanswer.FieldTicketIndex:
+		return m.TicketIndex()
+	case registrationanswer.FieldQuestionID:
+		return m.QuestionID()
+	case registrationanswer.FieldQuestionLabel:
+		return m.QuestionLabel()
+	case registrationanswer.FieldValues:
+		return m.Values()
+	case registrationanswer.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegistrationAnswerMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case registrationanswer.FieldPaymentID:
+		return m.OldPaymentID(ctx)
+	case registrationanswer.FieldTicketIndex:
+		return m.OldTicketIndex(ctx)
+	case registrationanswer.FieldQuestionID:
+		return m.OldQuestionID(ctx)
+	case registrationanswer.FieldQuestionLabel:
+		return m.OldQuestionLabel(ctx)
+	case registrationanswer.FieldValues:
+		return m.OldValues(ctx)
+	case registrationanswer.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RegistrationAnswer field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistrationAnswerMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case registrationanswer.FieldPaymentID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPaymentID(v)
+		return nil
+	case registrationanswer.FieldTicketIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTicketIndex(v)
+		return nil
+	case registrationanswer.FieldQuestionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionID(v)
+		return nil
+	case registrationanswer.FieldQuestionLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuestionLabel(v)
+		return nil
+	case registrationanswer.FieldValues:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetValues(v)
+		return nil
+	case registrationanswer.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationAnswer field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegistrationAnswerMutation) AddedFields() []string {
+	var fields []string
+	if m.addticket_index != nil {
+		fields = append(fields, registrationanswer.FieldTicketIndex)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegistrationAnswerMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case registrationanswer.FieldTicketIndex:
+		return m.AddedTicketIndex()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistrationAnswerMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case registrationanswer.FieldTicketIndex:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTicketIndex(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationAnswer numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegistrationAnswerMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegistrationAnswerMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegistrationAnswerMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RegistrationAnswer nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegistrationAnswerMutation) ResetField(name string) error {
+	switch name {
+	case registrationanswer.FieldPaymentID:
+		m.ResetPaymentID()
+		return nil
+	case registrationanswer.FieldTicketIndex:
+		m.ResetTicketIndex()
+		return nil
+	case registrationanswer.FieldQuestionID:
+		m.ResetQuestionID()
+		return nil
+	case registrationanswer.FieldQuestionLabel:
+		m.ResetQuestionLabel()
+		return nil
+	case registrationanswer.FieldValues:
+		m.ResetValues()
+		return nil
+	case registrationanswer.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationAnswer field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegistrationAnswerMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.payment != nil {
+		edges = append(edges, registrationanswer.EdgePayment)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegistrationAnswerMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case registrationanswer.EdgePayment:
+		if id := m.payment; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegistrationAnswerMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegistrationAnswerMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegistrationAnswerMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedpayment {
+		edges = append(edges, registrationanswer.EdgePayment)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegistrationAnswerMutation) EdgeCleared(name string) bool {
+	switch name {
+	case registrationanswer.EdgePayment:
+		return m.clearedpayment
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegistrationAnswerMutation) ClearEdge(name string) error {
+	switch name {
+	case registrationanswer.EdgePayment:
+		m.ClearPayment()
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationAnswer unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegistrationAnswerMutation) ResetEdge(name string) error {
+	switch name {
+	case registrationanswer.EdgePayment:
+		m.ResetPayment()
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationAnswer edge %s", name)
+}
+
+// RegistrationQuestionMutation represents an operation that mutates the RegistrationQuestion nodes in the graph.
+type RegistrationQuestionMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	label         *string
+	_type         *registrationquestion.Type
+	options       *[]string
+	appendoptions []string
+	required      *bool
+	position      *int
+	addposition   *int
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	event         *uuid.UUID
+	clearedevent  bool
+	done          bool
+	oldValue      func(context.Context) (*RegistrationQuestion, error)
+	predicates    []predicate.RegistrationQuestion
+}
+
+var _ ent.Mutation = (*RegistrationQuestionMutation)(nil)
+
+// registrationquestionOption allows management of the mutation configuration using functional options.
+type registrationquestionOption func(*RegistrationQuestionMutation)
+
+// newRegistrationQuestionMutation creates new mutation for the RegistrationQuestion entity.
+func newRegistrationQuestionMutation(c config, op Op, opts ...registrationquestionOption) *RegistrationQuestionMutation {
+	m := &RegistrationQuestionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRegistrationQuestion,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRegistrationQuestionID sets the ID field of the mutation.
+func withRegistrationQuestionID(id uuid.UUID) registrationquestionOption {
+	return func(m *RegistrationQuestionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RegistrationQuestion
+		)
+		m.oldValue = func(ctx context.Context) (*RegistrationQuestion, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RegistrationQuestion.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRegistrationQuestion sets the old RegistrationQuestion of the mutation.
+func withRegistrationQuestion(node *RegistrationQuestion) registrationquestionOption {
+	return func(m *RegistrationQuestionMutation) {
+		m.oldValue = func(context.Context) (*RegistrationQuestion, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RegistrationQuestionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RegistrationQuestionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RegistrationQuestion entities.
+func (m *RegistrationQuestionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RegistrationQuestionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RegistrationQuestionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RegistrationQuestion.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEventID sets the "event_id" field.
+func (m *RegistrationQuestionMutation) SetEventID(u uuid.UUID) {
+	m.event = &u
+}
+
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *RegistrationQuestionMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEventID returns the old "event_id" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEventID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
+	}
+	return oldValue.EventID, nil
+}
+
+// ResetEventID resets all changes to the "event_id" field.
+func (m *RegistrationQuestionMutation) ResetEventID() {
+	m.event = nil
+}
+
+// SetLabel sets the "label" field.
+func (m *RegistrationQuestionMutation) SetLabel(s string) {
+	m.label = &s
+}
+
+// Label returns the value of the "label" field in the mutation.
+func (m *RegistrationQuestionMutation) Label() (r string, exists bool) {
+	v := m.label
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLabel returns the old "label" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldLabel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLabel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLabel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLabel: %w", err)
+	}
+	return oldValue.Label, nil
+}
+
+// ResetLabel resets all changes to the "label" field.
+func (m *RegistrationQuestionMutation) ResetLabel() {
+	m.label = nil
+}
+
+// SetType sets the "type" field.
+func (m *RegistrationQuestionMutation) SetType(r registrationquestion.Type) {
+	m._type = &r
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *RegistrationQuestionMutation) GetType() (r registrationquestion.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldType(ctx context.Context) (v registrationquestion.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *RegistrationQuestionMutation) ResetType() {
+	m._type = nil
+}
+
+// SetOptions sets the "options" field.
+func (m *RegistrationQuestionMutation) SetOptions(s []string) {
+	m.options = &s
+	m.appendoptions = nil
+}
+
+// Options returns the value of the "options" field in the mutation.
+func (m *RegistrationQuestionMutation) Options() (r []string, exists bool) {
+	v := m.options
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptions returns the old "options" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldOptions(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptions: %w", err)
+	}
+	return oldValue.Options, nil
+}
+
+// AppendOptions adds s to the "options" field.
+func (m *RegistrationQuestionMutation) AppendOptions(s []string) {
+	m.appendoptions = append(m.appendoptions, s...)
+}
+
+// AppendedOptions returns the list of values that were appended to the "options" field in this mutation.
+func (m *RegistrationQuestionMutation) AppendedOptions() ([]string, bool) {
+	if len(m.appendoptions) == 0 {
+		return nil, false
+	}
+	return m.appendoptions, true
+}
+
+// ClearOptions clears the value of the "options" field.
+func (m *RegistrationQuestionMutation) ClearOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	m.clearedFields[registrationquestion.FieldOptions] = struct{}{}
+}
+
+// OptionsCleared returns if the "options" field was cleared in this mutation.
+func (m *RegistrationQuestionMutation) OptionsCleared() bool {
+	_, ok := m.clearedFields[registrationquestion.FieldOptions]
+	return ok
+}
+
+// ResetOptions resets all changes to the "options" field.
+func (m *RegistrationQuestionMutation) ResetOptions() {
+	m.options = nil
+	m.appendoptions = nil
+	delete(m.clearedFields, registrationquestion.FieldOptions)
+}
+
+// SetRequired sets the "required" field.
+func (m *RegistrationQuestionMutation) SetRequired(b bool) {
+	m.required = &b
+}
+
+// Required returns the value of the "required" field in the mutation.
+func (m *RegistrationQuestionMutation) Required() (r bool, exists bool) {
+	v := m.required
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRequired returns the old "required" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldRequired(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequired is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequired requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequired: %w", err)
+	}
+	return oldValue.Required, nil
+}
+
+// ResetRequired resets all changes to the "required" field.
+func (m *RegistrationQuestionMutation) ResetRequired() {
+	m.required = nil
+}
+
+// SetPosition sets the "position" field.
+func (m *RegistrationQuestionMutation) SetPosition(i int) {
+	m.position = &i
+	m.addposition = nil
+}
+
+// Position returns the value of the "position" field in the mutation.
+func (m *RegistrationQuestionMutation) Position() (r int, exists bool) {
+	v := m.position
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPosition returns the old "position" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldPosition(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPosition is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPosition requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPosition: %w", err)
+	}
+	return oldValue.Position, nil
+}
+
+// AddPosition adds i to the "position" field.
+func (m *RegistrationQuestionMutation) AddPosition(i int) {
+	if m.addposition != nil {
+		*m.addposition += i
+	} else {
+		m.addposition = &i
+	}
+}
+
+// AddedPosition returns the value that was added to the "position" field in this mutation.
+func (m *RegistrationQuestionMutation) AddedPosition() (r int, exists bool) {
+	v := m.addposition
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPosition resets all changes to the "position" field.
+func (m *RegistrationQuestionMutation) ResetPosition() {
+	m.position = nil
+	m.addposition = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RegistrationQuestionMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RegistrationQuestionMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RegistrationQuestionMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RegistrationQuestionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RegistrationQuestionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RegistrationQuestion entity.
+// If the RegistrationQuestion object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RegistrationQuestionMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RegistrationQuestionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (m *RegistrationQuestionMutation) ClearEvent() {
+	m.clearedevent = true
+	m.clearedFields[registrationquestion.FieldEventID] = struct{}{}
+}
+
+// EventCleared reports if the "event" edge to the Event entity was cleared.
+func (m *RegistrationQuestionMutation) EventCleared() bool {
+	return m.clearedevent
+}
+
+// EventIDs returns the "event" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// EventID instead. It exists only for internal usage by the builders.
+func (m *RegistrationQuestionMutation) EventIDs() (ids []uuid.UUID) {
+	if id := m.event; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetEvent resets all changes to the "event" edge.
+func (m *RegistrationQuestionMutation) ResetEvent() {
+	m.event = nil
+	m.clearedevent = false
+}
+
+// Where appends a list predicates to the RegistrationQuestionMutation builder.
+func (m *RegistrationQuestionMutation) Where(ps ...predicate.RegistrationQuestion) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RegistrationQuestionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RegistrationQuestionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RegistrationQuestion, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RegistrationQuestionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RegistrationQuestionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RegistrationQuestion).
+func (m *RegistrationQuestionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RegistrationQuestionMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.event != nil {
+		fields = append(fields, registrationquestion.FieldEventID)
+	}
+	if m.label != nil {
+		fields = append(fields, registrationquestion.FieldLabel)
+	}
+	if m._type != nil {
+		fields = append(fields, registrationquestion.FieldType)
+	}
+	if m.options != nil {
+		fields = append(fields, registrationquestion.FieldOptions)
+	}
+	if m.required != nil {
+		fields = append(fields, registrationquestion.FieldRequired)
+	}
+	if m.position != nil {
+		fields = append(fields, registrationquestion.FieldPosition)
+	}
+	if m.created_at != nil {
+		fields = append(fields, registrationquestion.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, registrationquestion.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RegistrationQuestionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case registrationquestion.FieldEventID:
+		return m.EventID()
+	case registrationquestion.FieldLabel:
+		return m.Label()
+	case registrationquestion.FieldType:
+		return m.GetType()
+	case registrationquestion.FieldOptions:
+		return m.Options()
+	case registrationquestion.FieldRequired:
+		return m.Required()
+	case registrationquestion.FieldPosition:
+		return m.Position()
+	case registrationquestion.FieldCreatedAt:
+		return m.CreatedAt()
+	case registrationquestion.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RegistrationQuestionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case registrationquestion.FieldEventID:
+		return m.OldEventID(ctx)
+	case registrationquestion.FieldLabel:
+		return m.OldLabel(ctx)
+	case registrationquestion.FieldType:
+		return m.OldType(ctx)
+	case registrationquestion.FieldOptions:
+		return m.OldOptions(ctx)
+	case registrationquestion.FieldRequired:
+		return m.OldRequired(ctx)
+	case registrationquestion.FieldPosition:
+		return m.OldPosition(ctx)
+	case registrationquestion.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case registrationquestion.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RegistrationQuestion field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistrationQuestionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case registrationquestion.FieldEventID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEventID(v)
+		return nil
+	case registrationquestion.FieldLabel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLabel(v)
+		return nil
+	case registrationquestion.FieldType:
+		v, ok := value.(registrationquestion.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case registrationquestion.FieldOptions:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptions(v)
+		return nil
+	case registrationquestion.FieldRequired:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequired(v)
+		return nil
+	case registrationquestion.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPosition(v)
+		return nil
+	case registrationquestion.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case registrationquestion.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationQuestion field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RegistrationQuestionMutation) AddedFields() []string {
+	var fields []string
+	if m.addposition != nil {
+		fields = append(fields, registrationquestion.FieldPosition)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RegistrationQuestionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case registrationquestion.FieldPosition:
+		return m.AddedPosition()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RegistrationQuestionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case registrationquestion.FieldPosition:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPosition(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationQuestion numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RegistrationQuestionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(registrationquestion.FieldOptions) {
+		fields = append(fields, registrationquestion.FieldOptions)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RegistrationQuestionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RegistrationQuestionMutation) ClearField(name string) error {
+	switch name {
+	case registrationquestion.FieldOptions:
+		m.ClearOptions()
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationQuestion nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RegistrationQuestionMutation) ResetField(name string) error {
+	switch name {
+	case registrationquestion.FieldEventID:
+		m.ResetEventID()
+		return nil
+	case registrationquestion.FieldLabel:
+		m.ResetLabel()
+		return nil
+	case registrationquestion.FieldType:
+		m.ResetType()
+		return nil
+	case registrationquestion.FieldOptions:
+		m.ResetOptions()
+		return nil
+	case registrationquestion.FieldRequired:
+		m.ResetRequired()
+		return nil
+	case registrationquestion.FieldPosition:
+		m.ResetPosition()
+		return nil
+	case registrationquestion.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case registrationquestion.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationQuestion field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RegistrationQuestionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.event != nil {
+		edges = append(edges, registrationquestion.EdgeEvent)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RegistrationQuestionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case registrationquestion.EdgeEvent:
+		if id := m.event; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RegistrationQuestionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RegistrationQuestionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RegistrationQuestionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedevent {
+		edges = append(edges, registrationquestion.EdgeEvent)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RegistrationQuestionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case registrationquestion.EdgeEvent:
+		return m.clearedevent
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RegistrationQuestionMutation) ClearEdge(name string) error {
+	switch name {
+	case registrationquestion.EdgeEvent:
+		m.ClearEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationQuestion unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RegistrationQuestionMutation) ResetEdge(name string) error {
+	switch name {
+	case registrationquestion.EdgeEvent:
+		m.ResetEvent()
+		return nil
+	}
+	return fmt.Errorf("unknown RegistrationQuestion edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	first_name                 *string
+	last_name                  *string
+	nick_name                  *string
+	birthday                   *string
+	email                      *string
+	password                   *string
+	phone_number               *string
+	is_valid                   *bool
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	clearedFields              map[string]struct{}
+	owned_organizations        map[uuid.UUID]struct{}
+	removedowned_organizations map[uuid.UUID]struct{}
+	clearedowned_organizations bool
+	memberships                map[uuid.UUID]struct{}
+	removedmemberships         map[uuid.UUID]struct{}
+	clearedmemberships         bool
+	created_events             map[uuid.UUID]struct{}
+	removedcreated_events      map[uuid.UUID]struct{}
+	clearedcreated_events      bool
+	payments                   map[uuid.UUID]struct{}
+	removedpayments            map[uuid.UUID]struct{}
+	clearedpayments            bool
+	done                       bool
+	oldValue                   func(context.Context) (*User, error)
+	predicates                 []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetFirstName sets the "first_name" field.
+func (m *UserMutation) SetFirstName(s string) {
+	m.first_name = &s
+}
+
+// FirstName returns the value of the "first_name" field in the mutation.
+func (m *UserMutation) FirstName() (r string, exists bool) {
+	v := m.first_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFirstName returns the old "first_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFirstName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
+	}
+	return oldValue.FirstName, nil
+}
+
+// ResetFirstName resets all changes to the "first_name" field.
+func (m *UserMutation) ResetFirstName() {
+	m.first_name = nil
+}
+
+// SetLastName sets the "last_name" field.
+func (m *UserMutation) SetLastName(s string) {
+	m.last_name = &s
+}
+
+// LastName returns the value of the "last_name" field in the mutation.
+func (m *UserMutation) LastName() (r string, exists bool) {
+	v := m.last_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastName returns the old "last_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+	}
+	return oldValue.LastName, nil
+}
+
+// ResetLastName resets all changes to the "last_name" field.
+func (m *UserMutation) ResetLastName() {
+	m.last_name = nil
+}
+
+// SetNickName sets the "nick_name" field.
+func (m *UserMutation) SetNickName(s string) {
+	m.nick_name = &s
+}
+
+// NickName returns the value of the "nick_name" field in the mutation.
+func (m *UserMutation) NickName() (r string, exists bool) {
+	v := m.nick_name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldNickName returns the old "nick_name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldNickName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldNickName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldNickName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldNickName: %w", err)
+	}
+	return oldValue.NickName, nil
+}
+
+// ResetNickName resets all changes to the "nick_name" field.
+func (m *UserMutation) ResetNickName() {
+	m.nick_name = nil
+}
+
+// SetBirthday sets the "birthday" field.
+func (m *UserMutation) SetBirthday(s string) {
+	m.birthday = &s
+}
+
+// Birthday returns the value of the "birthday" field in the mutation.
+func (m *UserMutation) Birthday() (r string, exists bool) {
+	v := m.birthday
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBirthday returns the old "birthday" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldBirthday(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBirthday is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBirthday requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBirthday: %w", err)
+	}
+	return oldValue.Birthday, nil
+}
+
+// ResetBirthday resets all changes to the "birthday" field.
+func (m *UserMutation) ResetBirthday() {
+	m.birthday = nil
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPassword sets the "password" field.
+func (m *UserMutation) SetPassword(s string) {
+	m.password = &s
+}
+
+// Password returns the value of the "password" field in the mutation.
+func (m *UserMutation) Password() (r string, exists bool) {
+	v := m.password
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPassword returns the old "password" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPassword requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+	}
+	return oldValue.Password, nil
+}
+
+// ResetPassword resets all changes to the "password" field.
+func (m *UserMutation) ResetPassword() {
+	m.password = nil
+}
+
+// SetPhoneNumber sets the "phone_number" field.
+func (m *UserMutation) SetPhoneNumber(s string) {
+	m.phone_number = &s
+}
+
+// PhoneNumber returns the value of the "phone_number" field in the mutation.
+func (m *UserMutation) PhoneNumber() (r string, exists bool) {
+	v := m.phone_number
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPhoneNumber returns the old "phone_number" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPhoneNumber(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPhoneNumber is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPhoneNumber requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPhoneNumber: %w", err)
+	}
+	return oldValue.PhoneNumber, nil
+}
+
+// ResetPhoneNumber resets all changes to the "phone_number" field.
+func (m *UserMutation) ResetPhoneNumber() {
+	m.phone_number = nil
+}
+
+// SetIsValid sets the "is_valid" field.
+func (m *UserMutation) SetIsValid(b bool) {
+	m.is_valid = &b
+}
+
+// IsValid returns the value of the "is_valid" field in the mutation.
+func (m *UserMutation) IsValid() (r bool, exists bool) {
+	v := m.is_valid
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsValid returns the old "is_valid" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsValid(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsValid is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsValid requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsValid: %w", err)
+	}
+	return oldValue.IsValid, nil
+}
+
+// ResetIsValid resets all changes to the "is_valid" field.
+func (m *UserMutation) ResetIsValid() {
+	m.is_valid = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.addparticipant_count != nil {
-		fields = append(fields, event.FieldParticipantCount)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.addticket_price != nil {
-		fields = append(fields, event.FieldTicketPrice)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return fields
+	return oldValue.CreatedAt, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *EventMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case event.FieldTotalTickets:
-		return m.AddedTotalTickets()
-	case event.FieldAvailableTickets:
-		return m.AddedAvailableTickets()
-	case event.FieldParticipantCount:
-		return m.AddedParticipantCount()
-	case event.FieldTicketPrice:
-		return m.AddedTicketPrice()
-	}
-	return nil, false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *EventMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case event.FieldTotalTickets:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTotalTickets(v)
-		return nil
-	case event.FieldAvailableTickets:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAvailableTickets(v)
-		return nil
-	case event.FieldParticipantCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddParticipantCount(v)
-		return nil
-	case event.FieldTicketPrice:
-		v, ok := value.(float64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddTicketPrice(v)
-		return nil
-	}
-	return fmt.Errorf("unknown Event numeric field %s", name)
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *EventMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(event.FieldDescription) {
-		fields = append(fields, event.FieldDescription)
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	if m.FieldCleared(event.FieldLocation) {
-		fields = append(fields, event.FieldLocation)
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(event.FieldVenue) {
-		fields = append(fields, event.FieldVenue)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	if m.FieldCleared(event.FieldThumbnailURL) {
-		fields = append(fields, event.FieldThumbnailURL)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return fields
+	return oldValue.UpdatedAt, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *EventMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *EventMutation) ClearField(name string) error {
-	switch name {
-	case event.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case event.FieldLocation:
-		m.ClearLocation()
-		return nil
-	case event.FieldVenue:
-		m.ClearVenue()
-		return nil
-	case event.FieldThumbnailURL:
-		m.ClearThumbnailURL()
-		return nil
+// AddOwnedOrganizationIDs adds the "owned_organizations" edge to the Organization entity by ids.
+func (m *UserMutation) AddOwnedOrganizationIDs(ids ...uuid.UUID) {
+	if m.owned_organizations == nil {
+		m.owned_organizations = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.owned_organizations[ids[i]] = struct{}{}
 	}
-	return fmt.Errorf("unknown Event nullable field %s", name)
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *EventMutation) ResetField(name string) error {
-	switch name {
-	case event.FieldOrganizationID:
-		m.ResetOrganizationID()
-		return nil
-	case event.FieldTitle:
-		m.ResetTitle()
-		return nil
-	case event.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case event.FieldLocation:
-		m.ResetLocation()
-		return nil
-	case event.FieldVenue:
-		m.ResetVenue()
-		return nil
-	case event.FieldStartTime:
-		m.ResetStartTime()
-		return nil
-	case event.FieldEndTime:
-		m.ResetEndTime()
-		return nil
-	case event.FieldTotalTickets:
-		m.ResetTotalTickets()
-		return nil
-	case event.FieldAvailableTickets:
-		m.ResetAvailableTickets()
-		return nil
-	case event.FieldParticipantCount:
-		m.ResetParticipantCount()
-		return nil
-	case event.FieldTicketPrice:
-		m.ResetTicketPrice()
-		return nil
-	case event.FieldCurrency:
-		m.ResetCurrency()
-		return nil
-	case event.FieldThumbnailURL:
-		m.ResetThumbnailURL()
-		return nil
-	case event.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case event.FieldIsPublic:
-		m.ResetIsPublic()
-		return nil
-	case event.FieldCreatedBy:
-		m.ResetCreatedBy()
-		return nil
-	case event.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case event.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Event field %s", name)
+// ClearOwnedOrganizations clears the "owned_organizations" edge to the Organization entity.
+func (m *UserMutation) ClearOwnedOrganizations() {
+	m.clearedowned_organizations = true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *EventMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.organization != nil {
-		edges = append(edges, event.EdgeOrganization)
+// OwnedOrganizationsCleared reports if the "owned_organizations" edge to the Organization entity was cleared.
+func (m *UserMutation) OwnedOrganizationsCleared() bool {
+	return m.clearedowned_organizations
+}
+
+// RemoveOwnedOrganizationIDs removes the "owned_organizations" edge to the Organization entity by IDs.
+func (m *UserMutation) RemoveOwnedOrganizationIDs(ids ...uuid.UUID) {
+	if m.removedowned_organizations == nil {
+		m.removedowned_organizations = make(map[uuid.UUID]struct{})
 	}
-	if m.creator != nil {
-		edges = append(edges, event.EdgeCreator)
+	for i := range ids {
+		delete(m.owned_organizations, ids[i])
+		m.removedowned_organizations[ids[i]] = struct{}{}
 	}
-	if m.payments != nil {
-		edges = append(edges, event.EdgePayments)
+}
+
+// RemovedOwnedOrganizations returns the removed IDs of the "owned_organizations" edge to the Organization entity.
+func (m *UserMutation) RemovedOwnedOrganizationsIDs() (ids []uuid.UUID) {
+	for id := range m.removedowned_organizations {
+		ids = append(ids, id)
 	}
-	return edges
+	return
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *EventMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case event.EdgeOrganization:
-		if id := m.organization; id != nil {
-			return []ent.Value{*id}
-		}
-	case event.EdgeCreator:
-		if id := m.creator; id != nil {
-			return []ent.Value{*id}
-		}
-	case event.EdgePayments:
-		ids := make([]ent.Value, 0, len(m.payments))
-		for id := range m.payments {
-			ids = append(ids, id)
-		}
-		return ids
+// OwnedOrganizationsIDs returns the "owned_organizations" edge IDs in the mutation.
+func (m *UserMutation) OwnedOrganizationsIDs() (ids []uuid.UUID) {
+	for id := range m.owned_organizations {
+		ids = append(ids, id)
 	}
-	return nil
+	return
+}
+
+// ResetOwnedOrganizations resets all changes to the "owned_organizations" edge.
+func (m *UserMutation) ResetOwnedOrganizations() {
+	m.owned_organizations = nil
+	m.clearedowned_organizations = false
+	m.removedowned_organizations = nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *EventMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedpayments != nil {
-		edges = append(edges, event.EdgePayments)
+// AddMembershipIDs adds the "memberships" edge to the OrganizationMember entity by ids.
+func (m *UserMutation) AddMembershipIDs(ids ...uuid.UUID) {
+	if m.memberships == nil {
+		m.memberships = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.memberships[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *EventMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case event.EdgePayments:
-		ids := make([]ent.Value, 0, len(m.removedpayments))
-		for id := range m.removedpayments {
-			ids = append(ids, id)
-		}
-		return ids
-	}
-	return nil
+// ClearMemberships clears the "memberships" edge to the OrganizationMember entity.
+func (m *UserMutation) ClearMemberships() {
+	m.clearedmemberships = true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *EventMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedorganization {
-		edges = append(edges, event.EdgeOrganization)
-	}
-	if m.clearedcreator {
-		edges = append(edges, event.EdgeCreator)
+// MembershipsCleared reports if the "memberships" edge to the OrganizationMember entity was cleared.
+func (m *UserMutation) MembershipsCleared() bool {
+	return m.clearedmemberships
+}
+
+// RemoveMembershipIDs removes the "memberships" edge to the OrganizationMember entity by IDs.
+func (m *UserMutation) RemoveMembershipIDs(ids ...uuid.UUID) {
+	if m.removedmemberships == nil {
+		m.removedmemberships = make(map[uuid.UUID]struct{})
 	}
-	if m.clearedpayments {
-		edges = append(edges, event.EdgePayments)
+	for i := range ids {
+		delete(m.memberships, ids[i])
+		m.removedmemberships[ids[i]] = struct{}{}
 	}
-	return edges
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *EventMutation) EdgeCleared(name string) bool {
-	switch name {
-	case event.EdgeOrganization:
-		return m.clearedorganization
-	case event.EdgeCreator:
-		return m.clearedcreator
-	case event.EdgePayments:
-		return m.clearedpayments
+// RemovedMemberships returns the removed IDs of the "memberships" edge to the OrganizationMember entity.
+func (m *UserMutation) RemovedMembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.removedmemberships {
+		ids = append(ids, id)
 	}
-	return false
+	return
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *EventMutation) ClearEdge(name string) error {
-	switch name {
-	case event.EdgeOrganization:
-		m.ClearOrganization()
-		return nil
-	case event.EdgeCreator:
-		m.ClearCreator()
-		return nil
+// MembershipsIDs returns the "memberships" edge IDs in the mutation.
+func (m *UserMutation) MembershipsIDs() (ids []uuid.UUID) {
+	for id := range m.memberships {
+		ids = append(ids, id)
 	}
-	return fmt.Errorf("unknown Event unique edge %s", name)
+	return
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *EventMutation) ResetEdge(name string) error {
-	switch name {
-	case event.EdgeOrganization:
-		m.ResetOrganization()
-		return nil
-	case event.EdgeCreator:
-		m.ResetCreator()
-		return nil
-	case event.EdgePayments:
-		m.ResetPayments()
-		return nil
-	}
-	return fmt.Errorf("unknown Event edge %s", name)
+// ResetMemberships resets all changes to the "memberships" edge.
+func (m *UserMutation) ResetMemberships() {
+	m.memberships = nil
+	m.clearedmemberships = false
+	m.removedmemberships = nil
 }
 
-// OrganizationMutation represents an operation that mutates the Organization nodes in the graph.
-type OrganizationMutation struct {
-	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	name           *string
-	description    *string
-	logo_url       *string
-	category       *string
-	is_active      *bool
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	members        map[uuid.UUID]struct{}
-	removedmembers map[uuid.UUID]struct{}
-	clearedmembers bool
-	events         map[uuid.UUID]struct{}
-	removedevents  map[uuid.UUID]struct{}
-	clearedevents  bool
-	owner          *uuid.UUID
-	clearedowner   bool
-	done           bool
-	oldValue       func(context.Context) (*Organization, error)
-	predicates     []predicate.Organization
+// AddCreatedEventIDs adds the "created_events" edge to the Event entity by ids.
+func (m *UserMutation) AddCreatedEventIDs(ids ...uuid.UUID) {
+	if m.created_events == nil {
+		m.created_events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.created_events[ids[i]] = struct{}{}
+	}
 }
 
-var _ ent.Mutation = (*OrganizationMutation)(nil)
+// ClearCreatedEvents clears the "created_events" edge to the Event entity.
+func (m *UserMutation) ClearCreatedEvents() {
+	m.clearedcreated_events = true
+}
 
-// organizationOption allows management of the mutation configuration using functional options.
-type organizationOption func(*OrganizationMutation)
+// CreatedEventsCleared reports if the "created_events" edge to the Event entity was cleared.
+func (m *UserMutation) CreatedEventsCleared() bool {
+	return m.clearedcreated_events
+}
 
-// newOrganizationMutation creates new mutation for the Organization entity.
-func newOrganizationMutation(c config, op Op, opts ...organizationOption) *OrganizationMutation {
-	m := &OrganizationMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeOrganization,
-		clearedFields: make(map[string]struct{}),
+// RemoveCreatedEventIDs removes the "created_events" edge to the Event entity by IDs.
+func (m *UserMutation) RemoveCreatedEventIDs(ids ...uuid.UUID) {
+	if m.removedcreated_events == nil {
+		m.removedcreated_events = make(map[uuid.UUID]struct{})
 	}
-	for _, opt := range opts {
-		opt(m)
+	for i := range ids {
+		delete(m.created_events, ids[i])
+		m.removedcreated_events[ids[i]] = struct{}{}
 	}
-	return m
 }
 
-// withOrganizationID sets the ID field of the mutation.
-func withOrganizationID(id uuid.UUID) organizationOption {
-	return func(m *OrganizationMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Organization
-		)
-		m.oldValue = func(ctx context.Context) (*Organization, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Organization.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// RemovedCreatedEvents returns the removed IDs of the "created_events" edge to the Event entity.
+func (m *UserMutation) RemovedCreatedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedcreated_events {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// withOrganization sets the old Organization of the mutation.
-func withOrganization(node *Organization) organizationOption {
-	return func(m *OrganizationMutation) {
-		m.oldValue = func(context.Context) (*Organization, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// CreatedEventsIDs returns the "created_events" edge IDs in the mutation.
+func (m *UserMutation) CreatedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.created_events {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrganizationMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetCreatedEvents resets all changes to the "created_events" edge.
+func (m *UserMutation) ResetCreatedEvents() {
+	m.created_events = nil
+	m.clearedcreated_events = false
+	m.removedcreated_events = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m OrganizationMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// AddPaymentIDs adds the "payments" edge to the Payment entity by ids.
+func (m *UserMutation) AddPaymentIDs(ids ...uuid.UUID) {
+	if m.payments == nil {
+		m.payments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.payments[ids[i]] = struct{}{}
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Organization entities.
-func (m *OrganizationMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearPayments clears the "payments" edge to the Payment entity.
+func (m *UserMutation) ClearPayments() {
+	m.clearedpayments = true
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *OrganizationMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
+// PaymentsCleared reports if the "payments" edge to the Payment entity was cleared.
+func (m *UserMutation) PaymentsCleared() bool {
+	return m.clearedpayments
+}
+
+// RemovePaymentIDs removes the "payments" edge to the Payment entity by IDs.
+func (m *UserMutation) RemovePaymentIDs(ids ...uuid.UUID) {
+	if m.removedpayments == nil {
+		m.removedpayments = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.payments, ids[i])
+		m.removedpayments[ids[i]] = struct{}{}
 	}
-	return *m.id, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *OrganizationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Organization.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// RemovedPayments returns the removed IDs of the "payments" edge to the Payment entity.
+func (m *UserMutation) RemovedPaymentsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpayments {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PaymentsIDs returns the "payments" edge IDs in the mutation.
+func (m *UserMutation) PaymentsIDs() (ids []uuid.UUID) {
+	for id := range m.payments {
+		ids = append(ids, id)
 	}
+	return
 }
 
-// SetName sets the "name" field.
-func (m *OrganizationMutation) SetName(s string) {
-	m.name = &s
+// ResetPayments resets all changes to the "payments" edge.
+func (m *UserMutation) ResetPayments() {
+	m.payments = nil
+	m.clearedpayments = false
+	m.removedpayments = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *OrganizationMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldName returns the old "name" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.Name, nil
+	m.Where(p...)
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *OrganizationMutation) ResetName() {
-	m.name = nil
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
 }
 
-// SetDescription sets the "description" field.
-func (m *OrganizationMutation) SetDescription(s string) {
-	m.description = &s
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *OrganizationMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
 }
 
-// OldDescription returns the old "description" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldDescription(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.first_name != nil {
+		fields = append(fields, user.FieldFirstName)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+	if m.last_name != nil {
+		fields = append(fields, user.FieldLastName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	if m.nick_name != nil {
+		fields = append(fields, user.FieldNickName)
 	}
-	return oldValue.Description, nil
+	if m.birthday != nil {
+		fields = append(fields, user.FieldBirthday)
+	}
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password != nil {
+		fields = append(fields, user.FieldPassword)
+	}
+	if m.phone_number != nil {
+		fields = append(fields, user.FieldPhoneNumber)
+	}
+	if m.is_valid != nil {
+		fields = append(fields, user.FieldIsValid)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *OrganizationMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[organization.FieldDescription] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldFirstName:
+		return m.FirstName()
+	case user.FieldLastName:
+		return m.LastName()
+	case user.FieldNickName:
+		return m.NickName()
+	case user.FieldBirthday:
+		return m.Birthday()
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPassword:
+		return m.Password()
+	case user.FieldPhoneNumber:
+		return m.PhoneNumber()
+	case user.FieldIsValid:
+		return m.IsValid()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *OrganizationMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[organization.FieldDescription]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldFirstName:
+		return m.OldFirstName(ctx)
+	case user.FieldLastName:
+		return m.OldLastName(ctx)
+	case user.FieldNickName:
+		return m.OldNickName(ctx)
+	case user.FieldBirthday:
+		return m.OldBirthday(ctx)
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPassword:
+		return m.OldPassword(ctx)
+	case user.FieldPhoneNumber:
+		return m.OldPhoneNumber(ctx)
+	case user.FieldIsValid:
+		return m.OldIsValid(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *OrganizationMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, organization.FieldDescription)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldFirstName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFirstName(v)
+		return nil
+	case user.FieldLastName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastName(v)
+		return nil
+	case user.FieldNickName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNickName(v)
+		return nil
+	case user.FieldBirthday:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBirthday(v)
+		return nil
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPassword:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPassword(v)
+		return nil
+	case user.FieldPhoneNumber:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPhoneNumber(v)
+		return nil
+	case user.FieldIsValid:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsValid(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
 }
-
-// SetLogoURL sets the "logo_url" field.
-func (m *OrganizationMutation) SetLogoURL(s string) {
-	m.logo_url = &s
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
 }
 
-// LogoURL returns the value of the "logo_url" field in the mutation.
-func (m *OrganizationMutation) LogoURL() (r string, exists bool) {
-	v := m.logo_url
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// OldLogoURL returns the old "logo_url" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldLogoURL(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLogoURL is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLogoURL requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLogoURL: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
 	}
-	return oldValue.LogoURL, nil
+	return fmt.Errorf("unknown User numeric field %s", name)
 }
 
-// ClearLogoURL clears the value of the "logo_url" field.
-func (m *OrganizationMutation) ClearLogoURL() {
-	m.logo_url = nil
-	m.clearedFields[organization.FieldLogoURL] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	return nil
 }
 
-// LogoURLCleared returns if the "logo_url" field was cleared in this mutation.
-func (m *OrganizationMutation) LogoURLCleared() bool {
-	_, ok := m.clearedFields[organization.FieldLogoURL]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetLogoURL resets all changes to the "logo_url" field.
-func (m *OrganizationMutation) ResetLogoURL() {
-	m.logo_url = nil
-	delete(m.clearedFields, organization.FieldLogoURL)
-}
-
-// SetCategory sets the "category" field.
-func (m *OrganizationMutation) SetCategory(s string) {
-	m.category = &s
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown User nullable field %s", name)
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *OrganizationMutation) Category() (r string, exists bool) {
-	v := m.category
-	if v == nil {
-		return
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldFirstName:
+		m.ResetFirstName()
+		return nil
+	case user.FieldLastName:
+		m.ResetLastName()
+		return nil
+	case user.FieldNickName:
+		m.ResetNickName()
+		return nil
+	case user.FieldBirthday:
+		m.ResetBirthday()
+		return nil
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPassword:
+		m.ResetPassword()
+		return nil
+	case user.FieldPhoneNumber:
+		m.ResetPhoneNumber()
+		return nil
+	case user.FieldIsValid:
+		m.ResetIsValid()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown User field %s", name)
 }
 
-// OldCategory returns the old "category" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldCategory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.owned_organizations != nil {
+		edges = append(edges, user.EdgeOwnedOrganizations)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+	if m.memberships != nil {
+		edges = append(edges, user.EdgeMemberships)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	if m.created_events != nil {
+		edges = append(edges, user.EdgeCreatedEvents)
 	}
-	return oldValue.Category, nil
-}
-
-// ClearCategory clears the value of the "category" field.
-func (m *OrganizationMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[organization.FieldCategory] = struct{}{}
-}
-
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *OrganizationMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[organization.FieldCategory]
-	return ok
-}
-
-// ResetCategory resets all changes to the "category" field.
-func (m *OrganizationMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, organization.FieldCategory)
-}
-
-// SetOwnerID sets the "owner_id" field.
-func (m *OrganizationMutation) SetOwnerID(u uuid.UUID) {
-	m.owner = &u
+	if m.payments != nil {
+		edges = append(edges, user.EdgePayments)
+	}
+	return edges
 }
 
-// OwnerID returns the value of the "owner_id" field in the mutation.
-func (m *OrganizationMutation) OwnerID() (r uuid.UUID, exists bool) {
-	v := m.owner
-	if v == nil {
-		return
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeOwnedOrganizations:
+		ids := make([]ent.Value, 0, len(m.owned_organizations))
+		for id := range m.owned_organizations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.memberships))
+		for id := range m.memberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedEvents:
+		ids := make([]ent.Value, 0, len(m.created_events))
+		for id := range m.created_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.payments))
+		for id := range m.payments {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldOwnerID returns the old "owner_id" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldOwnerID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOwnerID is only allowed on UpdateOne operations")
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.removedowned_organizations != nil {
+		edges = append(edges, user.EdgeOwnedOrganizations)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOwnerID requires an ID field in the mutation")
+	if m.removedmemberships != nil {
+		edges = append(edges, user.EdgeMemberships)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOwnerID: %w", err)
+	if m.removedcreated_events != nil {
+		edges = append(edges, user.EdgeCreatedEvents)
 	}
-	return oldValue.OwnerID, nil
-}
-
-// ResetOwnerID resets all changes to the "owner_id" field.
-func (m *OrganizationMutation) ResetOwnerID() {
-	m.owner = nil
-}
-
-// SetIsActive sets the "is_active" field.
-func (m *OrganizationMutation) SetIsActive(b bool) {
-	m.is_active = &b
+	if m.removedpayments != nil {
+		edges = append(edges, user.EdgePayments)
+	}
+	return edges
 }
 
-// IsActive returns the value of the "is_active" field in the mutation.
-func (m *OrganizationMutation) IsActive() (r bool, exists bool) {
-	v := m.is_active
-	if v == nil {
-		return
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeOwnedOrganizations:
+		ids := make([]ent.Value, 0, len(m.removedowned_organizations))
+		for id := range m.removedowned_organizations {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeMemberships:
+		ids := make([]ent.Value, 0, len(m.removedmemberships))
+		for id := range m.removedmemberships {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeCreatedEvents:
+		ids := make([]ent.Value, 0, len(m.removedcreated_events))
+		for id := range m.removedcreated_events {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePayments:
+		ids := make([]ent.Value, 0, len(m.removedpayments))
+		for id := range m.removedpayments {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	return *v, true
+	return nil
 }
 
-// OldIsActive returns the old "is_active" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldIsActive(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 4)
+	if m.clearedowned_organizations {
+		edges = append(edges, user.EdgeOwnedOrganizations)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsActive requires an ID field in the mutation")
+	if m.clearedmemberships {
+		edges = append(edges, user.EdgeMemberships)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
+	if m.clearedcreated_events {
+		edges = append(edges, user.EdgeCreatedEvents)
 	}
-	return oldValue.IsActive, nil
-}
-
-// ResetIsActive resets all changes to the "is_active" field.
-func (m *OrganizationMutation) ResetIsActive() {
-	m.is_active = nil
+	if m.clearedpayments {
+		edges = append(edges, user.EdgePayments)
+	}
+	return edges
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *OrganizationMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeOwnedOrganizations:
+		return m.clearedowned_organizations
+	case user.EdgeMemberships:
+		return m.clearedmemberships
+	case user.EdgeCreatedEvents:
+		return m.clearedcreated_events
+	case user.EdgePayments:
+		return m.clearedpayments
+	}
+	return false
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OrganizationMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
 	}
-	return *v, true
+	return fmt.Errorf("unknown User unique edge %s", name)
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeOwnedOrganizations:
+		m.ResetOwnedOrganizations()
+		return nil
+	case user.EdgeMemberships:
+		m.ResetMemberships()
+		return nil
+	case user.EdgeCreatedEvents:
+		m.ResetCreatedEvents()
+		return nil
+	case user.EdgePayments:
+		m.ResetPayments()
+		return nil
 	}
-	return oldValue.CreatedAt, nil
+	return fmt.Errorf("unknown User edge %s", name)
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OrganizationMutation) ResetCreatedAt() {
-	m.created_at = nil
+// VenueMutation represents an operation that mutates the Venue nodes in the graph.
+type VenueMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	name                *string
+	address_street      *string
+	address_city        *string
+	address_region      *string
+	address_postal_code *string
+	address_country     *string
+	latitude            *float64
+	addlatitude         *float64
+	longitude           *float64
+	addlongitude        *float64
+	capacity            *int
+	addcapacity         *int
+	seating_layouts     *string
+	directions          *string
+	parking             *string
+	accessibility       *string
+	created_by          *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	events              map[uuid.UUID]struct{}
+	removedevents       map[uuid.UUID]struct{}
+	clearedevents       bool
+	event_series        map[uuid.UUID]struct{}
+	removedevent_series map[uuid.UUID]struct{}
+	clearedevent_series bool
+	done                bool
+	oldValue            func(context.Context) (*Venue, error)
+	predicates          []predicate.Venue
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *OrganizationMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
-}
+var _ ent.Mutation = (*VenueMutation)(nil)
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OrganizationMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+// venueOption allows management of the mutation configuration using functional options.
+type venueOption func(*VenueMutation)
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Organization entity.
-// If the Organization object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+// newVenueMutation creates new mutation for the Venue entity.
+func newVenueMutation(c config, op Op, opts ...venueOption) *VenueMutation {
+	m := &VenueMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeVenue,
+		clearedFields: make(map[string]struct{}),
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	for _, opt := range opts {
+		opt(m)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OrganizationMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+	return m
 }
 
-// AddMemberIDs adds the "members" edge to the OrganizationMember entity by ids.
-func (m *OrganizationMutation) AddMemberIDs(ids ...uuid.UUID) {
-	if m.members == nil {
-		m.members = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.members[ids[i]] = struct{}{}
+// withVenueID sets the ID field of the mutation.
+func withVenueID(id uuid.UUID) venueOption {
+	return func(m *VenueMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Venue
+		)
+		m.oldValue = func(ctx context.Context) (*Venue, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Venue.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
 }
 
-// ClearMembers clears the "members" edge to the OrganizationMember entity.
-func (m *OrganizationMutation) ClearMembers() {
-	m.clearedmembers = true
-}
-
-// MembersCleared reports if the "members" edge to the OrganizationMember entity was cleared.
-func (m *OrganizationMutation) MembersCleared() bool {
-	return m.clearedmembers
-}
-
-// RemoveMemberIDs removes the "members" edge to the OrganizationMember entity by IDs.
-func (m *OrganizationMutation) RemoveMemberIDs(ids ...uuid.UUID) {
-	if m.removedmembers == nil {
-		m.removedmembers = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.members, ids[i])
-		m.removedmembers[ids[i]] = struct{}{}
+// withVenue sets the old Venue of the mutation.
+func withVenue(node *Venue) venueOption {
+	return func(m *VenueMutation) {
+		m.oldValue = func(context.Context) (*Venue, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
 }
 
-// RemovedMembers returns the removed IDs of the "members" edge to the OrganizationMember entity.
-func (m *OrganizationMutation) RemovedMembersIDs() (ids []uuid.UUID) {
-	for id := range m.removedmembers {
-		ids = append(ids, id)
-	}
-	return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m VenueMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// MembersIDs returns the "members" edge IDs in the mutation.
-func (m *OrganizationMutation) MembersIDs() (ids []uuid.UUID) {
-	for id := range m.members {
-		ids = append(ids, id)
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m VenueMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ResetMembers resets all changes to the "members" edge.
-func (m *OrganizationMutation) ResetMembers() {
-	m.members = nil
-	m.clearedmembers = false
-	m.removedmembers = nil
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Venue entities.
+func (m *VenueMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// AddEventIDs adds the "events" edge to the Event entity by ids.
-func (m *OrganizationMutation) AddEventIDs(ids ...uuid.UUID) {
-	if m.events == nil {
-		m.events = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.events[ids[i]] = struct{}{}
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *VenueMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
+	return *m.id, true
 }
 
-// ClearEvents clears the "events" edge to the Event entity.
-func (m *OrganizationMutation) ClearEvents() {
-	m.clearedevents = true
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *VenueMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Venue.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// EventsCleared reports if the "events" edge to the Event entity was cleared.
-func (m *OrganizationMutation) EventsCleared() bool {
-	return m.clearedevents
+// SetOrganizationID sets the "organization_id" field.
+func (m *VenueMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
 }
 
-// RemoveEventIDs removes the "events" edge to the Event entity by IDs.
-func (m *OrganizationMutation) RemoveEventIDs(ids ...uuid.UUID) {
-	if m.removedevents == nil {
-		m.removedevents = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.events, ids[i])
-		m.removedevents[ids[i]] = struct{}{}
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *VenueMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedEvents returns the removed IDs of the "events" edge to the Event entity.
-func (m *OrganizationMutation) RemovedEventsIDs() (ids []uuid.UUID) {
-	for id := range m.removedevents {
-		ids = append(ids, id)
+// OldOrganizationID returns the old "organization_id" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldOrganizationID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+	}
+	return oldValue.OrganizationID, nil
 }
 
-// EventsIDs returns the "events" edge IDs in the mutation.
-func (m *OrganizationMutation) EventsIDs() (ids []uuid.UUID) {
-	for id := range m.events {
-		ids = append(ids, id)
-	}
-	return
+// ClearOrganizationID clears the value of the "organization_id" field.
+func (m *VenueMutation) ClearOrganizationID() {
+	m.organization = nil
+	m.clearedFields[venue.FieldOrganizationID] = struct{}{}
 }
 
-// ResetEvents resets all changes to the "events" edge.
-func (m *OrganizationMutation) ResetEvents() {
-	m.events = nil
-	m.clearedevents = false
-	m.removedevents = nil
+// OrganizationIDCleared returns if the "organization_id" field was cleared in this mutation.
+func (m *VenueMutation) OrganizationIDCleared() bool {
+	_, ok := m.clearedFields[venue.FieldOrganizationID]
+	return ok
 }
 
-// ClearOwner clears the "owner" edge to the User entity.
-func (m *OrganizationMutation) ClearOwner() {
-	m.clearedowner = true
-	m.clearedFields[organization.FieldOwnerID] = struct{}{}
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *VenueMutation) ResetOrganizationID() {
+	m.organization = nil
+	delete(m.clearedFields, venue.FieldOrganizationID)
 }
 
-// OwnerCleared reports if the "owner" edge to the User entity was cleared.
-func (m *OrganizationMutation) OwnerCleared() bool {
-	return m.clearedowner
+// SetName sets the "name" field.
+func (m *VenueMutation) SetName(s string) {
+	m.name = &s
 }
 
-// OwnerIDs returns the "owner" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OwnerID instead. It exists only for internal usage by the builders.
-func (m *OrganizationMutation) OwnerIDs() (ids []uuid.UUID) {
-	if id := m.owner; id != nil {
-		ids = append(ids, *id)
+// Name returns the value of the "name" field in the mutation.
+func (m *VenueMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetOwner resets all changes to the "owner" edge.
-func (m *OrganizationMutation) ResetOwner() {
-	m.owner = nil
-	m.clearedowner = false
+// OldName returns the old "name" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// Where appends a list predicates to the OrganizationMutation builder.
-func (m *OrganizationMutation) Where(ps ...predicate.Organization) {
-	m.predicates = append(m.predicates, ps...)
+// ResetName resets all changes to the "name" field.
+func (m *VenueMutation) ResetName() {
+	m.name = nil
 }
 
-// WhereP appends storage-level predicates to the OrganizationMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrganizationMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Organization, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// SetAddressStreet sets the "address_street" field.
+func (m *VenueMutation) SetAddressStreet(s string) {
+	m.address_street = &s
+}
+
+// AddressStreet returns the value of the "address_street" field in the mutation.
+func (m *VenueMutation) AddressStreet() (r string, exists bool) {
+	v := m.address_street
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *OrganizationMutation) Op() Op {
-	return m.op
+// OldAddressStreet returns the old "address_street" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldAddressStreet(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressStreet is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressStreet requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressStreet: %w", err)
+	}
+	return oldValue.AddressStreet, nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *OrganizationMutation) SetOp(op Op) {
-	m.op = op
+// ResetAddressStreet resets all changes to the "address_street" field.
+func (m *VenueMutation) ResetAddressStreet() {
+	m.address_street = nil
 }
 
-// Type returns the node type of this mutation (Organization).
-func (m *OrganizationMutation) Type() string {
-	return m.typ
+// SetAddressCity sets the "address_city" field.
+func (m *VenueMutation) SetAddressCity(s string) {
+	m.address_city = &s
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *OrganizationMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, organization.FieldName)
-	}
-	if m.description != nil {
-		fields = append(fields, organization.FieldDescription)
-	}
-	if m.logo_url != nil {
-		fields = append(fields, organization.FieldLogoURL)
-	}
-	if m.category != nil {
-		fields = append(fields, organization.FieldCategory)
-	}
-	if m.owner != nil {
-		fields = append(fields, organization.FieldOwnerID)
+// AddressCity returns the value of the "address_city" field in the mutation.
+func (m *VenueMutation) AddressCity() (r string, exists bool) {
+	v := m.address_city
+	if v == nil {
+		return
 	}
-	if m.is_active != nil {
-		fields = append(fields, organization.FieldIsActive)
+	return *v, true
+}
+
+// OldAddressCity returns the old "address_city" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldAddressCity(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressCity is only allowed on UpdateOne operations")
 	}
-	if m.created_at != nil {
-		fields = append(fields, organization.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressCity requires an ID field in the mutation")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, organization.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressCity: %w", err)
 	}
-	return fields
+	return oldValue.AddressCity, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *OrganizationMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case organization.FieldName:
-		return m.Name()
-	case organization.FieldDescription:
-		return m.Description()
-	case organization.FieldLogoURL:
-		return m.LogoURL()
-	case organization.FieldCategory:
-		return m.Category()
-	case organization.FieldOwnerID:
-		return m.OwnerID()
-	case organization.FieldIsActive:
-		return m.IsActive()
-	case organization.FieldCreatedAt:
-		return m.CreatedAt()
-	case organization.FieldUpdatedAt:
-		return m.UpdatedAt()
-	}
-	return nil, false
+// ResetAddressCity resets all changes to the "address_city" field.
+func (m *VenueMutation) ResetAddressCity() {
+	m.address_city = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *OrganizationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case organization.FieldName:
-		return m.OldName(ctx)
-	case organization.FieldDescription:
-		return m.OldDescription(ctx)
-	case organization.FieldLogoURL:
-		return m.OldLogoURL(ctx)
-	case organization.FieldCategory:
-		return m.OldCategory(ctx)
-	case organization.FieldOwnerID:
-		return m.OldOwnerID(ctx)
-	case organization.FieldIsActive:
-		return m.OldIsActive(ctx)
-	case organization.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case organization.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// SetAddressRegion sets the "address_region" field.
+func (m *VenueMutation) SetAddressRegion(s string) {
+	m.address_region = &s
+}
+
+// AddressRegion returns the value of the "address_region" field in the mutation.
+func (m *VenueMutation) AddressRegion() (r string, exists bool) {
+	v := m.address_region
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown Organization field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OrganizationMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case organization.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case organization.FieldDescription:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetDescription(v)
-		return nil
-	case organization.FieldLogoURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLogoURL(v)
-		return nil
-	case organization.FieldCategory:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCategory(v)
-		return nil
-	case organization.FieldOwnerID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetOwnerID(v)
-		return nil
-	case organization.FieldIsActive:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsActive(v)
-		return nil
-	case organization.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case organization.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// OldAddressRegion returns the old "address_region" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldAddressRegion(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressRegion is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressRegion requires an ID field in the mutation")
 	}
-	return fmt.Errorf("unknown Organization field %s", name)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressRegion: %w", err)
+	}
+	return oldValue.AddressRegion, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *OrganizationMutation) AddedFields() []string {
-	return nil
+// ResetAddressRegion resets all changes to the "address_region" field.
+func (m *VenueMutation) ResetAddressRegion() {
+	m.address_region = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *OrganizationMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (m *VenueMutation) SetAddressPostalCode(s string) {
+	m.address_postal_code = &s
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *OrganizationMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// AddressPostalCode returns the value of the "address_postal_code" field in the mutation.
+func (m *VenueMutation) AddressPostalCode() (r string, exists bool) {
+	v := m.address_postal_code
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Organization numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *OrganizationMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(organization.FieldDescription) {
-		fields = append(fields, organization.FieldDescription)
+// OldAddressPostalCode returns the old "address_postal_code" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldAddressPostalCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressPostalCode is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(organization.FieldLogoURL) {
-		fields = append(fields, organization.FieldLogoURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressPostalCode requires an ID field in the mutation")
 	}
-	if m.FieldCleared(organization.FieldCategory) {
-		fields = append(fields, organization.FieldCategory)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressPostalCode: %w", err)
 	}
-	return fields
+	return oldValue.AddressPostalCode, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *OrganizationMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetAddressPostalCode resets all changes to the "address_postal_code" field.
+func (m *VenueMutation) ResetAddressPostalCode() {
+	m.address_postal_code = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *OrganizationMutation) ClearField(name string) error {
-	switch name {
-	case organization.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case organization.FieldLogoURL:
-		m.ClearLogoURL()
-		return nil
-	case organization.FieldCategory:
-		m.ClearCategory()
-		return nil
-	}
-	return fmt.Errorf("unknown Organization nullable field %s", name)
+// SetAddressCountry sets the "address_country" field.
+func (m *VenueMutation) SetAddressCountry(s string) {
+	m.address_country = &s
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *OrganizationMutation) ResetField(name string) error {
-	switch name {
-	case organization.FieldName:
-		m.ResetName()
-		return nil
-	case organization.FieldDescription:
-		m.ResetDescription()
-		return nil
-	case organization.FieldLogoURL:
-		m.ResetLogoURL()
-		return nil
-	case organization.FieldCategory:
-		m.ResetCategory()
-		return nil
-	case organization.FieldOwnerID:
-		m.ResetOwnerID()
-		return nil
-	case organization.FieldIsActive:
-		m.ResetIsActive()
-		return nil
-	case organization.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case organization.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// AddressCountry returns the value of the "address_country" field in the mutation.
+func (m *VenueMutation) AddressCountry() (r string, exists bool) {
+	v := m.address_country
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Organization field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrganizationMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.members != nil {
-		edges = append(edges, organization.EdgeMembers)
+// OldAddressCountry returns the old "address_country" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldAddressCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAddressCountry is only allowed on UpdateOne operations")
 	}
-	if m.events != nil {
-		edges = append(edges, organization.EdgeEvents)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAddressCountry requires an ID field in the mutation")
 	}
-	if m.owner != nil {
-		edges = append(edges, organization.EdgeOwner)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAddressCountry: %w", err)
 	}
-	return edges
+	return oldValue.AddressCountry, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *OrganizationMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case organization.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.members))
-		for id := range m.members {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeEvents:
-		ids := make([]ent.Value, 0, len(m.events))
-		for id := range m.events {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeOwner:
-		if id := m.owner; id != nil {
-			return []ent.Value{*id}
-		}
+// ResetAddressCountry resets all changes to the "address_country" field.
+func (m *VenueMutation) ResetAddressCountry() {
+	m.address_country = nil
+}
+
+// SetLatitude sets the "latitude" field.
+func (m *VenueMutation) SetLatitude(f float64) {
+	m.latitude = &f
+	m.addlatitude = nil
+}
+
+// Latitude returns the value of the "latitude" field in the mutation.
+func (m *VenueMutation) Latitude() (r float64, exists bool) {
+	v := m.latitude
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrganizationMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedmembers != nil {
-		edges = append(edges, organization.EdgeMembers)
+// OldLatitude returns the old "latitude" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldLatitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatitude is only allowed on UpdateOne operations")
 	}
-	if m.removedevents != nil {
-		edges = append(edges, organization.EdgeEvents)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatitude requires an ID field in the mutation")
 	}
-	return edges
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatitude: %w", err)
+	}
+	return oldValue.Latitude, nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *OrganizationMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case organization.EdgeMembers:
-		ids := make([]ent.Value, 0, len(m.removedmembers))
-		for id := range m.removedmembers {
-			ids = append(ids, id)
-		}
-		return ids
-	case organization.EdgeEvents:
-		ids := make([]ent.Value, 0, len(m.removedevents))
-		for id := range m.removedevents {
-			ids = append(ids, id)
-		}
-		return ids
+// AddLatitude adds f to the "latitude" field.
+func (m *VenueMutation) AddLatitude(f float64) {
+	if m.addlatitude != nil {
+		*m.addlatitude += f
+	} else {
+		m.addlatitude = &f
 	}
-	return nil
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrganizationMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedmembers {
-		edges = append(edges, organization.EdgeMembers)
-	}
-	if m.clearedevents {
-		edges = append(edges, organization.EdgeEvents)
+// AddedLatitude returns the value that was added to the "latitude" field in this mutation.
+func (m *VenueMutation) AddedLatitude() (r float64, exists bool) {
+	v := m.addlatitude
+	if v == nil {
+		return
 	}
-	if m.clearedowner {
-		edges = append(edges, organization.EdgeOwner)
+	return *v, true
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (m *VenueMutation) ClearLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	m.clearedFields[venue.FieldLatitude] = struct{}{}
+}
+
+// LatitudeCleared returns if the "latitude" field was cleared in this mutation.
+func (m *VenueMutation) LatitudeCleared() bool {
+	_, ok := m.clearedFields[venue.FieldLatitude]
+	return ok
+}
+
+// ResetLatitude resets all changes to the "latitude" field.
+func (m *VenueMutation) ResetLatitude() {
+	m.latitude = nil
+	m.addlatitude = nil
+	delete(m.clearedFields, venue.FieldLatitude)
+}
+
+// SetLongitude sets the "longitude" field.
+func (m *VenueMutation) SetLongitude(f float64) {
+	m.longitude = &f
+	m.addlongitude = nil
+}
+
+// Longitude returns the value of the "longitude" field in the mutation.
+func (m *VenueMutation) Longitude() (r float64, exists bool) {
+	v := m.longitude
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *OrganizationMutation) EdgeCleared(name string) bool {
-	switch name {
-	case organization.EdgeMembers:
-		return m.clearedmembers
-	case organization.EdgeEvents:
-		return m.clearedevents
-	case organization.EdgeOwner:
-		return m.clearedowner
+// OldLongitude returns the old "longitude" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldLongitude(ctx context.Context) (v *float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLongitude is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLongitude requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLongitude: %w", err)
+	}
+	return oldValue.Longitude, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *OrganizationMutation) ClearEdge(name string) error {
-	switch name {
-	case organization.EdgeOwner:
-		m.ClearOwner()
-		return nil
+// AddLongitude adds f to the "longitude" field.
+func (m *VenueMutation) AddLongitude(f float64) {
+	if m.addlongitude != nil {
+		*m.addlongitude += f
+	} else {
+		m.addlongitude = &f
 	}
-	return fmt.Errorf("unknown Organization unique edge %s", name)
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *OrganizationMutation) ResetEdge(name string) error {
-	switch name {
-	case organization.EdgeMembers:
-		m.ResetMembers()
-		return nil
-	case organization.EdgeEvents:
-		m.ResetEvents()
-		return nil
-	case organization.EdgeOwner:
-		m.ResetOwner()
-		return nil
+// AddedLongitude returns the value that was added to the "longitude" field in this mutation.
+func (m *VenueMutation) AddedLongitude() (r float64, exists bool) {
+	v := m.addlongitude
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Organization edge %s", name)
+	return *v, true
 }
 
-// OrganizationMemberMutation represents an operation that mutates the OrganizationMember nodes in the graph.
-type OrganizationMemberMutation struct {
-	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	role                *organizationmember.Role
-	joined_at           *time.Time
-	created_at          *time.Time
-	updated_at          *time.Time
-	clearedFields       map[string]struct{}
-	organization        *uuid.UUID
-	clearedorganization bool
-	user                *uuid.UUID
-	cleareduser         bool
-	done                bool
-	oldValue            func(context.Context) (*OrganizationMember, error)
-	predicates          []predicate.OrganizationMember
+// ClearLongitude clears the value of the "longitude" field.
+func (m *VenueMutation) ClearLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	m.clearedFields[venue.FieldLongitude] = struct{}{}
+}
+
+// LongitudeCleared returns if the "longitude" field was cleared in this mutation.
+func (m *VenueMutation) LongitudeCleared() bool {
+	_, ok := m.clearedFields[venue.FieldLongitude]
+	return ok
 }
 
-var _ ent.Mutation = (*OrganizationMemberMutation)(nil)
+// ResetLongitude resets all changes to the "longitude" field.
+func (m *VenueMutation) ResetLongitude() {
+	m.longitude = nil
+	m.addlongitude = nil
+	delete(m.clearedFields, venue.FieldLongitude)
+}
 
-// organizationmemberOption allows management of the mutation configuration using functional options.
-type organizationmemberOption func(*OrganizationMemberMutation)
+// SetCapacity sets the "capacity" field.
+func (m *VenueMutation) SetCapacity(i int) {
+	m.capacity = &i
+	m.addcapacity = nil
+}
 
-// newOrganizationMemberMutation creates new mutation for the OrganizationMember entity.
-func newOrganizationMemberMutation(c config, op Op, opts ...organizationmemberOption) *OrganizationMemberMutation {
-	m := &OrganizationMemberMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeOrganizationMember,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// Capacity returns the value of the "capacity" field in the mutation.
+func (m *VenueMutation) Capacity() (r int, exists bool) {
+	v := m.capacity
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withOrganizationMemberID sets the ID field of the mutation.
-func withOrganizationMemberID(id uuid.UUID) organizationmemberOption {
-	return func(m *OrganizationMemberMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *OrganizationMember
-		)
-		m.oldValue = func(ctx context.Context) (*OrganizationMember, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().OrganizationMember.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldCapacity returns the old "capacity" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldCapacity(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCapacity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCapacity requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCapacity: %w", err)
+	}
+	return oldValue.Capacity, nil
 }
 
-// withOrganizationMember sets the old OrganizationMember of the mutation.
-func withOrganizationMember(node *OrganizationMember) organizationmemberOption {
-	return func(m *OrganizationMemberMutation) {
-		m.oldValue = func(context.Context) (*OrganizationMember, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// AddCapacity adds i to the "capacity" field.
+func (m *VenueMutation) AddCapacity(i int) {
+	if m.addcapacity != nil {
+		*m.addcapacity += i
+	} else {
+		m.addcapacity = &i
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m OrganizationMemberMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// AddedCapacity returns the value that was added to the "capacity" field in this mutation.
+func (m *VenueMutation) AddedCapacity() (r int, exists bool) {
+	v := m.addcapacity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m OrganizationMemberMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetCapacity resets all changes to the "capacity" field.
+func (m *VenueMutation) ResetCapacity() {
+	m.capacity = nil
+	m.addcapacity = nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of OrganizationMember entities.
-func (m *OrganizationMemberMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// SetSeatingLayouts sets the "seating_layouts" field.
+func (m *VenueMutation) SetSeatingLayouts(s string) {
+	m.seating_layouts = &s
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *OrganizationMemberMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SeatingLayouts returns the value of the "seating_layouts" field in the mutation.
+func (m *VenueMutation) SeatingLayouts() (r string, exists bool) {
+	v := m.seating_layouts
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *OrganizationMemberMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().OrganizationMember.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldSeatingLayouts returns the old "seating_layouts" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *VenueMutation) OldSeatingLayouts(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSeatingLayouts is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSeatingLayouts requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSeatingLayouts: %w", err)
 	}
+	return oldValue.SeatingLayouts, nil
 }
 
-// SetOrganizationID sets the "organization_id" field.
-func (m *OrganizationMemberMutation) SetOrganizationID(u uuid.UUID) {
-	m.organization = &u
+// ClearSeatingLayouts clears the value of the "seating_layouts" field.
+func (m *VenueMutation) ClearSeatingLayouts() {
+	m.seating_layouts = nil
+	m.clearedFields[venue.FieldSeatingLayouts] = struct{}{}
 }
 
-// OrganizationID returns the value of the "organization_id" field in the mutation.
-func (m *OrganizationMemberMutation) OrganizationID() (r uuid.UUID, exists bool) {
-	v := m.organization
+// SeatingLayoutsCleared returns if the "seating_layouts" field was cleared in this mutation.
+func (m *VenueMutation) SeatingLayoutsCleared() bool {
+	_, ok := m.clearedFields[venue.FieldSeatingLayouts]
+	return ok
+}
+
+// ResetSeatingLayouts resets all changes to the "seating_layouts" field.
+func (m *VenueMutation) ResetSeatingLayouts() {
+	m.seating_layouts = nil
+	delete(m.clearedFields, venue.FieldSeatingLayouts)
+}
+
+// SetDirections sets the "directions" field.
+func (m *VenueMutation) SetDirections(s string) {
+	m.directions = &s
+}
+
+// Directions returns the value of the "directions" field in the mutation.
+func (m *VenueMutation) Directions() (r string, exists bool) {
+	v := m.directions
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrganizationID returns the old "organization_id" field's value of the OrganizationMember entity.
-// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// OldDirections returns the old "directions" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMemberMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VenueMutation) OldDirections(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDirections is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
+		return v, errors.New("OldDirections requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDirections: %w", err)
 	}
-	return oldValue.OrganizationID, nil
+	return oldValue.Directions, nil
 }
 
-// ResetOrganizationID resets all changes to the "organization_id" field.
-func (m *OrganizationMemberMutation) ResetOrganizationID() {
-	m.organization = nil
+// ClearDirections clears the value of the "directions" field.
+func (m *VenueMutation) ClearDirections() {
+	m.directions = nil
+	m.clearedFields[venue.FieldDirections] = struct{}{}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *OrganizationMemberMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// DirectionsCleared returns if the "directions" field was cleared in this mutation.
+func (m *VenueMutation) DirectionsCleared() bool {
+	_, ok := m.clearedFields[venue.FieldDirections]
+	return ok
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *OrganizationMemberMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// ResetDirections resets all changes to the "directions" field.
+func (m *VenueMutation) ResetDirections() {
+	m.directions = nil
+	delete(m.clearedFields, venue.FieldDirections)
+}
+
+// SetParking sets the "parking" field.
+func (m *VenueMutation) SetParking(s string) {
+	m.parking = &s
+}
+
+// Parking returns the value of the "parking" field in the mutation.
+func (m *VenueMutation) Parking() (r string, exists bool) {
+	v := m.parking
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the OrganizationMember entity.
-// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// OldParking returns the old "parking" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMemberMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *VenueMutation) OldParking(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldParking is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldParking requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldParking: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.Parking, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *OrganizationMemberMutation) ResetUserID() {
-	m.user = nil
+// ClearParking clears the value of the "parking" field.
+func (m *VenueMutation) ClearParking() {
+	m.parking = nil
+	m.clearedFields[venue.FieldParking] = struct{}{}
 }
 
-// SetRole sets the "role" field.
-func (m *OrganizationMemberMutation) SetRole(o organizationmember.Role) {
-	m.role = &o
+// ParkingCleared returns if the "parking" field was cleared in this mutation.
+func (m *VenueMutation) ParkingCleared() bool {
+	_, ok := m.clearedFields[venue.FieldParking]
+	return ok
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *OrganizationMemberMutation) Role() (r organizationmember.Role, exists bool) {
-	v := m.role
+// ResetParking resets all changes to the "parking" field.
+func (m *VenueMutation) ResetParking() {
+	m.parking = nil
+	delete(m.clearedFields, venue.FieldParking)
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (m *VenueMutation) SetAccessibility(s string) {
+	m.accessibility = &s
+}
+
+// Accessibility returns the value of the "accessibility" field in the mutation.
+func (m *VenueMutation) Accessibility() (r string, exists bool) {
+	v := m.accessibility
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the OrganizationMember entity.
-// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// OldAccessibility returns the old "accessibility" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMemberMutation) OldRole(ctx context.Context) (v organizationmember.Role, err error) {
+func (m *VenueMutation) OldAccessibility(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldAccessibility is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldAccessibility requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldAccessibility: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Accessibility, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *OrganizationMemberMutation) ResetRole() {
-	m.role = nil
+// ClearAccessibility clears the value of the "accessibility" field.
+func (m *VenueMutation) ClearAccessibility() {
+	m.accessibility = nil
+	m.clearedFields[venue.FieldAccessibility] = struct{}{}
 }
 
-// SetJoinedAt sets the "joined_at" field.
-func (m *OrganizationMemberMutation) SetJoinedAt(t time.Time) {
-	m.joined_at = &t
+// AccessibilityCleared returns if the "accessibility" field was cleared in this mutation.
+func (m *VenueMutation) AccessibilityCleared() bool {
+	_, ok := m.clearedFields[venue.FieldAccessibility]
+	return ok
 }
 
-// JoinedAt returns the value of the "joined_at" field in the mutation.
-func (m *OrganizationMemberMutation) JoinedAt() (r time.Time, exists bool) {
-	v := m.joined_at
+// ResetAccessibility resets all changes to the "accessibility" field.
+func (m *VenueMutation) ResetAccessibility() {
+	m.accessibility = nil
+	delete(m.clearedFields, venue.FieldAccessibility)
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (m *VenueMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
+}
+
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *VenueMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldJoinedAt returns the old "joined_at" field's value of the OrganizationMember entity.
-// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMemberMutation) OldJoinedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VenueMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldJoinedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldJoinedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldJoinedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.JoinedAt, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetJoinedAt resets all changes to the "joined_at" field.
-func (m *OrganizationMemberMutation) ResetJoinedAt() {
-	m.joined_at = nil
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *VenueMutation) ResetCreatedBy() {
+	m.created_by = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *OrganizationMemberMutation) SetCreatedAt(t time.Time) {
+func (m *VenueMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *OrganizationMemberMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *VenueMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2972,10 +20810,10 @@ func (m *OrganizationMemberMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the OrganizationMember entity.
-// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMemberMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VenueMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2990,17 +20828,17 @@ func (m *OrganizationMemberMutation) OldCreatedAt(ctx context.Context) (v time.T
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *OrganizationMemberMutation) ResetCreatedAt() {
+func (m *VenueMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *OrganizationMemberMutation) SetUpdatedAt(t time.Time) {
+func (m *VenueMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *OrganizationMemberMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *VenueMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3008,91 +20846,172 @@ func (m *OrganizationMemberMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the OrganizationMember entity.
-// If the OrganizationMember object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Venue entity.
+// If the Venue object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *OrganizationMemberMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *VenueMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
 		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *VenueMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *VenueMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[venue.FieldOrganizationID] = struct{}{}
+}
+
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *VenueMutation) OrganizationCleared() bool {
+	return m.OrganizationIDCleared() || m.clearedorganization
+}
+
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *VenueMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *VenueMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by ids.
+func (m *VenueMutation) AddEventIDs(ids ...uuid.UUID) {
+	if m.events == nil {
+		m.events = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.events[ids[i]] = struct{}{}
+	}
+}
+
+// ClearEvents clears the "events" edge to the Event entity.
+func (m *VenueMutation) ClearEvents() {
+	m.clearedevents = true
+}
+
+// EventsCleared reports if the "events" edge to the Event entity was cleared.
+func (m *VenueMutation) EventsCleared() bool {
+	return m.clearedevents
+}
+
+// RemoveEventIDs removes the "events" edge to the Event entity by IDs.
+func (m *VenueMutation) RemoveEventIDs(ids ...uuid.UUID) {
+	if m.removedevents == nil {
+		m.removedevents = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.events, ids[i])
+		m.removedevents[ids[i]] = struct{}{}
 	}
-	return oldValue.UpdatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *OrganizationMemberMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// RemovedEvents returns the removed IDs of the "events" edge to the Event entity.
+func (m *VenueMutation) RemovedEventsIDs() (ids []uuid.UUID) {
+	for id := range m.removedevents {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ClearOrganization clears the "organization" edge to the Organization entity.
-func (m *OrganizationMemberMutation) ClearOrganization() {
-	m.clearedorganization = true
-	m.clearedFields[organizationmember.FieldOrganizationID] = struct{}{}
+// EventsIDs returns the "events" edge IDs in the mutation.
+func (m *VenueMutation) EventsIDs() (ids []uuid.UUID) {
+	for id := range m.events {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
-func (m *OrganizationMemberMutation) OrganizationCleared() bool {
-	return m.clearedorganization
+// ResetEvents resets all changes to the "events" edge.
+func (m *VenueMutation) ResetEvents() {
+	m.events = nil
+	m.clearedevents = false
+	m.removedevents = nil
 }
 
-// OrganizationIDs returns the "organization" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// OrganizationID instead. It exists only for internal usage by the builders.
-func (m *OrganizationMemberMutation) OrganizationIDs() (ids []uuid.UUID) {
-	if id := m.organization; id != nil {
-		ids = append(ids, *id)
+// AddEventSeriesIDs adds the "event_series" edge to the EventSeries entity by ids.
+func (m *VenueMutation) AddEventSeriesIDs(ids ...uuid.UUID) {
+	if m.event_series == nil {
+		m.event_series = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.event_series[ids[i]] = struct{}{}
 	}
-	return
 }
 
-// ResetOrganization resets all changes to the "organization" edge.
-func (m *OrganizationMemberMutation) ResetOrganization() {
-	m.organization = nil
-	m.clearedorganization = false
+// ClearEventSeries clears the "event_series" edge to the EventSeries entity.
+func (m *VenueMutation) ClearEventSeries() {
+	m.clearedevent_series = true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *OrganizationMemberMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[organizationmember.FieldUserID] = struct{}{}
+// EventSeriesCleared reports if the "event_series" edge to the EventSeries entity was cleared.
+func (m *VenueMutation) EventSeriesCleared() bool {
+	return m.clearedevent_series
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *OrganizationMemberMutation) UserCleared() bool {
-	return m.cleareduser
+// RemoveEventSeriesIDs removes the "event_series" edge to the EventSeries entity by IDs.
+func (m *VenueMutation) RemoveEventSeriesIDs(ids ...uuid.UUID) {
+	if m.removedevent_series == nil {
+		m.removedevent_series = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.event_series, ids[i])
+		m.removedevent_series[ids[i]] = struct{}{}
+	}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *OrganizationMemberMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// RemovedEventSeries returns the removed IDs of the "event_series" edge to the EventSeries entity.
+func (m *VenueMutation) RemovedEventSeriesIDs() (ids []uuid.UUID) {
+	for id := range m.removedevent_series {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *OrganizationMemberMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// EventSeriesIDs returns the "event_series" edge IDs in the mutation.
+func (m *VenueMutation) EventSeriesIDs() (ids []uuid.UUID) {
+	for id := range m.event_series {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the OrganizationMemberMutation builder.
-func (m *OrganizationMemberMutation) Where(ps ...predicate.OrganizationMember) {
+// ResetEventSeries resets all changes to the "event_series" edge.
+func (m *VenueMutation) ResetEventSeries() {
+	m.event_series = nil
+	m.clearedevent_series = false
+	m.removedevent_series = nil
+}
+
+// Where appends a list predicates to the VenueMutation builder.
+func (m *VenueMutation) Where(ps ...predicate.Venue) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the OrganizationMemberMutation builder. Using this method,
+// WhereP appends storage-level predicates to the VenueMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *OrganizationMemberMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.OrganizationMember, len(ps))
+func (m *VenueMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Venue, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3100,42 +21019,75 @@ func (m *OrganizationMemberMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *OrganizationMemberMutation) Op() Op {
+func (m *VenueMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *OrganizationMemberMutation) SetOp(op Op) {
+func (m *VenueMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (OrganizationMember).
-func (m *OrganizationMemberMutation) Type() string {
+// Type returns the node type of this mutation (Venue).
+func (m *VenueMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *OrganizationMemberMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *VenueMutation) Fields() []string {
+	fields := make([]string, 0, 17)
 	if m.organization != nil {
-		fields = append(fields, organizationmember.FieldOrganizationID)
+		fields = append(fields, venue.FieldOrganizationID)
 	}
-	if m.user != nil {
-		fields = append(fields, organizationmember.FieldUserID)
+	if m.name != nil {
+		fields = append(fields, venue.FieldName)
 	}
-	if m.role != nil {
-		fields = append(fields, organizationmember.FieldRole)
+	if m.address_street != nil {
+		fields = append(fields, venue.FieldAddressStreet)
 	}
-	if m.joined_at != nil {
-		fields = append(fields, organizationmember.FieldJoinedAt)
+	if m.address_city != nil {
+		fields = append(fields, venue.FieldAddressCity)
+	}
+	if m.address_region != nil {
+		fields = append(fields, venue.FieldAddressRegion)
+	}
+	if m.address_postal_code != nil {
+		fields = append(fields, venue.FieldAddressPostalCode)
+	}
+	if m.address_country != nil {
+		fields = append(fields, venue.FieldAddressCountry)
+	}
+	if m.latitude != nil {
+		fields = append(fields, venue.FieldLatitude)
+	}
+	if m.longitude != nil {
+		fields = append(fields, venue.FieldLongitude)
+	}
+	if m.capacity != nil {
+		fields = append(fields, venue.FieldCapacity)
+	}
+	if m.seating_layouts != nil {
+		fields = append(fields, venue.FieldSeatingLayouts)
+	}
+	if m.directions != nil {
+		fields = append(fields, venue.FieldDirections)
+	}
+	if m.parking != nil {
+		fields = append(fields, venue.FieldParking)
+	}
+	if m.accessibility != nil {
+		fields = append(fields, venue.FieldAccessibility)
+	}
+	if m.created_by != nil {
+		fields = append(fields, venue.FieldCreatedBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, organizationmember.FieldCreatedAt)
+		fields = append(fields, venue.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, organizationmember.FieldUpdatedAt)
+		fields = append(fields, venue.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3143,19 +21095,41 @@ func (m *OrganizationMemberMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *OrganizationMemberMutation) Field(name string) (ent.Value, bool) {
+func (m *VenueMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case organizationmember.FieldOrganizationID:
+	case venue.FieldOrganizationID:
 		return m.OrganizationID()
-	case organizationmember.FieldUserID:
-		return m.UserID()
-	case organizationmember.FieldRole:
-		return m.Role()
-	case organizationmember.FieldJoinedAt:
-		return m.JoinedAt()
-	case organizationmember.FieldCreatedAt:
+	case venue.FieldName:
+		return m.Name()
+	case venue.FieldAddressStreet:
+		return m.AddressStreet()
+	case venue.FieldAddressCity:
+		return m.AddressCity()
+	case venue.FieldAddressRegion:
+		return m.AddressRegion()
+	case venue.FieldAddressPostalCode:
+		return m.AddressPostalCode()
+	case venue.FieldAddressCountry:
+		return m.AddressCountry()
+	case venue.FieldLatitude:
+		return m.Latitude()
+	case venue.FieldLongitude:
+		return m.Longitude()
+	case venue.FieldCapacity:
+		return m.Capacity()
+	case venue.FieldSeatingLayouts:
+		return m.SeatingLayouts()
+	case venue.FieldDirections:
+		return m.Directions()
+	case venue.FieldParking:
+		return m.Parking()
+	case venue.FieldAccessibility:
+		return m.Accessibility()
+	case venue.FieldCreatedBy:
+		return m.CreatedBy()
+	case venue.FieldCreatedAt:
 		return m.CreatedAt()
-	case organizationmember.FieldUpdatedAt:
+	case venue.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3164,65 +21138,164 @@ func (m *OrganizationMemberMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *OrganizationMemberMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *VenueMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case organizationmember.FieldOrganizationID:
+	case venue.FieldOrganizationID:
 		return m.OldOrganizationID(ctx)
-	case organizationmember.FieldUserID:
-		return m.OldUserID(ctx)
-	case organizationmember.FieldRole:
-		return m.OldRole(ctx)
-	case organizationmember.FieldJoinedAt:
-		return m.OldJoinedAt(ctx)
-	case organizationmember.FieldCreatedAt:
+	case venue.FieldName:
+		return m.OldName(ctx)
+	case venue.FieldAddressStreet:
+		return m.OldAddressStreet(ctx)
+	case venue.FieldAddressCity:
+		return m.OldAddressCity(ctx)
+	case venue.FieldAddressRegion:
+		return m.OldAddressRegion(ctx)
+	case venue.FieldAddressPostalCode:
+		return m.OldAddressPostalCode(ctx)
+	case venue.FieldAddressCountry:
+		return m.OldAddressCountry(ctx)
+	case venue.FieldLatitude:
+		return m.OldLatitude(ctx)
+	case venue.FieldLongitude:
+		return m.OldLongitude(ctx)
+	case venue.FieldCapacity:
+		return m.OldCapacity(ctx)
+	case venue.FieldSeatingLayouts:
+		return m.OldSeatingLayouts(ctx)
+	case venue.FieldDirections:
+		return m.OldDirections(ctx)
+	case venue.FieldParking:
+		return m.OldParking(ctx)
+	case venue.FieldAccessibility:
+		return m.OldAccessibility(ctx)
+	case venue.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case venue.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case organizationmember.FieldUpdatedAt:
+	case venue.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown OrganizationMember field %s", name)
+	return nil, fmt.Errorf("unknown Venue field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationMemberMutation) SetField(name string, value ent.Value) error {
+func (m *VenueMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case organizationmember.FieldOrganizationID:
+	case venue.FieldOrganizationID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetOrganizationID(v)
 		return nil
-	case organizationmember.FieldUserID:
-		v, ok := value.(uuid.UUID)
+	case venue.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetName(v)
 		return nil
-	case organizationmember.FieldRole:
-		v, ok := value.(organizationmember.Role)
+	case venue.FieldAddressStreet:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetAddressStreet(v)
 		return nil
-	case organizationmember.FieldJoinedAt:
-		v, ok := value.(time.Time)
+	case venue.FieldAddressCity:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetJoinedAt(v)
+		m.SetAddressCity(v)
 		return nil
-	case organizationmember.FieldCreatedAt:
+	case venue.FieldAddressRegion:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressRegion(v)
+		return nil
+	case venue.FieldAddressPostalCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressPostalCode(v)
+		return nil
+	case venue.FieldAddressCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAddressCountry(v)
+		return nil
+	case venue.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatitude(v)
+		return nil
+	case venue.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLongitude(v)
+		return nil
+	case venue.FieldCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCapacity(v)
+		return nil
+	case venue.FieldSeatingLayouts:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSeatingLayouts(v)
+		return nil
+	case venue.FieldDirections:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDirections(v)
+		return nil
+	case venue.FieldParking:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParking(v)
+		return nil
+	case venue.FieldAccessibility:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccessibility(v)
+		return nil
+	case venue.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedBy(v)
+		return nil
+	case venue.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case organizationmember.FieldUpdatedAt:
+	case venue.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3230,209 +21303,361 @@ func (m *OrganizationMemberMutation) SetField(name string, value ent.Value) erro
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown OrganizationMember field %s", name)
+	return fmt.Errorf("unknown Venue field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *OrganizationMemberMutation) AddedFields() []string {
-	return nil
+func (m *VenueMutation) AddedFields() []string {
+	var fields []string
+	if m.addlatitude != nil {
+		fields = append(fields, venue.FieldLatitude)
+	}
+	if m.addlongitude != nil {
+		fields = append(fields, venue.FieldLongitude)
+	}
+	if m.addcapacity != nil {
+		fields = append(fields, venue.FieldCapacity)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *OrganizationMemberMutation) AddedField(name string) (ent.Value, bool) {
+func (m *VenueMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case venue.FieldLatitude:
+		return m.AddedLatitude()
+	case venue.FieldLongitude:
+		return m.AddedLongitude()
+	case venue.FieldCapacity:
+		return m.AddedCapacity()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *OrganizationMemberMutation) AddField(name string, value ent.Value) error {
+func (m *VenueMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case venue.FieldLatitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatitude(v)
+		return nil
+	case venue.FieldLongitude:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLongitude(v)
+		return nil
+	case venue.FieldCapacity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCapacity(v)
+		return nil
 	}
-	return fmt.Errorf("unknown OrganizationMember numeric field %s", name)
+	return fmt.Errorf("unknown Venue numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *OrganizationMemberMutation) ClearedFields() []string {
-	return nil
+func (m *VenueMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(venue.FieldOrganizationID) {
+		fields = append(fields, venue.FieldOrganizationID)
+	}
+	if m.FieldCleared(venue.FieldLatitude) {
+		fields = append(fields, venue.FieldLatitude)
+	}
+	if m.FieldCleared(venue.FieldLongitude) {
+		fields = append(fields, venue.FieldLongitude)
+	}
+	if m.FieldCleared(venue.FieldSeatingLayouts) {
+		fields = append(fields, venue.FieldSeatingLayouts)
+	}
+	if m.FieldCleared(venue.FieldDirections) {
+		fields = append(fields, venue.FieldDirections)
+	}
+	if m.FieldCleared(venue.FieldParking) {
+		fields = append(fields, venue.FieldParking)
+	}
+	if m.FieldCleared(venue.FieldAccessibility) {
+		fields = append(fields, venue.FieldAccessibility)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *OrganizationMemberMutation) FieldCleared(name string) bool {
+func (m *VenueMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *OrganizationMemberMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown OrganizationMember nullable field %s", name)
+func (m *VenueMutation) ClearField(name string) error {
+	switch name {
+	case venue.FieldOrganizationID:
+		m.ClearOrganizationID()
+		return nil
+	case venue.FieldLatitude:
+		m.ClearLatitude()
+		return nil
+	case venue.FieldLongitude:
+		m.ClearLongitude()
+		return nil
+	case venue.FieldSeatingLayouts:
+		m.ClearSeatingLayouts()
+		return nil
+	case venue.FieldDirections:
+		m.ClearDirections()
+		return nil
+	case venue.FieldParking:
+		m.ClearParking()
+		return nil
+	case venue.FieldAccessibility:
+		m.ClearAccessibility()
+		return nil
+	}
+	return fmt.Errorf("unknown Venue nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *OrganizationMemberMutation) ResetField(name string) error {
+func (m *VenueMutation) ResetField(name string) error {
 	switch name {
-	case organizationmember.FieldOrganizationID:
+	case venue.FieldOrganizationID:
 		m.ResetOrganizationID()
 		return nil
-	case organizationmember.FieldUserID:
-		m.ResetUserID()
+	case venue.FieldName:
+		m.ResetName()
 		return nil
-	case organizationmember.FieldRole:
-		m.ResetRole()
+	case venue.FieldAddressStreet:
+		m.ResetAddressStreet()
 		return nil
-	case organizationmember.FieldJoinedAt:
-		m.ResetJoinedAt()
+	case venue.FieldAddressCity:
+		m.ResetAddressCity()
 		return nil
-	case organizationmember.FieldCreatedAt:
+	case venue.FieldAddressRegion:
+		m.ResetAddressRegion()
+		return nil
+	case venue.FieldAddressPostalCode:
+		m.ResetAddressPostalCode()
+		return nil
+	case venue.FieldAddressCountry:
+		m.ResetAddressCountry()
+		return nil
+	case venue.FieldLatitude:
+		m.ResetLatitude()
+		return nil
+	case venue.FieldLongitude:
+		m.ResetLongitude()
+		return nil
+	case venue.FieldCapacity:
+		m.ResetCapacity()
+		return nil
+	case venue.FieldSeatingLayouts:
+		m.ResetSeatingLayouts()
+		return nil
+	case venue.FieldDirections:
+		m.ResetDirections()
+		return nil
+	case venue.FieldParking:
+		m.ResetParking()
+		return nil
+	case venue.FieldAccessibility:
+		m.ResetAccessibility()
+		return nil
+	case venue.FieldCreatedBy:
+		m.ResetCreatedBy()
+		return nil
+	case venue.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case organizationmember.FieldUpdatedAt:
+	case venue.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown OrganizationMember field %s", name)
+	return fmt.Errorf("unknown Venue field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *OrganizationMemberMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *VenueMutation) AddedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.organization != nil {
-		edges = append(edges, organizationmember.EdgeOrganization)
+		edges = append(edges, venue.EdgeOrganization)
 	}
-	if m.user != nil {
-		edges = append(edges, organizationmember.EdgeUser)
+	if m.events != nil {
+		edges = append(edges, venue.EdgeEvents)
+	}
+	if m.event_series != nil {
+		edges = append(edges, venue.EdgeEventSeries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *OrganizationMemberMutation) AddedIDs(name string) []ent.Value {
+func (m *VenueMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case organizationmember.EdgeOrganization:
+	case venue.EdgeOrganization:
 		if id := m.organization; id != nil {
 			return []ent.Value{*id}
 		}
-	case organizationmember.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
+	case venue.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.events))
+		for id := range m.events {
+			ids = append(ids, id)
+		}
+		return ids
+	case venue.EdgeEventSeries:
+		ids := make([]ent.Value, 0, len(m.event_series))
+		for id := range m.event_series {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *OrganizationMemberMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *VenueMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 3)
+	if m.removedevents != nil {
+		edges = append(edges, venue.EdgeEvents)
+	}
+	if m.removedevent_series != nil {
+		edges = append(edges, venue.EdgeEventSeries)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *OrganizationMemberMutation) RemovedIDs(name string) []ent.Value {
+func (m *VenueMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case venue.EdgeEvents:
+		ids := make([]ent.Value, 0, len(m.removedevents))
+		for id := range m.removedevents {
+			ids = append(ids, id)
+		}
+		return ids
+	case venue.EdgeEventSeries:
+		ids := make([]ent.Value, 0, len(m.removedevent_series))
+		for id := range m.removedevent_series {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *OrganizationMemberMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *VenueMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 3)
 	if m.clearedorganization {
-		edges = append(edges, organizationmember.EdgeOrganization)
+		edges = append(edges, venue.EdgeOrganization)
 	}
-	if m.cleareduser {
-		edges = append(edges, organizationmember.EdgeUser)
+	if m.clearedevents {
+		edges = append(edges, venue.EdgeEvents)
+	}
+	if m.clearedevent_series {
+		edges = append(edges, venue.EdgeEventSeries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *OrganizationMemberMutation) EdgeCleared(name string) bool {
+func (m *VenueMutation) EdgeCleared(name string) bool {
 	switch name {
-	case organizationmember.EdgeOrganization:
+	case venue.EdgeOrganization:
 		return m.clearedorganization
-	case organizationmember.EdgeUser:
-		return m.cleareduser
+	case venue.EdgeEvents:
+		return m.clearedevents
+	case venue.EdgeEventSeries:
+		return m.clearedevent_series
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *OrganizationMemberMutation) ClearEdge(name string) error {
+func (m *VenueMutation) ClearEdge(name string) error {
 	switch name {
-	case organizationmember.EdgeOrganization:
+	case venue.EdgeOrganization:
 		m.ClearOrganization()
 		return nil
-	case organizationmember.EdgeUser:
-		m.ClearUser()
-		return nil
 	}
-	return fmt.Errorf("unknown OrganizationMember unique edge %s", name)
+	return fmt.Errorf("unknown Venue unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *OrganizationMemberMutation) ResetEdge(name string) error {
+func (m *VenueMutation) ResetEdge(name string) error {
 	switch name {
-	case organizationmember.EdgeOrganization:
+	case venue.EdgeOrganization:
 		m.ResetOrganization()
 		return nil
-	case organizationmember.EdgeUser:
-		m.ResetUser()
+	case venue.EdgeEvents:
+		m.ResetEvents()
+		return nil
+	case venue.EdgeEventSeries:
+		m.ResetEventSeries()
 		return nil
 	}
-	return fmt.Errorf("unknown OrganizationMember edge %s", name)
+	return fmt.Errorf("unknown Venue edge %s", name)
 }
 
-// PaymentMutation represents an operation that mutates the Payment nodes in the graph.
-type PaymentMutation struct {
+// WebhookDeliveryMutation represents an operation that mutates the WebhookDelivery nodes in the graph.
+type WebhookDeliveryMutation struct {
 	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	event_title        *string
-	ticket_quantity    *int
-	addticket_quantity *int
-	total_price        *float64
-	addtotal_price     *float64
-	currency           *string
-	buyer_name         *string
-	buyer_email        *string
-	buyer_phone        *string
-	payment_key        *string
-	order_id           *string
-	status             *payment.Status
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	event              *uuid.UUID
-	clearedevent       bool
-	user               *uuid.UUID
-	cleareduser        bool
-	done               bool
-	oldValue           func(context.Context) (*Payment, error)
-	predicates         []predicate.Payment
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	organization_id     *uuid.UUID
+	event_id            *uuid.UUID
+	event_type          *string
+	payload             *string
+	status              *webhookdelivery.Status
+	attempts            *int
+	addattempts         *int
+	last_status_code    *int
+	addlast_status_code *int
+	last_error          *string
+	next_attempt_at     *time.Time
+	delivered_at        *time.Time
+	replay_of           *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	endpoint            *uuid.UUID
+	clearedendpoint     bool
+	done                bool
+	oldValue            func(context.Context) (*WebhookDelivery, error)
+	predicates          []predicate.WebhookDelivery
 }
 
-var _ ent.Mutation = (*PaymentMutation)(nil)
+var _ ent.Mutation = (*WebhookDeliveryMutation)(nil)
 
-// paymentOption allows management of the mutation configuration using functional options.
-type paymentOption func(*PaymentMutation)
+// webhookdeliveryOption allows management of the mutation configuration using functional options.
+type webhookdeliveryOption func(*WebhookDeliveryMutation)
 
-// newPaymentMutation creates new mutation for the Payment entity.
-func newPaymentMutation(c config, op Op, opts ...paymentOption) *PaymentMutation {
-	m := &PaymentMutation{
+// newWebhookDeliveryMutation creates new mutation for the WebhookDelivery entity.
+func newWebhookDeliveryMutation(c config, op Op, opts ...webhookdeliveryOption) *WebhookDeliveryMutation {
+	m := &WebhookDeliveryMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePayment,
+		typ:           TypeWebhookDelivery,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3441,20 +21666,20 @@ func newPaymentMutation(c config, op Op, opts ...paymentOption) *PaymentMutation
 	return m
 }
 
-// withPaymentID sets the ID field of the mutation.
-func withPaymentID(id uuid.UUID) paymentOption {
-	return func(m *PaymentMutation) {
+// withWebhookDeliveryID sets the ID field of the mutation.
+func withWebhookDeliveryID(id uuid.UUID) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Payment
+			value *WebhookDelivery
 		)
-		m.oldValue = func(ctx context.Context) (*Payment, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookDelivery, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Payment.Get(ctx, id)
+					value, err = m.Client().WebhookDelivery.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3463,10 +21688,10 @@ func withPaymentID(id uuid.UUID) paymentOption {
 	}
 }
 
-// withPayment sets the old Payment of the mutation.
-func withPayment(node *Payment) paymentOption {
-	return func(m *PaymentMutation) {
-		m.oldValue = func(context.Context) (*Payment, error) {
+// withWebhookDelivery sets the old WebhookDelivery of the mutation.
+func withWebhookDelivery(node *WebhookDelivery) webhookdeliveryOption {
+	return func(m *WebhookDeliveryMutation) {
+		m.oldValue = func(context.Context) (*WebhookDelivery, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3475,7 +21700,7 @@ func withPayment(node *Payment) paymentOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PaymentMutation) Client() *Client {
+func (m WebhookDeliveryMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3483,7 +21708,7 @@ func (m PaymentMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PaymentMutation) Tx() (*Tx, error) {
+func (m WebhookDeliveryMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3493,14 +21718,14 @@ func (m PaymentMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Payment entities.
-func (m *PaymentMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookDelivery entities.
+func (m *WebhookDeliveryMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PaymentMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookDeliveryMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3511,7 +21736,7 @@ func (m *PaymentMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PaymentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookDeliveryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3520,530 +21745,557 @@ func (m *PaymentMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Payment.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookDelivery.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEventID sets the "event_id" field.
-func (m *PaymentMutation) SetEventID(u uuid.UUID) {
-	m.event = &u
-}
-
-// EventID returns the value of the "event_id" field in the mutation.
-func (m *PaymentMutation) EventID() (r uuid.UUID, exists bool) {
-	v := m.event
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldEventID returns the old "event_id" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
-	}
-	return oldValue.EventID, nil
-}
-
-// ResetEventID resets all changes to the "event_id" field.
-func (m *PaymentMutation) ResetEventID() {
-	m.event = nil
-}
-
-// SetUserID sets the "user_id" field.
-func (m *PaymentMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetEndpointID sets the "endpoint_id" field.
+func (m *WebhookDeliveryMutation) SetEndpointID(u uuid.UUID) {
+	m.endpoint = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PaymentMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// EndpointID returns the value of the "endpoint_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EndpointID() (r uuid.UUID, exists bool) {
+	v := m.endpoint
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldEndpointID returns the old "endpoint_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *WebhookDeliveryMutation) OldEndpointID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldEndpointID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldEndpointID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldEndpointID: %w", err)
 	}
-	return oldValue.UserID, nil
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (m *PaymentMutation) ClearUserID() {
-	m.user = nil
-	m.clearedFields[payment.FieldUserID] = struct{}{}
-}
-
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *PaymentMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[payment.FieldUserID]
-	return ok
+	return oldValue.EndpointID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PaymentMutation) ResetUserID() {
-	m.user = nil
-	delete(m.clearedFields, payment.FieldUserID)
+// ResetEndpointID resets all changes to the "endpoint_id" field.
+func (m *WebhookDeliveryMutation) ResetEndpointID() {
+	m.endpoint = nil
 }
 
-// SetEventTitle sets the "event_title" field.
-func (m *PaymentMutation) SetEventTitle(s string) {
-	m.event_title = &s
+// SetOrganizationID sets the "organization_id" field.
+func (m *WebhookDeliveryMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization_id = &u
 }
 
-// EventTitle returns the value of the "event_title" field in the mutation.
-func (m *PaymentMutation) EventTitle() (r string, exists bool) {
-	v := m.event_title
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *WebhookDeliveryMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEventTitle returns the old "event_title" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldEventTitle(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEventTitle is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEventTitle requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEventTitle: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
 	}
-	return oldValue.EventTitle, nil
+	return oldValue.OrganizationID, nil
 }
 
-// ResetEventTitle resets all changes to the "event_title" field.
-func (m *PaymentMutation) ResetEventTitle() {
-	m.event_title = nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *WebhookDeliveryMutation) ResetOrganizationID() {
+	m.organization_id = nil
 }
 
-// SetTicketQuantity sets the "ticket_quantity" field.
-func (m *PaymentMutation) SetTicketQuantity(i int) {
-	m.ticket_quantity = &i
-	m.addticket_quantity = nil
+// SetEventID sets the "event_id" field.
+func (m *WebhookDeliveryMutation) SetEventID(u uuid.UUID) {
+	m.event_id = &u
 }
 
-// TicketQuantity returns the value of the "ticket_quantity" field in the mutation.
-func (m *PaymentMutation) TicketQuantity() (r int, exists bool) {
-	v := m.ticket_quantity
+// EventID returns the value of the "event_id" field in the mutation.
+func (m *WebhookDeliveryMutation) EventID() (r uuid.UUID, exists bool) {
+	v := m.event_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTicketQuantity returns the old "ticket_quantity" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldEventID returns the old "event_id" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldTicketQuantity(ctx context.Context) (v int, err error) {
+func (m *WebhookDeliveryMutation) OldEventID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTicketQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTicketQuantity requires an ID field in the mutation")
+		return v, errors.New("OldEventID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTicketQuantity: %w", err)
-	}
-	return oldValue.TicketQuantity, nil
-}
-
-// AddTicketQuantity adds i to the "ticket_quantity" field.
-func (m *PaymentMutation) AddTicketQuantity(i int) {
-	if m.addticket_quantity != nil {
-		*m.addticket_quantity += i
-	} else {
-		m.addticket_quantity = &i
-	}
-}
-
-// AddedTicketQuantity returns the value that was added to the "ticket_quantity" field in this mutation.
-func (m *PaymentMutation) AddedTicketQuantity() (r int, exists bool) {
-	v := m.addticket_quantity
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldEventID: %w", err)
 	}
-	return *v, true
-}
-
-// ResetTicketQuantity resets all changes to the "ticket_quantity" field.
-func (m *PaymentMutation) ResetTicketQuantity() {
-	m.ticket_quantity = nil
-	m.addticket_quantity = nil
+	return oldValue.EventID, nil
 }
 
-// SetTotalPrice sets the "total_price" field.
-func (m *PaymentMutation) SetTotalPrice(f float64) {
-	m.total_price = &f
-	m.addtotal_price = nil
+// ResetEventID resets all changes to the "event_id" field.
+func (m *WebhookDeliveryMutation) ResetEventID() {
+	m.event_id = nil
 }
 
-// TotalPrice returns the value of the "total_price" field in the mutation.
-func (m *PaymentMutation) TotalPrice() (r float64, exists bool) {
-	v := m.total_price
+// SetEventType sets the "event_type" field.
+func (m *WebhookDeliveryMutation) SetEventType(s string) {
+	m.event_type = &s
+}
+
+// EventType returns the value of the "event_type" field in the mutation.
+func (m *WebhookDeliveryMutation) EventType() (r string, exists bool) {
+	v := m.event_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalPrice returns the old "total_price" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldEventType returns the old "event_type" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldTotalPrice(ctx context.Context) (v float64, err error) {
+func (m *WebhookDeliveryMutation) OldEventType(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalPrice requires an ID field in the mutation")
+		return v, errors.New("OldEventType requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventType: %w", err)
 	}
-	return oldValue.TotalPrice, nil
+	return oldValue.EventType, nil
 }
 
-// AddTotalPrice adds f to the "total_price" field.
-func (m *PaymentMutation) AddTotalPrice(f float64) {
-	if m.addtotal_price != nil {
-		*m.addtotal_price += f
-	} else {
-		m.addtotal_price = &f
-	}
+// ResetEventType resets all changes to the "event_type" field.
+func (m *WebhookDeliveryMutation) ResetEventType() {
+	m.event_type = nil
 }
 
-// AddedTotalPrice returns the value that was added to the "total_price" field in this mutation.
-func (m *PaymentMutation) AddedTotalPrice() (r float64, exists bool) {
-	v := m.addtotal_price
+// SetPayload sets the "payload" field.
+func (m *WebhookDeliveryMutation) SetPayload(s string) {
+	m.payload = &s
+}
+
+// Payload returns the value of the "payload" field in the mutation.
+func (m *WebhookDeliveryMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// ResetTotalPrice resets all changes to the "total_price" field.
-func (m *PaymentMutation) ResetTotalPrice() {
-	m.total_price = nil
-	m.addtotal_price = nil
+// OldPayload returns the old "payload" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WebhookDeliveryMutation) OldPayload(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPayload requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+	}
+	return oldValue.Payload, nil
 }
 
-// SetCurrency sets the "currency" field.
-func (m *PaymentMutation) SetCurrency(s string) {
-	m.currency = &s
+// ResetPayload resets all changes to the "payload" field.
+func (m *WebhookDeliveryMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *PaymentMutation) Currency() (r string, exists bool) {
-	v := m.currency
+// SetStatus sets the "status" field.
+func (m *WebhookDeliveryMutation) SetStatus(w webhookdelivery.Status) {
+	m.status = &w
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *WebhookDeliveryMutation) Status() (r webhookdelivery.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrency returns the old "currency" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldCurrency(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldStatus(ctx context.Context) (v webhookdelivery.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Currency, nil
+	return oldValue.Status, nil
 }
 
-// ResetCurrency resets all changes to the "currency" field.
-func (m *PaymentMutation) ResetCurrency() {
-	m.currency = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *WebhookDeliveryMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetBuyerName sets the "buyer_name" field.
-func (m *PaymentMutation) SetBuyerName(s string) {
-	m.buyer_name = &s
+// SetAttempts sets the "attempts" field.
+func (m *WebhookDeliveryMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// BuyerName returns the value of the "buyer_name" field in the mutation.
-func (m *PaymentMutation) BuyerName() (r string, exists bool) {
-	v := m.buyer_name
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *WebhookDeliveryMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuyerName returns the old "buyer_name" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldBuyerName(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyerName is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyerName requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyerName: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.BuyerName, nil
+	return oldValue.Attempts, nil
 }
 
-// ResetBuyerName resets all changes to the "buyer_name" field.
-func (m *PaymentMutation) ResetBuyerName() {
-	m.buyer_name = nil
+// AddAttempts adds i to the "attempts" field.
+func (m *WebhookDeliveryMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// SetBuyerEmail sets the "buyer_email" field.
-func (m *PaymentMutation) SetBuyerEmail(s string) {
-	m.buyer_email = &s
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// BuyerEmail returns the value of the "buyer_email" field in the mutation.
-func (m *PaymentMutation) BuyerEmail() (r string, exists bool) {
-	v := m.buyer_email
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *WebhookDeliveryMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
+}
+
+// SetLastStatusCode sets the "last_status_code" field.
+func (m *WebhookDeliveryMutation) SetLastStatusCode(i int) {
+	m.last_status_code = &i
+	m.addlast_status_code = nil
+}
+
+// LastStatusCode returns the value of the "last_status_code" field in the mutation.
+func (m *WebhookDeliveryMutation) LastStatusCode() (r int, exists bool) {
+	v := m.last_status_code
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuyerEmail returns the old "buyer_email" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldLastStatusCode returns the old "last_status_code" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldBuyerEmail(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastStatusCode(ctx context.Context) (v *int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyerEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastStatusCode is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyerEmail requires an ID field in the mutation")
+		return v, errors.New("OldLastStatusCode requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyerEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastStatusCode: %w", err)
 	}
-	return oldValue.BuyerEmail, nil
+	return oldValue.LastStatusCode, nil
 }
 
-// ResetBuyerEmail resets all changes to the "buyer_email" field.
-func (m *PaymentMutation) ResetBuyerEmail() {
-	m.buyer_email = nil
+// AddLastStatusCode adds i to the "last_status_code" field.
+func (m *WebhookDeliveryMutation) AddLastStatusCode(i int) {
+	if m.addlast_status_code != nil {
+		*m.addlast_status_code += i
+	} else {
+		m.addlast_status_code = &i
+	}
 }
 
-// SetBuyerPhone sets the "buyer_phone" field.
-func (m *PaymentMutation) SetBuyerPhone(s string) {
-	m.buyer_phone = &s
+// AddedLastStatusCode returns the value that was added to the "last_status_code" field in this mutation.
+func (m *WebhookDeliveryMutation) AddedLastStatusCode() (r int, exists bool) {
+	v := m.addlast_status_code
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// BuyerPhone returns the value of the "buyer_phone" field in the mutation.
-func (m *PaymentMutation) BuyerPhone() (r string, exists bool) {
-	v := m.buyer_phone
+// ClearLastStatusCode clears the value of the "last_status_code" field.
+func (m *WebhookDeliveryMutation) ClearLastStatusCode() {
+	m.last_status_code = nil
+	m.addlast_status_code = nil
+	m.clearedFields[webhookdelivery.FieldLastStatusCode] = struct{}{}
+}
+
+// LastStatusCodeCleared returns if the "last_status_code" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastStatusCodeCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastStatusCode]
+	return ok
+}
+
+// ResetLastStatusCode resets all changes to the "last_status_code" field.
+func (m *WebhookDeliveryMutation) ResetLastStatusCode() {
+	m.last_status_code = nil
+	m.addlast_status_code = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastStatusCode)
+}
+
+// SetLastError sets the "last_error" field.
+func (m *WebhookDeliveryMutation) SetLastError(s string) {
+	m.last_error = &s
+}
+
+// LastError returns the value of the "last_error" field in the mutation.
+func (m *WebhookDeliveryMutation) LastError() (r string, exists bool) {
+	v := m.last_error
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBuyerPhone returns the old "buyer_phone" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldLastError returns the old "last_error" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldBuyerPhone(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldLastError(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBuyerPhone is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastError is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBuyerPhone requires an ID field in the mutation")
+		return v, errors.New("OldLastError requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBuyerPhone: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastError: %w", err)
 	}
-	return oldValue.BuyerPhone, nil
+	return oldValue.LastError, nil
 }
 
-// ResetBuyerPhone resets all changes to the "buyer_phone" field.
-func (m *PaymentMutation) ResetBuyerPhone() {
-	m.buyer_phone = nil
+// ClearLastError clears the value of the "last_error" field.
+func (m *WebhookDeliveryMutation) ClearLastError() {
+	m.last_error = nil
+	m.clearedFields[webhookdelivery.FieldLastError] = struct{}{}
 }
 
-// SetPaymentKey sets the "payment_key" field.
-func (m *PaymentMutation) SetPaymentKey(s string) {
-	m.payment_key = &s
+// LastErrorCleared returns if the "last_error" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) LastErrorCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldLastError]
+	return ok
 }
 
-// PaymentKey returns the value of the "payment_key" field in the mutation.
-func (m *PaymentMutation) PaymentKey() (r string, exists bool) {
-	v := m.payment_key
+// ResetLastError resets all changes to the "last_error" field.
+func (m *WebhookDeliveryMutation) ResetLastError() {
+	m.last_error = nil
+	delete(m.clearedFields, webhookdelivery.FieldLastError)
+}
+
+// SetNextAttemptAt sets the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) SetNextAttemptAt(t time.Time) {
+	m.next_attempt_at = &t
+}
+
+// NextAttemptAt returns the value of the "next_attempt_at" field in the mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAt() (r time.Time, exists bool) {
+	v := m.next_attempt_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPaymentKey returns the old "payment_key" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldNextAttemptAt returns the old "next_attempt_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldPaymentKey(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldNextAttemptAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPaymentKey is only allowed on UpdateOne operations")
+		return v, errors.New("OldNextAttemptAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPaymentKey requires an ID field in the mutation")
+		return v, errors.New("OldNextAttemptAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPaymentKey: %w", err)
+		return v, fmt.Errorf("querying old value for OldNextAttemptAt: %w", err)
 	}
-	return oldValue.PaymentKey, nil
+	return oldValue.NextAttemptAt, nil
 }
 
-// ClearPaymentKey clears the value of the "payment_key" field.
-func (m *PaymentMutation) ClearPaymentKey() {
-	m.payment_key = nil
-	m.clearedFields[payment.FieldPaymentKey] = struct{}{}
+// ClearNextAttemptAt clears the value of the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ClearNextAttemptAt() {
+	m.next_attempt_at = nil
+	m.clearedFields[webhookdelivery.FieldNextAttemptAt] = struct{}{}
 }
 
-// PaymentKeyCleared returns if the "payment_key" field was cleared in this mutation.
-func (m *PaymentMutation) PaymentKeyCleared() bool {
-	_, ok := m.clearedFields[payment.FieldPaymentKey]
+// NextAttemptAtCleared returns if the "next_attempt_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) NextAttemptAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldNextAttemptAt]
 	return ok
 }
 
-// ResetPaymentKey resets all changes to the "payment_key" field.
-func (m *PaymentMutation) ResetPaymentKey() {
-	m.payment_key = nil
-	delete(m.clearedFields, payment.FieldPaymentKey)
+// ResetNextAttemptAt resets all changes to the "next_attempt_at" field.
+func (m *WebhookDeliveryMutation) ResetNextAttemptAt() {
+	m.next_attempt_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldNextAttemptAt)
 }
 
-// SetOrderID sets the "order_id" field.
-func (m *PaymentMutation) SetOrderID(s string) {
-	m.order_id = &s
+// SetDeliveredAt sets the "delivered_at" field.
+func (m *WebhookDeliveryMutation) SetDeliveredAt(t time.Time) {
+	m.delivered_at = &t
 }
 
-// OrderID returns the value of the "order_id" field in the mutation.
-func (m *PaymentMutation) OrderID() (r string, exists bool) {
-	v := m.order_id
+// DeliveredAt returns the value of the "delivered_at" field in the mutation.
+func (m *WebhookDeliveryMutation) DeliveredAt() (r time.Time, exists bool) {
+	v := m.delivered_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOrderID returns the old "order_id" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldDeliveredAt returns the old "delivered_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldOrderID(ctx context.Context) (v string, err error) {
+func (m *WebhookDeliveryMutation) OldDeliveredAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOrderID is only allowed on UpdateOne operations")
+		return v, errors.New("OldDeliveredAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOrderID requires an ID field in the mutation")
+		return v, errors.New("OldDeliveredAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOrderID: %w", err)
+		return v, fmt.Errorf("querying old value for OldDeliveredAt: %w", err)
 	}
-	return oldValue.OrderID, nil
+	return oldValue.DeliveredAt, nil
 }
 
-// ClearOrderID clears the value of the "order_id" field.
-func (m *PaymentMutation) ClearOrderID() {
-	m.order_id = nil
-	m.clearedFields[payment.FieldOrderID] = struct{}{}
+// ClearDeliveredAt clears the value of the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ClearDeliveredAt() {
+	m.delivered_at = nil
+	m.clearedFields[webhookdelivery.FieldDeliveredAt] = struct{}{}
 }
 
-// OrderIDCleared returns if the "order_id" field was cleared in this mutation.
-func (m *PaymentMutation) OrderIDCleared() bool {
-	_, ok := m.clearedFields[payment.FieldOrderID]
+// DeliveredAtCleared returns if the "delivered_at" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) DeliveredAtCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldDeliveredAt]
 	return ok
 }
 
-// ResetOrderID resets all changes to the "order_id" field.
-func (m *PaymentMutation) ResetOrderID() {
-	m.order_id = nil
-	delete(m.clearedFields, payment.FieldOrderID)
+// ResetDeliveredAt resets all changes to the "delivered_at" field.
+func (m *WebhookDeliveryMutation) ResetDeliveredAt() {
+	m.delivered_at = nil
+	delete(m.clearedFields, webhookdelivery.FieldDeliveredAt)
 }
 
-// SetStatus sets the "status" field.
-func (m *PaymentMutation) SetStatus(pa payment.Status) {
-	m.status = &pa
+// SetReplayOf sets the "replay_of" field.
+func (m *WebhookDeliveryMutation) SetReplayOf(u uuid.UUID) {
+	m.replay_of = &u
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *PaymentMutation) Status() (r payment.Status, exists bool) {
-	v := m.status
+// ReplayOf returns the value of the "replay_of" field in the mutation.
+func (m *WebhookDeliveryMutation) ReplayOf() (r uuid.UUID, exists bool) {
+	v := m.replay_of
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldReplayOf returns the old "replay_of" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldStatus(ctx context.Context) (v payment.Status, err error) {
+func (m *WebhookDeliveryMutation) OldReplayOf(ctx context.Context) (v *uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldReplayOf is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldReplayOf requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldReplayOf: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.ReplayOf, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *PaymentMutation) ResetStatus() {
-	m.status = nil
+// ClearReplayOf clears the value of the "replay_of" field.
+func (m *WebhookDeliveryMutation) ClearReplayOf() {
+	m.replay_of = nil
+	m.clearedFields[webhookdelivery.FieldReplayOf] = struct{}{}
+}
+
+// ReplayOfCleared returns if the "replay_of" field was cleared in this mutation.
+func (m *WebhookDeliveryMutation) ReplayOfCleared() bool {
+	_, ok := m.clearedFields[webhookdelivery.FieldReplayOf]
+	return ok
+}
+
+// ResetReplayOf resets all changes to the "replay_of" field.
+func (m *WebhookDeliveryMutation) ResetReplayOf() {
+	m.replay_of = nil
+	delete(m.clearedFields, webhookdelivery.FieldReplayOf)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *PaymentMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PaymentMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -4051,10 +22303,10 @@ func (m *PaymentMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookDeliveryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -4069,17 +22321,17 @@ func (m *PaymentMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PaymentMutation) ResetCreatedAt() {
+func (m *WebhookDeliveryMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *PaymentMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookDeliveryMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PaymentMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookDeliveryMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -4087,91 +22339,64 @@ func (m *PaymentMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Payment entity.
-// If the Payment object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookDelivery entity.
+// If the WebhookDelivery object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PaymentMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PaymentMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearEvent clears the "event" edge to the Event entity.
-func (m *PaymentMutation) ClearEvent() {
-	m.clearedevent = true
-	m.clearedFields[payment.FieldEventID] = struct{}{}
-}
-
-// EventCleared reports if the "event" edge to the Event entity was cleared.
-func (m *PaymentMutation) EventCleared() bool {
-	return m.clearedevent
-}
-
-// EventIDs returns the "event" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// EventID instead. It exists only for internal usage by the builders.
-func (m *PaymentMutation) EventIDs() (ids []uuid.UUID) {
-	if id := m.event; id != nil {
-		ids = append(ids, *id)
+func (m *WebhookDeliveryMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// ResetEvent resets all changes to the "event" edge.
-func (m *PaymentMutation) ResetEvent() {
-	m.event = nil
-	m.clearedevent = false
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WebhookDeliveryMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PaymentMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[payment.FieldUserID] = struct{}{}
+// ClearEndpoint clears the "endpoint" edge to the WebhookEndpoint entity.
+func (m *WebhookDeliveryMutation) ClearEndpoint() {
+	m.clearedendpoint = true
+	m.clearedFields[webhookdelivery.FieldEndpointID] = struct{}{}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PaymentMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
+// EndpointCleared reports if the "endpoint" edge to the WebhookEndpoint entity was cleared.
+func (m *WebhookDeliveryMutation) EndpointCleared() bool {
+	return m.clearedendpoint
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// EndpointIDs returns the "endpoint" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PaymentMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
+// EndpointID instead. It exists only for internal usage by the builders.
+func (m *WebhookDeliveryMutation) EndpointIDs() (ids []uuid.UUID) {
+	if id := m.endpoint; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PaymentMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetEndpoint resets all changes to the "endpoint" edge.
+func (m *WebhookDeliveryMutation) ResetEndpoint() {
+	m.endpoint = nil
+	m.clearedendpoint = false
 }
 
-// Where appends a list predicates to the PaymentMutation builder.
-func (m *PaymentMutation) Where(ps ...predicate.Payment) {
+// Where appends a list predicates to the WebhookDeliveryMutation builder.
+func (m *WebhookDeliveryMutation) Where(ps ...predicate.WebhookDelivery) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the PaymentMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookDeliveryMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PaymentMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Payment, len(ps))
+func (m *WebhookDeliveryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookDelivery, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -4179,66 +22404,66 @@ func (m *PaymentMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *PaymentMutation) Op() Op {
+func (m *WebhookDeliveryMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *PaymentMutation) SetOp(op Op) {
+func (m *WebhookDeliveryMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Payment).
-func (m *PaymentMutation) Type() string {
+// Type returns the node type of this mutation (WebhookDelivery).
+func (m *WebhookDeliveryMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *PaymentMutation) Fields() []string {
+func (m *WebhookDeliveryMutation) Fields() []string {
 	fields := make([]string, 0, 14)
-	if m.event != nil {
-		fields = append(fields, payment.FieldEventID)
+	if m.endpoint != nil {
+		fields = append(fields, webhookdelivery.FieldEndpointID)
 	}
-	if m.user != nil {
-		fields = append(fields, payment.FieldUserID)
+	if m.organization_id != nil {
+		fields = append(fields, webhookdelivery.FieldOrganizationID)
 	}
-	if m.event_title != nil {
-		fields = append(fields, payment.FieldEventTitle)
+	if m.event_id != nil {
+		fields = append(fields, webhookdelivery.FieldEventID)
 	}
-	if m.ticket_quantity != nil {
-		fields = append(fields, payment.FieldTicketQuantity)
+	if m.event_type != nil {
+		fields = append(fields, webhookdelivery.FieldEventType)
 	}
-	if m.total_price != nil {
-		fields = append(fields, payment.FieldTotalPrice)
+	if m.payload != nil {
+		fields = append(fields, webhookdelivery.FieldPayload)
 	}
-	if m.currency != nil {
-		fields = append(fields, payment.FieldCurrency)
+	if m.status != nil {
+		fields = append(fields, webhookdelivery.FieldStatus)
 	}
-	if m.buyer_name != nil {
-		fields = append(fields, payment.FieldBuyerName)
+	if m.attempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.buyer_email != nil {
-		fields = append(fields, payment.FieldBuyerEmail)
+	if m.last_status_code != nil {
+		fields = append(fields, webhookdelivery.FieldLastStatusCode)
 	}
-	if m.buyer_phone != nil {
-		fields = append(fields, payment.FieldBuyerPhone)
+	if m.last_error != nil {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
-	if m.payment_key != nil {
-		fields = append(fields, payment.FieldPaymentKey)
+	if m.next_attempt_at != nil {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
 	}
-	if m.order_id != nil {
-		fields = append(fields, payment.FieldOrderID)
+	if m.delivered_at != nil {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
 	}
-	if m.status != nil {
-		fields = append(fields, payment.FieldStatus)
+	if m.replay_of != nil {
+		fields = append(fields, webhookdelivery.FieldReplayOf)
 	}
 	if m.created_at != nil {
-		fields = append(fields, payment.FieldCreatedAt)
+		fields = append(fields, webhookdelivery.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, payment.FieldUpdatedAt)
+		fields = append(fields, webhookdelivery.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -4246,35 +22471,35 @@ func (m *PaymentMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *PaymentMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case payment.FieldEventID:
+	case webhookdelivery.FieldEndpointID:
+		return m.EndpointID()
+	case webhookdelivery.FieldOrganizationID:
+		return m.OrganizationID()
+	case webhookdelivery.FieldEventID:
 		return m.EventID()
-	case payment.FieldUserID:
-		return m.UserID()
-	case payment.FieldEventTitle:
-		return m.EventTitle()
-	case payment.FieldTicketQuantity:
-		return m.TicketQuantity()
-	case payment.FieldTotalPrice:
-		return m.TotalPrice()
-	case payment.FieldCurrency:
-		return m.Currency()
-	case payment.FieldBuyerName:
-		return m.BuyerName()
-	case payment.FieldBuyerEmail:
-		return m.BuyerEmail()
-	case payment.FieldBuyerPhone:
-		return m.BuyerPhone()
-	case payment.FieldPaymentKey:
-		return m.PaymentKey()
-	case payment.FieldOrderID:
-		return m.OrderID()
-	case payment.FieldStatus:
+	case webhookdelivery.FieldEventType:
+		return m.EventType()
+	case webhookdelivery.FieldPayload:
+		return m.Payload()
+	case webhookdelivery.FieldStatus:
 		return m.Status()
-	case payment.FieldCreatedAt:
+	case webhookdelivery.FieldAttempts:
+		return m.Attempts()
+	case webhookdelivery.FieldLastStatusCode:
+		return m.LastStatusCode()
+	case webhookdelivery.FieldLastError:
+		return m.LastError()
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.NextAttemptAt()
+	case webhookdelivery.FieldDeliveredAt:
+		return m.DeliveredAt()
+	case webhookdelivery.FieldReplayOf:
+		return m.ReplayOf()
+	case webhookdelivery.FieldCreatedAt:
 		return m.CreatedAt()
-	case payment.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -4283,137 +22508,137 @@ func (m *PaymentMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *PaymentMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookDeliveryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case payment.FieldEventID:
+	case webhookdelivery.FieldEndpointID:
+		return m.OldEndpointID(ctx)
+	case webhookdelivery.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case webhookdelivery.FieldEventID:
 		return m.OldEventID(ctx)
-	case payment.FieldUserID:
-		return m.OldUserID(ctx)
-	case payment.FieldEventTitle:
-		return m.OldEventTitle(ctx)
-	case payment.FieldTicketQuantity:
-		return m.OldTicketQuantity(ctx)
-	case payment.FieldTotalPrice:
-		return m.OldTotalPrice(ctx)
-	case payment.FieldCurrency:
-		return m.OldCurrency(ctx)
-	case payment.FieldBuyerName:
-		return m.OldBuyerName(ctx)
-	case payment.FieldBuyerEmail:
-		return m.OldBuyerEmail(ctx)
-	case payment.FieldBuyerPhone:
-		return m.OldBuyerPhone(ctx)
-	case payment.FieldPaymentKey:
-		return m.OldPaymentKey(ctx)
-	case payment.FieldOrderID:
-		return m.OldOrderID(ctx)
-	case payment.FieldStatus:
+	case webhookdelivery.FieldEventType:
+		return m.OldEventType(ctx)
+	case webhookdelivery.FieldPayload:
+		return m.OldPayload(ctx)
+	case webhookdelivery.FieldStatus:
 		return m.OldStatus(ctx)
-	case payment.FieldCreatedAt:
+	case webhookdelivery.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case webhookdelivery.FieldLastStatusCode:
+		return m.OldLastStatusCode(ctx)
+	case webhookdelivery.FieldLastError:
+		return m.OldLastError(ctx)
+	case webhookdelivery.FieldNextAttemptAt:
+		return m.OldNextAttemptAt(ctx)
+	case webhookdelivery.FieldDeliveredAt:
+		return m.OldDeliveredAt(ctx)
+	case webhookdelivery.FieldReplayOf:
+		return m.OldReplayOf(ctx)
+	case webhookdelivery.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case payment.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Payment field %s", name)
+	return nil, fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case payment.FieldEventID:
+	case webhookdelivery.FieldEndpointID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEventID(v)
+		m.SetEndpointID(v)
 		return nil
-	case payment.FieldUserID:
+	case webhookdelivery.FieldOrganizationID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
+		m.SetOrganizationID(v)
 		return nil
-	case payment.FieldEventTitle:
-		v, ok := value.(string)
+	case webhookdelivery.FieldEventID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEventTitle(v)
+		m.SetEventID(v)
 		return nil
-	case payment.FieldTicketQuantity:
-		v, ok := value.(int)
+	case webhookdelivery.FieldEventType:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTicketQuantity(v)
+		m.SetEventType(v)
 		return nil
-	case payment.FieldTotalPrice:
-		v, ok := value.(float64)
+	case webhookdelivery.FieldPayload:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalPrice(v)
+		m.SetPayload(v)
 		return nil
-	case payment.FieldCurrency:
-		v, ok := value.(string)
+	case webhookdelivery.FieldStatus:
+		v, ok := value.(webhookdelivery.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrency(v)
+		m.SetStatus(v)
 		return nil
-	case payment.FieldBuyerName:
-		v, ok := value.(string)
+	case webhookdelivery.FieldAttempts:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyerName(v)
+		m.SetAttempts(v)
 		return nil
-	case payment.FieldBuyerEmail:
-		v, ok := value.(string)
+	case webhookdelivery.FieldLastStatusCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyerEmail(v)
+		m.SetLastStatusCode(v)
 		return nil
-	case payment.FieldBuyerPhone:
+	case webhookdelivery.FieldLastError:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBuyerPhone(v)
+		m.SetLastError(v)
 		return nil
-	case payment.FieldPaymentKey:
-		v, ok := value.(string)
+	case webhookdelivery.FieldNextAttemptAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPaymentKey(v)
+		m.SetNextAttemptAt(v)
 		return nil
-	case payment.FieldOrderID:
-		v, ok := value.(string)
+	case webhookdelivery.FieldDeliveredAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOrderID(v)
+		m.SetDeliveredAt(v)
 		return nil
-	case payment.FieldStatus:
-		v, ok := value.(payment.Status)
+	case webhookdelivery.FieldReplayOf:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStatus(v)
+		m.SetReplayOf(v)
 		return nil
-	case payment.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case payment.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -4421,18 +22646,18 @@ func (m *PaymentMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Payment field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *PaymentMutation) AddedFields() []string {
+func (m *WebhookDeliveryMutation) AddedFields() []string {
 	var fields []string
-	if m.addticket_quantity != nil {
-		fields = append(fields, payment.FieldTicketQuantity)
+	if m.addattempts != nil {
+		fields = append(fields, webhookdelivery.FieldAttempts)
 	}
-	if m.addtotal_price != nil {
-		fields = append(fields, payment.FieldTotalPrice)
+	if m.addlast_status_code != nil {
+		fields = append(fields, webhookdelivery.FieldLastStatusCode)
 	}
 	return fields
 }
@@ -4440,12 +22665,12 @@ func (m *PaymentMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *PaymentMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookDeliveryMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case payment.FieldTicketQuantity:
-		return m.AddedTicketQuantity()
-	case payment.FieldTotalPrice:
-		return m.AddedTotalPrice()
+	case webhookdelivery.FieldAttempts:
+		return m.AddedAttempts()
+	case webhookdelivery.FieldLastStatusCode:
+		return m.AddedLastStatusCode()
 	}
 	return nil, false
 }
@@ -4453,138 +22678,143 @@ func (m *PaymentMutation) AddedField(name string) (ent.Value, bool) {
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *PaymentMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookDeliveryMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case payment.FieldTicketQuantity:
+	case webhookdelivery.FieldAttempts:
 		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTicketQuantity(v)
+		m.AddAttempts(v)
 		return nil
-	case payment.FieldTotalPrice:
-		v, ok := value.(float64)
+	case webhookdelivery.FieldLastStatusCode:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTotalPrice(v)
+		m.AddLastStatusCode(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Payment numeric field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *PaymentMutation) ClearedFields() []string {
+func (m *WebhookDeliveryMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(payment.FieldUserID) {
-		fields = append(fields, payment.FieldUserID)
+	if m.FieldCleared(webhookdelivery.FieldLastStatusCode) {
+		fields = append(fields, webhookdelivery.FieldLastStatusCode)
 	}
-	if m.FieldCleared(payment.FieldPaymentKey) {
-		fields = append(fields, payment.FieldPaymentKey)
+	if m.FieldCleared(webhookdelivery.FieldLastError) {
+		fields = append(fields, webhookdelivery.FieldLastError)
 	}
-	if m.FieldCleared(payment.FieldOrderID) {
-		fields = append(fields, payment.FieldOrderID)
+	if m.FieldCleared(webhookdelivery.FieldNextAttemptAt) {
+		fields = append(fields, webhookdelivery.FieldNextAttemptAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldDeliveredAt) {
+		fields = append(fields, webhookdelivery.FieldDeliveredAt)
+	}
+	if m.FieldCleared(webhookdelivery.FieldReplayOf) {
+		fields = append(fields, webhookdelivery.FieldReplayOf)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *PaymentMutation) FieldCleared(name string) bool {
+func (m *WebhookDeliveryMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *PaymentMutation) ClearField(name string) error {
+func (m *WebhookDeliveryMutation) ClearField(name string) error {
 	switch name {
-	case payment.FieldUserID:
-		m.ClearUserID()
+	case webhookdelivery.FieldLastStatusCode:
+		m.ClearLastStatusCode()
 		return nil
-	case payment.FieldPaymentKey:
-		m.ClearPaymentKey()
+	case webhookdelivery.FieldLastError:
+		m.ClearLastError()
 		return nil
-	case payment.FieldOrderID:
-		m.ClearOrderID()
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ClearNextAttemptAt()
+		return nil
+	case webhookdelivery.FieldDeliveredAt:
+		m.ClearDeliveredAt()
+		return nil
+	case webhookdelivery.FieldReplayOf:
+		m.ClearReplayOf()
 		return nil
 	}
-	return fmt.Errorf("unknown Payment nullable field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *PaymentMutation) ResetField(name string) error {
+func (m *WebhookDeliveryMutation) ResetField(name string) error {
 	switch name {
-	case payment.FieldEventID:
-		m.ResetEventID()
+	case webhookdelivery.FieldEndpointID:
+		m.ResetEndpointID()
 		return nil
-	case payment.FieldUserID:
-		m.ResetUserID()
+	case webhookdelivery.FieldOrganizationID:
+		m.ResetOrganizationID()
 		return nil
-	case payment.FieldEventTitle:
-		m.ResetEventTitle()
+	case webhookdelivery.FieldEventID:
+		m.ResetEventID()
 		return nil
-	case payment.FieldTicketQuantity:
-		m.ResetTicketQuantity()
+	case webhookdelivery.FieldEventType:
+		m.ResetEventType()
 		return nil
-	case payment.FieldTotalPrice:
-		m.ResetTotalPrice()
+	case webhookdelivery.FieldPayload:
+		m.ResetPayload()
 		return nil
-	case payment.FieldCurrency:
-		m.ResetCurrency()
+	case webhookdelivery.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case payment.FieldBuyerName:
-		m.ResetBuyerName()
+	case webhookdelivery.FieldAttempts:
+		m.ResetAttempts()
 		return nil
-	case payment.FieldBuyerEmail:
-		m.ResetBuyerEmail()
+	case webhookdelivery.FieldLastStatusCode:
+		m.ResetLastStatusCode()
 		return nil
-	case payment.FieldBuyerPhone:
-		m.ResetBuyerPhone()
+	case webhookdelivery.FieldLastError:
+		m.ResetLastError()
 		return nil
-	case payment.FieldPaymentKey:
-		m.ResetPaymentKey()
+	case webhookdelivery.FieldNextAttemptAt:
+		m.ResetNextAttemptAt()
 		return nil
-	case payment.FieldOrderID:
-		m.ResetOrderID()
+	case webhookdelivery.FieldDeliveredAt:
+		m.ResetDeliveredAt()
 		return nil
-	case payment.FieldStatus:
-		m.ResetStatus()
+	case webhookdelivery.FieldReplayOf:
+		m.ResetReplayOf()
 		return nil
-	case payment.FieldCreatedAt:
+	case webhookdelivery.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case payment.FieldUpdatedAt:
+	case webhookdelivery.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Payment field %s", name)
+	return fmt.Errorf("unknown WebhookDelivery field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PaymentMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.event != nil {
-		edges = append(edges, payment.EdgeEvent)
-	}
-	if m.user != nil {
-		edges = append(edges, payment.EdgeUser)
+func (m *WebhookDeliveryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.endpoint != nil {
+		edges = append(edges, webhookdelivery.EdgeEndpoint)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *PaymentMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case payment.EdgeEvent:
-		if id := m.event; id != nil {
-			return []ent.Value{*id}
-		}
-	case payment.EdgeUser:
-		if id := m.user; id != nil {
+	case webhookdelivery.EdgeEndpoint:
+		if id := m.endpoint; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -4592,114 +22822,94 @@ func (m *PaymentMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PaymentMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+func (m *WebhookDeliveryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *PaymentMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookDeliveryMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PaymentMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedevent {
-		edges = append(edges, payment.EdgeEvent)
-	}
-	if m.cleareduser {
-		edges = append(edges, payment.EdgeUser)
+func (m *WebhookDeliveryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedendpoint {
+		edges = append(edges, webhookdelivery.EdgeEndpoint)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *PaymentMutation) EdgeCleared(name string) bool {
+func (m *WebhookDeliveryMutation) EdgeCleared(name string) bool {
 	switch name {
-	case payment.EdgeEvent:
-		return m.clearedevent
-	case payment.EdgeUser:
-		return m.cleareduser
+	case webhookdelivery.EdgeEndpoint:
+		return m.clearedendpoint
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *PaymentMutation) ClearEdge(name string) error {
+func (m *WebhookDeliveryMutation) ClearEdge(name string) error {
 	switch name {
-	case payment.EdgeEvent:
-		m.ClearEvent()
-		return nil
-	case payment.EdgeUser:
-		m.ClearUser()
+	case webhookdelivery.EdgeEndpoint:
+		m.ClearEndpoint()
 		return nil
 	}
-	return fmt.Errorf("unknown Payment unique edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *PaymentMutation) ResetEdge(name string) error {
+func (m *WebhookDeliveryMutation) ResetEdge(name string) error {
 	switch name {
-	case payment.EdgeEvent:
-		m.ResetEvent()
-		return nil
-	case payment.EdgeUser:
-		m.ResetUser()
+	case webhookdelivery.EdgeEndpoint:
+		m.ResetEndpoint()
 		return nil
 	}
-	return fmt.Errorf("unknown Payment edge %s", name)
+	return fmt.Errorf("unknown WebhookDelivery edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WebhookEndpointMutation represents an operation that mutates the WebhookEndpoint nodes in the graph.
+type WebhookEndpointMutation struct {
 	config
-	op                         Op
-	typ                        string
-	id                         *uuid.UUID
-	first_name                 *string
-	last_name                  *string
-	nick_name                  *string
-	birthday                   *string
-	email                      *string
-	password                   *string
-	phone_number               *string
-	is_valid                   *bool
-	created_at                 *time.Time
-	updated_at                 *time.Time
-	clearedFields              map[string]struct{}
-	owned_organizations        map[uuid.UUID]struct{}
-	removedowned_organizations map[uuid.UUID]struct{}
-	clearedowned_organizations bool
-	memberships                map[uuid.UUID]struct{}
-	removedmemberships         map[uuid.UUID]struct{}
-	clearedmemberships         bool
-	created_events             map[uuid.UUID]struct{}
-	removedcreated_events      map[uuid.UUID]struct{}
-	clearedcreated_events      bool
-	payments                   map[uuid.UUID]struct{}
-	removedpayments            map[uuid.UUID]struct{}
-	clearedpayments            bool
-	done                       bool
-	oldValue                   func(context.Context) (*User, error)
-	predicates                 []predicate.User
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	url                 *string
+	secret              *string
+	event_types         *[]string
+	appendevent_types   []string
+	is_active           *bool
+	created_by          *uuid.UUID
+	created_at          *time.Time
+	updated_at          *time.Time
+	clearedFields       map[string]struct{}
+	organization        *uuid.UUID
+	clearedorganization bool
+	deliveries          map[uuid.UUID]struct{}
+	removeddeliveries   map[uuid.UUID]struct{}
+	cleareddeliveries   bool
+	done                bool
+	oldValue            func(context.Context) (*WebhookEndpoint, error)
+	predicates          []predicate.WebhookEndpoint
 }
 
-var _ ent.Mutation = (*UserMutation)(nil)
+var _ ent.Mutation = (*WebhookEndpointMutation)(nil)
 
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
+// webhookendpointOption allows management of the mutation configuration using functional options.
+type webhookendpointOption func(*WebhookEndpointMutation)
 
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+// newWebhookEndpointMutation creates new mutation for the WebhookEndpoint entity.
+func newWebhookEndpointMutation(c config, op Op, opts ...webhookendpointOption) *WebhookEndpointMutation {
+	m := &WebhookEndpointMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWebhookEndpoint,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4708,20 +22918,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWebhookEndpointID sets the ID field of the mutation.
+func withWebhookEndpointID(id uuid.UUID) webhookendpointOption {
+	return func(m *WebhookEndpointMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WebhookEndpoint
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WebhookEndpoint, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WebhookEndpoint.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4730,10 +22940,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWebhookEndpoint sets the old WebhookEndpoint of the mutation.
+func withWebhookEndpoint(node *WebhookEndpoint) webhookendpointOption {
+	return func(m *WebhookEndpointMutation) {
+		m.oldValue = func(context.Context) (*WebhookEndpoint, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -4742,7 +22952,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WebhookEndpointMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -4750,7 +22960,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WebhookEndpointMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -4760,14 +22970,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WebhookEndpoint entities.
+func (m *WebhookEndpointMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WebhookEndpointMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -4778,7 +22988,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WebhookEndpointMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -4787,307 +22997,250 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WebhookEndpoint.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetFirstName sets the "first_name" field.
-func (m *UserMutation) SetFirstName(s string) {
-	m.first_name = &s
-}
-
-// FirstName returns the value of the "first_name" field in the mutation.
-func (m *UserMutation) FirstName() (r string, exists bool) {
-	v := m.first_name
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldFirstName returns the old "first_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldFirstName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldFirstName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldFirstName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldFirstName: %w", err)
-	}
-	return oldValue.FirstName, nil
-}
-
-// ResetFirstName resets all changes to the "first_name" field.
-func (m *UserMutation) ResetFirstName() {
-	m.first_name = nil
-}
-
-// SetLastName sets the "last_name" field.
-func (m *UserMutation) SetLastName(s string) {
-	m.last_name = &s
+// SetOrganizationID sets the "organization_id" field.
+func (m *WebhookEndpointMutation) SetOrganizationID(u uuid.UUID) {
+	m.organization = &u
 }
 
-// LastName returns the value of the "last_name" field in the mutation.
-func (m *UserMutation) LastName() (r string, exists bool) {
-	v := m.last_name
+// OrganizationID returns the value of the "organization_id" field in the mutation.
+func (m *WebhookEndpointMutation) OrganizationID() (r uuid.UUID, exists bool) {
+	v := m.organization
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastName returns the old "last_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldOrganizationID returns the old "organization_id" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastName(ctx context.Context) (v string, err error) {
+func (m *WebhookEndpointMutation) OldOrganizationID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastName is only allowed on UpdateOne operations")
+		return v, errors.New("OldOrganizationID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastName requires an ID field in the mutation")
+		return v, errors.New("OldOrganizationID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastName: %w", err)
+		return v, fmt.Errorf("querying old value for OldOrganizationID: %w", err)
 	}
-	return oldValue.LastName, nil
+	return oldValue.OrganizationID, nil
 }
 
-// ResetLastName resets all changes to the "last_name" field.
-func (m *UserMutation) ResetLastName() {
-	m.last_name = nil
+// ResetOrganizationID resets all changes to the "organization_id" field.
+func (m *WebhookEndpointMutation) ResetOrganizationID() {
+	m.organization = nil
 }
 
-// SetNickName sets the "nick_name" field.
-func (m *UserMutation) SetNickName(s string) {
-	m.nick_name = &s
+// SetURL sets the "url" field.
+func (m *WebhookEndpointMutation) SetURL(s string) {
+	m.url = &s
 }
 
-// NickName returns the value of the "nick_name" field in the mutation.
-func (m *UserMutation) NickName() (r string, exists bool) {
-	v := m.nick_name
+// URL returns the value of the "url" field in the mutation.
+func (m *WebhookEndpointMutation) URL() (r string, exists bool) {
+	v := m.url
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldNickName returns the old "nick_name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldURL returns the old "url" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldNickName(ctx context.Context) (v string, err error) {
+func (m *WebhookEndpointMutation) OldURL(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldNickName is only allowed on UpdateOne operations")
+		return v, errors.New("OldURL is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldNickName requires an ID field in the mutation")
+		return v, errors.New("OldURL requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldNickName: %w", err)
+		return v, fmt.Errorf("querying old value for OldURL: %w", err)
 	}
-	return oldValue.NickName, nil
+	return oldValue.URL, nil
 }
 
-// ResetNickName resets all changes to the "nick_name" field.
-func (m *UserMutation) ResetNickName() {
-	m.nick_name = nil
+// ResetURL resets all changes to the "url" field.
+func (m *WebhookEndpointMutation) ResetURL() {
+	m.url = nil
 }
 
-// SetBirthday sets the "birthday" field.
-func (m *UserMutation) SetBirthday(s string) {
-	m.birthday = &s
+// SetSecret sets the "secret" field.
+func (m *WebhookEndpointMutation) SetSecret(s string) {
+	m.secret = &s
 }
 
-// Birthday returns the value of the "birthday" field in the mutation.
-func (m *UserMutation) Birthday() (r string, exists bool) {
-	v := m.birthday
+// Secret returns the value of the "secret" field in the mutation.
+func (m *WebhookEndpointMutation) Secret() (r string, exists bool) {
+	v := m.secret
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBirthday returns the old "birthday" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldSecret returns the old "secret" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldBirthday(ctx context.Context) (v string, err error) {
+func (m *WebhookEndpointMutation) OldSecret(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBirthday is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecret is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBirthday requires an ID field in the mutation")
+		return v, errors.New("OldSecret requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBirthday: %w", err)
+		return v, fmt.Errorf("querying old value for OldSecret: %w", err)
 	}
-	return oldValue.Birthday, nil
+	return oldValue.Secret, nil
 }
 
-// ResetBirthday resets all changes to the "birthday" field.
-func (m *UserMutation) ResetBirthday() {
-	m.birthday = nil
+// ResetSecret resets all changes to the "secret" field.
+func (m *WebhookEndpointMutation) ResetSecret() {
+	m.secret = nil
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetEventTypes sets the "event_types" field.
+func (m *WebhookEndpointMutation) SetEventTypes(s []string) {
+	m.event_types = &s
+	m.appendevent_types = nil
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// EventTypes returns the value of the "event_types" field in the mutation.
+func (m *WebhookEndpointMutation) EventTypes() (r []string, exists bool) {
+	v := m.event_types
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldEventTypes returns the old "event_types" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WebhookEndpointMutation) OldEventTypes(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldEventTypes is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldEventTypes requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldEventTypes: %w", err)
 	}
-	return oldValue.Email, nil
-}
-
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
-}
-
-// SetPassword sets the "password" field.
-func (m *UserMutation) SetPassword(s string) {
-	m.password = &s
+	return oldValue.EventTypes, nil
 }
 
-// Password returns the value of the "password" field in the mutation.
-func (m *UserMutation) Password() (r string, exists bool) {
-	v := m.password
-	if v == nil {
-		return
-	}
-	return *v, true
+// AppendEventTypes adds s to the "event_types" field.
+func (m *WebhookEndpointMutation) AppendEventTypes(s []string) {
+	m.appendevent_types = append(m.appendevent_types, s...)
 }
 
-// OldPassword returns the old "password" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPassword(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPassword is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPassword requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPassword: %w", err)
+// AppendedEventTypes returns the list of values that were appended to the "event_types" field in this mutation.
+func (m *WebhookEndpointMutation) AppendedEventTypes() ([]string, bool) {
+	if len(m.appendevent_types) == 0 {
+		return nil, false
 	}
-	return oldValue.Password, nil
+	return m.appendevent_types, true
 }
 
-// ResetPassword resets all changes to the "password" field.
-func (m *UserMutation) ResetPassword() {
-	m.password = nil
+// ResetEventTypes resets all changes to the "event_types" field.
+func (m *WebhookEndpointMutation) ResetEventTypes() {
+	m.event_types = nil
+	m.appendevent_types = nil
 }
 
-// SetPhoneNumber sets the "phone_number" field.
-func (m *UserMutation) SetPhoneNumber(s string) {
-	m.phone_number = &s
+// SetIsActive sets the "is_active" field.
+func (m *WebhookEndpointMutation) SetIsActive(b bool) {
+	m.is_active = &b
 }
 
-// PhoneNumber returns the value of the "phone_number" field in the mutation.
-func (m *UserMutation) PhoneNumber() (r string, exists bool) {
-	v := m.phone_number
+// IsActive returns the value of the "is_active" field in the mutation.
+func (m *WebhookEndpointMutation) IsActive() (r bool, exists bool) {
+	v := m.is_active
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPhoneNumber returns the old "phone_number" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldIsActive returns the old "is_active" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPhoneNumber(ctx context.Context) (v string, err error) {
+func (m *WebhookEndpointMutation) OldIsActive(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPhoneNumber is only allowed on UpdateOne operations")
+		return v, errors.New("OldIsActive is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPhoneNumber requires an ID field in the mutation")
+		return v, errors.New("OldIsActive requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPhoneNumber: %w", err)
+		return v, fmt.Errorf("querying old value for OldIsActive: %w", err)
 	}
-	return oldValue.PhoneNumber, nil
+	return oldValue.IsActive, nil
 }
 
-// ResetPhoneNumber resets all changes to the "phone_number" field.
-func (m *UserMutation) ResetPhoneNumber() {
-	m.phone_number = nil
+// ResetIsActive resets all changes to the "is_active" field.
+func (m *WebhookEndpointMutation) ResetIsActive() {
+	m.is_active = nil
 }
 
-// SetIsValid sets the "is_valid" field.
-func (m *UserMutation) SetIsValid(b bool) {
-	m.is_valid = &b
+// SetCreatedBy sets the "created_by" field.
+func (m *WebhookEndpointMutation) SetCreatedBy(u uuid.UUID) {
+	m.created_by = &u
 }
 
-// IsValid returns the value of the "is_valid" field in the mutation.
-func (m *UserMutation) IsValid() (r bool, exists bool) {
-	v := m.is_valid
+// CreatedBy returns the value of the "created_by" field in the mutation.
+func (m *WebhookEndpointMutation) CreatedBy() (r uuid.UUID, exists bool) {
+	v := m.created_by
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsValid returns the old "is_valid" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedBy returns the old "created_by" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldIsValid(ctx context.Context) (v bool, err error) {
+func (m *WebhookEndpointMutation) OldCreatedBy(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsValid is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedBy is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsValid requires an ID field in the mutation")
+		return v, errors.New("OldCreatedBy requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsValid: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedBy: %w", err)
 	}
-	return oldValue.IsValid, nil
+	return oldValue.CreatedBy, nil
 }
 
-// ResetIsValid resets all changes to the "is_valid" field.
-func (m *UserMutation) ResetIsValid() {
-	m.is_valid = nil
+// ResetCreatedBy resets all changes to the "created_by" field.
+func (m *WebhookEndpointMutation) ResetCreatedBy() {
+	m.created_by = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *WebhookEndpointMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WebhookEndpointMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -5095,10 +23248,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookEndpointMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -5113,17 +23266,17 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *WebhookEndpointMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *WebhookEndpointMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *WebhookEndpointMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -5131,10 +23284,10 @@ func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the WebhookEndpoint entity.
+// If the WebhookEndpoint object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WebhookEndpointMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -5149,235 +23302,100 @@ func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
+func (m *WebhookEndpointMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// AddOwnedOrganizationIDs adds the "owned_organizations" edge to the Organization entity by ids.
-func (m *UserMutation) AddOwnedOrganizationIDs(ids ...uuid.UUID) {
-	if m.owned_organizations == nil {
-		m.owned_organizations = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.owned_organizations[ids[i]] = struct{}{}
-	}
-}
-
-// ClearOwnedOrganizations clears the "owned_organizations" edge to the Organization entity.
-func (m *UserMutation) ClearOwnedOrganizations() {
-	m.clearedowned_organizations = true
-}
-
-// OwnedOrganizationsCleared reports if the "owned_organizations" edge to the Organization entity was cleared.
-func (m *UserMutation) OwnedOrganizationsCleared() bool {
-	return m.clearedowned_organizations
-}
-
-// RemoveOwnedOrganizationIDs removes the "owned_organizations" edge to the Organization entity by IDs.
-func (m *UserMutation) RemoveOwnedOrganizationIDs(ids ...uuid.UUID) {
-	if m.removedowned_organizations == nil {
-		m.removedowned_organizations = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.owned_organizations, ids[i])
-		m.removedowned_organizations[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedOwnedOrganizations returns the removed IDs of the "owned_organizations" edge to the Organization entity.
-func (m *UserMutation) RemovedOwnedOrganizationsIDs() (ids []uuid.UUID) {
-	for id := range m.removedowned_organizations {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// OwnedOrganizationsIDs returns the "owned_organizations" edge IDs in the mutation.
-func (m *UserMutation) OwnedOrganizationsIDs() (ids []uuid.UUID) {
-	for id := range m.owned_organizations {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetOwnedOrganizations resets all changes to the "owned_organizations" edge.
-func (m *UserMutation) ResetOwnedOrganizations() {
-	m.owned_organizations = nil
-	m.clearedowned_organizations = false
-	m.removedowned_organizations = nil
-}
-
-// AddMembershipIDs adds the "memberships" edge to the OrganizationMember entity by ids.
-func (m *UserMutation) AddMembershipIDs(ids ...uuid.UUID) {
-	if m.memberships == nil {
-		m.memberships = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.memberships[ids[i]] = struct{}{}
-	}
-}
-
-// ClearMemberships clears the "memberships" edge to the OrganizationMember entity.
-func (m *UserMutation) ClearMemberships() {
-	m.clearedmemberships = true
-}
-
-// MembershipsCleared reports if the "memberships" edge to the OrganizationMember entity was cleared.
-func (m *UserMutation) MembershipsCleared() bool {
-	return m.clearedmemberships
-}
-
-// RemoveMembershipIDs removes the "memberships" edge to the OrganizationMember entity by IDs.
-func (m *UserMutation) RemoveMembershipIDs(ids ...uuid.UUID) {
-	if m.removedmemberships == nil {
-		m.removedmemberships = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.memberships, ids[i])
-		m.removedmemberships[ids[i]] = struct{}{}
-	}
-}
-
-// RemovedMemberships returns the removed IDs of the "memberships" edge to the OrganizationMember entity.
-func (m *UserMutation) RemovedMembershipsIDs() (ids []uuid.UUID) {
-	for id := range m.removedmemberships {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// MembershipsIDs returns the "memberships" edge IDs in the mutation.
-func (m *UserMutation) MembershipsIDs() (ids []uuid.UUID) {
-	for id := range m.memberships {
-		ids = append(ids, id)
-	}
-	return
-}
-
-// ResetMemberships resets all changes to the "memberships" edge.
-func (m *UserMutation) ResetMemberships() {
-	m.memberships = nil
-	m.clearedmemberships = false
-	m.removedmemberships = nil
-}
-
-// AddCreatedEventIDs adds the "created_events" edge to the Event entity by ids.
-func (m *UserMutation) AddCreatedEventIDs(ids ...uuid.UUID) {
-	if m.created_events == nil {
-		m.created_events = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.created_events[ids[i]] = struct{}{}
-	}
-}
-
-// ClearCreatedEvents clears the "created_events" edge to the Event entity.
-func (m *UserMutation) ClearCreatedEvents() {
-	m.clearedcreated_events = true
-}
-
-// CreatedEventsCleared reports if the "created_events" edge to the Event entity was cleared.
-func (m *UserMutation) CreatedEventsCleared() bool {
-	return m.clearedcreated_events
-}
-
-// RemoveCreatedEventIDs removes the "created_events" edge to the Event entity by IDs.
-func (m *UserMutation) RemoveCreatedEventIDs(ids ...uuid.UUID) {
-	if m.removedcreated_events == nil {
-		m.removedcreated_events = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.created_events, ids[i])
-		m.removedcreated_events[ids[i]] = struct{}{}
-	}
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (m *WebhookEndpointMutation) ClearOrganization() {
+	m.clearedorganization = true
+	m.clearedFields[webhookendpoint.FieldOrganizationID] = struct{}{}
 }
 
-// RemovedCreatedEvents returns the removed IDs of the "created_events" edge to the Event entity.
-func (m *UserMutation) RemovedCreatedEventsIDs() (ids []uuid.UUID) {
-	for id := range m.removedcreated_events {
-		ids = append(ids, id)
-	}
-	return
+// OrganizationCleared reports if the "organization" edge to the Organization entity was cleared.
+func (m *WebhookEndpointMutation) OrganizationCleared() bool {
+	return m.clearedorganization
 }
 
-// CreatedEventsIDs returns the "created_events" edge IDs in the mutation.
-func (m *UserMutation) CreatedEventsIDs() (ids []uuid.UUID) {
-	for id := range m.created_events {
-		ids = append(ids, id)
+// OrganizationIDs returns the "organization" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// OrganizationID instead. It exists only for internal usage by the builders.
+func (m *WebhookEndpointMutation) OrganizationIDs() (ids []uuid.UUID) {
+	if id := m.organization; id != nil {
+		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetCreatedEvents resets all changes to the "created_events" edge.
-func (m *UserMutation) ResetCreatedEvents() {
-	m.created_events = nil
-	m.clearedcreated_events = false
-	m.removedcreated_events = nil
+// ResetOrganization resets all changes to the "organization" edge.
+func (m *WebhookEndpointMutation) ResetOrganization() {
+	m.organization = nil
+	m.clearedorganization = false
 }
 
-// AddPaymentIDs adds the "payments" edge to the Payment entity by ids.
-func (m *UserMutation) AddPaymentIDs(ids ...uuid.UUID) {
-	if m.payments == nil {
-		m.payments = make(map[uuid.UUID]struct{})
+// AddDeliveryIDs adds the "deliveries" edge to the WebhookDelivery entity by ids.
+func (m *WebhookEndpointMutation) AddDeliveryIDs(ids ...uuid.UUID) {
+	if m.deliveries == nil {
+		m.deliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		m.payments[ids[i]] = struct{}{}
+		m.deliveries[ids[i]] = struct{}{}
 	}
 }
 
-// ClearPayments clears the "payments" edge to the Payment entity.
-func (m *UserMutation) ClearPayments() {
-	m.clearedpayments = true
+// ClearDeliveries clears the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookEndpointMutation) ClearDeliveries() {
+	m.cleareddeliveries = true
 }
 
-// PaymentsCleared reports if the "payments" edge to the Payment entity was cleared.
-func (m *UserMutation) PaymentsCleared() bool {
-	return m.clearedpayments
+// DeliveriesCleared reports if the "deliveries" edge to the WebhookDelivery entity was cleared.
+func (m *WebhookEndpointMutation) DeliveriesCleared() bool {
+	return m.cleareddeliveries
 }
 
-// RemovePaymentIDs removes the "payments" edge to the Payment entity by IDs.
-func (m *UserMutation) RemovePaymentIDs(ids ...uuid.UUID) {
-	if m.removedpayments == nil {
-		m.removedpayments = make(map[uuid.UUID]struct{})
+// RemoveDeliveryIDs removes the "deliveries" edge to the WebhookDelivery entity by IDs.
+func (m *WebhookEndpointMutation) RemoveDeliveryIDs(ids ...uuid.UUID) {
+	if m.removeddeliveries == nil {
+		m.removeddeliveries = make(map[uuid.UUID]struct{})
 	}
 	for i := range ids {
-		delete(m.payments, ids[i])
-		m.removedpayments[ids[i]] = struct{}{}
+		delete(m.deliveries, ids[i])
+		m.removeddeliveries[ids[i]] = struct{}{}
 	}
 }
 
-// RemovedPayments returns the removed IDs of the "payments" edge to the Payment entity.
-func (m *UserMutation) RemovedPaymentsIDs() (ids []uuid.UUID) {
-	for id := range m.removedpayments {
+// RemovedDeliveries returns the removed IDs of the "deliveries" edge to the WebhookDelivery entity.
+func (m *WebhookEndpointMutation) RemovedDeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.removeddeliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// PaymentsIDs returns the "payments" edge IDs in the mutation.
-func (m *UserMutation) PaymentsIDs() (ids []uuid.UUID) {
-	for id := range m.payments {
+// DeliveriesIDs returns the "deliveries" edge IDs in the mutation.
+func (m *WebhookEndpointMutation) DeliveriesIDs() (ids []uuid.UUID) {
+	for id := range m.deliveries {
 		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetPayments resets all changes to the "payments" edge.
-func (m *UserMutation) ResetPayments() {
-	m.payments = nil
-	m.clearedpayments = false
-	m.removedpayments = nil
+// ResetDeliveries resets all changes to the "deliveries" edge.
+func (m *WebhookEndpointMutation) ResetDeliveries() {
+	m.deliveries = nil
+	m.cleareddeliveries = false
+	m.removeddeliveries = nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
+// Where appends a list predicates to the WebhookEndpointMutation builder.
+func (m *WebhookEndpointMutation) Where(ps ...predicate.WebhookEndpoint) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WebhookEndpointMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
+func (m *WebhookEndpointMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WebhookEndpoint, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5385,54 +23403,48 @@ func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserMutation) Op() Op {
+func (m *WebhookEndpointMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
-	return m.typ
-}
-
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.first_name != nil {
-		fields = append(fields, user.FieldFirstName)
-	}
-	if m.last_name != nil {
-		fields = append(fields, user.FieldLastName)
-	}
-	if m.nick_name != nil {
-		fields = append(fields, user.FieldNickName)
+func (m *WebhookEndpointMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (WebhookEndpoint).
+func (m *WebhookEndpointMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WebhookEndpointMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.organization != nil {
+		fields = append(fields, webhookendpoint.FieldOrganizationID)
 	}
-	if m.birthday != nil {
-		fields = append(fields, user.FieldBirthday)
+	if m.url != nil {
+		fields = append(fields, webhookendpoint.FieldURL)
 	}
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
+	if m.secret != nil {
+		fields = append(fields, webhookendpoint.FieldSecret)
 	}
-	if m.password != nil {
-		fields = append(fields, user.FieldPassword)
+	if m.event_types != nil {
+		fields = append(fields, webhookendpoint.FieldEventTypes)
 	}
-	if m.phone_number != nil {
-		fields = append(fields, user.FieldPhoneNumber)
+	if m.is_active != nil {
+		fields = append(fields, webhookendpoint.FieldIsActive)
 	}
-	if m.is_valid != nil {
-		fields = append(fields, user.FieldIsValid)
+	if m.created_by != nil {
+		fields = append(fields, webhookendpoint.FieldCreatedBy)
 	}
 	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+		fields = append(fields, webhookendpoint.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+		fields = append(fields, webhookendpoint.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -5440,27 +23452,23 @@ func (m *UserMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
+func (m *WebhookEndpointMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case user.FieldFirstName:
-		return m.FirstName()
-	case user.FieldLastName:
-		return m.LastName()
-	case user.FieldNickName:
-		return m.NickName()
-	case user.FieldBirthday:
-		return m.Birthday()
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPassword:
-		return m.Password()
-	case user.FieldPhoneNumber:
-		return m.PhoneNumber()
-	case user.FieldIsValid:
-		return m.IsValid()
-	case user.FieldCreatedAt:
+	case webhookendpoint.FieldOrganizationID:
+		return m.OrganizationID()
+	case webhookendpoint.FieldURL:
+		return m.URL()
+	case webhookendpoint.FieldSecret:
+		return m.Secret()
+	case webhookendpoint.FieldEventTypes:
+		return m.EventTypes()
+	case webhookendpoint.FieldIsActive:
+		return m.IsActive()
+	case webhookendpoint.FieldCreatedBy:
+		return m.CreatedBy()
+	case webhookendpoint.FieldCreatedAt:
 		return m.CreatedAt()
-	case user.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -5469,101 +23477,83 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WebhookEndpointMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case user.FieldFirstName:
-		return m.OldFirstName(ctx)
-	case user.FieldLastName:
-		return m.OldLastName(ctx)
-	case user.FieldNickName:
-		return m.OldNickName(ctx)
-	case user.FieldBirthday:
-		return m.OldBirthday(ctx)
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPassword:
-		return m.OldPassword(ctx)
-	case user.FieldPhoneNumber:
-		return m.OldPhoneNumber(ctx)
-	case user.FieldIsValid:
-		return m.OldIsValid(ctx)
-	case user.FieldCreatedAt:
+	case webhookendpoint.FieldOrganizationID:
+		return m.OldOrganizationID(ctx)
+	case webhookendpoint.FieldURL:
+		return m.OldURL(ctx)
+	case webhookendpoint.FieldSecret:
+		return m.OldSecret(ctx)
+	case webhookendpoint.FieldEventTypes:
+		return m.OldEventTypes(ctx)
+	case webhookendpoint.FieldIsActive:
+		return m.OldIsActive(ctx)
+	case webhookendpoint.FieldCreatedBy:
+		return m.OldCreatedBy(ctx)
+	case webhookendpoint.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return nil, fmt.Errorf("unknown WebhookEndpoint field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
+func (m *WebhookEndpointMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case user.FieldFirstName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetFirstName(v)
-		return nil
-	case user.FieldLastName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastName(v)
-		return nil
-	case user.FieldNickName:
-		v, ok := value.(string)
+	case webhookendpoint.FieldOrganizationID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetNickName(v)
+		m.SetOrganizationID(v)
 		return nil
-	case user.FieldBirthday:
+	case webhookendpoint.FieldURL:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetBirthday(v)
+		m.SetURL(v)
 		return nil
-	case user.FieldEmail:
+	case webhookendpoint.FieldSecret:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetEmail(v)
+		m.SetSecret(v)
 		return nil
-	case user.FieldPassword:
-		v, ok := value.(string)
+	case webhookendpoint.FieldEventTypes:
+		v, ok := value.([]string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPassword(v)
+		m.SetEventTypes(v)
 		return nil
-	case user.FieldPhoneNumber:
-		v, ok := value.(string)
+	case webhookendpoint.FieldIsActive:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPhoneNumber(v)
+		m.SetIsActive(v)
 		return nil
-	case user.FieldIsValid:
-		v, ok := value.(bool)
+	case webhookendpoint.FieldCreatedBy:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsValid(v)
+		m.SetCreatedBy(v)
 		return nil
-	case user.FieldCreatedAt:
+	case webhookendpoint.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case user.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -5571,131 +23561,105 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserMutation) AddedFields() []string {
+func (m *WebhookEndpointMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WebhookEndpointMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
+func (m *WebhookEndpointMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserMutation) ClearedFields() []string {
+func (m *WebhookEndpointMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
+func (m *WebhookEndpointMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown User nullable field %s", name)
+func (m *WebhookEndpointMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown WebhookEndpoint nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
+func (m *WebhookEndpointMutation) ResetField(name string) error {
 	switch name {
-	case user.FieldFirstName:
-		m.ResetFirstName()
-		return nil
-	case user.FieldLastName:
-		m.ResetLastName()
-		return nil
-	case user.FieldNickName:
-		m.ResetNickName()
+	case webhookendpoint.FieldOrganizationID:
+		m.ResetOrganizationID()
 		return nil
-	case user.FieldBirthday:
-		m.ResetBirthday()
+	case webhookendpoint.FieldURL:
+		m.ResetURL()
 		return nil
-	case user.FieldEmail:
-		m.ResetEmail()
+	case webhookendpoint.FieldSecret:
+		m.ResetSecret()
 		return nil
-	case user.FieldPassword:
-		m.ResetPassword()
+	case webhookendpoint.FieldEventTypes:
+		m.ResetEventTypes()
 		return nil
-	case user.FieldPhoneNumber:
-		m.ResetPhoneNumber()
+	case webhookendpoint.FieldIsActive:
+		m.ResetIsActive()
 		return nil
-	case user.FieldIsValid:
-		m.ResetIsValid()
+	case webhookendpoint.FieldCreatedBy:
+		m.ResetCreatedBy()
 		return nil
-	case user.FieldCreatedAt:
+	case webhookendpoint.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case user.FieldUpdatedAt:
+	case webhookendpoint.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.owned_organizations != nil {
-		edges = append(edges, user.EdgeOwnedOrganizations)
-	}
-	if m.memberships != nil {
-		edges = append(edges, user.EdgeMemberships)
-	}
-	if m.created_events != nil {
-		edges = append(edges, user.EdgeCreatedEvents)
+func (m *WebhookEndpointMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.organization != nil {
+		edges = append(edges, webhookendpoint.EdgeOrganization)
 	}
-	if m.payments != nil {
-		edges = append(edges, user.EdgePayments)
+	if m.deliveries != nil {
+		edges = append(edges, webhookendpoint.EdgeDeliveries)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
+func (m *WebhookEndpointMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeOwnedOrganizations:
-		ids := make([]ent.Value, 0, len(m.owned_organizations))
-		for id := range m.owned_organizations {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.memberships))
-		for id := range m.memberships {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEvents:
-		ids := make([]ent.Value, 0, len(m.created_events))
-		for id := range m.created_events {
-			ids = append(ids, id)
+	case webhookendpoint.EdgeOrganization:
+		if id := m.organization; id != nil {
+			return []ent.Value{*id}
 		}
-		return ids
-	case user.EdgePayments:
-		ids := make([]ent.Value, 0, len(m.payments))
-		for id := range m.payments {
+	case webhookendpoint.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.deliveries))
+		for id := range m.deliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5704,48 +23668,21 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.removedowned_organizations != nil {
-		edges = append(edges, user.EdgeOwnedOrganizations)
-	}
-	if m.removedmemberships != nil {
-		edges = append(edges, user.EdgeMemberships)
-	}
-	if m.removedcreated_events != nil {
-		edges = append(edges, user.EdgeCreatedEvents)
-	}
-	if m.removedpayments != nil {
-		edges = append(edges, user.EdgePayments)
+func (m *WebhookEndpointMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeddeliveries != nil {
+		edges = append(edges, webhookendpoint.EdgeDeliveries)
 	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+func (m *WebhookEndpointMutation) RemovedIDs(name string) []ent.Value {
 	switch name {
-	case user.EdgeOwnedOrganizations:
-		ids := make([]ent.Value, 0, len(m.removedowned_organizations))
-		for id := range m.removedowned_organizations {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeMemberships:
-		ids := make([]ent.Value, 0, len(m.removedmemberships))
-		for id := range m.removedmemberships {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeCreatedEvents:
-		ids := make([]ent.Value, 0, len(m.removedcreated_events))
-		for id := range m.removedcreated_events {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgePayments:
-		ids := make([]ent.Value, 0, len(m.removedpayments))
-		for id := range m.removedpayments {
+	case webhookendpoint.EdgeDeliveries:
+		ids := make([]ent.Value, 0, len(m.removeddeliveries))
+		for id := range m.removeddeliveries {
 			ids = append(ids, id)
 		}
 		return ids
@@ -5754,63 +23691,50 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 4)
-	if m.clearedowned_organizations {
-		edges = append(edges, user.EdgeOwnedOrganizations)
-	}
-	if m.clearedmemberships {
-		edges = append(edges, user.EdgeMemberships)
-	}
-	if m.clearedcreated_events {
-		edges = append(edges, user.EdgeCreatedEvents)
+func (m *WebhookEndpointMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedorganization {
+		edges = append(edges, webhookendpoint.EdgeOrganization)
 	}
-	if m.clearedpayments {
-		edges = append(edges, user.EdgePayments)
+	if m.cleareddeliveries {
+		edges = append(edges, webhookendpoint.EdgeDeliveries)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
+func (m *WebhookEndpointMutation) EdgeCleared(name string) bool {
 	switch name {
-	case user.EdgeOwnedOrganizations:
-		return m.clearedowned_organizations
-	case user.EdgeMemberships:
-		return m.clearedmemberships
-	case user.EdgeCreatedEvents:
-		return m.clearedcreated_events
-	case user.EdgePayments:
-		return m.clearedpayments
+	case webhookendpoint.EdgeOrganization:
+		return m.clearedorganization
+	case webhookendpoint.EdgeDeliveries:
+		return m.cleareddeliveries
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
+func (m *WebhookEndpointMutation) ClearEdge(name string) error {
 	switch name {
+	case webhookendpoint.EdgeOrganization:
+		m.ClearOrganization()
+		return nil
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
+func (m *WebhookEndpointMutation) ResetEdge(name string) error {
 	switch name {
-	case user.EdgeOwnedOrganizations:
-		m.ResetOwnedOrganizations()
-		return nil
-	case user.EdgeMemberships:
-		m.ResetMemberships()
-		return nil
-	case user.EdgeCreatedEvents:
-		m.ResetCreatedEvents()
+	case webhookendpoint.EdgeOrganization:
+		m.ResetOrganization()
 		return nil
-	case user.EdgePayments:
-		m.ResetPayments()
+	case webhookendpoint.EdgeDeliveries:
+		m.ResetDeliveries()
 		return nil
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	return fmt.Errorf("unknown WebhookEndpoint edge %s", name)
 }
@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -21,16 +22,24 @@ type Organization struct {
 	ID uuid.UUID `json:"id,omitempty"`
 	// Organization name
 	Name string `json:"name,omitempty"`
+	// Lowercase name with Korean syllables reduced to their initial consonants, for search suggestions
+	NameInitials string `json:"name_initials,omitempty"`
 	// Organization description
 	Description string `json:"description,omitempty"`
 	// Organization logo URL
 	LogoURL string `json:"logo_url,omitempty"`
 	// Organization category (e.g., Technology, Music, Sports, Education, etc.)
 	Category string `json:"category,omitempty"`
+	// URL slug of the public organization profile
+	Slug *string `json:"slug,omitempty"`
+	// Social links keyed by network (website, instagram, x, ...)
+	SocialLinks map[string]string `json:"social_links,omitempty"`
 	// User ID of the organization owner
 	OwnerID uuid.UUID `json:"owner_id,omitempty"`
 	// Whether the organization is active
 	IsActive bool `json:"is_active,omitempty"`
+	// Set when the organization is deleted; deleted organizations keep their event and payment history
+	DeletedAt *time.Time `json:"deleted_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -45,13 +54,27 @@ type Organization struct {
 type OrganizationEdges struct {
 	// Members holds the value of the members edge.
 	Members []*OrganizationMember `json:"members,omitempty"`
+	// Roles holds the value of the roles edge.
+	Roles []*OrganizationRole `json:"roles,omitempty"`
+	// Invitations holds the value of the invitations edge.
+	Invitations []*OrganizationInvitation `json:"invitations,omitempty"`
+	// OwnershipTransfers holds the value of the ownership_transfers edge.
+	OwnershipTransfers []*OwnershipTransfer `json:"ownership_transfers,omitempty"`
+	// APIKeys holds the value of the api_keys edge.
+	APIKeys []*APIKey `json:"api_keys,omitempty"`
+	// WebhookEndpoints holds the value of the webhook_endpoints edge.
+	WebhookEndpoints []*WebhookEndpoint `json:"webhook_endpoints,omitempty"`
 	// Events holds the value of the events edge.
 	Events []*Event `json:"events,omitempty"`
+	// EventSeries holds the value of the event_series edge.
+	EventSeries []*EventSeries `json:"event_series,omitempty"`
+	// Venues holds the value of the venues edge.
+	Venues []*Venue `json:"venues,omitempty"`
 	// Owner holds the value of the owner edge.
 	Owner *User `json:"owner,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [10]bool
 }
 
 // MembersOrErr returns the Members value or an error if the edge
@@ -63,21 +86,84 @@ func (e OrganizationEdges) MembersOrErr() ([]*OrganizationMember, error) {
 	return nil, &NotLoadedError{edge: "members"}
 }
 
+// RolesOrErr returns the Roles value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) RolesOrErr() ([]*OrganizationRole, error) {
+	if e.loadedTypes[1] {
+		return e.Roles, nil
+	}
+	return nil, &NotLoadedError{edge: "roles"}
+}
+
+// InvitationsOrErr returns the Invitations value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) InvitationsOrErr() ([]*OrganizationInvitation, error) {
+	if e.loadedTypes[2] {
+		return e.Invitations, nil
+	}
+	return nil, &NotLoadedError{edge: "invitations"}
+}
+
+// OwnershipTransfersOrErr returns the OwnershipTransfers value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) OwnershipTransfersOrErr() ([]*OwnershipTransfer, error) {
+	if e.loadedTypes[3] {
+		return e.OwnershipTransfers, nil
+	}
+	return nil, &NotLoadedError{edge: "ownership_transfers"}
+}
+
+// APIKeysOrErr returns the APIKeys value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) APIKeysOrErr() ([]*APIKey, error) {
+	if e.loadedTypes[4] {
+		return e.APIKeys, nil
+	}
+	return nil, &NotLoadedError{edge: "api_keys"}
+}
+
+// WebhookEndpointsOrErr returns the WebhookEndpoints value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) WebhookEndpointsOrErr() ([]*WebhookEndpoint, error) {
+	if e.loadedTypes[5] {
+		return e.WebhookEndpoints, nil
+	}
+	return nil, &NotLoadedError{edge: "webhook_endpoints"}
+}
+
 // EventsOrErr returns the Events value or an error if the edge
 // was not loaded in eager-loading.
 func (e OrganizationEdges) EventsOrErr() ([]*Event, error) {
-	if e.loadedTypes[1] {
+	if e.loadedTypes[6] {
 		return e.Events, nil
 	}
 	return nil, &NotLoadedError{edge: "events"}
 }
 
+// EventSeriesOrErr returns the EventSeries value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) EventSeriesOrErr() ([]*EventSeries, error) {
+	if e.loadedTypes[7] {
+		return e.EventSeries, nil
+	}
+	return nil, &NotLoadedError{edge: "event_series"}
+}
+
+// VenuesOrErr returns the Venues value or an error if the edge
+// was not loaded in eager-loading.
+func (e OrganizationEdges) VenuesOrErr() ([]*Venue, error) {
+	if e.loadedTypes[8] {
+		return e.Venues, nil
+	}
+	return nil, &NotLoadedError{edge: "venues"}
+}
+
 // OwnerOrErr returns the Owner value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e OrganizationEdges) OwnerOrErr() (*User, error) {
 	if e.Owner != nil {
 		return e.Owner, nil
-	} else if e.loadedTypes[2] {
+	} else if e.loadedTypes[9] {
 		return nil, &NotFoundError{label: user.Label}
 	}
 	return nil, &NotLoadedError{edge: "owner"}
@@ -88,11 +174,13 @@ func (*Organization) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case organization.FieldSocialLinks:
+			values[i] = new([]byte)
 		case organization.FieldIsActive:
 			values[i] = new(sql.NullBool)
-		case organization.FieldName, organization.FieldDescription, organization.FieldLogoURL, organization.FieldCategory:
+		case organization.FieldName, organization.FieldNameInitials, organization.FieldDescription, organization.FieldLogoURL, organization.FieldCategory, organization.FieldSlug:
 			values[i] = new(sql.NullString)
-		case organization.FieldCreatedAt, organization.FieldUpdatedAt:
+		case organization.FieldDeletedAt, organization.FieldCreatedAt, organization.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
 		case organization.FieldID, organization.FieldOwnerID:
 			values[i] = new(uuid.UUID)
@@ -123,6 +211,12 @@ func (_m *Organization) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Name = value.String
 			}
+		case organization.FieldNameInitials:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name_initials", values[i])
+			} else if value.Valid {
+				_m.NameInitials = value.String
+			}
 		case organization.FieldDescription:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field description", values[i])
@@ -141,6 +235,21 @@ func (_m *Organization) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Category = value.String
 			}
+		case organization.FieldSlug:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field slug", values[i])
+			} else if value.Valid {
+				_m.Slug = new(string)
+				*_m.Slug = value.String
+			}
+		case organization.FieldSocialLinks:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field social_links", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.SocialLinks); err != nil {
+					return fmt.Errorf("unmarshal field social_links: %w", err)
+				}
+			}
 		case organization.FieldOwnerID:
 			if value, ok := values[i].(*uuid.UUID); !ok {
 				return fmt.Errorf("unexpected type %T for field owner_id", values[i])
@@ -153,6 +262,13 @@ func (_m *Organization) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsActive = value.Bool
 			}
+		case organization.FieldDeletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deleted_at", values[i])
+			} else if value.Valid {
+				_m.DeletedAt = new(time.Time)
+				*_m.DeletedAt = value.Time
+			}
 		case organization.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -183,11 +299,46 @@ func (_m *Organization) QueryMembers() *OrganizationMemberQuery {
 	return NewOrganizationClient(_m.config).QueryMembers(_m)
 }
 
+// QueryRoles queries the "roles" edge of the Organization entity.
+func (_m *Organization) QueryRoles() *OrganizationRoleQuery {
+	return NewOrganizationClient(_m.config).QueryRoles(_m)
+}
+
+// QueryInvitations queries the "invitations" edge of the Organization entity.
+func (_m *Organization) QueryInvitations() *OrganizationInvitationQuery {
+	return NewOrganizationClient(_m.config).QueryInvitations(_m)
+}
+
+// QueryOwnershipTransfers queries the "ownership_transfers" edge of the Organization entity.
+func (_m *Organization) QueryOwnershipTransfers() *OwnershipTransferQuery {
+	return NewOrganizationClient(_m.config).QueryOwnershipTransfers(_m)
+}
+
+// QueryAPIKeys queries the "api_keys" edge of the Organization entity.
+func (_m *Organization) QueryAPIKeys() *APIKeyQuery {
+	return NewOrganizationClient(_m.config).QueryAPIKeys(_m)
+}
+
+// QueryWebhookEndpoints queries the "webhook_endpoints" edge of the Organization entity.
+func (_m *Organization) QueryWebhookEndpoints() *WebhookEndpointQuery {
+	return NewOrganizationClient(_m.config).QueryWebhookEndpoints(_m)
+}
+
 // QueryEvents queries the "events" edge of the Organization entity.
 func (_m *Organization) QueryEvents() *EventQuery {
 	return NewOrganizationClient(_m.config).QueryEvents(_m)
 }
 
+// QueryEventSeries queries the "event_series" edge of the Organization entity.
+func (_m *Organization) QueryEventSeries() *EventSeriesQuery {
+	return NewOrganizationClient(_m.config).QueryEventSeries(_m)
+}
+
+// QueryVenues queries the "venues" edge of the Organization entity.
+func (_m *Organization) QueryVenues() *VenueQuery {
+	return NewOrganizationClient(_m.config).QueryVenues(_m)
+}
+
 // QueryOwner queries the "owner" edge of the Organization entity.
 func (_m *Organization) QueryOwner() *UserQuery {
 	return NewOrganizationClient(_m.config).QueryOwner(_m)
@@ -219,6 +370,9 @@ func (_m *Organization) String() string {
 	builder.WriteString("name=")
 	builder.WriteString(_m.Name)
 	builder.WriteString(", ")
+	builder.WriteString("name_initials=")
+	builder.WriteString(_m.NameInitials)
+	builder.WriteString(", ")
 	builder.WriteString("description=")
 	builder.WriteString(_m.Description)
 	builder.WriteString(", ")
@@ -228,12 +382,25 @@ func (_m *Organization) String() string {
 	builder.WriteString("category=")
 	builder.WriteString(_m.Category)
 	builder.WriteString(", ")
+	if v := _m.Slug; v != nil {
+		builder.WriteString("slug=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("social_links=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SocialLinks))
+	builder.WriteString(", ")
 	builder.WriteString("owner_id=")
 	builder.WriteString(fmt.Sprintf("%v", _m.OwnerID))
 	builder.WriteString(", ")
 	builder.WriteString("is_active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsActive))
 	builder.WriteString(", ")
+	if v := _m.DeletedAt; v != nil {
+		builder.WriteString("deleted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -10,10 +10,17 @@ import (
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/apikey"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationmember"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationrole"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookendpoint"
 	"github.com/google/uuid"
 )
 
@@ -30,6 +37,20 @@ func (_c *OrganizationCreate) SetName(v string) *OrganizationCreate {
 	return _c
 }
 
+// SetNameInitials sets the "name_initials" field.
+func (_c *OrganizationCreate) SetNameInitials(v string) *OrganizationCreate {
+	_c.mutation.SetNameInitials(v)
+	return _c
+}
+
+// SetNillableNameInitials sets the "name_initials" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableNameInitials(v *string) *OrganizationCreate {
+	if v != nil {
+		_c.SetNameInitials(*v)
+	}
+	return _c
+}
+
 // SetDescription sets the "description" field.
 func (_c *OrganizationCreate) SetDescription(v string) *OrganizationCreate {
 	_c.mutation.SetDescription(v)
@@ -72,6 +93,26 @@ func (_c *OrganizationCreate) SetNillableCategory(v *string) *OrganizationCreate
 	return _c
 }
 
+// SetSlug sets the "slug" field.
+func (_c *OrganizationCreate) SetSlug(v string) *OrganizationCreate {
+	_c.mutation.SetSlug(v)
+	return _c
+}
+
+// SetNillableSlug sets the "slug" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableSlug(v *string) *OrganizationCreate {
+	if v != nil {
+		_c.SetSlug(*v)
+	}
+	return _c
+}
+
+// SetSocialLinks sets the "social_links" field.
+func (_c *OrganizationCreate) SetSocialLinks(v map[string]string) *OrganizationCreate {
+	_c.mutation.SetSocialLinks(v)
+	return _c
+}
+
 // SetOwnerID sets the "owner_id" field.
 func (_c *OrganizationCreate) SetOwnerID(v uuid.UUID) *OrganizationCreate {
 	_c.mutation.SetOwnerID(v)
@@ -92,6 +133,20 @@ func (_c *OrganizationCreate) SetNillableIsActive(v *bool) *OrganizationCreate {
 	return _c
 }
 
+// SetDeletedAt sets the "deleted_at" field.
+func (_c *OrganizationCreate) SetDeletedAt(v time.Time) *OrganizationCreate {
+	_c.mutation.SetDeletedAt(v)
+	return _c
+}
+
+// SetNillableDeletedAt sets the "deleted_at" field if the given value is not nil.
+func (_c *OrganizationCreate) SetNillableDeletedAt(v *time.Time) *OrganizationCreate {
+	if v != nil {
+		_c.SetDeletedAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *OrganizationCreate) SetCreatedAt(v time.Time) *OrganizationCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -149,6 +204,81 @@ func (_c *OrganizationCreate) AddMembers(v ...*OrganizationMember) *Organization
 	return _c.AddMemberIDs(ids...)
 }
 
+// AddRoleIDs adds the "roles" edge to the OrganizationRole entity by IDs.
+func (_c *OrganizationCreate) AddRoleIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddRoleIDs(ids...)
+	return _c
+}
+
+// AddRoles adds the "roles" edges to the OrganizationRole entity.
+func (_c *OrganizationCreate) AddRoles(v ...*OrganizationRole) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRoleIDs(ids...)
+}
+
+// AddInvitationIDs adds the "invitations" edge to the OrganizationInvitation entity by IDs.
+func (_c *OrganizationCreate) AddInvitationIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddInvitationIDs(ids...)
+	return _c
+}
+
+// AddInvitations adds the "invitations" edges to the OrganizationInvitation entity.
+func (_c *OrganizationCreate) AddInvitations(v ...*OrganizationInvitation) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddInvitationIDs(ids...)
+}
+
+// AddOwnershipTransferIDs adds the "ownership_transfers" edge to the OwnershipTransfer entity by IDs.
+func (_c *OrganizationCreate) AddOwnershipTransferIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddOwnershipTransferIDs(ids...)
+	return _c
+}
+
+// AddOwnershipTransfers adds the "ownership_transfers" edges to the OwnershipTransfer entity.
+func (_c *OrganizationCreate) AddOwnershipTransfers(v ...*OwnershipTransfer) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOwnershipTransferIDs(ids...)
+}
+
+// AddAPIKeyIDs adds the "api_keys" edge to the APIKey entity by IDs.
+func (_c *OrganizationCreate) AddAPIKeyIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddAPIKeyIDs(ids...)
+	return _c
+}
+
+// AddAPIKeys adds the "api_keys" edges to the APIKey entity.
+func (_c *OrganizationCreate) AddAPIKeys(v ...*APIKey) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddAPIKeyIDs(ids...)
+}
+
+// AddWebhookEndpointIDs adds the "webhook_endpoints" edge to the WebhookEndpoint entity by IDs.
+func (_c *OrganizationCreate) AddWebhookEndpointIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddWebhookEndpointIDs(ids...)
+	return _c
+}
+
+// AddWebhookEndpoints adds the "webhook_endpoints" edges to the WebhookEndpoint entity.
+func (_c *OrganizationCreate) AddWebhookEndpoints(v ...*WebhookEndpoint) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWebhookEndpointIDs(ids...)
+}
+
 // AddEventIDs adds the "events" edge to the Event entity by IDs.
 func (_c *OrganizationCreate) AddEventIDs(ids ...uuid.UUID) *OrganizationCreate {
 	_c.mutation.AddEventIDs(ids...)
@@ -164,6 +294,36 @@ func (_c *OrganizationCreate) AddEvents(v ...*Event) *OrganizationCreate {
 	return _c.AddEventIDs(ids...)
 }
 
+// AddEventSeriesIDs adds the "event_series" edge to the EventSeries entity by IDs.
+func (_c *OrganizationCreate) AddEventSeriesIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddEventSeriesIDs(ids...)
+	return _c
+}
+
+// AddEventSeries adds the "event_series" edges to the EventSeries entity.
+func (_c *OrganizationCreate) AddEventSeries(v ...*EventSeries) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventSeriesIDs(ids...)
+}
+
+// AddVenueIDs adds the "venues" edge to the Venue entity by IDs.
+func (_c *OrganizationCreate) AddVenueIDs(ids ...uuid.UUID) *OrganizationCreate {
+	_c.mutation.AddVenueIDs(ids...)
+	return _c
+}
+
+// AddVenues adds the "venues" edges to the Venue entity.
+func (_c *OrganizationCreate) AddVenues(v ...*Venue) *OrganizationCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddVenueIDs(ids...)
+}
+
 // SetOwner sets the "owner" edge to the User entity.
 func (_c *OrganizationCreate) SetOwner(v *User) *OrganizationCreate {
 	return _c.SetOwnerID(v.ID)
@@ -204,6 +364,10 @@ func (_c *OrganizationCreate) ExecX(ctx context.Context) {
 
 // defaults sets the default values of the builder before save.
 func (_c *OrganizationCreate) defaults() {
+	if _, ok := _c.mutation.NameInitials(); !ok {
+		v := organization.DefaultNameInitials
+		_c.mutation.SetNameInitials(v)
+	}
 	if _, ok := _c.mutation.IsActive(); !ok {
 		v := organization.DefaultIsActive
 		_c.mutation.SetIsActive(v)
@@ -232,6 +396,9 @@ func (_c *OrganizationCreate) check() error {
 			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Organization.name": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.NameInitials(); !ok {
+		return &ValidationError{Name: "name_initials", err: errors.New(`ent: missing required field "Organization.name_initials"`)}
+	}
 	if _, ok := _c.mutation.OwnerID(); !ok {
 		return &ValidationError{Name: "owner_id", err: errors.New(`ent: missing required field "Organization.owner_id"`)}
 	}
@@ -286,6 +453,10 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		_spec.SetField(organization.FieldName, field.TypeString, value)
 		_node.Name = value
 	}
+	if value, ok := _c.mutation.NameInitials(); ok {
+		_spec.SetField(organization.FieldNameInitials, field.TypeString, value)
+		_node.NameInitials = value
+	}
 	if value, ok := _c.mutation.Description(); ok {
 		_spec.SetField(organization.FieldDescription, field.TypeString, value)
 		_node.Description = value
@@ -298,10 +469,22 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		_spec.SetField(organization.FieldCategory, field.TypeString, value)
 		_node.Category = value
 	}
+	if value, ok := _c.mutation.Slug(); ok {
+		_spec.SetField(organization.FieldSlug, field.TypeString, value)
+		_node.Slug = &value
+	}
+	if value, ok := _c.mutation.SocialLinks(); ok {
+		_spec.SetField(organization.FieldSocialLinks, field.TypeJSON, value)
+		_node.SocialLinks = value
+	}
 	if value, ok := _c.mutation.IsActive(); ok {
 		_spec.SetField(organization.FieldIsActive, field.TypeBool, value)
 		_node.IsActive = value
 	}
+	if value, ok := _c.mutation.DeletedAt(); ok {
+		_spec.SetField(organization.FieldDeletedAt, field.TypeTime, value)
+		_node.DeletedAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(organization.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -326,6 +509,86 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RolesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.RolesTable,
+			Columns: []string{organization.RolesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationrole.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.InvitationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.InvitationsTable,
+			Columns: []string{organization.InvitationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organizationinvitation.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.OwnershipTransfersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.OwnershipTransfersTable,
+			Columns: []string{organization.OwnershipTransfersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.APIKeysIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.APIKeysTable,
+			Columns: []string{organization.APIKeysColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(apikey.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WebhookEndpointsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.WebhookEndpointsTable,
+			Columns: []string{organization.WebhookEndpointsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(webhookendpoint.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -342,6 +605,38 @@ func (_c *OrganizationCreate) createSpec() (*Organization, *sqlgraph.CreateSpec)
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.EventSeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.EventSeriesTable,
+			Columns: []string{organization.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.VenuesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   organization.VenuesTable,
+			Columns: []string{organization.VenuesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.OwnerIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.M2O,
@@ -0,0 +1,570 @@
+// Code generated by ent, DO NOT EDIT.
+
+package organizationinvitation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// Email applies equality check predicate on the "email" field. It's identical to EmailEQ.
+func Email(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldEmail, v))
+}
+
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldRole, v))
+}
+
+// InvitedBy applies equality check predicate on the "invited_by" field. It's identical to InvitedByEQ.
+func InvitedBy(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RespondedBy applies equality check predicate on the "responded_by" field. It's identical to RespondedByEQ.
+func RespondedBy(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldRespondedBy, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// EmailEQ applies the EQ predicate on the "email" field.
+func EmailEQ(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldEmail, v))
+}
+
+// EmailNEQ applies the NEQ predicate on the "email" field.
+func EmailNEQ(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldEmail, v))
+}
+
+// EmailIn applies the In predicate on the "email" field.
+func EmailIn(vs ...string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldEmail, vs...))
+}
+
+// EmailNotIn applies the NotIn predicate on the "email" field.
+func EmailNotIn(vs ...string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldEmail, vs...))
+}
+
+// EmailGT applies the GT predicate on the "email" field.
+func EmailGT(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldEmail, v))
+}
+
+// EmailGTE applies the GTE predicate on the "email" field.
+func EmailGTE(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldEmail, v))
+}
+
+// EmailLT applies the LT predicate on the "email" field.
+func EmailLT(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldEmail, v))
+}
+
+// EmailLTE applies the LTE predicate on the "email" field.
+func EmailLTE(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldEmail, v))
+}
+
+// EmailContains applies the Contains predicate on the "email" field.
+func EmailContains(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldContains(FieldEmail, v))
+}
+
+// EmailHasPrefix applies the HasPrefix predicate on the "email" field.
+func EmailHasPrefix(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldHasPrefix(FieldEmail, v))
+}
+
+// EmailHasSuffix applies the HasSuffix predicate on the "email" field.
+func EmailHasSuffix(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldHasSuffix(FieldEmail, v))
+}
+
+// EmailEqualFold applies the EqualFold predicate on the "email" field.
+func EmailEqualFold(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEqualFold(FieldEmail, v))
+}
+
+// EmailContainsFold applies the ContainsFold predicate on the "email" field.
+func EmailContainsFold(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldContainsFold(FieldEmail, v))
+}
+
+// RoleEQ applies the EQ predicate on the "role" field.
+func RoleEQ(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldRole, v))
+}
+
+// RoleNEQ applies the NEQ predicate on the "role" field.
+func RoleNEQ(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldRole, v))
+}
+
+// RoleIn applies the In predicate on the "role" field.
+func RoleIn(vs ...string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldRole, vs...))
+}
+
+// RoleNotIn applies the NotIn predicate on the "role" field.
+func RoleNotIn(vs ...string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldRole, vs...))
+}
+
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldContainsFold(FieldRole, v))
+}
+
+// InvitedByEQ applies the EQ predicate on the "invited_by" field.
+func InvitedByEQ(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldInvitedBy, v))
+}
+
+// InvitedByNEQ applies the NEQ predicate on the "invited_by" field.
+func InvitedByNEQ(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldInvitedBy, v))
+}
+
+// InvitedByIn applies the In predicate on the "invited_by" field.
+func InvitedByIn(vs ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByNotIn applies the NotIn predicate on the "invited_by" field.
+func InvitedByNotIn(vs ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldInvitedBy, vs...))
+}
+
+// InvitedByGT applies the GT predicate on the "invited_by" field.
+func InvitedByGT(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldInvitedBy, v))
+}
+
+// InvitedByGTE applies the GTE predicate on the "invited_by" field.
+func InvitedByGTE(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldInvitedBy, v))
+}
+
+// InvitedByLT applies the LT predicate on the "invited_by" field.
+func InvitedByLT(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldInvitedBy, v))
+}
+
+// InvitedByLTE applies the LTE predicate on the "invited_by" field.
+func InvitedByLTE(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldInvitedBy, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RespondedByEQ applies the EQ predicate on the "responded_by" field.
+func RespondedByEQ(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldRespondedBy, v))
+}
+
+// RespondedByNEQ applies the NEQ predicate on the "responded_by" field.
+func RespondedByNEQ(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldRespondedBy, v))
+}
+
+// RespondedByIn applies the In predicate on the "responded_by" field.
+func RespondedByIn(vs ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldRespondedBy, vs...))
+}
+
+// RespondedByNotIn applies the NotIn predicate on the "responded_by" field.
+func RespondedByNotIn(vs ...uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldRespondedBy, vs...))
+}
+
+// RespondedByGT applies the GT predicate on the "responded_by" field.
+func RespondedByGT(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldRespondedBy, v))
+}
+
+// RespondedByGTE applies the GTE predicate on the "responded_by" field.
+func RespondedByGTE(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldRespondedBy, v))
+}
+
+// RespondedByLT applies the LT predicate on the "responded_by" field.
+func RespondedByLT(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldRespondedBy, v))
+}
+
+// RespondedByLTE applies the LTE predicate on the "responded_by" field.
+func RespondedByLTE(v uuid.UUID) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldRespondedBy, v))
+}
+
+// RespondedByIsNil applies the IsNil predicate on the "responded_by" field.
+func RespondedByIsNil() predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIsNull(FieldRespondedBy))
+}
+
+// RespondedByNotNil applies the NotNil predicate on the "responded_by" field.
+func RespondedByNotNil() predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotNull(FieldRespondedBy))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OrganizationInvitation) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OrganizationInvitation) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OrganizationInvitation) predicate.OrganizationInvitation {
+	return predicate.OrganizationInvitation(sql.NotPredicates(p))
+}
@@ -0,0 +1,414 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
+	"github.com/google/uuid"
+)
+
+// OrganizationInvitationCreate is the builder for creating a OrganizationInvitation entity.
+type OrganizationInvitationCreate struct {
+	config
+	mutation *OrganizationInvitationMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *OrganizationInvitationCreate) SetOrganizationID(v uuid.UUID) *OrganizationInvitationCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetEmail sets the "email" field.
+func (_c *OrganizationInvitationCreate) SetEmail(v string) *OrganizationInvitationCreate {
+	_c.mutation.SetEmail(v)
+	return _c
+}
+
+// SetRole sets the "role" field.
+func (_c *OrganizationInvitationCreate) SetRole(v string) *OrganizationInvitationCreate {
+	_c.mutation.SetRole(v)
+	return _c
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_c *OrganizationInvitationCreate) SetInvitedBy(v uuid.UUID) *OrganizationInvitationCreate {
+	_c.mutation.SetInvitedBy(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OrganizationInvitationCreate) SetStatus(v organizationinvitation.Status) *OrganizationInvitationCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OrganizationInvitationCreate) SetNillableStatus(v *organizationinvitation.Status) *OrganizationInvitationCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OrganizationInvitationCreate) SetExpiresAt(v time.Time) *OrganizationInvitationCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRespondedBy sets the "responded_by" field.
+func (_c *OrganizationInvitationCreate) SetRespondedBy(v uuid.UUID) *OrganizationInvitationCreate {
+	_c.mutation.SetRespondedBy(v)
+	return _c
+}
+
+// SetNillableRespondedBy sets the "responded_by" field if the given value is not nil.
+func (_c *OrganizationInvitationCreate) SetNillableRespondedBy(v *uuid.UUID) *OrganizationInvitationCreate {
+	if v != nil {
+		_c.SetRespondedBy(*v)
+	}
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *OrganizationInvitationCreate) SetRespondedAt(v time.Time) *OrganizationInvitationCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *OrganizationInvitationCreate) SetNillableRespondedAt(v *time.Time) *OrganizationInvitationCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OrganizationInvitationCreate) SetCreatedAt(v time.Time) *OrganizationInvitationCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OrganizationInvitationCreate) SetNillableCreatedAt(v *time.Time) *OrganizationInvitationCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OrganizationInvitationCreate) SetUpdatedAt(v time.Time) *OrganizationInvitationCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OrganizationInvitationCreate) SetNillableUpdatedAt(v *time.Time) *OrganizationInvitationCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrganizationInvitationCreate) SetID(v uuid.UUID) *OrganizationInvitationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OrganizationInvitationCreate) SetNillableID(v *uuid.UUID) *OrganizationInvitationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *OrganizationInvitationCreate) SetOrganization(v *Organization) *OrganizationInvitationCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationInvitationMutation object of the builder.
+func (_c *OrganizationInvitationCreate) Mutation() *OrganizationInvitationMutation {
+	return _c.mutation
+}
+
+// Save creates the OrganizationInvitation in the database.
+func (_c *OrganizationInvitationCreate) Save(ctx context.Context) (*OrganizationInvitation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrganizationInvitationCreate) SaveX(ctx context.Context) *OrganizationInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationInvitationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationInvitationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrganizationInvitationCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := organizationinvitation.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := organizationinvitation.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := organizationinvitation.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := organizationinvitation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrganizationInvitationCreate) check() error {
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "OrganizationInvitation.organization_id"`)}
+	}
+	if _, ok := _c.mutation.Email(); !ok {
+		return &ValidationError{Name: "email", err: errors.New(`ent: missing required field "OrganizationInvitation.email"`)}
+	}
+	if v, ok := _c.mutation.Email(); ok {
+		if err := organizationinvitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.email": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Role(); !ok {
+		return &ValidationError{Name: "role", err: errors.New(`ent: missing required field "OrganizationInvitation.role"`)}
+	}
+	if v, ok := _c.mutation.Role(); ok {
+		if err := organizationinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.role": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.InvitedBy(); !ok {
+		return &ValidationError{Name: "invited_by", err: errors.New(`ent: missing required field "OrganizationInvitation.invited_by"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OrganizationInvitation.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := organizationinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OrganizationInvitation.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OrganizationInvitation.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OrganizationInvitation.updated_at"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "OrganizationInvitation.organization"`)}
+	}
+	return nil
+}
+
+func (_c *OrganizationInvitationCreate) sqlSave(ctx context.Context) (*OrganizationInvitation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrganizationInvitationCreate) createSpec() (*OrganizationInvitation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OrganizationInvitation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(organizationinvitation.Table, sqlgraph.NewFieldSpec(organizationinvitation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Email(); ok {
+		_spec.SetField(organizationinvitation.FieldEmail, field.TypeString, value)
+		_node.Email = value
+	}
+	if value, ok := _c.mutation.Role(); ok {
+		_spec.SetField(organizationinvitation.FieldRole, field.TypeString, value)
+		_node.Role = value
+	}
+	if value, ok := _c.mutation.InvitedBy(); ok {
+		_spec.SetField(organizationinvitation.FieldInvitedBy, field.TypeUUID, value)
+		_node.InvitedBy = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(organizationinvitation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(organizationinvitation.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RespondedBy(); ok {
+		_spec.SetField(organizationinvitation.FieldRespondedBy, field.TypeUUID, value)
+		_node.RespondedBy = &value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(organizationinvitation.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(organizationinvitation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizationinvitation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationinvitation.OrganizationTable,
+			Columns: []string{organizationinvitation.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrganizationInvitationCreateBulk is the builder for creating many OrganizationInvitation entities in bulk.
+type OrganizationInvitationCreateBulk struct {
+	config
+	err      error
+	builders []*OrganizationInvitationCreate
+}
+
+// Save creates the OrganizationInvitation entities in the database.
+func (_c *OrganizationInvitationCreateBulk) Save(ctx context.Context) ([]*OrganizationInvitation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OrganizationInvitation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrganizationInvitationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrganizationInvitationCreateBulk) SaveX(ctx context.Context) []*OrganizationInvitation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrganizationInvitationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrganizationInvitationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,648 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OrganizationInvitationUpdate is the builder for updating OrganizationInvitation entities.
+type OrganizationInvitationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationInvitationMutation
+}
+
+// Where appends a list predicates to the OrganizationInvitationUpdate builder.
+func (_u *OrganizationInvitationUpdate) Where(ps ...predicate.OrganizationInvitation) *OrganizationInvitationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationInvitationUpdate) SetOrganizationID(v uuid.UUID) *OrganizationInvitationUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableOrganizationID(v *uuid.UUID) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *OrganizationInvitationUpdate) SetEmail(v string) *OrganizationInvitationUpdate {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableEmail(v *string) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *OrganizationInvitationUpdate) SetRole(v string) *OrganizationInvitationUpdate {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableRole(v *string) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_u *OrganizationInvitationUpdate) SetInvitedBy(v uuid.UUID) *OrganizationInvitationUpdate {
+	_u.mutation.SetInvitedBy(v)
+	return _u
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableInvitedBy(v *uuid.UUID) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetInvitedBy(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OrganizationInvitationUpdate) SetStatus(v organizationinvitation.Status) *OrganizationInvitationUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableStatus(v *organizationinvitation.Status) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OrganizationInvitationUpdate) SetExpiresAt(v time.Time) *OrganizationInvitationUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableExpiresAt(v *time.Time) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedBy sets the "responded_by" field.
+func (_u *OrganizationInvitationUpdate) SetRespondedBy(v uuid.UUID) *OrganizationInvitationUpdate {
+	_u.mutation.SetRespondedBy(v)
+	return _u
+}
+
+// SetNillableRespondedBy sets the "responded_by" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableRespondedBy(v *uuid.UUID) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetRespondedBy(*v)
+	}
+	return _u
+}
+
+// ClearRespondedBy clears the value of the "responded_by" field.
+func (_u *OrganizationInvitationUpdate) ClearRespondedBy() *OrganizationInvitationUpdate {
+	_u.mutation.ClearRespondedBy()
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *OrganizationInvitationUpdate) SetRespondedAt(v time.Time) *OrganizationInvitationUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdate) SetNillableRespondedAt(v *time.Time) *OrganizationInvitationUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *OrganizationInvitationUpdate) ClearRespondedAt() *OrganizationInvitationUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrganizationInvitationUpdate) SetUpdatedAt(v time.Time) *OrganizationInvitationUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OrganizationInvitationUpdate) SetOrganization(v *Organization) *OrganizationInvitationUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationInvitationMutation object of the builder.
+func (_u *OrganizationInvitationUpdate) Mutation() *OrganizationInvitationMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OrganizationInvitationUpdate) ClearOrganization() *OrganizationInvitationUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrganizationInvitationUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationInvitationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrganizationInvitationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationInvitationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrganizationInvitationUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := organizationinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationInvitationUpdate) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := organizationinvitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := organizationinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := organizationinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrganizationInvitation.organization"`)
+	}
+	return nil
+}
+
+func (_u *OrganizationInvitationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationinvitation.Table, organizationinvitation.Columns, sqlgraph.NewFieldSpec(organizationinvitation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(organizationinvitation.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(organizationinvitation.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvitedBy(); ok {
+		_spec.SetField(organizationinvitation.FieldInvitedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(organizationinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(organizationinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedBy(); ok {
+		_spec.SetField(organizationinvitation.FieldRespondedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RespondedByCleared() {
+		_spec.ClearField(organizationinvitation.FieldRespondedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(organizationinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(organizationinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizationinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationinvitation.OrganizationTable,
+			Columns: []string{organizationinvitation.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationinvitation.OrganizationTable,
+			Columns: []string{organizationinvitation.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrganizationInvitationUpdateOne is the builder for updating a single OrganizationInvitation entity.
+type OrganizationInvitationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationInvitationMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationInvitationUpdateOne) SetOrganizationID(v uuid.UUID) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableOrganizationID(v *uuid.UUID) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetEmail sets the "email" field.
+func (_u *OrganizationInvitationUpdateOne) SetEmail(v string) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetEmail(v)
+	return _u
+}
+
+// SetNillableEmail sets the "email" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableEmail(v *string) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetEmail(*v)
+	}
+	return _u
+}
+
+// SetRole sets the "role" field.
+func (_u *OrganizationInvitationUpdateOne) SetRole(v string) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetRole(v)
+	return _u
+}
+
+// SetNillableRole sets the "role" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableRole(v *string) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetRole(*v)
+	}
+	return _u
+}
+
+// SetInvitedBy sets the "invited_by" field.
+func (_u *OrganizationInvitationUpdateOne) SetInvitedBy(v uuid.UUID) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetInvitedBy(v)
+	return _u
+}
+
+// SetNillableInvitedBy sets the "invited_by" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableInvitedBy(v *uuid.UUID) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetInvitedBy(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OrganizationInvitationUpdateOne) SetStatus(v organizationinvitation.Status) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableStatus(v *organizationinvitation.Status) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OrganizationInvitationUpdateOne) SetExpiresAt(v time.Time) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableExpiresAt(v *time.Time) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedBy sets the "responded_by" field.
+func (_u *OrganizationInvitationUpdateOne) SetRespondedBy(v uuid.UUID) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetRespondedBy(v)
+	return _u
+}
+
+// SetNillableRespondedBy sets the "responded_by" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableRespondedBy(v *uuid.UUID) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetRespondedBy(*v)
+	}
+	return _u
+}
+
+// ClearRespondedBy clears the value of the "responded_by" field.
+func (_u *OrganizationInvitationUpdateOne) ClearRespondedBy() *OrganizationInvitationUpdateOne {
+	_u.mutation.ClearRespondedBy()
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *OrganizationInvitationUpdateOne) SetRespondedAt(v time.Time) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *OrganizationInvitationUpdateOne) SetNillableRespondedAt(v *time.Time) *OrganizationInvitationUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *OrganizationInvitationUpdateOne) ClearRespondedAt() *OrganizationInvitationUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrganizationInvitationUpdateOne) SetUpdatedAt(v time.Time) *OrganizationInvitationUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OrganizationInvitationUpdateOne) SetOrganization(v *Organization) *OrganizationInvitationUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationInvitationMutation object of the builder.
+func (_u *OrganizationInvitationUpdateOne) Mutation() *OrganizationInvitationMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OrganizationInvitationUpdateOne) ClearOrganization() *OrganizationInvitationUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the OrganizationInvitationUpdate builder.
+func (_u *OrganizationInvitationUpdateOne) Where(ps ...predicate.OrganizationInvitation) *OrganizationInvitationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrganizationInvitationUpdateOne) Select(field string, fields ...string) *OrganizationInvitationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrganizationInvitation entity.
+func (_u *OrganizationInvitationUpdateOne) Save(ctx context.Context) (*OrganizationInvitation, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationInvitationUpdateOne) SaveX(ctx context.Context) *OrganizationInvitation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrganizationInvitationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationInvitationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrganizationInvitationUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := organizationinvitation.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationInvitationUpdateOne) check() error {
+	if v, ok := _u.mutation.Email(); ok {
+		if err := organizationinvitation.EmailValidator(v); err != nil {
+			return &ValidationError{Name: "email", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.email": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Role(); ok {
+		if err := organizationinvitation.RoleValidator(v); err != nil {
+			return &ValidationError{Name: "role", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.role": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := organizationinvitation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OrganizationInvitation.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrganizationInvitation.organization"`)
+	}
+	return nil
+}
+
+func (_u *OrganizationInvitationUpdateOne) sqlSave(ctx context.Context) (_node *OrganizationInvitation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationinvitation.Table, organizationinvitation.Columns, sqlgraph.NewFieldSpec(organizationinvitation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrganizationInvitation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organizationinvitation.FieldID)
+		for _, f := range fields {
+			if !organizationinvitation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organizationinvitation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Email(); ok {
+		_spec.SetField(organizationinvitation.FieldEmail, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Role(); ok {
+		_spec.SetField(organizationinvitation.FieldRole, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InvitedBy(); ok {
+		_spec.SetField(organizationinvitation.FieldInvitedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(organizationinvitation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(organizationinvitation.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedBy(); ok {
+		_spec.SetField(organizationinvitation.FieldRespondedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.RespondedByCleared() {
+		_spec.ClearField(organizationinvitation.FieldRespondedBy, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(organizationinvitation.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(organizationinvitation.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizationinvitation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationinvitation.OrganizationTable,
+			Columns: []string{organizationinvitation.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationinvitation.OrganizationTable,
+			Columns: []string{organizationinvitation.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrganizationInvitation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationinvitation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -24,8 +24,8 @@ type OrganizationMember struct {
 	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
 	// User ID
 	UserID uuid.UUID `json:"user_id,omitempty"`
-	// Member role in the organization
-	Role organizationmember.Role `json:"role,omitempty"`
+	// Built-in role (owner, admin, event_manager, finance, checkin_staff, viewer) or a custom role name
+	Role string `json:"role,omitempty"`
 	// When the user joined the organization
 	JoinedAt time.Time `json:"joined_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
@@ -119,7 +119,7 @@ func (_m *OrganizationMember) assignValues(columns []string, values []any) error
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field role", values[i])
 			} else if value.Valid {
-				_m.Role = organizationmember.Role(value.String)
+				_m.Role = value.String
 			}
 		case organizationmember.FieldJoinedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
@@ -192,7 +192,7 @@ func (_m *OrganizationMember) String() string {
 	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
 	builder.WriteString(", ")
 	builder.WriteString("role=")
-	builder.WriteString(fmt.Sprintf("%v", _m.Role))
+	builder.WriteString(_m.Role)
 	builder.WriteString(", ")
 	builder.WriteString("joined_at=")
 	builder.WriteString(_m.JoinedAt.Format(time.ANSIC))
@@ -3,7 +3,6 @@
 package organizationmember
 
 import (
-	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -72,6 +71,10 @@ func ValidColumn(column string) bool {
 }
 
 var (
+	// DefaultRole holds the default value on creation for the "role" field.
+	DefaultRole string
+	// RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	RoleValidator func(string) error
 	// DefaultJoinedAt holds the default value on creation for the "joined_at" field.
 	DefaultJoinedAt func() time.Time
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
@@ -84,32 +87,6 @@ var (
 	DefaultID func() uuid.UUID
 )
 
-// Role defines the type for the "role" enum field.
-type Role string
-
-// RoleMember is the default value of the Role enum.
-const DefaultRole = RoleMember
-
-// Role values.
-const (
-	RoleAdmin  Role = "admin"
-	RoleMember Role = "member"
-)
-
-func (r Role) String() string {
-	return string(r)
-}
-
-// RoleValidator is a validator for the "role" field enum values. It is called by the builders before save.
-func RoleValidator(r Role) error {
-	switch r {
-	case RoleAdmin, RoleMember:
-		return nil
-	default:
-		return fmt.Errorf("organizationmember: invalid enum value for role field: %q", r)
-	}
-}
-
 // OrderOption defines the ordering options for the OrganizationMember queries.
 type OrderOption func(*sql.Selector)
 
@@ -66,6 +66,11 @@ func UserID(v uuid.UUID) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldUserID, v))
 }
 
+// Role applies equality check predicate on the "role" field. It's identical to RoleEQ.
+func Role(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEQ(FieldRole, v))
+}
+
 // JoinedAt applies equality check predicate on the "joined_at" field. It's identical to JoinedAtEQ.
 func JoinedAt(v time.Time) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldJoinedAt, v))
@@ -122,25 +127,70 @@ func UserIDNotIn(vs ...uuid.UUID) predicate.OrganizationMember {
 }
 
 // RoleEQ applies the EQ predicate on the "role" field.
-func RoleEQ(v Role) predicate.OrganizationMember {
+func RoleEQ(v string) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldRole, v))
 }
 
 // RoleNEQ applies the NEQ predicate on the "role" field.
-func RoleNEQ(v Role) predicate.OrganizationMember {
+func RoleNEQ(v string) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldNEQ(FieldRole, v))
 }
 
 // RoleIn applies the In predicate on the "role" field.
-func RoleIn(vs ...Role) predicate.OrganizationMember {
+func RoleIn(vs ...string) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldIn(FieldRole, vs...))
 }
 
 // RoleNotIn applies the NotIn predicate on the "role" field.
-func RoleNotIn(vs ...Role) predicate.OrganizationMember {
+func RoleNotIn(vs ...string) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldNotIn(FieldRole, vs...))
 }
 
+// RoleGT applies the GT predicate on the "role" field.
+func RoleGT(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGT(FieldRole, v))
+}
+
+// RoleGTE applies the GTE predicate on the "role" field.
+func RoleGTE(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldGTE(FieldRole, v))
+}
+
+// RoleLT applies the LT predicate on the "role" field.
+func RoleLT(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLT(FieldRole, v))
+}
+
+// RoleLTE applies the LTE predicate on the "role" field.
+func RoleLTE(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldLTE(FieldRole, v))
+}
+
+// RoleContains applies the Contains predicate on the "role" field.
+func RoleContains(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldContains(FieldRole, v))
+}
+
+// RoleHasPrefix applies the HasPrefix predicate on the "role" field.
+func RoleHasPrefix(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldHasPrefix(FieldRole, v))
+}
+
+// RoleHasSuffix applies the HasSuffix predicate on the "role" field.
+func RoleHasSuffix(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldHasSuffix(FieldRole, v))
+}
+
+// RoleEqualFold applies the EqualFold predicate on the "role" field.
+func RoleEqualFold(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldEqualFold(FieldRole, v))
+}
+
+// RoleContainsFold applies the ContainsFold predicate on the "role" field.
+func RoleContainsFold(v string) predicate.OrganizationMember {
+	return predicate.OrganizationMember(sql.FieldContainsFold(FieldRole, v))
+}
+
 // JoinedAtEQ applies the EQ predicate on the "joined_at" field.
 func JoinedAtEQ(v time.Time) predicate.OrganizationMember {
 	return predicate.OrganizationMember(sql.FieldEQ(FieldJoinedAt, v))
@@ -36,13 +36,13 @@ func (_c *OrganizationMemberCreate) SetUserID(v uuid.UUID) *OrganizationMemberCr
 }
 
 // SetRole sets the "role" field.
-func (_c *OrganizationMemberCreate) SetRole(v organizationmember.Role) *OrganizationMemberCreate {
+func (_c *OrganizationMemberCreate) SetRole(v string) *OrganizationMemberCreate {
 	_c.mutation.SetRole(v)
 	return _c
 }
 
 // SetNillableRole sets the "role" field if the given value is not nil.
-func (_c *OrganizationMemberCreate) SetNillableRole(v *organizationmember.Role) *OrganizationMemberCreate {
+func (_c *OrganizationMemberCreate) SetNillableRole(v *string) *OrganizationMemberCreate {
 	if v != nil {
 		_c.SetRole(*v)
 	}
@@ -239,7 +239,7 @@ func (_c *OrganizationMemberCreate) createSpec() (*OrganizationMember, *sqlgraph
 		_spec.ID.Value = &id
 	}
 	if value, ok := _c.mutation.Role(); ok {
-		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
+		_spec.SetField(organizationmember.FieldRole, field.TypeString, value)
 		_node.Role = value
 	}
 	if value, ok := _c.mutation.JoinedAt(); ok {
@@ -60,13 +60,13 @@ func (_u *OrganizationMemberUpdate) SetNillableUserID(v *uuid.UUID) *Organizatio
 }
 
 // SetRole sets the "role" field.
-func (_u *OrganizationMemberUpdate) SetRole(v organizationmember.Role) *OrganizationMemberUpdate {
+func (_u *OrganizationMemberUpdate) SetRole(v string) *OrganizationMemberUpdate {
 	_u.mutation.SetRole(v)
 	return _u
 }
 
 // SetNillableRole sets the "role" field if the given value is not nil.
-func (_u *OrganizationMemberUpdate) SetNillableRole(v *organizationmember.Role) *OrganizationMemberUpdate {
+func (_u *OrganizationMemberUpdate) SetNillableRole(v *string) *OrganizationMemberUpdate {
 	if v != nil {
 		_u.SetRole(*v)
 	}
@@ -171,7 +171,7 @@ func (_u *OrganizationMemberUpdate) sqlSave(ctx context.Context) (_node int, err
 		}
 	}
 	if value, ok := _u.mutation.Role(); ok {
-		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
+		_spec.SetField(organizationmember.FieldRole, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(organizationmember.FieldUpdatedAt, field.TypeTime, value)
@@ -283,13 +283,13 @@ func (_u *OrganizationMemberUpdateOne) SetNillableUserID(v *uuid.UUID) *Organiza
 }
 
 // SetRole sets the "role" field.
-func (_u *OrganizationMemberUpdateOne) SetRole(v organizationmember.Role) *OrganizationMemberUpdateOne {
+func (_u *OrganizationMemberUpdateOne) SetRole(v string) *OrganizationMemberUpdateOne {
 	_u.mutation.SetRole(v)
 	return _u
 }
 
 // SetNillableRole sets the "role" field if the given value is not nil.
-func (_u *OrganizationMemberUpdateOne) SetNillableRole(v *organizationmember.Role) *OrganizationMemberUpdateOne {
+func (_u *OrganizationMemberUpdateOne) SetNillableRole(v *string) *OrganizationMemberUpdateOne {
 	if v != nil {
 		_u.SetRole(*v)
 	}
@@ -424,7 +424,7 @@ func (_u *OrganizationMemberUpdateOne) sqlSave(ctx context.Context) (_node *Orga
 		}
 	}
 	if value, ok := _u.mutation.Role(); ok {
-		_spec.SetField(organizationmember.FieldRole, field.TypeEnum, value)
+		_spec.SetField(organizationmember.FieldRole, field.TypeString, value)
 	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(organizationmember.FieldUpdatedAt, field.TypeTime, value)
@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationrole"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OrganizationRoleUpdate is the builder for updating OrganizationRole entities.
+type OrganizationRoleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrganizationRoleMutation
+}
+
+// Where appends a list predicates to the OrganizationRoleUpdate builder.
+func (_u *OrganizationRoleUpdate) Where(ps ...predicate.OrganizationRole) *OrganizationRoleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationRoleUpdate) SetOrganizationID(v uuid.UUID) *OrganizationRoleUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationRoleUpdate) SetNillableOrganizationID(v *uuid.UUID) *OrganizationRoleUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OrganizationRoleUpdate) SetName(v string) *OrganizationRoleUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OrganizationRoleUpdate) SetNillableName(v *string) *OrganizationRoleUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OrganizationRoleUpdate) SetDescription(v string) *OrganizationRoleUpdate {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OrganizationRoleUpdate) SetNillableDescription(v *string) *OrganizationRoleUpdate {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OrganizationRoleUpdate) ClearDescription() *OrganizationRoleUpdate {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetPermissions sets the "permissions" field.
+func (_u *OrganizationRoleUpdate) SetPermissions(v []string) *OrganizationRoleUpdate {
+	_u.mutation.SetPermissions(v)
+	return _u
+}
+
+// AppendPermissions appends value to the "permissions" field.
+func (_u *OrganizationRoleUpdate) AppendPermissions(v []string) *OrganizationRoleUpdate {
+	_u.mutation.AppendPermissions(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrganizationRoleUpdate) SetUpdatedAt(v time.Time) *OrganizationRoleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OrganizationRoleUpdate) SetOrganization(v *Organization) *OrganizationRoleUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationRoleMutation object of the builder.
+func (_u *OrganizationRoleUpdate) Mutation() *OrganizationRoleMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OrganizationRoleUpdate) ClearOrganization() *OrganizationRoleUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrganizationRoleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationRoleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrganizationRoleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationRoleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrganizationRoleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := organizationrole.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationRoleUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := organizationrole.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OrganizationRole.name": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrganizationRole.organization"`)
+	}
+	return nil
+}
+
+func (_u *OrganizationRoleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationrole.Table, organizationrole.Columns, sqlgraph.NewFieldSpec(organizationrole.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(organizationrole.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(organizationrole.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(organizationrole.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Permissions(); ok {
+		_spec.SetField(organizationrole.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizationrole.FieldPermissions, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizationrole.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationrole.OrganizationTable,
+			Columns: []string{organizationrole.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationrole.OrganizationTable,
+			Columns: []string{organizationrole.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrganizationRoleUpdateOne is the builder for updating a single OrganizationRole entity.
+type OrganizationRoleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrganizationRoleMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OrganizationRoleUpdateOne) SetOrganizationID(v uuid.UUID) *OrganizationRoleUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OrganizationRoleUpdateOne) SetNillableOrganizationID(v *uuid.UUID) *OrganizationRoleUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *OrganizationRoleUpdateOne) SetName(v string) *OrganizationRoleUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *OrganizationRoleUpdateOne) SetNillableName(v *string) *OrganizationRoleUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetDescription sets the "description" field.
+func (_u *OrganizationRoleUpdateOne) SetDescription(v string) *OrganizationRoleUpdateOne {
+	_u.mutation.SetDescription(v)
+	return _u
+}
+
+// SetNillableDescription sets the "description" field if the given value is not nil.
+func (_u *OrganizationRoleUpdateOne) SetNillableDescription(v *string) *OrganizationRoleUpdateOne {
+	if v != nil {
+		_u.SetDescription(*v)
+	}
+	return _u
+}
+
+// ClearDescription clears the value of the "description" field.
+func (_u *OrganizationRoleUpdateOne) ClearDescription() *OrganizationRoleUpdateOne {
+	_u.mutation.ClearDescription()
+	return _u
+}
+
+// SetPermissions sets the "permissions" field.
+func (_u *OrganizationRoleUpdateOne) SetPermissions(v []string) *OrganizationRoleUpdateOne {
+	_u.mutation.SetPermissions(v)
+	return _u
+}
+
+// AppendPermissions appends value to the "permissions" field.
+func (_u *OrganizationRoleUpdateOne) AppendPermissions(v []string) *OrganizationRoleUpdateOne {
+	_u.mutation.AppendPermissions(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrganizationRoleUpdateOne) SetUpdatedAt(v time.Time) *OrganizationRoleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OrganizationRoleUpdateOne) SetOrganization(v *Organization) *OrganizationRoleUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OrganizationRoleMutation object of the builder.
+func (_u *OrganizationRoleUpdateOne) Mutation() *OrganizationRoleMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OrganizationRoleUpdateOne) ClearOrganization() *OrganizationRoleUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the OrganizationRoleUpdate builder.
+func (_u *OrganizationRoleUpdateOne) Where(ps ...predicate.OrganizationRole) *OrganizationRoleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrganizationRoleUpdateOne) Select(field string, fields ...string) *OrganizationRoleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OrganizationRole entity.
+func (_u *OrganizationRoleUpdateOne) Save(ctx context.Context) (*OrganizationRole, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrganizationRoleUpdateOne) SaveX(ctx context.Context) *OrganizationRole {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrganizationRoleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrganizationRoleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrganizationRoleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := organizationrole.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrganizationRoleUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := organizationrole.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "OrganizationRole.name": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OrganizationRole.organization"`)
+	}
+	return nil
+}
+
+func (_u *OrganizationRoleUpdateOne) sqlSave(ctx context.Context) (_node *OrganizationRole, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(organizationrole.Table, organizationrole.Columns, sqlgraph.NewFieldSpec(organizationrole.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OrganizationRole.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, organizationrole.FieldID)
+		for _, f := range fields {
+			if !organizationrole.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != organizationrole.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(organizationrole.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Description(); ok {
+		_spec.SetField(organizationrole.FieldDescription, field.TypeString, value)
+	}
+	if _u.mutation.DescriptionCleared() {
+		_spec.ClearField(organizationrole.FieldDescription, field.TypeString)
+	}
+	if value, ok := _u.mutation.Permissions(); ok {
+		_spec.SetField(organizationrole.FieldPermissions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedPermissions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, organizationrole.FieldPermissions, value)
+		})
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(organizationrole.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationrole.OrganizationTable,
+			Columns: []string{organizationrole.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   organizationrole.OrganizationTable,
+			Columns: []string{organizationrole.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OrganizationRole{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{organizationrole.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,216 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
+	"github.com/google/uuid"
+)
+
+// OwnershipTransfer is the model entity for the OwnershipTransfer schema.
+type OwnershipTransfer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Organization being transferred
+	OrganizationID uuid.UUID `json:"organization_id,omitempty"`
+	// Owner who proposed the transfer
+	FromUserID uuid.UUID `json:"from_user_id,omitempty"`
+	// Admin proposed as the new owner
+	ToUserID uuid.UUID `json:"to_user_id,omitempty"`
+	// Transfer status
+	Status ownershiptransfer.Status `json:"status,omitempty"`
+	// When the proposal can no longer be accepted
+	ExpiresAt time.Time `json:"expires_at,omitempty"`
+	// When the transfer was accepted, declined or cancelled
+	RespondedAt *time.Time `json:"responded_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OwnershipTransferQuery when eager-loading is set.
+	Edges        OwnershipTransferEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OwnershipTransferEdges holds the relations/edges for other nodes in the graph.
+type OwnershipTransferEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OwnershipTransferEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*OwnershipTransfer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case ownershiptransfer.FieldStatus:
+			values[i] = new(sql.NullString)
+		case ownershiptransfer.FieldExpiresAt, ownershiptransfer.FieldRespondedAt, ownershiptransfer.FieldCreatedAt, ownershiptransfer.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case ownershiptransfer.FieldID, ownershiptransfer.FieldOrganizationID, ownershiptransfer.FieldFromUserID, ownershiptransfer.FieldToUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the OwnershipTransfer fields.
+func (_m *OwnershipTransfer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case ownershiptransfer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case ownershiptransfer.FieldOrganizationID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value != nil {
+				_m.OrganizationID = *value
+			}
+		case ownershiptransfer.FieldFromUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field from_user_id", values[i])
+			} else if value != nil {
+				_m.FromUserID = *value
+			}
+		case ownershiptransfer.FieldToUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field to_user_id", values[i])
+			} else if value != nil {
+				_m.ToUserID = *value
+			}
+		case ownershiptransfer.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = ownershiptransfer.Status(value.String)
+			}
+		case ownershiptransfer.FieldExpiresAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field expires_at", values[i])
+			} else if value.Valid {
+				_m.ExpiresAt = value.Time
+			}
+		case ownershiptransfer.FieldRespondedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field responded_at", values[i])
+			} else if value.Valid {
+				_m.RespondedAt = new(time.Time)
+				*_m.RespondedAt = value.Time
+			}
+		case ownershiptransfer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case ownershiptransfer.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the OwnershipTransfer.
+// This includes values selected through modifiers, order, etc.
+func (_m *OwnershipTransfer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the OwnershipTransfer entity.
+func (_m *OwnershipTransfer) QueryOrganization() *OrganizationQuery {
+	return NewOwnershipTransferClient(_m.config).QueryOrganization(_m)
+}
+
+// Update returns a builder for updating this OwnershipTransfer.
+// Note that you need to call OwnershipTransfer.Unwrap() before calling this method if this OwnershipTransfer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *OwnershipTransfer) Update() *OwnershipTransferUpdateOne {
+	return NewOwnershipTransferClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the OwnershipTransfer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *OwnershipTransfer) Unwrap() *OwnershipTransfer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: OwnershipTransfer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *OwnershipTransfer) String() string {
+	var builder strings.Builder
+	builder.WriteString("OwnershipTransfer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("organization_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrganizationID))
+	builder.WriteString(", ")
+	builder.WriteString("from_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FromUserID))
+	builder.WriteString(", ")
+	builder.WriteString("to_user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ToUserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	builder.WriteString("expires_at=")
+	builder.WriteString(_m.ExpiresAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.RespondedAt; v != nil {
+		builder.WriteString("responded_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// OwnershipTransfers is a parsable slice of OwnershipTransfer.
+type OwnershipTransfers []*OwnershipTransfer
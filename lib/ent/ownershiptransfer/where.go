@@ -0,0 +1,420 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ownershiptransfer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// FromUserID applies equality check predicate on the "from_user_id" field. It's identical to FromUserIDEQ.
+func FromUserID(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// ToUserID applies equality check predicate on the "to_user_id" field. It's identical to ToUserIDEQ.
+func ToUserID(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldToUserID, v))
+}
+
+// ExpiresAt applies equality check predicate on the "expires_at" field. It's identical to ExpiresAtEQ.
+func ExpiresAt(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// RespondedAt applies equality check predicate on the "responded_at" field. It's identical to RespondedAtEQ.
+func RespondedAt(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// FromUserIDEQ applies the EQ predicate on the "from_user_id" field.
+func FromUserIDEQ(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldFromUserID, v))
+}
+
+// FromUserIDNEQ applies the NEQ predicate on the "from_user_id" field.
+func FromUserIDNEQ(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldFromUserID, v))
+}
+
+// FromUserIDIn applies the In predicate on the "from_user_id" field.
+func FromUserIDIn(vs ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldFromUserID, vs...))
+}
+
+// FromUserIDNotIn applies the NotIn predicate on the "from_user_id" field.
+func FromUserIDNotIn(vs ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldFromUserID, vs...))
+}
+
+// FromUserIDGT applies the GT predicate on the "from_user_id" field.
+func FromUserIDGT(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldFromUserID, v))
+}
+
+// FromUserIDGTE applies the GTE predicate on the "from_user_id" field.
+func FromUserIDGTE(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldFromUserID, v))
+}
+
+// FromUserIDLT applies the LT predicate on the "from_user_id" field.
+func FromUserIDLT(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldFromUserID, v))
+}
+
+// FromUserIDLTE applies the LTE predicate on the "from_user_id" field.
+func FromUserIDLTE(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldFromUserID, v))
+}
+
+// ToUserIDEQ applies the EQ predicate on the "to_user_id" field.
+func ToUserIDEQ(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldToUserID, v))
+}
+
+// ToUserIDNEQ applies the NEQ predicate on the "to_user_id" field.
+func ToUserIDNEQ(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldToUserID, v))
+}
+
+// ToUserIDIn applies the In predicate on the "to_user_id" field.
+func ToUserIDIn(vs ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldToUserID, vs...))
+}
+
+// ToUserIDNotIn applies the NotIn predicate on the "to_user_id" field.
+func ToUserIDNotIn(vs ...uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldToUserID, vs...))
+}
+
+// ToUserIDGT applies the GT predicate on the "to_user_id" field.
+func ToUserIDGT(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldToUserID, v))
+}
+
+// ToUserIDGTE applies the GTE predicate on the "to_user_id" field.
+func ToUserIDGTE(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldToUserID, v))
+}
+
+// ToUserIDLT applies the LT predicate on the "to_user_id" field.
+func ToUserIDLT(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldToUserID, v))
+}
+
+// ToUserIDLTE applies the LTE predicate on the "to_user_id" field.
+func ToUserIDLTE(v uuid.UUID) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldToUserID, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// ExpiresAtEQ applies the EQ predicate on the "expires_at" field.
+func ExpiresAtEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtNEQ applies the NEQ predicate on the "expires_at" field.
+func ExpiresAtNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldExpiresAt, v))
+}
+
+// ExpiresAtIn applies the In predicate on the "expires_at" field.
+func ExpiresAtIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtNotIn applies the NotIn predicate on the "expires_at" field.
+func ExpiresAtNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldExpiresAt, vs...))
+}
+
+// ExpiresAtGT applies the GT predicate on the "expires_at" field.
+func ExpiresAtGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldExpiresAt, v))
+}
+
+// ExpiresAtGTE applies the GTE predicate on the "expires_at" field.
+func ExpiresAtGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldExpiresAt, v))
+}
+
+// ExpiresAtLT applies the LT predicate on the "expires_at" field.
+func ExpiresAtLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldExpiresAt, v))
+}
+
+// ExpiresAtLTE applies the LTE predicate on the "expires_at" field.
+func ExpiresAtLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldExpiresAt, v))
+}
+
+// RespondedAtEQ applies the EQ predicate on the "responded_at" field.
+func RespondedAtEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtNEQ applies the NEQ predicate on the "responded_at" field.
+func RespondedAtNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldRespondedAt, v))
+}
+
+// RespondedAtIn applies the In predicate on the "responded_at" field.
+func RespondedAtIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtNotIn applies the NotIn predicate on the "responded_at" field.
+func RespondedAtNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldRespondedAt, vs...))
+}
+
+// RespondedAtGT applies the GT predicate on the "responded_at" field.
+func RespondedAtGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldRespondedAt, v))
+}
+
+// RespondedAtGTE applies the GTE predicate on the "responded_at" field.
+func RespondedAtGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldRespondedAt, v))
+}
+
+// RespondedAtLT applies the LT predicate on the "responded_at" field.
+func RespondedAtLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldRespondedAt, v))
+}
+
+// RespondedAtLTE applies the LTE predicate on the "responded_at" field.
+func RespondedAtLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldRespondedAt, v))
+}
+
+// RespondedAtIsNil applies the IsNil predicate on the "responded_at" field.
+func RespondedAtIsNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIsNull(FieldRespondedAt))
+}
+
+// RespondedAtNotNil applies the NotNil predicate on the "responded_at" field.
+func RespondedAtNotNil() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotNull(FieldRespondedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.OwnershipTransfer) predicate.OwnershipTransfer {
+	return predicate.OwnershipTransfer(sql.NotPredicates(p))
+}
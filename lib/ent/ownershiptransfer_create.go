@@ -0,0 +1,373 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
+	"github.com/google/uuid"
+)
+
+// OwnershipTransferCreate is the builder for creating a OwnershipTransfer entity.
+type OwnershipTransferCreate struct {
+	config
+	mutation *OwnershipTransferMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *OwnershipTransferCreate) SetOrganizationID(v uuid.UUID) *OwnershipTransferCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetFromUserID sets the "from_user_id" field.
+func (_c *OwnershipTransferCreate) SetFromUserID(v uuid.UUID) *OwnershipTransferCreate {
+	_c.mutation.SetFromUserID(v)
+	return _c
+}
+
+// SetToUserID sets the "to_user_id" field.
+func (_c *OwnershipTransferCreate) SetToUserID(v uuid.UUID) *OwnershipTransferCreate {
+	_c.mutation.SetToUserID(v)
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OwnershipTransferCreate) SetStatus(v ownershiptransfer.Status) *OwnershipTransferCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OwnershipTransferCreate) SetNillableStatus(v *ownershiptransfer.Status) *OwnershipTransferCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *OwnershipTransferCreate) SetExpiresAt(v time.Time) *OwnershipTransferCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_c *OwnershipTransferCreate) SetRespondedAt(v time.Time) *OwnershipTransferCreate {
+	_c.mutation.SetRespondedAt(v)
+	return _c
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_c *OwnershipTransferCreate) SetNillableRespondedAt(v *time.Time) *OwnershipTransferCreate {
+	if v != nil {
+		_c.SetRespondedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OwnershipTransferCreate) SetCreatedAt(v time.Time) *OwnershipTransferCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OwnershipTransferCreate) SetNillableCreatedAt(v *time.Time) *OwnershipTransferCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OwnershipTransferCreate) SetUpdatedAt(v time.Time) *OwnershipTransferCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OwnershipTransferCreate) SetNillableUpdatedAt(v *time.Time) *OwnershipTransferCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OwnershipTransferCreate) SetID(v uuid.UUID) *OwnershipTransferCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OwnershipTransferCreate) SetNillableID(v *uuid.UUID) *OwnershipTransferCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *OwnershipTransferCreate) SetOrganization(v *Organization) *OwnershipTransferCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (_c *OwnershipTransferCreate) Mutation() *OwnershipTransferMutation {
+	return _c.mutation
+}
+
+// Save creates the OwnershipTransfer in the database.
+func (_c *OwnershipTransferCreate) Save(ctx context.Context) (*OwnershipTransfer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OwnershipTransferCreate) SaveX(ctx context.Context) *OwnershipTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OwnershipTransferCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OwnershipTransferCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OwnershipTransferCreate) defaults() {
+	if _, ok := _c.mutation.Status(); !ok {
+		v := ownershiptransfer.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := ownershiptransfer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := ownershiptransfer.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := ownershiptransfer.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OwnershipTransferCreate) check() error {
+	if _, ok := _c.mutation.OrganizationID(); !ok {
+		return &ValidationError{Name: "organization_id", err: errors.New(`ent: missing required field "OwnershipTransfer.organization_id"`)}
+	}
+	if _, ok := _c.mutation.FromUserID(); !ok {
+		return &ValidationError{Name: "from_user_id", err: errors.New(`ent: missing required field "OwnershipTransfer.from_user_id"`)}
+	}
+	if _, ok := _c.mutation.ToUserID(); !ok {
+		return &ValidationError{Name: "to_user_id", err: errors.New(`ent: missing required field "OwnershipTransfer.to_user_id"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "OwnershipTransfer.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ExpiresAt(); !ok {
+		return &ValidationError{Name: "expires_at", err: errors.New(`ent: missing required field "OwnershipTransfer.expires_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "OwnershipTransfer.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "OwnershipTransfer.updated_at"`)}
+	}
+	if len(_c.mutation.OrganizationIDs()) == 0 {
+		return &ValidationError{Name: "organization", err: errors.New(`ent: missing required edge "OwnershipTransfer.organization"`)}
+	}
+	return nil
+}
+
+func (_c *OwnershipTransferCreate) sqlSave(ctx context.Context) (*OwnershipTransfer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OwnershipTransferCreate) createSpec() (*OwnershipTransfer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &OwnershipTransfer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(ownershiptransfer.Table, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.FromUserID(); ok {
+		_spec.SetField(ownershiptransfer.FieldFromUserID, field.TypeUUID, value)
+		_node.FromUserID = value
+	}
+	if value, ok := _c.mutation.ToUserID(); ok {
+		_spec.SetField(ownershiptransfer.FieldToUserID, field.TypeUUID, value)
+		_node.ToUserID = value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = value
+	}
+	if value, ok := _c.mutation.RespondedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldRespondedAt, field.TypeTime, value)
+		_node.RespondedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.OrganizationTable,
+			Columns: []string{ownershiptransfer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OwnershipTransferCreateBulk is the builder for creating many OwnershipTransfer entities in bulk.
+type OwnershipTransferCreateBulk struct {
+	config
+	err      error
+	builders []*OwnershipTransferCreate
+}
+
+// Save creates the OwnershipTransfer entities in the database.
+func (_c *OwnershipTransferCreateBulk) Save(ctx context.Context) ([]*OwnershipTransfer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*OwnershipTransfer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OwnershipTransferMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OwnershipTransferCreateBulk) SaveX(ctx context.Context) []*OwnershipTransfer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OwnershipTransferCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OwnershipTransferCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
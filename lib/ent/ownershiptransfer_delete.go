@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+)
+
+// OwnershipTransferDelete is the builder for deleting a OwnershipTransfer entity.
+type OwnershipTransferDelete struct {
+	config
+	hooks    []Hook
+	mutation *OwnershipTransferMutation
+}
+
+// Where appends a list predicates to the OwnershipTransferDelete builder.
+func (_d *OwnershipTransferDelete) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *OwnershipTransferDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OwnershipTransferDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *OwnershipTransferDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(ownershiptransfer.Table, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// OwnershipTransferDeleteOne is the builder for deleting a single OwnershipTransfer entity.
+type OwnershipTransferDeleteOne struct {
+	_d *OwnershipTransferDelete
+}
+
+// Where appends a list predicates to the OwnershipTransferDelete builder.
+func (_d *OwnershipTransferDeleteOne) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *OwnershipTransferDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{ownershiptransfer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *OwnershipTransferDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
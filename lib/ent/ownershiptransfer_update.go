@@ -0,0 +1,474 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// OwnershipTransferUpdate is the builder for updating OwnershipTransfer entities.
+type OwnershipTransferUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OwnershipTransferMutation
+}
+
+// Where appends a list predicates to the OwnershipTransferUpdate builder.
+func (_u *OwnershipTransferUpdate) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OwnershipTransferUpdate) SetOrganizationID(v uuid.UUID) *OwnershipTransferUpdate {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OwnershipTransferUpdate) SetNillableOrganizationID(v *uuid.UUID) *OwnershipTransferUpdate {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OwnershipTransferUpdate) SetStatus(v ownershiptransfer.Status) *OwnershipTransferUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OwnershipTransferUpdate) SetNillableStatus(v *ownershiptransfer.Status) *OwnershipTransferUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OwnershipTransferUpdate) SetExpiresAt(v time.Time) *OwnershipTransferUpdate {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OwnershipTransferUpdate) SetNillableExpiresAt(v *time.Time) *OwnershipTransferUpdate {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *OwnershipTransferUpdate) SetRespondedAt(v time.Time) *OwnershipTransferUpdate {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *OwnershipTransferUpdate) SetNillableRespondedAt(v *time.Time) *OwnershipTransferUpdate {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *OwnershipTransferUpdate) ClearRespondedAt() *OwnershipTransferUpdate {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OwnershipTransferUpdate) SetUpdatedAt(v time.Time) *OwnershipTransferUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OwnershipTransferUpdate) SetOrganization(v *Organization) *OwnershipTransferUpdate {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (_u *OwnershipTransferUpdate) Mutation() *OwnershipTransferMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OwnershipTransferUpdate) ClearOrganization() *OwnershipTransferUpdate {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OwnershipTransferUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OwnershipTransferUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OwnershipTransferUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OwnershipTransferUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OwnershipTransferUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ownershiptransfer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OwnershipTransferUpdate) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.organization"`)
+	}
+	return nil
+}
+
+func (_u *OwnershipTransferUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ownershiptransfer.Table, ownershiptransfer.Columns, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(ownershiptransfer.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.OrganizationTable,
+			Columns: []string{ownershiptransfer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.OrganizationTable,
+			Columns: []string{ownershiptransfer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownershiptransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OwnershipTransferUpdateOne is the builder for updating a single OwnershipTransfer entity.
+type OwnershipTransferUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OwnershipTransferMutation
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_u *OwnershipTransferUpdateOne) SetOrganizationID(v uuid.UUID) *OwnershipTransferUpdateOne {
+	_u.mutation.SetOrganizationID(v)
+	return _u
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_u *OwnershipTransferUpdateOne) SetNillableOrganizationID(v *uuid.UUID) *OwnershipTransferUpdateOne {
+	if v != nil {
+		_u.SetOrganizationID(*v)
+	}
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OwnershipTransferUpdateOne) SetStatus(v ownershiptransfer.Status) *OwnershipTransferUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OwnershipTransferUpdateOne) SetNillableStatus(v *ownershiptransfer.Status) *OwnershipTransferUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_u *OwnershipTransferUpdateOne) SetExpiresAt(v time.Time) *OwnershipTransferUpdateOne {
+	_u.mutation.SetExpiresAt(v)
+	return _u
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_u *OwnershipTransferUpdateOne) SetNillableExpiresAt(v *time.Time) *OwnershipTransferUpdateOne {
+	if v != nil {
+		_u.SetExpiresAt(*v)
+	}
+	return _u
+}
+
+// SetRespondedAt sets the "responded_at" field.
+func (_u *OwnershipTransferUpdateOne) SetRespondedAt(v time.Time) *OwnershipTransferUpdateOne {
+	_u.mutation.SetRespondedAt(v)
+	return _u
+}
+
+// SetNillableRespondedAt sets the "responded_at" field if the given value is not nil.
+func (_u *OwnershipTransferUpdateOne) SetNillableRespondedAt(v *time.Time) *OwnershipTransferUpdateOne {
+	if v != nil {
+		_u.SetRespondedAt(*v)
+	}
+	return _u
+}
+
+// ClearRespondedAt clears the value of the "responded_at" field.
+func (_u *OwnershipTransferUpdateOne) ClearRespondedAt() *OwnershipTransferUpdateOne {
+	_u.mutation.ClearRespondedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OwnershipTransferUpdateOne) SetUpdatedAt(v time.Time) *OwnershipTransferUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_u *OwnershipTransferUpdateOne) SetOrganization(v *Organization) *OwnershipTransferUpdateOne {
+	return _u.SetOrganizationID(v.ID)
+}
+
+// Mutation returns the OwnershipTransferMutation object of the builder.
+func (_u *OwnershipTransferUpdateOne) Mutation() *OwnershipTransferMutation {
+	return _u.mutation
+}
+
+// ClearOrganization clears the "organization" edge to the Organization entity.
+func (_u *OwnershipTransferUpdateOne) ClearOrganization() *OwnershipTransferUpdateOne {
+	_u.mutation.ClearOrganization()
+	return _u
+}
+
+// Where appends a list predicates to the OwnershipTransferUpdate builder.
+func (_u *OwnershipTransferUpdateOne) Where(ps ...predicate.OwnershipTransfer) *OwnershipTransferUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OwnershipTransferUpdateOne) Select(field string, fields ...string) *OwnershipTransferUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated OwnershipTransfer entity.
+func (_u *OwnershipTransferUpdateOne) Save(ctx context.Context) (*OwnershipTransfer, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OwnershipTransferUpdateOne) SaveX(ctx context.Context) *OwnershipTransfer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OwnershipTransferUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OwnershipTransferUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OwnershipTransferUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := ownershiptransfer.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OwnershipTransferUpdateOne) check() error {
+	if v, ok := _u.mutation.Status(); ok {
+		if err := ownershiptransfer.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "OwnershipTransfer.status": %w`, err)}
+		}
+	}
+	if _u.mutation.OrganizationCleared() && len(_u.mutation.OrganizationIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "OwnershipTransfer.organization"`)
+	}
+	return nil
+}
+
+func (_u *OwnershipTransferUpdateOne) sqlSave(ctx context.Context) (_node *OwnershipTransfer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(ownershiptransfer.Table, ownershiptransfer.Columns, sqlgraph.NewFieldSpec(ownershiptransfer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "OwnershipTransfer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, ownershiptransfer.FieldID)
+		for _, f := range fields {
+			if !ownershiptransfer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != ownershiptransfer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(ownershiptransfer.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.ExpiresAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldExpiresAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.RespondedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldRespondedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RespondedAtCleared() {
+		_spec.ClearField(ownershiptransfer.FieldRespondedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(ownershiptransfer.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OrganizationCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.OrganizationTable,
+			Columns: []string{ownershiptransfer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   ownershiptransfer.OrganizationTable,
+			Columns: []string{ownershiptransfer.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &OwnershipTransfer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{ownershiptransfer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
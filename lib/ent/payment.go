@@ -34,9 +34,9 @@ type Payment struct {
 	Currency string `json:"currency,omitempty"`
 	// Buyer's name
 	BuyerName string `json:"buyer_name,omitempty"`
-	// Buyer's email
+	// Buyer's email (may be empty for box-office sales)
 	BuyerEmail string `json:"buyer_email,omitempty"`
-	// Buyer's phone number
+	// Buyer's phone number (may be empty for box-office sales)
 	BuyerPhone string `json:"buyer_phone,omitempty"`
 	// Payment gateway payment key
 	PaymentKey string `json:"payment_key,omitempty"`
@@ -44,6 +44,16 @@ type Payment struct {
 	OrderID string `json:"order_id,omitempty"`
 	// Payment status
 	Status payment.Status `json:"status,omitempty"`
+	// Sales channel the payment was made through
+	Channel payment.Channel `json:"channel,omitempty"`
+	// How the buyer paid
+	PaymentMethod payment.PaymentMethod `json:"payment_method,omitempty"`
+	// Staff user who recorded a box-office sale
+	OperatorID uuid.UUID `json:"operator_id,omitempty"`
+	// Receipt number issued for a box-office sale
+	ReceiptNumber string `json:"receipt_number,omitempty"`
+	// When the attendee was checked in at the event
+	CheckedInAt *time.Time `json:"checked_in_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -60,9 +70,11 @@ type PaymentEdges struct {
 	Event *Event `json:"event,omitempty"`
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// RegistrationAnswers holds the value of the registration_answers edge.
+	RegistrationAnswers []*RegistrationAnswer `json:"registration_answers,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // EventOrErr returns the Event value or an error if the edge
@@ -87,6 +99,15 @@ func (e PaymentEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// RegistrationAnswersOrErr returns the RegistrationAnswers value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaymentEdges) RegistrationAnswersOrErr() ([]*RegistrationAnswer, error) {
+	if e.loadedTypes[2] {
+		return e.RegistrationAnswers, nil
+	}
+	return nil, &NotLoadedError{edge: "registration_answers"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Payment) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -96,11 +117,11 @@ func (*Payment) scanValues(columns []string) ([]any, error) {
 			values[i] = new(sql.NullFloat64)
 		case payment.FieldTicketQuantity:
 			values[i] = new(sql.NullInt64)
-		case payment.FieldEventTitle, payment.FieldCurrency, payment.FieldBuyerName, payment.FieldBuyerEmail, payment.FieldBuyerPhone, payment.FieldPaymentKey, payment.FieldOrderID, payment.FieldStatus:
+		case payment.FieldEventTitle, payment.FieldCurrency, payment.FieldBuyerName, payment.FieldBuyerEmail, payment.FieldBuyerPhone, payment.FieldPaymentKey, payment.FieldOrderID, payment.FieldStatus, payment.FieldChannel, payment.FieldPaymentMethod, payment.FieldReceiptNumber:
 			values[i] = new(sql.NullString)
-		case payment.FieldCreatedAt, payment.FieldUpdatedAt:
+		case payment.FieldCheckedInAt, payment.FieldCreatedAt, payment.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case payment.FieldID, payment.FieldEventID, payment.FieldUserID:
+		case payment.FieldID, payment.FieldEventID, payment.FieldUserID, payment.FieldOperatorID:
 			values[i] = new(uuid.UUID)
 		default:
 			values[i] = new(sql.UnknownType)
@@ -195,6 +216,37 @@ func (_m *Payment) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Status = payment.Status(value.String)
 			}
+		case payment.FieldChannel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field channel", values[i])
+			} else if value.Valid {
+				_m.Channel = payment.Channel(value.String)
+			}
+		case payment.FieldPaymentMethod:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_method", values[i])
+			} else if value.Valid {
+				_m.PaymentMethod = payment.PaymentMethod(value.String)
+			}
+		case payment.FieldOperatorID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field operator_id", values[i])
+			} else if value != nil {
+				_m.OperatorID = *value
+			}
+		case payment.FieldReceiptNumber:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field receipt_number", values[i])
+			} else if value.Valid {
+				_m.ReceiptNumber = value.String
+			}
+		case payment.FieldCheckedInAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field checked_in_at", values[i])
+			} else if value.Valid {
+				_m.CheckedInAt = new(time.Time)
+				*_m.CheckedInAt = value.Time
+			}
 		case payment.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -230,6 +282,11 @@ func (_m *Payment) QueryUser() *UserQuery {
 	return NewPaymentClient(_m.config).QueryUser(_m)
 }
 
+// QueryRegistrationAnswers queries the "registration_answers" edge of the Payment entity.
+func (_m *Payment) QueryRegistrationAnswers() *RegistrationAnswerQuery {
+	return NewPaymentClient(_m.config).QueryRegistrationAnswers(_m)
+}
+
 // Update returns a builder for updating this Payment.
 // Note that you need to call Payment.Unwrap() before calling this method if this Payment
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -289,6 +346,23 @@ func (_m *Payment) String() string {
 	builder.WriteString("status=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Status))
 	builder.WriteString(", ")
+	builder.WriteString("channel=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Channel))
+	builder.WriteString(", ")
+	builder.WriteString("payment_method=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentMethod))
+	builder.WriteString(", ")
+	builder.WriteString("operator_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OperatorID))
+	builder.WriteString(", ")
+	builder.WriteString("receipt_number=")
+	builder.WriteString(_m.ReceiptNumber)
+	builder.WriteString(", ")
+	if v := _m.CheckedInAt; v != nil {
+		builder.WriteString("checked_in_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -40,6 +40,16 @@ const (
 	FieldOrderID = "order_id"
 	// FieldStatus holds the string denoting the status field in the database.
 	FieldStatus = "status"
+	// FieldChannel holds the string denoting the channel field in the database.
+	FieldChannel = "channel"
+	// FieldPaymentMethod holds the string denoting the payment_method field in the database.
+	FieldPaymentMethod = "payment_method"
+	// FieldOperatorID holds the string denoting the operator_id field in the database.
+	FieldOperatorID = "operator_id"
+	// FieldReceiptNumber holds the string denoting the receipt_number field in the database.
+	FieldReceiptNumber = "receipt_number"
+	// FieldCheckedInAt holds the string denoting the checked_in_at field in the database.
+	FieldCheckedInAt = "checked_in_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -48,6 +58,8 @@ const (
 	EdgeEvent = "event"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeRegistrationAnswers holds the string denoting the registration_answers edge name in mutations.
+	EdgeRegistrationAnswers = "registration_answers"
 	// Table holds the table name of the payment in the database.
 	Table = "payments"
 	// EventTable is the table that holds the event relation/edge.
@@ -64,6 +76,13 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_id"
+	// RegistrationAnswersTable is the table that holds the registration_answers relation/edge.
+	RegistrationAnswersTable = "registration_answers"
+	// RegistrationAnswersInverseTable is the table name for the RegistrationAnswer entity.
+	// It exists in this package in order to avoid circular dependency with the "registrationanswer" package.
+	RegistrationAnswersInverseTable = "registration_answers"
+	// RegistrationAnswersColumn is the table column denoting the registration_answers relation/edge.
+	RegistrationAnswersColumn = "payment_id"
 )
 
 // Columns holds all SQL columns for payment fields.
@@ -81,6 +100,11 @@ var Columns = []string{
 	FieldPaymentKey,
 	FieldOrderID,
 	FieldStatus,
+	FieldChannel,
+	FieldPaymentMethod,
+	FieldOperatorID,
+	FieldReceiptNumber,
+	FieldCheckedInAt,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -106,10 +130,6 @@ var (
 	DefaultCurrency string
 	// BuyerNameValidator is a validator for the "buyer_name" field. It is called by the builders before save.
 	BuyerNameValidator func(string) error
-	// BuyerEmailValidator is a validator for the "buyer_email" field. It is called by the builders before save.
-	BuyerEmailValidator func(string) error
-	// BuyerPhoneValidator is a validator for the "buyer_phone" field. It is called by the builders before save.
-	BuyerPhoneValidator func(string) error
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -149,6 +169,62 @@ func StatusValidator(s Status) error {
 	}
 }
 
+// Channel defines the type for the "channel" enum field.
+type Channel string
+
+// ChannelOnline is the default value of the Channel enum.
+const DefaultChannel = ChannelOnline
+
+// Channel values.
+const (
+	ChannelOnline    Channel = "online"
+	ChannelBoxOffice Channel = "box_office"
+	ChannelRsvp      Channel = "rsvp"
+)
+
+func (c Channel) String() string {
+	return string(c)
+}
+
+// ChannelValidator is a validator for the "channel" field enum values. It is called by the builders before save.
+func ChannelValidator(c Channel) error {
+	switch c {
+	case ChannelOnline, ChannelBoxOffice, ChannelRsvp:
+		return nil
+	default:
+		return fmt.Errorf("payment: invalid enum value for channel field: %q", c)
+	}
+}
+
+// PaymentMethod defines the type for the "payment_method" enum field.
+type PaymentMethod string
+
+// PaymentMethodGateway is the default value of the PaymentMethod enum.
+const DefaultPaymentMethod = PaymentMethodGateway
+
+// PaymentMethod values.
+const (
+	PaymentMethodGateway      PaymentMethod = "gateway"
+	PaymentMethodCash         PaymentMethod = "cash"
+	PaymentMethodCardTerminal PaymentMethod = "card_terminal"
+	PaymentMethodTransfer     PaymentMethod = "transfer"
+	PaymentMethodFree         PaymentMethod = "free"
+)
+
+func (pm PaymentMethod) String() string {
+	return string(pm)
+}
+
+// PaymentMethodValidator is a validator for the "payment_method" field enum values. It is called by the builders before save.
+func PaymentMethodValidator(pm PaymentMethod) error {
+	switch pm {
+	case PaymentMethodGateway, PaymentMethodCash, PaymentMethodCardTerminal, PaymentMethodTransfer, PaymentMethodFree:
+		return nil
+	default:
+		return fmt.Errorf("payment: invalid enum value for payment_method field: %q", pm)
+	}
+}
+
 // OrderOption defines the ordering options for the Payment queries.
 type OrderOption func(*sql.Selector)
 
@@ -217,6 +293,31 @@ func ByStatus(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldStatus, opts...).ToFunc()
 }
 
+// ByChannel orders the results by the channel field.
+func ByChannel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldChannel, opts...).ToFunc()
+}
+
+// ByPaymentMethod orders the results by the payment_method field.
+func ByPaymentMethod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentMethod, opts...).ToFunc()
+}
+
+// ByOperatorID orders the results by the operator_id field.
+func ByOperatorID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOperatorID, opts...).ToFunc()
+}
+
+// ByReceiptNumber orders the results by the receipt_number field.
+func ByReceiptNumber(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReceiptNumber, opts...).ToFunc()
+}
+
+// ByCheckedInAt orders the results by the checked_in_at field.
+func ByCheckedInAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCheckedInAt, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -240,6 +341,20 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByRegistrationAnswersCount orders the results by registration_answers count.
+func ByRegistrationAnswersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRegistrationAnswersStep(), opts...)
+	}
+}
+
+// ByRegistrationAnswers orders the results by registration_answers terms.
+func ByRegistrationAnswers(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRegistrationAnswersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newEventStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -254,3 +369,10 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newRegistrationAnswersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RegistrationAnswersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RegistrationAnswersTable, RegistrationAnswersColumn),
+	)
+}
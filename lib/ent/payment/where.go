@@ -111,6 +111,21 @@ func OrderID(v string) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldOrderID, v))
 }
 
+// OperatorID applies equality check predicate on the "operator_id" field. It's identical to OperatorIDEQ.
+func OperatorID(v uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// ReceiptNumber applies equality check predicate on the "receipt_number" field. It's identical to ReceiptNumberEQ.
+func ReceiptNumber(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldReceiptNumber, v))
+}
+
+// CheckedInAt applies equality check predicate on the "checked_in_at" field. It's identical to CheckedInAtEQ.
+func CheckedInAt(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCheckedInAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldCreatedAt, v))
@@ -746,6 +761,221 @@ func StatusNotIn(vs ...Status) predicate.Payment {
 	return predicate.Payment(sql.FieldNotIn(FieldStatus, vs...))
 }
 
+// ChannelEQ applies the EQ predicate on the "channel" field.
+func ChannelEQ(v Channel) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldChannel, v))
+}
+
+// ChannelNEQ applies the NEQ predicate on the "channel" field.
+func ChannelNEQ(v Channel) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldChannel, v))
+}
+
+// ChannelIn applies the In predicate on the "channel" field.
+func ChannelIn(vs ...Channel) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldChannel, vs...))
+}
+
+// ChannelNotIn applies the NotIn predicate on the "channel" field.
+func ChannelNotIn(vs ...Channel) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldChannel, vs...))
+}
+
+// PaymentMethodEQ applies the EQ predicate on the "payment_method" field.
+func PaymentMethodEQ(v PaymentMethod) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodNEQ applies the NEQ predicate on the "payment_method" field.
+func PaymentMethodNEQ(v PaymentMethod) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldPaymentMethod, v))
+}
+
+// PaymentMethodIn applies the In predicate on the "payment_method" field.
+func PaymentMethodIn(vs ...PaymentMethod) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldPaymentMethod, vs...))
+}
+
+// PaymentMethodNotIn applies the NotIn predicate on the "payment_method" field.
+func PaymentMethodNotIn(vs ...PaymentMethod) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldPaymentMethod, vs...))
+}
+
+// OperatorIDEQ applies the EQ predicate on the "operator_id" field.
+func OperatorIDEQ(v uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldOperatorID, v))
+}
+
+// OperatorIDNEQ applies the NEQ predicate on the "operator_id" field.
+func OperatorIDNEQ(v uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldOperatorID, v))
+}
+
+// OperatorIDIn applies the In predicate on the "operator_id" field.
+func OperatorIDIn(vs ...uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDNotIn applies the NotIn predicate on the "operator_id" field.
+func OperatorIDNotIn(vs ...uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldOperatorID, vs...))
+}
+
+// OperatorIDGT applies the GT predicate on the "operator_id" field.
+func OperatorIDGT(v uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldOperatorID, v))
+}
+
+// OperatorIDGTE applies the GTE predicate on the "operator_id" field.
+func OperatorIDGTE(v uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldOperatorID, v))
+}
+
+// OperatorIDLT applies the LT predicate on the "operator_id" field.
+func OperatorIDLT(v uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldOperatorID, v))
+}
+
+// OperatorIDLTE applies the LTE predicate on the "operator_id" field.
+func OperatorIDLTE(v uuid.UUID) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldOperatorID, v))
+}
+
+// OperatorIDIsNil applies the IsNil predicate on the "operator_id" field.
+func OperatorIDIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldOperatorID))
+}
+
+// OperatorIDNotNil applies the NotNil predicate on the "operator_id" field.
+func OperatorIDNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldOperatorID))
+}
+
+// ReceiptNumberEQ applies the EQ predicate on the "receipt_number" field.
+func ReceiptNumberEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberNEQ applies the NEQ predicate on the "receipt_number" field.
+func ReceiptNumberNEQ(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberIn applies the In predicate on the "receipt_number" field.
+func ReceiptNumberIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldReceiptNumber, vs...))
+}
+
+// ReceiptNumberNotIn applies the NotIn predicate on the "receipt_number" field.
+func ReceiptNumberNotIn(vs ...string) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldReceiptNumber, vs...))
+}
+
+// ReceiptNumberGT applies the GT predicate on the "receipt_number" field.
+func ReceiptNumberGT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberGTE applies the GTE predicate on the "receipt_number" field.
+func ReceiptNumberGTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberLT applies the LT predicate on the "receipt_number" field.
+func ReceiptNumberLT(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberLTE applies the LTE predicate on the "receipt_number" field.
+func ReceiptNumberLTE(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberContains applies the Contains predicate on the "receipt_number" field.
+func ReceiptNumberContains(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContains(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberHasPrefix applies the HasPrefix predicate on the "receipt_number" field.
+func ReceiptNumberHasPrefix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasPrefix(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberHasSuffix applies the HasSuffix predicate on the "receipt_number" field.
+func ReceiptNumberHasSuffix(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldHasSuffix(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberIsNil applies the IsNil predicate on the "receipt_number" field.
+func ReceiptNumberIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldReceiptNumber))
+}
+
+// ReceiptNumberNotNil applies the NotNil predicate on the "receipt_number" field.
+func ReceiptNumberNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldReceiptNumber))
+}
+
+// ReceiptNumberEqualFold applies the EqualFold predicate on the "receipt_number" field.
+func ReceiptNumberEqualFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldEqualFold(FieldReceiptNumber, v))
+}
+
+// ReceiptNumberContainsFold applies the ContainsFold predicate on the "receipt_number" field.
+func ReceiptNumberContainsFold(v string) predicate.Payment {
+	return predicate.Payment(sql.FieldContainsFold(FieldReceiptNumber, v))
+}
+
+// CheckedInAtEQ applies the EQ predicate on the "checked_in_at" field.
+func CheckedInAtEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldEQ(FieldCheckedInAt, v))
+}
+
+// CheckedInAtNEQ applies the NEQ predicate on the "checked_in_at" field.
+func CheckedInAtNEQ(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNEQ(FieldCheckedInAt, v))
+}
+
+// CheckedInAtIn applies the In predicate on the "checked_in_at" field.
+func CheckedInAtIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldIn(FieldCheckedInAt, vs...))
+}
+
+// CheckedInAtNotIn applies the NotIn predicate on the "checked_in_at" field.
+func CheckedInAtNotIn(vs ...time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldNotIn(FieldCheckedInAt, vs...))
+}
+
+// CheckedInAtGT applies the GT predicate on the "checked_in_at" field.
+func CheckedInAtGT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGT(FieldCheckedInAt, v))
+}
+
+// CheckedInAtGTE applies the GTE predicate on the "checked_in_at" field.
+func CheckedInAtGTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldGTE(FieldCheckedInAt, v))
+}
+
+// CheckedInAtLT applies the LT predicate on the "checked_in_at" field.
+func CheckedInAtLT(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLT(FieldCheckedInAt, v))
+}
+
+// CheckedInAtLTE applies the LTE predicate on the "checked_in_at" field.
+func CheckedInAtLTE(v time.Time) predicate.Payment {
+	return predicate.Payment(sql.FieldLTE(FieldCheckedInAt, v))
+}
+
+// CheckedInAtIsNil applies the IsNil predicate on the "checked_in_at" field.
+func CheckedInAtIsNil() predicate.Payment {
+	return predicate.Payment(sql.FieldIsNull(FieldCheckedInAt))
+}
+
+// CheckedInAtNotNil applies the NotNil predicate on the "checked_in_at" field.
+func CheckedInAtNotNil() predicate.Payment {
+	return predicate.Payment(sql.FieldNotNull(FieldCheckedInAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Payment {
 	return predicate.Payment(sql.FieldEQ(FieldCreatedAt, v))
@@ -872,6 +1102,29 @@ func HasUserWith(preds ...predicate.User) predicate.Payment {
 	})
 }
 
+// HasRegistrationAnswers applies the HasEdge predicate on the "registration_answers" edge.
+func HasRegistrationAnswers() predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RegistrationAnswersTable, RegistrationAnswersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRegistrationAnswersWith applies the HasEdge predicate on the "registration_answers" edge with a given conditions (other predicates).
+func HasRegistrationAnswersWith(preds ...predicate.RegistrationAnswer) predicate.Payment {
+	return predicate.Payment(func(s *sql.Selector) {
+		step := newRegistrationAnswersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Payment) predicate.Payment {
 	return predicate.Payment(sql.AndPredicates(predicates...))
@@ -12,6 +12,7 @@ import (
 	"entgo.io/ent/schema/field"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
 	"github.com/google/uuid"
 )
@@ -135,6 +136,76 @@ func (_c *PaymentCreate) SetNillableStatus(v *payment.Status) *PaymentCreate {
 	return _c
 }
 
+// SetChannel sets the "channel" field.
+func (_c *PaymentCreate) SetChannel(v payment.Channel) *PaymentCreate {
+	_c.mutation.SetChannel(v)
+	return _c
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillableChannel(v *payment.Channel) *PaymentCreate {
+	if v != nil {
+		_c.SetChannel(*v)
+	}
+	return _c
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_c *PaymentCreate) SetPaymentMethod(v payment.PaymentMethod) *PaymentCreate {
+	_c.mutation.SetPaymentMethod(v)
+	return _c
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillablePaymentMethod(v *payment.PaymentMethod) *PaymentCreate {
+	if v != nil {
+		_c.SetPaymentMethod(*v)
+	}
+	return _c
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_c *PaymentCreate) SetOperatorID(v uuid.UUID) *PaymentCreate {
+	_c.mutation.SetOperatorID(v)
+	return _c
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillableOperatorID(v *uuid.UUID) *PaymentCreate {
+	if v != nil {
+		_c.SetOperatorID(*v)
+	}
+	return _c
+}
+
+// SetReceiptNumber sets the "receipt_number" field.
+func (_c *PaymentCreate) SetReceiptNumber(v string) *PaymentCreate {
+	_c.mutation.SetReceiptNumber(v)
+	return _c
+}
+
+// SetNillableReceiptNumber sets the "receipt_number" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillableReceiptNumber(v *string) *PaymentCreate {
+	if v != nil {
+		_c.SetReceiptNumber(*v)
+	}
+	return _c
+}
+
+// SetCheckedInAt sets the "checked_in_at" field.
+func (_c *PaymentCreate) SetCheckedInAt(v time.Time) *PaymentCreate {
+	_c.mutation.SetCheckedInAt(v)
+	return _c
+}
+
+// SetNillableCheckedInAt sets the "checked_in_at" field if the given value is not nil.
+func (_c *PaymentCreate) SetNillableCheckedInAt(v *time.Time) *PaymentCreate {
+	if v != nil {
+		_c.SetCheckedInAt(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *PaymentCreate) SetCreatedAt(v time.Time) *PaymentCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -187,6 +258,21 @@ func (_c *PaymentCreate) SetUser(v *User) *PaymentCreate {
 	return _c.SetUserID(v.ID)
 }
 
+// AddRegistrationAnswerIDs adds the "registration_answers" edge to the RegistrationAnswer entity by IDs.
+func (_c *PaymentCreate) AddRegistrationAnswerIDs(ids ...uuid.UUID) *PaymentCreate {
+	_c.mutation.AddRegistrationAnswerIDs(ids...)
+	return _c
+}
+
+// AddRegistrationAnswers adds the "registration_answers" edges to the RegistrationAnswer entity.
+func (_c *PaymentCreate) AddRegistrationAnswers(v ...*RegistrationAnswer) *PaymentCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddRegistrationAnswerIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (_c *PaymentCreate) Mutation() *PaymentMutation {
 	return _c.mutation
@@ -230,6 +316,14 @@ func (_c *PaymentCreate) defaults() {
 		v := payment.DefaultStatus
 		_c.mutation.SetStatus(v)
 	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		v := payment.DefaultChannel
+		_c.mutation.SetChannel(v)
+	}
+	if _, ok := _c.mutation.PaymentMethod(); !ok {
+		v := payment.DefaultPaymentMethod
+		_c.mutation.SetPaymentMethod(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := payment.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -287,19 +381,9 @@ func (_c *PaymentCreate) check() error {
 	if _, ok := _c.mutation.BuyerEmail(); !ok {
 		return &ValidationError{Name: "buyer_email", err: errors.New(`ent: missing required field "Payment.buyer_email"`)}
 	}
-	if v, ok := _c.mutation.BuyerEmail(); ok {
-		if err := payment.BuyerEmailValidator(v); err != nil {
-			return &ValidationError{Name: "buyer_email", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_email": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.BuyerPhone(); !ok {
 		return &ValidationError{Name: "buyer_phone", err: errors.New(`ent: missing required field "Payment.buyer_phone"`)}
 	}
-	if v, ok := _c.mutation.BuyerPhone(); ok {
-		if err := payment.BuyerPhoneValidator(v); err != nil {
-			return &ValidationError{Name: "buyer_phone", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_phone": %w`, err)}
-		}
-	}
 	if _, ok := _c.mutation.Status(); !ok {
 		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Payment.status"`)}
 	}
@@ -308,6 +392,22 @@ func (_c *PaymentCreate) check() error {
 			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
 		}
 	}
+	if _, ok := _c.mutation.Channel(); !ok {
+		return &ValidationError{Name: "channel", err: errors.New(`ent: missing required field "Payment.channel"`)}
+	}
+	if v, ok := _c.mutation.Channel(); ok {
+		if err := payment.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "Payment.channel": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PaymentMethod(); !ok {
+		return &ValidationError{Name: "payment_method", err: errors.New(`ent: missing required field "Payment.payment_method"`)}
+	}
+	if v, ok := _c.mutation.PaymentMethod(); ok {
+		if err := payment.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Payment.payment_method": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Payment.created_at"`)}
 	}
@@ -392,6 +492,26 @@ func (_c *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		_spec.SetField(payment.FieldStatus, field.TypeEnum, value)
 		_node.Status = value
 	}
+	if value, ok := _c.mutation.Channel(); ok {
+		_spec.SetField(payment.FieldChannel, field.TypeEnum, value)
+		_node.Channel = value
+	}
+	if value, ok := _c.mutation.PaymentMethod(); ok {
+		_spec.SetField(payment.FieldPaymentMethod, field.TypeEnum, value)
+		_node.PaymentMethod = value
+	}
+	if value, ok := _c.mutation.OperatorID(); ok {
+		_spec.SetField(payment.FieldOperatorID, field.TypeUUID, value)
+		_node.OperatorID = value
+	}
+	if value, ok := _c.mutation.ReceiptNumber(); ok {
+		_spec.SetField(payment.FieldReceiptNumber, field.TypeString, value)
+		_node.ReceiptNumber = value
+	}
+	if value, ok := _c.mutation.CheckedInAt(); ok {
+		_spec.SetField(payment.FieldCheckedInAt, field.TypeTime, value)
+		_node.CheckedInAt = &value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(payment.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -434,6 +554,22 @@ func (_c *PaymentCreate) createSpec() (*Payment, *sqlgraph.CreateSpec) {
 		_node.UserID = nodes[0]
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.RegistrationAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RegistrationAnswersTable,
+			Columns: []string{payment.RegistrationAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
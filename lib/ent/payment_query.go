@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
 	"github.com/google/uuid"
 )
@@ -21,12 +23,13 @@ import (
 // PaymentQuery is the builder for querying Payment entities.
 type PaymentQuery struct {
 	config
-	ctx        *QueryContext
-	order      []payment.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Payment
-	withEvent  *EventQuery
-	withUser   *UserQuery
+	ctx                     *QueryContext
+	order                   []payment.OrderOption
+	inters                  []Interceptor
+	predicates              []predicate.Payment
+	withEvent               *EventQuery
+	withUser                *UserQuery
+	withRegistrationAnswers *RegistrationAnswerQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -107,6 +110,28 @@ func (_q *PaymentQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryRegistrationAnswers chains the current query on the "registration_answers" edge.
+func (_q *PaymentQuery) QueryRegistrationAnswers() *RegistrationAnswerQuery {
+	query := (&RegistrationAnswerClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(payment.Table, payment.FieldID, selector),
+			sqlgraph.To(registrationanswer.Table, registrationanswer.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, payment.RegistrationAnswersTable, payment.RegistrationAnswersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Payment entity from the query.
 // Returns a *NotFoundError when no Payment was found.
 func (_q *PaymentQuery) First(ctx context.Context) (*Payment, error) {
@@ -294,13 +319,14 @@ func (_q *PaymentQuery) Clone() *PaymentQuery {
 		return nil
 	}
 	return &PaymentQuery{
-		config:     _q.config,
-		ctx:        _q.ctx.Clone(),
-		order:      append([]payment.OrderOption{}, _q.order...),
-		inters:     append([]Interceptor{}, _q.inters...),
-		predicates: append([]predicate.Payment{}, _q.predicates...),
-		withEvent:  _q.withEvent.Clone(),
-		withUser:   _q.withUser.Clone(),
+		config:                  _q.config,
+		ctx:                     _q.ctx.Clone(),
+		order:                   append([]payment.OrderOption{}, _q.order...),
+		inters:                  append([]Interceptor{}, _q.inters...),
+		predicates:              append([]predicate.Payment{}, _q.predicates...),
+		withEvent:               _q.withEvent.Clone(),
+		withUser:                _q.withUser.Clone(),
+		withRegistrationAnswers: _q.withRegistrationAnswers.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -329,6 +355,17 @@ func (_q *PaymentQuery) WithUser(opts ...func(*UserQuery)) *PaymentQuery {
 	return _q
 }
 
+// WithRegistrationAnswers tells the query-builder to eager-load the nodes that are connected to
+// the "registration_answers" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *PaymentQuery) WithRegistrationAnswers(opts ...func(*RegistrationAnswerQuery)) *PaymentQuery {
+	query := (&RegistrationAnswerClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withRegistrationAnswers = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -407,9 +444,10 @@ func (_q *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Paym
 	var (
 		nodes       = []*Payment{}
 		_spec       = _q.querySpec()
-		loadedTypes = [2]bool{
+		loadedTypes = [3]bool{
 			_q.withEvent != nil,
 			_q.withUser != nil,
+			_q.withRegistrationAnswers != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -442,6 +480,15 @@ func (_q *PaymentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Paym
 			return nil, err
 		}
 	}
+	if query := _q.withRegistrationAnswers; query != nil {
+		if err := _q.loadRegistrationAnswers(ctx, query, nodes,
+			func(n *Payment) { n.Edges.RegistrationAnswers = []*RegistrationAnswer{} },
+			func(n *Payment, e *RegistrationAnswer) {
+				n.Edges.RegistrationAnswers = append(n.Edges.RegistrationAnswers, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -503,6 +550,36 @@ func (_q *PaymentQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	}
 	return nil
 }
+func (_q *PaymentQuery) loadRegistrationAnswers(ctx context.Context, query *RegistrationAnswerQuery, nodes []*Payment, init func(*Payment), assign func(*Payment, *RegistrationAnswer)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Payment)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(registrationanswer.FieldPaymentID)
+	}
+	query.Where(predicate.RegistrationAnswer(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(payment.RegistrationAnswersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.PaymentID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "payment_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *PaymentQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
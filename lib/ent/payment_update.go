@@ -14,6 +14,7 @@ import (
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
 	"github.com/google/uuid"
 )
@@ -231,6 +232,94 @@ func (_u *PaymentUpdate) SetNillableStatus(v *payment.Status) *PaymentUpdate {
 	return _u
 }
 
+// SetChannel sets the "channel" field.
+func (_u *PaymentUpdate) SetChannel(v payment.Channel) *PaymentUpdate {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableChannel(v *payment.Channel) *PaymentUpdate {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *PaymentUpdate) SetPaymentMethod(v payment.PaymentMethod) *PaymentUpdate {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillablePaymentMethod(v *payment.PaymentMethod) *PaymentUpdate {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *PaymentUpdate) SetOperatorID(v uuid.UUID) *PaymentUpdate {
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableOperatorID(v *uuid.UUID) *PaymentUpdate {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (_u *PaymentUpdate) ClearOperatorID() *PaymentUpdate {
+	_u.mutation.ClearOperatorID()
+	return _u
+}
+
+// SetReceiptNumber sets the "receipt_number" field.
+func (_u *PaymentUpdate) SetReceiptNumber(v string) *PaymentUpdate {
+	_u.mutation.SetReceiptNumber(v)
+	return _u
+}
+
+// SetNillableReceiptNumber sets the "receipt_number" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableReceiptNumber(v *string) *PaymentUpdate {
+	if v != nil {
+		_u.SetReceiptNumber(*v)
+	}
+	return _u
+}
+
+// ClearReceiptNumber clears the value of the "receipt_number" field.
+func (_u *PaymentUpdate) ClearReceiptNumber() *PaymentUpdate {
+	_u.mutation.ClearReceiptNumber()
+	return _u
+}
+
+// SetCheckedInAt sets the "checked_in_at" field.
+func (_u *PaymentUpdate) SetCheckedInAt(v time.Time) *PaymentUpdate {
+	_u.mutation.SetCheckedInAt(v)
+	return _u
+}
+
+// SetNillableCheckedInAt sets the "checked_in_at" field if the given value is not nil.
+func (_u *PaymentUpdate) SetNillableCheckedInAt(v *time.Time) *PaymentUpdate {
+	if v != nil {
+		_u.SetCheckedInAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedInAt clears the value of the "checked_in_at" field.
+func (_u *PaymentUpdate) ClearCheckedInAt() *PaymentUpdate {
+	_u.mutation.ClearCheckedInAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PaymentUpdate) SetUpdatedAt(v time.Time) *PaymentUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -247,6 +336,21 @@ func (_u *PaymentUpdate) SetUser(v *User) *PaymentUpdate {
 	return _u.SetUserID(v.ID)
 }
 
+// AddRegistrationAnswerIDs adds the "registration_answers" edge to the RegistrationAnswer entity by IDs.
+func (_u *PaymentUpdate) AddRegistrationAnswerIDs(ids ...uuid.UUID) *PaymentUpdate {
+	_u.mutation.AddRegistrationAnswerIDs(ids...)
+	return _u
+}
+
+// AddRegistrationAnswers adds the "registration_answers" edges to the RegistrationAnswer entity.
+func (_u *PaymentUpdate) AddRegistrationAnswers(v ...*RegistrationAnswer) *PaymentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRegistrationAnswerIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (_u *PaymentUpdate) Mutation() *PaymentMutation {
 	return _u.mutation
@@ -264,6 +368,27 @@ func (_u *PaymentUpdate) ClearUser() *PaymentUpdate {
 	return _u
 }
 
+// ClearRegistrationAnswers clears all "registration_answers" edges to the RegistrationAnswer entity.
+func (_u *PaymentUpdate) ClearRegistrationAnswers() *PaymentUpdate {
+	_u.mutation.ClearRegistrationAnswers()
+	return _u
+}
+
+// RemoveRegistrationAnswerIDs removes the "registration_answers" edge to RegistrationAnswer entities by IDs.
+func (_u *PaymentUpdate) RemoveRegistrationAnswerIDs(ids ...uuid.UUID) *PaymentUpdate {
+	_u.mutation.RemoveRegistrationAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveRegistrationAnswers removes "registration_answers" edges to RegistrationAnswer entities.
+func (_u *PaymentUpdate) RemoveRegistrationAnswers(v ...*RegistrationAnswer) *PaymentUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRegistrationAnswerIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *PaymentUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -322,19 +447,19 @@ func (_u *PaymentUpdate) check() error {
 			return &ValidationError{Name: "buyer_name", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_name": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.BuyerEmail(); ok {
-		if err := payment.BuyerEmailValidator(v); err != nil {
-			return &ValidationError{Name: "buyer_email", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_email": %w`, err)}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := payment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.BuyerPhone(); ok {
-		if err := payment.BuyerPhoneValidator(v); err != nil {
-			return &ValidationError{Name: "buyer_phone", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_phone": %w`, err)}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := payment.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "Payment.channel": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Status(); ok {
-		if err := payment.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
+	if v, ok := _u.mutation.PaymentMethod(); ok {
+		if err := payment.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Payment.payment_method": %w`, err)}
 		}
 	}
 	if _u.mutation.EventCleared() && len(_u.mutation.EventIDs()) > 0 {
@@ -397,6 +522,30 @@ func (_u *PaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(payment.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(payment.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(payment.FieldPaymentMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(payment.FieldOperatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.OperatorIDCleared() {
+		_spec.ClearField(payment.FieldOperatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReceiptNumber(); ok {
+		_spec.SetField(payment.FieldReceiptNumber, field.TypeString, value)
+	}
+	if _u.mutation.ReceiptNumberCleared() {
+		_spec.ClearField(payment.FieldReceiptNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.CheckedInAt(); ok {
+		_spec.SetField(payment.FieldCheckedInAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedInAtCleared() {
+		_spec.ClearField(payment.FieldCheckedInAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(payment.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -458,6 +607,51 @@ func (_u *PaymentUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RegistrationAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RegistrationAnswersTable,
+			Columns: []string{payment.RegistrationAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRegistrationAnswersIDs(); len(nodes) > 0 && !_u.mutation.RegistrationAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RegistrationAnswersTable,
+			Columns: []string{payment.RegistrationAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegistrationAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RegistrationAnswersTable,
+			Columns: []string{payment.RegistrationAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{payment.Label}
@@ -678,6 +872,94 @@ func (_u *PaymentUpdateOne) SetNillableStatus(v *payment.Status) *PaymentUpdateO
 	return _u
 }
 
+// SetChannel sets the "channel" field.
+func (_u *PaymentUpdateOne) SetChannel(v payment.Channel) *PaymentUpdateOne {
+	_u.mutation.SetChannel(v)
+	return _u
+}
+
+// SetNillableChannel sets the "channel" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableChannel(v *payment.Channel) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetChannel(*v)
+	}
+	return _u
+}
+
+// SetPaymentMethod sets the "payment_method" field.
+func (_u *PaymentUpdateOne) SetPaymentMethod(v payment.PaymentMethod) *PaymentUpdateOne {
+	_u.mutation.SetPaymentMethod(v)
+	return _u
+}
+
+// SetNillablePaymentMethod sets the "payment_method" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillablePaymentMethod(v *payment.PaymentMethod) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetPaymentMethod(*v)
+	}
+	return _u
+}
+
+// SetOperatorID sets the "operator_id" field.
+func (_u *PaymentUpdateOne) SetOperatorID(v uuid.UUID) *PaymentUpdateOne {
+	_u.mutation.SetOperatorID(v)
+	return _u
+}
+
+// SetNillableOperatorID sets the "operator_id" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableOperatorID(v *uuid.UUID) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetOperatorID(*v)
+	}
+	return _u
+}
+
+// ClearOperatorID clears the value of the "operator_id" field.
+func (_u *PaymentUpdateOne) ClearOperatorID() *PaymentUpdateOne {
+	_u.mutation.ClearOperatorID()
+	return _u
+}
+
+// SetReceiptNumber sets the "receipt_number" field.
+func (_u *PaymentUpdateOne) SetReceiptNumber(v string) *PaymentUpdateOne {
+	_u.mutation.SetReceiptNumber(v)
+	return _u
+}
+
+// SetNillableReceiptNumber sets the "receipt_number" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableReceiptNumber(v *string) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetReceiptNumber(*v)
+	}
+	return _u
+}
+
+// ClearReceiptNumber clears the value of the "receipt_number" field.
+func (_u *PaymentUpdateOne) ClearReceiptNumber() *PaymentUpdateOne {
+	_u.mutation.ClearReceiptNumber()
+	return _u
+}
+
+// SetCheckedInAt sets the "checked_in_at" field.
+func (_u *PaymentUpdateOne) SetCheckedInAt(v time.Time) *PaymentUpdateOne {
+	_u.mutation.SetCheckedInAt(v)
+	return _u
+}
+
+// SetNillableCheckedInAt sets the "checked_in_at" field if the given value is not nil.
+func (_u *PaymentUpdateOne) SetNillableCheckedInAt(v *time.Time) *PaymentUpdateOne {
+	if v != nil {
+		_u.SetCheckedInAt(*v)
+	}
+	return _u
+}
+
+// ClearCheckedInAt clears the value of the "checked_in_at" field.
+func (_u *PaymentUpdateOne) ClearCheckedInAt() *PaymentUpdateOne {
+	_u.mutation.ClearCheckedInAt()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *PaymentUpdateOne) SetUpdatedAt(v time.Time) *PaymentUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -694,6 +976,21 @@ func (_u *PaymentUpdateOne) SetUser(v *User) *PaymentUpdateOne {
 	return _u.SetUserID(v.ID)
 }
 
+// AddRegistrationAnswerIDs adds the "registration_answers" edge to the RegistrationAnswer entity by IDs.
+func (_u *PaymentUpdateOne) AddRegistrationAnswerIDs(ids ...uuid.UUID) *PaymentUpdateOne {
+	_u.mutation.AddRegistrationAnswerIDs(ids...)
+	return _u
+}
+
+// AddRegistrationAnswers adds the "registration_answers" edges to the RegistrationAnswer entity.
+func (_u *PaymentUpdateOne) AddRegistrationAnswers(v ...*RegistrationAnswer) *PaymentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddRegistrationAnswerIDs(ids...)
+}
+
 // Mutation returns the PaymentMutation object of the builder.
 func (_u *PaymentUpdateOne) Mutation() *PaymentMutation {
 	return _u.mutation
@@ -711,6 +1008,27 @@ func (_u *PaymentUpdateOne) ClearUser() *PaymentUpdateOne {
 	return _u
 }
 
+// ClearRegistrationAnswers clears all "registration_answers" edges to the RegistrationAnswer entity.
+func (_u *PaymentUpdateOne) ClearRegistrationAnswers() *PaymentUpdateOne {
+	_u.mutation.ClearRegistrationAnswers()
+	return _u
+}
+
+// RemoveRegistrationAnswerIDs removes the "registration_answers" edge to RegistrationAnswer entities by IDs.
+func (_u *PaymentUpdateOne) RemoveRegistrationAnswerIDs(ids ...uuid.UUID) *PaymentUpdateOne {
+	_u.mutation.RemoveRegistrationAnswerIDs(ids...)
+	return _u
+}
+
+// RemoveRegistrationAnswers removes "registration_answers" edges to RegistrationAnswer entities.
+func (_u *PaymentUpdateOne) RemoveRegistrationAnswers(v ...*RegistrationAnswer) *PaymentUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveRegistrationAnswerIDs(ids...)
+}
+
 // Where appends a list predicates to the PaymentUpdate builder.
 func (_u *PaymentUpdateOne) Where(ps ...predicate.Payment) *PaymentUpdateOne {
 	_u.mutation.Where(ps...)
@@ -782,19 +1100,19 @@ func (_u *PaymentUpdateOne) check() error {
 			return &ValidationError{Name: "buyer_name", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_name": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.BuyerEmail(); ok {
-		if err := payment.BuyerEmailValidator(v); err != nil {
-			return &ValidationError{Name: "buyer_email", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_email": %w`, err)}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := payment.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.BuyerPhone(); ok {
-		if err := payment.BuyerPhoneValidator(v); err != nil {
-			return &ValidationError{Name: "buyer_phone", err: fmt.Errorf(`ent: validator failed for field "Payment.buyer_phone": %w`, err)}
+	if v, ok := _u.mutation.Channel(); ok {
+		if err := payment.ChannelValidator(v); err != nil {
+			return &ValidationError{Name: "channel", err: fmt.Errorf(`ent: validator failed for field "Payment.channel": %w`, err)}
 		}
 	}
-	if v, ok := _u.mutation.Status(); ok {
-		if err := payment.StatusValidator(v); err != nil {
-			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Payment.status": %w`, err)}
+	if v, ok := _u.mutation.PaymentMethod(); ok {
+		if err := payment.PaymentMethodValidator(v); err != nil {
+			return &ValidationError{Name: "payment_method", err: fmt.Errorf(`ent: validator failed for field "Payment.payment_method": %w`, err)}
 		}
 	}
 	if _u.mutation.EventCleared() && len(_u.mutation.EventIDs()) > 0 {
@@ -874,6 +1192,30 @@ func (_u *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err er
 	if value, ok := _u.mutation.Status(); ok {
 		_spec.SetField(payment.FieldStatus, field.TypeEnum, value)
 	}
+	if value, ok := _u.mutation.Channel(); ok {
+		_spec.SetField(payment.FieldChannel, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.PaymentMethod(); ok {
+		_spec.SetField(payment.FieldPaymentMethod, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OperatorID(); ok {
+		_spec.SetField(payment.FieldOperatorID, field.TypeUUID, value)
+	}
+	if _u.mutation.OperatorIDCleared() {
+		_spec.ClearField(payment.FieldOperatorID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ReceiptNumber(); ok {
+		_spec.SetField(payment.FieldReceiptNumber, field.TypeString, value)
+	}
+	if _u.mutation.ReceiptNumberCleared() {
+		_spec.ClearField(payment.FieldReceiptNumber, field.TypeString)
+	}
+	if value, ok := _u.mutation.CheckedInAt(); ok {
+		_spec.SetField(payment.FieldCheckedInAt, field.TypeTime, value)
+	}
+	if _u.mutation.CheckedInAtCleared() {
+		_spec.ClearField(payment.FieldCheckedInAt, field.TypeTime)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(payment.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -935,6 +1277,51 @@ func (_u *PaymentUpdateOne) sqlSave(ctx context.Context) (_node *Payment, err er
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.RegistrationAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RegistrationAnswersTable,
+			Columns: []string{payment.RegistrationAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedRegistrationAnswersIDs(); len(nodes) > 0 && !_u.mutation.RegistrationAnswersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RegistrationAnswersTable,
+			Columns: []string{payment.RegistrationAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RegistrationAnswersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   payment.RegistrationAnswersTable,
+			Columns: []string{payment.RegistrationAnswersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Payment{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
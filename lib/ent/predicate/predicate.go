@@ -6,17 +6,62 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// APIKey is the predicate function for apikey builders.
+type APIKey func(*sql.Selector)
+
+// AuditLog is the predicate function for auditlog builders.
+type AuditLog func(*sql.Selector)
+
+// CancellationRefund is the predicate function for cancellationrefund builders.
+type CancellationRefund func(*sql.Selector)
+
 // Event is the predicate function for event builders.
 type Event func(*sql.Selector)
 
+// EventCancellation is the predicate function for eventcancellation builders.
+type EventCancellation func(*sql.Selector)
+
+// EventChange is the predicate function for eventchange builders.
+type EventChange func(*sql.Selector)
+
+// EventFavorite is the predicate function for eventfavorite builders.
+type EventFavorite func(*sql.Selector)
+
+// EventSeries is the predicate function for eventseries builders.
+type EventSeries func(*sql.Selector)
+
 // Organization is the predicate function for organization builders.
 type Organization func(*sql.Selector)
 
+// OrganizationInvitation is the predicate function for organizationinvitation builders.
+type OrganizationInvitation func(*sql.Selector)
+
 // OrganizationMember is the predicate function for organizationmember builders.
 type OrganizationMember func(*sql.Selector)
 
+// OrganizationRole is the predicate function for organizationrole builders.
+type OrganizationRole func(*sql.Selector)
+
+// OwnershipTransfer is the predicate function for ownershiptransfer builders.
+type OwnershipTransfer func(*sql.Selector)
+
 // Payment is the predicate function for payment builders.
 type Payment func(*sql.Selector)
 
+// RegistrationAnswer is the predicate function for registrationanswer builders.
+type RegistrationAnswer func(*sql.Selector)
+
+// RegistrationQuestion is the predicate function for registrationquestion builders.
+type RegistrationQuestion func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
+
+// Venue is the predicate function for venue builders.
+type Venue func(*sql.Selector)
+
+// WebhookDelivery is the predicate function for webhookdelivery builders.
+type WebhookDelivery func(*sql.Selector)
+
+// WebhookEndpoint is the predicate function for webhookendpoint builders.
+type WebhookEndpoint func(*sql.Selector)
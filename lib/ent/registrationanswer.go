@@ -0,0 +1,198 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+	"github.com/google/uuid"
+)
+
+// RegistrationAnswer is the model entity for the RegistrationAnswer schema.
+type RegistrationAnswer struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Payment the answered ticket belongs to
+	PaymentID uuid.UUID `json:"payment_id,omitempty"`
+	// Zero-based index of the ticket within the payment
+	TicketIndex int `json:"ticket_index,omitempty"`
+	// Answered question ID (kept even if the question is later removed)
+	QuestionID uuid.UUID `json:"question_id,omitempty"`
+	// Question label at the time of answering
+	QuestionLabel string `json:"question_label,omitempty"`
+	// Answer values; a single element except for multi_select
+	Values []string `json:"values,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RegistrationAnswerQuery when eager-loading is set.
+	Edges        RegistrationAnswerEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RegistrationAnswerEdges holds the relations/edges for other nodes in the graph.
+type RegistrationAnswerEdges struct {
+	// Payment holds the value of the payment edge.
+	Payment *Payment `json:"payment,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// PaymentOrErr returns the Payment value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RegistrationAnswerEdges) PaymentOrErr() (*Payment, error) {
+	if e.Payment != nil {
+		return e.Payment, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: payment.Label}
+	}
+	return nil, &NotLoadedError{edge: "payment"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RegistrationAnswer) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case registrationanswer.FieldValues:
+			values[i] = new([]byte)
+		case registrationanswer.FieldTicketIndex:
+			values[i] = new(sql.NullInt64)
+		case registrationanswer.FieldQuestionLabel:
+			values[i] = new(sql.NullString)
+		case registrationanswer.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case registrationanswer.FieldID, registrationanswer.FieldPaymentID, registrationanswer.FieldQuestionID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RegistrationAnswer fields.
+func (_m *RegistrationAnswer) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case registrationanswer.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case registrationanswer.FieldPaymentID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field payment_id", values[i])
+			} else if value != nil {
+				_m.PaymentID = *value
+			}
+		case registrationanswer.FieldTicketIndex:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field ticket_index", values[i])
+			} else if value.Valid {
+				_m.TicketIndex = int(value.Int64)
+			}
+		case registrationanswer.FieldQuestionID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field question_id", values[i])
+			} else if value != nil {
+				_m.QuestionID = *value
+			}
+		case registrationanswer.FieldQuestionLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field question_label", values[i])
+			} else if value.Valid {
+				_m.QuestionLabel = value.String
+			}
+		case registrationanswer.FieldValues:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field values", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Values); err != nil {
+					return fmt.Errorf("unmarshal field values: %w", err)
+				}
+			}
+		case registrationanswer.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RegistrationAnswer.
+// This includes values selected through modifiers, order, etc.
+func (_m *RegistrationAnswer) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPayment queries the "payment" edge of the RegistrationAnswer entity.
+func (_m *RegistrationAnswer) QueryPayment() *PaymentQuery {
+	return NewRegistrationAnswerClient(_m.config).QueryPayment(_m)
+}
+
+// Update returns a builder for updating this RegistrationAnswer.
+// Note that you need to call RegistrationAnswer.Unwrap() before calling this method if this RegistrationAnswer
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RegistrationAnswer) Update() *RegistrationAnswerUpdateOne {
+	return NewRegistrationAnswerClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RegistrationAnswer entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RegistrationAnswer) Unwrap() *RegistrationAnswer {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RegistrationAnswer is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RegistrationAnswer) String() string {
+	var builder strings.Builder
+	builder.WriteString("RegistrationAnswer(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("payment_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PaymentID))
+	builder.WriteString(", ")
+	builder.WriteString("ticket_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TicketIndex))
+	builder.WriteString(", ")
+	builder.WriteString("question_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.QuestionID))
+	builder.WriteString(", ")
+	builder.WriteString("question_label=")
+	builder.WriteString(_m.QuestionLabel)
+	builder.WriteString(", ")
+	builder.WriteString("values=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Values))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RegistrationAnswers is a parsable slice of RegistrationAnswer.
+type RegistrationAnswers []*RegistrationAnswer
@@ -0,0 +1,118 @@
+// Code generated by ent, DO NOT EDIT.
+
+package registrationanswer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the registrationanswer type in the database.
+	Label = "registration_answer"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldPaymentID holds the string denoting the payment_id field in the database.
+	FieldPaymentID = "payment_id"
+	// FieldTicketIndex holds the string denoting the ticket_index field in the database.
+	FieldTicketIndex = "ticket_index"
+	// FieldQuestionID holds the string denoting the question_id field in the database.
+	FieldQuestionID = "question_id"
+	// FieldQuestionLabel holds the string denoting the question_label field in the database.
+	FieldQuestionLabel = "question_label"
+	// FieldValues holds the string denoting the values field in the database.
+	FieldValues = "values"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgePayment holds the string denoting the payment edge name in mutations.
+	EdgePayment = "payment"
+	// Table holds the table name of the registrationanswer in the database.
+	Table = "registration_answers"
+	// PaymentTable is the table that holds the payment relation/edge.
+	PaymentTable = "registration_answers"
+	// PaymentInverseTable is the table name for the Payment entity.
+	// It exists in this package in order to avoid circular dependency with the "payment" package.
+	PaymentInverseTable = "payments"
+	// PaymentColumn is the table column denoting the payment relation/edge.
+	PaymentColumn = "payment_id"
+)
+
+// Columns holds all SQL columns for registrationanswer fields.
+var Columns = []string{
+	FieldID,
+	FieldPaymentID,
+	FieldTicketIndex,
+	FieldQuestionID,
+	FieldQuestionLabel,
+	FieldValues,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TicketIndexValidator is a validator for the "ticket_index" field. It is called by the builders before save.
+	TicketIndexValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the RegistrationAnswer queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByPaymentID orders the results by the payment_id field.
+func ByPaymentID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPaymentID, opts...).ToFunc()
+}
+
+// ByTicketIndex orders the results by the ticket_index field.
+func ByTicketIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicketIndex, opts...).ToFunc()
+}
+
+// ByQuestionID orders the results by the question_id field.
+func ByQuestionID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionID, opts...).ToFunc()
+}
+
+// ByQuestionLabel orders the results by the question_label field.
+func ByQuestionLabel(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuestionLabel, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByPaymentField orders the results by payment field.
+func ByPaymentField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPaymentStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newPaymentStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PaymentInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, PaymentTable, PaymentColumn),
+	)
+}
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package registrationanswer
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLTE(FieldID, id))
+}
+
+// PaymentID applies equality check predicate on the "payment_id" field. It's identical to PaymentIDEQ.
+func PaymentID(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldPaymentID, v))
+}
+
+// TicketIndex applies equality check predicate on the "ticket_index" field. It's identical to TicketIndexEQ.
+func TicketIndex(v int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldTicketIndex, v))
+}
+
+// QuestionID applies equality check predicate on the "question_id" field. It's identical to QuestionIDEQ.
+func QuestionID(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// QuestionLabel applies equality check predicate on the "question_label" field. It's identical to QuestionLabelEQ.
+func QuestionLabel(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldQuestionLabel, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// PaymentIDEQ applies the EQ predicate on the "payment_id" field.
+func PaymentIDEQ(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldPaymentID, v))
+}
+
+// PaymentIDNEQ applies the NEQ predicate on the "payment_id" field.
+func PaymentIDNEQ(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNEQ(FieldPaymentID, v))
+}
+
+// PaymentIDIn applies the In predicate on the "payment_id" field.
+func PaymentIDIn(vs ...uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldIn(FieldPaymentID, vs...))
+}
+
+// PaymentIDNotIn applies the NotIn predicate on the "payment_id" field.
+func PaymentIDNotIn(vs ...uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNotIn(FieldPaymentID, vs...))
+}
+
+// TicketIndexEQ applies the EQ predicate on the "ticket_index" field.
+func TicketIndexEQ(v int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldTicketIndex, v))
+}
+
+// TicketIndexNEQ applies the NEQ predicate on the "ticket_index" field.
+func TicketIndexNEQ(v int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNEQ(FieldTicketIndex, v))
+}
+
+// TicketIndexIn applies the In predicate on the "ticket_index" field.
+func TicketIndexIn(vs ...int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldIn(FieldTicketIndex, vs...))
+}
+
+// TicketIndexNotIn applies the NotIn predicate on the "ticket_index" field.
+func TicketIndexNotIn(vs ...int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNotIn(FieldTicketIndex, vs...))
+}
+
+// TicketIndexGT applies the GT predicate on the "ticket_index" field.
+func TicketIndexGT(v int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGT(FieldTicketIndex, v))
+}
+
+// TicketIndexGTE applies the GTE predicate on the "ticket_index" field.
+func TicketIndexGTE(v int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGTE(FieldTicketIndex, v))
+}
+
+// TicketIndexLT applies the LT predicate on the "ticket_index" field.
+func TicketIndexLT(v int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLT(FieldTicketIndex, v))
+}
+
+// TicketIndexLTE applies the LTE predicate on the "ticket_index" field.
+func TicketIndexLTE(v int) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLTE(FieldTicketIndex, v))
+}
+
+// QuestionIDEQ applies the EQ predicate on the "question_id" field.
+func QuestionIDEQ(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldQuestionID, v))
+}
+
+// QuestionIDNEQ applies the NEQ predicate on the "question_id" field.
+func QuestionIDNEQ(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNEQ(FieldQuestionID, v))
+}
+
+// QuestionIDIn applies the In predicate on the "question_id" field.
+func QuestionIDIn(vs ...uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDNotIn applies the NotIn predicate on the "question_id" field.
+func QuestionIDNotIn(vs ...uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNotIn(FieldQuestionID, vs...))
+}
+
+// QuestionIDGT applies the GT predicate on the "question_id" field.
+func QuestionIDGT(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGT(FieldQuestionID, v))
+}
+
+// QuestionIDGTE applies the GTE predicate on the "question_id" field.
+func QuestionIDGTE(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGTE(FieldQuestionID, v))
+}
+
+// QuestionIDLT applies the LT predicate on the "question_id" field.
+func QuestionIDLT(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLT(FieldQuestionID, v))
+}
+
+// QuestionIDLTE applies the LTE predicate on the "question_id" field.
+func QuestionIDLTE(v uuid.UUID) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLTE(FieldQuestionID, v))
+}
+
+// QuestionLabelEQ applies the EQ predicate on the "question_label" field.
+func QuestionLabelEQ(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldQuestionLabel, v))
+}
+
+// QuestionLabelNEQ applies the NEQ predicate on the "question_label" field.
+func QuestionLabelNEQ(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNEQ(FieldQuestionLabel, v))
+}
+
+// QuestionLabelIn applies the In predicate on the "question_label" field.
+func QuestionLabelIn(vs ...string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldIn(FieldQuestionLabel, vs...))
+}
+
+// QuestionLabelNotIn applies the NotIn predicate on the "question_label" field.
+func QuestionLabelNotIn(vs ...string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNotIn(FieldQuestionLabel, vs...))
+}
+
+// QuestionLabelGT applies the GT predicate on the "question_label" field.
+func QuestionLabelGT(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGT(FieldQuestionLabel, v))
+}
+
+// QuestionLabelGTE applies the GTE predicate on the "question_label" field.
+func QuestionLabelGTE(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGTE(FieldQuestionLabel, v))
+}
+
+// QuestionLabelLT applies the LT predicate on the "question_label" field.
+func QuestionLabelLT(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLT(FieldQuestionLabel, v))
+}
+
+// QuestionLabelLTE applies the LTE predicate on the "question_label" field.
+func QuestionLabelLTE(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLTE(FieldQuestionLabel, v))
+}
+
+// QuestionLabelContains applies the Contains predicate on the "question_label" field.
+func QuestionLabelContains(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldContains(FieldQuestionLabel, v))
+}
+
+// QuestionLabelHasPrefix applies the HasPrefix predicate on the "question_label" field.
+func QuestionLabelHasPrefix(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldHasPrefix(FieldQuestionLabel, v))
+}
+
+// QuestionLabelHasSuffix applies the HasSuffix predicate on the "question_label" field.
+func QuestionLabelHasSuffix(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldHasSuffix(FieldQuestionLabel, v))
+}
+
+// QuestionLabelEqualFold applies the EqualFold predicate on the "question_label" field.
+func QuestionLabelEqualFold(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEqualFold(FieldQuestionLabel, v))
+}
+
+// QuestionLabelContainsFold applies the ContainsFold predicate on the "question_label" field.
+func QuestionLabelContainsFold(v string) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldContainsFold(FieldQuestionLabel, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasPayment applies the HasEdge predicate on the "payment" edge.
+func HasPayment() predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, PaymentTable, PaymentColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPaymentWith applies the HasEdge predicate on the "payment" edge with a given conditions (other predicates).
+func HasPaymentWith(preds ...predicate.Payment) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(func(s *sql.Selector) {
+		step := newPaymentStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RegistrationAnswer) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RegistrationAnswer) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RegistrationAnswer) predicate.RegistrationAnswer {
+	return predicate.RegistrationAnswer(sql.NotPredicates(p))
+}
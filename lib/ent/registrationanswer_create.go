@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+	"github.com/google/uuid"
+)
+
+// RegistrationAnswerCreate is the builder for creating a RegistrationAnswer entity.
+type RegistrationAnswerCreate struct {
+	config
+	mutation *RegistrationAnswerMutation
+	hooks    []Hook
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (_c *RegistrationAnswerCreate) SetPaymentID(v uuid.UUID) *RegistrationAnswerCreate {
+	_c.mutation.SetPaymentID(v)
+	return _c
+}
+
+// SetTicketIndex sets the "ticket_index" field.
+func (_c *RegistrationAnswerCreate) SetTicketIndex(v int) *RegistrationAnswerCreate {
+	_c.mutation.SetTicketIndex(v)
+	return _c
+}
+
+// SetQuestionID sets the "question_id" field.
+func (_c *RegistrationAnswerCreate) SetQuestionID(v uuid.UUID) *RegistrationAnswerCreate {
+	_c.mutation.SetQuestionID(v)
+	return _c
+}
+
+// SetQuestionLabel sets the "question_label" field.
+func (_c *RegistrationAnswerCreate) SetQuestionLabel(v string) *RegistrationAnswerCreate {
+	_c.mutation.SetQuestionLabel(v)
+	return _c
+}
+
+// SetValues sets the "values" field.
+func (_c *RegistrationAnswerCreate) SetValues(v []string) *RegistrationAnswerCreate {
+	_c.mutation.SetValues(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RegistrationAnswerCreate) SetCreatedAt(v time.Time) *RegistrationAnswerCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RegistrationAnswerCreate) SetNillableCreatedAt(v *time.Time) *RegistrationAnswerCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RegistrationAnswerCreate) SetID(v uuid.UUID) *RegistrationAnswerCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RegistrationAnswerCreate) SetNillableID(v *uuid.UUID) *RegistrationAnswerCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (_c *RegistrationAnswerCreate) SetPayment(v *Payment) *RegistrationAnswerCreate {
+	return _c.SetPaymentID(v.ID)
+}
+
+// Mutation returns the RegistrationAnswerMutation object of the builder.
+func (_c *RegistrationAnswerCreate) Mutation() *RegistrationAnswerMutation {
+	return _c.mutation
+}
+
+// Save creates the RegistrationAnswer in the database.
+func (_c *RegistrationAnswerCreate) Save(ctx context.Context) (*RegistrationAnswer, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RegistrationAnswerCreate) SaveX(ctx context.Context) *RegistrationAnswer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationAnswerCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationAnswerCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RegistrationAnswerCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := registrationanswer.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := registrationanswer.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RegistrationAnswerCreate) check() error {
+	if _, ok := _c.mutation.PaymentID(); !ok {
+		return &ValidationError{Name: "payment_id", err: errors.New(`ent: missing required field "RegistrationAnswer.payment_id"`)}
+	}
+	if _, ok := _c.mutation.TicketIndex(); !ok {
+		return &ValidationError{Name: "ticket_index", err: errors.New(`ent: missing required field "RegistrationAnswer.ticket_index"`)}
+	}
+	if v, ok := _c.mutation.TicketIndex(); ok {
+		if err := registrationanswer.TicketIndexValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_index", err: fmt.Errorf(`ent: validator failed for field "RegistrationAnswer.ticket_index": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.QuestionID(); !ok {
+		return &ValidationError{Name: "question_id", err: errors.New(`ent: missing required field "RegistrationAnswer.question_id"`)}
+	}
+	if _, ok := _c.mutation.QuestionLabel(); !ok {
+		return &ValidationError{Name: "question_label", err: errors.New(`ent: missing required field "RegistrationAnswer.question_label"`)}
+	}
+	if _, ok := _c.mutation.Values(); !ok {
+		return &ValidationError{Name: "values", err: errors.New(`ent: missing required field "RegistrationAnswer.values"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RegistrationAnswer.created_at"`)}
+	}
+	if len(_c.mutation.PaymentIDs()) == 0 {
+		return &ValidationError{Name: "payment", err: errors.New(`ent: missing required edge "RegistrationAnswer.payment"`)}
+	}
+	return nil
+}
+
+func (_c *RegistrationAnswerCreate) sqlSave(ctx context.Context) (*RegistrationAnswer, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RegistrationAnswerCreate) createSpec() (*RegistrationAnswer, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RegistrationAnswer{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(registrationanswer.Table, sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.TicketIndex(); ok {
+		_spec.SetField(registrationanswer.FieldTicketIndex, field.TypeInt, value)
+		_node.TicketIndex = value
+	}
+	if value, ok := _c.mutation.QuestionID(); ok {
+		_spec.SetField(registrationanswer.FieldQuestionID, field.TypeUUID, value)
+		_node.QuestionID = value
+	}
+	if value, ok := _c.mutation.QuestionLabel(); ok {
+		_spec.SetField(registrationanswer.FieldQuestionLabel, field.TypeString, value)
+		_node.QuestionLabel = value
+	}
+	if value, ok := _c.mutation.Values(); ok {
+		_spec.SetField(registrationanswer.FieldValues, field.TypeJSON, value)
+		_node.Values = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(registrationanswer.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationanswer.PaymentTable,
+			Columns: []string{registrationanswer.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.PaymentID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RegistrationAnswerCreateBulk is the builder for creating many RegistrationAnswer entities in bulk.
+type RegistrationAnswerCreateBulk struct {
+	config
+	err      error
+	builders []*RegistrationAnswerCreate
+}
+
+// Save creates the RegistrationAnswer entities in the database.
+func (_c *RegistrationAnswerCreateBulk) Save(ctx context.Context) ([]*RegistrationAnswer, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RegistrationAnswer, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RegistrationAnswerMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RegistrationAnswerCreateBulk) SaveX(ctx context.Context) []*RegistrationAnswer {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationAnswerCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationAnswerCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
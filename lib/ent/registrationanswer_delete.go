@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+)
+
+// RegistrationAnswerDelete is the builder for deleting a RegistrationAnswer entity.
+type RegistrationAnswerDelete struct {
+	config
+	hooks    []Hook
+	mutation *RegistrationAnswerMutation
+}
+
+// Where appends a list predicates to the RegistrationAnswerDelete builder.
+func (_d *RegistrationAnswerDelete) Where(ps ...predicate.RegistrationAnswer) *RegistrationAnswerDelete {
+	_d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (_d *RegistrationAnswerDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, _d.sqlExec, _d.mutation, _d.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RegistrationAnswerDelete) ExecX(ctx context.Context) int {
+	n, err := _d.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (_d *RegistrationAnswerDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(registrationanswer.Table, sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID))
+	if ps := _d.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, _d.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	_d.mutation.done = true
+	return affected, err
+}
+
+// RegistrationAnswerDeleteOne is the builder for deleting a single RegistrationAnswer entity.
+type RegistrationAnswerDeleteOne struct {
+	_d *RegistrationAnswerDelete
+}
+
+// Where appends a list predicates to the RegistrationAnswerDelete builder.
+func (_d *RegistrationAnswerDeleteOne) Where(ps ...predicate.RegistrationAnswer) *RegistrationAnswerDeleteOne {
+	_d._d.mutation.Where(ps...)
+	return _d
+}
+
+// Exec executes the deletion query.
+func (_d *RegistrationAnswerDeleteOne) Exec(ctx context.Context) error {
+	n, err := _d._d.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{registrationanswer.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_d *RegistrationAnswerDeleteOne) ExecX(ctx context.Context) {
+	if err := _d.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
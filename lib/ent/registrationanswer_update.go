@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+	"github.com/google/uuid"
+)
+
+// RegistrationAnswerUpdate is the builder for updating RegistrationAnswer entities.
+type RegistrationAnswerUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RegistrationAnswerMutation
+}
+
+// Where appends a list predicates to the RegistrationAnswerUpdate builder.
+func (_u *RegistrationAnswerUpdate) Where(ps ...predicate.RegistrationAnswer) *RegistrationAnswerUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (_u *RegistrationAnswerUpdate) SetPaymentID(v uuid.UUID) *RegistrationAnswerUpdate {
+	_u.mutation.SetPaymentID(v)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment_id" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdate) SetNillablePaymentID(v *uuid.UUID) *RegistrationAnswerUpdate {
+	if v != nil {
+		_u.SetPaymentID(*v)
+	}
+	return _u
+}
+
+// SetTicketIndex sets the "ticket_index" field.
+func (_u *RegistrationAnswerUpdate) SetTicketIndex(v int) *RegistrationAnswerUpdate {
+	_u.mutation.ResetTicketIndex()
+	_u.mutation.SetTicketIndex(v)
+	return _u
+}
+
+// SetNillableTicketIndex sets the "ticket_index" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdate) SetNillableTicketIndex(v *int) *RegistrationAnswerUpdate {
+	if v != nil {
+		_u.SetTicketIndex(*v)
+	}
+	return _u
+}
+
+// AddTicketIndex adds value to the "ticket_index" field.
+func (_u *RegistrationAnswerUpdate) AddTicketIndex(v int) *RegistrationAnswerUpdate {
+	_u.mutation.AddTicketIndex(v)
+	return _u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (_u *RegistrationAnswerUpdate) SetQuestionID(v uuid.UUID) *RegistrationAnswerUpdate {
+	_u.mutation.SetQuestionID(v)
+	return _u
+}
+
+// SetNillableQuestionID sets the "question_id" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdate) SetNillableQuestionID(v *uuid.UUID) *RegistrationAnswerUpdate {
+	if v != nil {
+		_u.SetQuestionID(*v)
+	}
+	return _u
+}
+
+// SetQuestionLabel sets the "question_label" field.
+func (_u *RegistrationAnswerUpdate) SetQuestionLabel(v string) *RegistrationAnswerUpdate {
+	_u.mutation.SetQuestionLabel(v)
+	return _u
+}
+
+// SetNillableQuestionLabel sets the "question_label" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdate) SetNillableQuestionLabel(v *string) *RegistrationAnswerUpdate {
+	if v != nil {
+		_u.SetQuestionLabel(*v)
+	}
+	return _u
+}
+
+// SetValues sets the "values" field.
+func (_u *RegistrationAnswerUpdate) SetValues(v []string) *RegistrationAnswerUpdate {
+	_u.mutation.SetValues(v)
+	return _u
+}
+
+// AppendValues appends value to the "values" field.
+func (_u *RegistrationAnswerUpdate) AppendValues(v []string) *RegistrationAnswerUpdate {
+	_u.mutation.AppendValues(v)
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (_u *RegistrationAnswerUpdate) SetPayment(v *Payment) *RegistrationAnswerUpdate {
+	return _u.SetPaymentID(v.ID)
+}
+
+// Mutation returns the RegistrationAnswerMutation object of the builder.
+func (_u *RegistrationAnswerUpdate) Mutation() *RegistrationAnswerMutation {
+	return _u.mutation
+}
+
+// ClearPayment clears the "payment" edge to the Payment entity.
+func (_u *RegistrationAnswerUpdate) ClearPayment() *RegistrationAnswerUpdate {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RegistrationAnswerUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationAnswerUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RegistrationAnswerUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationAnswerUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RegistrationAnswerUpdate) check() error {
+	if v, ok := _u.mutation.TicketIndex(); ok {
+		if err := registrationanswer.TicketIndexValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_index", err: fmt.Errorf(`ent: validator failed for field "RegistrationAnswer.ticket_index": %w`, err)}
+		}
+	}
+	if _u.mutation.PaymentCleared() && len(_u.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RegistrationAnswer.payment"`)
+	}
+	return nil
+}
+
+func (_u *RegistrationAnswerUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(registrationanswer.Table, registrationanswer.Columns, sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TicketIndex(); ok {
+		_spec.SetField(registrationanswer.FieldTicketIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketIndex(); ok {
+		_spec.AddField(registrationanswer.FieldTicketIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QuestionID(); ok {
+		_spec.SetField(registrationanswer.FieldQuestionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.QuestionLabel(); ok {
+		_spec.SetField(registrationanswer.FieldQuestionLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Values(); ok {
+		_spec.SetField(registrationanswer.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, registrationanswer.FieldValues, value)
+		})
+	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationanswer.PaymentTable,
+			Columns: []string{registrationanswer.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationanswer.PaymentTable,
+			Columns: []string{registrationanswer.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationanswer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RegistrationAnswerUpdateOne is the builder for updating a single RegistrationAnswer entity.
+type RegistrationAnswerUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RegistrationAnswerMutation
+}
+
+// SetPaymentID sets the "payment_id" field.
+func (_u *RegistrationAnswerUpdateOne) SetPaymentID(v uuid.UUID) *RegistrationAnswerUpdateOne {
+	_u.mutation.SetPaymentID(v)
+	return _u
+}
+
+// SetNillablePaymentID sets the "payment_id" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdateOne) SetNillablePaymentID(v *uuid.UUID) *RegistrationAnswerUpdateOne {
+	if v != nil {
+		_u.SetPaymentID(*v)
+	}
+	return _u
+}
+
+// SetTicketIndex sets the "ticket_index" field.
+func (_u *RegistrationAnswerUpdateOne) SetTicketIndex(v int) *RegistrationAnswerUpdateOne {
+	_u.mutation.ResetTicketIndex()
+	_u.mutation.SetTicketIndex(v)
+	return _u
+}
+
+// SetNillableTicketIndex sets the "ticket_index" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdateOne) SetNillableTicketIndex(v *int) *RegistrationAnswerUpdateOne {
+	if v != nil {
+		_u.SetTicketIndex(*v)
+	}
+	return _u
+}
+
+// AddTicketIndex adds value to the "ticket_index" field.
+func (_u *RegistrationAnswerUpdateOne) AddTicketIndex(v int) *RegistrationAnswerUpdateOne {
+	_u.mutation.AddTicketIndex(v)
+	return _u
+}
+
+// SetQuestionID sets the "question_id" field.
+func (_u *RegistrationAnswerUpdateOne) SetQuestionID(v uuid.UUID) *RegistrationAnswerUpdateOne {
+	_u.mutation.SetQuestionID(v)
+	return _u
+}
+
+// SetNillableQuestionID sets the "question_id" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdateOne) SetNillableQuestionID(v *uuid.UUID) *RegistrationAnswerUpdateOne {
+	if v != nil {
+		_u.SetQuestionID(*v)
+	}
+	return _u
+}
+
+// SetQuestionLabel sets the "question_label" field.
+func (_u *RegistrationAnswerUpdateOne) SetQuestionLabel(v string) *RegistrationAnswerUpdateOne {
+	_u.mutation.SetQuestionLabel(v)
+	return _u
+}
+
+// SetNillableQuestionLabel sets the "question_label" field if the given value is not nil.
+func (_u *RegistrationAnswerUpdateOne) SetNillableQuestionLabel(v *string) *RegistrationAnswerUpdateOne {
+	if v != nil {
+		_u.SetQuestionLabel(*v)
+	}
+	return _u
+}
+
+// SetValues sets the "values" field.
+func (_u *RegistrationAnswerUpdateOne) SetValues(v []string) *RegistrationAnswerUpdateOne {
+	_u.mutation.SetValues(v)
+	return _u
+}
+
+// AppendValues appends value to the "values" field.
+func (_u *RegistrationAnswerUpdateOne) AppendValues(v []string) *RegistrationAnswerUpdateOne {
+	_u.mutation.AppendValues(v)
+	return _u
+}
+
+// SetPayment sets the "payment" edge to the Payment entity.
+func (_u *RegistrationAnswerUpdateOne) SetPayment(v *Payment) *RegistrationAnswerUpdateOne {
+	return _u.SetPaymentID(v.ID)
+}
+
+// Mutation returns the RegistrationAnswerMutation object of the builder.
+func (_u *RegistrationAnswerUpdateOne) Mutation() *RegistrationAnswerMutation {
+	return _u.mutation
+}
+
+// ClearPayment clears the "payment" edge to the Payment entity.
+func (_u *RegistrationAnswerUpdateOne) ClearPayment() *RegistrationAnswerUpdateOne {
+	_u.mutation.ClearPayment()
+	return _u
+}
+
+// Where appends a list predicates to the RegistrationAnswerUpdate builder.
+func (_u *RegistrationAnswerUpdateOne) Where(ps ...predicate.RegistrationAnswer) *RegistrationAnswerUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RegistrationAnswerUpdateOne) Select(field string, fields ...string) *RegistrationAnswerUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RegistrationAnswer entity.
+func (_u *RegistrationAnswerUpdateOne) Save(ctx context.Context) (*RegistrationAnswer, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationAnswerUpdateOne) SaveX(ctx context.Context) *RegistrationAnswer {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RegistrationAnswerUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationAnswerUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RegistrationAnswerUpdateOne) check() error {
+	if v, ok := _u.mutation.TicketIndex(); ok {
+		if err := registrationanswer.TicketIndexValidator(v); err != nil {
+			return &ValidationError{Name: "ticket_index", err: fmt.Errorf(`ent: validator failed for field "RegistrationAnswer.ticket_index": %w`, err)}
+		}
+	}
+	if _u.mutation.PaymentCleared() && len(_u.mutation.PaymentIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RegistrationAnswer.payment"`)
+	}
+	return nil
+}
+
+func (_u *RegistrationAnswerUpdateOne) sqlSave(ctx context.Context) (_node *RegistrationAnswer, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(registrationanswer.Table, registrationanswer.Columns, sqlgraph.NewFieldSpec(registrationanswer.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RegistrationAnswer.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, registrationanswer.FieldID)
+		for _, f := range fields {
+			if !registrationanswer.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != registrationanswer.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.TicketIndex(); ok {
+		_spec.SetField(registrationanswer.FieldTicketIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTicketIndex(); ok {
+		_spec.AddField(registrationanswer.FieldTicketIndex, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.QuestionID(); ok {
+		_spec.SetField(registrationanswer.FieldQuestionID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.QuestionLabel(); ok {
+		_spec.SetField(registrationanswer.FieldQuestionLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Values(); ok {
+		_spec.SetField(registrationanswer.FieldValues, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedValues(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, registrationanswer.FieldValues, value)
+		})
+	}
+	if _u.mutation.PaymentCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationanswer.PaymentTable,
+			Columns: []string{registrationanswer.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PaymentIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationanswer.PaymentTable,
+			Columns: []string{registrationanswer.PaymentColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(payment.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RegistrationAnswer{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationanswer.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,222 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
+	"github.com/google/uuid"
+)
+
+// RegistrationQuestion is the model entity for the RegistrationQuestion schema.
+type RegistrationQuestion struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Event this question belongs to
+	EventID uuid.UUID `json:"event_id,omitempty"`
+	// Question shown to the attendee
+	Label string `json:"label,omitempty"`
+	// Answer input type
+	Type registrationquestion.Type `json:"type,omitempty"`
+	// Allowed values for select and multi_select questions
+	Options []string `json:"options,omitempty"`
+	// Whether every ticket must answer this question
+	Required bool `json:"required,omitempty"`
+	// Display order within the event form
+	Position int `json:"position,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the RegistrationQuestionQuery when eager-loading is set.
+	Edges        RegistrationQuestionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// RegistrationQuestionEdges holds the relations/edges for other nodes in the graph.
+type RegistrationQuestionEdges struct {
+	// Event holds the value of the event edge.
+	Event *Event `json:"event,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// EventOrErr returns the Event value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e RegistrationQuestionEdges) EventOrErr() (*Event, error) {
+	if e.Event != nil {
+		return e.Event, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: event.Label}
+	}
+	return nil, &NotLoadedError{edge: "event"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RegistrationQuestion) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case registrationquestion.FieldOptions:
+			values[i] = new([]byte)
+		case registrationquestion.FieldRequired:
+			values[i] = new(sql.NullBool)
+		case registrationquestion.FieldPosition:
+			values[i] = new(sql.NullInt64)
+		case registrationquestion.FieldLabel, registrationquestion.FieldType:
+			values[i] = new(sql.NullString)
+		case registrationquestion.FieldCreatedAt, registrationquestion.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case registrationquestion.FieldID, registrationquestion.FieldEventID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RegistrationQuestion fields.
+func (_m *RegistrationQuestion) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case registrationquestion.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case registrationquestion.FieldEventID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field event_id", values[i])
+			} else if value != nil {
+				_m.EventID = *value
+			}
+		case registrationquestion.FieldLabel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field label", values[i])
+			} else if value.Valid {
+				_m.Label = value.String
+			}
+		case registrationquestion.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = registrationquestion.Type(value.String)
+			}
+		case registrationquestion.FieldOptions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field options", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Options); err != nil {
+					return fmt.Errorf("unmarshal field options: %w", err)
+				}
+			}
+		case registrationquestion.FieldRequired:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field required", values[i])
+			} else if value.Valid {
+				_m.Required = value.Bool
+			}
+		case registrationquestion.FieldPosition:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field position", values[i])
+			} else if value.Valid {
+				_m.Position = int(value.Int64)
+			}
+		case registrationquestion.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case registrationquestion.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RegistrationQuestion.
+// This includes values selected through modifiers, order, etc.
+func (_m *RegistrationQuestion) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryEvent queries the "event" edge of the RegistrationQuestion entity.
+func (_m *RegistrationQuestion) QueryEvent() *EventQuery {
+	return NewRegistrationQuestionClient(_m.config).QueryEvent(_m)
+}
+
+// Update returns a builder for updating this RegistrationQuestion.
+// Note that you need to call RegistrationQuestion.Unwrap() before calling this method if this RegistrationQuestion
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RegistrationQuestion) Update() *RegistrationQuestionUpdateOne {
+	return NewRegistrationQuestionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RegistrationQuestion entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RegistrationQuestion) Unwrap() *RegistrationQuestion {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RegistrationQuestion is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RegistrationQuestion) String() string {
+	var builder strings.Builder
+	builder.WriteString("RegistrationQuestion(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("event_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EventID))
+	builder.WriteString(", ")
+	builder.WriteString("label=")
+	builder.WriteString(_m.Label)
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("options=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Options))
+	builder.WriteString(", ")
+	builder.WriteString("required=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Required))
+	builder.WriteString(", ")
+	builder.WriteString("position=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Position))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RegistrationQuestions is a parsable slice of RegistrationQuestion.
+type RegistrationQuestions []*RegistrationQuestion
@@ -0,0 +1,382 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
+	"github.com/google/uuid"
+)
+
+// RegistrationQuestionCreate is the builder for creating a RegistrationQuestion entity.
+type RegistrationQuestionCreate struct {
+	config
+	mutation *RegistrationQuestionMutation
+	hooks    []Hook
+}
+
+// SetEventID sets the "event_id" field.
+func (_c *RegistrationQuestionCreate) SetEventID(v uuid.UUID) *RegistrationQuestionCreate {
+	_c.mutation.SetEventID(v)
+	return _c
+}
+
+// SetLabel sets the "label" field.
+func (_c *RegistrationQuestionCreate) SetLabel(v string) *RegistrationQuestionCreate {
+	_c.mutation.SetLabel(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *RegistrationQuestionCreate) SetType(v registrationquestion.Type) *RegistrationQuestionCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetOptions sets the "options" field.
+func (_c *RegistrationQuestionCreate) SetOptions(v []string) *RegistrationQuestionCreate {
+	_c.mutation.SetOptions(v)
+	return _c
+}
+
+// SetRequired sets the "required" field.
+func (_c *RegistrationQuestionCreate) SetRequired(v bool) *RegistrationQuestionCreate {
+	_c.mutation.SetRequired(v)
+	return _c
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_c *RegistrationQuestionCreate) SetNillableRequired(v *bool) *RegistrationQuestionCreate {
+	if v != nil {
+		_c.SetRequired(*v)
+	}
+	return _c
+}
+
+// SetPosition sets the "position" field.
+func (_c *RegistrationQuestionCreate) SetPosition(v int) *RegistrationQuestionCreate {
+	_c.mutation.SetPosition(v)
+	return _c
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_c *RegistrationQuestionCreate) SetNillablePosition(v *int) *RegistrationQuestionCreate {
+	if v != nil {
+		_c.SetPosition(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RegistrationQuestionCreate) SetCreatedAt(v time.Time) *RegistrationQuestionCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RegistrationQuestionCreate) SetNillableCreatedAt(v *time.Time) *RegistrationQuestionCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RegistrationQuestionCreate) SetUpdatedAt(v time.Time) *RegistrationQuestionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RegistrationQuestionCreate) SetNillableUpdatedAt(v *time.Time) *RegistrationQuestionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RegistrationQuestionCreate) SetID(v uuid.UUID) *RegistrationQuestionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RegistrationQuestionCreate) SetNillableID(v *uuid.UUID) *RegistrationQuestionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (_c *RegistrationQuestionCreate) SetEvent(v *Event) *RegistrationQuestionCreate {
+	return _c.SetEventID(v.ID)
+}
+
+// Mutation returns the RegistrationQuestionMutation object of the builder.
+func (_c *RegistrationQuestionCreate) Mutation() *RegistrationQuestionMutation {
+	return _c.mutation
+}
+
+// Save creates the RegistrationQuestion in the database.
+func (_c *RegistrationQuestionCreate) Save(ctx context.Context) (*RegistrationQuestion, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RegistrationQuestionCreate) SaveX(ctx context.Context) *RegistrationQuestion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationQuestionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationQuestionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RegistrationQuestionCreate) defaults() {
+	if _, ok := _c.mutation.Required(); !ok {
+		v := registrationquestion.DefaultRequired
+		_c.mutation.SetRequired(v)
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		v := registrationquestion.DefaultPosition
+		_c.mutation.SetPosition(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := registrationquestion.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := registrationquestion.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := registrationquestion.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RegistrationQuestionCreate) check() error {
+	if _, ok := _c.mutation.EventID(); !ok {
+		return &ValidationError{Name: "event_id", err: errors.New(`ent: missing required field "RegistrationQuestion.event_id"`)}
+	}
+	if _, ok := _c.mutation.Label(); !ok {
+		return &ValidationError{Name: "label", err: errors.New(`ent: missing required field "RegistrationQuestion.label"`)}
+	}
+	if v, ok := _c.mutation.Label(); ok {
+		if err := registrationquestion.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "RegistrationQuestion.label": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "RegistrationQuestion.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := registrationquestion.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "RegistrationQuestion.type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Required(); !ok {
+		return &ValidationError{Name: "required", err: errors.New(`ent: missing required field "RegistrationQuestion.required"`)}
+	}
+	if _, ok := _c.mutation.Position(); !ok {
+		return &ValidationError{Name: "position", err: errors.New(`ent: missing required field "RegistrationQuestion.position"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RegistrationQuestion.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RegistrationQuestion.updated_at"`)}
+	}
+	if len(_c.mutation.EventIDs()) == 0 {
+		return &ValidationError{Name: "event", err: errors.New(`ent: missing required edge "RegistrationQuestion.event"`)}
+	}
+	return nil
+}
+
+func (_c *RegistrationQuestionCreate) sqlSave(ctx context.Context) (*RegistrationQuestion, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RegistrationQuestionCreate) createSpec() (*RegistrationQuestion, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RegistrationQuestion{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(registrationquestion.Table, sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Label(); ok {
+		_spec.SetField(registrationquestion.FieldLabel, field.TypeString, value)
+		_node.Label = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(registrationquestion.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Options(); ok {
+		_spec.SetField(registrationquestion.FieldOptions, field.TypeJSON, value)
+		_node.Options = value
+	}
+	if value, ok := _c.mutation.Required(); ok {
+		_spec.SetField(registrationquestion.FieldRequired, field.TypeBool, value)
+		_node.Required = value
+	}
+	if value, ok := _c.mutation.Position(); ok {
+		_spec.SetField(registrationquestion.FieldPosition, field.TypeInt, value)
+		_node.Position = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(registrationquestion.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationquestion.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationquestion.EventTable,
+			Columns: []string{registrationquestion.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.EventID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// RegistrationQuestionCreateBulk is the builder for creating many RegistrationQuestion entities in bulk.
+type RegistrationQuestionCreateBulk struct {
+	config
+	err      error
+	builders []*RegistrationQuestionCreate
+}
+
+// Save creates the RegistrationQuestion entities in the database.
+func (_c *RegistrationQuestionCreateBulk) Save(ctx context.Context) ([]*RegistrationQuestion, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RegistrationQuestion, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RegistrationQuestionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RegistrationQuestionCreateBulk) SaveX(ctx context.Context) []*RegistrationQuestion {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RegistrationQuestionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RegistrationQuestionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,579 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
+	"github.com/google/uuid"
+)
+
+// RegistrationQuestionUpdate is the builder for updating RegistrationQuestion entities.
+type RegistrationQuestionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RegistrationQuestionMutation
+}
+
+// Where appends a list predicates to the RegistrationQuestionUpdate builder.
+func (_u *RegistrationQuestionUpdate) Where(ps ...predicate.RegistrationQuestion) *RegistrationQuestionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *RegistrationQuestionUpdate) SetEventID(v uuid.UUID) *RegistrationQuestionUpdate {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdate) SetNillableEventID(v *uuid.UUID) *RegistrationQuestionUpdate {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *RegistrationQuestionUpdate) SetLabel(v string) *RegistrationQuestionUpdate {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdate) SetNillableLabel(v *string) *RegistrationQuestionUpdate {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *RegistrationQuestionUpdate) SetType(v registrationquestion.Type) *RegistrationQuestionUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdate) SetNillableType(v *registrationquestion.Type) *RegistrationQuestionUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *RegistrationQuestionUpdate) SetOptions(v []string) *RegistrationQuestionUpdate {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *RegistrationQuestionUpdate) AppendOptions(v []string) *RegistrationQuestionUpdate {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *RegistrationQuestionUpdate) ClearOptions() *RegistrationQuestionUpdate {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *RegistrationQuestionUpdate) SetRequired(v bool) *RegistrationQuestionUpdate {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdate) SetNillableRequired(v *bool) *RegistrationQuestionUpdate {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *RegistrationQuestionUpdate) SetPosition(v int) *RegistrationQuestionUpdate {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdate) SetNillablePosition(v *int) *RegistrationQuestionUpdate {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *RegistrationQuestionUpdate) AddPosition(v int) *RegistrationQuestionUpdate {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RegistrationQuestionUpdate) SetUpdatedAt(v time.Time) *RegistrationQuestionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (_u *RegistrationQuestionUpdate) SetEvent(v *Event) *RegistrationQuestionUpdate {
+	return _u.SetEventID(v.ID)
+}
+
+// Mutation returns the RegistrationQuestionMutation object of the builder.
+func (_u *RegistrationQuestionUpdate) Mutation() *RegistrationQuestionMutation {
+	return _u.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (_u *RegistrationQuestionUpdate) ClearEvent() *RegistrationQuestionUpdate {
+	_u.mutation.ClearEvent()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RegistrationQuestionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationQuestionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RegistrationQuestionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationQuestionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RegistrationQuestionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := registrationquestion.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RegistrationQuestionUpdate) check() error {
+	if v, ok := _u.mutation.Label(); ok {
+		if err := registrationquestion.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "RegistrationQuestion.label": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := registrationquestion.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "RegistrationQuestion.type": %w`, err)}
+		}
+	}
+	if _u.mutation.EventCleared() && len(_u.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RegistrationQuestion.event"`)
+	}
+	return nil
+}
+
+func (_u *RegistrationQuestionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(registrationquestion.Table, registrationquestion.Columns, sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(registrationquestion.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(registrationquestion.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(registrationquestion.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, registrationquestion.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(registrationquestion.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(registrationquestion.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(registrationquestion.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(registrationquestion.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationquestion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationquestion.EventTable,
+			Columns: []string{registrationquestion.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationquestion.EventTable,
+			Columns: []string{registrationquestion.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationquestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RegistrationQuestionUpdateOne is the builder for updating a single RegistrationQuestion entity.
+type RegistrationQuestionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RegistrationQuestionMutation
+}
+
+// SetEventID sets the "event_id" field.
+func (_u *RegistrationQuestionUpdateOne) SetEventID(v uuid.UUID) *RegistrationQuestionUpdateOne {
+	_u.mutation.SetEventID(v)
+	return _u
+}
+
+// SetNillableEventID sets the "event_id" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdateOne) SetNillableEventID(v *uuid.UUID) *RegistrationQuestionUpdateOne {
+	if v != nil {
+		_u.SetEventID(*v)
+	}
+	return _u
+}
+
+// SetLabel sets the "label" field.
+func (_u *RegistrationQuestionUpdateOne) SetLabel(v string) *RegistrationQuestionUpdateOne {
+	_u.mutation.SetLabel(v)
+	return _u
+}
+
+// SetNillableLabel sets the "label" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdateOne) SetNillableLabel(v *string) *RegistrationQuestionUpdateOne {
+	if v != nil {
+		_u.SetLabel(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *RegistrationQuestionUpdateOne) SetType(v registrationquestion.Type) *RegistrationQuestionUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdateOne) SetNillableType(v *registrationquestion.Type) *RegistrationQuestionUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetOptions sets the "options" field.
+func (_u *RegistrationQuestionUpdateOne) SetOptions(v []string) *RegistrationQuestionUpdateOne {
+	_u.mutation.SetOptions(v)
+	return _u
+}
+
+// AppendOptions appends value to the "options" field.
+func (_u *RegistrationQuestionUpdateOne) AppendOptions(v []string) *RegistrationQuestionUpdateOne {
+	_u.mutation.AppendOptions(v)
+	return _u
+}
+
+// ClearOptions clears the value of the "options" field.
+func (_u *RegistrationQuestionUpdateOne) ClearOptions() *RegistrationQuestionUpdateOne {
+	_u.mutation.ClearOptions()
+	return _u
+}
+
+// SetRequired sets the "required" field.
+func (_u *RegistrationQuestionUpdateOne) SetRequired(v bool) *RegistrationQuestionUpdateOne {
+	_u.mutation.SetRequired(v)
+	return _u
+}
+
+// SetNillableRequired sets the "required" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdateOne) SetNillableRequired(v *bool) *RegistrationQuestionUpdateOne {
+	if v != nil {
+		_u.SetRequired(*v)
+	}
+	return _u
+}
+
+// SetPosition sets the "position" field.
+func (_u *RegistrationQuestionUpdateOne) SetPosition(v int) *RegistrationQuestionUpdateOne {
+	_u.mutation.ResetPosition()
+	_u.mutation.SetPosition(v)
+	return _u
+}
+
+// SetNillablePosition sets the "position" field if the given value is not nil.
+func (_u *RegistrationQuestionUpdateOne) SetNillablePosition(v *int) *RegistrationQuestionUpdateOne {
+	if v != nil {
+		_u.SetPosition(*v)
+	}
+	return _u
+}
+
+// AddPosition adds value to the "position" field.
+func (_u *RegistrationQuestionUpdateOne) AddPosition(v int) *RegistrationQuestionUpdateOne {
+	_u.mutation.AddPosition(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RegistrationQuestionUpdateOne) SetUpdatedAt(v time.Time) *RegistrationQuestionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetEvent sets the "event" edge to the Event entity.
+func (_u *RegistrationQuestionUpdateOne) SetEvent(v *Event) *RegistrationQuestionUpdateOne {
+	return _u.SetEventID(v.ID)
+}
+
+// Mutation returns the RegistrationQuestionMutation object of the builder.
+func (_u *RegistrationQuestionUpdateOne) Mutation() *RegistrationQuestionMutation {
+	return _u.mutation
+}
+
+// ClearEvent clears the "event" edge to the Event entity.
+func (_u *RegistrationQuestionUpdateOne) ClearEvent() *RegistrationQuestionUpdateOne {
+	_u.mutation.ClearEvent()
+	return _u
+}
+
+// Where appends a list predicates to the RegistrationQuestionUpdate builder.
+func (_u *RegistrationQuestionUpdateOne) Where(ps ...predicate.RegistrationQuestion) *RegistrationQuestionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RegistrationQuestionUpdateOne) Select(field string, fields ...string) *RegistrationQuestionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RegistrationQuestion entity.
+func (_u *RegistrationQuestionUpdateOne) Save(ctx context.Context) (*RegistrationQuestion, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RegistrationQuestionUpdateOne) SaveX(ctx context.Context) *RegistrationQuestion {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RegistrationQuestionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RegistrationQuestionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RegistrationQuestionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := registrationquestion.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RegistrationQuestionUpdateOne) check() error {
+	if v, ok := _u.mutation.Label(); ok {
+		if err := registrationquestion.LabelValidator(v); err != nil {
+			return &ValidationError{Name: "label", err: fmt.Errorf(`ent: validator failed for field "RegistrationQuestion.label": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := registrationquestion.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "RegistrationQuestion.type": %w`, err)}
+		}
+	}
+	if _u.mutation.EventCleared() && len(_u.mutation.EventIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "RegistrationQuestion.event"`)
+	}
+	return nil
+}
+
+func (_u *RegistrationQuestionUpdateOne) sqlSave(ctx context.Context) (_node *RegistrationQuestion, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(registrationquestion.Table, registrationquestion.Columns, sqlgraph.NewFieldSpec(registrationquestion.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RegistrationQuestion.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, registrationquestion.FieldID)
+		for _, f := range fields {
+			if !registrationquestion.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != registrationquestion.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Label(); ok {
+		_spec.SetField(registrationquestion.FieldLabel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(registrationquestion.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Options(); ok {
+		_spec.SetField(registrationquestion.FieldOptions, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedOptions(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, registrationquestion.FieldOptions, value)
+		})
+	}
+	if _u.mutation.OptionsCleared() {
+		_spec.ClearField(registrationquestion.FieldOptions, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Required(); ok {
+		_spec.SetField(registrationquestion.FieldRequired, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.Position(); ok {
+		_spec.SetField(registrationquestion.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedPosition(); ok {
+		_spec.AddField(registrationquestion.FieldPosition, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(registrationquestion.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EventCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationquestion.EventTable,
+			Columns: []string{registrationquestion.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   registrationquestion.EventTable,
+			Columns: []string{registrationquestion.EventColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &RegistrationQuestion{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{registrationquestion.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
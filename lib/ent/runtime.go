@@ -5,12 +5,27 @@ package ent
 import (
 	"time"
 
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/apikey"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/auditlog"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/cancellationrefund"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventcancellation"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventchange"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventfavorite"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationinvitation"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationmember"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organizationrole"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/ownershiptransfer"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/payment"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationanswer"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/registrationquestion"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/schema"
 	"github.com/dev-hyunsang/ticketly-backend/lib/ent/user"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookdelivery"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/webhookendpoint"
 	"github.com/google/uuid"
 )
 
@@ -18,50 +33,178 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	apikeyFields := schema.APIKey{}.Fields()
+	_ = apikeyFields
+	// apikeyDescName is the schema descriptor for name field.
+	apikeyDescName := apikeyFields[2].Descriptor()
+	// apikey.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	apikey.NameValidator = apikeyDescName.Validators[0].(func(string) error)
+	// apikeyDescPrefix is the schema descriptor for prefix field.
+	apikeyDescPrefix := apikeyFields[3].Descriptor()
+	// apikey.PrefixValidator is a validator for the "prefix" field. It is called by the builders before save.
+	apikey.PrefixValidator = apikeyDescPrefix.Validators[0].(func(string) error)
+	// apikeyDescSecretHash is the schema descriptor for secret_hash field.
+	apikeyDescSecretHash := apikeyFields[4].Descriptor()
+	// apikey.SecretHashValidator is a validator for the "secret_hash" field. It is called by the builders before save.
+	apikey.SecretHashValidator = apikeyDescSecretHash.Validators[0].(func(string) error)
+	// apikeyDescCreatedAt is the schema descriptor for created_at field.
+	apikeyDescCreatedAt := apikeyFields[10].Descriptor()
+	// apikey.DefaultCreatedAt holds the default value on creation for the created_at field.
+	apikey.DefaultCreatedAt = apikeyDescCreatedAt.Default.(func() time.Time)
+	// apikeyDescUpdatedAt is the schema descriptor for updated_at field.
+	apikeyDescUpdatedAt := apikeyFields[11].Descriptor()
+	// apikey.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	apikey.DefaultUpdatedAt = apikeyDescUpdatedAt.Default.(func() time.Time)
+	// apikey.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	apikey.UpdateDefaultUpdatedAt = apikeyDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// apikeyDescID is the schema descriptor for id field.
+	apikeyDescID := apikeyFields[0].Descriptor()
+	// apikey.DefaultID holds the default value on creation for the id field.
+	apikey.DefaultID = apikeyDescID.Default.(func() uuid.UUID)
+	auditlogFields := schema.AuditLog{}.Fields()
+	_ = auditlogFields
+	// auditlogDescAction is the schema descriptor for action field.
+	auditlogDescAction := auditlogFields[3].Descriptor()
+	// auditlog.ActionValidator is a validator for the "action" field. It is called by the builders before save.
+	auditlog.ActionValidator = auditlogDescAction.Validators[0].(func(string) error)
+	// auditlogDescTargetType is the schema descriptor for target_type field.
+	auditlogDescTargetType := auditlogFields[4].Descriptor()
+	// auditlog.TargetTypeValidator is a validator for the "target_type" field. It is called by the builders before save.
+	auditlog.TargetTypeValidator = auditlogDescTargetType.Validators[0].(func(string) error)
+	// auditlogDescCreatedAt is the schema descriptor for created_at field.
+	auditlogDescCreatedAt := auditlogFields[11].Descriptor()
+	// auditlog.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditlog.DefaultCreatedAt = auditlogDescCreatedAt.Default.(func() time.Time)
+	// auditlogDescID is the schema descriptor for id field.
+	auditlogDescID := auditlogFields[0].Descriptor()
+	// auditlog.DefaultID holds the default value on creation for the id field.
+	auditlog.DefaultID = auditlogDescID.Default.(func() uuid.UUID)
+	cancellationrefundFields := schema.CancellationRefund{}.Fields()
+	_ = cancellationrefundFields
+	// cancellationrefundDescAttempts is the schema descriptor for attempts field.
+	cancellationrefundDescAttempts := cancellationrefundFields[5].Descriptor()
+	// cancellationrefund.DefaultAttempts holds the default value on creation for the attempts field.
+	cancellationrefund.DefaultAttempts = cancellationrefundDescAttempts.Default.(int)
+	// cancellationrefund.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	cancellationrefund.AttemptsValidator = cancellationrefundDescAttempts.Validators[0].(func(int) error)
+	// cancellationrefundDescLastError is the schema descriptor for last_error field.
+	cancellationrefundDescLastError := cancellationrefundFields[6].Descriptor()
+	// cancellationrefund.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	cancellationrefund.LastErrorValidator = cancellationrefundDescLastError.Validators[0].(func(string) error)
+	// cancellationrefundDescCreatedAt is the schema descriptor for created_at field.
+	cancellationrefundDescCreatedAt := cancellationrefundFields[9].Descriptor()
+	// cancellationrefund.DefaultCreatedAt holds the default value on creation for the created_at field.
+	cancellationrefund.DefaultCreatedAt = cancellationrefundDescCreatedAt.Default.(func() time.Time)
+	// cancellationrefundDescUpdatedAt is the schema descriptor for updated_at field.
+	cancellationrefundDescUpdatedAt := cancellationrefundFields[10].Descriptor()
+	// cancellationrefund.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	cancellationrefund.DefaultUpdatedAt = cancellationrefundDescUpdatedAt.Default.(func() time.Time)
+	// cancellationrefund.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	cancellationrefund.UpdateDefaultUpdatedAt = cancellationrefundDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// cancellationrefundDescID is the schema descriptor for id field.
+	cancellationrefundDescID := cancellationrefundFields[0].Descriptor()
+	// cancellationrefund.DefaultID holds the default value on creation for the id field.
+	cancellationrefund.DefaultID = cancellationrefundDescID.Default.(func() uuid.UUID)
 	eventFields := schema.Event{}.Fields()
 	_ = eventFields
 	// eventDescTitle is the schema descriptor for title field.
-	eventDescTitle := eventFields[2].Descriptor()
+	eventDescTitle := eventFields[3].Descriptor()
 	// event.TitleValidator is a validator for the "title" field. It is called by the builders before save.
 	event.TitleValidator = eventDescTitle.Validators[0].(func(string) error)
+	// eventDescSeatingLayout is the schema descriptor for seating_layout field.
+	eventDescSeatingLayout := eventFields[8].Descriptor()
+	// event.DefaultSeatingLayout holds the default value on creation for the seating_layout field.
+	event.DefaultSeatingLayout = eventDescSeatingLayout.Default.(string)
+	// eventDescAddressStreet is the schema descriptor for address_street field.
+	eventDescAddressStreet := eventFields[9].Descriptor()
+	// event.DefaultAddressStreet holds the default value on creation for the address_street field.
+	event.DefaultAddressStreet = eventDescAddressStreet.Default.(string)
+	// eventDescAddressCity is the schema descriptor for address_city field.
+	eventDescAddressCity := eventFields[10].Descriptor()
+	// event.DefaultAddressCity holds the default value on creation for the address_city field.
+	event.DefaultAddressCity = eventDescAddressCity.Default.(string)
+	// event.AddressCityValidator is a validator for the "address_city" field. It is called by the builders before save.
+	event.AddressCityValidator = eventDescAddressCity.Validators[0].(func(string) error)
+	// eventDescAddressRegion is the schema descriptor for address_region field.
+	eventDescAddressRegion := eventFields[11].Descriptor()
+	// event.DefaultAddressRegion holds the default value on creation for the address_region field.
+	event.DefaultAddressRegion = eventDescAddressRegion.Default.(string)
+	// event.AddressRegionValidator is a validator for the "address_region" field. It is called by the builders before save.
+	event.AddressRegionValidator = eventDescAddressRegion.Validators[0].(func(string) error)
+	// eventDescAddressPostalCode is the schema descriptor for address_postal_code field.
+	eventDescAddressPostalCode := eventFields[12].Descriptor()
+	// event.DefaultAddressPostalCode holds the default value on creation for the address_postal_code field.
+	event.DefaultAddressPostalCode = eventDescAddressPostalCode.Default.(string)
+	// event.AddressPostalCodeValidator is a validator for the "address_postal_code" field. It is called by the builders before save.
+	event.AddressPostalCodeValidator = eventDescAddressPostalCode.Validators[0].(func(string) error)
+	// eventDescAddressCountry is the schema descriptor for address_country field.
+	eventDescAddressCountry := eventFields[13].Descriptor()
+	// event.DefaultAddressCountry holds the default value on creation for the address_country field.
+	event.DefaultAddressCountry = eventDescAddressCountry.Default.(string)
+	// event.AddressCountryValidator is a validator for the "address_country" field. It is called by the builders before save.
+	event.AddressCountryValidator = eventDescAddressCountry.Validators[0].(func(string) error)
+	// eventDescGeohash is the schema descriptor for geohash field.
+	eventDescGeohash := eventFields[16].Descriptor()
+	// event.DefaultGeohash holds the default value on creation for the geohash field.
+	event.DefaultGeohash = eventDescGeohash.Default.(string)
+	// event.GeohashValidator is a validator for the "geohash" field. It is called by the builders before save.
+	event.GeohashValidator = eventDescGeohash.Validators[0].(func(string) error)
+	// eventDescCategory is the schema descriptor for category field.
+	eventDescCategory := eventFields[17].Descriptor()
+	// event.DefaultCategory holds the default value on creation for the category field.
+	event.DefaultCategory = eventDescCategory.Default.(string)
+	// event.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	event.CategoryValidator = eventDescCategory.Validators[0].(func(string) error)
+	// eventDescTitleInitials is the schema descriptor for title_initials field.
+	eventDescTitleInitials := eventFields[18].Descriptor()
+	// event.DefaultTitleInitials holds the default value on creation for the title_initials field.
+	event.DefaultTitleInitials = eventDescTitleInitials.Default.(string)
+	// eventDescVenueInitials is the schema descriptor for venue_initials field.
+	eventDescVenueInitials := eventFields[19].Descriptor()
+	// event.DefaultVenueInitials holds the default value on creation for the venue_initials field.
+	event.DefaultVenueInitials = eventDescVenueInitials.Default.(string)
 	// eventDescTotalTickets is the schema descriptor for total_tickets field.
-	eventDescTotalTickets := eventFields[8].Descriptor()
+	eventDescTotalTickets := eventFields[22].Descriptor()
 	// event.DefaultTotalTickets holds the default value on creation for the total_tickets field.
 	event.DefaultTotalTickets = eventDescTotalTickets.Default.(int)
 	// event.TotalTicketsValidator is a validator for the "total_tickets" field. It is called by the builders before save.
 	event.TotalTicketsValidator = eventDescTotalTickets.Validators[0].(func(int) error)
 	// eventDescAvailableTickets is the schema descriptor for available_tickets field.
-	eventDescAvailableTickets := eventFields[9].Descriptor()
+	eventDescAvailableTickets := eventFields[23].Descriptor()
 	// event.DefaultAvailableTickets holds the default value on creation for the available_tickets field.
 	event.DefaultAvailableTickets = eventDescAvailableTickets.Default.(int)
 	// event.AvailableTicketsValidator is a validator for the "available_tickets" field. It is called by the builders before save.
 	event.AvailableTicketsValidator = eventDescAvailableTickets.Validators[0].(func(int) error)
 	// eventDescParticipantCount is the schema descriptor for participant_count field.
-	eventDescParticipantCount := eventFields[10].Descriptor()
+	eventDescParticipantCount := eventFields[24].Descriptor()
 	// event.DefaultParticipantCount holds the default value on creation for the participant_count field.
 	event.DefaultParticipantCount = eventDescParticipantCount.Default.(int)
 	// event.ParticipantCountValidator is a validator for the "participant_count" field. It is called by the builders before save.
 	event.ParticipantCountValidator = eventDescParticipantCount.Validators[0].(func(int) error)
 	// eventDescTicketPrice is the schema descriptor for ticket_price field.
-	eventDescTicketPrice := eventFields[11].Descriptor()
+	eventDescTicketPrice := eventFields[25].Descriptor()
 	// event.DefaultTicketPrice holds the default value on creation for the ticket_price field.
 	event.DefaultTicketPrice = eventDescTicketPrice.Default.(float64)
 	// event.TicketPriceValidator is a validator for the "ticket_price" field. It is called by the builders before save.
 	event.TicketPriceValidator = eventDescTicketPrice.Validators[0].(func(float64) error)
 	// eventDescCurrency is the schema descriptor for currency field.
-	eventDescCurrency := eventFields[12].Descriptor()
+	eventDescCurrency := eventFields[26].Descriptor()
 	// event.DefaultCurrency holds the default value on creation for the currency field.
 	event.DefaultCurrency = eventDescCurrency.Default.(string)
 	// eventDescIsPublic is the schema descriptor for is_public field.
-	eventDescIsPublic := eventFields[15].Descriptor()
+	eventDescIsPublic := eventFields[37].Descriptor()
 	// event.DefaultIsPublic holds the default value on creation for the is_public field.
 	event.DefaultIsPublic = eventDescIsPublic.Default.(bool)
+	// eventDescRequiresApproval is the schema descriptor for requires_approval field.
+	eventDescRequiresApproval := eventFields[38].Descriptor()
+	// event.DefaultRequiresApproval holds the default value on creation for the requires_approval field.
+	event.DefaultRequiresApproval = eventDescRequiresApproval.Default.(bool)
 	// eventDescCreatedAt is the schema descriptor for created_at field.
-	eventDescCreatedAt := eventFields[17].Descriptor()
+	eventDescCreatedAt := eventFields[40].Descriptor()
 	// event.DefaultCreatedAt holds the default value on creation for the created_at field.
 	event.DefaultCreatedAt = eventDescCreatedAt.Default.(func() time.Time)
 	// eventDescUpdatedAt is the schema descriptor for updated_at field.
-	eventDescUpdatedAt := eventFields[18].Descriptor()
+	eventDescUpdatedAt := eventFields[41].Descriptor()
 	// event.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	event.DefaultUpdatedAt = eventDescUpdatedAt.Default.(func() time.Time)
 	// event.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -70,22 +213,150 @@ func init() {
 	eventDescID := eventFields[0].Descriptor()
 	// event.DefaultID holds the default value on creation for the id field.
 	event.DefaultID = eventDescID.Default.(func() uuid.UUID)
+	eventcancellationFields := schema.EventCancellation{}.Fields()
+	_ = eventcancellationFields
+	// eventcancellationDescCreatedAt is the schema descriptor for created_at field.
+	eventcancellationDescCreatedAt := eventcancellationFields[7].Descriptor()
+	// eventcancellation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventcancellation.DefaultCreatedAt = eventcancellationDescCreatedAt.Default.(func() time.Time)
+	// eventcancellationDescUpdatedAt is the schema descriptor for updated_at field.
+	eventcancellationDescUpdatedAt := eventcancellationFields[8].Descriptor()
+	// eventcancellation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventcancellation.DefaultUpdatedAt = eventcancellationDescUpdatedAt.Default.(func() time.Time)
+	// eventcancellation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventcancellation.UpdateDefaultUpdatedAt = eventcancellationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventcancellationDescID is the schema descriptor for id field.
+	eventcancellationDescID := eventcancellationFields[0].Descriptor()
+	// eventcancellation.DefaultID holds the default value on creation for the id field.
+	eventcancellation.DefaultID = eventcancellationDescID.Default.(func() uuid.UUID)
+	eventchangeFields := schema.EventChange{}.Fields()
+	_ = eventchangeFields
+	// eventchangeDescNotifiedCount is the schema descriptor for notified_count field.
+	eventchangeDescNotifiedCount := eventchangeFields[5].Descriptor()
+	// eventchange.DefaultNotifiedCount holds the default value on creation for the notified_count field.
+	eventchange.DefaultNotifiedCount = eventchangeDescNotifiedCount.Default.(int)
+	// eventchange.NotifiedCountValidator is a validator for the "notified_count" field. It is called by the builders before save.
+	eventchange.NotifiedCountValidator = eventchangeDescNotifiedCount.Validators[0].(func(int) error)
+	// eventchangeDescCreatedAt is the schema descriptor for created_at field.
+	eventchangeDescCreatedAt := eventchangeFields[7].Descriptor()
+	// eventchange.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventchange.DefaultCreatedAt = eventchangeDescCreatedAt.Default.(func() time.Time)
+	// eventchangeDescID is the schema descriptor for id field.
+	eventchangeDescID := eventchangeFields[0].Descriptor()
+	// eventchange.DefaultID holds the default value on creation for the id field.
+	eventchange.DefaultID = eventchangeDescID.Default.(func() uuid.UUID)
+	eventfavoriteFields := schema.EventFavorite{}.Fields()
+	_ = eventfavoriteFields
+	// eventfavoriteDescCreatedAt is the schema descriptor for created_at field.
+	eventfavoriteDescCreatedAt := eventfavoriteFields[3].Descriptor()
+	// eventfavorite.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventfavorite.DefaultCreatedAt = eventfavoriteDescCreatedAt.Default.(func() time.Time)
+	// eventfavoriteDescID is the schema descriptor for id field.
+	eventfavoriteDescID := eventfavoriteFields[0].Descriptor()
+	// eventfavorite.DefaultID holds the default value on creation for the id field.
+	eventfavorite.DefaultID = eventfavoriteDescID.Default.(func() uuid.UUID)
+	eventseriesFields := schema.EventSeries{}.Fields()
+	_ = eventseriesFields
+	// eventseriesDescTitle is the schema descriptor for title field.
+	eventseriesDescTitle := eventseriesFields[2].Descriptor()
+	// eventseries.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	eventseries.TitleValidator = eventseriesDescTitle.Validators[0].(func(string) error)
+	// eventseriesDescSeatingLayout is the schema descriptor for seating_layout field.
+	eventseriesDescSeatingLayout := eventseriesFields[7].Descriptor()
+	// eventseries.DefaultSeatingLayout holds the default value on creation for the seating_layout field.
+	eventseries.DefaultSeatingLayout = eventseriesDescSeatingLayout.Default.(string)
+	// eventseriesDescAddressStreet is the schema descriptor for address_street field.
+	eventseriesDescAddressStreet := eventseriesFields[8].Descriptor()
+	// eventseries.DefaultAddressStreet holds the default value on creation for the address_street field.
+	eventseries.DefaultAddressStreet = eventseriesDescAddressStreet.Default.(string)
+	// eventseriesDescAddressCity is the schema descriptor for address_city field.
+	eventseriesDescAddressCity := eventseriesFields[9].Descriptor()
+	// eventseries.DefaultAddressCity holds the default value on creation for the address_city field.
+	eventseries.DefaultAddressCity = eventseriesDescAddressCity.Default.(string)
+	// eventseries.AddressCityValidator is a validator for the "address_city" field. It is called by the builders before save.
+	eventseries.AddressCityValidator = eventseriesDescAddressCity.Validators[0].(func(string) error)
+	// eventseriesDescAddressRegion is the schema descriptor for address_region field.
+	eventseriesDescAddressRegion := eventseriesFields[10].Descriptor()
+	// eventseries.DefaultAddressRegion holds the default value on creation for the address_region field.
+	eventseries.DefaultAddressRegion = eventseriesDescAddressRegion.Default.(string)
+	// eventseries.AddressRegionValidator is a validator for the "address_region" field. It is called by the builders before save.
+	eventseries.AddressRegionValidator = eventseriesDescAddressRegion.Validators[0].(func(string) error)
+	// eventseriesDescAddressPostalCode is the schema descriptor for address_postal_code field.
+	eventseriesDescAddressPostalCode := eventseriesFields[11].Descriptor()
+	// eventseries.DefaultAddressPostalCode holds the default value on creation for the address_postal_code field.
+	eventseries.DefaultAddressPostalCode = eventseriesDescAddressPostalCode.Default.(string)
+	// eventseries.AddressPostalCodeValidator is a validator for the "address_postal_code" field. It is called by the builders before save.
+	eventseries.AddressPostalCodeValidator = eventseriesDescAddressPostalCode.Validators[0].(func(string) error)
+	// eventseriesDescAddressCountry is the schema descriptor for address_country field.
+	eventseriesDescAddressCountry := eventseriesFields[12].Descriptor()
+	// eventseries.DefaultAddressCountry holds the default value on creation for the address_country field.
+	eventseries.DefaultAddressCountry = eventseriesDescAddressCountry.Default.(string)
+	// eventseries.AddressCountryValidator is a validator for the "address_country" field. It is called by the builders before save.
+	eventseries.AddressCountryValidator = eventseriesDescAddressCountry.Validators[0].(func(string) error)
+	// eventseriesDescCategory is the schema descriptor for category field.
+	eventseriesDescCategory := eventseriesFields[15].Descriptor()
+	// eventseries.DefaultCategory holds the default value on creation for the category field.
+	eventseries.DefaultCategory = eventseriesDescCategory.Default.(string)
+	// eventseries.CategoryValidator is a validator for the "category" field. It is called by the builders before save.
+	eventseries.CategoryValidator = eventseriesDescCategory.Validators[0].(func(string) error)
+	// eventseriesDescTotalTickets is the schema descriptor for total_tickets field.
+	eventseriesDescTotalTickets := eventseriesFields[19].Descriptor()
+	// eventseries.DefaultTotalTickets holds the default value on creation for the total_tickets field.
+	eventseries.DefaultTotalTickets = eventseriesDescTotalTickets.Default.(int)
+	// eventseries.TotalTicketsValidator is a validator for the "total_tickets" field. It is called by the builders before save.
+	eventseries.TotalTicketsValidator = eventseriesDescTotalTickets.Validators[0].(func(int) error)
+	// eventseriesDescTicketPrice is the schema descriptor for ticket_price field.
+	eventseriesDescTicketPrice := eventseriesFields[20].Descriptor()
+	// eventseries.DefaultTicketPrice holds the default value on creation for the ticket_price field.
+	eventseries.DefaultTicketPrice = eventseriesDescTicketPrice.Default.(float64)
+	// eventseries.TicketPriceValidator is a validator for the "ticket_price" field. It is called by the builders before save.
+	eventseries.TicketPriceValidator = eventseriesDescTicketPrice.Validators[0].(func(float64) error)
+	// eventseriesDescCurrency is the schema descriptor for currency field.
+	eventseriesDescCurrency := eventseriesFields[21].Descriptor()
+	// eventseries.DefaultCurrency holds the default value on creation for the currency field.
+	eventseries.DefaultCurrency = eventseriesDescCurrency.Default.(string)
+	// eventseriesDescIsPublic is the schema descriptor for is_public field.
+	eventseriesDescIsPublic := eventseriesFields[23].Descriptor()
+	// eventseries.DefaultIsPublic holds the default value on creation for the is_public field.
+	eventseries.DefaultIsPublic = eventseriesDescIsPublic.Default.(bool)
+	// eventseriesDescRequiresApproval is the schema descriptor for requires_approval field.
+	eventseriesDescRequiresApproval := eventseriesFields[24].Descriptor()
+	// eventseries.DefaultRequiresApproval holds the default value on creation for the requires_approval field.
+	eventseries.DefaultRequiresApproval = eventseriesDescRequiresApproval.Default.(bool)
+	// eventseriesDescCreatedAt is the schema descriptor for created_at field.
+	eventseriesDescCreatedAt := eventseriesFields[26].Descriptor()
+	// eventseries.DefaultCreatedAt holds the default value on creation for the created_at field.
+	eventseries.DefaultCreatedAt = eventseriesDescCreatedAt.Default.(func() time.Time)
+	// eventseriesDescUpdatedAt is the schema descriptor for updated_at field.
+	eventseriesDescUpdatedAt := eventseriesFields[27].Descriptor()
+	// eventseries.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	eventseries.DefaultUpdatedAt = eventseriesDescUpdatedAt.Default.(func() time.Time)
+	// eventseries.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	eventseries.UpdateDefaultUpdatedAt = eventseriesDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// eventseriesDescID is the schema descriptor for id field.
+	eventseriesDescID := eventseriesFields[0].Descriptor()
+	// eventseries.DefaultID holds the default value on creation for the id field.
+	eventseries.DefaultID = eventseriesDescID.Default.(func() uuid.UUID)
 	organizationFields := schema.Organization{}.Fields()
 	_ = organizationFields
 	// organizationDescName is the schema descriptor for name field.
 	organizationDescName := organizationFields[1].Descriptor()
 	// organization.NameValidator is a validator for the "name" field. It is called by the builders before save.
 	organization.NameValidator = organizationDescName.Validators[0].(func(string) error)
+	// organizationDescNameInitials is the schema descriptor for name_initials field.
+	organizationDescNameInitials := organizationFields[2].Descriptor()
+	// organization.DefaultNameInitials holds the default value on creation for the name_initials field.
+	organization.DefaultNameInitials = organizationDescNameInitials.Default.(string)
 	// organizationDescIsActive is the schema descriptor for is_active field.
-	organizationDescIsActive := organizationFields[6].Descriptor()
+	organizationDescIsActive := organizationFields[9].Descriptor()
 	// organization.DefaultIsActive holds the default value on creation for the is_active field.
 	organization.DefaultIsActive = organizationDescIsActive.Default.(bool)
 	// organizationDescCreatedAt is the schema descriptor for created_at field.
-	organizationDescCreatedAt := organizationFields[7].Descriptor()
+	organizationDescCreatedAt := organizationFields[11].Descriptor()
 	// organization.DefaultCreatedAt holds the default value on creation for the created_at field.
 	organization.DefaultCreatedAt = organizationDescCreatedAt.Default.(func() time.Time)
 	// organizationDescUpdatedAt is the schema descriptor for updated_at field.
-	organizationDescUpdatedAt := organizationFields[8].Descriptor()
+	organizationDescUpdatedAt := organizationFields[12].Descriptor()
 	// organization.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	organization.DefaultUpdatedAt = organizationDescUpdatedAt.Default.(func() time.Time)
 	// organization.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -94,8 +365,38 @@ func init() {
 	organizationDescID := organizationFields[0].Descriptor()
 	// organization.DefaultID holds the default value on creation for the id field.
 	organization.DefaultID = organizationDescID.Default.(func() uuid.UUID)
+	organizationinvitationFields := schema.OrganizationInvitation{}.Fields()
+	_ = organizationinvitationFields
+	// organizationinvitationDescEmail is the schema descriptor for email field.
+	organizationinvitationDescEmail := organizationinvitationFields[2].Descriptor()
+	// organizationinvitation.EmailValidator is a validator for the "email" field. It is called by the builders before save.
+	organizationinvitation.EmailValidator = organizationinvitationDescEmail.Validators[0].(func(string) error)
+	// organizationinvitationDescRole is the schema descriptor for role field.
+	organizationinvitationDescRole := organizationinvitationFields[3].Descriptor()
+	// organizationinvitation.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	organizationinvitation.RoleValidator = organizationinvitationDescRole.Validators[0].(func(string) error)
+	// organizationinvitationDescCreatedAt is the schema descriptor for created_at field.
+	organizationinvitationDescCreatedAt := organizationinvitationFields[9].Descriptor()
+	// organizationinvitation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationinvitation.DefaultCreatedAt = organizationinvitationDescCreatedAt.Default.(func() time.Time)
+	// organizationinvitationDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationinvitationDescUpdatedAt := organizationinvitationFields[10].Descriptor()
+	// organizationinvitation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organizationinvitation.DefaultUpdatedAt = organizationinvitationDescUpdatedAt.Default.(func() time.Time)
+	// organizationinvitation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organizationinvitation.UpdateDefaultUpdatedAt = organizationinvitationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationinvitationDescID is the schema descriptor for id field.
+	organizationinvitationDescID := organizationinvitationFields[0].Descriptor()
+	// organizationinvitation.DefaultID holds the default value on creation for the id field.
+	organizationinvitation.DefaultID = organizationinvitationDescID.Default.(func() uuid.UUID)
 	organizationmemberFields := schema.OrganizationMember{}.Fields()
 	_ = organizationmemberFields
+	// organizationmemberDescRole is the schema descriptor for role field.
+	organizationmemberDescRole := organizationmemberFields[3].Descriptor()
+	// organizationmember.DefaultRole holds the default value on creation for the role field.
+	organizationmember.DefaultRole = organizationmemberDescRole.Default.(string)
+	// organizationmember.RoleValidator is a validator for the "role" field. It is called by the builders before save.
+	organizationmember.RoleValidator = organizationmemberDescRole.Validators[0].(func(string) error)
 	// organizationmemberDescJoinedAt is the schema descriptor for joined_at field.
 	organizationmemberDescJoinedAt := organizationmemberFields[4].Descriptor()
 	// organizationmember.DefaultJoinedAt holds the default value on creation for the joined_at field.
@@ -114,6 +415,42 @@ func init() {
 	organizationmemberDescID := organizationmemberFields[0].Descriptor()
 	// organizationmember.DefaultID holds the default value on creation for the id field.
 	organizationmember.DefaultID = organizationmemberDescID.Default.(func() uuid.UUID)
+	organizationroleFields := schema.OrganizationRole{}.Fields()
+	_ = organizationroleFields
+	// organizationroleDescName is the schema descriptor for name field.
+	organizationroleDescName := organizationroleFields[2].Descriptor()
+	// organizationrole.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	organizationrole.NameValidator = organizationroleDescName.Validators[0].(func(string) error)
+	// organizationroleDescCreatedAt is the schema descriptor for created_at field.
+	organizationroleDescCreatedAt := organizationroleFields[5].Descriptor()
+	// organizationrole.DefaultCreatedAt holds the default value on creation for the created_at field.
+	organizationrole.DefaultCreatedAt = organizationroleDescCreatedAt.Default.(func() time.Time)
+	// organizationroleDescUpdatedAt is the schema descriptor for updated_at field.
+	organizationroleDescUpdatedAt := organizationroleFields[6].Descriptor()
+	// organizationrole.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	organizationrole.DefaultUpdatedAt = organizationroleDescUpdatedAt.Default.(func() time.Time)
+	// organizationrole.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	organizationrole.UpdateDefaultUpdatedAt = organizationroleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// organizationroleDescID is the schema descriptor for id field.
+	organizationroleDescID := organizationroleFields[0].Descriptor()
+	// organizationrole.DefaultID holds the default value on creation for the id field.
+	organizationrole.DefaultID = organizationroleDescID.Default.(func() uuid.UUID)
+	ownershiptransferFields := schema.OwnershipTransfer{}.Fields()
+	_ = ownershiptransferFields
+	// ownershiptransferDescCreatedAt is the schema descriptor for created_at field.
+	ownershiptransferDescCreatedAt := ownershiptransferFields[7].Descriptor()
+	// ownershiptransfer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	ownershiptransfer.DefaultCreatedAt = ownershiptransferDescCreatedAt.Default.(func() time.Time)
+	// ownershiptransferDescUpdatedAt is the schema descriptor for updated_at field.
+	ownershiptransferDescUpdatedAt := ownershiptransferFields[8].Descriptor()
+	// ownershiptransfer.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	ownershiptransfer.DefaultUpdatedAt = ownershiptransferDescUpdatedAt.Default.(func() time.Time)
+	// ownershiptransfer.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	ownershiptransfer.UpdateDefaultUpdatedAt = ownershiptransferDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// ownershiptransferDescID is the schema descriptor for id field.
+	ownershiptransferDescID := ownershiptransferFields[0].Descriptor()
+	// ownershiptransfer.DefaultID holds the default value on creation for the id field.
+	ownershiptransfer.DefaultID = ownershiptransferDescID.Default.(func() uuid.UUID)
 	paymentFields := schema.Payment{}.Fields()
 	_ = paymentFields
 	// paymentDescEventTitle is the schema descriptor for event_title field.
@@ -136,20 +473,12 @@ func init() {
 	paymentDescBuyerName := paymentFields[7].Descriptor()
 	// payment.BuyerNameValidator is a validator for the "buyer_name" field. It is called by the builders before save.
 	payment.BuyerNameValidator = paymentDescBuyerName.Validators[0].(func(string) error)
-	// paymentDescBuyerEmail is the schema descriptor for buyer_email field.
-	paymentDescBuyerEmail := paymentFields[8].Descriptor()
-	// payment.BuyerEmailValidator is a validator for the "buyer_email" field. It is called by the builders before save.
-	payment.BuyerEmailValidator = paymentDescBuyerEmail.Validators[0].(func(string) error)
-	// paymentDescBuyerPhone is the schema descriptor for buyer_phone field.
-	paymentDescBuyerPhone := paymentFields[9].Descriptor()
-	// payment.BuyerPhoneValidator is a validator for the "buyer_phone" field. It is called by the builders before save.
-	payment.BuyerPhoneValidator = paymentDescBuyerPhone.Validators[0].(func(string) error)
 	// paymentDescCreatedAt is the schema descriptor for created_at field.
-	paymentDescCreatedAt := paymentFields[13].Descriptor()
+	paymentDescCreatedAt := paymentFields[18].Descriptor()
 	// payment.DefaultCreatedAt holds the default value on creation for the created_at field.
 	payment.DefaultCreatedAt = paymentDescCreatedAt.Default.(func() time.Time)
 	// paymentDescUpdatedAt is the schema descriptor for updated_at field.
-	paymentDescUpdatedAt := paymentFields[14].Descriptor()
+	paymentDescUpdatedAt := paymentFields[19].Descriptor()
 	// payment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	payment.DefaultUpdatedAt = paymentDescUpdatedAt.Default.(func() time.Time)
 	// payment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -158,6 +487,48 @@ func init() {
 	paymentDescID := paymentFields[0].Descriptor()
 	// payment.DefaultID holds the default value on creation for the id field.
 	payment.DefaultID = paymentDescID.Default.(func() uuid.UUID)
+	registrationanswerFields := schema.RegistrationAnswer{}.Fields()
+	_ = registrationanswerFields
+	// registrationanswerDescTicketIndex is the schema descriptor for ticket_index field.
+	registrationanswerDescTicketIndex := registrationanswerFields[2].Descriptor()
+	// registrationanswer.TicketIndexValidator is a validator for the "ticket_index" field. It is called by the builders before save.
+	registrationanswer.TicketIndexValidator = registrationanswerDescTicketIndex.Validators[0].(func(int) error)
+	// registrationanswerDescCreatedAt is the schema descriptor for created_at field.
+	registrationanswerDescCreatedAt := registrationanswerFields[6].Descriptor()
+	// registrationanswer.DefaultCreatedAt holds the default value on creation for the created_at field.
+	registrationanswer.DefaultCreatedAt = registrationanswerDescCreatedAt.Default.(func() time.Time)
+	// registrationanswerDescID is the schema descriptor for id field.
+	registrationanswerDescID := registrationanswerFields[0].Descriptor()
+	// registrationanswer.DefaultID holds the default value on creation for the id field.
+	registrationanswer.DefaultID = registrationanswerDescID.Default.(func() uuid.UUID)
+	registrationquestionFields := schema.RegistrationQuestion{}.Fields()
+	_ = registrationquestionFields
+	// registrationquestionDescLabel is the schema descriptor for label field.
+	registrationquestionDescLabel := registrationquestionFields[2].Descriptor()
+	// registrationquestion.LabelValidator is a validator for the "label" field. It is called by the builders before save.
+	registrationquestion.LabelValidator = registrationquestionDescLabel.Validators[0].(func(string) error)
+	// registrationquestionDescRequired is the schema descriptor for required field.
+	registrationquestionDescRequired := registrationquestionFields[5].Descriptor()
+	// registrationquestion.DefaultRequired holds the default value on creation for the required field.
+	registrationquestion.DefaultRequired = registrationquestionDescRequired.Default.(bool)
+	// registrationquestionDescPosition is the schema descriptor for position field.
+	registrationquestionDescPosition := registrationquestionFields[6].Descriptor()
+	// registrationquestion.DefaultPosition holds the default value on creation for the position field.
+	registrationquestion.DefaultPosition = registrationquestionDescPosition.Default.(int)
+	// registrationquestionDescCreatedAt is the schema descriptor for created_at field.
+	registrationquestionDescCreatedAt := registrationquestionFields[7].Descriptor()
+	// registrationquestion.DefaultCreatedAt holds the default value on creation for the created_at field.
+	registrationquestion.DefaultCreatedAt = registrationquestionDescCreatedAt.Default.(func() time.Time)
+	// registrationquestionDescUpdatedAt is the schema descriptor for updated_at field.
+	registrationquestionDescUpdatedAt := registrationquestionFields[8].Descriptor()
+	// registrationquestion.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	registrationquestion.DefaultUpdatedAt = registrationquestionDescUpdatedAt.Default.(func() time.Time)
+	// registrationquestion.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	registrationquestion.UpdateDefaultUpdatedAt = registrationquestionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// registrationquestionDescID is the schema descriptor for id field.
+	registrationquestionDescID := registrationquestionFields[0].Descriptor()
+	// registrationquestion.DefaultID holds the default value on creation for the id field.
+	registrationquestion.DefaultID = registrationquestionDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescIsValid is the schema descriptor for is_valid field.
@@ -176,4 +547,128 @@ func init() {
 	userDescID := userFields[0].Descriptor()
 	// user.DefaultID holds the default value on creation for the id field.
 	user.DefaultID = userDescID.Default.(func() uuid.UUID)
+	venueFields := schema.Venue{}.Fields()
+	_ = venueFields
+	// venueDescName is the schema descriptor for name field.
+	venueDescName := venueFields[2].Descriptor()
+	// venue.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	venue.NameValidator = venueDescName.Validators[0].(func(string) error)
+	// venueDescAddressStreet is the schema descriptor for address_street field.
+	venueDescAddressStreet := venueFields[3].Descriptor()
+	// venue.DefaultAddressStreet holds the default value on creation for the address_street field.
+	venue.DefaultAddressStreet = venueDescAddressStreet.Default.(string)
+	// venueDescAddressCity is the schema descriptor for address_city field.
+	venueDescAddressCity := venueFields[4].Descriptor()
+	// venue.DefaultAddressCity holds the default value on creation for the address_city field.
+	venue.DefaultAddressCity = venueDescAddressCity.Default.(string)
+	// venue.AddressCityValidator is a validator for the "address_city" field. It is called by the builders before save.
+	venue.AddressCityValidator = venueDescAddressCity.Validators[0].(func(string) error)
+	// venueDescAddressRegion is the schema descriptor for address_region field.
+	venueDescAddressRegion := venueFields[5].Descriptor()
+	// venue.DefaultAddressRegion holds the default value on creation for the address_region field.
+	venue.DefaultAddressRegion = venueDescAddressRegion.Default.(string)
+	// venue.AddressRegionValidator is a validator for the "address_region" field. It is called by the builders before save.
+	venue.AddressRegionValidator = venueDescAddressRegion.Validators[0].(func(string) error)
+	// venueDescAddressPostalCode is the schema descriptor for address_postal_code field.
+	venueDescAddressPostalCode := venueFields[6].Descriptor()
+	// venue.DefaultAddressPostalCode holds the default value on creation for the address_postal_code field.
+	venue.DefaultAddressPostalCode = venueDescAddressPostalCode.Default.(string)
+	// venue.AddressPostalCodeValidator is a validator for the "address_postal_code" field. It is called by the builders before save.
+	venue.AddressPostalCodeValidator = venueDescAddressPostalCode.Validators[0].(func(string) error)
+	// venueDescAddressCountry is the schema descriptor for address_country field.
+	venueDescAddressCountry := venueFields[7].Descriptor()
+	// venue.DefaultAddressCountry holds the default value on creation for the address_country field.
+	venue.DefaultAddressCountry = venueDescAddressCountry.Default.(string)
+	// venue.AddressCountryValidator is a validator for the "address_country" field. It is called by the builders before save.
+	venue.AddressCountryValidator = venueDescAddressCountry.Validators[0].(func(string) error)
+	// venueDescCapacity is the schema descriptor for capacity field.
+	venueDescCapacity := venueFields[10].Descriptor()
+	// venue.CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	venue.CapacityValidator = venueDescCapacity.Validators[0].(func(int) error)
+	// venueDescCreatedAt is the schema descriptor for created_at field.
+	venueDescCreatedAt := venueFields[16].Descriptor()
+	// venue.DefaultCreatedAt holds the default value on creation for the created_at field.
+	venue.DefaultCreatedAt = venueDescCreatedAt.Default.(func() time.Time)
+	// venueDescUpdatedAt is the schema descriptor for updated_at field.
+	venueDescUpdatedAt := venueFields[17].Descriptor()
+	// venue.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	venue.DefaultUpdatedAt = venueDescUpdatedAt.Default.(func() time.Time)
+	// venue.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	venue.UpdateDefaultUpdatedAt = venueDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// venueDescID is the schema descriptor for id field.
+	venueDescID := venueFields[0].Descriptor()
+	// venue.DefaultID holds the default value on creation for the id field.
+	venue.DefaultID = venueDescID.Default.(func() uuid.UUID)
+	webhookdeliveryFields := schema.WebhookDelivery{}.Fields()
+	_ = webhookdeliveryFields
+	// webhookdeliveryDescEventType is the schema descriptor for event_type field.
+	webhookdeliveryDescEventType := webhookdeliveryFields[4].Descriptor()
+	// webhookdelivery.EventTypeValidator is a validator for the "event_type" field. It is called by the builders before save.
+	webhookdelivery.EventTypeValidator = webhookdeliveryDescEventType.Validators[0].(func(string) error)
+	// webhookdeliveryDescAttempts is the schema descriptor for attempts field.
+	webhookdeliveryDescAttempts := webhookdeliveryFields[7].Descriptor()
+	// webhookdelivery.DefaultAttempts holds the default value on creation for the attempts field.
+	webhookdelivery.DefaultAttempts = webhookdeliveryDescAttempts.Default.(int)
+	// webhookdelivery.AttemptsValidator is a validator for the "attempts" field. It is called by the builders before save.
+	webhookdelivery.AttemptsValidator = webhookdeliveryDescAttempts.Validators[0].(func(int) error)
+	// webhookdeliveryDescLastError is the schema descriptor for last_error field.
+	webhookdeliveryDescLastError := webhookdeliveryFields[9].Descriptor()
+	// webhookdelivery.LastErrorValidator is a validator for the "last_error" field. It is called by the builders before save.
+	webhookdelivery.LastErrorValidator = webhookdeliveryDescLastError.Validators[0].(func(string) error)
+	// webhookdeliveryDescCreatedAt is the schema descriptor for created_at field.
+	webhookdeliveryDescCreatedAt := webhookdeliveryFields[13].Descriptor()
+	// webhookdelivery.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookdelivery.DefaultCreatedAt = webhookdeliveryDescCreatedAt.Default.(func() time.Time)
+	// webhookdeliveryDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookdeliveryDescUpdatedAt := webhookdeliveryFields[14].Descriptor()
+	// webhookdelivery.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookdelivery.DefaultUpdatedAt = webhookdeliveryDescUpdatedAt.Default.(func() time.Time)
+	// webhookdelivery.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookdelivery.UpdateDefaultUpdatedAt = webhookdeliveryDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookdeliveryDescID is the schema descriptor for id field.
+	webhookdeliveryDescID := webhookdeliveryFields[0].Descriptor()
+	// webhookdelivery.DefaultID holds the default value on creation for the id field.
+	webhookdelivery.DefaultID = webhookdeliveryDescID.Default.(func() uuid.UUID)
+	webhookendpointFields := schema.WebhookEndpoint{}.Fields()
+	_ = webhookendpointFields
+	// webhookendpointDescURL is the schema descriptor for url field.
+	webhookendpointDescURL := webhookendpointFields[2].Descriptor()
+	// webhookendpoint.URLValidator is a validator for the "url" field. It is called by the builders before save.
+	webhookendpoint.URLValidator = func() func(string) error {
+		validators := webhookendpointDescURL.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(url string) error {
+			for _, fn := range fns {
+				if err := fn(url); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// webhookendpointDescSecret is the schema descriptor for secret field.
+	webhookendpointDescSecret := webhookendpointFields[3].Descriptor()
+	// webhookendpoint.SecretValidator is a validator for the "secret" field. It is called by the builders before save.
+	webhookendpoint.SecretValidator = webhookendpointDescSecret.Validators[0].(func(string) error)
+	// webhookendpointDescIsActive is the schema descriptor for is_active field.
+	webhookendpointDescIsActive := webhookendpointFields[5].Descriptor()
+	// webhookendpoint.DefaultIsActive holds the default value on creation for the is_active field.
+	webhookendpoint.DefaultIsActive = webhookendpointDescIsActive.Default.(bool)
+	// webhookendpointDescCreatedAt is the schema descriptor for created_at field.
+	webhookendpointDescCreatedAt := webhookendpointFields[7].Descriptor()
+	// webhookendpoint.DefaultCreatedAt holds the default value on creation for the created_at field.
+	webhookendpoint.DefaultCreatedAt = webhookendpointDescCreatedAt.Default.(func() time.Time)
+	// webhookendpointDescUpdatedAt is the schema descriptor for updated_at field.
+	webhookendpointDescUpdatedAt := webhookendpointFields[8].Descriptor()
+	// webhookendpoint.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	webhookendpoint.DefaultUpdatedAt = webhookendpointDescUpdatedAt.Default.(func() time.Time)
+	// webhookendpoint.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	webhookendpoint.UpdateDefaultUpdatedAt = webhookendpointDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// webhookendpointDescID is the schema descriptor for id field.
+	webhookendpointDescID := webhookendpointFields[0].Descriptor()
+	// webhookendpoint.DefaultID holds the default value on creation for the id field.
+	webhookendpoint.DefaultID = webhookendpointDescID.Default.(func() uuid.UUID)
 }
@@ -0,0 +1,84 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// APIKey holds the schema definition for the APIKey entity.
+type APIKey struct {
+	ent.Schema
+}
+
+// Fields of the APIKey.
+func (APIKey) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable().
+			Comment("Organization the key acts for"),
+		field.String("name").
+			NotEmpty().
+			Comment("Label shown to organization admins"),
+		field.String("prefix").
+			NotEmpty().
+			Unique().
+			Immutable().
+			Comment("Public part of the key used to look it up"),
+		field.String("secret_hash").
+			NotEmpty().
+			Sensitive().
+			Immutable().
+			Comment("SHA-256 of the secret part of the key"),
+		field.JSON("scopes", []string{}).
+			Comment("Scopes granted to the key"),
+		field.UUID("created_by", uuid.UUID{}).
+			Immutable().
+			Comment("Member who created the key"),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Comment("When the key stops being valid; nil for keys that do not expire"),
+		field.Time("last_used_at").
+			Optional().
+			Nillable().
+			Comment("When the key last authenticated a request"),
+		field.Time("revoked_at").
+			Optional().
+			Nillable().
+			Comment("When the key was revoked"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the APIKey.
+func (APIKey) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("api_keys").
+			Field("organization_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the APIKey.
+func (APIKey) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id"),
+	}
+}
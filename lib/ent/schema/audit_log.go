@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// AuditLog holds the schema definition for the AuditLog entity.
+// Entries have no edges so they outlive the records they describe.
+type AuditLog struct {
+	ent.Schema
+}
+
+// Fields of the AuditLog.
+func (AuditLog) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Organization the action belongs to"),
+		field.UUID("actor_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("User who performed the action, empty for system actions"),
+		field.String("action").
+			NotEmpty().
+			Immutable().
+			Comment("Action name such as organization.ownership_transferred"),
+		field.String("target_type").
+			NotEmpty().
+			Immutable().
+			Comment("Type of the affected record"),
+		field.String("target_id").
+			Optional().
+			Immutable().
+			Comment("ID of the affected record"),
+		field.JSON("before", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Changed fields before the action"),
+		field.JSON("after", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Changed fields after the action"),
+		field.JSON("metadata", map[string]interface{}{}).
+			Optional().
+			Immutable().
+			Comment("Action details"),
+		field.String("ip").
+			Optional().
+			Immutable().
+			Comment("IP address of the request"),
+		field.String("user_agent").
+			Optional().
+			Immutable().
+			Comment("User agent of the request"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the AuditLog.
+func (AuditLog) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "created_at"),
+		index.Fields("target_type", "target_id"),
+	}
+}
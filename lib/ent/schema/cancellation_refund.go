@@ -0,0 +1,80 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// CancellationRefund holds the schema definition for the CancellationRefund entity.
+type CancellationRefund struct {
+	ent.Schema
+}
+
+// Fields of the CancellationRefund.
+func (CancellationRefund) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("cancellation_id", uuid.UUID{}).
+			Immutable().
+			Comment("Event cancellation the refund belongs to"),
+		field.UUID("payment_id", uuid.UUID{}).
+			Unique().
+			Immutable().
+			Comment("Payment that is refunded or cancelled"),
+		field.Enum("method").
+			Values("gateway", "manual", "none").
+			Comment("gateway for online payments, manual for box-office sales, none when nothing was charged; decided again at each attempt"),
+		field.Enum("status").
+			Values("pending", "awaiting_confirmation", "succeeded", "failed").
+			Default("pending").
+			Comment("awaiting_confirmation while the organizer pays back a manual refund; failed once every retry is used"),
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("Number of refund attempts made"),
+		field.String("last_error").
+			Optional().
+			MaxLen(1000).
+			Comment("Error of the last failed attempt"),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next attempt is due while pending"),
+		field.Time("refunded_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the CancellationRefund.
+func (CancellationRefund) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("cancellation", EventCancellation.Type).
+			Ref("refunds").
+			Field("cancellation_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the CancellationRefund.
+func (CancellationRefund) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("cancellation_id", "status"),
+	}
+}
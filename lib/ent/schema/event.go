@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -23,6 +25,11 @@ func (Event) Fields() []ent.Field {
 			Immutable(),
 		field.UUID("organization_id", uuid.UUID{}).
 			Comment("Organization that hosts this event"),
+		field.UUID("series_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Series the event is an occurrence of"),
 		field.String("title").
 			NotEmpty().
 			Comment("Event title"),
@@ -35,6 +42,51 @@ func (Event) Fields() []ent.Field {
 		field.String("venue").
 			Optional().
 			Comment("Event venue name"),
+		field.UUID("venue_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Saved venue the event takes place at; its name, address and coordinates are copied to the event"),
+		field.String("seating_layout").
+			Default("").
+			Comment("Seating layout of the saved venue; empty for the venue's full capacity"),
+		field.String("address_street").
+			Default("").
+			Comment("Street address of the venue"),
+		field.String("address_city").
+			Default("").
+			MaxLen(100),
+		field.String("address_region").
+			Default("").
+			MaxLen(100).
+			Comment("State, province or 시/도"),
+		field.String("address_postal_code").
+			Default("").
+			MaxLen(20),
+		field.String("address_country").
+			Default("").
+			MaxLen(2).
+			Comment("ISO 3166-1 alpha-2 country code"),
+		field.Float("latitude").
+			Optional().
+			Nillable().
+			Comment("WGS 84 latitude of the venue; set together with longitude"),
+		field.Float("longitude").
+			Optional().
+			Nillable(),
+		field.String("geohash").
+			Default("").
+			MaxLen(12).
+			Comment("Geohash of the coordinates for nearby search; empty without coordinates"),
+		field.String("category").
+			Default("").
+			MaxLen(50).
+			Comment("Lowercase category such as concert, conference or workshop"),
+		field.String("title_initials").
+			Default("").
+			Comment("Lowercase title with Korean syllables reduced to their initial consonants, for search suggestions"),
+		field.String("venue_initials").
+			Default("").
+			Comment("Venue reduced like title_initials"),
 		field.Time("start_time").
 			Comment("Event start time"),
 		field.Time("end_time").
@@ -65,9 +117,43 @@ func (Event) Fields() []ent.Field {
 			Values("draft", "published", "ongoing", "completed", "cancelled").
 			Default("draft").
 			Comment("Event status"),
+		field.Enum("review_status").
+			Values("not_submitted", "pending", "changes_requested", "approved", "rejected").
+			Default("not_submitted").
+			Comment("Approval workflow status, separate from the lifecycle status"),
+		field.Text("review_comment").
+			Optional().
+			Comment("Comment of the last submission or review"),
+		field.UUID("submitted_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Member who last submitted the event for review"),
+		field.Time("submitted_at").
+			Optional().
+			Nillable().
+			Comment("When the event was last submitted for review"),
+		field.UUID("reviewed_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Member who last approved, rejected or requested changes"),
+		field.Time("reviewed_at").
+			Optional().
+			Nillable().
+			Comment("When the event was last reviewed"),
+		field.Time("publish_at").
+			Optional().
+			Nillable().
+			Comment("When a draft event is published by the scheduler"),
+		field.Time("date_change_refund_until").
+			Optional().
+			Nillable().
+			Comment("Ticket holders may get a full refund until then because the event date moved"),
 		field.Bool("is_public").
 			Default(true).
 			Comment("Whether the event is publicly visible"),
+		field.Bool("requires_approval").
+			Default(false).
+			Comment("Whether RSVPs to a free event must be approved by the organizer"),
 		field.UUID("created_by", uuid.UUID{}).
 			Comment("User ID who created this event"),
 		field.Time("created_at").
@@ -92,6 +178,43 @@ func (Event) Edges() []ent.Edge {
 			Field("created_by").
 			Required().
 			Unique(),
+		edge.From("linked_venue", Venue.Type).
+			Ref("events").
+			Field("venue_id").
+			Unique(),
+		edge.From("series", EventSeries.Type).
+			Ref("occurrences").
+			Field("series_id").
+			Immutable().
+			Unique(),
 		edge.To("payments", Payment.Type),
+		edge.To("registration_questions", RegistrationQuestion.Type),
+	}
+}
+
+// Indexes of the Event.
+func (Event) Indexes() []ent.Index {
+	return []ent.Index{
+		// Used by the lifecycle scheduler
+		index.Fields("status", "start_time"),
+		index.Fields("status", "end_time"),
+		index.Fields("status", "publish_at"),
+		index.Fields("series_id", "start_time"),
+		// Used by public listings
+		index.Fields("category", "start_time"),
+		index.Fields("created_at"),
+		index.Fields("ticket_price"),
+		index.Fields("participant_count"),
+		// Nearby search narrows candidates to the geohash cells around a point
+		index.Fields("geohash"),
+		// Full-text indexes for search; migrations give them the ngram parser
+		// The title has its own index so title matches can be ranked higher
+		index.Fields("title", "description", "location", "venue").
+			Annotations(entsql.IndexType("FULLTEXT")),
+		index.Fields("title").
+			Annotations(entsql.IndexType("FULLTEXT")),
+		// Used by search suggestions
+		index.Fields("title_initials"),
+		index.Fields("venue_initials"),
 	}
 }
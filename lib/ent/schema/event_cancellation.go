@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EventCancellation holds the schema definition for the EventCancellation entity.
+type EventCancellation struct {
+	ent.Schema
+}
+
+// Fields of the EventCancellation.
+func (EventCancellation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("event_id", uuid.UUID{}).
+			Unique().
+			Immutable().
+			Comment("Cancelled event; an event is cancelled at most once"),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable().
+			Comment("Organization the event belongs to"),
+		field.UUID("requested_by", uuid.UUID{}).
+			Immutable().
+			Comment("Member who cancelled the event"),
+		field.Text("reason").
+			Optional().
+			Immutable().
+			Comment("Reason shown to attendees and sent to the payment gateway"),
+		field.Enum("status").
+			Values("running", "completed").
+			Default("running").
+			Comment("running while refunds are still being attempted"),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EventCancellation.
+func (EventCancellation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("refunds", CancellationRefund.Type),
+	}
+}
+
+// Indexes of the EventCancellation.
+func (EventCancellation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("status"),
+	}
+}
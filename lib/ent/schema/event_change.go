@@ -0,0 +1,57 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EventChange holds the schema definition for the EventChange entity.
+type EventChange struct {
+	ent.Schema
+}
+
+// Fields of the EventChange.
+func (EventChange) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("event_id", uuid.UUID{}).
+			Immutable().
+			Comment("Event that changed"),
+		field.UUID("changed_by", uuid.UUID{}).
+			Immutable().
+			Comment("Member who changed the event"),
+		field.JSON("before", map[string]string{}).
+			Immutable().
+			Comment("Previous values of the changed fields"),
+		field.JSON("after", map[string]string{}).
+			Immutable().
+			Comment("New values of the changed fields"),
+		field.Int("notified_count").
+			Default(0).
+			NonNegative().
+			Immutable().
+			Comment("Number of ticket holders notified"),
+		field.Time("refund_until").
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("End of the refund window opened by a date change"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the EventChange.
+func (EventChange) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("event_id", "created_at"),
+	}
+}
@@ -0,0 +1,42 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// EventFavorite holds the schema definition for the EventFavorite entity.
+type EventFavorite struct {
+	ent.Schema
+}
+
+// Fields of the EventFavorite.
+func (EventFavorite) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable().
+			Comment("User who favorited the event"),
+		field.UUID("event_id", uuid.UUID{}).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the EventFavorite.
+func (EventFavorite) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "event_id").
+			Unique(),
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,116 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// EventSeries holds the schema definition for the EventSeries entity.
+type EventSeries struct {
+	ent.Schema
+}
+
+// Fields of the EventSeries.
+func (EventSeries) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable().
+			Comment("Organization that hosts the series"),
+		field.String("title").
+			NotEmpty().
+			Comment("Series title, copied to its occurrences"),
+		field.Text("description").
+			Optional(),
+		field.String("location").
+			Optional(),
+		field.String("venue").
+			Optional(),
+		field.UUID("venue_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("Saved venue copied to the occurrences"),
+		field.String("seating_layout").
+			Default(""),
+		field.String("address_street").
+			Default(""),
+		field.String("address_city").
+			Default("").
+			MaxLen(100),
+		field.String("address_region").
+			Default("").
+			MaxLen(100),
+		field.String("address_postal_code").
+			Default("").
+			MaxLen(20),
+		field.String("address_country").
+			Default("").
+			MaxLen(2),
+		field.Float("latitude").
+			Optional().
+			Nillable(),
+		field.Float("longitude").
+			Optional().
+			Nillable(),
+		field.String("category").
+			Default("").
+			MaxLen(50),
+		field.Time("start_time").
+			Immutable().
+			Comment("Start of the first occurrence; later occurrences keep its time of day"),
+		field.Time("end_time").
+			Immutable().
+			Comment("End of the first occurrence; every occurrence lasts as long"),
+		field.Text("recurrence").
+			Immutable().
+			Comment("JSON encoded recurrence rule"),
+		field.Int("total_tickets").
+			Default(0).
+			Min(0).
+			Comment("Tickets per occurrence"),
+		field.Float("ticket_price").
+			Default(0.0).
+			Min(0),
+		field.String("currency").
+			Default("KRW"),
+		field.String("thumbnail_url").
+			Optional(),
+		field.Bool("is_public").
+			Default(true).
+			Comment("Whether the series page is publicly visible"),
+		field.Bool("requires_approval").
+			Default(false),
+		field.UUID("created_by", uuid.UUID{}).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the EventSeries.
+func (EventSeries) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("event_series").
+			Field("organization_id").
+			Required().
+			Immutable().
+			Unique(),
+		edge.From("linked_venue", Venue.Type).
+			Ref("event_series").
+			Field("venue_id").
+			Unique(),
+		edge.To("occurrences", Event.Type),
+	}
+}
@@ -4,8 +4,10 @@ import (
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
 	"github.com/google/uuid"
 )
 
@@ -24,6 +26,9 @@ func (Organization) Fields() []ent.Field {
 		field.String("name").
 			NotEmpty().
 			Comment("Organization name"),
+		field.String("name_initials").
+			Default("").
+			Comment("Lowercase name with Korean syllables reduced to their initial consonants, for search suggestions"),
 		field.Text("description").
 			Optional().
 			Comment("Organization description"),
@@ -33,11 +38,23 @@ func (Organization) Fields() []ent.Field {
 		field.String("category").
 			Optional().
 			Comment("Organization category (e.g., Technology, Music, Sports, Education, etc.)"),
+		field.String("slug").
+			Optional().
+			Nillable().
+			Unique().
+			Comment("URL slug of the public organization profile"),
+		field.JSON("social_links", map[string]string{}).
+			Optional().
+			Comment("Social links keyed by network (website, instagram, x, ...)"),
 		field.UUID("owner_id", uuid.UUID{}).
 			Comment("User ID of the organization owner"),
 		field.Bool("is_active").
 			Default(true).
 			Comment("Whether the organization is active"),
+		field.Time("deleted_at").
+			Optional().
+			Nillable().
+			Comment("Set when the organization is deleted; deleted organizations keep their event and payment history"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -51,7 +68,14 @@ func (Organization) Fields() []ent.Field {
 func (Organization) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("members", OrganizationMember.Type),
+		edge.To("roles", OrganizationRole.Type),
+		edge.To("invitations", OrganizationInvitation.Type),
+		edge.To("ownership_transfers", OwnershipTransfer.Type),
+		edge.To("api_keys", APIKey.Type),
+		edge.To("webhook_endpoints", WebhookEndpoint.Type),
 		edge.To("events", Event.Type),
+		edge.To("event_series", EventSeries.Type),
+		edge.To("venues", Venue.Type),
 		edge.From("owner", User.Type).
 			Ref("owned_organizations").
 			Field("owner_id").
@@ -59,3 +83,13 @@ func (Organization) Edges() []ent.Edge {
 			Unique(),
 	}
 }
+
+// Indexes of the Organization.
+func (Organization) Indexes() []ent.Index {
+	return []ent.Index{
+		// Full-text index for event search; migrations give it the ngram parser
+		index.Fields("name").
+			Annotations(entsql.IndexType("FULLTEXT")),
+		index.Fields("name_initials"),
+	}
+}
@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrganizationInvitation holds the schema definition for the OrganizationInvitation entity.
+type OrganizationInvitation struct {
+	ent.Schema
+}
+
+// Fields of the OrganizationInvitation.
+func (OrganizationInvitation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Comment("Organization the invitee will join"),
+		field.String("email").
+			NotEmpty().
+			Comment("Invitee email, stored lowercase"),
+		field.String("role").
+			NotEmpty().
+			Comment("Role granted when the invitation is accepted"),
+		field.UUID("invited_by", uuid.UUID{}).
+			Comment("Member who sent the invitation"),
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "revoked").
+			Default("pending").
+			Comment("Invitation status"),
+		field.Time("expires_at").
+			Comment("When the invitation token stops being valid"),
+		field.UUID("responded_by", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Comment("User who accepted or declined the invitation"),
+		field.Time("responded_at").
+			Optional().
+			Nillable().
+			Comment("When the invitation was accepted, declined or revoked"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the OrganizationInvitation.
+func (OrganizationInvitation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("invitations").
+			Field("organization_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the OrganizationInvitation.
+func (OrganizationInvitation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "status"),
+		index.Fields("email", "status"),
+	}
+}
@@ -25,10 +25,10 @@ func (OrganizationMember) Fields() []ent.Field {
 			Comment("Organization ID"),
 		field.UUID("user_id", uuid.UUID{}).
 			Comment("User ID"),
-		field.Enum("role").
-			Values("admin", "member").
-			Default("member").
-			Comment("Member role in the organization"),
+		field.String("role").
+			NotEmpty().
+			Default("viewer").
+			Comment("Built-in role (owner, admin, event_manager, finance, checkin_staff, viewer) or a custom role name"),
 		field.Time("joined_at").
 			Default(time.Now).
 			Immutable().
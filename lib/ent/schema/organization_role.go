@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OrganizationRole holds the schema definition for the OrganizationRole entity.
+type OrganizationRole struct {
+	ent.Schema
+}
+
+// Fields of the OrganizationRole.
+func (OrganizationRole) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Comment("Organization that defined this role"),
+		field.String("name").
+			NotEmpty().
+			Comment("Role name assigned to members"),
+		field.String("description").
+			Optional().
+			Comment("Role description"),
+		field.JSON("permissions", []string{}).
+			Comment("Permissions granted by this role"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the OrganizationRole.
+func (OrganizationRole) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("roles").
+			Field("organization_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the OrganizationRole.
+func (OrganizationRole) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "name").
+			Unique(),
+	}
+}
@@ -0,0 +1,68 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// OwnershipTransfer holds the schema definition for the OwnershipTransfer entity.
+type OwnershipTransfer struct {
+	ent.Schema
+}
+
+// Fields of the OwnershipTransfer.
+func (OwnershipTransfer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Comment("Organization being transferred"),
+		field.UUID("from_user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Owner who proposed the transfer"),
+		field.UUID("to_user_id", uuid.UUID{}).
+			Immutable().
+			Comment("Admin proposed as the new owner"),
+		field.Enum("status").
+			Values("pending", "accepted", "declined", "cancelled").
+			Default("pending").
+			Comment("Transfer status"),
+		field.Time("expires_at").
+			Comment("When the proposal can no longer be accepted"),
+		field.Time("responded_at").
+			Optional().
+			Nillable().
+			Comment("When the transfer was accepted, declined or cancelled"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the OwnershipTransfer.
+func (OwnershipTransfer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("ownership_transfers").
+			Field("organization_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the OwnershipTransfer.
+func (OwnershipTransfer) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "status"),
+	}
+}
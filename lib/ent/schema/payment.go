@@ -42,11 +42,9 @@ func (Payment) Fields() []ent.Field {
 			NotEmpty().
 			Comment("Buyer's name"),
 		field.String("buyer_email").
-			NotEmpty().
-			Comment("Buyer's email"),
+			Comment("Buyer's email (may be empty for box-office sales)"),
 		field.String("buyer_phone").
-			NotEmpty().
-			Comment("Buyer's phone number"),
+			Comment("Buyer's phone number (may be empty for box-office sales)"),
 		field.String("payment_key").
 			Optional().
 			Comment("Payment gateway payment key"),
@@ -58,6 +56,24 @@ func (Payment) Fields() []ent.Field {
 			Values("pending", "completed", "failed", "cancelled", "refunded").
 			Default("pending").
 			Comment("Payment status"),
+		field.Enum("channel").
+			Values("online", "box_office", "rsvp").
+			Default("online").
+			Comment("Sales channel the payment was made through"),
+		field.Enum("payment_method").
+			Values("gateway", "cash", "card_terminal", "transfer", "free").
+			Default("gateway").
+			Comment("How the buyer paid"),
+		field.UUID("operator_id", uuid.UUID{}).
+			Optional().
+			Comment("Staff user who recorded a box-office sale"),
+		field.String("receipt_number").
+			Optional().
+			Comment("Receipt number issued for a box-office sale"),
+		field.Time("checked_in_at").
+			Optional().
+			Nillable().
+			Comment("When the attendee was checked in at the event"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -79,5 +95,6 @@ func (Payment) Edges() []ent.Edge {
 			Ref("payments").
 			Field("user_id").
 			Unique(),
+		edge.To("registration_answers", RegistrationAnswer.Type),
 	}
 }
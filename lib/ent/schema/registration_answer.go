@@ -0,0 +1,50 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RegistrationAnswer holds the schema definition for the RegistrationAnswer entity.
+type RegistrationAnswer struct {
+	ent.Schema
+}
+
+// Fields of the RegistrationAnswer.
+func (RegistrationAnswer) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("payment_id", uuid.UUID{}).
+			Comment("Payment the answered ticket belongs to"),
+		field.Int("ticket_index").
+			NonNegative().
+			Comment("Zero-based index of the ticket within the payment"),
+		field.UUID("question_id", uuid.UUID{}).
+			Comment("Answered question ID (kept even if the question is later removed)"),
+		field.String("question_label").
+			Comment("Question label at the time of answering"),
+		field.JSON("values", []string{}).
+			Comment("Answer values; a single element except for multi_select"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the RegistrationAnswer.
+func (RegistrationAnswer) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("payment", Payment.Type).
+			Ref("registration_answers").
+			Field("payment_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// RegistrationQuestion holds the schema definition for the RegistrationQuestion entity.
+type RegistrationQuestion struct {
+	ent.Schema
+}
+
+// Fields of the RegistrationQuestion.
+func (RegistrationQuestion) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("event_id", uuid.UUID{}).
+			Comment("Event this question belongs to"),
+		field.String("label").
+			NotEmpty().
+			Comment("Question shown to the attendee"),
+		field.Enum("type").
+			Values("text", "select", "multi_select", "checkbox", "date").
+			Comment("Answer input type"),
+		field.JSON("options", []string{}).
+			Optional().
+			Comment("Allowed values for select and multi_select questions"),
+		field.Bool("required").
+			Default(false).
+			Comment("Whether every ticket must answer this question"),
+		field.Int("position").
+			Default(0).
+			Comment("Display order within the event form"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the RegistrationQuestion.
+func (RegistrationQuestion) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("event", Event.Type).
+			Ref("registration_questions").
+			Field("event_id").
+			Required().
+			Unique(),
+	}
+}
@@ -0,0 +1,93 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Venue holds the schema definition for the Venue entity.
+type Venue struct {
+	ent.Schema
+}
+
+// Fields of the Venue.
+func (Venue) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Organization that owns the venue; platform-wide venues have none"),
+		field.String("name").
+			NotEmpty(),
+		field.String("address_street").
+			Default(""),
+		field.String("address_city").
+			Default("").
+			MaxLen(100),
+		field.String("address_region").
+			Default("").
+			MaxLen(100),
+		field.String("address_postal_code").
+			Default("").
+			MaxLen(20),
+		field.String("address_country").
+			Default("").
+			MaxLen(2),
+		field.Float("latitude").
+			Optional().
+			Nillable(),
+		field.Float("longitude").
+			Optional().
+			Nillable(),
+		field.Int("capacity").
+			Positive().
+			Comment("Most attendees the venue holds"),
+		field.Text("seating_layouts").
+			Optional().
+			Comment("JSON encoded seating layouts, each with its own capacity"),
+		field.Text("directions").
+			Optional(),
+		field.Text("parking").
+			Optional(),
+		field.Text("accessibility").
+			Optional(),
+		field.UUID("created_by", uuid.UUID{}).
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Venue.
+func (Venue) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("venues").
+			Field("organization_id").
+			Immutable().
+			Unique(),
+		edge.To("events", Event.Type),
+		edge.To("event_series", EventSeries.Type),
+	}
+}
+
+// Indexes of the Venue.
+func (Venue) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "name"),
+	}
+}
@@ -0,0 +1,98 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookDelivery holds the schema definition for the WebhookDelivery entity.
+type WebhookDelivery struct {
+	ent.Schema
+}
+
+// Fields of the WebhookDelivery.
+func (WebhookDelivery) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("endpoint_id", uuid.UUID{}).
+			Immutable().
+			Comment("Endpoint the event is delivered to"),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable().
+			Comment("Organization the endpoint belongs to"),
+		field.UUID("event_id", uuid.UUID{}).
+			Immutable().
+			Comment("ID of the webhook event, shared by its deliveries and replays"),
+		field.String("event_type").
+			NotEmpty().
+			Immutable().
+			Comment("Webhook event type, e.g. order.completed"),
+		field.Text("payload").
+			Immutable().
+			Comment("JSON body posted to the endpoint"),
+		field.Enum("status").
+			Values("pending", "succeeded", "failed").
+			Default("pending").
+			Comment("Delivery status; failed once every retry is used"),
+		field.Int("attempts").
+			Default(0).
+			NonNegative().
+			Comment("Number of delivery attempts made"),
+		field.Int("last_status_code").
+			Optional().
+			Nillable().
+			Comment("HTTP status of the last attempt"),
+		field.String("last_error").
+			Optional().
+			MaxLen(1000).
+			Comment("Error or response excerpt of the last failed attempt"),
+		field.Time("next_attempt_at").
+			Optional().
+			Nillable().
+			Comment("When the next attempt is due while pending"),
+		field.Time("delivered_at").
+			Optional().
+			Nillable().
+			Comment("When the endpoint accepted the delivery"),
+		field.UUID("replay_of", uuid.UUID{}).
+			Optional().
+			Nillable().
+			Immutable().
+			Comment("Delivery this one replays"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookDelivery.
+func (WebhookDelivery) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("endpoint", WebhookEndpoint.Type).
+			Ref("deliveries").
+			Field("endpoint_id").
+			Required().
+			Immutable().
+			Unique(),
+	}
+}
+
+// Indexes of the WebhookDelivery.
+func (WebhookDelivery) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("endpoint_id", "created_at"),
+		index.Fields("organization_id", "created_at"),
+		index.Fields("status"),
+	}
+}
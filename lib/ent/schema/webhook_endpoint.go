@@ -0,0 +1,71 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WebhookEndpoint holds the schema definition for the WebhookEndpoint entity.
+type WebhookEndpoint struct {
+	ent.Schema
+}
+
+// Fields of the WebhookEndpoint.
+func (WebhookEndpoint) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique().
+			Immutable(),
+		field.UUID("organization_id", uuid.UUID{}).
+			Immutable().
+			Comment("Organization receiving the webhooks"),
+		field.String("url").
+			NotEmpty().
+			MaxLen(2048).
+			Comment("URL the events are posted to"),
+		field.String("secret").
+			NotEmpty().
+			Sensitive().
+			Comment("Key used to sign payloads with HMAC-SHA256"),
+		field.JSON("event_types", []string{}).
+			Comment("Event types delivered to the endpoint"),
+		field.Bool("is_active").
+			Default(true).
+			Comment("Inactive endpoints receive no new deliveries"),
+		field.UUID("created_by", uuid.UUID{}).
+			Immutable().
+			Comment("Member who registered the endpoint"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WebhookEndpoint.
+func (WebhookEndpoint) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("organization", Organization.Type).
+			Ref("webhook_endpoints").
+			Field("organization_id").
+			Required().
+			Immutable().
+			Unique(),
+		edge.To("deliveries", WebhookDelivery.Type),
+	}
+}
+
+// Indexes of the WebhookEndpoint.
+func (WebhookEndpoint) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("organization_id", "is_active"),
+	}
+}
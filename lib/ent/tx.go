@@ -12,16 +12,46 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// APIKey is the client for interacting with the APIKey builders.
+	APIKey *APIKeyClient
+	// AuditLog is the client for interacting with the AuditLog builders.
+	AuditLog *AuditLogClient
+	// CancellationRefund is the client for interacting with the CancellationRefund builders.
+	CancellationRefund *CancellationRefundClient
 	// Event is the client for interacting with the Event builders.
 	Event *EventClient
+	// EventCancellation is the client for interacting with the EventCancellation builders.
+	EventCancellation *EventCancellationClient
+	// EventChange is the client for interacting with the EventChange builders.
+	EventChange *EventChangeClient
+	// EventFavorite is the client for interacting with the EventFavorite builders.
+	EventFavorite *EventFavoriteClient
+	// EventSeries is the client for interacting with the EventSeries builders.
+	EventSeries *EventSeriesClient
 	// Organization is the client for interacting with the Organization builders.
 	Organization *OrganizationClient
+	// OrganizationInvitation is the client for interacting with the OrganizationInvitation builders.
+	OrganizationInvitation *OrganizationInvitationClient
 	// OrganizationMember is the client for interacting with the OrganizationMember builders.
 	OrganizationMember *OrganizationMemberClient
+	// OrganizationRole is the client for interacting with the OrganizationRole builders.
+	OrganizationRole *OrganizationRoleClient
+	// OwnershipTransfer is the client for interacting with the OwnershipTransfer builders.
+	OwnershipTransfer *OwnershipTransferClient
 	// Payment is the client for interacting with the Payment builders.
 	Payment *PaymentClient
+	// RegistrationAnswer is the client for interacting with the RegistrationAnswer builders.
+	RegistrationAnswer *RegistrationAnswerClient
+	// RegistrationQuestion is the client for interacting with the RegistrationQuestion builders.
+	RegistrationQuestion *RegistrationQuestionClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
+	// Venue is the client for interacting with the Venue builders.
+	Venue *VenueClient
+	// WebhookDelivery is the client for interacting with the WebhookDelivery builders.
+	WebhookDelivery *WebhookDeliveryClient
+	// WebhookEndpoint is the client for interacting with the WebhookEndpoint builders.
+	WebhookEndpoint *WebhookEndpointClient
 
 	// lazily loaded.
 	client     *Client
@@ -153,11 +183,26 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.APIKey = NewAPIKeyClient(tx.config)
+	tx.AuditLog = NewAuditLogClient(tx.config)
+	tx.CancellationRefund = NewCancellationRefundClient(tx.config)
 	tx.Event = NewEventClient(tx.config)
+	tx.EventCancellation = NewEventCancellationClient(tx.config)
+	tx.EventChange = NewEventChangeClient(tx.config)
+	tx.EventFavorite = NewEventFavoriteClient(tx.config)
+	tx.EventSeries = NewEventSeriesClient(tx.config)
 	tx.Organization = NewOrganizationClient(tx.config)
+	tx.OrganizationInvitation = NewOrganizationInvitationClient(tx.config)
 	tx.OrganizationMember = NewOrganizationMemberClient(tx.config)
+	tx.OrganizationRole = NewOrganizationRoleClient(tx.config)
+	tx.OwnershipTransfer = NewOwnershipTransferClient(tx.config)
 	tx.Payment = NewPaymentClient(tx.config)
+	tx.RegistrationAnswer = NewRegistrationAnswerClient(tx.config)
+	tx.RegistrationQuestion = NewRegistrationQuestionClient(tx.config)
 	tx.User = NewUserClient(tx.config)
+	tx.Venue = NewVenueClient(tx.config)
+	tx.WebhookDelivery = NewWebhookDeliveryClient(tx.config)
+	tx.WebhookEndpoint = NewWebhookEndpointClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -167,7 +212,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Event.QueryXXX(), the query will be executed
+// applies a query, for example: APIKey.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
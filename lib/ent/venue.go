@@ -0,0 +1,359 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/google/uuid"
+)
+
+// Venue is the model entity for the Venue schema.
+type Venue struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Organization that owns the venue; platform-wide venues have none
+	OrganizationID *uuid.UUID `json:"organization_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// AddressStreet holds the value of the "address_street" field.
+	AddressStreet string `json:"address_street,omitempty"`
+	// AddressCity holds the value of the "address_city" field.
+	AddressCity string `json:"address_city,omitempty"`
+	// AddressRegion holds the value of the "address_region" field.
+	AddressRegion string `json:"address_region,omitempty"`
+	// AddressPostalCode holds the value of the "address_postal_code" field.
+	AddressPostalCode string `json:"address_postal_code,omitempty"`
+	// AddressCountry holds the value of the "address_country" field.
+	AddressCountry string `json:"address_country,omitempty"`
+	// Latitude holds the value of the "latitude" field.
+	Latitude *float64 `json:"latitude,omitempty"`
+	// Longitude holds the value of the "longitude" field.
+	Longitude *float64 `json:"longitude,omitempty"`
+	// Most attendees the venue holds
+	Capacity int `json:"capacity,omitempty"`
+	// JSON encoded seating layouts, each with its own capacity
+	SeatingLayouts string `json:"seating_layouts,omitempty"`
+	// Directions holds the value of the "directions" field.
+	Directions string `json:"directions,omitempty"`
+	// Parking holds the value of the "parking" field.
+	Parking string `json:"parking,omitempty"`
+	// Accessibility holds the value of the "accessibility" field.
+	Accessibility string `json:"accessibility,omitempty"`
+	// CreatedBy holds the value of the "created_by" field.
+	CreatedBy uuid.UUID `json:"created_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the VenueQuery when eager-loading is set.
+	Edges        VenueEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// VenueEdges holds the relations/edges for other nodes in the graph.
+type VenueEdges struct {
+	// Organization holds the value of the organization edge.
+	Organization *Organization `json:"organization,omitempty"`
+	// Events holds the value of the events edge.
+	Events []*Event `json:"events,omitempty"`
+	// EventSeries holds the value of the event_series edge.
+	EventSeries []*EventSeries `json:"event_series,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [3]bool
+}
+
+// OrganizationOrErr returns the Organization value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e VenueEdges) OrganizationOrErr() (*Organization, error) {
+	if e.Organization != nil {
+		return e.Organization, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: organization.Label}
+	}
+	return nil, &NotLoadedError{edge: "organization"}
+}
+
+// EventsOrErr returns the Events value or an error if the edge
+// was not loaded in eager-loading.
+func (e VenueEdges) EventsOrErr() ([]*Event, error) {
+	if e.loadedTypes[1] {
+		return e.Events, nil
+	}
+	return nil, &NotLoadedError{edge: "events"}
+}
+
+// EventSeriesOrErr returns the EventSeries value or an error if the edge
+// was not loaded in eager-loading.
+func (e VenueEdges) EventSeriesOrErr() ([]*EventSeries, error) {
+	if e.loadedTypes[2] {
+		return e.EventSeries, nil
+	}
+	return nil, &NotLoadedError{edge: "event_series"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Venue) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case venue.FieldOrganizationID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case venue.FieldLatitude, venue.FieldLongitude:
+			values[i] = new(sql.NullFloat64)
+		case venue.FieldCapacity:
+			values[i] = new(sql.NullInt64)
+		case venue.FieldName, venue.FieldAddressStreet, venue.FieldAddressCity, venue.FieldAddressRegion, venue.FieldAddressPostalCode, venue.FieldAddressCountry, venue.FieldSeatingLayouts, venue.FieldDirections, venue.FieldParking, venue.FieldAccessibility:
+			values[i] = new(sql.NullString)
+		case venue.FieldCreatedAt, venue.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case venue.FieldID, venue.FieldCreatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Venue fields.
+func (_m *Venue) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case venue.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case venue.FieldOrganizationID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field organization_id", values[i])
+			} else if value.Valid {
+				_m.OrganizationID = new(uuid.UUID)
+				*_m.OrganizationID = *value.S.(*uuid.UUID)
+			}
+		case venue.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case venue.FieldAddressStreet:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_street", values[i])
+			} else if value.Valid {
+				_m.AddressStreet = value.String
+			}
+		case venue.FieldAddressCity:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_city", values[i])
+			} else if value.Valid {
+				_m.AddressCity = value.String
+			}
+		case venue.FieldAddressRegion:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_region", values[i])
+			} else if value.Valid {
+				_m.AddressRegion = value.String
+			}
+		case venue.FieldAddressPostalCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_postal_code", values[i])
+			} else if value.Valid {
+				_m.AddressPostalCode = value.String
+			}
+		case venue.FieldAddressCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field address_country", values[i])
+			} else if value.Valid {
+				_m.AddressCountry = value.String
+			}
+		case venue.FieldLatitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field latitude", values[i])
+			} else if value.Valid {
+				_m.Latitude = new(float64)
+				*_m.Latitude = value.Float64
+			}
+		case venue.FieldLongitude:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field longitude", values[i])
+			} else if value.Valid {
+				_m.Longitude = new(float64)
+				*_m.Longitude = value.Float64
+			}
+		case venue.FieldCapacity:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field capacity", values[i])
+			} else if value.Valid {
+				_m.Capacity = int(value.Int64)
+			}
+		case venue.FieldSeatingLayouts:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field seating_layouts", values[i])
+			} else if value.Valid {
+				_m.SeatingLayouts = value.String
+			}
+		case venue.FieldDirections:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field directions", values[i])
+			} else if value.Valid {
+				_m.Directions = value.String
+			}
+		case venue.FieldParking:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field parking", values[i])
+			} else if value.Valid {
+				_m.Parking = value.String
+			}
+		case venue.FieldAccessibility:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field accessibility", values[i])
+			} else if value.Valid {
+				_m.Accessibility = value.String
+			}
+		case venue.FieldCreatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field created_by", values[i])
+			} else if value != nil {
+				_m.CreatedBy = *value
+			}
+		case venue.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case venue.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Venue.
+// This includes values selected through modifiers, order, etc.
+func (_m *Venue) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryOrganization queries the "organization" edge of the Venue entity.
+func (_m *Venue) QueryOrganization() *OrganizationQuery {
+	return NewVenueClient(_m.config).QueryOrganization(_m)
+}
+
+// QueryEvents queries the "events" edge of the Venue entity.
+func (_m *Venue) QueryEvents() *EventQuery {
+	return NewVenueClient(_m.config).QueryEvents(_m)
+}
+
+// QueryEventSeries queries the "event_series" edge of the Venue entity.
+func (_m *Venue) QueryEventSeries() *EventSeriesQuery {
+	return NewVenueClient(_m.config).QueryEventSeries(_m)
+}
+
+// Update returns a builder for updating this Venue.
+// Note that you need to call Venue.Unwrap() before calling this method if this Venue
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Venue) Update() *VenueUpdateOne {
+	return NewVenueClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Venue entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Venue) Unwrap() *Venue {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Venue is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Venue) String() string {
+	var builder strings.Builder
+	builder.WriteString("Venue(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.OrganizationID; v != nil {
+		builder.WriteString("organization_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("address_street=")
+	builder.WriteString(_m.AddressStreet)
+	builder.WriteString(", ")
+	builder.WriteString("address_city=")
+	builder.WriteString(_m.AddressCity)
+	builder.WriteString(", ")
+	builder.WriteString("address_region=")
+	builder.WriteString(_m.AddressRegion)
+	builder.WriteString(", ")
+	builder.WriteString("address_postal_code=")
+	builder.WriteString(_m.AddressPostalCode)
+	builder.WriteString(", ")
+	builder.WriteString("address_country=")
+	builder.WriteString(_m.AddressCountry)
+	builder.WriteString(", ")
+	if v := _m.Latitude; v != nil {
+		builder.WriteString("latitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.Longitude; v != nil {
+		builder.WriteString("longitude=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("capacity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Capacity))
+	builder.WriteString(", ")
+	builder.WriteString("seating_layouts=")
+	builder.WriteString(_m.SeatingLayouts)
+	builder.WriteString(", ")
+	builder.WriteString("directions=")
+	builder.WriteString(_m.Directions)
+	builder.WriteString(", ")
+	builder.WriteString("parking=")
+	builder.WriteString(_m.Parking)
+	builder.WriteString(", ")
+	builder.WriteString("accessibility=")
+	builder.WriteString(_m.Accessibility)
+	builder.WriteString(", ")
+	builder.WriteString("created_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CreatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Venues is a parsable slice of Venue.
+type Venues []*Venue
@@ -0,0 +1,295 @@
+// Code generated by ent, DO NOT EDIT.
+
+package venue
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the venue type in the database.
+	Label = "venue"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldOrganizationID holds the string denoting the organization_id field in the database.
+	FieldOrganizationID = "organization_id"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldAddressStreet holds the string denoting the address_street field in the database.
+	FieldAddressStreet = "address_street"
+	// FieldAddressCity holds the string denoting the address_city field in the database.
+	FieldAddressCity = "address_city"
+	// FieldAddressRegion holds the string denoting the address_region field in the database.
+	FieldAddressRegion = "address_region"
+	// FieldAddressPostalCode holds the string denoting the address_postal_code field in the database.
+	FieldAddressPostalCode = "address_postal_code"
+	// FieldAddressCountry holds the string denoting the address_country field in the database.
+	FieldAddressCountry = "address_country"
+	// FieldLatitude holds the string denoting the latitude field in the database.
+	FieldLatitude = "latitude"
+	// FieldLongitude holds the string denoting the longitude field in the database.
+	FieldLongitude = "longitude"
+	// FieldCapacity holds the string denoting the capacity field in the database.
+	FieldCapacity = "capacity"
+	// FieldSeatingLayouts holds the string denoting the seating_layouts field in the database.
+	FieldSeatingLayouts = "seating_layouts"
+	// FieldDirections holds the string denoting the directions field in the database.
+	FieldDirections = "directions"
+	// FieldParking holds the string denoting the parking field in the database.
+	FieldParking = "parking"
+	// FieldAccessibility holds the string denoting the accessibility field in the database.
+	FieldAccessibility = "accessibility"
+	// FieldCreatedBy holds the string denoting the created_by field in the database.
+	FieldCreatedBy = "created_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeOrganization holds the string denoting the organization edge name in mutations.
+	EdgeOrganization = "organization"
+	// EdgeEvents holds the string denoting the events edge name in mutations.
+	EdgeEvents = "events"
+	// EdgeEventSeries holds the string denoting the event_series edge name in mutations.
+	EdgeEventSeries = "event_series"
+	// Table holds the table name of the venue in the database.
+	Table = "venues"
+	// OrganizationTable is the table that holds the organization relation/edge.
+	OrganizationTable = "venues"
+	// OrganizationInverseTable is the table name for the Organization entity.
+	// It exists in this package in order to avoid circular dependency with the "organization" package.
+	OrganizationInverseTable = "organizations"
+	// OrganizationColumn is the table column denoting the organization relation/edge.
+	OrganizationColumn = "organization_id"
+	// EventsTable is the table that holds the events relation/edge.
+	EventsTable = "events"
+	// EventsInverseTable is the table name for the Event entity.
+	// It exists in this package in order to avoid circular dependency with the "event" package.
+	EventsInverseTable = "events"
+	// EventsColumn is the table column denoting the events relation/edge.
+	EventsColumn = "venue_id"
+	// EventSeriesTable is the table that holds the event_series relation/edge.
+	EventSeriesTable = "event_series"
+	// EventSeriesInverseTable is the table name for the EventSeries entity.
+	// It exists in this package in order to avoid circular dependency with the "eventseries" package.
+	EventSeriesInverseTable = "event_series"
+	// EventSeriesColumn is the table column denoting the event_series relation/edge.
+	EventSeriesColumn = "venue_id"
+)
+
+// Columns holds all SQL columns for venue fields.
+var Columns = []string{
+	FieldID,
+	FieldOrganizationID,
+	FieldName,
+	FieldAddressStreet,
+	FieldAddressCity,
+	FieldAddressRegion,
+	FieldAddressPostalCode,
+	FieldAddressCountry,
+	FieldLatitude,
+	FieldLongitude,
+	FieldCapacity,
+	FieldSeatingLayouts,
+	FieldDirections,
+	FieldParking,
+	FieldAccessibility,
+	FieldCreatedBy,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultAddressStreet holds the default value on creation for the "address_street" field.
+	DefaultAddressStreet string
+	// DefaultAddressCity holds the default value on creation for the "address_city" field.
+	DefaultAddressCity string
+	// AddressCityValidator is a validator for the "address_city" field. It is called by the builders before save.
+	AddressCityValidator func(string) error
+	// DefaultAddressRegion holds the default value on creation for the "address_region" field.
+	DefaultAddressRegion string
+	// AddressRegionValidator is a validator for the "address_region" field. It is called by the builders before save.
+	AddressRegionValidator func(string) error
+	// DefaultAddressPostalCode holds the default value on creation for the "address_postal_code" field.
+	DefaultAddressPostalCode string
+	// AddressPostalCodeValidator is a validator for the "address_postal_code" field. It is called by the builders before save.
+	AddressPostalCodeValidator func(string) error
+	// DefaultAddressCountry holds the default value on creation for the "address_country" field.
+	DefaultAddressCountry string
+	// AddressCountryValidator is a validator for the "address_country" field. It is called by the builders before save.
+	AddressCountryValidator func(string) error
+	// CapacityValidator is a validator for the "capacity" field. It is called by the builders before save.
+	CapacityValidator func(int) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Venue queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByOrganizationID orders the results by the organization_id field.
+func ByOrganizationID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrganizationID, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// ByAddressStreet orders the results by the address_street field.
+func ByAddressStreet(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressStreet, opts...).ToFunc()
+}
+
+// ByAddressCity orders the results by the address_city field.
+func ByAddressCity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressCity, opts...).ToFunc()
+}
+
+// ByAddressRegion orders the results by the address_region field.
+func ByAddressRegion(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressRegion, opts...).ToFunc()
+}
+
+// ByAddressPostalCode orders the results by the address_postal_code field.
+func ByAddressPostalCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressPostalCode, opts...).ToFunc()
+}
+
+// ByAddressCountry orders the results by the address_country field.
+func ByAddressCountry(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAddressCountry, opts...).ToFunc()
+}
+
+// ByLatitude orders the results by the latitude field.
+func ByLatitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLatitude, opts...).ToFunc()
+}
+
+// ByLongitude orders the results by the longitude field.
+func ByLongitude(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLongitude, opts...).ToFunc()
+}
+
+// ByCapacity orders the results by the capacity field.
+func ByCapacity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCapacity, opts...).ToFunc()
+}
+
+// BySeatingLayouts orders the results by the seating_layouts field.
+func BySeatingLayouts(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSeatingLayouts, opts...).ToFunc()
+}
+
+// ByDirections orders the results by the directions field.
+func ByDirections(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDirections, opts...).ToFunc()
+}
+
+// ByParking orders the results by the parking field.
+func ByParking(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldParking, opts...).ToFunc()
+}
+
+// ByAccessibility orders the results by the accessibility field.
+func ByAccessibility(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccessibility, opts...).ToFunc()
+}
+
+// ByCreatedBy orders the results by the created_by field.
+func ByCreatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByOrganizationField orders the results by organization field.
+func ByOrganizationField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrganizationStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByEventsCount orders the results by events count.
+func ByEventsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventsStep(), opts...)
+	}
+}
+
+// ByEvents orders the results by events terms.
+func ByEvents(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEventSeriesCount orders the results by event_series count.
+func ByEventSeriesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEventSeriesStep(), opts...)
+	}
+}
+
+// ByEventSeries orders the results by event_series terms.
+func ByEventSeries(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEventSeriesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newOrganizationStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrganizationInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+	)
+}
+func newEventsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+	)
+}
+func newEventSeriesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EventSeriesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EventSeriesTable, EventSeriesColumn),
+	)
+}
@@ -0,0 +1,1206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package venue
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldID, id))
+}
+
+// OrganizationID applies equality check predicate on the "organization_id" field. It's identical to OrganizationIDEQ.
+func OrganizationID(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldName, v))
+}
+
+// AddressStreet applies equality check predicate on the "address_street" field. It's identical to AddressStreetEQ.
+func AddressStreet(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressStreet, v))
+}
+
+// AddressCity applies equality check predicate on the "address_city" field. It's identical to AddressCityEQ.
+func AddressCity(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressCity, v))
+}
+
+// AddressRegion applies equality check predicate on the "address_region" field. It's identical to AddressRegionEQ.
+func AddressRegion(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressRegion, v))
+}
+
+// AddressPostalCode applies equality check predicate on the "address_postal_code" field. It's identical to AddressPostalCodeEQ.
+func AddressPostalCode(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressPostalCode, v))
+}
+
+// AddressCountry applies equality check predicate on the "address_country" field. It's identical to AddressCountryEQ.
+func AddressCountry(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressCountry, v))
+}
+
+// Latitude applies equality check predicate on the "latitude" field. It's identical to LatitudeEQ.
+func Latitude(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldLatitude, v))
+}
+
+// Longitude applies equality check predicate on the "longitude" field. It's identical to LongitudeEQ.
+func Longitude(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldLongitude, v))
+}
+
+// Capacity applies equality check predicate on the "capacity" field. It's identical to CapacityEQ.
+func Capacity(v int) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldCapacity, v))
+}
+
+// SeatingLayouts applies equality check predicate on the "seating_layouts" field. It's identical to SeatingLayoutsEQ.
+func SeatingLayouts(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldSeatingLayouts, v))
+}
+
+// Directions applies equality check predicate on the "directions" field. It's identical to DirectionsEQ.
+func Directions(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldDirections, v))
+}
+
+// Parking applies equality check predicate on the "parking" field. It's identical to ParkingEQ.
+func Parking(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldParking, v))
+}
+
+// Accessibility applies equality check predicate on the "accessibility" field. It's identical to AccessibilityEQ.
+func Accessibility(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAccessibility, v))
+}
+
+// CreatedBy applies equality check predicate on the "created_by" field. It's identical to CreatedByEQ.
+func CreatedBy(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// OrganizationIDEQ applies the EQ predicate on the "organization_id" field.
+func OrganizationIDEQ(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDNEQ applies the NEQ predicate on the "organization_id" field.
+func OrganizationIDNEQ(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldOrganizationID, v))
+}
+
+// OrganizationIDIn applies the In predicate on the "organization_id" field.
+func OrganizationIDIn(vs ...uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDNotIn applies the NotIn predicate on the "organization_id" field.
+func OrganizationIDNotIn(vs ...uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldOrganizationID, vs...))
+}
+
+// OrganizationIDIsNil applies the IsNil predicate on the "organization_id" field.
+func OrganizationIDIsNil() predicate.Venue {
+	return predicate.Venue(sql.FieldIsNull(FieldOrganizationID))
+}
+
+// OrganizationIDNotNil applies the NotNil predicate on the "organization_id" field.
+func OrganizationIDNotNil() predicate.Venue {
+	return predicate.Venue(sql.FieldNotNull(FieldOrganizationID))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldName, v))
+}
+
+// AddressStreetEQ applies the EQ predicate on the "address_street" field.
+func AddressStreetEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressStreet, v))
+}
+
+// AddressStreetNEQ applies the NEQ predicate on the "address_street" field.
+func AddressStreetNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldAddressStreet, v))
+}
+
+// AddressStreetIn applies the In predicate on the "address_street" field.
+func AddressStreetIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldAddressStreet, vs...))
+}
+
+// AddressStreetNotIn applies the NotIn predicate on the "address_street" field.
+func AddressStreetNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldAddressStreet, vs...))
+}
+
+// AddressStreetGT applies the GT predicate on the "address_street" field.
+func AddressStreetGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldAddressStreet, v))
+}
+
+// AddressStreetGTE applies the GTE predicate on the "address_street" field.
+func AddressStreetGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldAddressStreet, v))
+}
+
+// AddressStreetLT applies the LT predicate on the "address_street" field.
+func AddressStreetLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldAddressStreet, v))
+}
+
+// AddressStreetLTE applies the LTE predicate on the "address_street" field.
+func AddressStreetLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldAddressStreet, v))
+}
+
+// AddressStreetContains applies the Contains predicate on the "address_street" field.
+func AddressStreetContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldAddressStreet, v))
+}
+
+// AddressStreetHasPrefix applies the HasPrefix predicate on the "address_street" field.
+func AddressStreetHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldAddressStreet, v))
+}
+
+// AddressStreetHasSuffix applies the HasSuffix predicate on the "address_street" field.
+func AddressStreetHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldAddressStreet, v))
+}
+
+// AddressStreetEqualFold applies the EqualFold predicate on the "address_street" field.
+func AddressStreetEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldAddressStreet, v))
+}
+
+// AddressStreetContainsFold applies the ContainsFold predicate on the "address_street" field.
+func AddressStreetContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldAddressStreet, v))
+}
+
+// AddressCityEQ applies the EQ predicate on the "address_city" field.
+func AddressCityEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressCity, v))
+}
+
+// AddressCityNEQ applies the NEQ predicate on the "address_city" field.
+func AddressCityNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldAddressCity, v))
+}
+
+// AddressCityIn applies the In predicate on the "address_city" field.
+func AddressCityIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldAddressCity, vs...))
+}
+
+// AddressCityNotIn applies the NotIn predicate on the "address_city" field.
+func AddressCityNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldAddressCity, vs...))
+}
+
+// AddressCityGT applies the GT predicate on the "address_city" field.
+func AddressCityGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldAddressCity, v))
+}
+
+// AddressCityGTE applies the GTE predicate on the "address_city" field.
+func AddressCityGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldAddressCity, v))
+}
+
+// AddressCityLT applies the LT predicate on the "address_city" field.
+func AddressCityLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldAddressCity, v))
+}
+
+// AddressCityLTE applies the LTE predicate on the "address_city" field.
+func AddressCityLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldAddressCity, v))
+}
+
+// AddressCityContains applies the Contains predicate on the "address_city" field.
+func AddressCityContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldAddressCity, v))
+}
+
+// AddressCityHasPrefix applies the HasPrefix predicate on the "address_city" field.
+func AddressCityHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldAddressCity, v))
+}
+
+// AddressCityHasSuffix applies the HasSuffix predicate on the "address_city" field.
+func AddressCityHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldAddressCity, v))
+}
+
+// AddressCityEqualFold applies the EqualFold predicate on the "address_city" field.
+func AddressCityEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldAddressCity, v))
+}
+
+// AddressCityContainsFold applies the ContainsFold predicate on the "address_city" field.
+func AddressCityContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldAddressCity, v))
+}
+
+// AddressRegionEQ applies the EQ predicate on the "address_region" field.
+func AddressRegionEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressRegion, v))
+}
+
+// AddressRegionNEQ applies the NEQ predicate on the "address_region" field.
+func AddressRegionNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldAddressRegion, v))
+}
+
+// AddressRegionIn applies the In predicate on the "address_region" field.
+func AddressRegionIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldAddressRegion, vs...))
+}
+
+// AddressRegionNotIn applies the NotIn predicate on the "address_region" field.
+func AddressRegionNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldAddressRegion, vs...))
+}
+
+// AddressRegionGT applies the GT predicate on the "address_region" field.
+func AddressRegionGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldAddressRegion, v))
+}
+
+// AddressRegionGTE applies the GTE predicate on the "address_region" field.
+func AddressRegionGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldAddressRegion, v))
+}
+
+// AddressRegionLT applies the LT predicate on the "address_region" field.
+func AddressRegionLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldAddressRegion, v))
+}
+
+// AddressRegionLTE applies the LTE predicate on the "address_region" field.
+func AddressRegionLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldAddressRegion, v))
+}
+
+// AddressRegionContains applies the Contains predicate on the "address_region" field.
+func AddressRegionContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldAddressRegion, v))
+}
+
+// AddressRegionHasPrefix applies the HasPrefix predicate on the "address_region" field.
+func AddressRegionHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldAddressRegion, v))
+}
+
+// AddressRegionHasSuffix applies the HasSuffix predicate on the "address_region" field.
+func AddressRegionHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldAddressRegion, v))
+}
+
+// AddressRegionEqualFold applies the EqualFold predicate on the "address_region" field.
+func AddressRegionEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldAddressRegion, v))
+}
+
+// AddressRegionContainsFold applies the ContainsFold predicate on the "address_region" field.
+func AddressRegionContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldAddressRegion, v))
+}
+
+// AddressPostalCodeEQ applies the EQ predicate on the "address_postal_code" field.
+func AddressPostalCodeEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeNEQ applies the NEQ predicate on the "address_postal_code" field.
+func AddressPostalCodeNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeIn applies the In predicate on the "address_postal_code" field.
+func AddressPostalCodeIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldAddressPostalCode, vs...))
+}
+
+// AddressPostalCodeNotIn applies the NotIn predicate on the "address_postal_code" field.
+func AddressPostalCodeNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldAddressPostalCode, vs...))
+}
+
+// AddressPostalCodeGT applies the GT predicate on the "address_postal_code" field.
+func AddressPostalCodeGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeGTE applies the GTE predicate on the "address_postal_code" field.
+func AddressPostalCodeGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeLT applies the LT predicate on the "address_postal_code" field.
+func AddressPostalCodeLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeLTE applies the LTE predicate on the "address_postal_code" field.
+func AddressPostalCodeLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeContains applies the Contains predicate on the "address_postal_code" field.
+func AddressPostalCodeContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeHasPrefix applies the HasPrefix predicate on the "address_postal_code" field.
+func AddressPostalCodeHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeHasSuffix applies the HasSuffix predicate on the "address_postal_code" field.
+func AddressPostalCodeHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeEqualFold applies the EqualFold predicate on the "address_postal_code" field.
+func AddressPostalCodeEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldAddressPostalCode, v))
+}
+
+// AddressPostalCodeContainsFold applies the ContainsFold predicate on the "address_postal_code" field.
+func AddressPostalCodeContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldAddressPostalCode, v))
+}
+
+// AddressCountryEQ applies the EQ predicate on the "address_country" field.
+func AddressCountryEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAddressCountry, v))
+}
+
+// AddressCountryNEQ applies the NEQ predicate on the "address_country" field.
+func AddressCountryNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldAddressCountry, v))
+}
+
+// AddressCountryIn applies the In predicate on the "address_country" field.
+func AddressCountryIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldAddressCountry, vs...))
+}
+
+// AddressCountryNotIn applies the NotIn predicate on the "address_country" field.
+func AddressCountryNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldAddressCountry, vs...))
+}
+
+// AddressCountryGT applies the GT predicate on the "address_country" field.
+func AddressCountryGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldAddressCountry, v))
+}
+
+// AddressCountryGTE applies the GTE predicate on the "address_country" field.
+func AddressCountryGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldAddressCountry, v))
+}
+
+// AddressCountryLT applies the LT predicate on the "address_country" field.
+func AddressCountryLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldAddressCountry, v))
+}
+
+// AddressCountryLTE applies the LTE predicate on the "address_country" field.
+func AddressCountryLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldAddressCountry, v))
+}
+
+// AddressCountryContains applies the Contains predicate on the "address_country" field.
+func AddressCountryContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldAddressCountry, v))
+}
+
+// AddressCountryHasPrefix applies the HasPrefix predicate on the "address_country" field.
+func AddressCountryHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldAddressCountry, v))
+}
+
+// AddressCountryHasSuffix applies the HasSuffix predicate on the "address_country" field.
+func AddressCountryHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldAddressCountry, v))
+}
+
+// AddressCountryEqualFold applies the EqualFold predicate on the "address_country" field.
+func AddressCountryEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldAddressCountry, v))
+}
+
+// AddressCountryContainsFold applies the ContainsFold predicate on the "address_country" field.
+func AddressCountryContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldAddressCountry, v))
+}
+
+// LatitudeEQ applies the EQ predicate on the "latitude" field.
+func LatitudeEQ(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldLatitude, v))
+}
+
+// LatitudeNEQ applies the NEQ predicate on the "latitude" field.
+func LatitudeNEQ(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldLatitude, v))
+}
+
+// LatitudeIn applies the In predicate on the "latitude" field.
+func LatitudeIn(vs ...float64) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldLatitude, vs...))
+}
+
+// LatitudeNotIn applies the NotIn predicate on the "latitude" field.
+func LatitudeNotIn(vs ...float64) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldLatitude, vs...))
+}
+
+// LatitudeGT applies the GT predicate on the "latitude" field.
+func LatitudeGT(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldLatitude, v))
+}
+
+// LatitudeGTE applies the GTE predicate on the "latitude" field.
+func LatitudeGTE(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldLatitude, v))
+}
+
+// LatitudeLT applies the LT predicate on the "latitude" field.
+func LatitudeLT(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldLatitude, v))
+}
+
+// LatitudeLTE applies the LTE predicate on the "latitude" field.
+func LatitudeLTE(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldLatitude, v))
+}
+
+// LatitudeIsNil applies the IsNil predicate on the "latitude" field.
+func LatitudeIsNil() predicate.Venue {
+	return predicate.Venue(sql.FieldIsNull(FieldLatitude))
+}
+
+// LatitudeNotNil applies the NotNil predicate on the "latitude" field.
+func LatitudeNotNil() predicate.Venue {
+	return predicate.Venue(sql.FieldNotNull(FieldLatitude))
+}
+
+// LongitudeEQ applies the EQ predicate on the "longitude" field.
+func LongitudeEQ(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldLongitude, v))
+}
+
+// LongitudeNEQ applies the NEQ predicate on the "longitude" field.
+func LongitudeNEQ(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldLongitude, v))
+}
+
+// LongitudeIn applies the In predicate on the "longitude" field.
+func LongitudeIn(vs ...float64) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldLongitude, vs...))
+}
+
+// LongitudeNotIn applies the NotIn predicate on the "longitude" field.
+func LongitudeNotIn(vs ...float64) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldLongitude, vs...))
+}
+
+// LongitudeGT applies the GT predicate on the "longitude" field.
+func LongitudeGT(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldLongitude, v))
+}
+
+// LongitudeGTE applies the GTE predicate on the "longitude" field.
+func LongitudeGTE(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldLongitude, v))
+}
+
+// LongitudeLT applies the LT predicate on the "longitude" field.
+func LongitudeLT(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldLongitude, v))
+}
+
+// LongitudeLTE applies the LTE predicate on the "longitude" field.
+func LongitudeLTE(v float64) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldLongitude, v))
+}
+
+// LongitudeIsNil applies the IsNil predicate on the "longitude" field.
+func LongitudeIsNil() predicate.Venue {
+	return predicate.Venue(sql.FieldIsNull(FieldLongitude))
+}
+
+// LongitudeNotNil applies the NotNil predicate on the "longitude" field.
+func LongitudeNotNil() predicate.Venue {
+	return predicate.Venue(sql.FieldNotNull(FieldLongitude))
+}
+
+// CapacityEQ applies the EQ predicate on the "capacity" field.
+func CapacityEQ(v int) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldCapacity, v))
+}
+
+// CapacityNEQ applies the NEQ predicate on the "capacity" field.
+func CapacityNEQ(v int) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldCapacity, v))
+}
+
+// CapacityIn applies the In predicate on the "capacity" field.
+func CapacityIn(vs ...int) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldCapacity, vs...))
+}
+
+// CapacityNotIn applies the NotIn predicate on the "capacity" field.
+func CapacityNotIn(vs ...int) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldCapacity, vs...))
+}
+
+// CapacityGT applies the GT predicate on the "capacity" field.
+func CapacityGT(v int) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldCapacity, v))
+}
+
+// CapacityGTE applies the GTE predicate on the "capacity" field.
+func CapacityGTE(v int) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldCapacity, v))
+}
+
+// CapacityLT applies the LT predicate on the "capacity" field.
+func CapacityLT(v int) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldCapacity, v))
+}
+
+// CapacityLTE applies the LTE predicate on the "capacity" field.
+func CapacityLTE(v int) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldCapacity, v))
+}
+
+// SeatingLayoutsEQ applies the EQ predicate on the "seating_layouts" field.
+func SeatingLayoutsEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsNEQ applies the NEQ predicate on the "seating_layouts" field.
+func SeatingLayoutsNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsIn applies the In predicate on the "seating_layouts" field.
+func SeatingLayoutsIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldSeatingLayouts, vs...))
+}
+
+// SeatingLayoutsNotIn applies the NotIn predicate on the "seating_layouts" field.
+func SeatingLayoutsNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldSeatingLayouts, vs...))
+}
+
+// SeatingLayoutsGT applies the GT predicate on the "seating_layouts" field.
+func SeatingLayoutsGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsGTE applies the GTE predicate on the "seating_layouts" field.
+func SeatingLayoutsGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsLT applies the LT predicate on the "seating_layouts" field.
+func SeatingLayoutsLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsLTE applies the LTE predicate on the "seating_layouts" field.
+func SeatingLayoutsLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsContains applies the Contains predicate on the "seating_layouts" field.
+func SeatingLayoutsContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsHasPrefix applies the HasPrefix predicate on the "seating_layouts" field.
+func SeatingLayoutsHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsHasSuffix applies the HasSuffix predicate on the "seating_layouts" field.
+func SeatingLayoutsHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsIsNil applies the IsNil predicate on the "seating_layouts" field.
+func SeatingLayoutsIsNil() predicate.Venue {
+	return predicate.Venue(sql.FieldIsNull(FieldSeatingLayouts))
+}
+
+// SeatingLayoutsNotNil applies the NotNil predicate on the "seating_layouts" field.
+func SeatingLayoutsNotNil() predicate.Venue {
+	return predicate.Venue(sql.FieldNotNull(FieldSeatingLayouts))
+}
+
+// SeatingLayoutsEqualFold applies the EqualFold predicate on the "seating_layouts" field.
+func SeatingLayoutsEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldSeatingLayouts, v))
+}
+
+// SeatingLayoutsContainsFold applies the ContainsFold predicate on the "seating_layouts" field.
+func SeatingLayoutsContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldSeatingLayouts, v))
+}
+
+// DirectionsEQ applies the EQ predicate on the "directions" field.
+func DirectionsEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldDirections, v))
+}
+
+// DirectionsNEQ applies the NEQ predicate on the "directions" field.
+func DirectionsNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldDirections, v))
+}
+
+// DirectionsIn applies the In predicate on the "directions" field.
+func DirectionsIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldDirections, vs...))
+}
+
+// DirectionsNotIn applies the NotIn predicate on the "directions" field.
+func DirectionsNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldDirections, vs...))
+}
+
+// DirectionsGT applies the GT predicate on the "directions" field.
+func DirectionsGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldDirections, v))
+}
+
+// DirectionsGTE applies the GTE predicate on the "directions" field.
+func DirectionsGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldDirections, v))
+}
+
+// DirectionsLT applies the LT predicate on the "directions" field.
+func DirectionsLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldDirections, v))
+}
+
+// DirectionsLTE applies the LTE predicate on the "directions" field.
+func DirectionsLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldDirections, v))
+}
+
+// DirectionsContains applies the Contains predicate on the "directions" field.
+func DirectionsContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldDirections, v))
+}
+
+// DirectionsHasPrefix applies the HasPrefix predicate on the "directions" field.
+func DirectionsHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldDirections, v))
+}
+
+// DirectionsHasSuffix applies the HasSuffix predicate on the "directions" field.
+func DirectionsHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldDirections, v))
+}
+
+// DirectionsIsNil applies the IsNil predicate on the "directions" field.
+func DirectionsIsNil() predicate.Venue {
+	return predicate.Venue(sql.FieldIsNull(FieldDirections))
+}
+
+// DirectionsNotNil applies the NotNil predicate on the "directions" field.
+func DirectionsNotNil() predicate.Venue {
+	return predicate.Venue(sql.FieldNotNull(FieldDirections))
+}
+
+// DirectionsEqualFold applies the EqualFold predicate on the "directions" field.
+func DirectionsEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldDirections, v))
+}
+
+// DirectionsContainsFold applies the ContainsFold predicate on the "directions" field.
+func DirectionsContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldDirections, v))
+}
+
+// ParkingEQ applies the EQ predicate on the "parking" field.
+func ParkingEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldParking, v))
+}
+
+// ParkingNEQ applies the NEQ predicate on the "parking" field.
+func ParkingNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldParking, v))
+}
+
+// ParkingIn applies the In predicate on the "parking" field.
+func ParkingIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldParking, vs...))
+}
+
+// ParkingNotIn applies the NotIn predicate on the "parking" field.
+func ParkingNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldParking, vs...))
+}
+
+// ParkingGT applies the GT predicate on the "parking" field.
+func ParkingGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldParking, v))
+}
+
+// ParkingGTE applies the GTE predicate on the "parking" field.
+func ParkingGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldParking, v))
+}
+
+// ParkingLT applies the LT predicate on the "parking" field.
+func ParkingLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldParking, v))
+}
+
+// ParkingLTE applies the LTE predicate on the "parking" field.
+func ParkingLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldParking, v))
+}
+
+// ParkingContains applies the Contains predicate on the "parking" field.
+func ParkingContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldParking, v))
+}
+
+// ParkingHasPrefix applies the HasPrefix predicate on the "parking" field.
+func ParkingHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldParking, v))
+}
+
+// ParkingHasSuffix applies the HasSuffix predicate on the "parking" field.
+func ParkingHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldParking, v))
+}
+
+// ParkingIsNil applies the IsNil predicate on the "parking" field.
+func ParkingIsNil() predicate.Venue {
+	return predicate.Venue(sql.FieldIsNull(FieldParking))
+}
+
+// ParkingNotNil applies the NotNil predicate on the "parking" field.
+func ParkingNotNil() predicate.Venue {
+	return predicate.Venue(sql.FieldNotNull(FieldParking))
+}
+
+// ParkingEqualFold applies the EqualFold predicate on the "parking" field.
+func ParkingEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldParking, v))
+}
+
+// ParkingContainsFold applies the ContainsFold predicate on the "parking" field.
+func ParkingContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldParking, v))
+}
+
+// AccessibilityEQ applies the EQ predicate on the "accessibility" field.
+func AccessibilityEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldAccessibility, v))
+}
+
+// AccessibilityNEQ applies the NEQ predicate on the "accessibility" field.
+func AccessibilityNEQ(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldAccessibility, v))
+}
+
+// AccessibilityIn applies the In predicate on the "accessibility" field.
+func AccessibilityIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldAccessibility, vs...))
+}
+
+// AccessibilityNotIn applies the NotIn predicate on the "accessibility" field.
+func AccessibilityNotIn(vs ...string) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldAccessibility, vs...))
+}
+
+// AccessibilityGT applies the GT predicate on the "accessibility" field.
+func AccessibilityGT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldAccessibility, v))
+}
+
+// AccessibilityGTE applies the GTE predicate on the "accessibility" field.
+func AccessibilityGTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldAccessibility, v))
+}
+
+// AccessibilityLT applies the LT predicate on the "accessibility" field.
+func AccessibilityLT(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldAccessibility, v))
+}
+
+// AccessibilityLTE applies the LTE predicate on the "accessibility" field.
+func AccessibilityLTE(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldAccessibility, v))
+}
+
+// AccessibilityContains applies the Contains predicate on the "accessibility" field.
+func AccessibilityContains(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContains(FieldAccessibility, v))
+}
+
+// AccessibilityHasPrefix applies the HasPrefix predicate on the "accessibility" field.
+func AccessibilityHasPrefix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasPrefix(FieldAccessibility, v))
+}
+
+// AccessibilityHasSuffix applies the HasSuffix predicate on the "accessibility" field.
+func AccessibilityHasSuffix(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldHasSuffix(FieldAccessibility, v))
+}
+
+// AccessibilityIsNil applies the IsNil predicate on the "accessibility" field.
+func AccessibilityIsNil() predicate.Venue {
+	return predicate.Venue(sql.FieldIsNull(FieldAccessibility))
+}
+
+// AccessibilityNotNil applies the NotNil predicate on the "accessibility" field.
+func AccessibilityNotNil() predicate.Venue {
+	return predicate.Venue(sql.FieldNotNull(FieldAccessibility))
+}
+
+// AccessibilityEqualFold applies the EqualFold predicate on the "accessibility" field.
+func AccessibilityEqualFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldEqualFold(FieldAccessibility, v))
+}
+
+// AccessibilityContainsFold applies the ContainsFold predicate on the "accessibility" field.
+func AccessibilityContainsFold(v string) predicate.Venue {
+	return predicate.Venue(sql.FieldContainsFold(FieldAccessibility, v))
+}
+
+// CreatedByEQ applies the EQ predicate on the "created_by" field.
+func CreatedByEQ(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldCreatedBy, v))
+}
+
+// CreatedByNEQ applies the NEQ predicate on the "created_by" field.
+func CreatedByNEQ(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldCreatedBy, v))
+}
+
+// CreatedByIn applies the In predicate on the "created_by" field.
+func CreatedByIn(vs ...uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByNotIn applies the NotIn predicate on the "created_by" field.
+func CreatedByNotIn(vs ...uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldCreatedBy, vs...))
+}
+
+// CreatedByGT applies the GT predicate on the "created_by" field.
+func CreatedByGT(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldCreatedBy, v))
+}
+
+// CreatedByGTE applies the GTE predicate on the "created_by" field.
+func CreatedByGTE(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldCreatedBy, v))
+}
+
+// CreatedByLT applies the LT predicate on the "created_by" field.
+func CreatedByLT(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldCreatedBy, v))
+}
+
+// CreatedByLTE applies the LTE predicate on the "created_by" field.
+func CreatedByLTE(v uuid.UUID) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldCreatedBy, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Venue {
+	return predicate.Venue(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasOrganization applies the HasEdge predicate on the "organization" edge.
+func HasOrganization() predicate.Venue {
+	return predicate.Venue(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, OrganizationTable, OrganizationColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrganizationWith applies the HasEdge predicate on the "organization" edge with a given conditions (other predicates).
+func HasOrganizationWith(preds ...predicate.Organization) predicate.Venue {
+	return predicate.Venue(func(s *sql.Selector) {
+		step := newOrganizationStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEvents applies the HasEdge predicate on the "events" edge.
+func HasEvents() predicate.Venue {
+	return predicate.Venue(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventsTable, EventsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventsWith applies the HasEdge predicate on the "events" edge with a given conditions (other predicates).
+func HasEventsWith(preds ...predicate.Event) predicate.Venue {
+	return predicate.Venue(func(s *sql.Selector) {
+		step := newEventsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEventSeries applies the HasEdge predicate on the "event_series" edge.
+func HasEventSeries() predicate.Venue {
+	return predicate.Venue(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EventSeriesTable, EventSeriesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEventSeriesWith applies the HasEdge predicate on the "event_series" edge with a given conditions (other predicates).
+func HasEventSeriesWith(preds ...predicate.EventSeries) predicate.Venue {
+	return predicate.Venue(func(s *sql.Selector) {
+		step := newEventSeriesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Venue) predicate.Venue {
+	return predicate.Venue(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Venue) predicate.Venue {
+	return predicate.Venue(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Venue) predicate.Venue {
+	return predicate.Venue(sql.NotPredicates(p))
+}
@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/organization"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/google/uuid"
+)
+
+// VenueCreate is the builder for creating a Venue entity.
+type VenueCreate struct {
+	config
+	mutation *VenueMutation
+	hooks    []Hook
+}
+
+// SetOrganizationID sets the "organization_id" field.
+func (_c *VenueCreate) SetOrganizationID(v uuid.UUID) *VenueCreate {
+	_c.mutation.SetOrganizationID(v)
+	return _c
+}
+
+// SetNillableOrganizationID sets the "organization_id" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableOrganizationID(v *uuid.UUID) *VenueCreate {
+	if v != nil {
+		_c.SetOrganizationID(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *VenueCreate) SetName(v string) *VenueCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_c *VenueCreate) SetAddressStreet(v string) *VenueCreate {
+	_c.mutation.SetAddressStreet(v)
+	return _c
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableAddressStreet(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetAddressStreet(*v)
+	}
+	return _c
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_c *VenueCreate) SetAddressCity(v string) *VenueCreate {
+	_c.mutation.SetAddressCity(v)
+	return _c
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableAddressCity(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetAddressCity(*v)
+	}
+	return _c
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_c *VenueCreate) SetAddressRegion(v string) *VenueCreate {
+	_c.mutation.SetAddressRegion(v)
+	return _c
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableAddressRegion(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetAddressRegion(*v)
+	}
+	return _c
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_c *VenueCreate) SetAddressPostalCode(v string) *VenueCreate {
+	_c.mutation.SetAddressPostalCode(v)
+	return _c
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableAddressPostalCode(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetAddressPostalCode(*v)
+	}
+	return _c
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_c *VenueCreate) SetAddressCountry(v string) *VenueCreate {
+	_c.mutation.SetAddressCountry(v)
+	return _c
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableAddressCountry(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetAddressCountry(*v)
+	}
+	return _c
+}
+
+// SetLatitude sets the "latitude" field.
+func (_c *VenueCreate) SetLatitude(v float64) *VenueCreate {
+	_c.mutation.SetLatitude(v)
+	return _c
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableLatitude(v *float64) *VenueCreate {
+	if v != nil {
+		_c.SetLatitude(*v)
+	}
+	return _c
+}
+
+// SetLongitude sets the "longitude" field.
+func (_c *VenueCreate) SetLongitude(v float64) *VenueCreate {
+	_c.mutation.SetLongitude(v)
+	return _c
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableLongitude(v *float64) *VenueCreate {
+	if v != nil {
+		_c.SetLongitude(*v)
+	}
+	return _c
+}
+
+// SetCapacity sets the "capacity" field.
+func (_c *VenueCreate) SetCapacity(v int) *VenueCreate {
+	_c.mutation.SetCapacity(v)
+	return _c
+}
+
+// SetSeatingLayouts sets the "seating_layouts" field.
+func (_c *VenueCreate) SetSeatingLayouts(v string) *VenueCreate {
+	_c.mutation.SetSeatingLayouts(v)
+	return _c
+}
+
+// SetNillableSeatingLayouts sets the "seating_layouts" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableSeatingLayouts(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetSeatingLayouts(*v)
+	}
+	return _c
+}
+
+// SetDirections sets the "directions" field.
+func (_c *VenueCreate) SetDirections(v string) *VenueCreate {
+	_c.mutation.SetDirections(v)
+	return _c
+}
+
+// SetNillableDirections sets the "directions" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableDirections(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetDirections(*v)
+	}
+	return _c
+}
+
+// SetParking sets the "parking" field.
+func (_c *VenueCreate) SetParking(v string) *VenueCreate {
+	_c.mutation.SetParking(v)
+	return _c
+}
+
+// SetNillableParking sets the "parking" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableParking(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetParking(*v)
+	}
+	return _c
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (_c *VenueCreate) SetAccessibility(v string) *VenueCreate {
+	_c.mutation.SetAccessibility(v)
+	return _c
+}
+
+// SetNillableAccessibility sets the "accessibility" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableAccessibility(v *string) *VenueCreate {
+	if v != nil {
+		_c.SetAccessibility(*v)
+	}
+	return _c
+}
+
+// SetCreatedBy sets the "created_by" field.
+func (_c *VenueCreate) SetCreatedBy(v uuid.UUID) *VenueCreate {
+	_c.mutation.SetCreatedBy(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *VenueCreate) SetCreatedAt(v time.Time) *VenueCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableCreatedAt(v *time.Time) *VenueCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *VenueCreate) SetUpdatedAt(v time.Time) *VenueCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableUpdatedAt(v *time.Time) *VenueCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *VenueCreate) SetID(v uuid.UUID) *VenueCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *VenueCreate) SetNillableID(v *uuid.UUID) *VenueCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetOrganization sets the "organization" edge to the Organization entity.
+func (_c *VenueCreate) SetOrganization(v *Organization) *VenueCreate {
+	return _c.SetOrganizationID(v.ID)
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by IDs.
+func (_c *VenueCreate) AddEventIDs(ids ...uuid.UUID) *VenueCreate {
+	_c.mutation.AddEventIDs(ids...)
+	return _c
+}
+
+// AddEvents adds the "events" edges to the Event entity.
+func (_c *VenueCreate) AddEvents(v ...*Event) *VenueCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventIDs(ids...)
+}
+
+// AddEventSeriesIDs adds the "event_series" edge to the EventSeries entity by IDs.
+func (_c *VenueCreate) AddEventSeriesIDs(ids ...uuid.UUID) *VenueCreate {
+	_c.mutation.AddEventSeriesIDs(ids...)
+	return _c
+}
+
+// AddEventSeries adds the "event_series" edges to the EventSeries entity.
+func (_c *VenueCreate) AddEventSeries(v ...*EventSeries) *VenueCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddEventSeriesIDs(ids...)
+}
+
+// Mutation returns the VenueMutation object of the builder.
+func (_c *VenueCreate) Mutation() *VenueMutation {
+	return _c.mutation
+}
+
+// Save creates the Venue in the database.
+func (_c *VenueCreate) Save(ctx context.Context) (*Venue, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *VenueCreate) SaveX(ctx context.Context) *Venue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VenueCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VenueCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *VenueCreate) defaults() {
+	if _, ok := _c.mutation.AddressStreet(); !ok {
+		v := venue.DefaultAddressStreet
+		_c.mutation.SetAddressStreet(v)
+	}
+	if _, ok := _c.mutation.AddressCity(); !ok {
+		v := venue.DefaultAddressCity
+		_c.mutation.SetAddressCity(v)
+	}
+	if _, ok := _c.mutation.AddressRegion(); !ok {
+		v := venue.DefaultAddressRegion
+		_c.mutation.SetAddressRegion(v)
+	}
+	if _, ok := _c.mutation.AddressPostalCode(); !ok {
+		v := venue.DefaultAddressPostalCode
+		_c.mutation.SetAddressPostalCode(v)
+	}
+	if _, ok := _c.mutation.AddressCountry(); !ok {
+		v := venue.DefaultAddressCountry
+		_c.mutation.SetAddressCountry(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := venue.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := venue.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := venue.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *VenueCreate) check() error {
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Venue.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := venue.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Venue.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressStreet(); !ok {
+		return &ValidationError{Name: "address_street", err: errors.New(`ent: missing required field "Venue.address_street"`)}
+	}
+	if _, ok := _c.mutation.AddressCity(); !ok {
+		return &ValidationError{Name: "address_city", err: errors.New(`ent: missing required field "Venue.address_city"`)}
+	}
+	if v, ok := _c.mutation.AddressCity(); ok {
+		if err := venue.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "Venue.address_city": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressRegion(); !ok {
+		return &ValidationError{Name: "address_region", err: errors.New(`ent: missing required field "Venue.address_region"`)}
+	}
+	if v, ok := _c.mutation.AddressRegion(); ok {
+		if err := venue.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "Venue.address_region": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressPostalCode(); !ok {
+		return &ValidationError{Name: "address_postal_code", err: errors.New(`ent: missing required field "Venue.address_postal_code"`)}
+	}
+	if v, ok := _c.mutation.AddressPostalCode(); ok {
+		if err := venue.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "Venue.address_postal_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AddressCountry(); !ok {
+		return &ValidationError{Name: "address_country", err: errors.New(`ent: missing required field "Venue.address_country"`)}
+	}
+	if v, ok := _c.mutation.AddressCountry(); ok {
+		if err := venue.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "Venue.address_country": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Capacity(); !ok {
+		return &ValidationError{Name: "capacity", err: errors.New(`ent: missing required field "Venue.capacity"`)}
+	}
+	if v, ok := _c.mutation.Capacity(); ok {
+		if err := venue.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Venue.capacity": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedBy(); !ok {
+		return &ValidationError{Name: "created_by", err: errors.New(`ent: missing required field "Venue.created_by"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Venue.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Venue.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *VenueCreate) sqlSave(ctx context.Context) (*Venue, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *VenueCreate) createSpec() (*Venue, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Venue{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(venue.Table, sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(venue.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.AddressStreet(); ok {
+		_spec.SetField(venue.FieldAddressStreet, field.TypeString, value)
+		_node.AddressStreet = value
+	}
+	if value, ok := _c.mutation.AddressCity(); ok {
+		_spec.SetField(venue.FieldAddressCity, field.TypeString, value)
+		_node.AddressCity = value
+	}
+	if value, ok := _c.mutation.AddressRegion(); ok {
+		_spec.SetField(venue.FieldAddressRegion, field.TypeString, value)
+		_node.AddressRegion = value
+	}
+	if value, ok := _c.mutation.AddressPostalCode(); ok {
+		_spec.SetField(venue.FieldAddressPostalCode, field.TypeString, value)
+		_node.AddressPostalCode = value
+	}
+	if value, ok := _c.mutation.AddressCountry(); ok {
+		_spec.SetField(venue.FieldAddressCountry, field.TypeString, value)
+		_node.AddressCountry = value
+	}
+	if value, ok := _c.mutation.Latitude(); ok {
+		_spec.SetField(venue.FieldLatitude, field.TypeFloat64, value)
+		_node.Latitude = &value
+	}
+	if value, ok := _c.mutation.Longitude(); ok {
+		_spec.SetField(venue.FieldLongitude, field.TypeFloat64, value)
+		_node.Longitude = &value
+	}
+	if value, ok := _c.mutation.Capacity(); ok {
+		_spec.SetField(venue.FieldCapacity, field.TypeInt, value)
+		_node.Capacity = value
+	}
+	if value, ok := _c.mutation.SeatingLayouts(); ok {
+		_spec.SetField(venue.FieldSeatingLayouts, field.TypeString, value)
+		_node.SeatingLayouts = value
+	}
+	if value, ok := _c.mutation.Directions(); ok {
+		_spec.SetField(venue.FieldDirections, field.TypeString, value)
+		_node.Directions = value
+	}
+	if value, ok := _c.mutation.Parking(); ok {
+		_spec.SetField(venue.FieldParking, field.TypeString, value)
+		_node.Parking = value
+	}
+	if value, ok := _c.mutation.Accessibility(); ok {
+		_spec.SetField(venue.FieldAccessibility, field.TypeString, value)
+		_node.Accessibility = value
+	}
+	if value, ok := _c.mutation.CreatedBy(); ok {
+		_spec.SetField(venue.FieldCreatedBy, field.TypeUUID, value)
+		_node.CreatedBy = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(venue.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(venue.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.OrganizationIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   venue.OrganizationTable,
+			Columns: []string{venue.OrganizationColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(organization.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.OrganizationID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventsTable,
+			Columns: []string{venue.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.EventSeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventSeriesTable,
+			Columns: []string{venue.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// VenueCreateBulk is the builder for creating many Venue entities in bulk.
+type VenueCreateBulk struct {
+	config
+	err      error
+	builders []*VenueCreate
+}
+
+// Save creates the Venue entities in the database.
+func (_c *VenueCreateBulk) Save(ctx context.Context) ([]*Venue, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Venue, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*VenueMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *VenueCreateBulk) SaveX(ctx context.Context) []*Venue {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *VenueCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *VenueCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1225 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/event"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/eventseries"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/predicate"
+	"github.com/dev-hyunsang/ticketly-backend/lib/ent/venue"
+	"github.com/google/uuid"
+)
+
+// VenueUpdate is the builder for updating Venue entities.
+type VenueUpdate struct {
+	config
+	hooks    []Hook
+	mutation *VenueMutation
+}
+
+// Where appends a list predicates to the VenueUpdate builder.
+func (_u *VenueUpdate) Where(ps ...predicate.Venue) *VenueUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *VenueUpdate) SetName(v string) *VenueUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableName(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_u *VenueUpdate) SetAddressStreet(v string) *VenueUpdate {
+	_u.mutation.SetAddressStreet(v)
+	return _u
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableAddressStreet(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetAddressStreet(*v)
+	}
+	return _u
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_u *VenueUpdate) SetAddressCity(v string) *VenueUpdate {
+	_u.mutation.SetAddressCity(v)
+	return _u
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableAddressCity(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetAddressCity(*v)
+	}
+	return _u
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_u *VenueUpdate) SetAddressRegion(v string) *VenueUpdate {
+	_u.mutation.SetAddressRegion(v)
+	return _u
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableAddressRegion(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetAddressRegion(*v)
+	}
+	return _u
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_u *VenueUpdate) SetAddressPostalCode(v string) *VenueUpdate {
+	_u.mutation.SetAddressPostalCode(v)
+	return _u
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableAddressPostalCode(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetAddressPostalCode(*v)
+	}
+	return _u
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_u *VenueUpdate) SetAddressCountry(v string) *VenueUpdate {
+	_u.mutation.SetAddressCountry(v)
+	return _u
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableAddressCountry(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetAddressCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *VenueUpdate) SetLatitude(v float64) *VenueUpdate {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableLatitude(v *float64) *VenueUpdate {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *VenueUpdate) AddLatitude(v float64) *VenueUpdate {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *VenueUpdate) ClearLatitude() *VenueUpdate {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *VenueUpdate) SetLongitude(v float64) *VenueUpdate {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableLongitude(v *float64) *VenueUpdate {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *VenueUpdate) AddLongitude(v float64) *VenueUpdate {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *VenueUpdate) ClearLongitude() *VenueUpdate {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *VenueUpdate) SetCapacity(v int) *VenueUpdate {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableCapacity(v *int) *VenueUpdate {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *VenueUpdate) AddCapacity(v int) *VenueUpdate {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetSeatingLayouts sets the "seating_layouts" field.
+func (_u *VenueUpdate) SetSeatingLayouts(v string) *VenueUpdate {
+	_u.mutation.SetSeatingLayouts(v)
+	return _u
+}
+
+// SetNillableSeatingLayouts sets the "seating_layouts" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableSeatingLayouts(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetSeatingLayouts(*v)
+	}
+	return _u
+}
+
+// ClearSeatingLayouts clears the value of the "seating_layouts" field.
+func (_u *VenueUpdate) ClearSeatingLayouts() *VenueUpdate {
+	_u.mutation.ClearSeatingLayouts()
+	return _u
+}
+
+// SetDirections sets the "directions" field.
+func (_u *VenueUpdate) SetDirections(v string) *VenueUpdate {
+	_u.mutation.SetDirections(v)
+	return _u
+}
+
+// SetNillableDirections sets the "directions" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableDirections(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetDirections(*v)
+	}
+	return _u
+}
+
+// ClearDirections clears the value of the "directions" field.
+func (_u *VenueUpdate) ClearDirections() *VenueUpdate {
+	_u.mutation.ClearDirections()
+	return _u
+}
+
+// SetParking sets the "parking" field.
+func (_u *VenueUpdate) SetParking(v string) *VenueUpdate {
+	_u.mutation.SetParking(v)
+	return _u
+}
+
+// SetNillableParking sets the "parking" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableParking(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetParking(*v)
+	}
+	return _u
+}
+
+// ClearParking clears the value of the "parking" field.
+func (_u *VenueUpdate) ClearParking() *VenueUpdate {
+	_u.mutation.ClearParking()
+	return _u
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (_u *VenueUpdate) SetAccessibility(v string) *VenueUpdate {
+	_u.mutation.SetAccessibility(v)
+	return _u
+}
+
+// SetNillableAccessibility sets the "accessibility" field if the given value is not nil.
+func (_u *VenueUpdate) SetNillableAccessibility(v *string) *VenueUpdate {
+	if v != nil {
+		_u.SetAccessibility(*v)
+	}
+	return _u
+}
+
+// ClearAccessibility clears the value of the "accessibility" field.
+func (_u *VenueUpdate) ClearAccessibility() *VenueUpdate {
+	_u.mutation.ClearAccessibility()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VenueUpdate) SetUpdatedAt(v time.Time) *VenueUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by IDs.
+func (_u *VenueUpdate) AddEventIDs(ids ...uuid.UUID) *VenueUpdate {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the Event entity.
+func (_u *VenueUpdate) AddEvents(v ...*Event) *VenueUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddEventSeriesIDs adds the "event_series" edge to the EventSeries entity by IDs.
+func (_u *VenueUpdate) AddEventSeriesIDs(ids ...uuid.UUID) *VenueUpdate {
+	_u.mutation.AddEventSeriesIDs(ids...)
+	return _u
+}
+
+// AddEventSeries adds the "event_series" edges to the EventSeries entity.
+func (_u *VenueUpdate) AddEventSeries(v ...*EventSeries) *VenueUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventSeriesIDs(ids...)
+}
+
+// Mutation returns the VenueMutation object of the builder.
+func (_u *VenueUpdate) Mutation() *VenueMutation {
+	return _u.mutation
+}
+
+// ClearEvents clears all "events" edges to the Event entity.
+func (_u *VenueUpdate) ClearEvents() *VenueUpdate {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to Event entities by IDs.
+func (_u *VenueUpdate) RemoveEventIDs(ids ...uuid.UUID) *VenueUpdate {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to Event entities.
+func (_u *VenueUpdate) RemoveEvents(v ...*Event) *VenueUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearEventSeries clears all "event_series" edges to the EventSeries entity.
+func (_u *VenueUpdate) ClearEventSeries() *VenueUpdate {
+	_u.mutation.ClearEventSeries()
+	return _u
+}
+
+// RemoveEventSeriesIDs removes the "event_series" edge to EventSeries entities by IDs.
+func (_u *VenueUpdate) RemoveEventSeriesIDs(ids ...uuid.UUID) *VenueUpdate {
+	_u.mutation.RemoveEventSeriesIDs(ids...)
+	return _u
+}
+
+// RemoveEventSeries removes "event_series" edges to EventSeries entities.
+func (_u *VenueUpdate) RemoveEventSeries(v ...*EventSeries) *VenueUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventSeriesIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *VenueUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VenueUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *VenueUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VenueUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VenueUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := venue.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VenueUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := venue.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Venue.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCity(); ok {
+		if err := venue.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "Venue.address_city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressRegion(); ok {
+		if err := venue.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "Venue.address_region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressPostalCode(); ok {
+		if err := venue.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "Venue.address_postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCountry(); ok {
+		if err := venue.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "Venue.address_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := venue.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Venue.capacity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VenueUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(venue.Table, venue.Columns, sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(venue.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressStreet(); ok {
+		_spec.SetField(venue.FieldAddressStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCity(); ok {
+		_spec.SetField(venue.FieldAddressCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressRegion(); ok {
+		_spec.SetField(venue.FieldAddressRegion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressPostalCode(); ok {
+		_spec.SetField(venue.FieldAddressPostalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCountry(); ok {
+		_spec.SetField(venue.FieldAddressCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(venue.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(venue.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(venue.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(venue.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(venue.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(venue.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(venue.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(venue.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SeatingLayouts(); ok {
+		_spec.SetField(venue.FieldSeatingLayouts, field.TypeString, value)
+	}
+	if _u.mutation.SeatingLayoutsCleared() {
+		_spec.ClearField(venue.FieldSeatingLayouts, field.TypeString)
+	}
+	if value, ok := _u.mutation.Directions(); ok {
+		_spec.SetField(venue.FieldDirections, field.TypeString, value)
+	}
+	if _u.mutation.DirectionsCleared() {
+		_spec.ClearField(venue.FieldDirections, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parking(); ok {
+		_spec.SetField(venue.FieldParking, field.TypeString, value)
+	}
+	if _u.mutation.ParkingCleared() {
+		_spec.ClearField(venue.FieldParking, field.TypeString)
+	}
+	if value, ok := _u.mutation.Accessibility(); ok {
+		_spec.SetField(venue.FieldAccessibility, field.TypeString, value)
+	}
+	if _u.mutation.AccessibilityCleared() {
+		_spec.ClearField(venue.FieldAccessibility, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(venue.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventsTable,
+			Columns: []string{venue.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventsTable,
+			Columns: []string{venue.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventsTable,
+			Columns: []string{venue.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventSeriesTable,
+			Columns: []string{venue.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventSeriesIDs(); len(nodes) > 0 && !_u.mutation.EventSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventSeriesTable,
+			Columns: []string{venue.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventSeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventSeriesTable,
+			Columns: []string{venue.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{venue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// VenueUpdateOne is the builder for updating a single Venue entity.
+type VenueUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *VenueMutation
+}
+
+// SetName sets the "name" field.
+func (_u *VenueUpdateOne) SetName(v string) *VenueUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableName(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetAddressStreet sets the "address_street" field.
+func (_u *VenueUpdateOne) SetAddressStreet(v string) *VenueUpdateOne {
+	_u.mutation.SetAddressStreet(v)
+	return _u
+}
+
+// SetNillableAddressStreet sets the "address_street" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableAddressStreet(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetAddressStreet(*v)
+	}
+	return _u
+}
+
+// SetAddressCity sets the "address_city" field.
+func (_u *VenueUpdateOne) SetAddressCity(v string) *VenueUpdateOne {
+	_u.mutation.SetAddressCity(v)
+	return _u
+}
+
+// SetNillableAddressCity sets the "address_city" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableAddressCity(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetAddressCity(*v)
+	}
+	return _u
+}
+
+// SetAddressRegion sets the "address_region" field.
+func (_u *VenueUpdateOne) SetAddressRegion(v string) *VenueUpdateOne {
+	_u.mutation.SetAddressRegion(v)
+	return _u
+}
+
+// SetNillableAddressRegion sets the "address_region" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableAddressRegion(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetAddressRegion(*v)
+	}
+	return _u
+}
+
+// SetAddressPostalCode sets the "address_postal_code" field.
+func (_u *VenueUpdateOne) SetAddressPostalCode(v string) *VenueUpdateOne {
+	_u.mutation.SetAddressPostalCode(v)
+	return _u
+}
+
+// SetNillableAddressPostalCode sets the "address_postal_code" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableAddressPostalCode(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetAddressPostalCode(*v)
+	}
+	return _u
+}
+
+// SetAddressCountry sets the "address_country" field.
+func (_u *VenueUpdateOne) SetAddressCountry(v string) *VenueUpdateOne {
+	_u.mutation.SetAddressCountry(v)
+	return _u
+}
+
+// SetNillableAddressCountry sets the "address_country" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableAddressCountry(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetAddressCountry(*v)
+	}
+	return _u
+}
+
+// SetLatitude sets the "latitude" field.
+func (_u *VenueUpdateOne) SetLatitude(v float64) *VenueUpdateOne {
+	_u.mutation.ResetLatitude()
+	_u.mutation.SetLatitude(v)
+	return _u
+}
+
+// SetNillableLatitude sets the "latitude" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableLatitude(v *float64) *VenueUpdateOne {
+	if v != nil {
+		_u.SetLatitude(*v)
+	}
+	return _u
+}
+
+// AddLatitude adds value to the "latitude" field.
+func (_u *VenueUpdateOne) AddLatitude(v float64) *VenueUpdateOne {
+	_u.mutation.AddLatitude(v)
+	return _u
+}
+
+// ClearLatitude clears the value of the "latitude" field.
+func (_u *VenueUpdateOne) ClearLatitude() *VenueUpdateOne {
+	_u.mutation.ClearLatitude()
+	return _u
+}
+
+// SetLongitude sets the "longitude" field.
+func (_u *VenueUpdateOne) SetLongitude(v float64) *VenueUpdateOne {
+	_u.mutation.ResetLongitude()
+	_u.mutation.SetLongitude(v)
+	return _u
+}
+
+// SetNillableLongitude sets the "longitude" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableLongitude(v *float64) *VenueUpdateOne {
+	if v != nil {
+		_u.SetLongitude(*v)
+	}
+	return _u
+}
+
+// AddLongitude adds value to the "longitude" field.
+func (_u *VenueUpdateOne) AddLongitude(v float64) *VenueUpdateOne {
+	_u.mutation.AddLongitude(v)
+	return _u
+}
+
+// ClearLongitude clears the value of the "longitude" field.
+func (_u *VenueUpdateOne) ClearLongitude() *VenueUpdateOne {
+	_u.mutation.ClearLongitude()
+	return _u
+}
+
+// SetCapacity sets the "capacity" field.
+func (_u *VenueUpdateOne) SetCapacity(v int) *VenueUpdateOne {
+	_u.mutation.ResetCapacity()
+	_u.mutation.SetCapacity(v)
+	return _u
+}
+
+// SetNillableCapacity sets the "capacity" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableCapacity(v *int) *VenueUpdateOne {
+	if v != nil {
+		_u.SetCapacity(*v)
+	}
+	return _u
+}
+
+// AddCapacity adds value to the "capacity" field.
+func (_u *VenueUpdateOne) AddCapacity(v int) *VenueUpdateOne {
+	_u.mutation.AddCapacity(v)
+	return _u
+}
+
+// SetSeatingLayouts sets the "seating_layouts" field.
+func (_u *VenueUpdateOne) SetSeatingLayouts(v string) *VenueUpdateOne {
+	_u.mutation.SetSeatingLayouts(v)
+	return _u
+}
+
+// SetNillableSeatingLayouts sets the "seating_layouts" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableSeatingLayouts(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetSeatingLayouts(*v)
+	}
+	return _u
+}
+
+// ClearSeatingLayouts clears the value of the "seating_layouts" field.
+func (_u *VenueUpdateOne) ClearSeatingLayouts() *VenueUpdateOne {
+	_u.mutation.ClearSeatingLayouts()
+	return _u
+}
+
+// SetDirections sets the "directions" field.
+func (_u *VenueUpdateOne) SetDirections(v string) *VenueUpdateOne {
+	_u.mutation.SetDirections(v)
+	return _u
+}
+
+// SetNillableDirections sets the "directions" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableDirections(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetDirections(*v)
+	}
+	return _u
+}
+
+// ClearDirections clears the value of the "directions" field.
+func (_u *VenueUpdateOne) ClearDirections() *VenueUpdateOne {
+	_u.mutation.ClearDirections()
+	return _u
+}
+
+// SetParking sets the "parking" field.
+func (_u *VenueUpdateOne) SetParking(v string) *VenueUpdateOne {
+	_u.mutation.SetParking(v)
+	return _u
+}
+
+// SetNillableParking sets the "parking" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableParking(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetParking(*v)
+	}
+	return _u
+}
+
+// ClearParking clears the value of the "parking" field.
+func (_u *VenueUpdateOne) ClearParking() *VenueUpdateOne {
+	_u.mutation.ClearParking()
+	return _u
+}
+
+// SetAccessibility sets the "accessibility" field.
+func (_u *VenueUpdateOne) SetAccessibility(v string) *VenueUpdateOne {
+	_u.mutation.SetAccessibility(v)
+	return _u
+}
+
+// SetNillableAccessibility sets the "accessibility" field if the given value is not nil.
+func (_u *VenueUpdateOne) SetNillableAccessibility(v *string) *VenueUpdateOne {
+	if v != nil {
+		_u.SetAccessibility(*v)
+	}
+	return _u
+}
+
+// ClearAccessibility clears the value of the "accessibility" field.
+func (_u *VenueUpdateOne) ClearAccessibility() *VenueUpdateOne {
+	_u.mutation.ClearAccessibility()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *VenueUpdateOne) SetUpdatedAt(v time.Time) *VenueUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// AddEventIDs adds the "events" edge to the Event entity by IDs.
+func (_u *VenueUpdateOne) AddEventIDs(ids ...uuid.UUID) *VenueUpdateOne {
+	_u.mutation.AddEventIDs(ids...)
+	return _u
+}
+
+// AddEvents adds the "events" edges to the Event entity.
+func (_u *VenueUpdateOne) AddEvents(v ...*Event) *VenueUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventIDs(ids...)
+}
+
+// AddEventSeriesIDs adds the "event_series" edge to the EventSeries entity by IDs.
+func (_u *VenueUpdateOne) AddEventSeriesIDs(ids ...uuid.UUID) *VenueUpdateOne {
+	_u.mutation.AddEventSeriesIDs(ids...)
+	return _u
+}
+
+// AddEventSeries adds the "event_series" edges to the EventSeries entity.
+func (_u *VenueUpdateOne) AddEventSeries(v ...*EventSeries) *VenueUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddEventSeriesIDs(ids...)
+}
+
+// Mutation returns the VenueMutation object of the builder.
+func (_u *VenueUpdateOne) Mutation() *VenueMutation {
+	return _u.mutation
+}
+
+// ClearEvents clears all "events" edges to the Event entity.
+func (_u *VenueUpdateOne) ClearEvents() *VenueUpdateOne {
+	_u.mutation.ClearEvents()
+	return _u
+}
+
+// RemoveEventIDs removes the "events" edge to Event entities by IDs.
+func (_u *VenueUpdateOne) RemoveEventIDs(ids ...uuid.UUID) *VenueUpdateOne {
+	_u.mutation.RemoveEventIDs(ids...)
+	return _u
+}
+
+// RemoveEvents removes "events" edges to Event entities.
+func (_u *VenueUpdateOne) RemoveEvents(v ...*Event) *VenueUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventIDs(ids...)
+}
+
+// ClearEventSeries clears all "event_series" edges to the EventSeries entity.
+func (_u *VenueUpdateOne) ClearEventSeries() *VenueUpdateOne {
+	_u.mutation.ClearEventSeries()
+	return _u
+}
+
+// RemoveEventSeriesIDs removes the "event_series" edge to EventSeries entities by IDs.
+func (_u *VenueUpdateOne) RemoveEventSeriesIDs(ids ...uuid.UUID) *VenueUpdateOne {
+	_u.mutation.RemoveEventSeriesIDs(ids...)
+	return _u
+}
+
+// RemoveEventSeries removes "event_series" edges to EventSeries entities.
+func (_u *VenueUpdateOne) RemoveEventSeries(v ...*EventSeries) *VenueUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveEventSeriesIDs(ids...)
+}
+
+// Where appends a list predicates to the VenueUpdate builder.
+func (_u *VenueUpdateOne) Where(ps ...predicate.Venue) *VenueUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *VenueUpdateOne) Select(field string, fields ...string) *VenueUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Venue entity.
+func (_u *VenueUpdateOne) Save(ctx context.Context) (*Venue, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *VenueUpdateOne) SaveX(ctx context.Context) *Venue {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *VenueUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *VenueUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *VenueUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := venue.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *VenueUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := venue.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Venue.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCity(); ok {
+		if err := venue.AddressCityValidator(v); err != nil {
+			return &ValidationError{Name: "address_city", err: fmt.Errorf(`ent: validator failed for field "Venue.address_city": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressRegion(); ok {
+		if err := venue.AddressRegionValidator(v); err != nil {
+			return &ValidationError{Name: "address_region", err: fmt.Errorf(`ent: validator failed for field "Venue.address_region": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressPostalCode(); ok {
+		if err := venue.AddressPostalCodeValidator(v); err != nil {
+			return &ValidationError{Name: "address_postal_code", err: fmt.Errorf(`ent: validator failed for field "Venue.address_postal_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AddressCountry(); ok {
+		if err := venue.AddressCountryValidator(v); err != nil {
+			return &ValidationError{Name: "address_country", err: fmt.Errorf(`ent: validator failed for field "Venue.address_country": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Capacity(); ok {
+		if err := venue.CapacityValidator(v); err != nil {
+			return &ValidationError{Name: "capacity", err: fmt.Errorf(`ent: validator failed for field "Venue.capacity": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *VenueUpdateOne) sqlSave(ctx context.Context) (_node *Venue, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(venue.Table, venue.Columns, sqlgraph.NewFieldSpec(venue.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Venue.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, venue.FieldID)
+		for _, f := range fields {
+			if !venue.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != venue.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(venue.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressStreet(); ok {
+		_spec.SetField(venue.FieldAddressStreet, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCity(); ok {
+		_spec.SetField(venue.FieldAddressCity, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressRegion(); ok {
+		_spec.SetField(venue.FieldAddressRegion, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressPostalCode(); ok {
+		_spec.SetField(venue.FieldAddressPostalCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AddressCountry(); ok {
+		_spec.SetField(venue.FieldAddressCountry, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Latitude(); ok {
+		_spec.SetField(venue.FieldLatitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLatitude(); ok {
+		_spec.AddField(venue.FieldLatitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LatitudeCleared() {
+		_spec.ClearField(venue.FieldLatitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Longitude(); ok {
+		_spec.SetField(venue.FieldLongitude, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedLongitude(); ok {
+		_spec.AddField(venue.FieldLongitude, field.TypeFloat64, value)
+	}
+	if _u.mutation.LongitudeCleared() {
+		_spec.ClearField(venue.FieldLongitude, field.TypeFloat64)
+	}
+	if value, ok := _u.mutation.Capacity(); ok {
+		_spec.SetField(venue.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCapacity(); ok {
+		_spec.AddField(venue.FieldCapacity, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.SeatingLayouts(); ok {
+		_spec.SetField(venue.FieldSeatingLayouts, field.TypeString, value)
+	}
+	if _u.mutation.SeatingLayoutsCleared() {
+		_spec.ClearField(venue.FieldSeatingLayouts, field.TypeString)
+	}
+	if value, ok := _u.mutation.Directions(); ok {
+		_spec.SetField(venue.FieldDirections, field.TypeString, value)
+	}
+	if _u.mutation.DirectionsCleared() {
+		_spec.ClearField(venue.FieldDirections, field.TypeString)
+	}
+	if value, ok := _u.mutation.Parking(); ok {
+		_spec.SetField(venue.FieldParking, field.TypeString, value)
+	}
+	if _u.mutation.ParkingCleared() {
+		_spec.ClearField(venue.FieldParking, field.TypeString)
+	}
+	if value, ok := _u.mutation.Accessibility(); ok {
+		_spec.SetField(venue.FieldAccessibility, field.TypeString, value)
+	}
+	if _u.mutation.AccessibilityCleared() {
+		_spec.ClearField(venue.FieldAccessibility, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(venue.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventsTable,
+			Columns: []string{venue.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventsIDs(); len(nodes) > 0 && !_u.mutation.EventsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventsTable,
+			Columns: []string{venue.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventsTable,
+			Columns: []string{venue.EventsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(event.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.EventSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventSeriesTable,
+			Columns: []string{venue.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedEventSeriesIDs(); len(nodes) > 0 && !_u.mutation.EventSeriesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventSeriesTable,
+			Columns: []string{venue.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.EventSeriesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   venue.EventSeriesTable,
+			Columns: []string{venue.EventSeriesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(eventseries.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Venue{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{venue.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}